@@ -0,0 +1,174 @@
+// Command replay re-runs a participation journal against a test database to reproduce
+// overbooking or waitlist promotion anomalies seen in production.
+//
+// Usage:
+//
+//	curl -H "Authorization: Bearer $ADMIN_TOKEN" https://api.example.com/v1/admin/games/$GAME_ID/journal > journal.json
+//	DATABASE_URL=postgres://localhost/volley_test go run ./cmd/replay -journal journal.json
+//
+// The test database must already contain the game and its users (e.g. restored from a snapshot).
+// Each game's participants are deleted before the replay starts. Requests are sent at their
+// original relative timing, divided by -speed, so requests that overlapped in production overlap again.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/database"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type replayResult struct {
+	entry   models.ParticipationJournalEntry
+	outcome string
+	err     error
+}
+
+func main() {
+	journalPath := flag.String("journal", "", "path to a journal exported from GET /v1/admin/games/:gameId/journal")
+	speed := flag.Float64("speed", 1, "replay speed multiplier (0 sends every request at once)")
+	flag.Parse()
+
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	if *journalPath == "" || *speed < 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	raw, err := os.ReadFile(*journalPath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to read journal")
+	}
+	var journal models.ListParticipationJournalResponse
+	if err := json.Unmarshal(raw, &journal); err != nil {
+		log.Fatal().Err(err).Msg("Failed to parse journal")
+	}
+	entries := journal.Entries
+	if len(entries) == 0 {
+		log.Fatal().Msg("Journal has no entries")
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].RequestedAt.Before(entries[j].RequestedAt)
+	})
+
+	ctx := context.Background()
+	pool, err := database.NewPool(ctx)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create database pool")
+	}
+	defer pool.Close()
+
+	queries := repository.New(pool)
+	gamesService := service.NewGamesService(queries, pool)
+
+	gameIDs := map[string]bool{}
+	for _, e := range entries {
+		if !gameIDs[e.GameID] {
+			gameIDs[e.GameID] = true
+			if err := gamesService.ResetParticipants(ctx, e.GameID); err != nil {
+				log.Fatal().Err(err).Str("gameId", e.GameID).Msg("Failed to reset game")
+			}
+		}
+	}
+
+	results := replay(ctx, gamesService, entries, *speed)
+
+	anomalies := 0
+	fmt.Printf("%-6s %-5s %-36s %-10s %-10s\n", "ID", "ACT", "USER", "ORIGINAL", "REPLAY")
+	for _, r := range results {
+		marker := ""
+		if r.outcome != r.entry.Outcome {
+			marker = "  <- differs"
+			anomalies++
+		}
+		fmt.Printf("%-6d %-5s %-36s %-10s %-10s%s\n", r.entry.ID, r.entry.Action, r.entry.UserID, r.entry.Outcome, r.outcome, marker)
+		if r.err != nil {
+			fmt.Printf("       error: %v\n", r.err)
+		}
+	}
+
+	fmt.Println()
+	for gameID := range gameIDs {
+		ok, err := checkRoster(ctx, queries, gameID)
+		if err != nil {
+			log.Fatal().Err(err).Str("gameId", gameID).Msg("Failed to check roster")
+		}
+		if !ok {
+			anomalies++
+		}
+	}
+
+	if anomalies > 0 {
+		os.Exit(1)
+	}
+}
+
+// replay sends each entry at its original offset from the first request, scaled by speed
+func replay(ctx context.Context, gamesService *service.GamesService, entries []models.ParticipationJournalEntry, speed float64) []replayResult {
+	results := make([]replayResult, len(entries))
+	start := time.Now()
+	first := entries[0].RequestedAt
+
+	var wg sync.WaitGroup
+	for i, e := range entries {
+		results[i].entry = e
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if speed > 0 {
+				delay := time.Duration(float64(e.RequestedAt.Sub(first)) / speed)
+				time.Sleep(time.Until(start.Add(delay)))
+			}
+			results[i].outcome, results[i].err = gamesService.ReplayParticipation(ctx, e)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// checkRoster prints a game's final roster counts and reports whether they are consistent
+func checkRoster(ctx context.Context, queries *repository.Queries, gameID string) (bool, error) {
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return false, err
+	}
+	game, err := queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		return false, err
+	}
+	confirmed, err := queries.CountConfirmedParticipants(ctx, gameUUID)
+	if err != nil {
+		return false, err
+	}
+	waitlist, err := queries.CountWaitlistParticipants(ctx, gameUUID)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Printf("game %s: %d/%d confirmed, %d waitlisted, status %s\n", gameID, confirmed, game.MaxParticipants, waitlist, game.Status)
+
+	ok := true
+	if confirmed > int64(game.MaxParticipants) {
+		fmt.Println("  overbooked: more confirmed participants than spots")
+		ok = false
+	}
+	if waitlist > 0 && confirmed < int64(game.MaxParticipants) {
+		fmt.Println("  missed promotion: open spots with a non-empty waitlist")
+		ok = false
+	}
+	return ok, nil
+}
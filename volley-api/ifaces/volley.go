@@ -13,39 +13,247 @@ type IFaceTest interface {
 
 // Querier is the interface for database queries
 type Querier interface {
+	AddGameTags(ctx context.Context, arg repository.AddGameTagsParams) error
 	BatchUpdateParticipantsToConfirmed(ctx context.Context, participantIds []pgtype.UUID) error
 	BatchUpdateParticipantsToWaitlist(ctx context.Context, participantIds []pgtype.UUID) error
+	BlockPlayer(ctx context.Context, arg repository.BlockPlayerParams) error
 	CancelGame(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error)
+	CancelPendingEmailChangeRequests(ctx context.Context, userID pgtype.UUID) error
+	CheckInParticipant(ctx context.Context, id pgtype.UUID) (repository.Participant, error)
+	ClaimDueBrokerEvents(ctx context.Context, arg repository.ClaimDueBrokerEventsParams) ([]repository.BrokerEvent, error)
+	ClaimDueNotificationDeliveries(ctx context.Context, arg repository.ClaimDueNotificationDeliveriesParams) ([]repository.NotificationDelivery, error)
+	ClaimDueSideEffects(ctx context.Context, arg repository.ClaimDueSideEffectsParams) ([]repository.SideEffect, error)
+	ClaimDueWebhookDeliveries(ctx context.Context, arg repository.ClaimDueWebhookDeliveriesParams) ([]repository.ClaimDueWebhookDeliveriesRow, error)
+	ClaimJobRun(ctx context.Context, arg repository.ClaimJobRunParams) (int64, error)
+	ClearFailedLoginsByEmail(ctx context.Context, email string) error
+	CloseGamesPastSignupDeadline(ctx context.Context) (int64, error)
+	CompleteBrokerEvent(ctx context.Context, id pgtype.UUID) error
+	CompleteFinishedGames(ctx context.Context) (int64, error)
+	CompleteSideEffect(ctx context.Context, id pgtype.UUID) error
+	CompleteWebhookDelivery(ctx context.Context, id pgtype.UUID) error
+	ConsumeMagicLinkToken(ctx context.Context, tokenHash string) (repository.MagicLinkToken, error)
+	ConsumeWebAuthnChallenge(ctx context.Context, arg repository.ConsumeWebAuthnChallengeParams) (repository.WebauthnChallenge, error)
 	CountConfirmedParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error)
+	CountHeldReservations(ctx context.Context, gameID pgtype.UUID) (int64, error)
+	CountLoginCodesSince(ctx context.Context, arg repository.CountLoginCodesSinceParams) (int64, error)
+	CountOpenGamesByOwner(ctx context.Context, ownerID pgtype.UUID) (int64, error)
+	CountPhoneVerificationsSince(ctx context.Context, arg repository.CountPhoneVerificationsSinceParams) (int64, error)
+	CountReferrals(ctx context.Context, referrerID pgtype.UUID) (int64, error)
+	CountReportsByReporterSince(ctx context.Context, arg repository.CountReportsByReporterSinceParams) (int64, error)
 	CountWaitlistParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error)
+	CreateAdminAuditEntry(ctx context.Context, arg repository.CreateAdminAuditEntryParams) error
+	CreateConversation(ctx context.Context, arg repository.CreateConversationParams) (repository.Conversation, error)
+	CreateConversationMessage(ctx context.Context, arg repository.CreateConversationMessageParams) (repository.ConversationMessage, error)
+	CreateEmailChangeRequest(ctx context.Context, arg repository.CreateEmailChangeRequestParams) (repository.EmailChangeRequest, error)
 	CreateGame(ctx context.Context, arg repository.CreateGameParams) (repository.CreateGameRow, error)
+	CreateGameAnnouncement(ctx context.Context, arg repository.CreateGameAnnouncementParams) (repository.GameAnnouncement, error)
+	CreateGameChange(ctx context.Context, arg repository.CreateGameChangeParams) (repository.GameChange, error)
+	CreateGameChatMessage(ctx context.Context, arg repository.CreateGameChatMessageParams) (repository.GameChatMessage, error)
+	CreateGameComment(ctx context.Context, arg repository.CreateGameCommentParams) (repository.GameComment, error)
+	CreateGamePosition(ctx context.Context, arg repository.CreateGamePositionParams) error
+	CreateLegalAcceptance(ctx context.Context, arg repository.CreateLegalAcceptanceParams) error
+	CreateLoginCode(ctx context.Context, arg repository.CreateLoginCodeParams) (repository.LoginCode, error)
+	CreateMagicLinkToken(ctx context.Context, arg repository.CreateMagicLinkTokenParams) (repository.MagicLinkToken, error)
+	CreateNotificationDelivery(ctx context.Context, arg repository.CreateNotificationDeliveryParams) (pgtype.UUID, error)
 	CreateParticipant(ctx context.Context, arg repository.CreateParticipantParams) (repository.Participant, error)
+	CreateParticipationCorrection(ctx context.Context, arg repository.CreateParticipationCorrectionParams) (repository.ParticipationCorrection, error)
+	CreateParticipationJournalEntry(ctx context.Context, arg repository.CreateParticipationJournalEntryParams) error
+	CreatePhoneVerification(ctx context.Context, arg repository.CreatePhoneVerificationParams) (repository.PhoneVerification, error)
+	CreatePlaceholderParticipant(ctx context.Context, arg repository.CreatePlaceholderParticipantParams) (repository.Participant, error)
+	CreateReferral(ctx context.Context, arg repository.CreateReferralParams) error
+	CreateReferralCode(ctx context.Context, arg repository.CreateReferralCodeParams) (repository.ReferralCode, error)
 	CreateRefreshToken(ctx context.Context, arg repository.CreateRefreshTokenParams) (repository.RefreshToken, error)
+	CreateReport(ctx context.Context, arg repository.CreateReportParams) (repository.Report, error)
+	CreateSavedSearch(ctx context.Context, arg repository.CreateSavedSearchParams) (repository.SavedSearch, error)
 	CreateTeam(ctx context.Context, arg repository.CreateTeamParams) (repository.Team, error)
 	CreateUser(ctx context.Context, arg repository.CreateUserParams) (repository.User, error)
+	CreateWebAuthnChallenge(ctx context.Context, arg repository.CreateWebAuthnChallengeParams) error
+	CreateWebAuthnCredential(ctx context.Context, arg repository.CreateWebAuthnCredentialParams) (repository.WebauthnCredential, error)
+	CreateWebhookSubscription(ctx context.Context, arg repository.CreateWebhookSubscriptionParams) (repository.WebhookSubscription, error)
+	DeactivateUser(ctx context.Context, userID pgtype.UUID) (repository.UserDeactivation, error)
+	DeadLetterNotificationDelivery(ctx context.Context, arg repository.DeadLetterNotificationDeliveryParams) error
+	DeleteExpiredContactShareRequests(ctx context.Context) (int64, error)
+	DeleteExpiredWebAuthnChallenges(ctx context.Context) error
+	DeleteFailedLoginsBefore(ctx context.Context, attemptedAt pgtype.Timestamptz) (int64, error)
 	DeleteGame(ctx context.Context, id pgtype.UUID) error
+	DeleteGameComment(ctx context.Context, arg repository.DeleteGameCommentParams) error
+	DeleteGameReservation(ctx context.Context, arg repository.DeleteGameReservationParams) (int64, error)
+	DeleteGameTags(ctx context.Context, gameID pgtype.UUID) error
+	DeleteOldBrokerEvents(ctx context.Context) (int64, error)
+	DeleteOldDeadLetters(ctx context.Context) (int64, error)
+	DeleteOldNotificationDeliveries(ctx context.Context) (int64, error)
 	DeleteParticipant(ctx context.Context, id pgtype.UUID) error
+	DeleteParticipantsByGame(ctx context.Context, gameID pgtype.UUID) error
+	DeleteSavedSearch(ctx context.Context, arg repository.DeleteSavedSearchParams) (int64, error)
 	DeleteTeam(ctx context.Context, id pgtype.UUID) error
 	DeleteUser(ctx context.Context, id pgtype.UUID) error
+	DeleteWebhookSubscription(ctx context.Context, arg repository.DeleteWebhookSubscriptionParams) (int64, error)
+	EnqueueBrokerEvent(ctx context.Context, arg repository.EnqueueBrokerEventParams) error
+	EnqueueSideEffect(ctx context.Context, arg repository.EnqueueSideEffectParams) (repository.SideEffect, error)
+	EnqueueWebhookDeliveries(ctx context.Context, arg repository.EnqueueWebhookDeliveriesParams) (int64, error)
+	FailBrokerEvent(ctx context.Context, arg repository.FailBrokerEventParams) error
+	FailNotificationDelivery(ctx context.Context, arg repository.FailNotificationDeliveryParams) error
+	FailSideEffect(ctx context.Context, arg repository.FailSideEffectParams) error
+	FailWebhookDelivery(ctx context.Context, arg repository.FailWebhookDeliveryParams) error
+	FinishJobRun(ctx context.Context, arg repository.FinishJobRunParams) error
+	FlagGameContent(ctx context.Context, arg repository.FlagGameContentParams) error
+	GetAttendance(ctx context.Context, arg repository.GetAttendanceParams) (repository.Attendance, error)
+	GetContactShareRequest(ctx context.Context, gameID pgtype.UUID) (repository.ContactShareRequest, error)
+	GetConversation(ctx context.Context, id pgtype.UUID) (repository.Conversation, error)
+	GetCreditBalance(ctx context.Context, userID pgtype.UUID) (int32, error)
+	GetCreditBalanceForUpdate(ctx context.Context, userID pgtype.UUID) (int32, error)
+	GetDeadLetterForUpdate(ctx context.Context, id pgtype.UUID) (repository.DeadLetter, error)
 	GetGame(ctx context.Context, id pgtype.UUID) (repository.GetGameRow, error)
+	GetGameCancellationReason(ctx context.Context, gameID pgtype.UUID) (string, error)
+	GetGameComment(ctx context.Context, arg repository.GetGameCommentParams) (repository.GameComment, error)
+	GetGameCreditsSpent(ctx context.Context, arg repository.GetGameCreditsSpentParams) (int32, error)
 	GetGameForUpdate(ctx context.Context, id pgtype.UUID) (repository.GetGameForUpdateRow, error)
+	GetGameOwner(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error)
+	GetLatestMagicLinkToken(ctx context.Context, userID pgtype.UUID) (repository.MagicLinkToken, error)
+	GetLatestPendingEmailChangeRequest(ctx context.Context, userID pgtype.UUID) (repository.EmailChangeRequest, error)
+	GetLatestPendingLoginCode(ctx context.Context, userID pgtype.UUID) (repository.LoginCode, error)
+	GetLatestPendingPhoneVerification(ctx context.Context, userID pgtype.UUID) (repository.PhoneVerification, error)
+	GetNotificationPreferences(ctx context.Context, userID pgtype.UUID) (repository.UserNotificationPreference, error)
 	GetParticipant(ctx context.Context, id pgtype.UUID) (repository.Participant, error)
 	GetParticipantByGameAndUser(ctx context.Context, arg repository.GetParticipantByGameAndUserParams) (repository.Participant, error)
+	GetParticipationReceipt(ctx context.Context, arg repository.GetParticipationReceiptParams) (repository.GetParticipationReceiptRow, error)
+	GetPendingEmailChangeRequestByTokenHash(ctx context.Context, tokenHash string) (repository.EmailChangeRequest, error)
+	GetReferralCodeByCode(ctx context.Context, code string) (repository.ReferralCode, error)
+	GetReferralCodeByUser(ctx context.Context, userID pgtype.UUID) (repository.ReferralCode, error)
 	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (repository.RefreshToken, error)
+	GetRosterSnapshot(ctx context.Context, gameID pgtype.UUID) (repository.RosterSnapshot, error)
+	GetSMSNumber(ctx context.Context, id pgtype.UUID) (pgtype.Text, error)
 	GetTeam(ctx context.Context, id pgtype.UUID) (repository.Team, error)
 	GetUserByEmail(ctx context.Context, email string) (repository.User, error)
 	GetUserByID(ctx context.Context, id pgtype.UUID) (repository.User, error)
+	GetUserByVerifiedPhone(ctx context.Context, phoneNumber pgtype.Text) (repository.User, error)
+	GetUserFavoriteCategory(ctx context.Context, userID pgtype.UUID) (string, error)
+	GetUserPlayStats(ctx context.Context, userID pgtype.UUID) (repository.GetUserPlayStatsRow, error)
+	GetUserSessionState(ctx context.Context, id pgtype.UUID) (repository.GetUserSessionStateRow, error)
+	GetUserSettings(ctx context.Context, userID pgtype.UUID) (repository.UserSetting, error)
+	GetWebAuthnCredential(ctx context.Context, credentialID []byte) (repository.WebauthnCredential, error)
+	GrantContactShareConsent(ctx context.Context, arg repository.GrantContactShareConsentParams) error
+	HasActiveReservation(ctx context.Context, arg repository.HasActiveReservationParams) (bool, error)
+	IncrementLoginCodeAttempts(ctx context.Context, id pgtype.UUID) (int32, error)
+	IncrementPhoneVerificationAttempts(ctx context.Context, id pgtype.UUID) (int32, error)
+	IncrementUserTokenVersion(ctx context.Context, id pgtype.UUID) (int32, error)
+	IsOrganizerOfParticipant(ctx context.Context, arg repository.IsOrganizerOfParticipantParams) (bool, error)
+	IsPlayerBlockedByHost(ctx context.Context, arg repository.IsPlayerBlockedByHostParams) (bool, error)
+	IsUserDeactivated(ctx context.Context, userID pgtype.UUID) (bool, error)
+	IsUserShadowBanned(ctx context.Context, userID pgtype.UUID) (bool, error)
+	LiftShadowBan(ctx context.Context, userID pgtype.UUID) (int64, error)
+	LinkPlaceholderParticipant(ctx context.Context, arg repository.LinkPlaceholderParticipantParams) (repository.Participant, error)
+	ListAdminAudit(ctx context.Context, arg repository.ListAdminAuditParams) ([]repository.AdminAudit, error)
+	ListAttendanceSummaries(ctx context.Context, arg repository.ListAttendanceSummariesParams) ([]repository.ListAttendanceSummariesRow, error)
+	ListConversationMessages(ctx context.Context, arg repository.ListConversationMessagesParams) ([]repository.ConversationMessage, error)
+	ListConversations(ctx context.Context, arg repository.ListConversationsParams) ([]repository.ListConversationsRow, error)
+	ListCreditTransactions(ctx context.Context, arg repository.ListCreditTransactionsParams) ([]repository.CreditTransaction, error)
+	ListDeadLetters(ctx context.Context, arg repository.ListDeadLettersParams) ([]repository.DeadLetter, error)
+	ListGameAnnouncements(ctx context.Context, gameID pgtype.UUID) ([]repository.GameAnnouncement, error)
+	ListGameChangesByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.GameChange, error)
+	ListGameChatMessages(ctx context.Context, arg repository.ListGameChatMessagesParams) ([]repository.ListGameChatMessagesRow, error)
+	ListGameComments(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameCommentsRow, error)
+	ListGameCreditSpenders(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameCreditSpendersRow, error)
+	ListGameNotificationSettings(ctx context.Context, gameID pgtype.UUID) ([]repository.GameNotificationSetting, error)
+	ListGamePositions(ctx context.Context, gameID pgtype.UUID) ([]repository.GamePosition, error)
+	ListGameReservations(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameReservationsRow, error)
+	ListGameTags(ctx context.Context, gameID pgtype.UUID) ([]string, error)
 	ListGamesInRadius(ctx context.Context, arg repository.ListGamesInRadiusParams) ([]repository.ListGamesInRadiusRow, error)
+	ListGamesWithExpiredReservations(ctx context.Context) ([]pgtype.UUID, error)
+	ListLeaderboard(ctx context.Context, arg repository.ListLeaderboardParams) ([]repository.ListLeaderboardRow, error)
+	ListLegalAcceptancesByUser(ctx context.Context, userID pgtype.UUID) ([]repository.ListLegalAcceptancesByUserRow, error)
 	ListActiveParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.ListActiveParticipantsByGameRow, error)
+	ListBlockedPlayers(ctx context.Context, hostID pgtype.UUID) ([]repository.ListBlockedPlayersRow, error)
+	ListCurrentLegalDocuments(ctx context.Context) ([]repository.LegalDocument, error)
+	ListNewGameAlertRecipients(ctx context.Context, gameID pgtype.UUID) ([]repository.ListNewGameAlertRecipientsRow, error)
+	ListOwnerUpcomingGames(ctx context.Context, ownerID pgtype.UUID) ([]repository.ListOwnerUpcomingGamesRow, error)
 	ListParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.ListParticipantsByGameRow, error)
+	ListParticipantsByGamePage(ctx context.Context, arg repository.ListParticipantsByGamePageParams) ([]repository.ListParticipantsByGamePageRow, error)
+	ListParticipantsByGames(ctx context.Context, gameIds []pgtype.UUID) ([]repository.ListParticipantsByGamesRow, error)
 	ListParticipantsByUser(ctx context.Context, userID pgtype.UUID) ([]repository.Participant, error)
+	ListParticipationCorrections(ctx context.Context, gameID pgtype.UUID) ([]repository.ParticipationCorrection, error)
+	ListParticipationJournalByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.ParticipationJournal, error)
+	ListPendingLegalDocuments(ctx context.Context, userID pgtype.UUID) ([]repository.LegalDocument, error)
+	ListPlayerReliability(ctx context.Context, userIds []pgtype.UUID) ([]repository.PlayerReliability, error)
+	ListRecentFailedLoginsByEmail(ctx context.Context, arg repository.ListRecentFailedLoginsByEmailParams) ([]pgtype.Timestamptz, error)
+	ListRecentFailedLoginsByIP(ctx context.Context, arg repository.ListRecentFailedLoginsByIPParams) ([]pgtype.Timestamptz, error)
+	ListRecentGameCreationsByOwner(ctx context.Context, arg repository.ListRecentGameCreationsByOwnerParams) ([]pgtype.Timestamptz, error)
+	ListRecentReferrals(ctx context.Context, arg repository.ListRecentReferralsParams) ([]repository.ListRecentReferralsRow, error)
+	ListReports(ctx context.Context, arg repository.ListReportsParams) ([]repository.Report, error)
+	ListRosterSnapshotEntries(ctx context.Context, gameID pgtype.UUID) ([]repository.ListRosterSnapshotEntriesRow, error)
+	ListSavedSearches(ctx context.Context, userID pgtype.UUID) ([]repository.SavedSearch, error)
+	ListSharedContacts(ctx context.Context, gameID pgtype.UUID) ([]repository.ListSharedContactsRow, error)
+	ListSkillEndorsementCounts(ctx context.Context, userIds []pgtype.UUID) ([]repository.ListSkillEndorsementCountsRow, error)
 	ListTeamsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.Team, error)
+	ListUpcomingGamesInRadius(ctx context.Context, arg repository.ListUpcomingGamesInRadiusParams) ([]repository.ListUpcomingGamesInRadiusRow, error)
+	ListUserNotificationDeliveries(ctx context.Context, arg repository.ListUserNotificationDeliveriesParams) ([]repository.ListUserNotificationDeliveriesRow, error)
+	ListUserOverlappingGames(ctx context.Context, arg repository.ListUserOverlappingGamesParams) ([]repository.ListUserOverlappingGamesRow, error)
+	ListUserParticipationHistory(ctx context.Context, arg repository.ListUserParticipationHistoryParams) ([]repository.ListUserParticipationHistoryRow, error)
+	ListUserPlayedCategories(ctx context.Context, userID pgtype.UUID) ([]string, error)
+	ListUserSportPreferences(ctx context.Context, userID pgtype.UUID) ([]repository.UserSportPreference, error)
+	ListUserUpcomingParticipations(ctx context.Context, userID pgtype.UUID) ([]repository.ListUserUpcomingParticipationsRow, error)
+	ListWebAuthnCredentialsByUser(ctx context.Context, userID pgtype.UUID) ([]repository.WebauthnCredential, error)
+	ListWebhookSubscriptions(ctx context.Context, ownerID pgtype.UUID) ([]repository.WebhookSubscription, error)
+	MarkConversationRead(ctx context.Context, arg repository.MarkConversationReadParams) error
+	MarkDeadLetterRequeued(ctx context.Context, arg repository.MarkDeadLetterRequeuedParams) (repository.DeadLetter, error)
+	MarkEmailChangeRequestConfirmed(ctx context.Context, id pgtype.UUID) error
+	MarkLoginCodeUsed(ctx context.Context, id pgtype.UUID) error
+	MarkNotificationDeliverySent(ctx context.Context, id pgtype.UUID) error
+	MarkParticipantDropped(ctx context.Context, arg repository.MarkParticipantDroppedParams) (repository.Participant, error)
+	MarkPhoneVerificationVerified(ctx context.Context, id pgtype.UUID) error
+	MoveParticipantsToBackOfLine(ctx context.Context, arg repository.MoveParticipantsToBackOfLineParams) error
+	MoveParticipantsToFrontOfLine(ctx context.Context, arg repository.MoveParticipantsToFrontOfLineParams) error
+	PlayedCompletedGame(ctx context.Context, arg repository.PlayedCompletedGameParams) (bool, error)
+	PruneLeaderboardCells(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error)
+	ReactivateUser(ctx context.Context, userID pgtype.UUID) (int64, error)
+	RecordCreditTransaction(ctx context.Context, arg repository.RecordCreditTransactionParams) (repository.CreditTransaction, error)
+	RecordFailedLogin(ctx context.Context, arg repository.RecordFailedLoginParams) error
+	RecordNewGameAlert(ctx context.Context, arg repository.RecordNewGameAlertParams) (int64, error)
+	RefreshLeaderboardCells(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error)
+	RefreshPlayerReliability(ctx context.Context, userID pgtype.UUID) (int64, error)
+	ReleaseExpiredReservations(ctx context.Context, gameID pgtype.UUID) (int64, error)
+	ReorderWaitlist(ctx context.Context, arg repository.ReorderWaitlistParams) error
+	ReplaceUserSportPreferences(ctx context.Context, arg repository.ReplaceUserSportPreferencesParams) error
+	RequestContactSharing(ctx context.Context, arg repository.RequestContactSharingParams) (repository.ContactShareRequest, error)
+	RequeueNotificationDelivery(ctx context.Context, arg repository.RequeueNotificationDeliveryParams) error
+	RequeueSideEffect(ctx context.Context, arg repository.RequeueSideEffectParams) (int64, error)
+	RequeueWebhookDelivery(ctx context.Context, arg repository.RequeueWebhookDeliveryParams) (int64, error)
+	RescheduleBrokerEvent(ctx context.Context, arg repository.RescheduleBrokerEventParams) error
+	RescheduleNotificationDelivery(ctx context.Context, arg repository.RescheduleNotificationDeliveryParams) error
+	RescheduleSideEffect(ctx context.Context, arg repository.RescheduleSideEffectParams) error
+	RescheduleWebhookDelivery(ctx context.Context, arg repository.RescheduleWebhookDeliveryParams) error
 	RevokeAllUserRefreshTokens(ctx context.Context, userID pgtype.UUID) error
+	RevokeContactShareConsent(ctx context.Context, arg repository.RevokeContactShareConsentParams) (int64, error)
 	RevokeRefreshToken(ctx context.Context, tokenHash string) error
+	SaveGameCancellationReason(ctx context.Context, arg repository.SaveGameCancellationReasonParams) error
+	SaveUserOnboarding(ctx context.Context, arg repository.SaveUserOnboardingParams) (repository.UserSetting, error)
+	SetSavedSearchAlerts(ctx context.Context, arg repository.SetSavedSearchAlertsParams) (repository.SavedSearch, error)
+	SetUserVerifiedPhone(ctx context.Context, arg repository.SetUserVerifiedPhoneParams) (repository.User, error)
+	ShadowBanUser(ctx context.Context, arg repository.ShadowBanUserParams) (repository.UserShadowBan, error)
+	StartGamesPastStartTime(ctx context.Context) (int64, error)
+	SuspendUser(ctx context.Context, arg repository.SuspendUserParams) (repository.UserSuspension, error)
+	TouchConversation(ctx context.Context, arg repository.TouchConversationParams) error
+	UnassignTeamParticipants(ctx context.Context, teamID pgtype.UUID) (int64, error)
+	UnblockPlayer(ctx context.Context, arg repository.UnblockPlayerParams) (int64, error)
+	UnsuspendUser(ctx context.Context, userID pgtype.UUID) (int64, error)
 	UpdateGame(ctx context.Context, arg repository.UpdateGameParams) (pgtype.UUID, error)
+	UpdateGameCommentBody(ctx context.Context, arg repository.UpdateGameCommentBodyParams) (repository.GameComment, error)
+	UpdateGameStatus(ctx context.Context, arg repository.UpdateGameStatusParams) error
 	UpdateParticipantPayment(ctx context.Context, arg repository.UpdateParticipantPaymentParams) (repository.Participant, error)
 	UpdateParticipantStatus(ctx context.Context, arg repository.UpdateParticipantStatusParams) (repository.Participant, error)
 	UpdateParticipantStatusResetJoinedAt(ctx context.Context, arg repository.UpdateParticipantStatusResetJoinedAtParams) (repository.Participant, error)
 	UpdateParticipantTeam(ctx context.Context, arg repository.UpdateParticipantTeamParams) (repository.Participant, error)
+	UpdateReportStatus(ctx context.Context, arg repository.UpdateReportStatusParams) (repository.Report, error)
+	UpdateTeam(ctx context.Context, arg repository.UpdateTeamParams) (repository.Team, error)
 	UpdateUser(ctx context.Context, arg repository.UpdateUserParams) (repository.User, error)
+	UpdateUserPassword(ctx context.Context, arg repository.UpdateUserPasswordParams) (repository.User, error)
+	UpdateWebAuthnCredentialUsage(ctx context.Context, arg repository.UpdateWebAuthnCredentialUsageParams) error
+	UpsertAttendance(ctx context.Context, arg repository.UpsertAttendanceParams) (repository.Attendance, error)
+	UpsertGameMVP(ctx context.Context, arg repository.UpsertGameMVPParams) (repository.GameMvp, error)
+	UpsertGameNotificationSettings(ctx context.Context, arg repository.UpsertGameNotificationSettingsParams) (repository.GameNotificationSetting, error)
+	UpsertGameReservation(ctx context.Context, arg repository.UpsertGameReservationParams) (repository.GameReservation, error)
+	UpsertNotificationPreferences(ctx context.Context, arg repository.UpsertNotificationPreferencesParams) (repository.UserNotificationPreference, error)
+	UpsertSkillEndorsement(ctx context.Context, arg repository.UpsertSkillEndorsementParams) (repository.SkillEndorsement, error)
+	UpsertUserSettings(ctx context.Context, arg repository.UpsertUserSettingsParams) (repository.UserSetting, error)
+	UserHasRole(ctx context.Context, arg repository.UserHasRoleParams) (bool, error)
 }
@@ -0,0 +1,288 @@
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// exportFlushEvery is how many rows are buffered before an export chunk is flushed to the client
+const exportFlushEvery = 500
+
+// ExportGames handles GET /admin/exports/games
+// Query parameters: format (csv or ndjson, default csv), createdFrom and createdTo (RFC 3339).
+// The response is streamed in chunks as rows are read, so it has no Content-Length.
+func (h *Handler) ExportGames(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	format := models.ExportFormat(c.DefaultQuery("format", string(models.ExportFormatCSV)))
+	if format != models.ExportFormatCSV && format != models.ExportFormatNDJSON {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format (must be: csv or ndjson)"})
+		return
+	}
+
+	var filters service.ExportGamesFilters
+	for _, param := range []struct {
+		name   string
+		target **time.Time
+	}{
+		{"createdFrom", &filters.CreatedFrom},
+		{"createdTo", &filters.CreatedTo},
+	} {
+		if value := c.Query(param.name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + param.name + " (must be RFC 3339)"})
+				return
+			}
+			*param.target = &parsed
+		}
+	}
+
+	logger = logger.With().Str("format", string(format)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	var w gameExportWriter
+	switch format {
+	case models.ExportFormatCSV:
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="games.csv"`)
+		w = &csvGameExportWriter{w: csv.NewWriter(c.Writer)}
+	case models.ExportFormatNDJSON:
+		c.Header("Content-Type", "application/x-ndjson")
+		c.Header("Content-Disposition", `attachment; filename="games.ndjson"`)
+		w = &ndjsonGameExportWriter{enc: json.NewEncoder(c.Writer)}
+	}
+
+	rows := 0
+	err := h.gamesService.ExportGames(ctx, filters, func(g models.GameExport) error {
+		if err := w.Write(g); err != nil {
+			return err
+		}
+		rows++
+		if rows%exportFlushEvery == 0 {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			c.Writer.Flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			logger.Warn().Int("rows", rows).Msg("Export cancelled by client")
+			return
+		}
+		logger.Error().Err(err).Int("rows", rows).Msg("Failed to export games")
+		if !c.Writer.Written() {
+			// Nothing streamed yet, so replace the export headers with a normal JSON error
+			c.Header("Content-Type", "")
+			c.Header("Content-Disposition", "")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export games"})
+		}
+		// Otherwise the status is already sent and the body just ends early
+		return
+	}
+
+	c.Writer.Flush()
+	logger.Info().Int("rows", rows).Msg("Games exported")
+}
+
+// ListParticipationJournal handles GET /admin/games/:gameId/journal
+// The response body is the input file for cmd/replay.
+func (h *Handler) ListParticipationJournal(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	entries, err := h.gamesService.ListParticipationJournal(ctx, gameID)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to list participation journal")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve participation journal"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListParticipationJournalResponse{Entries: entries})
+}
+
+// gameExportWriter encodes export rows onto the response body. Nothing is written until the
+// first row or Flush, so a query that fails up front can still return a JSON error.
+type gameExportWriter interface {
+	Write(g models.GameExport) error
+	Flush() error
+}
+
+type csvGameExportWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (cw *csvGameExportWriter) writeHeader() error {
+	if cw.headerWritten {
+		return nil
+	}
+	cw.headerWritten = true
+	return cw.w.Write(models.GameExportCSVHeader)
+}
+
+func (cw *csvGameExportWriter) Write(g models.GameExport) error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+	return cw.w.Write(g.CSVRecord())
+}
+
+// Flush writes buffered rows; an empty export still gets its header row
+func (cw *csvGameExportWriter) Flush() error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+type ndjsonGameExportWriter struct {
+	enc *json.Encoder
+}
+
+func (nw *ndjsonGameExportWriter) Write(g models.GameExport) error {
+	return nw.enc.Encode(g)
+}
+
+func (nw *ndjsonGameExportWriter) Flush() error {
+	return nil
+}
+
+// GetSLOStatus handles GET /admin/slo
+// It reports each SLO target's error budget burn rate over the last 5 minutes and hour, for
+// external alerting to scrape. Counts are per instance and reset on restart.
+func (h *Handler) GetSLOStatus(c *gin.Context) {
+	if h.slo == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "SLO tracking is not enabled"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"targets": h.slo.Summary(time.Now())})
+}
+
+// ListNotificationDeliveries handles GET /admin/users/:userId/notification-deliveries
+func (h *Handler) ListNotificationDeliveries(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	userID := c.Param("userId")
+
+	logger = logger.With().Str("adminId", adminID).Str("recipientId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	deliveries, err := h.userService.ListNotificationDeliveries(ctx, userID)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to list notification deliveries")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list notification deliveries"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListNotificationDeliveriesResponse{Deliveries: deliveries})
+}
+
+// ListDeadLetters handles GET /admin/dead-letters
+// Query parameters: source (side_effect, webhook_delivery or notification_delivery) and
+// includeRequeued (default false).
+func (h *Handler) ListDeadLetters(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var filters service.ListDeadLettersFilters
+	if sourceStr := c.Query("source"); sourceStr != "" {
+		source := models.DeadLetterSource(sourceStr)
+		switch source {
+		case models.DeadLetterSideEffect, models.DeadLetterWebhookDelivery, models.DeadLetterNotificationDelivery:
+			filters.Source = &source
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid source (must be: side_effect, webhook_delivery, or notification_delivery)"})
+			return
+		}
+	}
+	if includeStr := c.Query("includeRequeued"); includeStr != "" {
+		include, err := strconv.ParseBool(includeStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid includeRequeued (must be true or false)"})
+			return
+		}
+		filters.IncludeRequeued = include
+	}
+
+	letters, err := h.gamesService.ListDeadLetters(ctx, filters)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list dead letters")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list dead letters"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListDeadLettersResponse{DeadLetters: letters})
+}
+
+// RequeueDeadLetter handles POST /admin/dead-letters/:deadLetterId/requeue
+func (h *Handler) RequeueDeadLetter(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	deadLetterID := c.Param("deadLetterId")
+
+	logger = logger.With().Str("adminId", adminID).Str("deadLetterId", deadLetterID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	letter, err := h.gamesService.RequeueDeadLetter(ctx, adminID, deadLetterID)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Dead letter not found"})
+		case errors.Is(err, service.ErrDeadLetterRequeued):
+			c.JSON(http.StatusConflict, gin.H{"error": "Dead letter has already been requeued"})
+		case errors.Is(err, service.ErrDeadLetterTargetGone):
+			c.JSON(http.StatusConflict, gin.H{"error": "The game or webhook subscription this work was for no longer exists"})
+		case errors.Is(err, service.ErrDeadLetterNotRequeueable):
+			c.JSON(http.StatusConflict, gin.H{"error": "This message wasn't kept, so it can't be sent again"})
+		default:
+			logger.Error().Err(err).Msg("Failed to requeue dead letter")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to requeue dead letter"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, letter)
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// PostAnnouncement handles POST /games/:gameId/announcements
+func (h *Handler) PostAnnouncement(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.CreateAnnouncementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	announcement, err := h.gamesService.PostAnnouncement(ctx, gameID, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		var contentErr *service.ContentRejectedError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.As(err, &contentErr):
+			logger.Warn().Msg("Announcement rejected by content filter")
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "The announcement's text isn't allowed"})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+		case errors.Is(err, service.ErrNotOwner):
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only the game's organizers can post announcements"})
+		case errors.Is(err, service.ErrGameNotEditable):
+			c.JSON(http.StatusConflict, gin.H{"error": "Cancelled or completed games cannot get announcements"})
+		case errors.Is(err, service.ErrTooManyAnnouncements):
+			c.JSON(http.StatusConflict, gin.H{"error": "This game has reached its announcement limit"})
+		default:
+			logger.Error().Err(err).Msg("Failed to post announcement")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to post announcement"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, announcement)
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// AppVersionHeader carries the mobile app's version (e.g. 2.4.1)
+const AppVersionHeader = "X-App-Version"
+
+// ParseAppVersion parses a dotted numeric version such as "2.4" or "2.4.1". A pre-release or
+// build suffix ("2.4.1-beta", "2.4.1+57") is ignored.
+func ParseAppVersion(raw string) ([]int, error) {
+	raw = strings.TrimPrefix(strings.TrimSpace(raw), "v")
+	if i := strings.IndexAny(raw, "-+ "); i >= 0 {
+		raw = raw[:i]
+	}
+	parts := strings.Split(raw, ".")
+	version := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid app version %q", raw)
+		}
+		version[i] = n
+	}
+	return version, nil
+}
+
+// compareAppVersions returns -1, 0 or 1 as a is older than, the same as, or newer than b.
+// Missing components count as zero, so 2.4 == 2.4.0.
+func compareAppVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// AppVersionMiddleware rejects requests from apps older than the policy's minimum version with
+// 426 Upgrade Required. Requests without a parseable X-App-Version (web, scripts) are let through,
+// as is GET /v1/metadata so outdated apps can still read the policy.
+func AppVersionMiddleware(policy models.AppVersionPolicy) (gin.HandlerFunc, error) {
+	minimum, err := ParseAppVersion(policy.MinimumVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(c *gin.Context) {
+		if c.FullPath() == "/v1/metadata" {
+			c.Next()
+			return
+		}
+		version, err := ParseAppVersion(c.GetHeader(AppVersionHeader))
+		if err != nil || compareAppVersions(version, minimum) >= 0 {
+			c.Next()
+			return
+		}
+
+		logger := LoggerFromContext(c)
+		logger.Warn().Str("appVersion", c.GetHeader(AppVersionHeader)).Msg("Rejected outdated app version")
+		c.AbortWithStatusJSON(http.StatusUpgradeRequired, gin.H{
+			"error":          "This version of the app is no longer supported. Please update to continue.",
+			"minimumVersion": policy.MinimumVersion,
+			"upgradeUrl":     policy.UpgradeURL,
+		})
+	}, nil
+}
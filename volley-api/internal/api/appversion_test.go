@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAppVersion(t *testing.T) {
+	version, err := ParseAppVersion("v2.4.1-beta+57")
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 4, 1}, version)
+
+	for _, raw := range []string{"", "two", "2..1", "2.-1"} {
+		_, err := ParseAppVersion(raw)
+		assert.Error(t, err, raw)
+	}
+
+	assert.Equal(t, 0, compareAppVersions([]int{2, 4}, []int{2, 4, 0}))
+	assert.Equal(t, -1, compareAppVersions([]int{2, 3, 9}, []int{2, 4}))
+	assert.Equal(t, 1, compareAppVersions([]int{10}, []int{9, 9, 9}))
+}
+
+func TestAppVersionMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	_, err := AppVersionMiddleware(models.AppVersionPolicy{MinimumVersion: "latest"})
+	require.Error(t, err)
+
+	middleware, err := AppVersionMiddleware(models.AppVersionPolicy{MinimumVersion: "2.4.0", UpgradeURL: "https://example.com/app"})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(middleware)
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/v1/games", ok)
+	router.GET("/v1/metadata", ok)
+
+	cases := []struct {
+		path    string
+		version string
+		status  int
+	}{
+		{"/v1/games", "2.4.0", http.StatusOK},
+		{"/v1/games", "3.0", http.StatusOK},
+		{"/v1/games", "", http.StatusOK},
+		{"/v1/games", "2.3.9", http.StatusUpgradeRequired},
+		{"/v1/metadata", "1.0.0", http.StatusOK},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.version != "" {
+			req.Header.Set(AppVersionHeader, tc.version)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tc.status, w.Code, "%s %s", tc.path, tc.version)
+		if tc.status == http.StatusUpgradeRequired {
+			assert.Contains(t, w.Body.String(), `"upgradeUrl":"https://example.com/app"`)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// AuthPolicy is the authorization an endpoint requires. Every route declares one in the route
+// table (see Routes) and RegisterRoutes enforces it before the handler runs, so handlers of
+// authenticated routes can rely on the user ID being present.
+type AuthPolicy string
+
+const (
+	// AuthPublic endpoints need no token
+	AuthPublic AuthPolicy = "public"
+	// AuthOptional endpoints accept a token to personalize the response but work without one
+	AuthOptional AuthPolicy = "optional"
+	// AuthUser endpoints require a valid token
+	AuthUser AuthPolicy = "user"
+	// AuthGameOwner endpoints require the user to own the game in the :gameId path parameter
+	AuthGameOwner AuthPolicy = "game_owner"
+	// AuthCoOrganizer endpoints require the user to organize the game in :gameId. There are no
+	// co-organizers yet, so only the owner passes.
+	AuthCoOrganizer AuthPolicy = "co_organizer"
+	// AuthAdmin endpoints require the admin role
+	AuthAdmin AuthPolicy = "admin"
+)
+
+// Route is one endpoint of the API together with its authorization policy
+type Route struct {
+	Method string
+	Path   string
+	Auth   AuthPolicy
+	// LegalAcceptance blocks users who haven't accepted the current legal documents
+	LegalAcceptance bool
+	// AnyRegion serves a non-GET request in the region that received it, for endpoints that
+	// write no user data. Other authenticated writes go to the user's home region.
+	AnyRegion bool
+	Handler   gin.HandlerFunc
+}
+
+// RouteInfo describes a route's policy for GET /admin/routes
+type RouteInfo struct {
+	Method          string     `json:"method"`
+	Path            string     `json:"path"`
+	Auth            AuthPolicy `json:"auth"`
+	LegalAcceptance bool       `json:"legalAcceptance"`
+}
+
+// authMiddlewares returns the middleware chain enforcing a policy
+func (h *Handler) authMiddlewares(policy AuthPolicy) []gin.HandlerFunc {
+	switch policy {
+	case AuthOptional:
+		return []gin.HandlerFunc{OptionalAuthMiddleware(), h.SessionMiddleware()}
+	case AuthUser:
+		return []gin.HandlerFunc{AuthMiddleware(), h.SessionMiddleware()}
+	case AuthGameOwner, AuthCoOrganizer:
+		return []gin.HandlerFunc{AuthMiddleware(), h.SessionMiddleware(), h.GameOwnerMiddleware()}
+	case AuthAdmin:
+		return []gin.HandlerFunc{AuthMiddleware(), h.SessionMiddleware(), h.AdminMiddleware()}
+	}
+	return nil
+}
+
+// GameOwnerMiddleware rejects users who don't own the game in the :gameId path parameter with 403.
+// Must run after AuthMiddleware.
+func (h *Handler) GameOwnerMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger := LoggerFromContext(c)
+		ctx := logger.WithContext(c.Request.Context())
+
+		ownerID, err := h.gamesService.GameOwnerID(ctx, c.Param("gameId"))
+		if err != nil {
+			var invalidArgErr *service.InvalidArgumentError
+			switch {
+			case errors.As(err, &invalidArgErr):
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			case errors.Is(err, apperrors.ErrNotFound):
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			default:
+				logger.Error().Err(err).Msg("Failed to get game owner")
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to check permissions"})
+			}
+			return
+		}
+
+		if ownerID != authenticatedUserID(c) {
+			logger.Warn().Str("gameId", c.Param("gameId")).Msg("Game owner access denied")
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Only the game owner can perform this action"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// authenticatedUserID returns the user ID set by AuthMiddleware. Only for routes whose policy
+// requires a user; the route table guarantees the middleware ran.
+func authenticatedUserID(c *gin.Context) string {
+	return c.GetString("userID")
+}
+
+// ListRoutes handles GET /admin/routes
+// It lists every route with its authorization policy so the policy can be audited in one place.
+func (h *Handler) ListRoutes(c *gin.Context) {
+	routes := h.Routes()
+	infos := make([]RouteInfo, 0, len(routes))
+	for _, route := range routes {
+		infos = append(infos, RouteInfo{
+			Method:          route.Method,
+			Path:            route.Path,
+			Auth:            route.Auth,
+			LegalAcceptance: route.LegalAcceptance,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"routes": infos})
+}
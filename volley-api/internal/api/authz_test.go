@@ -0,0 +1,128 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gabe-dev-svc/volley/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRoutesDeclareAuthPolicy(t *testing.T) {
+	policies := map[AuthPolicy]bool{
+		AuthPublic: true, AuthOptional: true, AuthUser: true,
+		AuthGameOwner: true, AuthCoOrganizer: true, AuthAdmin: true,
+	}
+	seen := map[string]bool{}
+	for _, route := range (&Handler{}).Routes() {
+		key := route.Method + " " + route.Path
+		assert.False(t, seen[key], "duplicate route %s", key)
+		seen[key] = true
+
+		assert.True(t, policies[route.Auth], "%s has unknown auth policy %q", key, route.Auth)
+		assert.NotNil(t, route.Handler, key)
+		if route.Auth == AuthGameOwner || route.Auth == AuthCoOrganizer {
+			assert.Contains(t, route.Path, ":gameId", "%s needs a game to check ownership of", key)
+		}
+		if route.LegalAcceptance {
+			assert.NotContains(t, []AuthPolicy{AuthPublic, AuthOptional}, route.Auth, "%s needs a user to check legal acceptance for", key)
+		}
+	}
+}
+
+func TestRegisterRoutesRequiresToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	(&Handler{}).RegisterRoutes(router)
+
+	for _, route := range (&Handler{}).Routes() {
+		if route.Auth == AuthPublic || route.Auth == AuthOptional {
+			continue
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(route.Method, route.Path, nil))
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "%s %s", route.Method, route.Path)
+	}
+}
+
+func TestGameOwnerMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	gameID := "550e8400-e29b-41d4-a716-446655440001"
+	ownerID := "550e8400-e29b-41d4-a716-446655440002"
+
+	cases := []struct {
+		name     string
+		userID   string
+		ownerErr error
+		status   int
+	}{
+		{"Owner passes", ownerID, nil, http.StatusOK},
+		{"Other users are forbidden", "550e8400-e29b-41d4-a716-446655440003", nil, http.StatusForbidden},
+		{"Unknown game", ownerID, pgx.ErrNoRows, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockQuerier := mocks.NewQuerier(t)
+			mockQuerier.EXPECT().GetGameOwner(mock.Anything, pgtype.UUID{Bytes: uuid.MustParse(gameID), Valid: true}).
+				Return(pgtype.UUID{Bytes: uuid.MustParse(ownerID), Valid: true}, tc.ownerErr)
+			h := &Handler{gamesService: service.NewGamesService(mockQuerier, nil)}
+
+			router := gin.New()
+			router.POST("/v1/games/:gameId/cancel", func(c *gin.Context) {
+				c.Set("userID", tc.userID)
+			}, h.GameOwnerMiddleware(), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/games/"+gameID+"/cancel", nil))
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestSessionMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	userID := "550e8400-e29b-41d4-a716-446655440002"
+
+	cases := []struct {
+		name         string
+		tokenVersion int32
+		suspended    bool
+		deactivated  bool
+		status       int
+	}{
+		{"Current token passes", 2, false, false, http.StatusOK},
+		{"Token issued before a password change is rejected", 1, false, false, http.StatusUnauthorized},
+		{"Suspended account is forbidden", 2, true, false, http.StatusForbidden},
+		{"Deactivated account is forbidden", 2, false, true, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockQuerier := mocks.NewQuerier(t)
+			mockQuerier.EXPECT().GetUserSessionState(mock.Anything, pgtype.UUID{Bytes: uuid.MustParse(userID), Valid: true}).
+				Return(repository.GetUserSessionStateRow{TokenVersion: 2, Suspended: tc.suspended, Deactivated: tc.deactivated}, nil)
+			h := &Handler{userService: service.NewUserService(mockQuerier, nil, nil)}
+
+			router := gin.New()
+			router.GET("/v1/users/me", func(c *gin.Context) {
+				c.Set("userID", userID)
+				c.Set("tokenVersion", tc.tokenVersion)
+			}, h.SessionMiddleware(), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/users/me", nil))
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// BulkUpdateParticipants handles POST /games/:gameId/participants/status
+func (h *Handler) BulkUpdateParticipants(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.BulkUpdateParticipantsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.BulkUpdateParticipants(ctx, gameID, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, apperrors.ErrNotFound):
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+		case errors.Is(err, service.ErrNotOwner):
+			logger.Warn().Err(err).Msg("User is not the game owner")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can update participants"})
+		case errors.Is(err, service.ErrGameNotEditable):
+			c.JSON(http.StatusConflict, gin.H{"error": "Cancelled or completed games cannot be edited"})
+		default:
+			logger.Error().Err(err).Msg("Failed to update participants")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update participants"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
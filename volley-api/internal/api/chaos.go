@@ -0,0 +1,125 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ChaosFault is the kind of failure a chaos rule injects
+type ChaosFault string
+
+const (
+	ChaosFaultLatency ChaosFault = "latency" // Delay the request, then handle it normally
+	ChaosFaultError   ChaosFault = "error"   // Respond with an error status without running the handler
+	ChaosFaultDrop    ChaosFault = "drop"    // Close the connection without responding
+)
+
+// ChaosRule injects a fault into a percentage of matching requests.
+// Path is a gin route template (e.g. /v1/games/:gameId/participation), a prefix ending in "*",
+// or "*" for every route. An empty Method matches any method.
+type ChaosRule struct {
+	Method      string     `json:"method,omitempty"`
+	Path        string     `json:"path"`
+	Fault       ChaosFault `json:"fault"`
+	Probability float64    `json:"probability"`       // 0.0 - 1.0
+	Latency     string     `json:"latency,omitempty"` // Go duration for latency faults, e.g. "2s"
+	Status      int        `json:"status,omitempty"`  // Status for error faults (default 503)
+	latency     time.Duration
+}
+
+// ParseChaosRules parses and validates the JSON rule list from VOLLEY_CHAOS_RULES
+func ParseChaosRules(raw string) ([]ChaosRule, error) {
+	var rules []ChaosRule
+	if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+		return nil, fmt.Errorf("invalid chaos rules: %w", err)
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Path == "" {
+			return nil, fmt.Errorf("chaos rule %d: path is required", i)
+		}
+		if rule.Probability <= 0 || rule.Probability > 1 {
+			return nil, fmt.Errorf("chaos rule %d: probability must be in (0, 1]", i)
+		}
+		rule.Method = strings.ToUpper(rule.Method)
+
+		switch rule.Fault {
+		case ChaosFaultLatency:
+			latency, err := time.ParseDuration(rule.Latency)
+			if err != nil || latency <= 0 {
+				return nil, fmt.Errorf("chaos rule %d: latency must be a positive duration", i)
+			}
+			rule.latency = latency
+		case ChaosFaultError:
+			if rule.Status == 0 {
+				rule.Status = http.StatusServiceUnavailable
+			}
+			if rule.Status < 400 || rule.Status > 599 {
+				return nil, fmt.Errorf("chaos rule %d: status must be a 4xx or 5xx code", i)
+			}
+		case ChaosFaultDrop:
+		default:
+			return nil, fmt.Errorf("chaos rule %d: unknown fault %q (must be: latency, error, or drop)", i, rule.Fault)
+		}
+	}
+	return rules, nil
+}
+
+func (r ChaosRule) matches(method, routePath string) bool {
+	if r.Method != "" && r.Method != method {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(r.Path, "*"); ok {
+		return strings.HasPrefix(routePath, prefix)
+	}
+	return r.Path == routePath
+}
+
+// ChaosMiddleware injects latency, errors or dropped connections according to rules so client
+// retry and backoff behavior can be exercised against realistic failures. It is only installed
+// outside release mode. Each matching rule rolls independently, in order; an error or drop ends
+// the request.
+func ChaosMiddleware(rules []ChaosRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger := LoggerFromContext(c)
+		routePath := c.FullPath()
+
+		for _, rule := range rules {
+			if !rule.matches(c.Request.Method, routePath) || rand.Float64() >= rule.Probability {
+				continue
+			}
+
+			logger.Warn().Str("fault", string(rule.Fault)).Str("route", routePath).Msg("Chaos fault injected")
+			switch rule.Fault {
+			case ChaosFaultLatency:
+				select {
+				case <-time.After(rule.latency):
+				case <-c.Request.Context().Done():
+					c.Abort()
+					return
+				}
+			case ChaosFaultError:
+				c.AbortWithStatusJSON(rule.Status, gin.H{"error": "Injected fault"})
+				return
+			case ChaosFaultDrop:
+				c.Abort()
+				if conn, _, err := c.Writer.Hijack(); err == nil {
+					conn.Close()
+					return
+				}
+				// Hijacking is unsupported (e.g. HTTP/2); fall back to an empty 502 instead
+				c.Status(http.StatusBadGateway)
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
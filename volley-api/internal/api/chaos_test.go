@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChaosRules(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		rules, err := ParseChaosRules(`[
+			{"method": "post", "path": "/v1/games/:gameId/participation", "fault": "error", "probability": 0.5},
+			{"path": "*", "fault": "latency", "latency": "1500ms", "probability": 1}
+		]`)
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		assert.Equal(t, "POST", rules[0].Method)
+		assert.Equal(t, http.StatusServiceUnavailable, rules[0].Status)
+		assert.Equal(t, "1.5s", rules[1].latency.String())
+	})
+
+	invalid := map[string]string{
+		"missing path":        `[{"fault": "drop", "probability": 1}]`,
+		"probability too big": `[{"path": "*", "fault": "drop", "probability": 2}]`,
+		"unknown fault":       `[{"path": "*", "fault": "explode", "probability": 1}]`,
+		"bad latency":         `[{"path": "*", "fault": "latency", "latency": "soon", "probability": 1}]`,
+		"non-error status":    `[{"path": "*", "fault": "error", "status": 200, "probability": 1}]`,
+		"not JSON":            `latency everywhere`,
+	}
+	for name, raw := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseChaosRules(raw)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestChaosMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rules, err := ParseChaosRules(`[{"method": "GET", "path": "/v1/games*", "fault": "error", "status": 500, "probability": 1}]`)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(ChaosMiddleware(rules))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/v1/games/:gameId", ok)
+	router.POST("/v1/games", ok)
+	router.GET("/v1/legal/documents", ok)
+
+	for _, tc := range []struct {
+		method, path string
+		expected     int
+	}{
+		{"GET", "/v1/games/123", http.StatusInternalServerError},
+		{"POST", "/v1/games", http.StatusOK},
+		{"GET", "/v1/legal/documents", http.StatusOK},
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(tc.method, tc.path, nil))
+		assert.Equal(t, tc.expected, w.Code, "%s %s", tc.method, tc.path)
+	}
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/realtime"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// maxChatFrameBytes caps a frame read from a chat client, well above the longest message
+	maxChatFrameBytes = 8 << 10
+	// chatWriteTimeout is how long a frame may take to reach a client before it is disconnected
+	chatWriteTimeout = 10 * time.Second
+	// chatMembershipCheckInterval is how often an open chat connection checks the user can still
+	// use the chat, so players who drop out stop receiving it
+	chatMembershipCheckInterval = time.Minute
+)
+
+// ListChatMessages handles GET /games/:gameId/chat
+func (h *Handler) ListChatMessages(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var before *time.Time
+	if value := c.Query("before"); value != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid before (must be RFC 3339)"})
+			return
+		}
+		before = &parsed
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	messages, err := h.gamesService.ListChatMessages(ctx, gameID, userID, before)
+	if err != nil {
+		writeChatError(c, logger, err, "Failed to list chat messages")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListChatMessagesResponse{Messages: messages})
+}
+
+// GameChat handles GET /games/:gameId/chat/ws. The connection is upgraded to a WebSocket that
+// receives every message sent in the game's chat as a models.ChatFrame and takes the user's
+// messages as models.SendChatMessageRequest. A message that can't be sent is answered with an
+// error frame and the connection stays open.
+func (h *Handler) GameChat(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	parsed, err := uuid.Parse(c.Param("gameId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid game ID format"})
+		return
+	}
+	gameID := parsed.String()
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if h.realtime == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Chat is not available"})
+		return
+	}
+	if err := h.gamesService.AuthorizeChat(ctx, gameID, userID); err != nil {
+		writeChatError(c, logger, err, "Failed to open chat")
+		return
+	}
+
+	server := websocket.Server{
+		// Clients authenticate with a bearer token rather than cookies, so another site can't open
+		// a chat as the user and any origin (or none, from the mobile apps) is accepted
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+			ws.MaxPayloadBytes = maxChatFrameBytes
+
+			sub := h.realtime.Join(realtime.GameChatRoom(gameID))
+			defer sub.Close()
+			logger.Info().Msg("Chat connected")
+
+			// Room messages and membership checks are handled while the loop below reads the
+			// user's messages; closing the connection ends both
+			go func() {
+				defer ws.Close()
+				ticker := time.NewTicker(chatMembershipCheckInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case frame, ok := <-sub.Messages():
+						if !ok {
+							return
+						}
+						if err := sendChatFrame(ws, frame); err != nil {
+							return
+						}
+					case <-ticker.C:
+						if err := h.gamesService.AuthorizeChat(ctx, gameID, userID); err != nil {
+							logger.Info().Err(err).Msg("Chat closed - user can no longer use it")
+							return
+						}
+					}
+				}
+			}()
+
+			for {
+				var req models.SendChatMessageRequest
+				err := websocket.JSON.Receive(ws, &req)
+				var syntaxErr *json.SyntaxError
+				var typeErr *json.UnmarshalTypeError
+				if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) {
+					writeChatFrame(ws, models.ChatFrame{Type: models.ChatFrameError, Error: "Invalid message format"})
+					continue
+				}
+				if err != nil {
+					break
+				}
+
+				if _, err := h.gamesService.PostChatMessage(ctx, gameID, userID, req.Body); err != nil {
+					writeChatFrame(ws, models.ChatFrame{Type: models.ChatFrameError, Error: chatErrorMessage(logger, err)})
+				}
+			}
+			logger.Info().Msg("Chat disconnected")
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// writeChatFrame sends the server's own frame, such as an error, to one client
+func writeChatFrame(ws *websocket.Conn, frame models.ChatFrame) {
+	encoded, err := json.Marshal(frame)
+	if err != nil {
+		return
+	}
+	_ = sendChatFrame(ws, encoded)
+}
+
+func sendChatFrame(ws *websocket.Conn, frame []byte) error {
+	if err := ws.SetWriteDeadline(time.Now().Add(chatWriteTimeout)); err != nil {
+		return err
+	}
+	return websocket.Message.Send(ws, string(frame))
+}
+
+// chatErrorMessage is what a chat client is told when its message wasn't sent
+func chatErrorMessage(logger zerolog.Logger, err error) string {
+	var invalidArgErr *service.InvalidArgumentError
+	var contentErr *service.ContentRejectedError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		return invalidArgErr.Error()
+	case errors.As(err, &contentErr):
+		logger.Warn().Msg("Chat message rejected by content filter")
+		return "The message's text isn't allowed"
+	case errors.Is(err, service.ErrNotChatMember), errors.Is(err, apperrors.ErrNotFound):
+		return "You can no longer send messages in this chat"
+	default:
+		logger.Error().Err(err).Msg("Failed to send chat message")
+		return "Failed to send message"
+	}
+}
+
+// writeChatError maps game chat service errors to responses
+func writeChatError(c *gin.Context, logger zerolog.Logger, err error, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.Is(err, apperrors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+	case errors.Is(err, service.ErrNotChatMember):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the host and confirmed or waitlisted players can use the game's chat"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
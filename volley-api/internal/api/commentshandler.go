@@ -0,0 +1,129 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// ListComments handles GET /games/:gameId/comments
+func (h *Handler) ListComments(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", authenticatedUserID(c)).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	comments, err := h.gamesService.ListComments(ctx, gameID)
+	if err != nil {
+		writeCommentError(c, logger, err, "Game not found", "Failed to list comments")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListCommentsResponse{Comments: comments})
+}
+
+// PostComment handles POST /games/:gameId/comments
+func (h *Handler) PostComment(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	comment, err := h.gamesService.PostComment(ctx, gameID, userID, req)
+	if err != nil {
+		writeCommentError(c, logger, err, "Game not found", "Failed to post comment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+// EditComment handles PATCH /games/:gameId/comments/:commentId
+func (h *Handler) EditComment(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	commentID := c.Param("commentId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("commentId", commentID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	comment, err := h.gamesService.EditComment(ctx, gameID, commentID, userID, req)
+	if err != nil {
+		writeCommentError(c, logger, err, "Comment not found", "Failed to edit comment")
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+// DeleteComment handles DELETE /games/:gameId/comments/:commentId
+func (h *Handler) DeleteComment(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	gameID := c.Param("gameId")
+	commentID := c.Param("commentId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("commentId", commentID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.gamesService.DeleteComment(ctx, gameID, commentID, userID); err != nil {
+		writeCommentError(c, logger, err, "Comment not found", "Failed to delete comment")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// writeCommentError maps game comment service errors to responses
+func writeCommentError(c *gin.Context, logger zerolog.Logger, err error, notFound string, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	var contentErr *service.ContentRejectedError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.As(err, &contentErr):
+		logger.Warn().Msg("Comment rejected by content filter")
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "The comment's text isn't allowed"})
+	case errors.Is(err, apperrors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": notFound})
+	case errors.Is(err, service.ErrNotCommentAuthor):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the comment's author can edit it"})
+	case errors.Is(err, service.ErrCannotDeleteComment):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the comment's author or the game's host can delete it"})
+	case errors.Is(err, service.ErrBlockedByHost):
+		logger.Warn().Err(err).Msg("Blocked player attempted to comment")
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can't comment on games hosted by this organizer"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// RequestContactSharing handles POST /games/:gameId/contact-sharing
+func (h *Handler) RequestContactSharing(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	sharing, err := h.gamesService.RequestContactSharing(ctx, gameID, userID)
+	if err != nil {
+		writeContactSharingError(c, logger, err, "Failed to request contact sharing")
+		return
+	}
+
+	c.JSON(http.StatusOK, sharing)
+}
+
+// ListSharedContacts handles GET /games/:gameId/contact-sharing
+func (h *Handler) ListSharedContacts(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	sharing, err := h.gamesService.ListSharedContacts(ctx, gameID, userID)
+	if err != nil {
+		writeContactSharingError(c, logger, err, "Failed to list shared contacts")
+		return
+	}
+
+	c.JSON(http.StatusOK, sharing)
+}
+
+// ConsentToContactSharing handles PUT /games/:gameId/contact-sharing/consent
+func (h *Handler) ConsentToContactSharing(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.gamesService.ConsentToContactSharing(ctx, gameID, userID); err != nil {
+		writeContactSharingError(c, logger, err, "Failed to share contact")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeContactSharingConsent handles DELETE /games/:gameId/contact-sharing/consent
+func (h *Handler) RevokeContactSharingConsent(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.gamesService.RevokeContactSharingConsent(ctx, gameID, userID); err != nil {
+		writeContactSharingError(c, logger, err, "Failed to stop sharing contact")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// writeContactSharingError maps contact sharing service errors to responses
+func writeContactSharingError(c *gin.Context, logger zerolog.Logger, err error, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.Is(err, apperrors.ErrNotFound):
+		logger.Warn().Err(err).Msg("Game not found")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+	case errors.Is(err, service.ErrNotOwner):
+		logger.Warn().Err(err).Msg("User is not the game owner")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can see shared contacts"})
+	case errors.Is(err, service.ErrContactSharingNotRequested):
+		c.JSON(http.StatusNotFound, gin.H{"error": "The organizer has not asked participants to share contacts"})
+	case errors.Is(err, service.ErrContactSharingExpired):
+		c.JSON(http.StatusGone, gin.H{"error": "Contact sharing ended with the game"})
+	case errors.Is(err, service.ErrNotParticipant):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only confirmed participants can share their contact"})
+	case errors.Is(err, service.ErrPhoneNotVerified):
+		c.JSON(http.StatusConflict, gin.H{"error": "Verify a phone number before sharing it"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
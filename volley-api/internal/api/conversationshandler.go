@@ -0,0 +1,133 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// StartConversation handles POST /conversations
+func (h *Handler) StartConversation(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.StartConversationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Str("gameId", req.GameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	conversation, err := h.gamesService.StartConversation(ctx, userID, req)
+	if err != nil {
+		writeConversationError(c, logger, err, "Game not found", "Failed to start conversation")
+		return
+	}
+
+	c.JSON(http.StatusCreated, conversation)
+}
+
+// ListConversations handles GET /conversations
+func (h *Handler) ListConversations(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	conversations, err := h.gamesService.ListConversations(ctx, userID)
+	if err != nil {
+		writeConversationError(c, logger, err, "Conversation not found", "Failed to list conversations")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListConversationsResponse{Conversations: conversations})
+}
+
+// ListMessages handles GET /conversations/:conversationId/messages
+func (h *Handler) ListMessages(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var before *time.Time
+	if value := c.Query("before"); value != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid before (must be RFC 3339)"})
+			return
+		}
+		before = &parsed
+	}
+
+	conversationID := c.Param("conversationId")
+	logger = logger.With().Str("userId", userID).Str("conversationId", conversationID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	messages, err := h.gamesService.ListMessages(ctx, userID, conversationID, before)
+	if err != nil {
+		writeConversationError(c, logger, err, "Conversation not found", "Failed to list messages")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListMessagesResponse{Messages: messages})
+}
+
+// SendMessage handles POST /conversations/:conversationId/messages
+func (h *Handler) SendMessage(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.SendMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	conversationID := c.Param("conversationId")
+	logger = logger.With().Str("userId", userID).Str("conversationId", conversationID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	message, err := h.gamesService.SendMessage(ctx, userID, conversationID, req)
+	if err != nil {
+		writeConversationError(c, logger, err, "Conversation not found", "Failed to send message")
+		return
+	}
+
+	c.JSON(http.StatusCreated, message)
+}
+
+// writeConversationError maps direct message service errors to responses
+func writeConversationError(c *gin.Context, logger zerolog.Logger, err error, notFound string, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.Is(err, apperrors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": notFound})
+	case errors.Is(err, service.ErrNotSharingGame):
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can only message the host or confirmed and waitlisted players of a game"})
+	case errors.Is(err, service.ErrBlockedByHost):
+		logger.Warn().Err(err).Msg("Message between host and blocked player refused")
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can't message this user"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// CorrectParticipation handles POST /games/:gameId/participants/:userId/corrections
+func (h *Handler) CorrectParticipation(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.CorrectParticipationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (reason is required and attendance must be: attended or no_show)"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	participantID := c.Param("userId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("participantId", participantID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	corrections, err := h.gamesService.CorrectParticipation(ctx, gameID, userID, participantID, req)
+	if err != nil {
+		writeCorrectionError(c, logger, err, "Failed to correct participation")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListCorrectionsResponse{Corrections: corrections})
+}
+
+// ListCorrections handles GET /games/:gameId/corrections
+func (h *Handler) ListCorrections(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	corrections, err := h.gamesService.ListCorrections(ctx, gameID, userID)
+	if err != nil {
+		writeCorrectionError(c, logger, err, "Failed to list corrections")
+		return
+	}
+
+	c.JSON(http.StatusOK, corrections)
+}
+
+// writeCorrectionError maps correction service errors to responses
+func writeCorrectionError(c *gin.Context, logger zerolog.Logger, err error, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.Is(err, apperrors.ErrNotFound):
+		logger.Warn().Err(err).Msg("Game not found")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+	case errors.Is(err, service.ErrNotOwner):
+		logger.Warn().Err(err).Msg("User is not the game owner")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can correct participant records"})
+	case errors.Is(err, service.ErrNotParticipant):
+		c.JSON(http.StatusNotFound, gin.H{"error": "User is not a participant (attendance needs a confirmed one)"})
+	case errors.Is(err, service.ErrAttendanceNotOpen):
+		c.JSON(http.StatusConflict, gin.H{"error": "Attendance can be corrected once the game has started"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// EndorseSkill handles PUT /games/:gameId/participants/:userId/endorsement
+func (h *Handler) EndorseSkill(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.EndorseSkillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (skillLevel must be: beginner, intermediate or advanced)"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	endorseeID := c.Param("userId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("endorseeId", endorseeID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	endorsement, err := h.gamesService.EndorseSkill(ctx, gameID, userID, endorseeID, req.SkillLevel)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrCannotEndorseSelf) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "You can't endorse yourself"})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrNotParticipant) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only players of this game can endorse other players"})
+			return
+		}
+		if errors.Is(err, service.ErrEndorseeNotPlayer) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User did not play in this game"})
+			return
+		}
+		if errors.Is(err, service.ErrEndorsementNotOpen) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Players can be endorsed once the game is completed"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to endorse skill")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save endorsement"})
+		return
+	}
+
+	c.JSON(http.StatusOK, endorsement)
+}
@@ -1,14 +1,19 @@
 package api
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
 	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/places"
+	"github.com/gabe-dev-svc/volley/internal/realtime"
 	"github.com/gabe-dev-svc/volley/internal/service"
 	"github.com/gabe-dev-svc/volley/internal/util"
 	"github.com/gin-gonic/gin"
@@ -16,26 +21,43 @@ import (
 )
 
 type Handler struct {
-	gamesService    *service.GamesService
-	userService     *service.UserService
-	googlePlacesKey string
+	gamesService *service.GamesService
+	userService  *service.UserService
+	statsService *service.StatsService
+	places       places.Client
+	appVersion   *models.AppVersionPolicy // Minimum app version, exposed in metadata (nil when not enforced)
+	slo          *SLOTracker
+	region       *RegionConfig // Cross-region write routing (nil for a single-region deployment)
+	realtime     *realtime.Hub // Serves live connections: game chats and event streams (nil turns them off)
 }
 
-func NewHandler(gamesService *service.GamesService, userService *service.UserService, googlePlacesKey string) *Handler {
+func NewHandler(gamesService *service.GamesService, userService *service.UserService, statsService *service.StatsService, placesClient places.Client) *Handler {
 	return &Handler{
-		gamesService:    gamesService,
-		userService:     userService,
-		googlePlacesKey: googlePlacesKey,
+		gamesService: gamesService,
+		userService:  userService,
+		statsService: statsService,
+		places:       placesClient,
 	}
 }
 
-// getUserID extracts and validates the authenticated user ID from the Gin context
-func getUserID(c *gin.Context) (string, error) {
-	userID := c.GetString("userID")
-	if userID == "" {
-		return "", fmt.Errorf("user ID not found in context")
-	}
-	return userID, nil
+// SetAppVersionPolicy exposes the enforced minimum app version in GET /metadata
+func (h *Handler) SetAppVersionPolicy(policy models.AppVersionPolicy) {
+	h.appVersion = &policy
+}
+
+// SetRegionConfig enables routing of cross-region writes to the user's home region
+func (h *Handler) SetRegionConfig(config RegionConfig) {
+	h.region = &config
+}
+
+// SetSLOTracker exposes the tracker's burn rates in GET /admin/slo
+func (h *Handler) SetSLOTracker(tracker *SLOTracker) {
+	h.slo = tracker
+}
+
+// SetRealtimeHub serves live connections, game chats and event streams, from the hub
+func (h *Handler) SetRealtimeHub(hub *realtime.Hub) {
+	h.realtime = hub
 }
 
 // ListGames handles GET /games
@@ -43,41 +65,32 @@ func (h *Handler) ListGames(c *gin.Context) {
 	logger := LoggerFromContext(c)
 	ctx := logger.WithContext(c.Request.Context())
 
-	// Parse query parameters
+	// Parse query parameters. Signed-in users without categories get their sport preferences.
 	categories := c.QueryArray("categories")
-	if len(categories) == 0 {
+	if len(categories) == 0 && authenticatedUserID(c) == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "At least one sport category is required"})
 		return
 	}
 
-	latitude := c.Query("latitude")
-	if latitude == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "latitude is required"})
-		return
-	}
-
-	longitude := c.Query("longitude")
-	if longitude == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "longitude is required"})
-		return
-	}
-
-	// Parse latitude and longitude
-	var lat, lng float64
-	if _, err := fmt.Sscanf(latitude, "%f", &lat); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid latitude"})
-		return
-	}
-	if _, err := fmt.Sscanf(longitude, "%f", &lng); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid longitude"})
+	format := models.ListGamesFormat(c.DefaultQuery("format", string(models.ListGamesFormatJSON)))
+	if format != models.ListGamesFormatJSON && format != models.ListGamesFormatGeoJSON {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format (must be: json or geojson)"})
 		return
 	}
 
-	// Parse optional parameters
-	var radius float64 = 16093.4 // Default 10 miles in meters
-	if radiusStr := c.Query("radius"); radiusStr != "" {
-		if _, err := fmt.Sscanf(radiusStr, "%f", &radius); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid radius"})
+	// A map viewport replaces the search circle
+	var lat, lng, radius float64
+	var bounds *service.BoundingBox
+	if boundsStr := c.Query("bounds"); boundsStr != "" {
+		var box service.BoundingBox
+		if _, err := fmt.Sscanf(boundsStr, "%f,%f,%f,%f", &box.MinLatitude, &box.MinLongitude, &box.MaxLatitude, &box.MaxLongitude); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid bounds (must be minLat,minLng,maxLat,maxLng)"})
+			return
+		}
+		bounds = &box
+	} else {
+		var ok bool
+		if lat, lng, radius, ok = h.searchCircle(ctx, c); !ok {
 			return
 		}
 	}
@@ -98,6 +111,76 @@ func (h *Handler) ListGames(c *gin.Context) {
 		status = &statusStr
 	}
 
+	var skillLevels []models.SkillLevel
+	for _, level := range c.QueryArray("skillLevels") {
+		skillLevels = append(skillLevels, models.SkillLevel(level))
+	}
+
+	var freeOnly bool
+	if freeOnlyStr := c.Query("freeOnly"); freeOnlyStr != "" {
+		var err error
+		if freeOnly, err = strconv.ParseBool(freeOnlyStr); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid freeOnly"})
+			return
+		}
+	}
+
+	var maxPrice *int
+	if maxPriceStr := c.Query("maxPricePerPersonCents"); maxPriceStr != "" {
+		var cents int
+		if _, err := fmt.Sscanf(maxPriceStr, "%d", &cents); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid maxPricePerPersonCents"})
+			return
+		}
+		maxPrice = &cents
+	}
+
+	var daysOfWeek []time.Weekday
+	for _, value := range c.QueryArray("daysOfWeek") {
+		for _, name := range strings.Split(value, ",") {
+			day, ok := weekdaysByName[strings.ToLower(strings.TrimSpace(name))]
+			if !ok {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid daysOfWeek (must be: mon, tue, wed, thu, fri, sat, or sun)"})
+				return
+			}
+			daysOfWeek = append(daysOfWeek, day)
+		}
+	}
+
+	var tags []string
+	for _, value := range c.QueryArray("tags") {
+		tags = append(tags, strings.Split(value, ",")...)
+	}
+	var matchAnyTag bool
+	switch c.DefaultQuery("tagMatch", "all") {
+	case "all":
+	case "any":
+		matchAnyTag = true
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tagMatch (must be: all or any)"})
+		return
+	}
+
+	// Tabs like "Hosting" list the signed-in user's own games; the service checks the value
+	var relationship *service.GameRelationship
+	if relationshipStr := c.Query("relationship"); relationshipStr != "" {
+		if authenticatedUserID(c) == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Sign in to filter games by relationship"})
+			return
+		}
+		r := service.GameRelationship(relationshipStr)
+		relationship = &r
+	}
+
+	startsAfter, ok := parseTimeOfDay(c, "startsAfter")
+	if !ok {
+		return
+	}
+	startsBefore, ok := parseTimeOfDay(c, "startsBefore")
+	if !ok {
+		return
+	}
+
 	var limit int = 20 // Default
 	if limitStr := c.Query("limit"); limitStr != "" {
 		if _, err := fmt.Sscanf(limitStr, "%d", &limit); err != nil {
@@ -123,28 +206,121 @@ func (h *Handler) ListGames(c *gin.Context) {
 	}
 
 	// Call service
-	games, err := h.gamesService.ListGames(ctx, service.ListGamesFilters{
-		Categories: categories,
-		Latitude:   lat,
-		Longitude:  lng,
-		Radius:     radius,
-		TimeFilter: timeFilter,
-		Status:     status,
-		Limit:      limit,
-		Offset:     offset,
+	response, err := h.gamesService.ListGames(ctx, service.ListGamesFilters{
+		Categories:             categories,
+		Latitude:               lat,
+		Longitude:              lng,
+		Radius:                 radius,
+		Bounds:                 bounds,
+		TimeFilter:             timeFilter,
+		Status:                 status,
+		SkillLevels:            skillLevels,
+		FreeOnly:               freeOnly,
+		MaxPricePerPersonCents: maxPrice,
+		DaysOfWeek:             daysOfWeek,
+		StartsAfter:            startsAfter,
+		StartsBefore:           startsBefore,
+		Timezone:               c.Query("timezone"),
+		Tags:                   tags,
+		MatchAnyTag:            matchAnyTag,
+		Relationship:           relationship,
+		Limit:                  limit,
+		Offset:                 offset,
 	}, userID)
 	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
 		logger.Error().Err(err).Msg("Failed to list games")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	// Ensure we return an empty array instead of null
-	if games == nil {
-		games = []models.GameSummary{}
+	if format == models.ListGamesFormatGeoJSON {
+		c.Header("Content-Type", "application/geo+json")
+		c.JSON(http.StatusOK, response.GeoJSON())
+		return
 	}
+	c.JSON(http.StatusOK, response)
+}
+
+// searchCircle reads the point and radius ListGames searches around. Signed-in users fall back to
+// their saved home location and default radius. It responds with an error and returns false when
+// they are missing or malformed.
+func (h *Handler) searchCircle(ctx context.Context, c *gin.Context) (lat float64, lng float64, radius float64, ok bool) {
+	latitude, longitude, radiusStr := c.Query("latitude"), c.Query("longitude"), c.Query("radius")
+	var settings *models.UserSettings
+	if latitude == "" || longitude == "" || radiusStr == "" {
+		var err error
+		if settings, err = h.savedSettings(ctx, c); err != nil {
+			logger := LoggerFromContext(c)
+			logger.Error().Err(err).Msg("Failed to load saved settings")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load saved settings"})
+			return 0, 0, 0, false
+		}
+	}
+
+	if latitude == "" && longitude == "" && settings != nil && settings.HomeLocation != nil {
+		lat, lng = settings.HomeLocation.Latitude, settings.HomeLocation.Longitude
+	} else {
+		if latitude == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "latitude is required"})
+			return 0, 0, 0, false
+		}
+		if longitude == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "longitude is required"})
+			return 0, 0, 0, false
+		}
+		if _, err := fmt.Sscanf(latitude, "%f", &lat); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid latitude"})
+			return 0, 0, 0, false
+		}
+		if _, err := fmt.Sscanf(longitude, "%f", &lng); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid longitude"})
+			return 0, 0, 0, false
+		}
+	}
+
+	radius = 16093.4 // Default 10 miles in meters
+	if settings != nil && settings.DefaultRadius != nil {
+		radius = *settings.DefaultRadius
+	}
+	if radiusStr != "" {
+		if _, err := fmt.Sscanf(radiusStr, "%f", &radius); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid radius"})
+			return 0, 0, 0, false
+		}
+	}
+	return lat, lng, radius, true
+}
 
-	c.JSON(http.StatusOK, models.ListGamesResponse{Games: games})
+// weekdaysByName maps the daysOfWeek values accepted by ListGames to days
+var weekdaysByName = map[string]time.Weekday{
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+	"sun": time.Sunday,
+}
+
+// parseTimeOfDay reads an optional HH:MM query parameter as an offset from midnight, responding
+// with 400 and returning false when it is malformed
+func parseTimeOfDay(c *gin.Context, name string) (*time.Duration, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, true
+	}
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s (must be HH:MM)", name)})
+		return nil, false
+	}
+	offset := time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute
+	return &offset, true
 }
 
 // CreateGame handles POST /games
@@ -157,12 +333,7 @@ func (h *Handler) CreateGame(c *gin.Context) {
 	ctx := logger.WithContext(c.Request.Context())
 
 	// Extract userID from auth middleware context
-	userIDStr, err := getUserID(c)
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to extract user ID from context")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
-		return
-	}
+	userIDStr := authenticatedUserID(c)
 
 	var req models.CreateGameRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -178,6 +349,25 @@ func (h *Handler) CreateGame(c *gin.Context) {
 
 	game, err := h.gamesService.CreateGame(ctx, userIDStr, req)
 	if err != nil {
+		var contentErr *service.ContentRejectedError
+		if errors.As(err, &contentErr) {
+			logger.Warn().Strs("fields", contentErr.Fields).Msg("Game text rejected by content filter")
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error":  "Some of the game's text isn't allowed",
+				"fields": contentErr.Fields,
+			})
+			return
+		}
+		var limitErr *service.GameCreationLimitError
+		if errors.As(err, &limitErr) {
+			if limitErr.MaxOpenGames > 0 {
+				c.JSON(http.StatusTooManyRequests, gin.H{"error": fmt.Sprintf("You can have at most %d upcoming games at a time", limitErr.MaxOpenGames)})
+				return
+			}
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(limitErr.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "You've created too many games recently, please try again later"})
+			return
+		}
 		logger.Error().Err(err).Str("userID", userIDStr).Msg("Failed to create game")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create game"})
 		return
@@ -196,16 +386,18 @@ func (h *Handler) GetGame(c *gin.Context) {
 	logger := LoggerFromContext(c)
 	ctx := logger.WithContext(c.Request.Context())
 
+	userID := authenticatedUserID(c)
+
 	gameID := c.Param("gameId")
 	if gameID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
 		return
 	}
 
-	logger = logger.With().Str("gameId", gameID).Logger()
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
 	ctx = logger.WithContext(ctx)
 
-	game, err := h.gamesService.GetGame(ctx, gameID)
+	game, err := h.gamesService.GetGame(ctx, gameID, userID)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrNotFound) {
 			logger.Warn().Err(err).Msg("Game not found")
@@ -222,7 +414,211 @@ func (h *Handler) GetGame(c *gin.Context) {
 
 // UpdateGame handles PATCH /games/:gameId
 func (h *Handler) UpdateGame(c *gin.Context) {
-	// TODO: Implement
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	if gameID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
+		return
+	}
+
+	var req models.UpdateGameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.UpdateGame(ctx, gameID, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrNotOwner) {
+			logger.Warn().Err(err).Msg("User is not the game owner")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can update the game"})
+			return
+		}
+		if errors.Is(err, service.ErrGameNotEditable) {
+			logger.Warn().Err(err).Msg("Game can no longer be edited")
+			c.JSON(http.StatusConflict, gin.H{"error": "Cancelled or completed games cannot be edited"})
+			return
+		}
+		var contentErr *service.ContentRejectedError
+		if errors.As(err, &contentErr) {
+			logger.Warn().Strs("fields", contentErr.Fields).Msg("Game text rejected by content filter")
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error":  "Some of the game's text isn't allowed",
+				"fields": contentErr.Fields,
+			})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to update game")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update game"})
+		return
+	}
+
+	logger.Info().Msg("Game updated successfully")
+	c.JSON(http.StatusOK, game)
+}
+
+// ListGameChanges handles GET /games/:gameId/changes
+func (h *Handler) ListGameChanges(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	if gameID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	changes, err := h.gamesService.ListGameChanges(ctx, gameID, userID)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrNotParticipant) {
+			logger.Warn().Err(err).Msg("User is not a participant")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only participants can view the change history"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to list game changes")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve game changes"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListGameChangesResponse{Changes: changes})
+}
+
+// ListParticipants handles GET /games/:gameId/participants
+func (h *Handler) ListParticipants(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	if gameID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
+		return
+	}
+
+	filters := service.ListParticipantsFilters{
+		Sort: service.ParticipantSort(c.Query("sort")),
+	}
+	if statusStr := c.Query("status"); statusStr != "" {
+		status := models.ParticipantStatus(statusStr)
+		filters.Status = &status
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if _, err := fmt.Sscanf(limitStr, "%d", &filters.Limit); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if _, err := fmt.Sscanf(offsetStr, "%d", &filters.Offset); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset"})
+			return
+		}
+	}
+
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	page, err := h.gamesService.ListParticipants(ctx, gameID, userID, filters)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to list participants")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve participants"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetRosterSnapshot handles GET /games/:gameId/roster-snapshot
+func (h *Handler) GetRosterSnapshot(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	if gameID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	snapshot, err := h.gamesService.GetRosterSnapshot(ctx, gameID, userID)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrSnapshotNotTaken) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "The roster snapshot is taken when the game starts"})
+			return
+		}
+		if errors.Is(err, service.ErrNotParticipant) {
+			logger.Warn().Err(err).Msg("User is not a participant")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only participants can view the roster snapshot"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to get roster snapshot")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve roster snapshot"})
+		return
+	}
+
+	c.JSON(http.StatusOK, snapshot)
 }
 
 // DeleteGame handles DELETE /games/:gameId
@@ -235,90 +631,346 @@ func (h *Handler) JoinGame(c *gin.Context) {
 	logger := LoggerFromContext(c)
 	ctx := logger.WithContext(c.Request.Context())
 
-	userID, err := getUserID(c)
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	if gameID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
+		return
+	}
+
+	// The body is optional; games with positions need one naming the position
+	var req models.JoinGameRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			logger.Error().Err(err).Msg("Invalid request body")
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+			return
+		}
+	}
+
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	participants, err := h.gamesService.JoinGame(ctx, gameID, userID, req)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to extract user ID from context")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		var conflictErr *service.ScheduleConflictError
+		if errors.As(err, &conflictErr) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":     "You're already confirmed for a game at the same time",
+				"conflicts": conflictErr.Conflicts,
+			})
+			return
+		}
+		if errors.Is(err, service.ErrAgeRestricted) {
+			logger.Warn().Err(err).Msg("Minor attempted to join adult-only game")
+			c.JSON(http.StatusForbidden, gin.H{"error": "This game is restricted to adults"})
+			return
+		}
+		if errors.Is(err, service.ErrBlockedByHost) {
+			logger.Warn().Err(err).Msg("Blocked player attempted to join game")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You can't join games hosted by this organizer"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to join game")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	logger.Info().Str("gameID", gameID).Msg("User joined game")
+
+	var myStatus *models.ParticipantStatus
+	for _, p := range participants {
+		if p.ID == userID {
+			status := p.Status
+			myStatus = &status
+			break
+		}
+	}
+	response := h.participationResponse(ctx, gameID, userID, myStatus)
+
+	// The join already succeeded, so a failed lookup only loses the double-booking warning
+	conflicts, err := h.gamesService.ScheduleConflicts(ctx, gameID, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to check schedule conflicts")
+	}
+	response.Conflicts = conflicts
+
+	c.JSON(http.StatusOK, response)
+}
+
+// participationResponse builds the join/drop response with the refreshed game so clients can update
+// the details screen without a second request. The roster change has already succeeded, so a failed
+// game lookup is logged and the game omitted rather than failing the request.
+func (h *Handler) participationResponse(ctx context.Context, gameID, userID string, myStatus *models.ParticipantStatus) models.ParticipationResponse {
+	response := models.ParticipationResponse{MyStatus: myStatus}
+
+	game, err := h.gamesService.GetGame(ctx, gameID, userID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to load game for participation response")
+		return response
+	}
+	response.Game = game
+	return response
+}
+
+// DropGame handles POST /games/:gameId/drop
+func (h *Handler) DropGame(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
 	gameID := c.Param("gameId")
 	if gameID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
 		return
 	}
 
-	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	// The body is optional; an empty one drops without a reason
+	var req models.DropGameRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			logger.Error().Err(err).Msg("Invalid request body")
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (reason must be: injury, schedule_conflict, weather, or other)"})
+			return
+		}
+	}
+
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	result, err := h.gamesService.DropParticipantFromGame(ctx, gameID, userID, req.Reason)
+	if err != nil {
+		// Handle specific error types
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrTooLate) {
+			logger.Warn().Err(err).Msg("Drop deadline has passed")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Drop deadline has passed"})
+			return
+		}
+		if errors.Is(err, service.ErrGameFinished) {
+			logger.Warn().Err(err).Msg("Game has already finished")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Game has already finished"})
+			return
+		}
+		if errors.Is(err, service.ErrNotParticipant) {
+			logger.Warn().Err(err).Msg("User is not a participant")
+			c.JSON(http.StatusBadRequest, gin.H{"error": "You are not a participant of this game"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to drop from game")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to drop from game"})
+		return
+	}
+
+	logger.Info().Msg("User dropped from game successfully")
+
+	dropped := models.ParticipantStatusDropped
+	response := h.participationResponse(ctx, gameID, userID, &dropped)
+	response.LateDrop = result.LateDrop
+	c.JSON(http.StatusOK, response)
+}
+
+// CheckIn handles POST /games/:gameId/checkin
+func (h *Handler) CheckIn(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	if gameID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.CheckIn(ctx, gameID, userID)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrCheckInNotOpen) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Check-in opens an hour before the game starts and closes when it ends"})
+			return
+		}
+		if errors.Is(err, service.ErrNotParticipant) {
+			logger.Warn().Err(err).Msg("User is not a confirmed participant")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only confirmed participants can check in"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to check in")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check in"})
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// UpdateGameNotificationSettings handles PATCH /games/:gameId/participation/notifications
+func (h *Handler) UpdateGameNotificationSettings(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.UpdateGameNotificationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	settings, err := h.gamesService.UpdateGameNotificationSettings(ctx, gameID, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrNotParticipant) {
+			logger.Warn().Err(err).Msg("User is not a participant")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only participants can change notifications for a game"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to update notification settings")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notification settings"})
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+// MarkAttendance handles POST /games/:gameId/participants/:userId/attendance
+func (h *Handler) MarkAttendance(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.MarkAttendanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (status must be: attended or no_show)"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	participantUserID := c.Param("userId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("participantUserId", participantUserID).Logger()
 	ctx = logger.WithContext(ctx)
 
-	participant, err := h.gamesService.JoinGame(ctx, gameID, userID)
+	attendance, err := h.gamesService.MarkAttendance(ctx, gameID, userID, participantUserID, req.Status)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to join game")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrNotOwner) {
+			logger.Warn().Err(err).Msg("User is not the game owner")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can mark attendance"})
+			return
+		}
+		if errors.Is(err, service.ErrNotParticipant) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User is not a confirmed participant of this game"})
+			return
+		}
+		if errors.Is(err, service.ErrAttendanceNotOpen) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Attendance can be marked once the game has started"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to mark attendance")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark attendance"})
 		return
 	}
 
-	logger.Info().Str("gameID", gameID).Msg("User joined game")
-	c.JSON(http.StatusOK, participant)
+	c.JSON(http.StatusOK, attendance)
 }
 
-// DropGame handles POST /games/:gameId/drop
-func (h *Handler) DropGame(c *gin.Context) {
+// MarkPayment handles POST /games/:gameId/participants/:userId/payment
+func (h *Handler) MarkPayment(c *gin.Context) {
 	logger := LoggerFromContext(c)
 	ctx := logger.WithContext(c.Request.Context())
 
-	userID, err := getUserID(c)
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to extract user ID from context")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
-		return
-	}
+	userID := authenticatedUserID(c)
 
-	gameID := c.Param("gameId")
-	if gameID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Game ID is required"})
+	var req models.MarkPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (paid is required)"})
 		return
 	}
 
-	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	gameID := c.Param("gameId")
+	participantUserID := c.Param("userId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("participantUserId", participantUserID).Logger()
 	ctx = logger.WithContext(ctx)
 
-	result, err := h.gamesService.DropParticipantFromGame(ctx, gameID, userID)
+	game, err := h.gamesService.MarkPayment(ctx, gameID, userID, participantUserID, req)
 	if err != nil {
-		// Handle specific error types
-		if errors.Is(err, service.ErrTooLate) {
-			logger.Warn().Err(err).Msg("Drop deadline has passed")
-			c.JSON(http.StatusForbidden, gin.H{"error": "Drop deadline has passed"})
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
 			return
 		}
-		if errors.Is(err, service.ErrGameFinished) {
-			logger.Warn().Err(err).Msg("Game has already finished")
-			c.JSON(http.StatusForbidden, gin.H{"error": "Game has already finished"})
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn().Err(err).Msg("Game not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrNotOwner) {
+			logger.Warn().Err(err).Msg("User is not the game owner")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can record payments"})
 			return
 		}
 		if errors.Is(err, service.ErrNotParticipant) {
-			logger.Warn().Err(err).Msg("User is not a participant")
-			c.JSON(http.StatusBadRequest, gin.H{"error": "You are not a participant of this game"})
+			c.JSON(http.StatusNotFound, gin.H{"error": "User is not a participant of this game"})
+			return
+		}
+		if errors.Is(err, service.ErrGameNotEditable) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Payments can't be recorded for cancelled games"})
 			return
 		}
 
-		logger.Error().Err(err).Msg("Failed to drop from game")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to drop from game"})
+		logger.Error().Err(err).Msg("Failed to record payment")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record payment"})
 		return
 	}
 
-	logger.Info().Msg("User dropped from game successfully")
-
-	// TODO: Send push notification to promoted user if applicable
-	if result.PromotedUser != nil {
-		logger.Info().
-			Str("promotedUserId", result.PromotedUser.ID).
-			Str("promotedUserEmail", result.PromotedUser.Email).
-			Msg("TODO: Send push notification to promoted user")
-		// Future: h.notificationService.SendWaitlistPromotion(ctx, result.PromotedUser, gameID)
-	}
-
-	c.JSON(http.StatusOK, gin.H{"message": "Successfully dropped from game"})
+	c.JSON(http.StatusOK, game)
 }
 
 // CancelGame handles POST /games/:gameId/cancel
@@ -326,12 +978,7 @@ func (h *Handler) CancelGame(c *gin.Context) {
 	logger := LoggerFromContext(c)
 	ctx := logger.WithContext(c.Request.Context())
 
-	userID, err := getUserID(c)
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to extract user ID from context")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
-		return
-	}
+	userID := authenticatedUserID(c)
 
 	gameID := c.Param("gameId")
 	if gameID == "" {
@@ -339,12 +986,27 @@ func (h *Handler) CancelGame(c *gin.Context) {
 		return
 	}
 
+	var req models.CancelGameRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			logger.Error().Err(err).Msg("Invalid request body")
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (reason must be at most 500 characters)"})
+			return
+		}
+	}
+
 	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
 	ctx = logger.WithContext(ctx)
 
-	result, err := h.gamesService.CancelGame(ctx, gameID, userID)
+	result, err := h.gamesService.CancelGame(ctx, gameID, userID, req.Reason)
 	if err != nil {
 		// Handle specific error types
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			logger.Warn().Err(err).Msg("Invalid cancel request")
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
 		if errors.Is(err, service.ErrNotOwner) {
 			logger.Warn().Err(err).Msg("User is not the game owner")
 			c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can cancel the game"})
@@ -373,14 +1035,6 @@ func (h *Handler) CancelGame(c *gin.Context) {
 
 	logger.Info().Int("participantCount", len(result.ParticipantsToNotify)).Msg("Game cancelled successfully")
 
-	// TODO: Send push notifications to all participants
-	if len(result.ParticipantsToNotify) > 0 {
-		logger.Info().
-			Int("participantCount", len(result.ParticipantsToNotify)).
-			Msg("TODO: Send push notifications to all participants about game cancellation")
-		// Future: h.notificationService.SendGameCancellation(ctx, result.ParticipantsToNotify, gameID)
-	}
-
 	c.JSON(http.StatusOK, gin.H{"message": "Game cancelled successfully"})
 }
 
@@ -398,25 +1052,46 @@ func (h *Handler) Register(c *gin.Context) {
 		return
 	}
 
+	// Binding already validated the format
+	birthdate, _ := time.Parse(time.DateOnly, req.Birthdate)
+
+	var referralCode string
+	if req.ReferralCode != nil {
+		referralCode = *req.ReferralCode
+	}
+
 	// Create user
 	user, err := h.userService.CreateUser(ctx, service.CreateUserRequest{
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Email:     req.Email,
-		Password:  req.Password,
+		FirstName:              req.FirstName,
+		LastName:               req.LastName,
+		Email:                  req.Email,
+		Password:               req.Password,
+		Birthdate:              birthdate,
+		AcceptedLegalDocuments: req.AcceptedLegalDocuments,
+		ClientInfo:             clientInfo(c),
+		ReferralCode:           referralCode,
 	})
 	if err != nil {
 		if errors.Is(err, apperrors.ErrAlreadyExists) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		if errors.Is(err, service.ErrLegalAcceptanceRequired) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "You must accept the terms of service and privacy policy to register"})
+			return
+		}
 		logger.Error().Err(err).Msg("CreateUser failed")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user"})
 		return
 	}
 
 	// Generate JWT access token
-	token, err := util.GenerateToken(user.ID, req.Email, req.FirstName, req.LastName, nil)
+	token, err := util.GenerateToken(user.ID, req.Email, req.FirstName, req.LastName, user.HomeRegion, user.TokenVersion, nil)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
 		return
@@ -492,6 +1167,13 @@ func setRefreshCookie(c *gin.Context, token string) {
 	)
 }
 
+// clearAuthCookies expires the access and refresh token cookies
+func clearAuthCookies(c *gin.Context) {
+	c.SetSameSite(http.SameSiteStrictMode)
+	c.SetCookie("auth_token", "", -1, "/", "", c.Request.TLS != nil, true)
+	c.SetCookie("refresh_token", "", -1, "/", "", c.Request.TLS != nil, true)
+}
+
 func (h *Handler) Login(c *gin.Context) {
 	logger := LoggerFromContext(c)
 	ctx := logger.WithContext(c.Request.Context())
@@ -504,15 +1186,30 @@ func (h *Handler) Login(c *gin.Context) {
 	}
 
 	// Authenticate user
-	user, err := h.userService.Login(ctx, req.Email, req.Password)
+	user, err := h.userService.Login(ctx, req.Email, req.Password, c.ClientIP())
 	if err != nil {
-		logger.Warn().Err(err).Str("email", req.Email).Msg("Login failed")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+		var throttledErr *service.LoginThrottledError
+		if errors.As(err, &throttledErr) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttledErr.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many failed sign-in attempts, please try again later"})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			logger.Warn().Err(err).Str("email", req.Email).Msg("Login failed")
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+			return
+		}
+		if errors.Is(err, service.ErrAccountDeactivated) {
+			c.JSON(http.StatusForbidden, gin.H{"error": deactivatedAccountMessage})
+			return
+		}
+		logger.Error().Err(err).Msg("Login failed")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to log in"})
 		return
 	}
 
 	// Generate JWT access token
-	token, err := util.GenerateToken(user.ID, user.Email, user.FirstName, user.LastName, nil)
+	token, err := util.GenerateToken(user.ID, user.Email, user.FirstName, user.LastName, user.HomeRegion, user.TokenVersion, nil)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to generate token")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
@@ -548,6 +1245,155 @@ func (h *Handler) Login(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// RequestMagicLink handles POST /auth/magic-link - emails a passwordless sign-in link
+func (h *Handler) RequestMagicLink(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var req models.MagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The response is the same whether or not the email belongs to an account
+	if err := h.userService.RequestMagicLink(ctx, req.Email); err != nil {
+		logger.Error().Err(err).Msg("Failed to send magic link")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send sign-in link"})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "If an account exists for this email, a sign-in link is on its way"})
+}
+
+// RedeemMagicLink handles POST /auth/magic-link/redeem - signs in with a token from a sign-in link
+func (h *Handler) RedeemMagicLink(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var req models.RedeemMagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.userService.RedeemMagicLink(ctx, req.Token)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidMagicLink) {
+			logger.Warn().Err(err).Msg("Invalid magic link")
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired sign-in link"})
+			return
+		}
+		if errors.Is(err, service.ErrAccountDeactivated) {
+			c.JSON(http.StatusForbidden, gin.H{"error": deactivatedAccountMessage})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to redeem magic link")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to sign in"})
+		return
+	}
+
+	logger.Info().Str("userID", user.ID).Msg("User logged in with magic link")
+	h.startSession(c, user)
+}
+
+// RequestLoginCode handles POST /auth/otp/request - texts a sign-in code to a verified phone number
+func (h *Handler) RequestLoginCode(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var req models.LoginCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The response is the same whether or not the number belongs to an account
+	if err := h.userService.RequestLoginCode(ctx, req.PhoneNumber, req.CountryCode); err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to send login code")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send sign-in code"})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "If an account uses this phone number, a sign-in code is on its way"})
+}
+
+// VerifyLoginCode handles POST /auth/otp/verify - signs in with a code sent by SMS
+func (h *Handler) VerifyLoginCode(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var req models.VerifyLoginCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.userService.VerifyLoginCode(ctx, req.PhoneNumber, req.CountryCode, req.Code)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidVerificationCode) {
+			logger.Warn().Err(err).Msg("Invalid login code")
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired code"})
+			return
+		}
+		if errors.Is(err, service.ErrAccountDeactivated) {
+			c.JSON(http.StatusForbidden, gin.H{"error": deactivatedAccountMessage})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to verify login code")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to sign in"})
+		return
+	}
+
+	logger.Info().Str("userID", user.ID).Msg("User logged in with SMS code")
+	h.startSession(c, user)
+}
+
+// startSession issues an access and refresh token for a user who has just proven who they are by
+// a passwordless method. Like Login, mobile clients get the tokens in the body and web clients get cookies.
+func (h *Handler) startSession(c *gin.Context, user *models.User) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	token, err := util.GenerateToken(user.ID, user.Email, user.FirstName, user.LastName, user.HomeRegion, user.TokenVersion, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to generate token")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		return
+	}
+
+	deviceInfo := c.GetHeader("User-Agent")
+	refreshToken, err := h.userService.CreateRefreshTokenForUser(ctx, user.ID, deviceInfo)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to generate refresh token")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate refresh token"})
+		return
+	}
+
+	resp := models.AuthResponse{
+		User: *user,
+	}
+	if isMobileClient(c) {
+		resp.Token = &token
+		resp.RefreshToken = &refreshToken
+	} else {
+		setAuthCookie(c, token)
+		setRefreshCookie(c, refreshToken)
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // RefreshToken handles POST /auth/refresh - refreshes an access token using a refresh token
 func (h *Handler) RefreshToken(c *gin.Context) {
 	logger := LoggerFromContext(c)
@@ -580,7 +1426,7 @@ func (h *Handler) RefreshToken(c *gin.Context) {
 	}
 
 	// Generate new access token
-	accessToken, err := util.GenerateToken(user.ID, user.Email, user.FirstName, user.LastName, nil)
+	accessToken, err := util.GenerateToken(user.ID, user.Email, user.FirstName, user.LastName, user.HomeRegion, user.TokenVersion, nil)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to generate access token")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
@@ -622,101 +1468,56 @@ func (h *Handler) RefreshToken(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
-// PlacesAutocomplete handles POST /places/search (Google Places API v1)
-func (h *Handler) PlacesAutocomplete(c *gin.Context) {
+// SignOutEverywhere handles POST /auth/logout-all - ends every session of the current user,
+// invalidating refresh tokens and previously issued access tokens
+func (h *Handler) SignOutEverywhere(c *gin.Context) {
 	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
 
-	// Bind and validate request body
-	var req models.PlaceSearchRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
 
-	// Check if API key is configured
-	if h.googlePlacesKey == "" {
-		logger.Error().Msg("Google Places API key not configured")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Location service not configured"})
+	if err := h.userService.SignOutEverywhere(ctx, userID); err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to sign out everywhere")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to sign out"})
 		return
 	}
 
-	// Build Google Places API v1 searchText request
-	apiURL := "https://places.googleapis.com/v1/places:searchText"
-
-	requestBody, err := json.Marshal(map[string]any{
-		"textQuery":      req.TextQuery,
-		"maxResultCount": 5,
-	})
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to marshal request body")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal error"})
-		return
+	if !isMobileClient(c) {
+		clearAuthCookies(c)
 	}
+	c.Status(http.StatusNoContent)
+}
 
-	// Create HTTP request
-	httpReq, err := http.NewRequest("POST", apiURL, strings.NewReader(string(requestBody)))
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to create HTTP request")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal error"})
+// PlacesAutocomplete handles POST /places/search (Google Places API v1)
+func (h *Handler) PlacesAutocomplete(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	// Bind and validate request body
+	var req models.PlaceSearchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("X-Goog-Api-Key", h.googlePlacesKey)
-	httpReq.Header.Set("X-Goog-FieldMask", "places.id,places.displayName,places.formattedAddress,places.location")
-
-	// Make request to Google Places API
-	client := &http.Client{}
-	resp, err := client.Do(httpReq)
+	predictions, err := h.places.Search(ctx, req.TextQuery)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to call Google Places API")
+		if errors.Is(err, places.ErrNotConfigured) {
+			logger.Error().Msg("Google Places API key not configured")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Location service not configured"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to fetch location suggestions")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch location suggestions"})
 		return
 	}
-	defer resp.Body.Close()
-
-	// Parse Google's response
-	var googleResp models.GooglePlacesSearchResponse
-	if err := json.NewDecoder(resp.Body).Decode(&googleResp); err != nil {
-		logger.Error().Err(err).Msg("Failed to parse Google Places API response")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to parse location data"})
-		return
-	}
-
-	// Check HTTP status
-	if resp.StatusCode != http.StatusOK {
-		logger.Error().
-			Int("httpStatus", resp.StatusCode).
-			Msg("Google Places API returned error")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Location service error"})
-		return
-	}
-
-	// Convert to our response format
-	predictions := make([]models.PlacePrediction, len(googleResp.Places))
-	for i, place := range googleResp.Places {
-		addressParts := strings.Split(place.FormattedAddress, ",")
-		mainText := place.DisplayName.Text
-		if mainText == "" && len(addressParts) > 0 {
-			mainText = strings.TrimSpace(addressParts[0])
-		}
-
-		secondaryText := ""
-		if len(addressParts) > 1 {
-			secondaryText = strings.TrimSpace(strings.Join(addressParts[1:], ","))
-		}
-
-		predictions[i] = models.PlacePrediction{
-			PlaceID:     place.ID,
-			Description: place.FormattedAddress,
-			Formatting: models.PlaceStructuredFormatting{
-				MainText:      mainText,
-				SecondaryText: secondaryText,
-			},
-		}
-	}
 
-	// Return response
 	c.JSON(http.StatusOK, models.PlaceAutocompleteResponse{
 		Predictions: predictions,
 	})
@@ -725,77 +1526,31 @@ func (h *Handler) PlacesAutocomplete(c *gin.Context) {
 // PlaceDetails handles GET /places/{placeId} (Google Places API v1)
 func (h *Handler) PlaceDetails(c *gin.Context) {
 	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
 
-	// Get place ID parameter (format: places/ChIJ...)
+	// Get place ID parameter
 	placeID := c.Param("placeId")
 	if placeID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "placeId parameter is required"})
 		return
 	}
 
-	// Check if API key is configured
-	if h.googlePlacesKey == "" {
-		logger.Error().Msg("Google Places API key not configured")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Location service not configured"})
-		return
-	}
-
-	// Build Google Places API v1 request
-	apiURL := fmt.Sprintf("https://places.googleapis.com/v1/places/%s", placeID)
-
-	// Create HTTP request
-	httpReq, err := http.NewRequest("GET", apiURL, nil)
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to create HTTP request")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal error"})
-		return
-	}
-
-	httpReq.Header.Set("X-Goog-Api-Key", h.googlePlacesKey)
-	httpReq.Header.Set("X-Goog-FieldMask", "displayName,formattedAddress,location")
-
-	// Make request to Google Places API
-	client := &http.Client{}
-	resp, err := client.Do(httpReq)
+	details, err := h.places.Details(ctx, placeID)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to call Google Places API")
+		if errors.Is(err, places.ErrNotConfigured) {
+			logger.Error().Msg("Google Places API key not configured")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Location service not configured"})
+			return
+		}
+		if errors.Is(err, places.ErrPlaceNotFound) {
+			logger.Warn().Str("placeId", placeID).Msg("Place not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Place not found"})
+			return
+		}
+		logger.Error().Err(err).Str("placeId", placeID).Msg("Failed to fetch place details")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch place details"})
 		return
 	}
-	defer resp.Body.Close()
-
-	// Parse Google's response
-	var googleResp models.GooglePlaceDetailsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&googleResp); err != nil {
-		logger.Error().Err(err).Int("statusCode", resp.StatusCode).Msg("Failed to parse Google Places API response")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to parse location data"})
-		return
-	}
-
-	// Check HTTP status
-	if resp.StatusCode == http.StatusNotFound {
-		logger.Warn().Str("placeId", placeID).Msg("Place not found")
-		c.JSON(http.StatusNotFound, gin.H{"error": "Place not found"})
-		return
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		logger.Error().
-			Int("httpStatus", resp.StatusCode).
-			Str("placeId", placeID).
-			Msg("Google Places API returned error")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Location service error"})
-		return
-	}
-
-	// Build response
-	response := models.PlaceDetailsResponse{
-		PlaceID:          googleResp.ID,
-		Name:             googleResp.DisplayName.Text,
-		FormattedAddress: googleResp.FormattedAddress,
-		Latitude:         googleResp.Location.Latitude,
-		Longitude:        googleResp.Location.Longitude,
-	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, details)
 }
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// maxImportBodyBytes caps the size of a bulk import upload
+const maxImportBodyBytes = 5 << 20
+
+// importCSVColumns lists the CSV columns accepted by ImportGames. Only category, location_name,
+// latitude, longitude, start_time, duration_minutes, max_participants and pricing_type are required.
+// tags holds several tags separated by semicolons.
+var importCSVColumns = []string{
+	"category", "title", "description",
+	"location_name", "location_address", "latitude", "longitude", "location_notes",
+	"start_time", "duration_minutes", "max_participants",
+	"pricing_type", "pricing_amount_cents", "pricing_currency",
+	"signup_deadline", "drop_deadline", "skill_level", "adult_only", "notes", "tags",
+}
+
+// ImportGames handles POST /games/import
+// The body is NDJSON (one CreateGameRequest per line, Content-Type application/x-ndjson) or CSV
+// (header row using importCSVColumns, Content-Type text/csv). Pass dryRun=true to validate only.
+func (h *Handler) ImportGames(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var err error
+	dryRun := false
+	if dryRunStr := c.Query("dryRun"); dryRunStr != "" {
+		if dryRun, err = strconv.ParseBool(dryRunStr); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dryRun (must be true or false)"})
+			return
+		}
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxImportBodyBytes)
+	var rows []service.ImportGameRow
+	switch c.ContentType() {
+	case "application/x-ndjson", "application/jsonl":
+		rows, err = parseNDJSONImport(body)
+	case "text/csv":
+		rows, err = parseCSVImport(body)
+	default:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be application/x-ndjson or text/csv"})
+		return
+	}
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("upload exceeds %d bytes", maxImportBodyBytes)})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Int("rows", len(rows)).Bool("dryRun", dryRun).Logger()
+	ctx = logger.WithContext(ctx)
+
+	result, err := h.gamesService.ImportGames(ctx, userID, rows, dryRun)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to import games")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import games"})
+		return
+	}
+
+	status := http.StatusCreated
+	switch {
+	case result.DryRun:
+		status = http.StatusOK
+	case result.Invalid > 0:
+		status = http.StatusUnprocessableEntity
+	case result.Created < result.Total:
+		status = http.StatusMultiStatus
+	}
+	c.JSON(status, result)
+}
+
+// parseNDJSONImport decodes one game per non-blank line. Rows that fail to decode or validate are
+// returned with ParseErr set so they are reported alongside the others.
+func parseNDJSONImport(r io.Reader) ([]service.ImportGameRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var rows []service.ImportGameRow
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		row := service.ImportGameRow{Line: line}
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&row.Request); err != nil {
+			row.ParseErr = fmt.Errorf("invalid JSON: %w", err)
+		} else {
+			row.ParseErr = binding.Validator.ValidateStruct(&row.Request)
+		}
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	return rows, nil
+}
+
+// parseCSVImport decodes one game per data row, mapping columns by header name
+func parseCSVImport(r io.Reader) ([]service.ImportGameRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for name := range columns {
+		if !isImportCSVColumn(name) {
+			return nil, fmt.Errorf("unknown CSV column %q", name)
+		}
+	}
+
+	var rows []service.ImportGameRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var row service.ImportGameRow
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("failed to read upload: %w", err)
+			}
+			row.Line = parseErr.Line
+			row.ParseErr = fmt.Errorf("invalid CSV: %w", parseErr.Err)
+		} else {
+			row.Line, _ = reader.FieldPos(0)
+			row.Request, row.ParseErr = parseCSVGameRecord(columns, record)
+			if row.ParseErr == nil {
+				row.ParseErr = binding.Validator.ValidateStruct(&row.Request)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func isImportCSVColumn(name string) bool {
+	for _, column := range importCSVColumns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCSVGameRecord builds a CreateGameRequest from a CSV record. Empty cells are treated as unset.
+func parseCSVGameRecord(columns map[string]int, record []string) (models.CreateGameRequest, error) {
+	get := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	optString := func(name string) *string {
+		if v := get(name); v != "" {
+			return &v
+		}
+		return nil
+	}
+
+	var req models.CreateGameRequest
+	var errs []string
+	parseInt := func(name string) int {
+		v := get(name)
+		if v == "" {
+			return 0
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s must be an integer", name))
+		}
+		return n
+	}
+	parseFloat := func(name string) *float64 {
+		v := get(name)
+		if v == "" {
+			return nil
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s must be a number", name))
+			return nil
+		}
+		return &f
+	}
+	parseTime := func(name string) *time.Time {
+		v := get(name)
+		if v == "" {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s must be an RFC 3339 timestamp", name))
+			return nil
+		}
+		return &t
+	}
+
+	req.Category = models.GameCategory(get("category"))
+	req.Title = optString("title")
+	req.Description = optString("description")
+	req.Location = models.Location{
+		Name:      get("location_name"),
+		Address:   optString("location_address"),
+		Latitude:  parseFloat("latitude"),
+		Longitude: parseFloat("longitude"),
+		Notes:     optString("location_notes"),
+	}
+	if startTime := parseTime("start_time"); startTime != nil {
+		req.StartTime = *startTime
+	}
+	req.DurationMinutes = parseInt("duration_minutes")
+	req.MaxParticipants = parseInt("max_participants")
+	req.Pricing = models.Pricing{
+		Type:        models.PricingType(get("pricing_type")),
+		AmountCents: parseInt("pricing_amount_cents"),
+		Currency:    get("pricing_currency"),
+	}
+	req.SignupDeadline = parseTime("signup_deadline")
+	req.DropDeadline = parseTime("drop_deadline")
+	if skillLevel := get("skill_level"); skillLevel != "" {
+		level := models.SkillLevel(skillLevel)
+		req.SkillLevel = &level
+	}
+	if adultOnly := get("adult_only"); adultOnly != "" {
+		v, err := strconv.ParseBool(adultOnly)
+		if err != nil {
+			errs = append(errs, "adult_only must be true or false")
+		}
+		req.AdultOnly = v
+	}
+	req.Notes = optString("notes")
+	if tags := get("tags"); tags != "" {
+		req.Tags = strings.Split(tags, ";")
+	}
+
+	if len(errs) > 0 {
+		return req, errors.New(strings.Join(errs, "; "))
+	}
+	return req, nil
+}
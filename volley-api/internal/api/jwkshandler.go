@@ -0,0 +1,17 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gabe-dev-svc/volley/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+// GetJWKS handles GET /.well-known/jwks.json
+// Other services verify volley access tokens against these public keys. Only RS256 and EdDSA keys are
+// published; HMAC-signed tokens can't be verified outside this API.
+func (h *Handler) GetJWKS(c *gin.Context) {
+	// Short enough that a newly added key is picked up well before it starts signing
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, util.DefaultJWTConfig().PublicJWKS())
+}
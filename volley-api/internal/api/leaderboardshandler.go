@@ -0,0 +1,128 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GetLeaderboard handles GET /leaderboards
+func (h *Handler) GetLeaderboard(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	latitude := c.Query("latitude")
+	if latitude == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "latitude is required"})
+		return
+	}
+	longitude := c.Query("longitude")
+	if longitude == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "longitude is required"})
+		return
+	}
+
+	filters := service.LeaderboardFilters{
+		Metric: models.LeaderboardMetric(c.Query("metric")),
+		Radius: 16093.4, // Default 10 miles in meters
+	}
+	if _, err := fmt.Sscanf(latitude, "%f", &filters.Latitude); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid latitude"})
+		return
+	}
+	if _, err := fmt.Sscanf(longitude, "%f", &filters.Longitude); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid longitude"})
+		return
+	}
+	if radiusStr := c.Query("radius"); radiusStr != "" {
+		if _, err := fmt.Sscanf(radiusStr, "%f", &filters.Radius); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid radius"})
+			return
+		}
+	}
+	if categoryStr := c.Query("category"); categoryStr != "" {
+		category := models.GameCategory(categoryStr)
+		filters.Category = &category
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if _, err := fmt.Sscanf(limitStr, "%d", &filters.Limit); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if _, err := fmt.Sscanf(offsetStr, "%d", &filters.Offset); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset"})
+			return
+		}
+	}
+
+	leaderboard, err := h.statsService.Leaderboard(ctx, filters)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to get leaderboard")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve leaderboard"})
+		return
+	}
+
+	c.JSON(http.StatusOK, leaderboard)
+}
+
+// NameMVP handles PUT /games/:gameId/mvp
+func (h *Handler) NameMVP(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.NameMVPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (userId is required)"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("mvpUserId", req.UserID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	mvp, err := h.gamesService.NameMVP(ctx, gameID, userID, req.UserID)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		if errors.Is(err, service.ErrNotOwner) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can name the MVP"})
+			return
+		}
+		if errors.Is(err, service.ErrNotParticipant) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User did not play in this game"})
+			return
+		}
+		if errors.Is(err, service.ErrMVPNotOpen) {
+			c.JSON(http.StatusConflict, gin.H{"error": "The MVP can be named once the game is completed"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to name MVP")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to name MVP"})
+		return
+	}
+
+	c.JSON(http.StatusOK, mvp)
+}
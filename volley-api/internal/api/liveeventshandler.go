@@ -0,0 +1,93 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/realtime"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// liveEventsKeepAlive is how often an idle event stream gets a comment, so proxies don't close it
+const liveEventsKeepAlive = 25 * time.Second
+
+// GameEvents handles GET /games/:gameId/events. It streams a models.GameLiveEvent as a server-sent
+// event, named by its type, whenever the game's roster or status changes, until the client
+// disconnects. Changes made while a client is disconnected aren't replayed, so clients reload the
+// game's details after connecting.
+func (h *Handler) GameEvents(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	parsed, err := uuid.Parse(c.Param("gameId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid game ID format"})
+		return
+	}
+	gameID := parsed.String()
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if h.realtime == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Live updates are not available"})
+		return
+	}
+	if err := h.gamesService.CheckGameExists(ctx, gameID); err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to get game")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve game"})
+		return
+	}
+
+	sub := h.realtime.Join(realtime.GameEventsRoom(gameID))
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Keep nginx from buffering the stream
+	c.Status(http.StatusOK)
+	if _, err := fmt.Fprint(c.Writer, "retry: 5000\n\n"); err != nil {
+		return
+	}
+	c.Writer.Flush()
+
+	ticker := time.NewTicker(liveEventsKeepAlive)
+	defer ticker.Stop()
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-sub.Messages():
+			if !ok {
+				logger.Info().Msg("Live event stream closed - client fell behind")
+				return
+			}
+			var event struct {
+				Type string `json:"type"`
+			}
+			if err := json.Unmarshal(message, &event); err != nil {
+				logger.Error().Err(err).Msg("Failed to decode live event")
+				continue
+			}
+			_, err = fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", event.Type, message)
+		case <-ticker.C:
+			_, err = fmt.Fprint(c.Writer, ": keepalive\n\n")
+		}
+		if err != nil {
+			return
+		}
+		c.Writer.Flush()
+	}
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gabe-dev-svc/volley/internal/realtime"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gabe-dev-svc/volley/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	gameID := "550e8400-e29b-41d4-a716-446655440001"
+	gameUUID := pgtype.UUID{Bytes: uuid.MustParse(gameID), Valid: true}
+
+	mockQuerier := mocks.NewQuerier(t)
+	mockQuerier.EXPECT().GetGame(mock.Anything, gameUUID).Return(repository.GetGameRow{ID: gameUUID}, nil)
+	hub := realtime.NewHub(nil)
+	h := &Handler{gamesService: service.NewGamesService(mockQuerier, nil), realtime: hub}
+
+	router := gin.New()
+	router.GET("/v1/games/:gameId/events", func(c *gin.Context) {
+		c.Set("userID", "550e8400-e29b-41d4-a716-446655440002")
+	}, h.GameEvents)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1/games/"+gameID+"/events", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	lines := bufio.NewScanner(resp.Body)
+	require.True(t, lines.Scan())
+	assert.Equal(t, "retry: 5000", lines.Text())
+	require.True(t, lines.Scan())
+
+	// The stream has joined the room once its first lines arrive
+	require.NoError(t, hub.Publish(ctx, realtime.GameEventsRoom(gameID), []byte(`{"type":"participant.joined","status":"waitlist"}`)))
+
+	require.True(t, lines.Scan())
+	assert.Equal(t, "event: participant.joined", lines.Text())
+	require.True(t, lines.Scan())
+	assert.Equal(t, `data: {"type":"participant.joined","status":"waitlist"}`, lines.Text())
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GetMetadata handles GET /metadata
+// The locale comes from the locale query parameter or the Accept-Language header. Responses carry an ETag
+// so clients can revalidate with If-None-Match and only download the catalog when it changes.
+func (h *Handler) GetMetadata(c *gin.Context) {
+	logger := LoggerFromContext(c)
+
+	locale := c.Query("locale")
+	if locale == "" {
+		locale = c.GetHeader("Accept-Language")
+	}
+	metadata := service.Metadata(service.NegotiateLocale(locale))
+	metadata.AppVersion = h.appVersion
+
+	body, err := json.Marshal(metadata)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to encode metadata")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve metadata"})
+		return
+	}
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	c.Header("ETag", etag)
+	c.Header("Vary", "Accept-Language")
+	c.Header("Cache-Control", "public, max-age=3600")
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	volleyerrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
 	"github.com/gabe-dev-svc/volley/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -121,6 +123,8 @@ func authenticateUser(c *gin.Context) error {
 	c.Set("email", claims.Email)
 	c.Set("firstName", claims.FirstName)
 	c.Set("lastName", claims.LastName)
+	c.Set("homeRegion", claims.HomeRegion)
+	c.Set("tokenVersion", claims.TokenVersion)
 
 	logger.Debug().
 		Str("userID", claims.UserID).
@@ -145,6 +149,22 @@ func OptionalAuthMiddleware() gin.HandlerFunc {
 	}
 }
 
+const (
+	// SandboxHeader is set on every response from a sandbox deployment so clients can flag the
+	// session as a sandbox user (e.g. show a banner) and QA tooling can assert it is not in production
+	SandboxHeader = "X-Volley-Sandbox"
+	// SandboxVerificationCode is the phone verification code accepted in sandbox mode
+	SandboxVerificationCode = "000000"
+)
+
+// SandboxMiddleware marks responses as coming from a sandbox deployment
+func SandboxMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header(SandboxHeader, "true")
+		c.Next()
+	}
+}
+
 // AuthMiddleware validates JWT token and sets user information in context
 func AuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -163,3 +183,97 @@ func AuthMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// SessionMiddleware rejects access tokens issued before the user last changed their password or
+// signed out everywhere with 401, and users whose account an admin suspended with 403. Requests
+// without an authenticated user pass through. Must run after AuthMiddleware or OptionalAuthMiddleware.
+func (h *Handler) SessionMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := authenticatedUserID(c)
+		if userID == "" {
+			c.Next()
+			return
+		}
+
+		logger := LoggerFromContext(c)
+		ctx := logger.WithContext(c.Request.Context())
+
+		tokenVersion, _ := c.Get("tokenVersion")
+		version, _ := tokenVersion.(int32)
+		if err := h.userService.CheckSession(ctx, userID, version); err != nil {
+			switch {
+			case errors.Is(err, service.ErrTokenRevoked):
+				logger.Warn().Str("userID", userID).Str("reason", "token_revoked").Msg("Authentication failed")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authentication token has been revoked"})
+			case errors.Is(err, service.ErrAccountSuspended):
+				logger.Warn().Str("userID", userID).Str("reason", "account_suspended").Msg("Authentication failed")
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Your account has been suspended"})
+			case errors.Is(err, service.ErrAccountDeactivated):
+				logger.Warn().Str("userID", userID).Str("reason", "account_deactivated").Msg("Authentication failed")
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Your account is deactivated"})
+			default:
+				logger.Error().Err(err).Msg("Failed to check session")
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to check authentication"})
+			}
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// LegalAcceptanceMiddleware blocks authenticated users from write endpoints until they have
+// accepted the current terms of service and privacy policy. Must run after AuthMiddleware.
+func (h *Handler) LegalAcceptanceMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger := LoggerFromContext(c)
+		ctx := logger.WithContext(c.Request.Context())
+
+		userID := authenticatedUserID(c)
+
+		pending, err := h.userService.ListPendingLegalDocuments(ctx, userID)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to check legal acceptance")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check legal acceptance"})
+			c.Abort()
+			return
+		}
+		if len(pending) > 0 {
+			logger.Warn().Str("userID", userID).Int("pendingCount", len(pending)).Msg("Legal acceptance required")
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":            "You must accept the latest terms of service and privacy policy to continue",
+				"pendingDocuments": pending,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AdminMiddleware restricts a route to users with the admin role. Must run after AuthMiddleware.
+func (h *Handler) AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger := LoggerFromContext(c)
+		ctx := logger.WithContext(c.Request.Context())
+
+		userID := authenticatedUserID(c)
+
+		isAdmin, err := h.userService.HasRole(ctx, userID, models.UserRoleAdmin)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to check admin role")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check permissions"})
+			c.Abort()
+			return
+		}
+		if !isAdmin {
+			logger.Warn().Str("userID", userID).Msg("Admin access denied")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Admin access required"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
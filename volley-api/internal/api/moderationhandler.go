@@ -0,0 +1,362 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ListReports handles GET /admin/reports
+// Query parameters: status (open, resolved or dismissed), targetType (game or user), limit, offset.
+func (h *Handler) ListReports(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var filters service.ListReportsFilters
+	if statusStr := c.Query("status"); statusStr != "" {
+		status := models.ReportStatus(statusStr)
+		switch status {
+		case models.ReportStatusOpen, models.ReportStatusResolved, models.ReportStatusDismissed:
+			filters.Status = &status
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid status (must be: open, resolved, or dismissed)"})
+			return
+		}
+	}
+	if targetTypeStr := c.Query("targetType"); targetTypeStr != "" {
+		targetType := models.ReportTargetType(targetTypeStr)
+		if targetType != models.ReportTargetGame && targetType != models.ReportTargetUser {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid targetType (must be: game or user)"})
+			return
+		}
+		filters.TargetType = &targetType
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if _, err := fmt.Sscanf(limitStr, "%d", &filters.Limit); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if _, err := fmt.Sscanf(offsetStr, "%d", &filters.Offset); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset"})
+			return
+		}
+	}
+
+	page, err := h.userService.ListReports(ctx, filters)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to list reports")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve reports"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// ReviewReport handles PATCH /admin/reports/:reportId
+func (h *Handler) ReviewReport(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	reportID := c.Param("reportId")
+
+	var req models.ReviewReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("adminId", adminID).Str("reportId", reportID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	report, err := h.userService.ReviewReport(ctx, adminID, reportID, req.Status)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Report not found"})
+		default:
+			logger.Error().Err(err).Msg("Failed to review report")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to review report"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// ForceCancelGame handles POST /admin/games/:gameId/cancel
+func (h *Handler) ForceCancelGame(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	gameID := c.Param("gameId")
+
+	var req models.ModerationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("adminId", adminID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	result, err := h.gamesService.ForceCancelGame(ctx, gameID, adminID, req.Reason)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+		case errors.Is(err, service.ErrGameFinished):
+			c.JSON(http.StatusConflict, gin.H{"error": "Cannot cancel a game that has already finished"})
+		default:
+			logger.Error().Err(err).Msg("Failed to force-cancel game")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to cancel game"})
+		}
+		return
+	}
+
+	logger.Info().Int("participantCount", len(result.ParticipantsToNotify)).Msg("Game force-cancelled")
+
+	c.JSON(http.StatusOK, gin.H{"message": "Game cancelled successfully"})
+}
+
+// RemoveParticipant handles DELETE /admin/games/:gameId/participants/:userId
+func (h *Handler) RemoveParticipant(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	gameID := c.Param("gameId")
+	userID := c.Param("userId")
+
+	var req models.ModerationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("adminId", adminID).Str("gameId", gameID).Str("playerId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.RemoveParticipant(ctx, gameID, adminID, userID, req.Reason)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Game not found"})
+		case errors.Is(err, service.ErrNotParticipant):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User is not a participant of this game"})
+		case errors.Is(err, service.ErrGameNotEditable):
+			c.JSON(http.StatusConflict, gin.H{"error": "Cannot change the roster of a cancelled or completed game"})
+		default:
+			logger.Error().Err(err).Msg("Failed to remove participant")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove participant"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// SuspendUser handles POST /admin/users/:userId/suspension
+func (h *Handler) SuspendUser(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	userID := c.Param("userId")
+
+	var req models.ModerationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("adminId", adminID).Str("suspendedUserId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	suspension, err := h.userService.SuspendUser(ctx, adminID, userID, req.Reason)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+		case errors.Is(err, service.ErrCannotSuspendSelf):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "You can't suspend your own account"})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			logger.Error().Err(err).Msg("Failed to suspend user")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to suspend user"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, suspension)
+}
+
+// UnsuspendUser handles DELETE /admin/users/:userId/suspension
+func (h *Handler) UnsuspendUser(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	userID := c.Param("userId")
+
+	logger = logger.With().Str("adminId", adminID).Str("suspendedUserId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.userService.UnsuspendUser(ctx, adminID, userID); err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User is not suspended"})
+		default:
+			logger.Error().Err(err).Msg("Failed to unsuspend user")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to lift suspension"})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ShadowBanUser handles POST /admin/users/:userId/shadow-ban
+func (h *Handler) ShadowBanUser(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	userID := c.Param("userId")
+
+	var req models.ModerationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("adminId", adminID).Str("bannedUserId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	ban, err := h.userService.ShadowBanUser(ctx, adminID, userID, req.Reason)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+		case errors.Is(err, service.ErrCannotSuspendSelf):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "You can't shadow-ban your own account"})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			logger.Error().Err(err).Msg("Failed to shadow-ban user")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to shadow-ban user"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, ban)
+}
+
+// LiftShadowBan handles DELETE /admin/users/:userId/shadow-ban
+func (h *Handler) LiftShadowBan(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	adminID := authenticatedUserID(c)
+	userID := c.Param("userId")
+
+	logger = logger.With().Str("adminId", adminID).Str("bannedUserId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.userService.LiftShadowBan(ctx, adminID, userID); err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User is not shadow-banned"})
+		default:
+			logger.Error().Err(err).Msg("Failed to lift shadow ban")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to lift shadow ban"})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListAdminAudit handles GET /admin/audit
+// Query parameters: actorId, targetId, action, limit, offset.
+func (h *Handler) ListAdminAudit(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var filters service.ListAdminAuditFilters
+	if actorID := c.Query("actorId"); actorID != "" {
+		filters.ActorID = &actorID
+	}
+	if targetID := c.Query("targetId"); targetID != "" {
+		filters.TargetID = &targetID
+	}
+	if actionStr := c.Query("action"); actionStr != "" {
+		action := models.AdminAction(actionStr)
+		switch action {
+		case models.AdminActionSuspendUser, models.AdminActionUnsuspendUser, models.AdminActionShadowBanUser,
+			models.AdminActionLiftShadowBan, models.AdminActionCancelGame, models.AdminActionRemoveParticipant,
+			models.AdminActionReviewReport, models.AdminActionRequeueDeadLetter:
+			filters.Action = &action
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid action (must be: suspend_user, unsuspend_user, shadow_ban_user, lift_shadow_ban, cancel_game, remove_participant, review_report, or requeue_dead_letter)"})
+			return
+		}
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if _, err := fmt.Sscanf(limitStr, "%d", &filters.Limit); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if _, err := fmt.Sscanf(offsetStr, "%d", &filters.Offset); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset"})
+			return
+		}
+	}
+
+	page, err := h.userService.ListAdminAudit(ctx, filters)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to list admin audit")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve audit trail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// BeginPasskeyRegistration handles POST /auth/webauthn/register/begin
+func (h *Handler) BeginPasskeyRegistration(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	options, err := h.userService.BeginPasskeyRegistration(ctx, userID)
+	if err != nil {
+		writePasskeyError(c, logger, err, "Failed to start passkey registration")
+		return
+	}
+
+	c.JSON(http.StatusOK, options)
+}
+
+// FinishPasskeyRegistration handles POST /auth/webauthn/register/finish
+func (h *Handler) FinishPasskeyRegistration(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.PasskeyRegistrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	passkey, err := h.userService.FinishPasskeyRegistration(ctx, userID, req)
+	if err != nil {
+		writePasskeyError(c, logger, err, "Failed to register passkey")
+		return
+	}
+
+	c.JSON(http.StatusCreated, passkey)
+}
+
+// BeginPasskeyLogin handles POST /auth/webauthn/login/begin
+func (h *Handler) BeginPasskeyLogin(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	options, err := h.userService.BeginPasskeyLogin(ctx)
+	if err != nil {
+		writePasskeyError(c, logger, err, "Failed to start passkey sign-in")
+		return
+	}
+
+	c.JSON(http.StatusOK, options)
+}
+
+// FinishPasskeyLogin handles POST /auth/webauthn/login/finish - signs in with a passkey assertion
+func (h *Handler) FinishPasskeyLogin(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var req models.PasskeyLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.userService.FinishPasskeyLogin(ctx, req)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidPasskey) {
+			logger.Warn().Err(err).Msg("Invalid passkey assertion")
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Passkey sign-in failed"})
+			return
+		}
+		if errors.Is(err, service.ErrAccountDeactivated) {
+			c.JSON(http.StatusForbidden, gin.H{"error": deactivatedAccountMessage})
+			return
+		}
+		writePasskeyError(c, logger, err, "Failed to sign in with passkey")
+		return
+	}
+
+	logger.Info().Str("userID", user.ID).Msg("User logged in with passkey")
+	h.startSession(c, user)
+}
+
+func writePasskeyError(c *gin.Context, logger zerolog.Logger, err error, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+	case errors.Is(err, service.ErrInvalidPasskey):
+		logger.Warn().Err(err).Msg("Invalid passkey response")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired passkey response"})
+	case errors.Is(err, apperrors.ErrAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "This passkey is already registered"})
+	case errors.Is(err, apperrors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// AddPlaceholder handles POST /games/:gameId/placeholders
+func (h *Handler) AddPlaceholder(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.AddPlaceholderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.AddPlaceholder(ctx, gameID, userID, req.Name)
+	if err != nil {
+		writePlaceholderError(c, logger, err, "Failed to add placeholder")
+		return
+	}
+
+	c.JSON(http.StatusCreated, game)
+}
+
+// RemovePlaceholder handles DELETE /games/:gameId/placeholders/:placeholderId
+func (h *Handler) RemovePlaceholder(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	placeholderID := c.Param("placeholderId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("placeholderId", placeholderID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.RemovePlaceholder(ctx, gameID, userID, placeholderID)
+	if err != nil {
+		writePlaceholderError(c, logger, err, "Failed to remove placeholder")
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// LinkPlaceholder handles POST /games/:gameId/placeholders/:placeholderId/link
+func (h *Handler) LinkPlaceholder(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.LinkPlaceholderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	placeholderID := c.Param("placeholderId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("placeholderId", placeholderID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.LinkPlaceholder(ctx, gameID, userID, placeholderID, req)
+	if err != nil {
+		writePlaceholderError(c, logger, err, "Failed to link placeholder")
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// writePlaceholderError maps placeholder service errors to responses
+func writePlaceholderError(c *gin.Context, logger zerolog.Logger, err error, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.Is(err, apperrors.ErrNotFound):
+		logger.Warn().Err(err).Msg("Game, placeholder or account not found")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, service.ErrNotOwner):
+		logger.Warn().Err(err).Msg("User is not the game owner")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can manage placeholders"})
+	case errors.Is(err, service.ErrGameNotEditable):
+		c.JSON(http.StatusConflict, gin.H{"error": "Cancelled or completed games cannot be edited"})
+	case errors.Is(err, service.ErrAlreadyParticipant):
+		c.JSON(http.StatusConflict, gin.H{"error": "That user is already participating in this game"})
+	case errors.Is(err, service.ErrAgeRestricted):
+		c.JSON(http.StatusForbidden, gin.H{"error": "This game is restricted to adults"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
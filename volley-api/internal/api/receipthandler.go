@@ -0,0 +1,85 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// GetReceipt handles GET /users/me/participation-history/:gameId/receipt
+// Query parameters: format (json or pdf, default json).
+func (h *Handler) GetReceipt(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	format := models.ReceiptFormat(c.DefaultQuery("format", string(models.ReceiptFormatJSON)))
+	if format != models.ReceiptFormatJSON && format != models.ReceiptFormatPDF {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format (must be: json or pdf)"})
+		return
+	}
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	receipt, err := h.gamesService.Receipt(ctx, gameID, userID)
+	if err != nil {
+		writeReceiptError(c, logger, err, "Failed to get receipt")
+		return
+	}
+
+	if format == models.ReceiptFormatJSON {
+		c.JSON(http.StatusOK, receipt)
+		return
+	}
+
+	c.Header("Content-Type", "application/pdf")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="volley-receipt-%s.pdf"`, receipt.Number))
+	c.Status(http.StatusOK)
+	if err := writeReceiptPDF(c.Writer, receipt); err != nil {
+		logger.Error().Err(err).Msg("Failed to write receipt PDF")
+	}
+}
+
+// EmailReceipt handles POST /users/me/participation-history/:gameId/receipt/email
+func (h *Handler) EmailReceipt(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.gamesService.EmailReceipt(ctx, gameID, userID); err != nil {
+		writeReceiptError(c, logger, err, "Failed to email receipt")
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// writeReceiptError maps receipt service errors to responses
+func writeReceiptError(c *gin.Context, logger zerolog.Logger, err error, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.Is(err, apperrors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "You did not sign up for this game"})
+	case errors.Is(err, service.ErrReceiptNotAvailable):
+		c.JSON(http.StatusConflict, gin.H{"error": "Receipts are available for paid games once they have ended"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
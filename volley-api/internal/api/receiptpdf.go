@@ -0,0 +1,91 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+)
+
+// writeReceiptPDF renders a receipt as a single-page PDF using only the standard Helvetica font,
+// which every PDF reader has, so no font needs to be embedded
+func writeReceiptPDF(w io.Writer, receipt *models.Receipt) error {
+	title := string(receipt.Category)
+	if receipt.GameTitle != nil {
+		title = *receipt.GameTitle
+	}
+	venue := receipt.Venue.Name
+	if receipt.Venue.Address != nil {
+		venue += ", " + *receipt.Venue.Address
+	}
+	amount := fmt.Sprintf("%d.%02d %s", receipt.AmountCents/100, receipt.AmountCents%100, receipt.Currency)
+	if receipt.AmountEstimated {
+		amount += " (estimated)"
+	}
+
+	lines := [][2]string{
+		{"Receipt", receipt.Number},
+		{"Issued", receipt.IssuedAt.Format("2006-01-02 15:04 MST")},
+		{"Game", title},
+		{"Date", receipt.StartTime.Format("Mon, 02 Jan 2006 15:04 MST")},
+		{"Duration", fmt.Sprintf("%d minutes", receipt.DurationMinutes)},
+		{"Venue", venue},
+		{"Organizer", receipt.Organizer},
+		{"Amount paid", amount},
+	}
+
+	var content bytes.Buffer
+	content.WriteString("BT /F1 20 Tf 72 760 Td (Volley receipt) Tj ET\n")
+	for i, line := range lines {
+		y := 720 - i*24
+		fmt.Fprintf(&content, "BT /F1 11 Tf 72 %d Td (%s) Tj ET\n", y, pdfString(line[0]))
+		fmt.Fprintf(&content, "BT /F1 11 Tf 180 %d Td (%s) Tj ET\n", y, pdfString(line[1]))
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+	}
+
+	var doc bytes.Buffer
+	doc.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = doc.Len()
+		fmt.Fprintf(&doc, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := doc.Len()
+	fmt.Fprintf(&doc, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&doc, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&doc, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	_, err := w.Write(doc.Bytes())
+	return err
+}
+
+// pdfString escapes text for a PDF literal string. Helvetica's standard encoding only covers
+// Latin-1, so other characters are replaced.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0xff:
+			b.WriteByte('?')
+		case r >= 0x80:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReceiptPDF(t *testing.T) {
+	title := "Pickup (beach)"
+	receipt := &models.Receipt{
+		Number:          "550e8400-e29b-41d4-a716-446655440000",
+		GameTitle:       &title,
+		Category:        models.GameCategoryVolleyball,
+		Venue:           models.ReceiptVenue{Name: "Café Courts"},
+		StartTime:       time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC),
+		DurationMinutes: 90,
+		Organizer:       "Ana Díaz",
+		AmountCents:     1250,
+		Currency:        "USD",
+		IssuedAt:        time.Date(2026, 6, 2, 9, 0, 0, 0, time.UTC),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeReceiptPDF(&buf, receipt))
+	doc := buf.String()
+
+	assert.True(t, strings.HasPrefix(doc, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(doc, "%%EOF\n"))
+	assert.Contains(t, doc, `(Pickup \(beach\))`)
+	assert.Contains(t, doc, `(12.50 USD)`)
+	assert.Contains(t, doc, `(Ana D\355az)`)
+
+	// startxref must point at the cross-reference table
+	var xref int
+	_, err := fmt.Sscanf(doc[strings.LastIndex(doc, "startxref\n"):], "startxref\n%d", &xref)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(doc[xref:], "xref\n"))
+}
+
+func TestPDFString(t *testing.T) {
+	assert.Equal(t, `a\\b \(c\)`, pdfString(`a\b (c)`))
+	assert.Equal(t, `caf\351 ?`, pdfString("café 😀"))
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ForwardedRegionHeader is set on writes proxied to a user's home region, naming the region that
+// forwarded them. A request that arrives with it is never forwarded again, so misconfigured peers
+// can't bounce a write back and forth.
+const ForwardedRegionHeader = "X-Volley-Forwarded-Region"
+
+// RegionConfig describes this deployment's region and how to reach the others
+type RegionConfig struct {
+	Local string              // Region this deployment serves
+	Peers map[string]*url.URL // Base URL of each other region's API, keyed by region
+}
+
+// ParseRegionPeers parses VOLLEY_REGION_PEERS, a JSON object mapping region names to API base
+// URLs, e.g. {"eu-west": "https://eu.api.volley.app"}
+func ParseRegionPeers(raw string) (map[string]*url.URL, error) {
+	var rawPeers map[string]string
+	if err := json.Unmarshal([]byte(raw), &rawPeers); err != nil {
+		return nil, fmt.Errorf("invalid region peers: %w", err)
+	}
+
+	peers := make(map[string]*url.URL, len(rawPeers))
+	for region, rawURL := range rawPeers {
+		peer, err := url.Parse(rawURL)
+		if err != nil || peer.Scheme == "" || peer.Host == "" {
+			return nil, fmt.Errorf("region %q: invalid peer URL %q", region, rawURL)
+		}
+		peers[region] = peer
+	}
+	return peers, nil
+}
+
+// RegionMiddleware keeps writes in the region that homes the user's data. Writes from a user homed
+// elsewhere are proxied to that region when it is a configured peer and rejected with 421
+// Misdirected Request otherwise, so the client can retry against its home region. Reads are
+// always served locally. Must run after AuthMiddleware.
+func RegionMiddleware(config RegionConfig) gin.HandlerFunc {
+	proxies := make(map[string]*httputil.ReverseProxy, len(config.Peers))
+	for region, peer := range config.Peers {
+		proxies[region] = httputil.NewSingleHostReverseProxy(peer)
+	}
+
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		// Tokens issued before regions existed carry no home region; their users live in the primary
+		homeRegion := c.GetString("homeRegion")
+		if homeRegion == "" || homeRegion == config.Local {
+			c.Next()
+			return
+		}
+
+		logger := LoggerFromContext(c)
+		proxy, ok := proxies[homeRegion]
+		if !ok || c.GetHeader(ForwardedRegionHeader) != "" {
+			logger.Warn().
+				Str("homeRegion", homeRegion).
+				Str("localRegion", config.Local).
+				Msg("Rejected cross-region write")
+			c.AbortWithStatusJSON(http.StatusMisdirectedRequest, gin.H{
+				"error":      "This account is served from another region",
+				"homeRegion": homeRegion,
+			})
+			return
+		}
+
+		logger.Info().
+			Str("homeRegion", homeRegion).
+			Str("localRegion", config.Local).
+			Msg("Proxying cross-region write to home region")
+		c.Request.Header.Set(ForwardedRegionHeader, config.Local)
+		proxy.ServeHTTP(c.Writer, c.Request)
+		c.Abort()
+	}
+}
+
+// HomeRegionMiddleware returns the region routing middleware, or a no-op when the deployment
+// has no region configuration
+func (h *Handler) HomeRegionMiddleware() gin.HandlerFunc {
+	if h.region == nil {
+		return func(c *gin.Context) { c.Next() }
+	}
+	return RegionMiddleware(*h.region)
+}
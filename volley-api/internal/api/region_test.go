@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegionPeers(t *testing.T) {
+	peers, err := ParseRegionPeers(`{"eu-west": "https://eu.example.com"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "eu.example.com", peers["eu-west"].Host)
+
+	for _, raw := range []string{"", `["eu-west"]`, `{"eu-west": "eu.example.com"}`} {
+		_, err := ParseRegionPeers(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestRegionMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var forwardedFrom string
+	peer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwardedFrom = r.Header.Get(ForwardedRegionHeader)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer peer.Close()
+	peerURL, err := url.Parse(peer.URL)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("homeRegion", c.GetHeader("Test-Home-Region"))
+	})
+	router.Use(RegionMiddleware(RegionConfig{
+		Local: "us-east",
+		Peers: map[string]*url.URL{"eu-west": peerURL},
+	}))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/v1/games", ok)
+	router.POST("/v1/games", ok)
+	// A real server rather than a recorder: the reverse proxy needs a connection-backed writer
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	cases := []struct {
+		name       string
+		method     string
+		homeRegion string
+		forwarded  string
+		status     int
+	}{
+		{"Reads are served locally", http.MethodGet, "eu-west", "", http.StatusOK},
+		{"Local users write locally", http.MethodPost, "us-east", "", http.StatusOK},
+		{"Tokens without a region write locally", http.MethodPost, "", "", http.StatusOK},
+		{"Writes are proxied to a known home region", http.MethodPost, "eu-west", "", http.StatusCreated},
+		{"Writes for an unknown region are rejected", http.MethodPost, "ap-south", "", http.StatusMisdirectedRequest},
+		{"Forwarded writes are never forwarded again", http.MethodPost, "eu-west", "ap-south", http.StatusMisdirectedRequest},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			forwardedFrom = ""
+			req, err := http.NewRequest(tc.method, server.URL+"/v1/games", nil)
+			require.NoError(t, err)
+			req.Header.Set("Test-Home-Region", tc.homeRegion)
+			if tc.forwarded != "" {
+				req.Header.Set(ForwardedRegionHeader, tc.forwarded)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, tc.status, resp.StatusCode)
+			if tc.status == http.StatusCreated {
+				assert.Equal(t, "us-east", forwardedFrom)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateReport handles POST /reports
+func (h *Handler) CreateReport(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.CreateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	report, err := h.userService.CreateReport(ctx, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "The reported " + string(req.TargetType) + " was not found"})
+		case errors.Is(err, apperrors.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "You have already reported this " + string(req.TargetType)})
+		case errors.Is(err, apperrors.ErrRateLimited):
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "You have filed too many reports today, please try again later"})
+		default:
+			logger.Error().Err(err).Msg("Failed to create report")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to file report"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
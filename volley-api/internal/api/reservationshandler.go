@@ -0,0 +1,100 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// ReserveSpots handles POST /games/:gameId/reservations
+func (h *Handler) ReserveSpots(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.ReserveSpotsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (userIds and expiresAt are required)"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	reservations, err := h.gamesService.ReserveSpots(ctx, gameID, userID, req)
+	if err != nil {
+		writeReservationError(c, logger, err, "Failed to reserve spots")
+		return
+	}
+
+	c.JSON(http.StatusOK, reservations)
+}
+
+// ListReservations handles GET /games/:gameId/reservations
+func (h *Handler) ListReservations(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	reservations, err := h.gamesService.ListReservations(ctx, gameID, userID)
+	if err != nil {
+		writeReservationError(c, logger, err, "Failed to list reservations")
+		return
+	}
+
+	c.JSON(http.StatusOK, reservations)
+}
+
+// CancelReservation handles DELETE /games/:gameId/reservations/:userId
+func (h *Handler) CancelReservation(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	reservedUserID := c.Param("userId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("reservedUserId", reservedUserID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	reservations, err := h.gamesService.CancelReservation(ctx, gameID, userID, reservedUserID)
+	if err != nil {
+		writeReservationError(c, logger, err, "Failed to cancel reservation")
+		return
+	}
+
+	c.JSON(http.StatusOK, reservations)
+}
+
+// writeReservationError maps reservation service errors to responses
+func writeReservationError(c *gin.Context, logger zerolog.Logger, err error, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.Is(err, apperrors.ErrNotFound):
+		logger.Warn().Err(err).Msg("Game or reservation not found")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, service.ErrNotOwner):
+		logger.Warn().Err(err).Msg("User is not the game owner")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can manage reservations"})
+	case errors.Is(err, service.ErrGameNotEditable):
+		c.JSON(http.StatusConflict, gin.H{"error": "Cancelled or completed games cannot be edited"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
@@ -1,37 +1,177 @@
 package api
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 )
 
+// Routes is the route table. Each endpoint declares its authorization policy here rather than in
+// the handler; GET /v1/admin/routes serves this table for auditing.
+func (h *Handler) Routes() []Route {
+	return []Route{
+		// Auth
+		{Method: http.MethodPost, Path: "/v1/auth/register", Auth: AuthPublic, Handler: h.Register},
+		{Method: http.MethodPost, Path: "/v1/auth/login", Auth: AuthPublic, Handler: h.Login},
+		{Method: http.MethodPost, Path: "/v1/auth/refresh", Auth: AuthPublic, Handler: h.RefreshToken},
+		{Method: http.MethodPost, Path: "/v1/auth/logout-all", Auth: AuthUser, Handler: h.SignOutEverywhere},
+		{Method: http.MethodPost, Path: "/v1/auth/magic-link", Auth: AuthPublic, Handler: h.RequestMagicLink},
+		{Method: http.MethodPost, Path: "/v1/auth/magic-link/redeem", Auth: AuthPublic, Handler: h.RedeemMagicLink},
+		{Method: http.MethodPost, Path: "/v1/auth/otp/request", Auth: AuthPublic, Handler: h.RequestLoginCode},
+		{Method: http.MethodPost, Path: "/v1/auth/otp/verify", Auth: AuthPublic, Handler: h.VerifyLoginCode},
+		{Method: http.MethodPost, Path: "/v1/auth/webauthn/register/begin", Auth: AuthUser, Handler: h.BeginPasskeyRegistration},
+		{Method: http.MethodPost, Path: "/v1/auth/webauthn/register/finish", Auth: AuthUser, Handler: h.FinishPasskeyRegistration},
+		{Method: http.MethodPost, Path: "/v1/auth/webauthn/login/begin", Auth: AuthPublic, Handler: h.BeginPasskeyLogin},
+		{Method: http.MethodPost, Path: "/v1/auth/webauthn/login/finish", Auth: AuthPublic, Handler: h.FinishPasskeyLogin},
+		{Method: http.MethodPost, Path: "/v1/auth/email-change/confirm", Auth: AuthPublic, Handler: h.ConfirmEmailChange},
+		{Method: http.MethodPost, Path: "/v1/auth/reactivate", Auth: AuthPublic, Handler: h.ReactivateAccount},
+		{Method: http.MethodGet, Path: "/.well-known/jwks.json", Auth: AuthPublic, Handler: h.GetJWKS},
+		{Method: http.MethodPost, Path: "/v1/unsubscribe", Auth: AuthPublic, Handler: h.Unsubscribe},
+
+		// Games
+		{Method: http.MethodGet, Path: "/v1/games", Auth: AuthOptional, Handler: h.ListGames},
+		{Method: http.MethodPost, Path: "/v1/games", Auth: AuthUser, LegalAcceptance: true, Handler: h.CreateGame},
+		{Method: http.MethodPost, Path: "/v1/games/import", Auth: AuthUser, LegalAcceptance: true, Handler: h.ImportGames},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId", Auth: AuthUser, Handler: h.GetGame},
+		{Method: http.MethodPatch, Path: "/v1/games/:gameId", Auth: AuthGameOwner, LegalAcceptance: true, Handler: h.UpdateGame},
+		{Method: http.MethodDelete, Path: "/v1/games/:gameId", Auth: AuthGameOwner, LegalAcceptance: true, Handler: h.DeleteGame},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/participation", Auth: AuthUser, LegalAcceptance: true, Handler: h.JoinGame},
+		{Method: http.MethodDelete, Path: "/v1/games/:gameId/participation", Auth: AuthUser, LegalAcceptance: true, Handler: h.DropGame},
+		{Method: http.MethodPatch, Path: "/v1/games/:gameId/participation/notifications", Auth: AuthUser, LegalAcceptance: true, Handler: h.UpdateGameNotificationSettings},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/checkin", Auth: AuthUser, LegalAcceptance: true, Handler: h.CheckIn},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/cancel", Auth: AuthGameOwner, LegalAcceptance: true, Handler: h.CancelGame},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/changes", Auth: AuthUser, Handler: h.ListGameChanges},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/events", Auth: AuthUser, Handler: h.GameEvents},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/announcements", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.PostAnnouncement},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/chat", Auth: AuthUser, Handler: h.ListChatMessages},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/chat/ws", Auth: AuthUser, LegalAcceptance: true, Handler: h.GameChat},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/comments", Auth: AuthUser, Handler: h.ListComments},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/comments", Auth: AuthUser, LegalAcceptance: true, Handler: h.PostComment},
+		{Method: http.MethodPatch, Path: "/v1/games/:gameId/comments/:commentId", Auth: AuthUser, LegalAcceptance: true, Handler: h.EditComment},
+		{Method: http.MethodDelete, Path: "/v1/games/:gameId/comments/:commentId", Auth: AuthUser, LegalAcceptance: true, Handler: h.DeleteComment},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/participants", Auth: AuthUser, Handler: h.ListParticipants},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/participants/:userId/attendance", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.MarkAttendance},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/participants/:userId/payment", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.MarkPayment},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/participants/:userId/corrections", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.CorrectParticipation},
+		{Method: http.MethodPut, Path: "/v1/games/:gameId/participants/:userId/endorsement", Auth: AuthUser, LegalAcceptance: true, Handler: h.EndorseSkill},
+		{Method: http.MethodPut, Path: "/v1/games/:gameId/mvp", Auth: AuthGameOwner, LegalAcceptance: true, Handler: h.NameMVP},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/corrections", Auth: AuthCoOrganizer, Handler: h.ListCorrections},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/roster-snapshot", Auth: AuthUser, Handler: h.GetRosterSnapshot},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/participants/status", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.BulkUpdateParticipants},
+		{Method: http.MethodPut, Path: "/v1/games/:gameId/waitlist/order", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.ReorderWaitlist},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/teams", Auth: AuthUser, Handler: h.ListTeams},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/teams", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.CreateTeam},
+		{Method: http.MethodPatch, Path: "/v1/games/:gameId/teams/:teamId", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.UpdateTeam},
+		{Method: http.MethodDelete, Path: "/v1/games/:gameId/teams/:teamId", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.DeleteTeam},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/teams/:teamId/players", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.AssignTeamPlayers},
+		{Method: http.MethodDelete, Path: "/v1/games/:gameId/teams/:teamId/players/:userId", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.UnassignTeamPlayer},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/reservations", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.ReserveSpots},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/reservations", Auth: AuthCoOrganizer, Handler: h.ListReservations},
+		{Method: http.MethodDelete, Path: "/v1/games/:gameId/reservations/:userId", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.CancelReservation},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/placeholders", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.AddPlaceholder},
+		{Method: http.MethodDelete, Path: "/v1/games/:gameId/placeholders/:placeholderId", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.RemovePlaceholder},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/placeholders/:placeholderId/link", Auth: AuthCoOrganizer, LegalAcceptance: true, Handler: h.LinkPlaceholder},
+		{Method: http.MethodPost, Path: "/v1/games/:gameId/contact-sharing", Auth: AuthGameOwner, LegalAcceptance: true, Handler: h.RequestContactSharing},
+		{Method: http.MethodGet, Path: "/v1/games/:gameId/contact-sharing", Auth: AuthGameOwner, Handler: h.ListSharedContacts},
+		{Method: http.MethodPut, Path: "/v1/games/:gameId/contact-sharing/consent", Auth: AuthUser, LegalAcceptance: true, Handler: h.ConsentToContactSharing},
+		{Method: http.MethodDelete, Path: "/v1/games/:gameId/contact-sharing/consent", Auth: AuthUser, LegalAcceptance: true, Handler: h.RevokeContactSharingConsent},
+
+		// Users
+		{Method: http.MethodPost, Path: "/v1/users/me/phone", Auth: AuthUser, LegalAcceptance: true, Handler: h.StartPhoneVerification},
+		{Method: http.MethodPost, Path: "/v1/users/me/phone/verify", Auth: AuthUser, LegalAcceptance: true, Handler: h.VerifyPhone},
+		{Method: http.MethodPost, Path: "/v1/users/me/email", Auth: AuthUser, LegalAcceptance: true, Handler: h.RequestEmailChange},
+		{Method: http.MethodPost, Path: "/v1/users/me/password", Auth: AuthUser, Handler: h.ChangePassword},
+		{Method: http.MethodGet, Path: "/v1/users/me/legal-acceptances", Auth: AuthUser, Handler: h.ListLegalAcceptances},
+		{Method: http.MethodPost, Path: "/v1/users/me/legal-acceptances", Auth: AuthUser, Handler: h.AcceptLegalDocuments},
+		{Method: http.MethodGet, Path: "/v1/users/me/dashboard", Auth: AuthUser, Handler: h.PlayerDashboard},
+		{Method: http.MethodGet, Path: "/v1/users/me/organizer-dashboard", Auth: AuthUser, Handler: h.OrganizerDashboard},
+		{Method: http.MethodGet, Path: "/v1/users/me/stats", Auth: AuthUser, Handler: h.GetMyStats},
+		{Method: http.MethodGet, Path: "/v1/users/me/sport-preferences", Auth: AuthUser, Handler: h.GetSportPreferences},
+		{Method: http.MethodPut, Path: "/v1/users/me/sport-preferences", Auth: AuthUser, LegalAcceptance: true, Handler: h.SetSportPreferences},
+		{Method: http.MethodGet, Path: "/v1/users/me/notification-preferences", Auth: AuthUser, Handler: h.GetNotificationPreferences},
+		{Method: http.MethodPatch, Path: "/v1/users/me/notification-preferences", Auth: AuthUser, LegalAcceptance: true, Handler: h.UpdateNotificationPreferences},
+		{Method: http.MethodGet, Path: "/v1/users/me/webhooks", Auth: AuthUser, Handler: h.ListWebhooks},
+		{Method: http.MethodPost, Path: "/v1/users/me/webhooks", Auth: AuthUser, LegalAcceptance: true, Handler: h.CreateWebhook},
+		{Method: http.MethodDelete, Path: "/v1/users/me/webhooks/:webhookId", Auth: AuthUser, Handler: h.DeleteWebhook},
+		{Method: http.MethodGet, Path: "/v1/users/me/saved-searches", Auth: AuthUser, Handler: h.ListSavedSearches},
+		{Method: http.MethodPost, Path: "/v1/users/me/saved-searches", Auth: AuthUser, LegalAcceptance: true, Handler: h.CreateSavedSearch},
+		{Method: http.MethodPatch, Path: "/v1/users/me/saved-searches/:savedSearchId", Auth: AuthUser, LegalAcceptance: true, Handler: h.UpdateSavedSearch},
+		{Method: http.MethodDelete, Path: "/v1/users/me/saved-searches/:savedSearchId", Auth: AuthUser, Handler: h.DeleteSavedSearch},
+		{Method: http.MethodGet, Path: "/v1/users/me/settings", Auth: AuthUser, Handler: h.GetSettings},
+		{Method: http.MethodPatch, Path: "/v1/users/me/settings", Auth: AuthUser, LegalAcceptance: true, Handler: h.UpdateSettings},
+		{Method: http.MethodGet, Path: "/v1/users/me/onboarding", Auth: AuthUser, Handler: h.GetOnboarding},
+		{Method: http.MethodPut, Path: "/v1/users/me/onboarding", Auth: AuthUser, LegalAcceptance: true, Handler: h.SaveOnboarding},
+		{Method: http.MethodGet, Path: "/v1/users/me/referrals", Auth: AuthUser, Handler: h.GetReferrals},
+		{Method: http.MethodGet, Path: "/v1/users/me/wallet", Auth: AuthUser, Handler: h.GetWallet},
+		{Method: http.MethodPost, Path: "/v1/users/me/deactivate", Auth: AuthUser, Handler: h.DeactivateAccount},
+		{Method: http.MethodGet, Path: "/v1/users/me/participation-history", Auth: AuthUser, Handler: h.ListParticipationHistory},
+		{Method: http.MethodGet, Path: "/v1/users/me/participation-history/:gameId/receipt", Auth: AuthUser, Handler: h.GetReceipt},
+		{Method: http.MethodPost, Path: "/v1/users/me/participation-history/:gameId/receipt/email", Auth: AuthUser, LegalAcceptance: true, Handler: h.EmailReceipt},
+		{Method: http.MethodGet, Path: "/v1/users/me/blocked-players", Auth: AuthUser, Handler: h.ListBlockedPlayers},
+		{Method: http.MethodPost, Path: "/v1/users/me/blocked-players", Auth: AuthUser, LegalAcceptance: true, Handler: h.BlockPlayer},
+		{Method: http.MethodDelete, Path: "/v1/users/me/blocked-players/:userId", Auth: AuthUser, LegalAcceptance: true, Handler: h.UnblockPlayer},
+		{Method: http.MethodGet, Path: "/v1/users/me/blocks", Auth: AuthUser, Handler: h.ListBlockedPlayers},
+		{Method: http.MethodPost, Path: "/v1/users/me/blocks/:userId", Auth: AuthUser, LegalAcceptance: true, Handler: h.BlockUser},
+		{Method: http.MethodDelete, Path: "/v1/users/me/blocks/:userId", Auth: AuthUser, LegalAcceptance: true, Handler: h.UnblockPlayer},
+		{Method: http.MethodGet, Path: "/v1/users/:userId/profile", Auth: AuthUser, Handler: h.GetPlayerProfile},
+
+		// Direct messages between hosts and players
+		{Method: http.MethodGet, Path: "/v1/conversations", Auth: AuthUser, Handler: h.ListConversations},
+		{Method: http.MethodPost, Path: "/v1/conversations", Auth: AuthUser, LegalAcceptance: true, Handler: h.StartConversation},
+		{Method: http.MethodGet, Path: "/v1/conversations/:conversationId/messages", Auth: AuthUser, Handler: h.ListMessages},
+		{Method: http.MethodPost, Path: "/v1/conversations/:conversationId/messages", Auth: AuthUser, LegalAcceptance: true, Handler: h.SendMessage},
+
+		// Leaderboards
+		{Method: http.MethodGet, Path: "/v1/leaderboards", Auth: AuthUser, Handler: h.GetLeaderboard},
+
+		// Reports for admin review
+		{Method: http.MethodPost, Path: "/v1/reports", Auth: AuthUser, LegalAcceptance: true, Handler: h.CreateReport},
+
+		// Legal documents and localized enum display metadata
+		{Method: http.MethodGet, Path: "/v1/legal/documents", Auth: AuthPublic, Handler: h.ListLegalDocuments},
+		{Method: http.MethodGet, Path: "/v1/metadata", Auth: AuthPublic, Handler: h.GetMetadata},
+
+		// Admin
+		{Method: http.MethodGet, Path: "/v1/admin/exports/games", Auth: AuthAdmin, Handler: h.ExportGames},
+		{Method: http.MethodGet, Path: "/v1/admin/games/:gameId/journal", Auth: AuthAdmin, Handler: h.ListParticipationJournal},
+		{Method: http.MethodGet, Path: "/v1/admin/slo", Auth: AuthAdmin, Handler: h.GetSLOStatus},
+		{Method: http.MethodGet, Path: "/v1/admin/routes", Auth: AuthAdmin, Handler: h.ListRoutes},
+		{Method: http.MethodGet, Path: "/v1/admin/reports", Auth: AuthAdmin, Handler: h.ListReports},
+		{Method: http.MethodPatch, Path: "/v1/admin/reports/:reportId", Auth: AuthAdmin, Handler: h.ReviewReport},
+		{Method: http.MethodPost, Path: "/v1/admin/games/:gameId/cancel", Auth: AuthAdmin, Handler: h.ForceCancelGame},
+		{Method: http.MethodDelete, Path: "/v1/admin/games/:gameId/participants/:userId", Auth: AuthAdmin, Handler: h.RemoveParticipant},
+		{Method: http.MethodPost, Path: "/v1/admin/users/:userId/suspension", Auth: AuthAdmin, Handler: h.SuspendUser},
+		{Method: http.MethodDelete, Path: "/v1/admin/users/:userId/suspension", Auth: AuthAdmin, Handler: h.UnsuspendUser},
+		{Method: http.MethodPost, Path: "/v1/admin/users/:userId/shadow-ban", Auth: AuthAdmin, Handler: h.ShadowBanUser},
+		{Method: http.MethodDelete, Path: "/v1/admin/users/:userId/shadow-ban", Auth: AuthAdmin, Handler: h.LiftShadowBan},
+		{Method: http.MethodGet, Path: "/v1/admin/users/:userId/notification-deliveries", Auth: AuthAdmin, Handler: h.ListNotificationDeliveries},
+		{Method: http.MethodGet, Path: "/v1/admin/dead-letters", Auth: AuthAdmin, Handler: h.ListDeadLetters},
+		{Method: http.MethodPost, Path: "/v1/admin/dead-letters/:deadLetterId/requeue", Auth: AuthAdmin, Handler: h.RequeueDeadLetter},
+		{Method: http.MethodGet, Path: "/v1/admin/audit", Auth: AuthAdmin, Handler: h.ListAdminAudit},
+
+		// Places (Google Places API v1 proxy)
+		{Method: http.MethodPost, Path: "/v1/places/search", Auth: AuthUser, AnyRegion: true, Handler: h.PlacesAutocomplete},
+		{Method: http.MethodGet, Path: "/v1/places/:placeId", Auth: AuthUser, Handler: h.PlaceDetails},
+	}
+}
+
+// RegisterRoutes registers the route table, putting the middleware that enforces each route's
+// policy in front of its handler
 func (h *Handler) RegisterRoutes(r *gin.Engine) {
-	v1 := r.Group("/v1")
-	{
-		auth := v1.Group("/auth")
-		{
-			auth.POST("/register", h.Register)
-			auth.POST("/login", h.Login)
-			auth.POST("/refresh", h.RefreshToken)
-		}
-		// Games routes
-		games := v1.Group("/games")
-		{
-			games.GET("", OptionalAuthMiddleware(), h.ListGames)
-			games.POST("", AuthMiddleware(), h.CreateGame)
-			games.GET("/:gameId", AuthMiddleware(), h.GetGame)
-			games.PATCH("/:gameId", AuthMiddleware(), h.UpdateGame)
-			games.DELETE("/:gameId", AuthMiddleware(), h.DeleteGame)
-			games.POST("/:gameId/participation", AuthMiddleware(), h.JoinGame)
-			games.DELETE("/:gameId/participation", AuthMiddleware(), h.DropGame)
-			games.POST("/:gameId/cancel", AuthMiddleware(), h.CancelGame)
-		}
+	// Created once so every route shares the same instance
+	legalAccepted := h.LegalAcceptanceMiddleware()
+	homeRegion := h.HomeRegionMiddleware()
 
-		// Places routes (Google Places API v1 proxy)
-		places := v1.Group("/places")
-		places.Use(AuthMiddleware())
-		{
-			places.POST("/search", h.PlacesAutocomplete)
-			places.GET("/:placeId", h.PlaceDetails)
+	for _, route := range h.Routes() {
+		handlers := h.authMiddlewares(route.Auth)
+		// Writes are served by the region that homes the user's data
+		if route.Auth != AuthPublic && route.Auth != AuthOptional && route.Auth != AuthAdmin && !route.AnyRegion {
+			handlers = append(handlers, homeRegion)
+		}
+		if route.LegalAcceptance {
+			handlers = append(handlers, legalAccepted)
 		}
+		handlers = append(handlers, route.Handler)
+		r.Handle(route.Method, route.Path, handlers...)
 	}
 }
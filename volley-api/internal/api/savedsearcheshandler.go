@@ -0,0 +1,126 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateSavedSearch handles POST /users/me/saved-searches
+func (h *Handler) CreateSavedSearch(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	var req models.CreateSavedSearchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (name and filters with a latitude and longitude are required)"})
+		return
+	}
+
+	search, err := h.gamesService.CreateSavedSearch(ctx, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, service.ErrTooManySavedSearches):
+			c.JSON(http.StatusConflict, gin.H{"error": "Saved search limit reached - delete one before saving another"})
+		default:
+			logger.Error().Err(err).Msg("Failed to save search")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save search"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, search)
+}
+
+// ListSavedSearches handles GET /users/me/saved-searches
+func (h *Handler) ListSavedSearches(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	searches, err := h.gamesService.ListSavedSearches(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list saved searches")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list saved searches"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListSavedSearchesResponse{SavedSearches: searches})
+}
+
+// UpdateSavedSearch handles PATCH /users/me/saved-searches/:savedSearchId
+func (h *Handler) UpdateSavedSearch(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	savedSearchID := c.Param("savedSearchId")
+	logger = logger.With().Str("userId", userID).Str("savedSearchId", savedSearchID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	var req models.UpdateSavedSearchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (alerts is required)"})
+		return
+	}
+
+	search, err := h.gamesService.SetSavedSearchAlerts(ctx, userID, savedSearchID, *req.Alerts)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Saved search not found"})
+		default:
+			logger.Error().Err(err).Msg("Failed to update saved search")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update saved search"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, search)
+}
+
+// DeleteSavedSearch handles DELETE /users/me/saved-searches/:savedSearchId
+func (h *Handler) DeleteSavedSearch(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	savedSearchID := c.Param("savedSearchId")
+	logger = logger.With().Str("userId", userID).Str("savedSearchId", savedSearchID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.gamesService.DeleteSavedSearch(ctx, userID, savedSearchID); err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Saved search not found"})
+		default:
+			logger.Error().Err(err).Msg("Failed to delete saved search")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete saved search"})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -2,12 +2,23 @@ package api
 
 import (
 	"context"
+	"errors"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/gabe-dev-svc/volley/internal/broker"
 	"github.com/gabe-dev-svc/volley/internal/database"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/jobs"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/gabe-dev-svc/volley/internal/places"
+	"github.com/gabe-dev-svc/volley/internal/realtime"
 	"github.com/gabe-dev-svc/volley/internal/repository"
 	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gabe-dev-svc/volley/internal/util"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
@@ -22,6 +33,8 @@ type Server struct {
 func NewServer() *Server {
 	// Configure zerolog based on environment
 	configureLogger()
+	configureJWT()
+	configureUnsubscribe()
 
 	// Initialize database pool
 	ctx := context.Background()
@@ -33,29 +46,209 @@ func NewServer() *Server {
 	// Create repository queries
 	queries := repository.New(pool)
 
+	// Sandbox mode swaps every external provider for a deterministic fake
+	sandbox := os.Getenv("VOLLEY_SANDBOX") == "true"
+
 	// Initialize services with repository
 	gamesService := service.NewGamesService(queries, pool)
-	userService := service.NewUserService(queries)
+	smsSender := configureSMSSender(sandbox)
+	// Every message is recorded in notification_deliveries, and failed emails and texts are retried
+	deliveries := service.NewDeliveryTracker(queries, notifications.NewLogPushSender(), notifications.NewLogEmailSender(), smsSender)
+	// Sign-in links, codes and email change links are tracked without their message
+	userService := service.NewUserService(queries, deliveries.Sensitive(), deliveries.Sensitive())
+	statsService := service.NewStatsService(queries)
+	notifier := service.NewNotifier(queries, deliveries, deliveries, deliveries)
+	gamesService.SetNotifier(notifier)
+	webhooks := service.NewWebhooks(queries)
+	// Game events reach players and hosts' webhooks through the bus, in subscription order
+	bus := events.NewBus()
+	notifier.Subscribe(bus)
+	webhooks.Subscribe(bus)
+	// New games are matched against saved searches; VOLLEY_MAX_NEW_GAME_ALERTS_PER_DAY caps the
+	// alerts one user gets in a rolling day
+	gameAlerts := service.NewGameAlerts(queries, notifier)
+	if raw := os.Getenv("VOLLEY_MAX_NEW_GAME_ALERTS_PER_DAY"); raw != "" {
+		maxPerDay, err := strconv.Atoi(raw)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse VOLLEY_MAX_NEW_GAME_ALERTS_PER_DAY")
+		}
+		gameAlerts.SetMaxPerDay(maxPerDay)
+	}
+	gameAlerts.Subscribe(bus)
+	// With VOLLEY_EVENT_BROKER set they are also published for other backend services
+	var brokerPublisher *service.BrokerPublisher
+	if publisher := configureEventBroker(); publisher != nil {
+		brokerPublisher = service.NewBrokerPublisher(queries, publisher)
+		brokerPublisher.Subscribe(bus)
+	}
+	// Live connections such as game chats are fed from the bus, and reach clients on every replica
+	// through Postgres notifications
+	hub := realtime.NewHub(pool)
+	realtime.Relay(bus, hub)
+	go hub.Run(ctx)
+	gamesService.SetEventBus(bus)
+
+	// Region pinning: new users are homed to this deployment's region and writes for users homed
+	// elsewhere are routed to their region
+	region := os.Getenv("VOLLEY_REGION")
+	if region == "" {
+		region = service.DefaultRegion
+	}
+	userService.SetRegion(region)
+	if appURL := os.Getenv("VOLLEY_APP_URL"); appURL != "" {
+		userService.SetAppURL(appURL)
+		notifier.SetAppURL(appURL)
+	}
+	// Passkeys are bound to VOLLEY_WEBAUTHN_RP_ID (e.g. "volley.gg") and may only be used from
+	// VOLLEY_WEBAUTHN_ORIGINS; both default to the app URL
+	webAuthnConfig := service.WebAuthnConfig{RPID: os.Getenv("VOLLEY_WEBAUTHN_RP_ID")}
+	if rawOrigins := os.Getenv("VOLLEY_WEBAUTHN_ORIGINS"); rawOrigins != "" {
+		for _, origin := range strings.Split(rawOrigins, ",") {
+			webAuthnConfig.Origins = append(webAuthnConfig.Origins, strings.TrimSpace(origin))
+		}
+	}
+	userService.SetWebAuthnConfig(webAuthnConfig)
+	regionConfig := RegionConfig{Local: region}
+	if rawPeers := os.Getenv("VOLLEY_REGION_PEERS"); rawPeers != "" {
+		regionConfig.Peers, err = ParseRegionPeers(rawPeers)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse VOLLEY_REGION_PEERS")
+		}
+	}
+
+	// Latency-aware reads: game browsing is served from a replica in this region when one is configured
+	readPool, err := database.NewReadPool(ctx)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create read replica pool")
+	}
+	if readPool != nil {
+		log.Info().Msg("Serving game listings from read replica")
+		gamesService.UseReadReplica(repository.New(readPool))
+	}
+
+	// Game text is screened against VOLLEY_CONTENT_FILTER_TERMS (comma-separated) when set; matches
+	// are rejected, or with VOLLEY_CONTENT_FILTER_MODE=flag saved and queued for admin review
+	if rawTerms := os.Getenv("VOLLEY_CONTENT_FILTER_TERMS"); rawTerms != "" {
+		mode := service.ContentFilterMode(os.Getenv("VOLLEY_CONTENT_FILTER_MODE"))
+		if mode == "" {
+			mode = service.ContentFilterReject
+		}
+		contentFilter, err := service.NewContentFilter(mode, rawTerms)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to configure content filter")
+		}
+		gamesService.SetContentFilter(contentFilter)
+	}
+
+	// Anti-spam caps on game creation; unset or 0 disables a cap. Hosts with the verified_host role are exempt.
+	var creationLimits service.GameCreationLimits
+	if raw := os.Getenv("VOLLEY_MAX_OPEN_GAMES_PER_HOST"); raw != "" {
+		if creationLimits.MaxOpenGames, err = strconv.Atoi(raw); err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse VOLLEY_MAX_OPEN_GAMES_PER_HOST")
+		}
+	}
+	if raw := os.Getenv("VOLLEY_MAX_GAMES_CREATED_PER_HOUR"); raw != "" {
+		if creationLimits.MaxPerHour, err = strconv.Atoi(raw); err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse VOLLEY_MAX_GAMES_CREATED_PER_HOUR")
+		}
+	}
+	gamesService.SetGameCreationLimits(creationLimits)
 
-	// Load Google Places API key from environment
-	googlePlacesKey := os.Getenv("GOOGLE_PLACES_API_KEY")
-	if googlePlacesKey == "" {
-		log.Warn().Msg("GOOGLE_PLACES_API_KEY not set - location autocomplete will not work")
+	if os.Getenv("VOLLEY_JOURNAL") == "true" {
+		log.Info().Msg("Participation journal enabled")
+		gamesService.EnableJournal()
+	}
+
+	if sandbox {
+		log.Warn().Str("verificationCode", SandboxVerificationCode).Msg("Sandbox mode enabled - external providers are faked")
+		userService.UseFixedVerificationCode(SandboxVerificationCode)
+	}
+
+	// Start background jobs. Each run is claimed in the database first, so with several replicas
+	// every job still runs once per interval.
+	scheduler := jobs.NewScheduler()
+	scheduler.SetLocker(jobs.NewPostgresLocker(queries))
+	scheduler.Register(gamesService.Jobs()...)
+	scheduler.Register(statsService.Jobs()...)
+	scheduler.Register(userService.Jobs()...)
+	scheduler.Register(webhooks.Jobs()...)
+	scheduler.Register(deliveries.Jobs()...)
+	if brokerPublisher != nil {
+		scheduler.Register(brokerPublisher.Jobs()...)
+	}
+	scheduler.Start(ctx)
+
+	var placesClient places.Client = places.NewSandboxClient()
+	if !sandbox {
+		// Load Google Places API key from environment
+		googlePlacesKey := os.Getenv("GOOGLE_PLACES_API_KEY")
+		if googlePlacesKey == "" {
+			log.Warn().Msg("GOOGLE_PLACES_API_KEY not set - location autocomplete will not work")
+		}
+		placesClient = places.NewGoogleClient(googlePlacesKey)
 	}
 
 	// Set up router
 	router := gin.New()
 	router.Use(RequestIDMiddleware())
 	router.Use(CustomGinLogger())
+
+	// SLO tracking sits outside recovery and fault injection so panics and injected faults count against the budget
+	rawTargets := os.Getenv("VOLLEY_SLO_TARGETS")
+	if rawTargets == "" {
+		rawTargets = DefaultSLOTargets
+	}
+	sloTargets, err := ParseSLOTargets(rawTargets)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to parse SLO targets")
+	}
+	sloTracker := NewSLOTracker(sloTargets)
+	router.Use(sloTracker.Middleware())
+
 	router.Use(gin.Recovery())
+	if sandbox {
+		router.Use(SandboxMiddleware())
+	}
+	if rawRules := os.Getenv("VOLLEY_CHAOS_RULES"); rawRules != "" {
+		if os.Getenv("GIN_MODE") == "release" {
+			log.Warn().Msg("VOLLEY_CHAOS_RULES ignored in release mode")
+		} else {
+			rules, err := ParseChaosRules(rawRules)
+			if err != nil {
+				log.Fatal().Err(err).Msg("Failed to parse chaos rules")
+			}
+			log.Warn().Int("rules", len(rules)).Msg("Chaos fault injection enabled")
+			router.Use(ChaosMiddleware(rules))
+		}
+	}
 
 	// Configure CORS to allow all localhost origins for development
 	config := cors.DefaultConfig()
 	config.AllowAllOrigins = true
-	config.AllowHeaders = append(config.AllowHeaders, "X-Client-Type", "Authorization")
+	config.AllowHeaders = append(config.AllowHeaders, "X-Client-Type", "Authorization", AppVersionHeader)
+	config.ExposeHeaders = append(config.ExposeHeaders, SandboxHeader)
 	router.Use(cors.New(config))
 
-	handler := NewHandler(gamesService, userService, googlePlacesKey)
+	handler := NewHandler(gamesService, userService, statsService, placesClient)
+	handler.SetSLOTracker(sloTracker)
+	handler.SetRealtimeHub(hub)
+	handler.SetRegionConfig(regionConfig)
+	log.Info().Str("region", region).Int("peers", len(regionConfig.Peers)).Msg("Region configured")
+
+	// Outdated mobile apps are told to upgrade instead of hitting API changes they can't handle
+	if minVersion := os.Getenv("VOLLEY_MIN_APP_VERSION"); minVersion != "" {
+		policy := models.AppVersionPolicy{
+			MinimumVersion: minVersion,
+			UpgradeURL:     os.Getenv("VOLLEY_APP_UPGRADE_URL"),
+		}
+		appVersion, err := AppVersionMiddleware(policy)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid VOLLEY_MIN_APP_VERSION")
+		}
+		log.Info().Str("minimumVersion", minVersion).Msg("Minimum app version enforced")
+		router.Use(appVersion)
+		handler.SetAppVersionPolicy(policy)
+	}
 	handler.RegisterRoutes(router)
 
 	log.Info().Msg("Server initialized successfully")
@@ -124,6 +317,90 @@ func configureLogger() {
 	log.Logger = log.With().Str("service", "volley-api").Logger()
 }
 
+// configureJWT loads the token signing keys: HMAC secrets from VOLLEY_JWT_KEYS and PEM-encoded RSA
+// or Ed25519 private keys from VOLLEY_JWT_PRIVATE_KEYS, both JSON objects keyed by key ID. New tokens
+// are signed with VOLLEY_JWT_SIGNING_KEY_ID. To rotate, add the new key, switch the signing key ID,
+// and remove the old key once the tokens it signed have expired. Outside release mode a built-in
+// development key is used when none are configured.
+func configureJWT() {
+	config, err := util.ParseJWTConfig(os.Getenv("VOLLEY_JWT_KEYS"), os.Getenv("VOLLEY_JWT_PRIVATE_KEYS"), os.Getenv("VOLLEY_JWT_SIGNING_KEY_ID"))
+	if errors.Is(err, util.ErrNoJWTKeys) && os.Getenv("GIN_MODE") != "release" {
+		log.Warn().Msg("No JWT keys configured - signing tokens with the development key")
+		return
+	}
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load JWT keys")
+	}
+	util.SetJWTConfig(config)
+	log.Info().Str("kid", config.SigningKeyID).Int("keys", len(config.Keys)).Msg("JWT keys loaded")
+}
+
+// configureUnsubscribe loads the secret that signs the one-click unsubscribe links in game emails
+// from VOLLEY_UNSUBSCRIBE_SECRET. Outside release mode a built-in development secret is used when
+// it isn't set.
+func configureUnsubscribe() {
+	secret := os.Getenv("VOLLEY_UNSUBSCRIBE_SECRET")
+	if secret == "" {
+		if os.Getenv("GIN_MODE") == "release" {
+			log.Fatal().Msg("VOLLEY_UNSUBSCRIBE_SECRET must be set in release mode")
+		}
+		log.Warn().Msg("No unsubscribe secret configured - signing unsubscribe links with the development secret")
+		return
+	}
+	if err := util.SetUnsubscribeSecret(secret); err != nil {
+		log.Fatal().Err(err).Msg("Failed to load unsubscribe secret")
+	}
+}
+
+// configureSMSSender returns where texts are sent: Twilio when TWILIO_ACCOUNT_SID is set, and
+// the log otherwise. Sandbox mode always logs, so test traffic never texts real numbers.
+func configureSMSSender(sandbox bool) notifications.SMSSender {
+	accountSID := os.Getenv("TWILIO_ACCOUNT_SID")
+	if sandbox || accountSID == "" {
+		return notifications.NewLogSMSSender()
+	}
+	return notifications.NewTwilioSMSSender(accountSID, os.Getenv("TWILIO_AUTH_TOKEN"), os.Getenv("TWILIO_FROM"))
+}
+
+// configureEventBroker returns the publisher for the external event broker VOLLEY_EVENT_BROKER
+// selects, or nil when it isn't set:
+//   - kafka: a Confluent REST Proxy at VOLLEY_KAFKA_REST_URL, producing to VOLLEY_KAFKA_TOPIC
+//   - sns: the SNS topic VOLLEY_SNS_TOPIC_ARN, with the standard AWS_* credential variables
+//   - nats: the server at VOLLEY_NATS_URL, on subjects under VOLLEY_NATS_SUBJECT_PREFIX
+func configureEventBroker() broker.Publisher {
+	var publisher broker.Publisher
+	var err error
+	switch kind := os.Getenv("VOLLEY_EVENT_BROKER"); kind {
+	case "":
+		return nil
+	case "kafka":
+		topic := os.Getenv("VOLLEY_KAFKA_TOPIC")
+		if topic == "" {
+			topic = "volley.events"
+		}
+		publisher, err = broker.NewKafkaPublisher(os.Getenv("VOLLEY_KAFKA_REST_URL"), topic)
+	case "sns":
+		publisher, err = broker.NewSNSPublisher(os.Getenv("VOLLEY_SNS_TOPIC_ARN"), broker.AWSCredentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		})
+	case "nats":
+		prefix := os.Getenv("VOLLEY_NATS_SUBJECT_PREFIX")
+		if prefix == "" {
+			prefix = "volley.events"
+		}
+		publisher, err = broker.NewNATSPublisher(os.Getenv("VOLLEY_NATS_URL"), prefix)
+	default:
+		log.Fatal().Str("broker", kind).Msg("VOLLEY_EVENT_BROKER must be kafka, sns or nats")
+	}
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to configure event broker")
+	}
+	log.Info().Str("broker", os.Getenv("VOLLEY_EVENT_BROKER")).Msg("Publishing domain events to external broker")
+	return publisher
+}
+
 func (s *Server) Run(port string) error {
 	return s.router.Run(":" + port)
 }
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureSMSSender(t *testing.T) {
+	t.Run("Texts go through Twilio when it's configured", func(t *testing.T) {
+		t.Setenv("TWILIO_ACCOUNT_SID", "AC123")
+		assert.IsType(t, &notifications.TwilioSMSSender{}, configureSMSSender(false))
+	})
+
+	t.Run("Sandbox mode never texts real numbers", func(t *testing.T) {
+		t.Setenv("TWILIO_ACCOUNT_SID", "AC123")
+		assert.IsType(t, &notifications.LogSMSSender{}, configureSMSSender(true))
+	})
+
+	t.Run("Texts are logged without Twilio", func(t *testing.T) {
+		t.Setenv("TWILIO_ACCOUNT_SID", "")
+		assert.IsType(t, &notifications.LogSMSSender{}, configureSMSSender(false))
+	})
+}
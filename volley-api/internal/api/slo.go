@@ -0,0 +1,195 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SLOTarget is a response time objective for a group of routes: Objective of requests must succeed
+// (non-5xx) within Latency. Routes are gin route templates or prefixes ending in "*", like chaos rules.
+type SLOTarget struct {
+	Name      string   `json:"name"`
+	Method    string   `json:"method,omitempty"`
+	Routes    []string `json:"routes"`
+	Latency   string   `json:"latency"`   // Go duration, e.g. "500ms"
+	Objective float64  `json:"objective"` // 0.0 - 1.0, e.g. 0.99
+	latency   time.Duration
+}
+
+// DefaultSLOTargets are used when VOLLEY_SLO_TARGETS is not set
+const DefaultSLOTargets = `[
+	{"name": "auth", "routes": ["/v1/auth/*"], "latency": "500ms", "objective": 0.99},
+	{"name": "listing", "method": "GET", "routes": ["/v1/games"], "latency": "800ms", "objective": 0.99},
+	{"name": "join", "method": "POST", "routes": ["/v1/games/:gameId/participation"], "latency": "1s", "objective": 0.995}
+]`
+
+// Burn rate thresholds for the multiwindow alerts: sustained at these rates, the 30-day error
+// budget is gone in about 2 days (critical) or 5 days (warning)
+const (
+	sloCriticalBurnRate = 14.4
+	sloWarningBurnRate  = 6
+)
+
+// sloWindows are the windows burn rates are reported over, short first
+var sloWindows = []time.Duration{5 * time.Minute, time.Hour}
+
+// ParseSLOTargets parses and validates the JSON target list from VOLLEY_SLO_TARGETS
+func ParseSLOTargets(raw string) ([]SLOTarget, error) {
+	var targets []SLOTarget
+	if err := json.Unmarshal([]byte(raw), &targets); err != nil {
+		return nil, fmt.Errorf("invalid SLO targets: %w", err)
+	}
+
+	for i := range targets {
+		target := &targets[i]
+		if target.Name == "" || len(target.Routes) == 0 {
+			return nil, fmt.Errorf("SLO target %d: name and routes are required", i)
+		}
+		if target.Objective <= 0 || target.Objective >= 1 {
+			return nil, fmt.Errorf("SLO target %q: objective must be in (0, 1)", target.Name)
+		}
+		latency, err := time.ParseDuration(target.Latency)
+		if err != nil || latency <= 0 {
+			return nil, fmt.Errorf("SLO target %q: latency must be a positive duration", target.Name)
+		}
+		target.latency = latency
+		target.Method = strings.ToUpper(target.Method)
+	}
+	return targets, nil
+}
+
+func (t SLOTarget) matches(method, routePath string) bool {
+	if t.Method != "" && t.Method != method {
+		return false
+	}
+	for _, route := range t.Routes {
+		if prefix, ok := strings.CutSuffix(route, "*"); ok {
+			if strings.HasPrefix(routePath, prefix) {
+				return true
+			}
+		} else if route == routePath {
+			return true
+		}
+	}
+	return false
+}
+
+// sloBucket counts one minute of requests for a target
+type sloBucket struct {
+	minute int64
+	total  int64
+	good   int64
+}
+
+// SLOTracker keeps per-minute good/total request counts for each target over the longest window
+type SLOTracker struct {
+	targets []SLOTarget
+	mu      sync.Mutex
+	buckets [][]sloBucket // [target][minute % len]
+}
+
+func NewSLOTracker(targets []SLOTarget) *SLOTracker {
+	size := int(sloWindows[len(sloWindows)-1] / time.Minute)
+	buckets := make([][]sloBucket, len(targets))
+	for i := range buckets {
+		buckets[i] = make([]sloBucket, size)
+	}
+	return &SLOTracker{targets: targets, buckets: buckets}
+}
+
+// Middleware records each request against the targets its route matches
+func (t *SLOTracker) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		t.record(c.Request.Method, c.FullPath(), c.Writer.Status(), time.Since(start), start)
+	}
+}
+
+func (t *SLOTracker) record(method, routePath string, status int, latency time.Duration, at time.Time) {
+	minute := at.Unix() / 60
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, target := range t.targets {
+		if !target.matches(method, routePath) {
+			continue
+		}
+		bucket := &t.buckets[i][minute%int64(len(t.buckets[i]))]
+		if bucket.minute != minute {
+			*bucket = sloBucket{minute: minute}
+		}
+		bucket.total++
+		if status < http.StatusInternalServerError && latency <= target.latency {
+			bucket.good++
+		}
+	}
+}
+
+// SLOWindowStatus is a target's traffic and error budget burn rate over one window
+type SLOWindowStatus struct {
+	Window   string  `json:"window"`
+	Requests int64   `json:"requests"`
+	Good     int64   `json:"good"`
+	BurnRate float64 `json:"burnRate"` // 1.0 spends the error budget exactly over the SLO period
+}
+
+// SLOStatus summarizes one target. Status is "critical" or "warning" when every window burns faster
+// than the matching threshold, and "ok" otherwise.
+type SLOStatus struct {
+	Name      string            `json:"name"`
+	Objective float64           `json:"objective"`
+	LatencyMs int64             `json:"latencyMs"`
+	Status    string            `json:"status"`
+	Windows   []SLOWindowStatus `json:"windows"`
+}
+
+// Summary reports every target's burn rates as of now
+func (t *SLOTracker) Summary(now time.Time) []SLOStatus {
+	nowMinute := now.Unix() / 60
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	summary := make([]SLOStatus, len(t.targets))
+	for i, target := range t.targets {
+		status := SLOStatus{
+			Name:      target.Name,
+			Objective: target.Objective,
+			LatencyMs: target.latency.Milliseconds(),
+			Windows:   make([]SLOWindowStatus, len(sloWindows)),
+		}
+		critical, warning := true, true
+		for w, window := range sloWindows {
+			ws := SLOWindowStatus{Window: window.String()}
+			since := nowMinute - int64(window/time.Minute)
+			for _, bucket := range t.buckets[i] {
+				if bucket.minute > since && bucket.minute <= nowMinute {
+					ws.Requests += bucket.total
+					ws.Good += bucket.good
+				}
+			}
+			if ws.Requests > 0 {
+				errorRate := float64(ws.Requests-ws.Good) / float64(ws.Requests)
+				ws.BurnRate = errorRate / (1 - target.Objective)
+			}
+			critical = critical && ws.BurnRate >= sloCriticalBurnRate
+			warning = warning && ws.BurnRate >= sloWarningBurnRate
+			status.Windows[w] = ws
+		}
+		switch {
+		case critical:
+			status.Status = "critical"
+		case warning:
+			status.Status = "warning"
+		default:
+			status.Status = "ok"
+		}
+		summary[i] = status
+	}
+	return summary
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSLOTargets(t *testing.T) {
+	targets, err := ParseSLOTargets(DefaultSLOTargets)
+	require.NoError(t, err)
+	require.Len(t, targets, 3)
+	assert.Equal(t, 500*time.Millisecond, targets[0].latency)
+
+	invalid := map[string]string{
+		"missing routes":    `[{"name": "auth", "latency": "1s", "objective": 0.99}]`,
+		"objective of 1":    `[{"name": "auth", "routes": ["*"], "latency": "1s", "objective": 1}]`,
+		"bad latency":       `[{"name": "auth", "routes": ["*"], "latency": "fast", "objective": 0.99}]`,
+		"not a JSON array":  `{"name": "auth"}`,
+		"negative latency":  `[{"name": "auth", "routes": ["*"], "latency": "-1s", "objective": 0.99}]`,
+		"missing objective": `[{"name": "auth", "routes": ["*"], "latency": "1s"}]`,
+	}
+	for name, raw := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSLOTargets(raw)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSLOTracker(t *testing.T) {
+	targets, err := ParseSLOTargets(`[
+		{"name": "join", "method": "POST", "routes": ["/v1/games/:gameId/participation"], "latency": "1s", "objective": 0.99},
+		{"name": "auth", "routes": ["/v1/auth/*"], "latency": "500ms", "objective": 0.9}
+	]`)
+	require.NoError(t, err)
+	tracker := NewSLOTracker(targets)
+	now := time.Now()
+
+	// join: 80 good, 10 slow and 10 server errors in the last 5 minutes
+	for i := 0; i < 80; i++ {
+		tracker.record(http.MethodPost, "/v1/games/:gameId/participation", http.StatusOK, 100*time.Millisecond, now)
+	}
+	for i := 0; i < 10; i++ {
+		tracker.record(http.MethodPost, "/v1/games/:gameId/participation", http.StatusOK, 2*time.Second, now)
+		tracker.record(http.MethodPost, "/v1/games/:gameId/participation", http.StatusInternalServerError, time.Millisecond, now)
+	}
+	// Different method, and client errors, don't burn the budget
+	tracker.record(http.MethodDelete, "/v1/games/:gameId/participation", http.StatusInternalServerError, time.Millisecond, now)
+	tracker.record(http.MethodPost, "/v1/auth/login", http.StatusUnauthorized, time.Millisecond, now)
+	// auth: a bad minute that has aged out of the 5 minute window
+	tracker.record(http.MethodPost, "/v1/auth/login", http.StatusBadGateway, time.Millisecond, now.Add(-30*time.Minute))
+
+	summary := tracker.Summary(now)
+	require.Len(t, summary, 2)
+
+	join := summary[0]
+	assert.Equal(t, "critical", join.Status)
+	assert.Equal(t, int64(100), join.Windows[0].Requests)
+	assert.Equal(t, int64(80), join.Windows[0].Good)
+	assert.InDelta(t, 20.0, join.Windows[0].BurnRate, 0.001)
+
+	auth := summary[1]
+	assert.Equal(t, "ok", auth.Status)
+	assert.Equal(t, int64(1), auth.Windows[0].Requests)
+	assert.Equal(t, 0.0, auth.Windows[0].BurnRate)
+	assert.Equal(t, int64(2), auth.Windows[1].Requests)
+	assert.InDelta(t, 5.0, auth.Windows[1].BurnRate, 0.001)
+}
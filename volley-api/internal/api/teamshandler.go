@@ -0,0 +1,177 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// CreateTeam handles POST /games/:gameId/teams
+func (h *Handler) CreateTeam(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.CreateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (name is required)"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	team, err := h.gamesService.CreateTeam(ctx, gameID, userID, req)
+	if err != nil {
+		writeTeamError(c, logger, err, "Failed to create team")
+		return
+	}
+
+	c.JSON(http.StatusCreated, team)
+}
+
+// ListTeams handles GET /games/:gameId/teams
+func (h *Handler) ListTeams(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	teams, err := h.gamesService.ListTeams(ctx, gameID, userID)
+	if err != nil {
+		writeTeamError(c, logger, err, "Failed to list teams")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListTeamsResponse{Teams: teams})
+}
+
+// UpdateTeam handles PATCH /games/:gameId/teams/:teamId
+func (h *Handler) UpdateTeam(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.UpdateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	teamID := c.Param("teamId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("teamId", teamID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	team, err := h.gamesService.UpdateTeam(ctx, gameID, userID, teamID, req)
+	if err != nil {
+		writeTeamError(c, logger, err, "Failed to update team")
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+// DeleteTeam handles DELETE /games/:gameId/teams/:teamId
+func (h *Handler) DeleteTeam(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	teamID := c.Param("teamId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("teamId", teamID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.gamesService.DeleteTeam(ctx, gameID, userID, teamID); err != nil {
+		writeTeamError(c, logger, err, "Failed to delete team")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AssignTeamPlayers handles POST /games/:gameId/teams/:teamId/players
+func (h *Handler) AssignTeamPlayers(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.AssignTeamPlayersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (userIds is required)"})
+		return
+	}
+
+	gameID := c.Param("gameId")
+	teamID := c.Param("teamId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("teamId", teamID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.AssignTeamPlayers(ctx, gameID, userID, teamID, req)
+	if err != nil {
+		writeTeamError(c, logger, err, "Failed to assign players")
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// UnassignTeamPlayer handles DELETE /games/:gameId/teams/:teamId/players/:userId
+func (h *Handler) UnassignTeamPlayer(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	gameID := c.Param("gameId")
+	teamID := c.Param("teamId")
+	playerID := c.Param("userId")
+	logger = logger.With().Str("userId", userID).Str("gameId", gameID).Str("teamId", teamID).Str("playerId", playerID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	game, err := h.gamesService.UnassignTeamPlayer(ctx, gameID, userID, teamID, playerID)
+	if err != nil {
+		writeTeamError(c, logger, err, "Failed to unassign player")
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+// writeTeamError maps team service errors to responses
+func writeTeamError(c *gin.Context, logger zerolog.Logger, err error, msg string) {
+	var invalidArgErr *service.InvalidArgumentError
+	switch {
+	case errors.As(err, &invalidArgErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+	case errors.Is(err, apperrors.ErrNotFound):
+		logger.Warn().Err(err).Msg("Game or team not found")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Game or team not found"})
+	case errors.Is(err, service.ErrNotOwner):
+		logger.Warn().Err(err).Msg("User is not the game owner")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the game owner can manage teams"})
+	case errors.Is(err, service.ErrGameNotEditable):
+		c.JSON(http.StatusConflict, gin.H{"error": "Cancelled or completed games cannot be edited"})
+	default:
+		logger.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": msg})
+	}
+}
@@ -0,0 +1,969 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/places"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gabe-dev-svc/volley/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+// StartPhoneVerification handles POST /users/me/phone
+func (h *Handler) StartPhoneVerification(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.StartPhoneVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	verification, err := h.userService.StartPhoneVerification(ctx, userID, req.PhoneNumber, req.CountryCode)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		if errors.Is(err, apperrors.ErrAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": "This phone number is already verified on another account"})
+			return
+		}
+		if errors.Is(err, apperrors.ErrRateLimited) {
+			logger.Warn().Err(err).Msg("Phone verification rate limited")
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many verification codes requested, please try again later"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to start phone verification")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send verification code"})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, verification)
+}
+
+// VerifyPhone handles POST /users/me/phone/verify
+func (h *Handler) VerifyPhone(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.VerifyPhoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	user, err := h.userService.VerifyPhone(ctx, userID, req.Code)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidVerificationCode) {
+			logger.Warn().Err(err).Msg("Invalid phone verification code")
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired verification code"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to verify phone")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify phone number"})
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// RequestEmailChange handles POST /users/me/email
+func (h *Handler) RequestEmailChange(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.RequestEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	change, err := h.userService.RequestEmailChange(ctx, userID, req.NewEmail, req.Password)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidPassword) {
+			logger.Warn().Msg("Email change rejected: wrong password")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Incorrect password"})
+			return
+		}
+		if errors.Is(err, apperrors.ErrAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": "An account with this email already exists"})
+			return
+		}
+		if errors.Is(err, apperrors.ErrRateLimited) {
+			logger.Warn().Err(err).Msg("Email change rate limited")
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "An email change was requested too recently, please try again later"})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to request email change")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send confirmation email"})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, change)
+}
+
+// ChangePassword handles POST /users/me/password
+// All existing sessions, including access tokens, are ended; the caller gets a fresh session.
+func (h *Handler) ChangePassword(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	user, err := h.userService.ChangePassword(ctx, userID, req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidPassword) {
+			logger.Warn().Msg("Password change rejected: wrong password")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Incorrect password"})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to change password")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change password"})
+		return
+	}
+
+	h.startSession(c, user)
+}
+
+// ConfirmEmailChange handles POST /auth/email-change/confirm
+func (h *Handler) ConfirmEmailChange(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var req models.ConfirmEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.userService.ConfirmEmailChange(ctx, req.Token)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidEmailChangeToken) {
+			logger.Warn().Err(err).Msg("Invalid email change token")
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired confirmation link"})
+			return
+		}
+		if errors.Is(err, apperrors.ErrAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": "An account with this email already exists"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to confirm email change")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change email"})
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// Unsubscribe handles POST /unsubscribe?token=...: the one-click unsubscribe in game email footers.
+// The token is in the query so mail clients can POST to the link as is (RFC 8058).
+func (h *Handler) Unsubscribe(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	unsubscribed, err := h.userService.Unsubscribe(ctx, token)
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidUnsubscribeToken) {
+			logger.Warn().Err(err).Msg("Invalid unsubscribe token")
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid unsubscribe link"})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to unsubscribe")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unsubscribe"})
+		return
+	}
+
+	c.JSON(http.StatusOK, unsubscribed)
+}
+
+// ListLegalDocuments handles GET /legal/documents
+func (h *Handler) ListLegalDocuments(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	documents, err := h.userService.ListCurrentLegalDocuments(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list legal documents")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list legal documents"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListLegalDocumentsResponse{Documents: documents})
+}
+
+// AcceptLegalDocuments handles POST /users/me/legal-acceptances
+func (h *Handler) AcceptLegalDocuments(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.AcceptLegalDocumentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	pending, err := h.userService.AcceptLegalDocuments(ctx, userID, req.Documents, clientInfo(c))
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Message})
+			return
+		}
+
+		logger.Error().Err(err).Msg("Failed to accept legal documents")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to accept legal documents"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pendingDocuments": pending})
+}
+
+// ListLegalAcceptances handles GET /users/me/legal-acceptances
+func (h *Handler) ListLegalAcceptances(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	acceptances, err := h.userService.ListLegalAcceptances(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list legal acceptances")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list legal acceptances"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListLegalAcceptancesResponse{Acceptances: acceptances})
+}
+
+// clientInfo captures the caller's IP address and user agent for audit records
+func clientInfo(c *gin.Context) service.ClientInfo {
+	return service.ClientInfo{
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
+
+// OrganizerDashboard handles GET /users/me/organizer-dashboard
+func (h *Handler) OrganizerDashboard(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	dashboard, err := h.gamesService.OrganizerDashboard(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to build organizer dashboard")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve organizer dashboard"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dashboard)
+}
+
+// PlayerDashboard handles GET /users/me/dashboard
+func (h *Handler) PlayerDashboard(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	// Recommendations need a location, from the query or the saved home; without one the dashboard skips them
+	var near *service.PlayerDashboardLocation
+	latitude, longitude := c.Query("latitude"), c.Query("longitude")
+	if latitude != "" || longitude != "" {
+		var lat, lng float64
+		if _, err := fmt.Sscanf(latitude, "%f", &lat); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid latitude"})
+			return
+		}
+		if _, err := fmt.Sscanf(longitude, "%f", &lng); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid longitude"})
+			return
+		}
+		near = &service.PlayerDashboardLocation{Latitude: lat, Longitude: lng}
+	} else {
+		settings, err := h.savedSettings(ctx, c)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to load saved settings")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve dashboard"})
+			return
+		}
+		if settings != nil && settings.HomeLocation != nil {
+			near = &service.PlayerDashboardLocation{Latitude: settings.HomeLocation.Latitude, Longitude: settings.HomeLocation.Longitude}
+		}
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	dashboard, err := h.gamesService.PlayerDashboard(ctx, userID, near)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to build player dashboard")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve dashboard"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dashboard)
+}
+
+// ListParticipationHistory handles GET /users/me/participation-history
+func (h *Handler) ListParticipationHistory(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var filters service.ParticipationHistoryFilters
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if _, err := fmt.Sscanf(limitStr, "%d", &filters.Limit); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if _, err := fmt.Sscanf(offsetStr, "%d", &filters.Offset); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset"})
+			return
+		}
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	page, err := h.gamesService.ParticipationHistory(ctx, userID, filters)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to list participation history")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve participation history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetMyStats handles GET /users/me/stats
+func (h *Handler) GetMyStats(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	stats, err := h.statsService.PlayerStats(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get player stats")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve stats"})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// GetPlayerProfile handles GET /users/:userId/profile
+func (h *Handler) GetPlayerProfile(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	profileUserID := c.Param("userId")
+	logger = logger.With().Str("profileUserId", profileUserID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	profile, err := h.statsService.PlayerProfile(ctx, profileUserID)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to get player profile")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve player profile"})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// ListBlockedPlayers handles GET /users/me/blocked-players and GET /users/me/blocks
+func (h *Handler) ListBlockedPlayers(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	blocked, err := h.userService.ListBlockedPlayers(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list blocked players")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve blocked players"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"blockedPlayers": blocked})
+}
+
+// BlockPlayer handles POST /users/me/blocked-players
+func (h *Handler) BlockPlayer(c *gin.Context) {
+	var req models.BlockPlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.blockPlayer(c, req.UserID)
+}
+
+// BlockUser handles POST /users/me/blocks/:userId
+func (h *Handler) BlockUser(c *gin.Context) {
+	h.blockPlayer(c, c.Param("userId"))
+}
+
+func (h *Handler) blockPlayer(c *gin.Context, playerID string) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Str("playerId", playerID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.userService.BlockPlayer(ctx, userID, playerID); err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrCannotBlockSelf) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "You can't block yourself"})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to block player")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to block player"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UnblockPlayer handles DELETE /users/me/blocked-players/:userId and DELETE /users/me/blocks/:userId
+func (h *Handler) UnblockPlayer(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	playerID := c.Param("userId")
+	logger = logger.With().Str("userId", userID).Str("playerId", playerID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.userService.UnblockPlayer(ctx, userID, playerID); err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Player is not blocked"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to unblock player")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unblock player"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetSportPreferences handles GET /users/me/sport-preferences
+func (h *Handler) GetSportPreferences(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	preferences, err := h.userService.GetSportPreferences(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get sport preferences")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve sport preferences"})
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// SetSportPreferences handles PUT /users/me/sport-preferences
+func (h *Handler) SetSportPreferences(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.SportPreferences
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (sports must list known categories, with skillLevel beginner, intermediate or advanced)"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	preferences, err := h.userService.SetSportPreferences(ctx, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to save sport preferences")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save sport preferences"})
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// GetNotificationPreferences handles GET /users/me/notification-preferences
+func (h *Handler) GetNotificationPreferences(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	preferences, err := h.userService.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get notification preferences")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notification preferences"})
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// UpdateNotificationPreferences handles PATCH /users/me/notification-preferences
+func (h *Handler) UpdateNotificationPreferences(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	preferences, err := h.userService.UpdateNotificationPreferences(ctx, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, service.ErrPhoneNotVerified):
+			c.JSON(http.StatusConflict, gin.H{"error": "Verify a phone number before turning on SMS"})
+		default:
+			logger.Error().Err(err).Msg("Failed to update notification preferences")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notification preferences"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// GetSettings handles GET /users/me/settings
+func (h *Handler) GetSettings(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	settings, err := h.userService.GetSettings(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get settings")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve settings"})
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+// UpdateSettings handles PATCH /users/me/settings
+func (h *Handler) UpdateSettings(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.UpdateSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	update := service.SettingsUpdate{
+		ClearHome:     req.ClearHomeLocation,
+		DefaultRadius: req.DefaultRadius,
+	}
+	if home := req.HomeLocation; home != nil {
+		switch {
+		case home.PlaceID != nil && (home.Latitude != nil || home.Longitude != nil):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "homeLocation takes either latitude and longitude or placeId, not both"})
+			return
+		case home.PlaceID != nil:
+			// Resolve the place once here so searches can use the saved coordinates directly
+			details, err := h.places.Details(ctx, *home.PlaceID)
+			if err != nil {
+				if errors.Is(err, places.ErrPlaceNotFound) {
+					c.JSON(http.StatusBadRequest, gin.H{"error": "homeLocation placeId was not found"})
+					return
+				}
+				logger.Error().Err(err).Str("placeId", *home.PlaceID).Msg("Failed to resolve home place")
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to resolve home place"})
+				return
+			}
+			update.Home = &models.HomeLocation{
+				Latitude:  details.Latitude,
+				Longitude: details.Longitude,
+				PlaceID:   &details.PlaceID,
+				Name:      &details.Name,
+			}
+		case home.Latitude != nil && home.Longitude != nil:
+			update.Home = &models.HomeLocation{Latitude: *home.Latitude, Longitude: *home.Longitude}
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "homeLocation needs latitude and longitude, or placeId"})
+			return
+		}
+	}
+
+	settings, err := h.userService.UpdateSettings(ctx, userID, update)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to save settings")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save settings"})
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+// GetOnboarding handles GET /users/me/onboarding
+func (h *Handler) GetOnboarding(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	onboarding, err := h.userService.GetOnboarding(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get onboarding")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve onboarding"})
+		return
+	}
+
+	c.JSON(http.StatusOK, onboarding)
+}
+
+// SaveOnboarding handles PUT /users/me/onboarding
+func (h *Handler) SaveOnboarding(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var req models.Onboarding
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (sports must list known categories and availability known windows)"})
+		return
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	onboarding, err := h.userService.SaveOnboarding(ctx, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to save onboarding")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save onboarding"})
+		return
+	}
+
+	c.JSON(http.StatusOK, onboarding)
+}
+
+// GetReferrals handles GET /users/me/referrals
+func (h *Handler) GetReferrals(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	referrals, err := h.userService.Referrals(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get referrals")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve referrals"})
+		return
+	}
+
+	c.JSON(http.StatusOK, referrals)
+}
+
+// GetWallet handles GET /users/me/wallet
+func (h *Handler) GetWallet(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+
+	var filters service.WalletFilters
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if _, err := fmt.Sscanf(limitStr, "%d", &filters.Limit); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if _, err := fmt.Sscanf(offsetStr, "%d", &filters.Offset); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset"})
+			return
+		}
+	}
+
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	wallet, err := h.userService.Wallet(ctx, userID, filters)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		if errors.As(err, &invalidArgErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to get wallet")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve wallet"})
+		return
+	}
+
+	c.JSON(http.StatusOK, wallet)
+}
+
+// deactivatedAccountMessage is returned when a deactivated user tries to sign in
+const deactivatedAccountMessage = "Your account is deactivated; reactivate it to sign in"
+
+// DeactivateAccount handles POST /users/me/deactivate - drops the user from their upcoming games,
+// hides their profile and signs them out everywhere until they reactivate
+func (h *Handler) DeactivateAccount(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	hosting, err := h.gamesService.HostsUpcomingGames(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list hosted games")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to deactivate account"})
+		return
+	}
+	if hosting {
+		c.JSON(http.StatusConflict, gin.H{"error": "Cancel the upcoming games you host before deactivating your account"})
+		return
+	}
+
+	// Leave games first so a failure here leaves the account active and the request can be retried
+	dropped, kept, err := h.gamesService.LeaveUpcomingGames(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to leave upcoming games")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to deactivate account"})
+		return
+	}
+
+	deactivatedAt, err := h.userService.Deactivate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to deactivate account")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to deactivate account"})
+		return
+	}
+
+	if !isMobileClient(c) {
+		clearAuthCookies(c)
+	}
+	c.JSON(http.StatusOK, models.Deactivation{
+		DeactivatedAt: deactivatedAt,
+		DroppedGames:  dropped,
+		KeptGames:     kept,
+	})
+}
+
+// ReactivateAccount handles POST /auth/reactivate - switches a deactivated account back on and signs in
+func (h *Handler) ReactivateAccount(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	var req models.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.userService.Reactivate(ctx, req.Email, req.Password, c.ClientIP())
+	if err != nil {
+		var throttledErr *service.LoginThrottledError
+		if errors.As(err, &throttledErr) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttledErr.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many failed sign-in attempts, please try again later"})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			logger.Warn().Err(err).Str("email", req.Email).Msg("Reactivation failed")
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+			return
+		}
+		logger.Error().Err(err).Msg("Failed to reactivate account")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reactivate account"})
+		return
+	}
+
+	logger.Info().Str("userID", user.ID).Msg("User reactivated and logged in")
+	h.startSession(c, user)
+}
+
+// savedSettings loads the signed-in user's search defaults, or nil for anonymous requests
+func (h *Handler) savedSettings(ctx context.Context, c *gin.Context) (*models.UserSettings, error) {
+	userID := authenticatedUserID(c)
+	if userID == "" {
+		return nil, nil
+	}
+	return h.userService.GetSettings(ctx, userID)
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateWebhook handles POST /users/me/webhooks
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	var req models.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format (url and at least one supported event type are required)"})
+		return
+	}
+
+	webhook, err := h.gamesService.CreateWebhook(ctx, userID, req)
+	if err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, service.ErrTooManyWebhooks):
+			c.JSON(http.StatusConflict, gin.H{"error": "Webhook limit reached - delete one before adding another"})
+		default:
+			logger.Error().Err(err).Msg("Failed to create webhook")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create webhook"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhook)
+}
+
+// ListWebhooks handles GET /users/me/webhooks
+func (h *Handler) ListWebhooks(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	logger = logger.With().Str("userId", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	webhooks, err := h.gamesService.ListWebhooks(ctx, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list webhooks")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list webhooks"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListWebhooksResponse{Webhooks: webhooks})
+}
+
+// DeleteWebhook handles DELETE /users/me/webhooks/:webhookId
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	logger := LoggerFromContext(c)
+	ctx := logger.WithContext(c.Request.Context())
+
+	userID := authenticatedUserID(c)
+	webhookID := c.Param("webhookId")
+	logger = logger.With().Str("userId", userID).Str("webhookId", webhookID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	if err := h.gamesService.DeleteWebhook(ctx, userID, webhookID); err != nil {
+		var invalidArgErr *service.InvalidArgumentError
+		switch {
+		case errors.As(err, &invalidArgErr):
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalidArgErr.Error()})
+		case errors.Is(err, apperrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+		default:
+			logger.Error().Err(err).Msg("Failed to delete webhook")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete webhook"})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,18 @@
+// Package broker publishes volley's domain events to an external message broker, for backend
+// services such as the analytics pipeline to consume
+package broker
+
+import "context"
+
+// Message is one event document to publish
+type Message struct {
+	ID   string // Event UUID; consumers dedupe on it since publishing is at least once
+	Type string // Event type, e.g. game.created
+	Key  string // Ordering key: the game ID
+	Body []byte // The JSON event document
+}
+
+// Publisher sends messages to a broker. Publish returns once the broker has accepted the message.
+type Publisher interface {
+	Publish(ctx context.Context, message Message) error
+}
@@ -0,0 +1,178 @@
+package broker
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMessage = Message{
+	ID:   "3f1c1b5e-8d0a-4a4e-9b7f-2f8e1a6c9d10",
+	Type: "game.created",
+	Key:  "9a7d3c2b-1e4f-4a6b-8c5d-0e1f2a3b4c5d",
+	Body: []byte(`{"schemaVersion":1,"type":"game.created"}`),
+}
+
+func TestKafkaPublisher(t *testing.T) {
+	t.Run("produces a keyed record to the topic", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/topics/volley.events", r.URL.Path)
+			assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+			user, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "volley", user)
+			assert.Equal(t, "secret", password)
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"records":[{"key":"9a7d3c2b-1e4f-4a6b-8c5d-0e1f2a3b4c5d","value":{"schemaVersion":1,"type":"game.created"}}]}`, string(body))
+			_, _ = w.Write([]byte(`{"offsets":[{"partition":2,"offset":41,"error_code":null,"error":null}]}`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewKafkaPublisher(strings.Replace(server.URL, "http://", "http://volley:secret@", 1), "volley.events")
+		require.NoError(t, err)
+		require.NoError(t, publisher.Publish(context.Background(), testMessage))
+	})
+
+	t.Run("record errors in a 200 response fail the publish", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"Leader not available"}]}`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewKafkaPublisher(server.URL, "volley.events")
+		require.NoError(t, err)
+		err = publisher.Publish(context.Background(), testMessage)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Leader not available")
+	})
+}
+
+func TestSNSPublisher(t *testing.T) {
+	t.Run("publishes the document with its event type attribute", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/20261016/us-east-1/sns/aws4_request, "))
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "Publish", r.PostForm.Get("Action"))
+			assert.Equal(t, "arn:aws:sns:us-east-1:123456789012:volley-events", r.PostForm.Get("TopicArn"))
+			assert.Equal(t, string(testMessage.Body), r.PostForm.Get("Message"))
+			assert.Equal(t, "game.created", r.PostForm.Get("MessageAttributes.entry.1.Value.StringValue"))
+			assert.Empty(t, r.PostForm.Get("MessageGroupId"))
+			_, _ = w.Write([]byte(`<PublishResponse><PublishResult><MessageId>1</MessageId></PublishResult></PublishResponse>`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewSNSPublisher("arn:aws:sns:us-east-1:123456789012:volley-events", AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+		require.NoError(t, err)
+		publisher.endpoint = server.URL + "/"
+		publisher.now = func() time.Time { return time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC) }
+		require.NoError(t, publisher.Publish(context.Background(), testMessage))
+	})
+
+	t.Run("rejections include the SNS error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>AuthorizationError</Code><Message>Not authorized</Message></Error></ErrorResponse>`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewSNSPublisher("arn:aws:sns:us-east-1:123456789012:volley-events", AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+		require.NoError(t, err)
+		publisher.endpoint = server.URL + "/"
+		err = publisher.Publish(context.Background(), testMessage)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "AuthorizationError")
+	})
+
+	t.Run("the region comes from the topic ARN", func(t *testing.T) {
+		publisher, err := NewSNSPublisher("arn:aws:sns:eu-west-1:123456789012:volley-events.fifo", AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+		require.NoError(t, err)
+		assert.Equal(t, "https://sns.eu-west-1.amazonaws.com/", publisher.endpoint)
+
+		_, err = NewSNSPublisher("volley-events", AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+		assert.Error(t, err)
+	})
+}
+
+func TestSignAWSRequest(t *testing.T) {
+	// The worked example from the AWS Signature Version 4 documentation
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	credentials := AWSCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	signAWSRequest(req, nil, credentials, "us-east-1", "iam", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+		"SignedHeaders=content-type;host;x-amz-date, "+
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7", req.Header.Get("Authorization"))
+}
+
+// fakeNATSServer accepts one connection, records the commands the client sends and answers the
+// PING with reply
+func fakeNATSServer(t *testing.T, reply string) (string, <-chan []string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte(`INFO {"server_id":"test","max_payload":1048576}` + "\r\n"))
+
+		var lines []string
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				break
+			}
+			line = strings.TrimRight(line, "\r\n")
+			lines = append(lines, line)
+			if line == "PING" {
+				_, _ = conn.Write([]byte(reply + "\r\n"))
+				break
+			}
+		}
+		received <- lines
+	}()
+	return listener.Addr().String(), received
+}
+
+func TestNATSPublisher(t *testing.T) {
+	t.Run("publishes on the event type's subject", func(t *testing.T) {
+		address, received := fakeNATSServer(t, "PONG")
+		publisher, err := NewNATSPublisher("nats://volley:secret@"+address, "volley.events")
+		require.NoError(t, err)
+		require.NoError(t, publisher.Publish(context.Background(), testMessage))
+
+		lines := <-received
+		require.Len(t, lines, 4)
+		assert.Contains(t, lines[0], `"user":"volley"`)
+		assert.Contains(t, lines[0], `"pass":"secret"`)
+		assert.Equal(t, "PUB volley.events.game.created 41", lines[1])
+		assert.Equal(t, string(testMessage.Body), lines[2])
+	})
+
+	t.Run("server errors fail the publish", func(t *testing.T) {
+		address, _ := fakeNATSServer(t, "-ERR 'Permissions Violation for Publish to volley.events.game.created'")
+		publisher, err := NewNATSPublisher("nats://"+address, "volley.events")
+		require.NoError(t, err)
+		err = publisher.Publish(context.Background(), testMessage)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Permissions Violation")
+	})
+}
@@ -0,0 +1,88 @@
+package broker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// KafkaPublisher produces messages to a Kafka topic through a Confluent REST Proxy (v2 API), keyed
+// so each game's events land on one partition
+type KafkaPublisher struct {
+	endpoint   string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewKafkaPublisher returns a publisher for the topic behind the REST proxy at proxyURL. Basic
+// auth credentials may be given in the URL.
+func NewKafkaPublisher(proxyURL string, topic string) (*KafkaPublisher, error) {
+	parsed, err := url.Parse(proxyURL)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid Kafka REST proxy URL %q", proxyURL)
+	}
+	publisher := &KafkaPublisher{httpClient: &http.Client{}}
+	if parsed.User != nil {
+		publisher.username = parsed.User.Username()
+		publisher.password, _ = parsed.User.Password()
+		parsed.User = nil
+	}
+	publisher.endpoint = strings.TrimSuffix(parsed.String(), "/") + "/topics/" + url.PathEscape(topic)
+	return publisher, nil
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, message Message) error {
+	type record struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+	body, err := json.Marshal(map[string][]record{
+		"records": {{Key: message.Key, Value: message.Body}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode Kafka records: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	httpReq.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	if p.username != "" {
+		httpReq.SetBasicAuth(p.username, p.password)
+	}
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call Kafka REST proxy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The proxy reports failures to produce an individual record in the offsets of a 200 response
+	var result struct {
+		Message string `json:"message"`
+		Offsets []struct {
+			Error *string `json:"error"`
+		} `json:"offsets"`
+	}
+	decodeErr := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&result)
+	if resp.StatusCode >= 300 {
+		if decodeErr == nil && result.Message != "" {
+			return fmt.Errorf("kafka REST proxy returned status %d: %s", resp.StatusCode, result.Message)
+		}
+		return fmt.Errorf("kafka REST proxy returned status %d", resp.StatusCode)
+	}
+	for _, offset := range result.Offsets {
+		if offset.Error != nil {
+			return fmt.Errorf("kafka REST proxy failed to produce the record: %s", *offset.Error)
+		}
+	}
+	return nil
+}
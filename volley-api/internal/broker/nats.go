@@ -0,0 +1,132 @@
+package broker
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// natsTimeout bounds a publish when the context has no deadline
+const natsTimeout = 10 * time.Second
+
+// NATSPublisher publishes messages to NATS on the subject <prefix>.<event type>. It speaks the
+// client protocol directly, connecting for each publish and waiting for the server's PONG, so a
+// message counts as published only once the server has processed it.
+type NATSPublisher struct {
+	address       string
+	tls           bool
+	connectFields map[string]any
+	subjectPrefix string
+}
+
+// NewNATSPublisher returns a publisher for the server at serverURL (nats://host:4222, or tls:// to
+// require TLS). A user and password, or a token as the user, may be given in the URL.
+func NewNATSPublisher(serverURL string, subjectPrefix string) (*NATSPublisher, error) {
+	parsed, err := url.Parse(serverURL)
+	if err != nil || parsed.Hostname() == "" || (parsed.Scheme != "nats" && parsed.Scheme != "tls") {
+		return nil, fmt.Errorf("invalid NATS URL %q", serverURL)
+	}
+	address := parsed.Host
+	if parsed.Port() == "" {
+		address = net.JoinHostPort(parsed.Hostname(), "4222")
+	}
+
+	connectFields := map[string]any{
+		"verbose":  false,
+		"pedantic": false,
+		"name":     "volley-api",
+		"lang":     "go",
+		"version":  "1.0.0",
+		"protocol": 0,
+	}
+	if parsed.User != nil {
+		if password, ok := parsed.User.Password(); ok {
+			connectFields["user"] = parsed.User.Username()
+			connectFields["pass"] = password
+		} else {
+			connectFields["auth_token"] = parsed.User.Username()
+		}
+	}
+	return &NATSPublisher{
+		address:       address,
+		tls:           parsed.Scheme == "tls",
+		connectFields: connectFields,
+		subjectPrefix: subjectPrefix,
+	}, nil
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, message Message) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", p.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	defer conn.Close()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(natsTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("failed to set NATS deadline: %w", err)
+	}
+
+	// The server opens with INFO, which says whether it requires TLS
+	reader := bufio.NewReader(conn)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read NATS server info: %w", err)
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("unexpected NATS greeting %q", strings.TrimSpace(line))
+	}
+	var info struct {
+		TLSRequired bool `json:"tls_required"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info); err != nil {
+		return fmt.Errorf("failed to decode NATS server info: %w", err)
+	}
+	if p.tls || info.TLSRequired {
+		host, _, _ := net.SplitHostPort(p.address)
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return fmt.Errorf("failed NATS TLS handshake: %w", err)
+		}
+		conn = tlsConn
+		reader = bufio.NewReader(conn)
+	}
+
+	connect, err := json.Marshal(p.connectFields)
+	if err != nil {
+		return fmt.Errorf("failed to encode NATS connect options: %w", err)
+	}
+	subject := p.subjectPrefix + "." + message.Type
+	if _, err := fmt.Fprintf(conn, "CONNECT %s\r\nPUB %s %d\r\n%s\r\nPING\r\n", connect, subject, len(message.Body), message.Body); err != nil {
+		return fmt.Errorf("failed to publish to NATS: %w", err)
+	}
+
+	// Commands are processed in order, so the PONG confirms the publish. Errors such as a rejected
+	// login or a subject the user may not publish to come back as -ERR first.
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read NATS reply: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("NATS server returned an error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		case line == "PING":
+			if _, err := conn.Write([]byte("PONG\r\n")); err != nil {
+				return fmt.Errorf("failed to answer NATS ping: %w", err)
+			}
+		}
+	}
+}
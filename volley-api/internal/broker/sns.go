@@ -0,0 +1,183 @@
+package broker
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AWSCredentials sign requests to AWS
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // Set for temporary credentials
+}
+
+// SNSPublisher publishes messages to an Amazon SNS topic, with the event type as the eventType
+// message attribute for subscription filter policies
+type SNSPublisher struct {
+	topicARN    string
+	region      string
+	endpoint    string
+	credentials AWSCredentials
+	httpClient  *http.Client
+	now         func() time.Time
+}
+
+// NewSNSPublisher returns a publisher for the topic, in the region named by its ARN
+func NewSNSPublisher(topicARN string, credentials AWSCredentials) (*SNSPublisher, error) {
+	// arn:aws:sns:us-east-1:123456789012:volley-events
+	parts := strings.Split(topicARN, ":")
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "sns" || parts[3] == "" {
+		return nil, fmt.Errorf("invalid SNS topic ARN %q", topicARN)
+	}
+	if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("AWS credentials are required to publish to SNS")
+	}
+	return &SNSPublisher{
+		topicARN:    topicARN,
+		region:      parts[3],
+		endpoint:    fmt.Sprintf("https://sns.%s.amazonaws.com/", parts[3]),
+		credentials: credentials,
+		httpClient:  &http.Client{},
+		now:         time.Now,
+	}, nil
+}
+
+func (p *SNSPublisher) Publish(ctx context.Context, message Message) error {
+	form := url.Values{}
+	form.Set("Action", "Publish")
+	form.Set("Version", "2010-03-31")
+	form.Set("TopicArn", p.topicARN)
+	form.Set("Message", string(message.Body))
+	form.Set("MessageAttributes.entry.1.Name", "eventType")
+	form.Set("MessageAttributes.entry.1.Value.DataType", "String")
+	form.Set("MessageAttributes.entry.1.Value.StringValue", message.Type)
+	if strings.HasSuffix(p.topicARN, ".fifo") {
+		// FIFO topics order within a group and drop duplicates of a deduplication ID
+		form.Set("MessageGroupId", message.Key)
+		form.Set("MessageDeduplicationId", message.ID)
+	}
+	body := form.Encode()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, strings.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	signAWSRequest(httpReq, []byte(body), p.credentials, p.region, "sns", p.now())
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call SNS API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var snsErr struct {
+			Error struct {
+				Code    string `xml:"Code"`
+				Message string `xml:"Message"`
+			} `xml:"Error"`
+		}
+		if err := xml.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&snsErr); err == nil && snsErr.Error.Code != "" {
+			return fmt.Errorf("SNS API returned status %d: %s: %s", resp.StatusCode, snsErr.Error.Code, snsErr.Error.Message)
+		}
+		return fmt.Errorf("SNS API returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// signAWSRequest adds AWS Signature Version 4 headers to the request, signing its host,
+// content type and date headers along with the body
+func signAWSRequest(req *http.Request, body []byte, credentials AWSCredentials, region string, service string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	if credentials.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", credentials.SessionToken)
+	}
+
+	headers := map[string]string{
+		"host":       req.URL.Host,
+		"x-amz-date": amzDate,
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		headers["content-type"] = contentType
+	}
+	if credentials.SessionToken != "" {
+		headers["x-amz-security-token"] = credentials.SessionToken
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		sha256Hex(body),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+credentials.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		credentials.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery sorts and percent-encodes query parameters the way Signature Version 4 expects
+func canonicalQuery(values url.Values) string {
+	pairs := make([]string, 0, len(values))
+	for name, list := range values {
+		for _, value := range list {
+			pairs = append(pairs, awsEscape(name)+"="+awsEscape(value))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func awsEscape(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
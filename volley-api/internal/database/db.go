@@ -37,3 +37,32 @@ func NewPool(ctx context.Context) (*pgxpool.Pool, error) {
 
 	return pool, nil
 }
+
+// NewReadPool creates a pool for the read replica at DATABASE_READ_URL, or returns nil when no
+// replica is configured
+func NewReadPool(ctx context.Context) (*pgxpool.Pool, error) {
+	databaseURL := os.Getenv("DATABASE_READ_URL")
+	if databaseURL == "" {
+		return nil, nil
+	}
+
+	config, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse read database URL: %w", err)
+	}
+
+	config.MaxConns = 10
+	config.MinConns = 2
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create read connection pool: %w", err)
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to ping read database: %w", err)
+	}
+
+	return pool, nil
+}
@@ -7,4 +7,5 @@ var (
 	ErrNotFound         = errors.New("resource not found")
 	ErrMissingAuthToken = errors.New("missing authentication token")
 	ErrInvalidAuthToken = errors.New("provided authentication token is invalid")
+	ErrRateLimited      = errors.New("too many requests, try again later")
 )
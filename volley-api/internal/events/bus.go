@@ -0,0 +1,75 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Event is something that happened in the domain, published once the change it describes has
+// committed
+type Event interface {
+	// EventName identifies the kind of event, e.g. "game.cancelled"
+	EventName() string
+}
+
+// Handler reacts to an event
+type Handler func(ctx context.Context, event Event) error
+
+// Bus hands each published event to the handlers subscribed to its kind. Services publish what
+// happened and don't know who reacts, so adding a reaction doesn't touch the code making the change.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	all      []Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{handlers: map[string][]Handler{}}
+}
+
+// Subscribe calls fn with every published event of type T
+func Subscribe[T Event](bus *Bus, fn func(ctx context.Context, event T) error) {
+	var zero T
+	name := zero.EventName()
+
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	bus.handlers[name] = append(bus.handlers[name], func(ctx context.Context, event Event) error {
+		return fn(ctx, event.(T))
+	})
+}
+
+// SubscribeAll calls handler with every published event, whatever its kind
+func (b *Bus) SubscribeAll(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.all = append(b.all, handler)
+}
+
+// Publish runs the event's handlers one after another, in the order they subscribed, before
+// returning. The change is already committed, so a handler that fails or panics is logged and
+// the remaining handlers still run.
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	b.mu.RLock()
+	handlers := append(append([]Handler{}, b.handlers[event.EventName()]...), b.all...)
+	b.mu.RUnlock()
+
+	logger := log.Ctx(ctx).With().Str("event", event.EventName()).Logger()
+	for _, handler := range handlers {
+		if err := runHandler(ctx, handler, event); err != nil {
+			logger.Error().Err(err).Msg("Event handler failed")
+		}
+	}
+}
+
+func runHandler(ctx context.Context, handler Handler, event Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event handler panicked: %v", r)
+		}
+	}()
+	return handler(ctx, event)
+}
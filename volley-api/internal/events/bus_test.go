@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("events reach the handlers for their kind in subscription order", func(t *testing.T) {
+		bus := NewBus()
+		var calls []string
+		Subscribe(bus, func(ctx context.Context, event GameCancelled) error {
+			calls = append(calls, "first "+event.Game.ID)
+			return nil
+		})
+		Subscribe(bus, func(ctx context.Context, event GameCancelled) error {
+			calls = append(calls, "second "+event.Game.ID)
+			return nil
+		})
+		Subscribe(bus, func(ctx context.Context, event GameCreated) error {
+			calls = append(calls, "created")
+			return nil
+		})
+		bus.SubscribeAll(func(ctx context.Context, event Event) error {
+			calls = append(calls, "all "+event.EventName())
+			return nil
+		})
+
+		bus.Publish(ctx, GameCancelled{Game: Game{ID: "game-1"}})
+
+		assert.Equal(t, []string{"first game-1", "second game-1", "all game.cancelled"}, calls)
+	})
+
+	t.Run("a failing or panicking handler doesn't stop the others", func(t *testing.T) {
+		bus := NewBus()
+		delivered := false
+		Subscribe(bus, func(ctx context.Context, event ParticipantDropped) error {
+			return errors.New("webhook queue unavailable")
+		})
+		Subscribe(bus, func(ctx context.Context, event ParticipantDropped) error {
+			panic("boom")
+		})
+		Subscribe(bus, func(ctx context.Context, event ParticipantDropped) error {
+			delivered = true
+			return nil
+		})
+
+		bus.Publish(ctx, ParticipantDropped{Game: Game{ID: "game-1"}, UserID: "user-1"})
+
+		assert.True(t, delivered)
+	})
+}
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+)
+
+// Game is the game an event is about, with what subscribers need to describe it
+type Game struct {
+	ID           string
+	OwnerID      string
+	Title        *string
+	Category     string
+	LocationName string
+	StartTime    time.Time
+}
+
+// GameCreated is published when a host creates a game
+type GameCreated struct {
+	Game Game
+}
+
+func (GameCreated) EventName() string { return "game.created" }
+
+// GameCancelled is published when a host or an admin cancels a game
+type GameCancelled struct {
+	Game   Game
+	Reason *string // The host's reason for players; nil when none was given or an admin cancelled
+	// Confirmed and waitlisted players with accounts. Empty when they couldn't be listed, in which
+	// case the notifications are retried as a side effect.
+	Participants []models.User
+}
+
+func (GameCancelled) EventName() string { return "game.cancelled" }
+
+// ParticipantJoined is published when a player signs up for a game
+type ParticipantJoined struct {
+	Game   Game
+	UserID string
+	Status models.ParticipantStatus // Where the player landed: confirmed or waitlist
+}
+
+func (ParticipantJoined) EventName() string { return "participant.joined" }
+
+// ParticipantDropped is published when a player drops out of a game
+type ParticipantDropped struct {
+	Game     Game
+	UserID   string
+	LateDrop bool // The player held a confirmed spot and dropped inside the late-drop window
+}
+
+func (ParticipantDropped) EventName() string { return "participant.dropped" }
+
+// ParticipantPromoted is published when a drop moves a waitlisted player into a confirmed spot
+type ParticipantPromoted struct {
+	Game   Game
+	Player models.User
+}
+
+func (ParticipantPromoted) EventName() string { return "participant.promoted" }
+
+// AnnouncementPosted is published when an organizer sends a message to a game's roster
+type AnnouncementPosted struct {
+	Game     Game
+	AuthorID string
+	Message  string
+	// Confirmed and waitlisted players with accounts, other than the author. Empty when they
+	// couldn't be listed.
+	Participants []models.User
+}
+
+func (AnnouncementPosted) EventName() string { return "announcement.posted" }
+
+// ContactSharingRequested is published when an organizer asks a game's confirmed players to share
+// their phone number
+type ContactSharingRequested struct {
+	Game Game
+	// Confirmed players with accounts who haven't muted reminders for the game
+	Participants []models.User
+}
+
+func (ContactSharingRequested) EventName() string { return "contact_sharing.requested" }
+
+// MessageSent is published when a host or player sends a direct message about a game they share
+type MessageSent struct {
+	Game           Game
+	ConversationID string
+	Sender         models.User
+	Recipient      models.User
+	Body           string
+}
+
+func (MessageSent) EventName() string { return "message.sent" }
+
+// ChatMessagePosted is published when a host or player sends a message in a game's chat room
+type ChatMessagePosted struct {
+	Message models.ChatMessage
+}
+
+func (ChatMessagePosted) EventName() string { return "chat.message_posted" }
@@ -0,0 +1,81 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// Locker decides which replica runs each tick of a job
+type Locker interface {
+	// Claim takes the job's next run for this replica. When claimed, release must be called with
+	// the run's error once it ends.
+	Claim(ctx context.Context, job Job) (release func(runErr error), claimed bool, err error)
+}
+
+const (
+	// jobLease is how long a claimed run blocks other replicas. A run that takes longer can
+	// overlap with the next one, and a crashed replica's runs resume once it expires.
+	jobLease = 10 * time.Minute
+	// jobIntervalSlack is the share of the interval a run may start early, so ticker jitter on the
+	// replica that ran last doesn't make it skip its own next run
+	jobIntervalSlack = 0.1
+)
+
+// PostgresLocker claims job runs in the job_runs table, which every replica shares. A run is
+// claimed when no replica holds the job's lease and its last run started at least an interval ago,
+// so each job runs once per interval however many replicas there are. No connection is held while
+// the job runs.
+type PostgresLocker struct {
+	queries ifaces.Querier
+	replica string
+}
+
+func NewPostgresLocker(queries ifaces.Querier) *PostgresLocker {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return &PostgresLocker{
+		queries: queries,
+		replica: fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}
+}
+
+func (l *PostgresLocker) Claim(ctx context.Context, job Job) (func(runErr error), bool, error) {
+	minGap := job.Interval.Seconds() * (1 - jobIntervalSlack)
+	claimed, err := l.queries.ClaimJobRun(ctx, repository.ClaimJobRunParams{
+		Name:          job.Name,
+		RunBy:         l.replica,
+		LeaseSeconds:  int32(jobLease / time.Second),
+		MinGapSeconds: minGap,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to claim job run: %w", err)
+	}
+	if claimed == 0 {
+		return nil, false, nil
+	}
+
+	release := func(runErr error) {
+		var lastError pgtype.Text
+		if runErr != nil {
+			lastError = pgtype.Text{String: runErr.Error(), Valid: true}
+		}
+		// The run is over even if the context was cancelled during it
+		if err := l.queries.FinishJobRun(context.WithoutCancel(ctx), repository.FinishJobRunParams{
+			Name:      job.Name,
+			LastRunBy: l.replica,
+			LastError: lastError,
+		}); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Failed to release job run - other replicas wait for the lease to expire")
+		}
+	}
+	return release, true, nil
+}
@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Job is a unit of background work run on a fixed interval
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs each registered job on its own ticker until the context is cancelled
+type Scheduler struct {
+	jobs   []Job
+	locker Locker // Keeps replicas from running the same job at once (nil runs every tick)
+}
+
+func NewScheduler(jobs ...Job) *Scheduler {
+	return &Scheduler{jobs: jobs}
+}
+
+// Register adds jobs to the schedule. Services list their periodic work in a Jobs method, so
+// adding a job doesn't touch the server setup. Jobs registered after Start don't run.
+func (s *Scheduler) Register(jobs ...Job) {
+	s.jobs = append(s.jobs, jobs...)
+}
+
+// SetLocker makes every run claim the job first, so each job runs on one replica per interval
+func (s *Scheduler) SetLocker(locker Locker) {
+	s.locker = locker
+}
+
+// Start launches every job in the background. Each job runs once immediately and then on every
+// tick; a failed run is logged and retried on the next tick.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.loop(ctx, job)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	logger := log.With().Str("job", job.Name).Logger()
+	ctx = logger.WithContext(ctx)
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.runClaimed(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			logger.Info().Msg("Job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runClaimed runs the job if this replica claims the run, releasing the claim when it ends
+func (s *Scheduler) runClaimed(ctx context.Context, job Job) {
+	if s.locker == nil {
+		runOnce(ctx, job)
+		return
+	}
+
+	logger := log.Ctx(ctx)
+	release, claimed, err := s.locker.Claim(ctx, job)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to claim job run")
+		return
+	}
+	if !claimed {
+		logger.Debug().Msg("Job run claimed by another replica")
+		return
+	}
+	release(runOnce(ctx, job))
+}
+
+// runOnce runs the job, returning its error. A panic is recovered and returned as an error.
+func runOnce(ctx context.Context, job Job) (err error) {
+	logger := log.Ctx(ctx)
+	start := time.Now()
+
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error().Interface("panic", r).Msg("Job panicked")
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil {
+		logger.Error().Err(err).Msg("Job failed")
+		return err
+	}
+	logger.Debug().Int64("latencyMs", time.Since(start).Milliseconds()).Msg("Job completed")
+	return nil
+}
@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_RunsJobsUntilCancelled(t *testing.T) {
+	var runs atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+
+	NewScheduler(Job{
+		Name:     "test",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return errors.New("failures are retried on the next tick")
+		},
+	}).Start(ctx)
+
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+	stopped := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load())
+}
+
+type fakeLocker struct {
+	claimed  bool
+	released chan error
+}
+
+func (l *fakeLocker) Claim(ctx context.Context, job Job) (func(runErr error), bool, error) {
+	if !l.claimed {
+		return nil, false, nil
+	}
+	return func(runErr error) { l.released <- runErr }, true, nil
+}
+
+func TestScheduler_RunsOnlyClaimedRuns(t *testing.T) {
+	t.Run("unclaimed runs are skipped", func(t *testing.T) {
+		var runs atomic.Int32
+		scheduler := NewScheduler()
+		scheduler.SetLocker(&fakeLocker{claimed: false})
+
+		scheduler.runClaimed(context.Background(), Job{
+			Name: "test",
+			Run: func(ctx context.Context) error {
+				runs.Add(1)
+				return nil
+			},
+		})
+
+		assert.Equal(t, int32(0), runs.Load())
+	})
+
+	t.Run("claimed runs release with the run's error", func(t *testing.T) {
+		runErr := errors.New("run failed")
+		locker := &fakeLocker{claimed: true, released: make(chan error, 1)}
+		scheduler := NewScheduler()
+		scheduler.SetLocker(locker)
+
+		scheduler.runClaimed(context.Background(), Job{
+			Name: "test",
+			Run:  func(ctx context.Context) error { return runErr },
+		})
+
+		assert.ErrorIs(t, <-locker.released, runErr)
+	})
+
+	t.Run("panics release with an error", func(t *testing.T) {
+		locker := &fakeLocker{claimed: true, released: make(chan error, 1)}
+		scheduler := NewScheduler()
+		scheduler.SetLocker(locker)
+
+		scheduler.runClaimed(context.Background(), Job{
+			Name: "test",
+			Run:  func(ctx context.Context) error { panic("boom") },
+		})
+
+		assert.ErrorContains(t, <-locker.released, "boom")
+	})
+}
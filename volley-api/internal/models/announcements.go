@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Announcement is a message an organizer sent to a game's roster
+type Announcement struct {
+	ID        string    `json:"id"`                 // Announcement UUID
+	AuthorID  *string   `json:"authorId,omitempty"` // Organizer who sent it; absent once their account is deleted
+	Message   string    `json:"message"`            // What the organizer said
+	CreatedAt time.Time `json:"createdAt"`          // When it was sent
+}
+
+// CreateAnnouncementRequest represents the request body for sending a message to a game's roster
+type CreateAnnouncementRequest struct {
+	Message string `json:"message" binding:"required,max=1000"` // Message for the players, e.g. "Bring a white shirt"
+}
@@ -1,11 +1,25 @@
 package models
 
+import "time"
+
+// UserRole is an elevated role granted to a user
+type UserRole string
+
+const (
+	UserRoleAdmin        UserRole = "admin"         // Access to /v1/admin endpoints
+	UserRoleVerifiedHost UserRole = "verified_host" // Exempt from game creation limits
+)
+
 // RegisterRequest represents a user registration request
 type RegisterRequest struct {
 	FirstName string `json:"firstName" binding:"required,min=1,max=100"`
 	LastName  string `json:"lastName" binding:"required,min=1,max=100"`
 	Email     string `json:"email" binding:"required,email"`
 	Password  string `json:"password" binding:"required,min=8,max=128"`
+	Birthdate string `json:"birthdate" binding:"required,datetime=2006-01-02"` // Date of birth (YYYY-MM-DD), used for minor protections
+	// Current legal document versions the user agreed to on the sign-up form
+	AcceptedLegalDocuments []LegalDocumentRef `json:"acceptedLegalDocuments" binding:"dive"`
+	ReferralCode           *string            `json:"referralCode,omitempty" binding:"omitempty,max=16"` // Code of the friend who invited the user
 }
 
 // LoginRequest represents a user login request
@@ -27,3 +41,66 @@ type AuthResponse struct {
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refreshToken" binding:"required"`
 }
+
+// StartPhoneVerificationRequest represents a request to send an SMS verification code
+type StartPhoneVerificationRequest struct {
+	PhoneNumber string `json:"phoneNumber" binding:"required,max=32"`
+	CountryCode string `json:"countryCode,omitempty" binding:"max=4"` // Calling code for national numbers, e.g. "44" (defaults to "1")
+}
+
+// PhoneVerification represents a pending SMS verification
+type PhoneVerification struct {
+	PhoneNumber string    `json:"phoneNumber"` // Normalized E.164 phone number the code was sent to
+	ExpiresAt   time.Time `json:"expiresAt"`   // When the code stops being accepted
+}
+
+// VerifyPhoneRequest represents a request to confirm an SMS verification code
+type VerifyPhoneRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric"`
+}
+
+// RequestEmailChangeRequest represents a request to move the account to a new email address
+type RequestEmailChangeRequest struct {
+	NewEmail string `json:"newEmail" binding:"required,email,max=255"`
+	Password string `json:"password" binding:"required"` // Current password, re-entered to authorize the change
+}
+
+// ChangePasswordRequest represents a request to replace the account password
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword" binding:"required"`
+	NewPassword     string `json:"newPassword" binding:"required,min=8,max=128"`
+}
+
+// EmailChange represents a pending email change awaiting confirmation
+type EmailChange struct {
+	NewEmail  string    `json:"newEmail"`  // Address the confirmation link was sent to
+	ExpiresAt time.Time `json:"expiresAt"` // When the link stops being accepted
+}
+
+// ConfirmEmailChangeRequest represents a request to apply an email change using the emailed token
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" binding:"required,max=128"`
+}
+
+// MagicLinkRequest represents a request to email a passwordless sign-in link
+type MagicLinkRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// RedeemMagicLinkRequest represents a request to sign in with the token from a sign-in link
+type RedeemMagicLinkRequest struct {
+	Token string `json:"token" binding:"required,max=128"`
+}
+
+// LoginCodeRequest represents a request to text a sign-in code to a verified phone number
+type LoginCodeRequest struct {
+	PhoneNumber string `json:"phoneNumber" binding:"required,max=32"`
+	CountryCode string `json:"countryCode,omitempty" binding:"max=4"` // Calling code for national numbers, e.g. "44" (defaults to "1")
+}
+
+// VerifyLoginCodeRequest represents a request to sign in with a code sent by SMS
+type VerifyLoginCodeRequest struct {
+	PhoneNumber string `json:"phoneNumber" binding:"required,max=32"`
+	CountryCode string `json:"countryCode,omitempty" binding:"max=4"`
+	Code        string `json:"code" binding:"required,len=6,numeric"`
+}
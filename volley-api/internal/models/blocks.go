@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// BlockedPlayer is a player the host has blocked from joining their games
+type BlockedPlayer struct {
+	UserID    string    `json:"userId"`    // Blocked player's user UUID
+	FirstName string    `json:"firstName"` // Player first name
+	LastName  string    `json:"lastName"`  // Player last name
+	BlockedAt time.Time `json:"blockedAt"` // When the host blocked them
+}
+
+// BlockPlayerRequest represents the request body for blocking a player
+type BlockPlayerRequest struct {
+	UserID string `json:"userId" binding:"required"` // User UUID of the player to block
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// BrokerEventSchemaVersion is the version of the event document published to the external event
+// broker. It only changes when a change would break consumers; new optional fields don't bump it.
+const BrokerEventSchemaVersion = 1
+
+// BrokerEvent is the JSON document published to the external event broker, described by
+// events.schema.json. It identifies people by ID only, never by name or contact details.
+type BrokerEvent struct {
+	SchemaVersion int             `json:"schemaVersion"` // BrokerEventSchemaVersion
+	ID            string          `json:"id"`            // Event UUID; delivery is at least once, so consumers dedupe on it
+	Type          string          `json:"type"`          // What happened, e.g. game.created
+	OccurredAt    time.Time       `json:"occurredAt"`    // When it happened
+	Data          BrokerEventData `json:"data"`          // The game and player involved
+}
+
+// BrokerEventData describes the game an event is about and the player involved
+type BrokerEventData struct {
+	GameID            string             `json:"gameId"`                      // Game UUID
+	HostID            string             `json:"hostId"`                      // UUID of the game's host
+	Category          string             `json:"category"`                    // Game category, e.g. volleyball
+	StartTime         time.Time          `json:"startTime"`                   // When the game starts
+	UserID            *string            `json:"userId,omitempty"`            // Player who joined, dropped or was promoted
+	ParticipantStatus *ParticipantStatus `json:"participantStatus,omitempty"` // Where a joining player landed: confirmed or waitlist
+	LateDrop          *bool              `json:"lateDrop,omitempty"`          // Whether a dropping player left a confirmed spot inside the late-drop window
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// ChatMessage is a message in a game's chat room
+type ChatMessage struct {
+	ID              string    `json:"id"`                 // Message UUID
+	GameID          string    `json:"gameId"`             // Game whose chat it was sent in
+	SenderID        *string   `json:"senderId,omitempty"` // Who sent it; absent once their account is deleted
+	SenderFirstName string    `json:"senderFirstName"`    // Sender's first name
+	SenderLastName  string    `json:"senderLastName"`     // Sender's last name
+	Body            string    `json:"body"`               // What they said
+	CreatedAt       time.Time `json:"createdAt"`          // When it was sent
+}
+
+// ChatFrameType is the kind of a frame sent over a game chat WebSocket
+type ChatFrameType string
+
+const (
+	ChatFrameMessage ChatFrameType = "message" // A message was sent in the chat
+	ChatFrameError   ChatFrameType = "error"   // The client's last message wasn't sent
+)
+
+// ChatFrame is what the server sends over a game chat WebSocket
+type ChatFrame struct {
+	Type    ChatFrameType `json:"type"`              // Kind of frame
+	Message *ChatMessage  `json:"message,omitempty"` // The message, for message frames
+	Error   string        `json:"error,omitempty"`   // Why the client's message wasn't sent, for error frames
+}
+
+// SendChatMessageRequest is what clients send over a game chat WebSocket
+type SendChatMessageRequest struct {
+	Body string `json:"body"` // Message, e.g. "Running 5 minutes late"
+}
+
+// ListChatMessagesResponse represents the response for a page of a game's chat history
+type ListChatMessagesResponse struct {
+	Messages []ChatMessage `json:"messages"` // Messages, newest first
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// Comment is a public comment on a game. Replies are nested under the comment that starts their
+// thread.
+type Comment struct {
+	ID              string     `json:"id"`                 // Comment UUID
+	ParentID        *string    `json:"parentId,omitempty"` // Comment starting the thread it replies to; absent for top-level comments
+	AuthorID        *string    `json:"authorId,omitempty"` // Who wrote it; absent once deleted or their account is
+	AuthorFirstName string     `json:"authorFirstName"`    // Author's first name
+	AuthorLastName  string     `json:"authorLastName"`     // Author's last name
+	Body            string     `json:"body"`               // What they said; empty once deleted
+	Deleted         bool       `json:"deleted"`            // Removed by its author or the host; kept so its replies still have a thread
+	EditedAt        *time.Time `json:"editedAt,omitempty"` // When the author last changed it
+	CreatedAt       time.Time  `json:"createdAt"`          // When it was posted
+	Replies         []Comment  `json:"replies,omitempty"`  // Replies, oldest first; only on top-level comments
+}
+
+// CreateCommentRequest represents the request body for commenting on a game
+type CreateCommentRequest struct {
+	Body     string  `json:"body" binding:"required,max=2000"` // Comment, e.g. "Is this beginner friendly?"
+	ParentID *string `json:"parentId,omitempty"`               // Comment to reply to; a reply to a reply joins the same thread
+}
+
+// UpdateCommentRequest represents the request body for editing a comment
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,max=2000"` // New text
+}
+
+// ListCommentsResponse represents the response for listing a game's comments
+type ListCommentsResponse struct {
+	Comments []Comment `json:"comments"` // Top-level comments, oldest first, with their replies
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// SharedContact is a confirmed participant's phone number, shared with the organizer of one game
+type SharedContact struct {
+	UserID      string    `json:"userId"`      // Participant's user UUID
+	FirstName   string    `json:"firstName"`   // Participant's first name
+	LastName    string    `json:"lastName"`    // Participant's last name
+	PhoneNumber string    `json:"phoneNumber"` // Verified phone number in E.164 format
+	ConsentedAt time.Time `json:"consentedAt"` // When the participant agreed to share it
+}
+
+// ContactSharing is an organizer's request for participant contacts and the contacts shared so far
+type ContactSharing struct {
+	GameID      string          `json:"gameId"`      // Game UUID
+	RequestedAt time.Time       `json:"requestedAt"` // When the organizer asked for contacts
+	ExpiresAt   time.Time       `json:"expiresAt"`   // When the game ends and access to the contacts ends
+	Contacts    []SharedContact `json:"contacts"`    // Participants who agreed to share, by last name
+}
@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// Conversation is a direct message thread between a game's host and one of its players
+type Conversation struct {
+	ID                  string     `json:"id"`                            // Conversation UUID
+	GameID              string     `json:"gameId"`                        // Game the two users share
+	GameTitle           string     `json:"gameTitle"`                     // Game's title, or its category when it has none
+	GameStartTime       time.Time  `json:"gameStartTime"`                 // When the game starts
+	IsHost              bool       `json:"isHost"`                        // Whether the current user hosts the game
+	OtherUserID         string     `json:"otherUserId"`                   // The other user in the conversation
+	OtherFirstName      string     `json:"otherFirstName"`                // The other user's first name
+	OtherLastName       string     `json:"otherLastName"`                 // The other user's last name
+	LastMessage         *string    `json:"lastMessage,omitempty"`         // Most recent message; absent until one is sent
+	LastMessageSenderID *string    `json:"lastMessageSenderId,omitempty"` // Who sent the most recent message
+	LastMessageAt       *time.Time `json:"lastMessageAt,omitempty"`       // When the most recent message was sent
+	UnreadCount         int        `json:"unreadCount"`                   // Messages from the other user the current user hasn't read
+	CreatedAt           time.Time  `json:"createdAt"`                     // When the conversation was started
+}
+
+// ConversationMessage is one message in a conversation
+type ConversationMessage struct {
+	ID        string    `json:"id"`        // Message UUID
+	SenderID  string    `json:"senderId"`  // User who sent it
+	Body      string    `json:"body"`      // What they said
+	CreatedAt time.Time `json:"createdAt"` // When it was sent
+}
+
+// StartConversationRequest represents the request body for opening a conversation about a game
+type StartConversationRequest struct {
+	GameID string  `json:"gameId" binding:"required"` // Game the conversation is about
+	UserID *string `json:"userId,omitempty"`          // Player the host wants to message; required from the host, ignored from players
+}
+
+// SendMessageRequest represents the request body for sending a message in a conversation
+type SendMessageRequest struct {
+	Body string `json:"body" binding:"required,max=2000"` // Message, e.g. "Is there parking nearby?"
+}
+
+// ListConversationsResponse represents the response for listing the user's conversations
+type ListConversationsResponse struct {
+	Conversations []Conversation `json:"conversations"` // Conversations, latest activity first
+}
+
+// ListMessagesResponse represents the response for listing a conversation's messages
+type ListMessagesResponse struct {
+	Messages []ConversationMessage `json:"messages"` // Messages, newest first
+}
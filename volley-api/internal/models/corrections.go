@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// CorrectionField is the part of a participant's record an organizer corrected
+type CorrectionField string
+
+const (
+	CorrectionFieldAttendance         CorrectionField = "attendance"         // Attendance status
+	CorrectionFieldPaid               CorrectionField = "paid"               // Whether the participant paid
+	CorrectionFieldPaymentAmountCents CorrectionField = "paymentAmountCents" // Amount the participant paid
+)
+
+// CorrectParticipationRequest fixes a participant's record after the fact. Omitted fields are left
+// as they are; at least one must be given.
+type CorrectParticipationRequest struct {
+	Attendance         *AttendanceStatus `json:"attendance,omitempty" binding:"omitempty,oneof=attended no_show"` // Corrected attendance
+	Paid               *bool             `json:"paid,omitempty"`                                                  // Corrected paid flag
+	PaymentAmountCents *int              `json:"paymentAmountCents,omitempty" binding:"omitempty,min=0"`          // Corrected amount paid
+	Reason             string            `json:"reason" binding:"required,max=500"`                               // Why the record was wrong; kept in the audit log
+}
+
+// Correction is one audited change an organizer made to a participant's record
+type Correction struct {
+	ID          string          `json:"id"`                    // Correction UUID
+	UserID      string          `json:"userId"`                // Participant whose record was corrected
+	Field       CorrectionField `json:"field"`                 // Corrected field
+	OldValue    *string         `json:"oldValue,omitempty"`    // Value before the correction
+	NewValue    *string         `json:"newValue,omitempty"`    // Value after the correction
+	Reason      string          `json:"reason"`                // Why the record was corrected
+	CorrectedBy *string         `json:"correctedBy,omitempty"` // UUID of the organizer who made it
+	CorrectedAt time.Time       `json:"correctedAt"`           // When it was made
+}
+
+// ListCorrectionsResponse represents a game's correction audit log
+type ListCorrectionsResponse struct {
+	Corrections []Correction `json:"corrections"` // Corrections ordered from newest to oldest
+}
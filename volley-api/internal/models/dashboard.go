@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// OrganizerActionType is the kind of follow-up an organizer has on one of their games
+type OrganizerActionType string
+
+const (
+	OrganizerActionUnpaidPlayers OrganizerActionType = "unpaid_players" // Confirmed players in a paid game who haven't paid
+	OrganizerActionLowFill       OrganizerActionType = "low_fill"       // Game starts soon with less than half its spots filled
+)
+
+// OrganizerGame is the game an organizer action is about
+type OrganizerGame struct {
+	ID              string       `json:"id"`              // Game UUID
+	Title           *string      `json:"title,omitempty"` // Custom title
+	Category        GameCategory `json:"category"`        // Sport category
+	LocationName    string       `json:"locationName"`    // Venue or field name
+	StartTime       time.Time    `json:"startTime"`       // Game start time
+	MaxParticipants int          `json:"maxParticipants"` // Maximum number of players
+	ConfirmedCount  int          `json:"confirmedCount"`  // Confirmed participants right now
+	Status          GameStatus   `json:"status"`          // Current game status
+}
+
+// OrganizerAction is one actionable item on the organizer dashboard
+type OrganizerAction struct {
+	Type    OrganizerActionType `json:"type"`              // Kind of action
+	Game    OrganizerGame       `json:"game"`              // Game the action is about
+	Players []User              `json:"players,omitempty"` // Players involved (unpaid players)
+}
+
+// OrganizerDashboard is everything the app home screen shows an organizer, in one response
+type OrganizerDashboard struct {
+	UpcomingGames []OrganizerGame   `json:"upcomingGames"` // Owner's upcoming games, soonest first
+	Actions       []OrganizerAction `json:"actions"`       // Actionable items, soonest game first
+}
+
+// PlayerGame is a game on the player dashboard
+type PlayerGame struct {
+	ID           string       `json:"id"`              // Game UUID
+	Title        *string      `json:"title,omitempty"` // Custom title
+	Category     GameCategory `json:"category"`        // Sport category
+	LocationName string       `json:"locationName"`    // Venue or field name
+	StartTime    time.Time    `json:"startTime"`       // Game start time
+	Status       GameStatus   `json:"status"`          // Current game status
+}
+
+// WaitlistSpot is the player's place on an upcoming game's waitlist
+type WaitlistSpot struct {
+	Game     PlayerGame `json:"game"`     // Game the player is waitlisted for
+	Position int        `json:"position"` // 1-based position in the waitlist
+}
+
+// PlayerDashboard is everything the app home screen shows a player, in one response
+type PlayerDashboard struct {
+	NextGame         *PlayerGame    `json:"nextGame"`         // Soonest upcoming game the player is confirmed for
+	Waitlists        []WaitlistSpot `json:"waitlists"`        // Upcoming games the player is waitlisted for, soonest first
+	RecommendedGames []GameSummary  `json:"recommendedGames"` // Open games nearby in sports the player has played
+}
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// Deactivation is the outcome of the user switching their account off
+type Deactivation struct {
+	DeactivatedAt time.Time    `json:"deactivatedAt"` // When the account was deactivated
+	DroppedGames  []PlayerGame `json:"droppedGames"`  // Upcoming games the user was dropped from
+	KeptGames     []PlayerGame `json:"keptGames"`     // Games past their drop deadline; the user is still on these rosters
+}
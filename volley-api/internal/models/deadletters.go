@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DeadLetterSource is the queue a dead letter ran out of retries in
+type DeadLetterSource string
+
+const (
+	DeadLetterSideEffect           DeadLetterSource = "side_effect"
+	DeadLetterWebhookDelivery      DeadLetterSource = "webhook_delivery"
+	DeadLetterNotificationDelivery DeadLetterSource = "notification_delivery"
+)
+
+// DeadLetterAttempt is one failed attempt at the work
+type DeadLetterAttempt struct {
+	Attempt int       `json:"attempt"` // 1 for the first attempt
+	Error   string    `json:"error"`   // Why it failed
+	At      time.Time `json:"at"`      // When it failed
+}
+
+// DeadLetter is queued work that ran out of retries, kept for an admin to inspect and re-enqueue
+type DeadLetter struct {
+	ID             string              `json:"id"`                   // Dead letter UUID
+	Source         DeadLetterSource    `json:"source"`               // Queue the work ran in
+	SourceID       string              `json:"sourceId"`             // Row UUID in that queue
+	Kind           string              `json:"kind"`                 // Side effect kind, webhook event type or notification channel
+	Payload        json.RawMessage     `json:"payload"`              // What re-enqueueing runs: the game, the subscription and event, or the message
+	Attempts       int                 `json:"attempts"`             // Attempts made before giving up
+	AttemptHistory []DeadLetterAttempt `json:"attemptHistory"`       // Failed attempts, oldest first
+	LastError      string              `json:"lastError"`            // Error of the final attempt
+	CreatedAt      time.Time           `json:"createdAt"`            // When the work was given up on
+	RequeuedAt     *time.Time          `json:"requeuedAt,omitempty"` // When an admin re-enqueued it
+	RequeuedBy     *string             `json:"requeuedBy,omitempty"` // Admin who re-enqueued it
+}
+
+// ListDeadLettersResponse lists dead letters, newest first
+type ListDeadLettersResponse struct {
+	DeadLetters []DeadLetter `json:"deadLetters"`
+}
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// ExportFormat is the encoding used to stream an admin export
+type ExportFormat string
+
+const (
+	ExportFormatCSV    ExportFormat = "csv"    // Comma-separated values with a header row
+	ExportFormatNDJSON ExportFormat = "ndjson" // One JSON object per line
+)
+
+// GameExport is one row of the admin games export
+type GameExport struct {
+	ID                 string       `json:"id"`                    // Game UUID
+	OwnerID            string       `json:"ownerId"`               // Owner user UUID
+	Category           GameCategory `json:"category"`              // Sport category
+	Title              *string      `json:"title,omitempty"`       // Custom title
+	Status             GameStatus   `json:"status"`                // Game status at export time
+	LocationName       string       `json:"locationName"`          // Venue or field name
+	Latitude           *float64     `json:"latitude,omitempty"`    // Latitude coordinate
+	Longitude          *float64     `json:"longitude,omitempty"`   // Longitude coordinate
+	StartTime          time.Time    `json:"startTime"`             // Game start time
+	DurationMinutes    int          `json:"durationMinutes"`       // Duration in minutes
+	MaxParticipants    int          `json:"maxParticipants"`       // Maximum number of players
+	ConfirmedCount     int          `json:"confirmedCount"`        // Confirmed participants
+	WaitlistCount      int          `json:"waitlistCount"`         // Waitlisted participants
+	PricingType        PricingType  `json:"pricingType"`           // Pricing type
+	PricingAmountCents int          `json:"pricingAmountCents"`    // Amount in cents
+	PricingCurrency    string       `json:"pricingCurrency"`       // Currency code
+	AdultOnly          bool         `json:"adultOnly"`             // Whether the game is restricted to adults
+	CancelledAt        *time.Time   `json:"cancelledAt,omitempty"` // When the game was cancelled
+	CreatedAt          time.Time    `json:"createdAt"`             // Creation timestamp
+}
+
+// GameExportCSVHeader lists the CSV columns in the order written by GameExport.CSVRecord
+var GameExportCSVHeader = []string{
+	"id", "owner_id", "category", "title", "status", "location_name", "latitude", "longitude",
+	"start_time", "duration_minutes", "max_participants", "confirmed_count", "waitlist_count",
+	"pricing_type", "pricing_amount_cents", "pricing_currency", "adult_only", "cancelled_at", "created_at",
+}
+
+// CSVRecord formats the row for CSV output; missing optional values are empty cells
+func (g GameExport) CSVRecord() []string {
+	optString := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	optFloat := func(f *float64) string {
+		if f == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*f, 'f', -1, 64)
+	}
+	optTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	return []string{
+		g.ID,
+		g.OwnerID,
+		string(g.Category),
+		optString(g.Title),
+		string(g.Status),
+		g.LocationName,
+		optFloat(g.Latitude),
+		optFloat(g.Longitude),
+		g.StartTime.Format(time.RFC3339),
+		strconv.Itoa(g.DurationMinutes),
+		strconv.Itoa(g.MaxParticipants),
+		strconv.Itoa(g.ConfirmedCount),
+		strconv.Itoa(g.WaitlistCount),
+		string(g.PricingType),
+		strconv.Itoa(g.PricingAmountCents),
+		g.PricingCurrency,
+		strconv.FormatBool(g.AdultOnly),
+		optTime(g.CancelledAt),
+		g.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -58,6 +58,24 @@ const (
 	ParticipantStatusRemoved   ParticipantStatus = "removed"   // Removed from game
 )
 
+// DropReason is why a participant dropped from a game
+type DropReason string
+
+const (
+	DropReasonInjury           DropReason = "injury"
+	DropReasonScheduleConflict DropReason = "schedule_conflict"
+	DropReasonWeather          DropReason = "weather"
+	DropReasonOther            DropReason = "other"
+)
+
+// AttendanceStatus is whether a confirmed participant showed up, as marked by the host
+type AttendanceStatus string
+
+const (
+	AttendanceStatusAttended AttendanceStatus = "attended"
+	AttendanceStatusNoShow   AttendanceStatus = "no_show"
+)
+
 // Location represents the location details of a game
 type Location struct {
 	Name      string   `json:"name"`                // Venue or field name
@@ -69,40 +87,78 @@ type Location struct {
 
 // Pricing represents the pricing details of a game
 type Pricing struct {
-	Type        PricingType `json:"type"`        // Pricing type
-	AmountCents int         `json:"amountCents"` // Amount in cents (0 for free)
-	Currency    string      `json:"currency"`    // Currency code (default: USD)
+	Type        PricingType `json:"type"`                 // Pricing type
+	AmountCents int         `json:"amountCents"`          // Amount in cents (0 for free)
+	Currency    string      `json:"currency"`             // Currency code (default: USD)
+	ShareCents  *int        `json:"shareCents,omitempty"` // Each confirmed player's share of a total price, rounded up (total pricing only)
 }
 
 // User represents a basic user structure
 type User struct {
-	ID        string    `json:"id"`                  // User UUID
-	Email     string    `json:"email"`               // User email
-	FirstName string    `json:"firstName"`           // User first name
-	LastName  string    `json:"lastName"`            // User last name
-	CreatedAt time.Time `json:"createdAt,omitempty"` // Account creation timestamp
+	ID            string    `json:"id"`                    // User UUID
+	Email         string    `json:"email"`                 // User email
+	FirstName     string    `json:"firstName"`             // User first name
+	LastName      string    `json:"lastName"`              // User last name
+	PhoneNumber   *string   `json:"phoneNumber,omitempty"` // Verified phone number in E.164 format
+	PhoneVerified bool      `json:"phoneVerified"`         // Whether the user has verified a phone number
+	CreatedAt     time.Time `json:"createdAt,omitempty"`   // Account creation timestamp
+	HomeRegion    string    `json:"homeRegion,omitempty"`  // Region the user's data is homed to
+	TokenVersion  int32     `json:"-"`                     // Current access token version, embedded in issued JWTs
 }
 
 // Team represents a team in a game
 type Team struct {
-	ID        string    `json:"id"`              // Team UUID
-	GameID    string    `json:"gameId"`          // Game UUID this team belongs to
-	Name      string    `json:"name"`            // Team name
-	Color     *string   `json:"color,omitempty"` // Hex color code
-	CreatedAt time.Time `json:"createdAt"`       // Team creation timestamp
+	ID        string        `json:"id"`                // Team UUID
+	GameID    string        `json:"gameId"`            // Game UUID this team belongs to
+	Name      string        `json:"name"`              // Team name
+	Color     *string       `json:"color,omitempty"`   // Hex color code
+	Players   []Participant `json:"players,omitempty"` // Confirmed and waitlisted participants on the team (game details only)
+	CreatedAt time.Time     `json:"createdAt"`         // Team creation timestamp
+}
+
+// CreateTeamRequest represents a host adding a team to their game
+type CreateTeamRequest struct {
+	Name  string  `json:"name" binding:"required,min=1,max=100"`     // Team name
+	Color *string `json:"color,omitempty" binding:"omitempty,max=7"` // Hex color code (#RGB or #RRGGBB)
+}
+
+// UpdateTeamRequest represents a host renaming or recoloring a team
+type UpdateTeamRequest struct {
+	Name  *string `json:"name,omitempty" binding:"omitempty,min=1,max=100"` // Team name
+	Color *string `json:"color,omitempty" binding:"omitempty,max=7"`        // Hex color code (#RGB or #RRGGBB)
+}
+
+// ListTeamsResponse represents the response for listing a game's teams
+type ListTeamsResponse struct {
+	Teams []Team `json:"teams"` // Teams with their players
+}
+
+// AssignTeamPlayersRequest represents a host putting participants on a team. Players already on
+// another team of the game move to this one.
+type AssignTeamPlayersRequest struct {
+	UserIDs []string `json:"userIds" binding:"required,min=1,max=100,dive,uuid"` // Participants' user UUIDs (placeholderId for placeholders)
 }
 
 // Participant represents a user's participation in a game
 type Participant struct {
-	User                                 // Embedded user (id, email, name, createdAt)
-	TeamID             *string           `json:"teamId,omitempty"`             // Team UUID (if assigned)
-	Status             ParticipantStatus `json:"status"`                       // Participant status
-	WaitlistPosition   *int              `json:"waitlistPosition,omitempty"`   // Position in waitlist
-	Paid               bool              `json:"paid"`                         // Payment status
-	PaymentAmountCents *int              `json:"paymentAmountCents,omitempty"` // Amount paid in cents
-	Notes              *string           `json:"notes,omitempty"`              // Additional notes
-	JoinedAt           time.Time         `json:"joinedAt"`                     // When they joined
-	UpdatedAt          time.Time         `json:"updatedAt"`                    // Last update timestamp
+	User                                  // Embedded user (id, email, name, createdAt); only firstName is set for placeholders
+	PlaceholderID      *string            `json:"placeholderId,omitempty"`      // Participant UUID of a name-only placeholder (no account)
+	TeamID             *string            `json:"teamId,omitempty"`             // Team UUID (if assigned)
+	Position           *string            `json:"position,omitempty"`           // Position signed up for, in games with positions
+	Status             ParticipantStatus  `json:"status"`                       // Participant status
+	WaitlistPosition   *int               `json:"waitlistPosition,omitempty"`   // Position in waitlist
+	Paid               bool               `json:"paid"`                         // Payment status
+	PaymentAmountCents *int               `json:"paymentAmountCents,omitempty"` // Amount paid in cents
+	ShareCents         *int               `json:"shareCents,omitempty"`         // What they owe of a total price (confirmed players, total pricing only)
+	Notes              *string            `json:"notes,omitempty"`              // Additional notes
+	DropReason         *DropReason        `json:"dropReason,omitempty"`         // Why they dropped (only shown to the host)
+	LateDrop           bool               `json:"lateDrop,omitempty"`           // Dropped a confirmed spot inside the late-drop window (only shown to the host)
+	CheckedInAt        *time.Time         `json:"checkedInAt,omitempty"`        // When they checked in at the venue
+	RecentAttendance   *AttendanceSummary `json:"recentAttendance,omitempty"`   // No-shows in their last games (only shown to the host)
+	Reliability        *ReliabilityScore  `json:"reliability,omitempty"`        // How often they honor their spot (omitted until they finish a game)
+	Skill              *SkillConfidence   `json:"skill,omitempty"`              // Skill level other players vouch for (omitted until endorsed)
+	JoinedAt           time.Time          `json:"joinedAt"`                     // When they joined
+	UpdatedAt          time.Time          `json:"updatedAt"`                    // Last update timestamp
 }
 
 // GameSummary represents essential game details for list views
@@ -115,70 +171,301 @@ type GameSummary struct {
 	StartTime               time.Time          `json:"startTime"`                         // Game start time
 	DurationMinutes         int                `json:"durationMinutes"`                   // Duration in minutes
 	MaxParticipants         int                `json:"maxParticipants"`                   // Maximum number of players
-	SignupCount             int                `json:"signupCount"`                       // Number of participants signed up
+	SignupCount             int                `json:"signupCount"`                       // Number of participants signed up (confirmed + waitlist)
+	ConfirmedCount          int                `json:"confirmedCount"`                    // Number of confirmed participants
+	WaitlistCount           int                `json:"waitlistCount"`                     // Number of waitlisted participants
 	Pricing                 Pricing            `json:"pricing"`                           // Pricing details
 	SignupDeadline          time.Time          `json:"signupDeadline"`                    // Sign-up deadline
 	SkillLevel              SkillLevel         `json:"skillLevel"`                        // Required skill level
+	AdultOnly               bool               `json:"adultOnly"`                         // Whether the game is restricted to adults
 	Status                  GameStatus         `json:"status"`                            // Current game status
 	UserParticipationStatus *ParticipantStatus `json:"userParticipationStatus,omitempty"` // Current user's participation status (if authenticated)
+	DistanceMeters          float64            `json:"distanceMeters"`                    // Distance from the search point, rounded to the meter
+	Tags                    []string           `json:"tags"`                              // Host's tags, e.g. "indoor", sorted
 }
 
 // Game represents a pickup sports game with full details
 type Game struct {
-	ID                    string        `json:"id"`                              // Game UUID
-	Owner                 *User         `json:"owner,omitempty"`                 // Owner user details
-	Category              GameCategory  `json:"category"`                        // Sport category
-	Title                 *string       `json:"title,omitempty"`                 // Custom title
-	Description           *string       `json:"description,omitempty"`           // Game description
-	Location              Location      `json:"location"`                        // Location details
-	StartTime             time.Time     `json:"startTime"`                       // Game start time
-	DurationMinutes       int           `json:"durationMinutes"`                 // Duration in minutes
-	MaxParticipants       int           `json:"maxParticipants"`                 // Maximum number of players
-	ConfirmedParticipants []Participant `json:"confirmedParticipants,omitempty"` // Confirmed participants (up to max)
-	Waitlist              []Participant `json:"waitlist,omitempty"`              // Waitlisted participants (beyond max)
-	Pricing               Pricing       `json:"pricing"`                         // Pricing details
-	SignupDeadline        time.Time     `json:"signupDeadline"`                  // Sign-up deadline
-	DropDeadline          *time.Time    `json:"dropDeadline,omitempty"`          // Drop deadline (optional)
-	SkillLevel            SkillLevel    `json:"skillLevel"`                      // Required skill level
-	Notes                 *string       `json:"notes,omitempty"`                 // Additional notes
-	Status                GameStatus    `json:"status"`                          // Current game status
-	CancelledAt           *time.Time    `json:"cancelledAt,omitempty"`           // When the game was cancelled
-	CreatedAt             time.Time     `json:"createdAt"`                       // Creation timestamp
-	UpdatedAt             time.Time     `json:"updatedAt"`                       // Last update timestamp
+	ID                    string         `json:"id"`                              // Game UUID
+	Owner                 *User          `json:"owner,omitempty"`                 // Owner user details
+	Category              GameCategory   `json:"category"`                        // Sport category
+	Title                 *string        `json:"title,omitempty"`                 // Custom title
+	Description           *string        `json:"description,omitempty"`           // Game description
+	Location              Location       `json:"location"`                        // Location details
+	StartTime             time.Time      `json:"startTime"`                       // Game start time
+	DurationMinutes       int            `json:"durationMinutes"`                 // Duration in minutes
+	MaxParticipants       int            `json:"maxParticipants"`                 // Maximum number of players
+	ConfirmedParticipants []Participant  `json:"confirmedParticipants,omitempty"` // Confirmed participants (up to max)
+	Waitlist              []Participant  `json:"waitlist,omitempty"`              // Waitlisted participants (beyond max)
+	Positions             []GamePosition `json:"positions,omitempty"`             // Positions sign-ups are for, each with its own cap
+	Teams                 []Team         `json:"teams,omitempty"`                 // Teams with their players
+	Announcements         []Announcement `json:"announcements,omitempty"`         // Messages from the organizers, newest first
+	Pricing               Pricing        `json:"pricing"`                         // Pricing details
+	SignupDeadline        time.Time      `json:"signupDeadline"`                  // Sign-up deadline
+	DropDeadline          *time.Time     `json:"dropDeadline,omitempty"`          // Drop deadline (optional)
+	LateDropWindowHours   int            `json:"lateDropWindowHours"`             // Drops this close to the start are flagged as late (0 disables)
+	SkillLevel            SkillLevel     `json:"skillLevel"`                      // Required skill level
+	AdultOnly             bool           `json:"adultOnly"`                       // Whether the game is restricted to adults
+	Tags                  []string       `json:"tags"`                            // Host's tags, e.g. "indoor", sorted
+	Notes                 *string        `json:"notes,omitempty"`                 // Additional notes
+	Status                GameStatus     `json:"status"`                          // Current game status
+	CancelledAt           *time.Time     `json:"cancelledAt,omitempty"`           // When the game was cancelled
+	CreatedAt             time.Time      `json:"createdAt"`                       // Creation timestamp
+	UpdatedAt             time.Time      `json:"updatedAt"`                       // Last update timestamp
 }
 
 // CreateGameRequest represents a request to create a new game
 type CreateGameRequest struct {
-	Category        GameCategory `json:"category" binding:"required"`               // Sport category
-	Title           *string      `json:"title,omitempty"`                           // Custom title
-	Description     *string      `json:"description,omitempty"`                     // Game description
-	Location        Location     `json:"location" binding:"required"`               // Location details
-	StartTime       time.Time    `json:"startTime" binding:"required"`              // Game start time
-	DurationMinutes int          `json:"durationMinutes" binding:"required,min=15"` // Duration in minutes
-	MaxParticipants int          `json:"maxParticipants" binding:"required,min=2"`  // Maximum number of players
-	Pricing         Pricing      `json:"pricing" binding:"required"`                // Pricing details
-	SignupDeadline  *time.Time   `json:"signupDeadline,omitempty"`                  // Sign-up deadline (defaults to start_time)
-	DropDeadline    *time.Time   `json:"dropDeadline,omitempty"`                    // Drop deadline (optional)
-	SkillLevel      *SkillLevel  `json:"skillLevel,omitempty"`                      // Required skill level (defaults to "all")
-	Notes           *string      `json:"notes,omitempty"`                           // Additional notes
+	Category            GameCategory   `json:"category" binding:"required"`                                     // Sport category
+	Title               *string        `json:"title,omitempty"`                                                 // Custom title
+	Description         *string        `json:"description,omitempty"`                                           // Game description
+	Location            Location       `json:"location" binding:"required"`                                     // Location details
+	StartTime           time.Time      `json:"startTime" binding:"required"`                                    // Game start time
+	DurationMinutes     int            `json:"durationMinutes" binding:"required,min=15"`                       // Duration in minutes
+	MaxParticipants     int            `json:"maxParticipants" binding:"required,min=2"`                        // Maximum number of players
+	Pricing             Pricing        `json:"pricing" binding:"required"`                                      // Pricing details
+	SignupDeadline      *time.Time     `json:"signupDeadline,omitempty"`                                        // Sign-up deadline (defaults to start_time)
+	DropDeadline        *time.Time     `json:"dropDeadline,omitempty"`                                          // Drop deadline (optional)
+	LateDropWindowHours *int           `json:"lateDropWindowHours,omitempty" binding:"omitempty,min=0,max=168"` // Hours before the start when drops count as late (defaults to 24, 0 disables)
+	SkillLevel          *SkillLevel    `json:"skillLevel,omitempty"`                                            // Required skill level (defaults to "all")
+	AdultOnly           bool           `json:"adultOnly,omitempty"`                                             // Restrict the game to adults
+	Notes               *string        `json:"notes,omitempty"`                                                 // Additional notes
+	Positions           []GamePosition `json:"positions,omitempty" binding:"omitempty,dive"`                    // Positions players sign up for (optional)
+	Tags                []string       `json:"tags,omitempty"`                                                  // Free-form tags, e.g. "indoor", "beginner-friendly" (optional)
 }
 
 // ListGamesResponse represents the response for listing games
 type ListGamesResponse struct {
-	Games []GameSummary `json:"games"` // List of game summaries
+	Games      []GameSummary `json:"games"`      // List of game summaries
+	TotalCount int           `json:"totalCount"` // Games matching the filters across every page; 0 for a page past the end
+	HasMore    bool          `json:"hasMore"`    // Whether a later page has more games
 }
 
 // UpdateGameRequest represents a request to update an existing game
 type UpdateGameRequest struct {
-	Title           *string     `json:"title,omitempty"`                                      // Custom title
-	Description     *string     `json:"description,omitempty"`                                // Game description
-	Location        *Location   `json:"location,omitempty"`                                   // Location details
-	StartTime       *time.Time  `json:"startTime,omitempty"`                                  // Game start time
-	DurationMinutes *int        `json:"durationMinutes,omitempty" binding:"omitempty,min=15"` // Duration in minutes
-	MaxParticipants *int        `json:"maxParticipants,omitempty" binding:"omitempty,min=2"`  // Maximum number of players
-	Pricing         *Pricing    `json:"pricing,omitempty"`                                    // Pricing details
-	SignupDeadline  *time.Time  `json:"signupDeadline,omitempty"`                             // Sign-up deadline
-	SkillLevel      *SkillLevel `json:"skillLevel,omitempty"`                                 // Required skill level
-	Notes           *string     `json:"notes,omitempty"`                                      // Additional notes
-	Status          *GameStatus `json:"status,omitempty"`                                     // Game status
+	Title               *string     `json:"title,omitempty"`                                                 // Custom title
+	Description         *string     `json:"description,omitempty"`                                           // Game description
+	Location            *Location   `json:"location,omitempty"`                                              // Location details
+	StartTime           *time.Time  `json:"startTime,omitempty"`                                             // Game start time
+	DurationMinutes     *int        `json:"durationMinutes,omitempty" binding:"omitempty,min=15"`            // Duration in minutes
+	MaxParticipants     *int        `json:"maxParticipants,omitempty" binding:"omitempty,min=2"`             // Maximum number of players
+	Pricing             *Pricing    `json:"pricing,omitempty"`                                               // Pricing details
+	SignupDeadline      *time.Time  `json:"signupDeadline,omitempty"`                                        // Sign-up deadline
+	LateDropWindowHours *int        `json:"lateDropWindowHours,omitempty" binding:"omitempty,min=0,max=168"` // Hours before the start when drops count as late (0 disables)
+	SkillLevel          *SkillLevel `json:"skillLevel,omitempty"`                                            // Required skill level
+	AdultOnly           *bool       `json:"adultOnly,omitempty"`                                             // Restrict the game to adults
+	Notes               *string     `json:"notes,omitempty"`                                                 // Additional notes
+	Status              *GameStatus `json:"status,omitempty"`                                                // Game status
+	Tags                *[]string   `json:"tags,omitempty"`                                                  // Replaces every tag; an empty list clears them
+}
+
+// ParticipationResponse represents the response for joining or dropping from a game
+type ParticipationResponse struct {
+	Game      *Game              `json:"game,omitempty"`      // Game with its refreshed roster
+	MyStatus  *ParticipantStatus `json:"myStatus,omitempty"`  // Current user's participation status after the change
+	LateDrop  bool               `json:"lateDrop,omitempty"`  // The drop fell inside the game's late-drop window (drop only)
+	Conflicts []PlayerGame       `json:"conflicts,omitempty"` // Overlapping games the user is confirmed for (join only)
+}
+
+// GameChange represents a recorded change to a game's material details
+type GameChange struct {
+	ID        string    `json:"id"`                  // Change UUID
+	Field     string    `json:"field"`               // Changed field (e.g. startTime, location.name, pricing.amountCents)
+	OldValue  *string   `json:"oldValue,omitempty"`  // Value before the change
+	NewValue  *string   `json:"newValue,omitempty"`  // Value after the change
+	ChangedBy *string   `json:"changedBy,omitempty"` // UUID of the user who made the change
+	ChangedAt time.Time `json:"changedAt"`           // When the change was made
+}
+
+// ListGameChangesResponse represents the response for a game's change history
+type ListGameChangesResponse struct {
+	Changes []GameChange `json:"changes"` // Changes ordered from newest to oldest
+}
+
+// JoinGameRequest is the optional body of a join request
+type JoinGameRequest struct {
+	Position        *string `json:"position,omitempty"`        // Position to sign up for; required in games with positions
+	RejectConflicts bool    `json:"rejectConflicts,omitempty"` // Refuse to join if already confirmed for an overlapping game
+}
+
+// DropGameRequest is the optional body of a drop request
+type DropGameRequest struct {
+	Reason *DropReason `json:"reason,omitempty" binding:"omitempty,oneof=injury schedule_conflict weather other"` // Why the participant is dropping
+}
+
+// CancelGameRequest is the optional body of a cancel request
+type CancelGameRequest struct {
+	Reason *string `json:"reason,omitempty" binding:"omitempty,max=500"` // Passed on to every player in the cancellation notice
+}
+
+// AttendanceSummary counts a player's no-shows over their most recent games with marked attendance
+type AttendanceSummary struct {
+	NoShows int `json:"noShows"` // Games marked no_show
+	Games   int `json:"games"`   // Games with marked attendance in the window (at most 10)
+}
+
+// ReliabilityScore summarizes how often a player honors the games they sign up for, over all of
+// their finished games. Recomputed periodically, so it can lag behind the latest game.
+type ReliabilityScore struct {
+	Score      int       `json:"score"`      // Share of finished games honored, 0-100
+	Honored    int       `json:"honored"`    // Games they stayed confirmed for and attended
+	LateDrops  int       `json:"lateDrops"`  // Confirmed spots dropped inside the game's late-drop window
+	NoShows    int       `json:"noShows"`    // Games the host marked them a no-show
+	ComputedAt time.Time `json:"computedAt"` // When the score was last recomputed
+}
+
+// SkillConfidence is the skill level other players vouch for, aggregated from their endorsements after
+// completed games. Each endorser counts once, at the level of their latest endorsement.
+type SkillConfidence struct {
+	Level      SkillLevel `json:"level"`      // Level the most endorsers vouch for (the lower one on a tie)
+	Confidence int        `json:"confidence"` // Share of endorsers who vouch for Level, 0-100
+	Endorsers  int        `json:"endorsers"`  // Players who endorsed them
+}
+
+// EndorseSkillRequest represents a player vouching for another player's skill after a game together
+type EndorseSkillRequest struct {
+	SkillLevel SkillLevel `json:"skillLevel" binding:"required,oneof=beginner intermediate advanced"` // beginner, intermediate or advanced
+}
+
+// SkillEndorsement is one player's endorsement of another after a completed game
+type SkillEndorsement struct {
+	GameID     string     `json:"gameId"`     // Game UUID
+	EndorseeID string     `json:"endorseeId"` // Endorsed player's user UUID
+	SkillLevel SkillLevel `json:"skillLevel"` // Level vouched for
+	UpdatedAt  time.Time  `json:"updatedAt"`  // When it was last given or changed
+}
+
+// PlayerStats summarizes a player's completed games
+type PlayerStats struct {
+	GamesPlayed    int           `json:"gamesPlayed"`              // Completed games they were confirmed for and not marked a no-show
+	GamesHosted    int           `json:"gamesHosted"`              // Completed games they organized
+	FavoriteSport  *GameCategory `json:"favoriteSport,omitempty"`  // Category they've played most; omitted until they've played
+	AttendanceRate *int          `json:"attendanceRate,omitempty"` // Share of confirmed completed games attended, 0-100; omitted until they've played
+}
+
+// PlayerProfile is the public view of a player
+type PlayerProfile struct {
+	ID          string            `json:"id"`                    // User UUID
+	FirstName   string            `json:"firstName"`             // User first name
+	LastName    string            `json:"lastName"`              // User last name
+	MemberSince time.Time         `json:"memberSince"`           // Account creation timestamp
+	Reliability *ReliabilityScore `json:"reliability,omitempty"` // Omitted until they finish a game
+	Skill       *SkillConfidence  `json:"skill,omitempty"`       // Omitted until another player endorses them
+	Stats       PlayerStats       `json:"stats"`                 // Play statistics
+}
+
+// MarkAttendanceRequest represents the request body for marking a participant's attendance
+type MarkAttendanceRequest struct {
+	Status AttendanceStatus `json:"status" binding:"required,oneof=attended no_show"` // attended or no_show
+}
+
+// NameMVPRequest represents a host naming the most valuable player of a completed game
+type NameMVPRequest struct {
+	UserID string `json:"userId" binding:"required,uuid"` // MVP's user UUID
+}
+
+// GameMVP is the player a host named most valuable in a completed game
+type GameMVP struct {
+	GameID  string    `json:"gameId"`  // Game UUID
+	UserID  string    `json:"userId"`  // MVP's user UUID
+	NamedAt time.Time `json:"namedAt"` // When the host last named them
+}
+
+// MarkPaymentRequest represents the request body for recording whether a participant paid
+type MarkPaymentRequest struct {
+	Paid               *bool `json:"paid" binding:"required"`                      // Whether the participant paid
+	PaymentAmountCents *int  `json:"paymentAmountCents" binding:"omitempty,min=0"` // Amount paid in cents; kept as is when omitted
+}
+
+// Attendance is a participant's attendance at a game
+type Attendance struct {
+	GameID   string           `json:"gameId"`   // Game UUID
+	UserID   string           `json:"userId"`   // Participant's user UUID
+	Status   AttendanceStatus `json:"status"`   // attended or no_show
+	MarkedAt time.Time        `json:"markedAt"` // When the host last marked it
+}
+
+// BulkUpdateParticipantsRequest represents a host moving many participants at once. Confirmed players
+// go to the front of the line in the given order and waitlisted ones to the back, so the roster stays
+// what reconciliation would compute from the line.
+type BulkUpdateParticipantsRequest struct {
+	UserIDs []string          `json:"userIds" binding:"required,min=1,max=100,dive,uuid"` // Participants' user UUIDs
+	Status  ParticipantStatus `json:"status" binding:"required,oneof=confirmed waitlist"` // confirmed or waitlist
+}
+
+// ReorderWaitlistRequest represents a host rearranging their game's waitlist. It lists every
+// waitlisted participant in the new promotion order.
+type ReorderWaitlistRequest struct {
+	UserIDs []string `json:"userIds" binding:"required,min=1,max=500,dive,uuid"` // Waitlisted users' UUIDs (placeholderId for placeholders), first promoted first
+}
+
+// AddPlaceholderRequest represents a host adding a friend without an account to their game
+type AddPlaceholderRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=100"` // Display name shown on the roster
+}
+
+// LinkPlaceholderRequest identifies the account a placeholder should become. Exactly one field is required.
+type LinkPlaceholderRequest struct {
+	UserID *string `json:"userId,omitempty" binding:"omitempty,uuid"` // Account UUID
+	Email  *string `json:"email,omitempty" binding:"omitempty,email"` // Account email
+}
+
+// RosterSnapshotEntry is one participant as they stood when the game started
+type RosterSnapshotEntry struct {
+	UserID             *string           `json:"userId,omitempty"`             // User UUID (absent for placeholders)
+	PlaceholderID      *string           `json:"placeholderId,omitempty"`      // Participant UUID of a name-only placeholder
+	FirstName          string            `json:"firstName"`                    // First name (or placeholder name) at game start
+	LastName           string            `json:"lastName"`                     // Last name at game start
+	Status             ParticipantStatus `json:"status"`                       // confirmed or waitlist
+	WaitlistPosition   *int              `json:"waitlistPosition,omitempty"`   // Position in waitlist
+	TeamID             *string           `json:"teamId,omitempty"`             // Team UUID (if assigned)
+	Paid               bool              `json:"paid"`                         // Payment status at game start
+	PaymentAmountCents *int              `json:"paymentAmountCents,omitempty"` // Amount paid in cents
+	JoinedAt           time.Time         `json:"joinedAt"`                     // When they joined
+}
+
+// RosterSnapshot is the immutable roster recorded when a game started
+type RosterSnapshot struct {
+	GameID                string                `json:"gameId"`                // Game UUID
+	TakenAt               time.Time             `json:"takenAt"`               // When the game started and the snapshot was taken
+	MaxParticipants       int                   `json:"maxParticipants"`       // Capacity at game start
+	ConfirmedParticipants []RosterSnapshotEntry `json:"confirmedParticipants"` // Confirmed participants
+	Waitlist              []RosterSnapshotEntry `json:"waitlist"`              // Waitlisted participants, in order
+}
+
+// ListParticipantsResponse represents one page of a game's participants
+type ListParticipantsResponse struct {
+	Participants []Participant `json:"participants"`         // Participants on this page
+	Limit        int           `json:"limit"`                // Page size used
+	Offset       int           `json:"offset"`               // Number of participants skipped
+	HasMore      bool          `json:"hasMore"`              // Whether another page follows
+	NextOffset   *int          `json:"nextOffset,omitempty"` // Offset of the next page, if any
+}
+
+// ImportRowStatus is the outcome of one row of a bulk game import
+type ImportRowStatus string
+
+const (
+	ImportRowStatusValid   ImportRowStatus = "valid"   // Passed validation (dry run)
+	ImportRowStatusCreated ImportRowStatus = "created" // Game created
+	ImportRowStatusInvalid ImportRowStatus = "invalid" // Failed validation
+	ImportRowStatusFailed  ImportRowStatus = "failed"  // Valid, but its batch could not be saved
+	ImportRowStatusSkipped ImportRowStatus = "skipped" // Valid, but not saved because other rows were invalid
+)
+
+// ImportGameResult reports what happened to one row of a bulk game import
+type ImportGameResult struct {
+	Line   int             `json:"line"`             // Line number in the uploaded file
+	Status ImportRowStatus `json:"status"`           // Row outcome
+	GameID *string         `json:"gameId,omitempty"` // Created game UUID
+	Error  *string         `json:"error,omitempty"`  // Why the row was rejected or failed
+}
+
+// ImportGamesResponse represents the response for a bulk game import
+type ImportGamesResponse struct {
+	DryRun  bool               `json:"dryRun"`  // Whether the upload was only validated
+	Total   int                `json:"total"`   // Number of rows in the upload
+	Invalid int                `json:"invalid"` // Rows that failed validation
+	Created int                `json:"created"` // Games created
+	Results []ImportGameResult `json:"results"` // Per-row outcomes in upload order
 }
@@ -0,0 +1,76 @@
+package models
+
+import "time"
+
+// ListGamesFormat is the encoding of a game listing
+type ListGamesFormat string
+
+const (
+	ListGamesFormatJSON    ListGamesFormat = "json"    // ListGamesResponse
+	ListGamesFormatGeoJSON ListGamesFormat = "geojson" // GameFeatureCollection, for map layers
+)
+
+// GameFeatureCollection is a page of games as a GeoJSON FeatureCollection (RFC 7946) that map
+// clients such as Mapbox or Leaflet can use as a layer source
+type GameFeatureCollection struct {
+	Type       string        `json:"type"`       // Always "FeatureCollection"
+	Features   []GameFeature `json:"features"`   // One point per game
+	TotalCount int           `json:"totalCount"` // Games matching the filters across every page
+	HasMore    bool          `json:"hasMore"`    // Whether a later page has more games
+}
+
+// GameFeature is one game as a GeoJSON point feature
+type GameFeature struct {
+	Type       string                `json:"type"`       // Always "Feature"
+	ID         string                `json:"id"`         // Game UUID
+	Geometry   PointGeometry         `json:"geometry"`   // Where the game is played
+	Properties GameFeatureProperties `json:"properties"` // What a map marker shows
+}
+
+// PointGeometry is a GeoJSON point
+type PointGeometry struct {
+	Type        string     `json:"type"`        // Always "Point"
+	Coordinates [2]float64 `json:"coordinates"` // Longitude then latitude, as GeoJSON orders them
+}
+
+// GameFeatureProperties are the game details carried by a GameFeature
+type GameFeatureProperties struct {
+	ID         string       `json:"id"`              // Game UUID, repeated for layers that can't read the feature ID
+	Category   GameCategory `json:"category"`        // Sport category
+	Title      *string      `json:"title,omitempty"` // Custom title
+	StartTime  time.Time    `json:"startTime"`       // Game start time
+	SpotsLeft  int          `json:"spotsLeft"`       // Confirmed spots still open
+	Status     GameStatus   `json:"status"`          // Current game status
+	SkillLevel SkillLevel   `json:"skillLevel"`      // Required skill level
+}
+
+// GeoJSON returns the page as a FeatureCollection
+func (r ListGamesResponse) GeoJSON() GameFeatureCollection {
+	collection := GameFeatureCollection{
+		Type:       "FeatureCollection",
+		Features:   make([]GameFeature, 0, len(r.Games)),
+		TotalCount: r.TotalCount,
+		HasMore:    r.HasMore,
+	}
+	for _, game := range r.Games {
+		var coordinates [2]float64
+		if game.Location.Longitude != nil && game.Location.Latitude != nil {
+			coordinates = [2]float64{*game.Location.Longitude, *game.Location.Latitude}
+		}
+		collection.Features = append(collection.Features, GameFeature{
+			Type:     "Feature",
+			ID:       game.ID,
+			Geometry: PointGeometry{Type: "Point", Coordinates: coordinates},
+			Properties: GameFeatureProperties{
+				ID:         game.ID,
+				Category:   game.Category,
+				Title:      game.Title,
+				StartTime:  game.StartTime,
+				SpotsLeft:  max(game.MaxParticipants-game.ConfirmedCount, 0),
+				Status:     game.Status,
+				SkillLevel: game.SkillLevel,
+			},
+		})
+	}
+	return collection
+}
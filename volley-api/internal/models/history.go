@@ -0,0 +1,32 @@
+package models
+
+// ParticipationOutcome is how a player's sign-up for a game that has ended turned out
+type ParticipationOutcome string
+
+const (
+	ParticipationOutcomeAttended   ParticipationOutcome = "attended"   // Confirmed and marked attended by the host
+	ParticipationOutcomeNoShow     ParticipationOutcome = "no_show"    // Confirmed but marked as a no-show
+	ParticipationOutcomeConfirmed  ParticipationOutcome = "confirmed"  // Confirmed; the host never marked attendance
+	ParticipationOutcomeDropped    ParticipationOutcome = "dropped"    // Dropped before the game
+	ParticipationOutcomeWaitlisted ParticipationOutcome = "waitlisted" // Still on the waitlist when the game ended
+	ParticipationOutcomeDeclined   ParticipationOutcome = "declined"   // Declined the invitation
+	ParticipationOutcomeRemoved    ParticipationOutcome = "removed"    // Removed by the host
+)
+
+// ParticipationHistoryEntry is one past game on a player's participation history
+type ParticipationHistoryEntry struct {
+	Game               PlayerGame           `json:"game"`                         // Game summary; status shows whether it was cancelled
+	Outcome            ParticipationOutcome `json:"outcome"`                      // How the player's sign-up ended
+	Paid               bool                 `json:"paid"`                         // Whether the player paid
+	PaymentAmountCents *int                 `json:"paymentAmountCents,omitempty"` // Amount paid, if recorded
+	ReceiptAvailable   bool                 `json:"receiptAvailable"`             // Whether a receipt can be downloaded for this game
+}
+
+// ParticipationHistoryResponse represents one page of a player's participation history
+type ParticipationHistoryResponse struct {
+	Entries    []ParticipationHistoryEntry `json:"entries"`              // Past games on this page, most recent first
+	Limit      int                         `json:"limit"`                // Page size used
+	Offset     int                         `json:"offset"`               // Number of entries skipped
+	HasMore    bool                        `json:"hasMore"`              // Whether another page follows
+	NextOffset *int                        `json:"nextOffset,omitempty"` // Offset of the next page, if any
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// JournalAction is a participation request recorded in the journal
+type JournalAction string
+
+const (
+	JournalActionJoin JournalAction = "join" // POST /games/:gameId/participation
+	JournalActionDrop JournalAction = "drop" // DELETE /games/:gameId/participation
+)
+
+// JournalOutcomeError is the outcome recorded for requests that failed
+const JournalOutcomeError = "error"
+
+// ParticipationJournalEntry is one journaled join or drop request
+type ParticipationJournalEntry struct {
+	ID             int64         `json:"id"`                       // Journal sequence number
+	GameID         string        `json:"gameId"`                   // Game UUID
+	UserID         string        `json:"userId"`                   // Requesting user UUID
+	Action         JournalAction `json:"action"`                   // join or drop
+	RequestedAt    time.Time     `json:"requestedAt"`              // When the request reached the service
+	CompletedAt    time.Time     `json:"completedAt"`              // When the service returned
+	Outcome        string        `json:"outcome"`                  // Resulting participant status, or "error"
+	Error          *string       `json:"error,omitempty"`          // Error message when the request failed
+	PromotedUserID *string       `json:"promotedUserId,omitempty"` // User promoted from the waitlist by a drop
+}
+
+// ListParticipationJournalResponse represents a game's participation journal
+type ListParticipationJournalResponse struct {
+	Entries []ParticipationJournalEntry `json:"entries"` // Entries in request order
+}
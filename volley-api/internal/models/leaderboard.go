@@ -0,0 +1,32 @@
+package models
+
+// LeaderboardMetric is what a leaderboard ranks players by
+type LeaderboardMetric string
+
+const (
+	LeaderboardMetricPlayed LeaderboardMetric = "played" // Completed games played
+	LeaderboardMetricHosted LeaderboardMetric = "hosted" // Completed games hosted
+	LeaderboardMetricMVPs   LeaderboardMetric = "mvps"   // Completed games named MVP of
+)
+
+// LeaderboardEntry is one ranked player. Totals cover completed games in the area (and sport, if filtered).
+type LeaderboardEntry struct {
+	Rank        int    `json:"rank"`        // 1-based position in the ranking
+	UserID      string `json:"userId"`      // User UUID
+	FirstName   string `json:"firstName"`   // User first name
+	LastName    string `json:"lastName"`    // User last name
+	GamesPlayed int    `json:"gamesPlayed"` // Games played
+	GamesHosted int    `json:"gamesHosted"` // Games hosted
+	MVPs        int    `json:"mvps"`        // Games named MVP of
+}
+
+// LeaderboardResponse is one page of a leaderboard
+type LeaderboardResponse struct {
+	Metric     LeaderboardMetric  `json:"metric"`               // What players are ranked by
+	Category   *GameCategory      `json:"category,omitempty"`   // Sport ranked; omitted for all sports
+	Entries    []LeaderboardEntry `json:"entries"`              // Players on this page, highest first
+	Limit      int                `json:"limit"`                // Page size used
+	Offset     int                `json:"offset"`               // Number of players skipped
+	HasMore    bool               `json:"hasMore"`              // Whether another page follows
+	NextOffset *int               `json:"nextOffset,omitempty"` // Offset of the next page, if any
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// LegalDocumentType identifies a kind of legal document users must accept
+type LegalDocumentType string
+
+const (
+	LegalDocumentTermsOfService LegalDocumentType = "terms_of_service"
+	LegalDocumentPrivacyPolicy  LegalDocumentType = "privacy_policy"
+)
+
+// LegalDocument represents a published version of a legal document
+type LegalDocument struct {
+	Type        LegalDocumentType `json:"type"`          // Document type
+	Version     string            `json:"version"`       // Version identifier, e.g. 2025-06-01
+	URL         *string           `json:"url,omitempty"` // Where the full text is hosted
+	PublishedAt time.Time         `json:"publishedAt"`   // When this version took effect
+}
+
+// LegalAcceptance records a user's acceptance of a legal document version
+type LegalAcceptance struct {
+	ID         string            `json:"id"`                  // Acceptance UUID
+	Type       LegalDocumentType `json:"type"`                // Document type
+	Version    string            `json:"version"`             // Accepted version
+	IPAddress  *string           `json:"ipAddress,omitempty"` // Client IP at time of acceptance
+	UserAgent  *string           `json:"userAgent,omitempty"` // Client user agent at time of acceptance
+	AcceptedAt time.Time         `json:"acceptedAt"`          // When the user accepted
+}
+
+// AcceptLegalDocumentsRequest represents a request to accept the current legal documents
+type AcceptLegalDocumentsRequest struct {
+	Documents []LegalDocumentRef `json:"documents" binding:"required,min=1,dive"`
+}
+
+// LegalDocumentRef identifies a specific version of a legal document
+type LegalDocumentRef struct {
+	Type    LegalDocumentType `json:"type" binding:"required,oneof=terms_of_service privacy_policy"`
+	Version string            `json:"version" binding:"required,max=50"`
+}
+
+// ListLegalDocumentsResponse represents the current legal documents
+type ListLegalDocumentsResponse struct {
+	Documents []LegalDocument `json:"documents"`
+}
+
+// ListLegalAcceptancesResponse represents a user's acceptance history
+type ListLegalAcceptancesResponse struct {
+	Acceptances []LegalAcceptance `json:"acceptances"`
+}
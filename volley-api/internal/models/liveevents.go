@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// GameLiveEventType names a change streamed to clients watching a game
+type GameLiveEventType string
+
+const (
+	GameLiveEventParticipantJoined   GameLiveEventType = "participant.joined"   // A player signed up
+	GameLiveEventParticipantDropped  GameLiveEventType = "participant.dropped"  // A player dropped out
+	GameLiveEventParticipantPromoted GameLiveEventType = "participant.promoted" // A waitlisted player got a confirmed spot
+	GameLiveEventGameCancelled       GameLiveEventType = "game.cancelled"       // The game was cancelled
+)
+
+// GameLiveEvent is streamed by GET /games/:gameId/events when the game's roster or status changes.
+// It says what happened but not to whom, so rosters that hide minors stay hidden; clients reload
+// the game's details to show the change.
+type GameLiveEvent struct {
+	Type       GameLiveEventType  `json:"type"`             // What happened
+	Status     *ParticipantStatus `json:"status,omitempty"` // Where a joining player landed: confirmed or waitlist
+	OccurredAt time.Time          `json:"occurredAt"`       // When it happened
+}
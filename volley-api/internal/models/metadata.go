@@ -0,0 +1,27 @@
+package models
+
+// EnumOption is how clients render one value of an API enum
+type EnumOption struct {
+	Value       string `json:"value"`          // Value as sent and received by the API
+	DisplayName string `json:"displayName"`    // Localized name to show users
+	Icon        string `json:"icon,omitempty"` // Icon identifier (Material Symbols name)
+	Order       int    `json:"order"`          // Position when listing the options
+}
+
+// Metadata is the localized rendering of the API's enums
+type Metadata struct {
+	Locale              string            `json:"locale"`               // Locale the display names are in
+	Categories          []EnumOption      `json:"categories"`           // GameCategory values
+	SkillLevels         []EnumOption      `json:"skillLevels"`          // SkillLevel values
+	GameStatuses        []EnumOption      `json:"gameStatuses"`         // GameStatus values
+	ParticipantStatuses []EnumOption      `json:"participantStatuses"`  // ParticipantStatus values
+	PricingTypes        []EnumOption      `json:"pricingTypes"`         // PricingType values
+	ReportReasons       []EnumOption      `json:"reportReasons"`        // ReportReason values
+	AppVersion          *AppVersionPolicy `json:"appVersion,omitempty"` // Oldest app version the API still serves (if enforced)
+}
+
+// AppVersionPolicy is the oldest mobile app version the API accepts
+type AppVersionPolicy struct {
+	MinimumVersion string `json:"minimumVersion"`       // Older apps get 426 Upgrade Required
+	UpgradeURL     string `json:"upgradeUrl,omitempty"` // Where to send users to update
+}
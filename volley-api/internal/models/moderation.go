@@ -0,0 +1,69 @@
+package models
+
+import "time"
+
+// ModerationRequest is the body of an admin moderation action. The reason is kept with the action.
+type ModerationRequest struct {
+	Reason string `json:"reason" binding:"required,max=2000"` // Why the admin is taking the action
+}
+
+// Suspension is an admin suspension of a user account
+type Suspension struct {
+	UserID      string    `json:"userId"`      // Suspended user's UUID
+	Reason      string    `json:"reason"`      // Why the account was suspended
+	SuspendedBy *string   `json:"suspendedBy"` // Admin user UUID (null if that admin was deleted)
+	SuspendedAt time.Time `json:"suspendedAt"` // When the account was suspended
+}
+
+// ShadowBan is an admin shadow ban of a user account. It isn't visible to the user.
+type ShadowBan struct {
+	UserID   string    `json:"userId"`   // Shadow-banned user's UUID
+	Reason   string    `json:"reason"`   // Why the account was shadow-banned
+	BannedBy *string   `json:"bannedBy"` // Admin user UUID (null if that admin was deleted)
+	BannedAt time.Time `json:"bannedAt"` // When the account was shadow-banned
+}
+
+// AdminAction is the kind of admin mutation recorded in the audit trail
+type AdminAction string
+
+const (
+	AdminActionSuspendUser       AdminAction = "suspend_user"
+	AdminActionUnsuspendUser     AdminAction = "unsuspend_user"
+	AdminActionShadowBanUser     AdminAction = "shadow_ban_user"
+	AdminActionLiftShadowBan     AdminAction = "lift_shadow_ban"
+	AdminActionCancelGame        AdminAction = "cancel_game"
+	AdminActionRemoveParticipant AdminAction = "remove_participant"
+	AdminActionReviewReport      AdminAction = "review_report"
+	AdminActionRequeueDeadLetter AdminAction = "requeue_dead_letter"
+)
+
+// AuditTargetType is what an admin action was taken against
+type AuditTargetType string
+
+const (
+	AuditTargetGame       AuditTargetType = "game"
+	AuditTargetUser       AuditTargetType = "user"
+	AuditTargetReport     AuditTargetType = "report"
+	AuditTargetDeadLetter AuditTargetType = "dead_letter"
+)
+
+// AuditEntry is one admin mutation in the audit trail
+type AuditEntry struct {
+	ID         string          `json:"id"`
+	ActorID    string          `json:"actorId"`          // Admin user UUID
+	Action     AdminAction     `json:"action"`           // What the admin did
+	TargetType AuditTargetType `json:"targetType"`       // game, user, report or dead_letter
+	TargetID   string          `json:"targetId"`         // UUID of the game, user, report or dead letter
+	GameID     *string         `json:"gameId,omitempty"` // Game the action was taken in, when the target is a player
+	Reason     *string         `json:"reason"`           // Reason the admin gave (null for report reviews)
+	CreatedAt  time.Time       `json:"createdAt"`
+}
+
+// ListAuditResponse is one page of the admin audit trail
+type ListAuditResponse struct {
+	Entries    []AuditEntry `json:"entries"`              // Entries on this page, newest first
+	Limit      int          `json:"limit"`                // Page size used
+	Offset     int          `json:"offset"`               // Number of entries skipped
+	HasMore    bool         `json:"hasMore"`              // Whether another page follows
+	NextOffset *int         `json:"nextOffset,omitempty"` // Offset of the next page, if any
+}
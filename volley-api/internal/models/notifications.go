@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+// GameNotificationSettings are a participant's notification mutes for one game. They apply on top
+// of the user's global preferences; game updates such as cancellations are always delivered.
+type GameNotificationSettings struct {
+	GameID         string `json:"gameId"`         // Game the settings apply to
+	RemindersMuted bool   `json:"remindersMuted"` // Reminders and organizer requests are not sent
+	ChatMuted      bool   `json:"chatMuted"`      // Chat messages are not sent
+}
+
+// UpdateGameNotificationSettingsRequest changes the given mutes; omitted ones keep their value
+type UpdateGameNotificationSettingsRequest struct {
+	RemindersMuted *bool `json:"remindersMuted"` // Mute reminders and organizer requests
+	ChatMuted      *bool `json:"chatMuted"`      // Mute chat messages
+}
+
+// NotificationPreferences are how a user wants to hear about their games, across all games
+type NotificationPreferences struct {
+	EmailEnabled  bool `json:"emailEnabled"`  // Game updates fall back to email when push can't reach the user
+	SMSEnabled    bool `json:"smsEnabled"`    // Updates about games starting within a few hours are also texted
+	PhoneVerified bool `json:"phoneVerified"` // SMS can only be turned on with a verified phone number
+}
+
+// UpdateNotificationPreferencesRequest changes the given preferences; omitted ones keep their value
+type UpdateNotificationPreferencesRequest struct {
+	EmailEnabled *bool `json:"emailEnabled"` // Resubscribe to or unsubscribe from game emails
+	SMSEnabled   *bool `json:"smsEnabled"`   // Opt in to or out of SMS
+}
+
+// Unsubscribed confirms a one-click unsubscribe
+type Unsubscribed struct {
+	Channel string `json:"channel"` // Channel that was turned off, e.g. "email"
+}
+
+// NotificationDeliveryStatus is where a notification is in being handed to its provider
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryQueued  NotificationDeliveryStatus = "queued"  // Being sent, or waiting to be retried
+	NotificationDeliverySent    NotificationDeliveryStatus = "sent"    // Accepted by the provider
+	NotificationDeliveryFailed  NotificationDeliveryStatus = "failed"  // Gave up after the last attempt
+	NotificationDeliveryBounced NotificationDeliveryStatus = "bounced" // The provider rejected the recipient for good
+)
+
+// NotificationDelivery is one push, email or text sent to a user, shown to admins for debugging
+// missing notifications. Message bodies aren't kept.
+type NotificationDelivery struct {
+	ID            string                     `json:"id"`                      // Delivery UUID
+	Channel       string                     `json:"channel"`                 // push, email or sms
+	Recipient     string                     `json:"recipient"`               // User ID, email address or phone number
+	Summary       *string                    `json:"summary,omitempty"`       // Email subject or push title
+	Status        NotificationDeliveryStatus `json:"status"`                  // Delivery status
+	Attempts      int                        `json:"attempts"`                // Send attempts so far
+	LastError     *string                    `json:"lastError,omitempty"`     // Why the last attempt failed
+	NextAttemptAt *time.Time                 `json:"nextAttemptAt,omitempty"` // When a queued delivery is retried
+	CreatedAt     time.Time                  `json:"createdAt"`               // When it was first sent
+	SentAt        *time.Time                 `json:"sentAt,omitempty"`        // When the provider accepted it
+}
+
+// ListNotificationDeliveriesResponse lists a user's most recent notification deliveries
+type ListNotificationDeliveriesResponse struct {
+	Deliveries []NotificationDelivery `json:"deliveries"` // Newest first
+}
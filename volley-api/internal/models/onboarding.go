@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// AvailabilityWindow is a part of the week the user can usually play, in their own timezone
+type AvailabilityWindow string
+
+const (
+	AvailabilityWeekdayMornings   AvailabilityWindow = "weekday_mornings"   // Monday-Friday before noon
+	AvailabilityWeekdayAfternoons AvailabilityWindow = "weekday_afternoons" // Monday-Friday noon-5pm
+	AvailabilityWeekdayEvenings   AvailabilityWindow = "weekday_evenings"   // Monday-Friday from 5pm
+	AvailabilityWeekendMornings   AvailabilityWindow = "weekend_mornings"   // Saturday-Sunday before noon
+	AvailabilityWeekendAfternoons AvailabilityWindow = "weekend_afternoons" // Saturday-Sunday noon-5pm
+	AvailabilityWeekendEvenings   AvailabilityWindow = "weekend_evenings"   // Saturday-Sunday from 5pm
+)
+
+// Onboarding is everything the app asks a new user in one step. PUT replaces all of it.
+type Onboarding struct {
+	Sports       []SportPreference    `json:"sports" binding:"required,max=8,dive"`                                                                                                              // Sports the user wants to play
+	Availability []AvailabilityWindow `json:"availability" binding:"max=6,dive,oneof=weekday_mornings weekday_afternoons weekday_evenings weekend_mornings weekend_afternoons weekend_evenings"` // When the user can play; empty means any time
+	Timezone     *string              `json:"timezone,omitempty" binding:"omitempty,max=64"`                                                                                                     // IANA timezone the availability is read in (required with availability)
+	TravelRadius *float64             `json:"travelRadius,omitempty" binding:"omitempty,gt=0,max=160934"`                                                                                        // How far the user will travel, in meters; saved as the default search radius
+	OnboardedAt  *time.Time           `json:"onboardedAt,omitempty"`                                                                                                                             // When onboarding was last saved (set by the server, omitted until then)
+}
@@ -0,0 +1,93 @@
+package models
+
+import "time"
+
+// Passkey is a WebAuthn credential registered to the current user
+type Passkey struct {
+	ID         string     `json:"id"`                   // Credential ID (base64url)
+	Name       *string    `json:"name,omitempty"`       // Label chosen at registration, e.g. "MacBook"
+	CreatedAt  time.Time  `json:"createdAt"`            // When the passkey was registered
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"` // Last successful sign-in with it
+}
+
+// PasskeyRelyingParty identifies this service to the authenticator
+type PasskeyRelyingParty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// PasskeyUser identifies the account a passkey is created for
+type PasskeyUser struct {
+	ID          string `json:"id"`          // User handle (base64url of the user UUID bytes)
+	Name        string `json:"name"`        // Account email
+	DisplayName string `json:"displayName"` // User's full name
+}
+
+// PasskeyCredentialParameter is a public key algorithm the server accepts
+type PasskeyCredentialParameter struct {
+	Type string `json:"type"` // Always "public-key"
+	Alg  int    `json:"alg"`  // COSE algorithm identifier
+}
+
+// PasskeyCredentialDescriptor references an existing credential
+type PasskeyCredentialDescriptor struct {
+	Type string `json:"type"` // Always "public-key"
+	ID   string `json:"id"`   // Credential ID (base64url)
+}
+
+// PasskeyAuthenticatorSelection states which authenticators may be used
+type PasskeyAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// PasskeyCreationOptions is the JSON form of PublicKeyCredentialCreationOptions; browsers accept it
+// as-is through PublicKeyCredential.parseCreationOptionsFromJSON
+type PasskeyCreationOptions struct {
+	Challenge              string                        `json:"challenge"`
+	RP                     PasskeyRelyingParty           `json:"rp"`
+	User                   PasskeyUser                   `json:"user"`
+	PubKeyCredParams       []PasskeyCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                         `json:"timeout"` // Milliseconds
+	ExcludeCredentials     []PasskeyCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection PasskeyAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                        `json:"attestation"`
+}
+
+// PasskeyRequestOptions is the JSON form of PublicKeyCredentialRequestOptions; browsers accept it
+// as-is through PublicKeyCredential.parseRequestOptionsFromJSON
+type PasskeyRequestOptions struct {
+	Challenge        string                        `json:"challenge"`
+	RPID             string                        `json:"rpId"`
+	Timeout          int64                         `json:"timeout"` // Milliseconds
+	UserVerification string                        `json:"userVerification"`
+	AllowCredentials []PasskeyCredentialDescriptor `json:"allowCredentials"` // Empty: any discoverable credential
+}
+
+// PasskeyAttestationResponse is the response part of a registration credential's toJSON()
+type PasskeyAttestationResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON" binding:"required"`
+	AuthenticatorData string `json:"authenticatorData" binding:"required"`
+	PublicKey         string `json:"publicKey" binding:"required"` // DER SubjectPublicKeyInfo from getPublicKey()
+}
+
+// PasskeyRegistrationRequest is a registration credential as produced by PublicKeyCredential.toJSON()
+type PasskeyRegistrationRequest struct {
+	RawID    string                     `json:"rawId" binding:"required"`
+	Response PasskeyAttestationResponse `json:"response" binding:"required"`
+	Name     *string                    `json:"name,omitempty" binding:"omitempty,max=100"` // Optional label for the passkey
+}
+
+// PasskeyAssertionResponse is the response part of an authentication credential's toJSON()
+type PasskeyAssertionResponse struct {
+	ClientDataJSON    string  `json:"clientDataJSON" binding:"required"`
+	AuthenticatorData string  `json:"authenticatorData" binding:"required"`
+	Signature         string  `json:"signature" binding:"required"`
+	UserHandle        *string `json:"userHandle,omitempty"`
+}
+
+// PasskeyLoginRequest is an authentication credential as produced by PublicKeyCredential.toJSON()
+type PasskeyLoginRequest struct {
+	RawID    string                   `json:"rawId" binding:"required"`
+	Response PasskeyAssertionResponse `json:"response" binding:"required"`
+}
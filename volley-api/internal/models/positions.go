@@ -0,0 +1,10 @@
+package models
+
+// GamePosition is a position a game takes sign-ups for, e.g. setter or goalkeeper. Confirmed players
+// per position are capped by Capacity on top of the game's MaxParticipants; later sign-ups for a
+// full position are waitlisted even when other positions have room.
+type GamePosition struct {
+	Name      string `json:"name" binding:"required,max=50"`    // Position name, unique within the game
+	Capacity  int    `json:"capacity" binding:"required,min=1"` // Maximum confirmed players in this position
+	Confirmed int    `json:"confirmed"`                         // Confirmed players in this position (ignored on create)
+}
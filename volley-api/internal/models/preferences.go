@@ -0,0 +1,12 @@
+package models
+
+// SportPreference is a sport the user wants to play and the level they consider themselves at
+type SportPreference struct {
+	Category   GameCategory `json:"category" binding:"required,oneof=soccer basketball pickleball flag_football volleyball ultimate_frisbee tennis other"` // Sport category
+	SkillLevel *SkillLevel  `json:"skillLevel,omitempty" binding:"omitempty,oneof=beginner intermediate advanced"`                                         // Self-reported level (omitted when not given)
+}
+
+// SportPreferences are the sports a user wants to play. An empty list clears them.
+type SportPreferences struct {
+	Sports []SportPreference `json:"sports" binding:"required,max=8,dive"` // One entry per sport
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// ReceiptFormat is the encoding a receipt is downloaded in
+type ReceiptFormat string
+
+const (
+	ReceiptFormatJSON ReceiptFormat = "json" // Receipt as a JSON object
+	ReceiptFormatPDF  ReceiptFormat = "pdf"  // Printable single-page PDF
+)
+
+// Receipt is a participant's receipt for a paid game that has ended
+type Receipt struct {
+	Number          string       `json:"number"`          // Receipt number; stable across downloads
+	GameID          string       `json:"gameId"`          // Game the receipt is for
+	GameTitle       *string      `json:"gameTitle"`       // Game title, if any
+	Category        GameCategory `json:"category"`        // Game category
+	Venue           ReceiptVenue `json:"venue"`           // Where the game was played
+	StartTime       time.Time    `json:"startTime"`       // When the game started
+	DurationMinutes int          `json:"durationMinutes"` // How long the game lasted
+	Organizer       string       `json:"organizer"`       // Organizer's full name
+	AmountCents     int          `json:"amountCents"`     // Amount paid in the currency's minor unit
+	Currency        string       `json:"currency"`        // ISO 4217 currency code
+	AmountEstimated bool         `json:"amountEstimated"` // True when no amount was recorded and it was estimated from the game's pricing
+	IssuedAt        time.Time    `json:"issuedAt"`        // When this copy was generated
+}
+
+// ReceiptVenue is the location shown on a receipt
+type ReceiptVenue struct {
+	Name    string  `json:"name"`    // Venue name
+	Address *string `json:"address"` // Street address, if known
+}
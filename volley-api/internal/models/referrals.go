@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// ReferredFriend is someone who signed up with the user's referral code
+type ReferredFriend struct {
+	FirstName  string    `json:"firstName"`  // Friend's first name
+	SignedUpAt time.Time `json:"signedUpAt"` // When they signed up
+}
+
+// ReferralSummary is the user's referral code and how many friends signed up with it
+type ReferralSummary struct {
+	Code    string           `json:"code"`    // Code to share; entered as referralCode when registering
+	Signups int              `json:"signups"` // Friends who signed up with the code
+	Recent  []ReferredFriend `json:"recent"`  // Most recent sign-ups first (at most 20)
+}
@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+// ReportTargetType is what a report is about
+type ReportTargetType string
+
+const (
+	ReportTargetGame ReportTargetType = "game"
+	ReportTargetUser ReportTargetType = "user"
+)
+
+// ReportReason is why something was reported
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "spam"          // Advertising or repeated junk games
+	ReportReasonHarassment    ReportReason = "harassment"    // Abusive or threatening behavior
+	ReportReasonInappropriate ReportReason = "inappropriate" // Offensive names, titles or descriptions
+	ReportReasonFraud         ReportReason = "fraud"         // Taking payment for games that don't happen
+	ReportReasonSafety        ReportReason = "safety"        // Unsafe venue or conduct
+	ReportReasonOther         ReportReason = "other"         // Anything else; explain in details
+)
+
+// ReportStatus is where a report is in admin review
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"      // Awaiting review
+	ReportStatusResolved  ReportStatus = "resolved"  // Reviewed and acted on
+	ReportStatusDismissed ReportStatus = "dismissed" // Reviewed, no action needed
+)
+
+// CreateReportRequest represents the request body for reporting a game or user
+type CreateReportRequest struct {
+	TargetType ReportTargetType `json:"targetType" binding:"required,oneof=game user"`                                    // What is being reported
+	TargetID   string           `json:"targetId" binding:"required,uuid"`                                                 // Game or user UUID
+	Reason     ReportReason     `json:"reason" binding:"required,oneof=spam harassment inappropriate fraud safety other"` // Why it is being reported
+	Details    *string          `json:"details,omitempty" binding:"omitempty,max=2000"`                                   // Free-text explanation
+}
+
+// Report is a report filed by a player, or by the content filter, for admin review
+type Report struct {
+	ID         string           `json:"id"`                   // Report UUID
+	ReporterID *string          `json:"reporterId"`           // User UUID of the reporter (null when flagged by the content filter)
+	TargetType ReportTargetType `json:"targetType"`           // What was reported
+	TargetID   string           `json:"targetId"`             // Game or user UUID
+	Reason     ReportReason     `json:"reason"`               // Why it was reported
+	Details    *string          `json:"details,omitempty"`    // Free-text explanation
+	Status     ReportStatus     `json:"status"`               // Review status
+	CreatedAt  time.Time        `json:"createdAt"`            // When it was filed
+	ReviewedAt *time.Time       `json:"reviewedAt,omitempty"` // When an admin resolved or dismissed it
+}
+
+// ListReportsResponse represents one page of reports in the admin review queue
+type ListReportsResponse struct {
+	Reports    []Report `json:"reports"`              // Reports on this page, oldest first
+	Limit      int      `json:"limit"`                // Page size used
+	Offset     int      `json:"offset"`               // Number of reports skipped
+	HasMore    bool     `json:"hasMore"`              // Whether another page follows
+	NextOffset *int     `json:"nextOffset,omitempty"` // Offset of the next page, if any
+}
+
+// ReviewReportRequest represents the request body for closing a report
+type ReviewReportRequest struct {
+	Status ReportStatus `json:"status" binding:"required,oneof=resolved dismissed"` // Outcome of the review
+}
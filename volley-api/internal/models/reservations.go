@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// ReserveSpotsRequest holds spots in a game for specific players until ExpiresAt. Reserving again
+// for a player moves their expiry.
+type ReserveSpotsRequest struct {
+	UserIDs   []string  `json:"userIds" binding:"required,min=1,max=50,dive,uuid"` // Players to hold a spot for
+	ExpiresAt time.Time `json:"expiresAt" binding:"required"`                      // When unclaimed spots go back to everyone
+}
+
+// Reservation is a spot a host holds for a specific player
+type Reservation struct {
+	UserID    string    `json:"userId"`    // Player the spot is held for
+	FirstName string    `json:"firstName"` // Player's first name
+	LastName  string    `json:"lastName"`  // Player's last name
+	ExpiresAt time.Time `json:"expiresAt"` // When the spot goes back to everyone if unclaimed
+	Joined    bool      `json:"joined"`    // Whether the player has taken their spot
+	Expired   bool      `json:"expired"`   // Whether the hold has lapsed
+	CreatedAt time.Time `json:"createdAt"` // When the spot was reserved
+}
+
+// ListReservationsResponse represents a game's reservations
+type ListReservationsResponse struct {
+	Reservations []Reservation `json:"reservations"` // Reservations ordered from oldest to newest
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// SavedSearchFilters are the game listing filters a saved search re-runs, named like the
+// GET /v1/games query parameters they fill in
+type SavedSearchFilters struct {
+	Categories  []GameCategory `json:"categories,omitempty" binding:"omitempty,max=8,dive,oneof=soccer basketball pickleball flag_football volleyball ultimate_frisbee tennis other"` // Sports to search; omit for the user's sport preferences
+	Latitude    *float64       `json:"latitude" binding:"required,min=-90,max=90"`                                                                                                    // Center of the search, e.g. the user's work
+	Longitude   *float64       `json:"longitude" binding:"required,min=-180,max=180"`                                                                                                 // Center of the search
+	Radius      float64        `json:"radius" binding:"omitempty,gt=0,max=160934"`                                                                                                    // Search radius in meters (defaults to 10 miles, max 100 miles)
+	SkillLevels []SkillLevel   `json:"skillLevels,omitempty" binding:"omitempty,dive,oneof=beginner intermediate advanced all"`                                                       // Skill levels to search; omit for any
+}
+
+// SavedSearch is a named set of game listing filters
+type SavedSearch struct {
+	ID        string             `json:"id"`        // Saved search UUID
+	Name      string             `json:"name"`      // What the user called it, e.g. "Tuesday volleyball near work"
+	Filters   SavedSearchFilters `json:"filters"`   // Filters to run GET /v1/games with
+	Alerts    bool               `json:"alerts"`    // Whether new games matching it are sent to the user
+	CreatedAt time.Time          `json:"createdAt"` // When it was saved
+}
+
+// CreateSavedSearchRequest represents the request body for saving a search
+type CreateSavedSearchRequest struct {
+	Name    string             `json:"name" binding:"required,max=100"` // Name to list it under
+	Filters SavedSearchFilters `json:"filters"`                         // Filters to save
+	Alerts  *bool              `json:"alerts"`                          // Send new games that match (defaults to true)
+}
+
+// UpdateSavedSearchRequest represents the request body for changing a saved search
+type UpdateSavedSearchRequest struct {
+	Alerts *bool `json:"alerts" binding:"required"` // Send new games that match
+}
+
+// ListSavedSearchesResponse lists the user's saved searches
+type ListSavedSearchesResponse struct {
+	SavedSearches []SavedSearch `json:"savedSearches"` // Saved searches, oldest first
+}
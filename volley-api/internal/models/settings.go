@@ -0,0 +1,29 @@
+package models
+
+// HomeLocation is where a user usually plays from
+type HomeLocation struct {
+	Latitude  float64 `json:"latitude"`          // Latitude of the home location
+	Longitude float64 `json:"longitude"`         // Longitude of the home location
+	PlaceID   *string `json:"placeId,omitempty"` // Google Place ID when picked from place search
+	Name      *string `json:"name,omitempty"`    // Place name when picked from place search
+}
+
+// UserSettings are a user's defaults for location-based searches
+type UserSettings struct {
+	HomeLocation  *HomeLocation `json:"homeLocation,omitempty"`  // Used when a search omits coordinates (omitted when not set)
+	DefaultRadius *float64      `json:"defaultRadius,omitempty"` // Search radius in meters used when a search omits one (omitted when not set)
+}
+
+// HomeLocationInput sets the home location from coordinates or a Google Place ID, but not both
+type HomeLocationInput struct {
+	Latitude  *float64 `json:"latitude,omitempty" binding:"omitempty,min=-90,max=90"`    // Latitude of the home location
+	Longitude *float64 `json:"longitude,omitempty" binding:"omitempty,min=-180,max=180"` // Longitude of the home location
+	PlaceID   *string  `json:"placeId,omitempty" binding:"omitempty,max=255"`            // Google Place ID, resolved to coordinates
+}
+
+// UpdateSettingsRequest changes a user's settings. Omitted fields are left unchanged.
+type UpdateSettingsRequest struct {
+	HomeLocation      *HomeLocationInput `json:"homeLocation,omitempty"`                                      // New home location
+	ClearHomeLocation bool               `json:"clearHomeLocation,omitempty"`                                 // Remove the saved home location
+	DefaultRadius     *float64           `json:"defaultRadius,omitempty" binding:"omitempty,gt=0,max=160934"` // New default radius in meters (max 100 miles)
+}
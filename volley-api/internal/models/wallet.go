@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// CreditKind is why a user's credit balance changed
+type CreditKind string
+
+const (
+	CreditKindReferral CreditKind = "referral"  // Earned when a friend signed up with the user's referral code
+	CreditKindRefund   CreditKind = "refund"    // Credit spent on a game, returned after a drop or cancellation
+	CreditKindGameJoin CreditKind = "game_join" // Spent toward a paid game when joining it
+)
+
+// CreditTransaction is one entry in a user's credit ledger
+type CreditTransaction struct {
+	ID                string     `json:"id"`                // Transaction UUID
+	AmountCents       int        `json:"amountCents"`       // Positive when credit was earned, negative when spent
+	Kind              CreditKind `json:"kind"`              // Why the balance changed
+	GameID            *string    `json:"gameId,omitempty"`  // Game the credit was spent on or refunded from
+	BalanceAfterCents int        `json:"balanceAfterCents"` // Balance right after this transaction
+	CreatedAt         time.Time  `json:"createdAt"`         // When the transaction happened
+}
+
+// Wallet is a user's credit balance and one page of their transaction history
+type Wallet struct {
+	BalanceCents int                 `json:"balanceCents"`         // Credit available to spend
+	Currency     string              `json:"currency"`             // ISO 4217 currency of the credit
+	Transactions []CreditTransaction `json:"transactions"`         // Most recent first
+	Limit        int                 `json:"limit"`                // Page size used
+	Offset       int                 `json:"offset"`               // Number of transactions skipped
+	HasMore      bool                `json:"hasMore"`              // Whether another page follows
+	NextOffset   *int                `json:"nextOffset,omitempty"` // Offset of the next page, if any
+}
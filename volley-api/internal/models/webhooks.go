@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// WebhookEventType is a kind of event a webhook subscription can receive
+type WebhookEventType string
+
+const (
+	WebhookEventGameCreated        WebhookEventType = "game.created"
+	WebhookEventParticipantJoined  WebhookEventType = "participant.joined"
+	WebhookEventParticipantDropped WebhookEventType = "participant.dropped"
+	WebhookEventGameCancelled      WebhookEventType = "game.cancelled"
+)
+
+// WebhookSubscription is an endpoint that receives events about the games its owner hosts
+type WebhookSubscription struct {
+	ID         string             `json:"id"`               // Subscription UUID
+	URL        string             `json:"url"`              // HTTPS endpoint events are POSTed to
+	EventTypes []WebhookEventType `json:"eventTypes"`       // Events sent to the endpoint
+	Secret     string             `json:"secret,omitempty"` // Signing secret, only returned when the subscription is created
+	CreatedAt  time.Time          `json:"createdAt"`        // When the subscription was created
+}
+
+// CreateWebhookRequest represents the request body for subscribing an endpoint to game events
+type CreateWebhookRequest struct {
+	URL        string             `json:"url" binding:"required,max=2048"`                                                                                   // HTTPS endpoint to POST events to
+	EventTypes []WebhookEventType `json:"eventTypes" binding:"required,min=1,dive,oneof=game.created participant.joined participant.dropped game.cancelled"` // Events to send
+}
+
+// ListWebhooksResponse lists the user's webhook subscriptions
+type ListWebhooksResponse struct {
+	Webhooks []WebhookSubscription `json:"webhooks"` // Subscriptions, oldest first
+}
+
+// WebhookEvent is the JSON body POSTed to a subscribed endpoint
+type WebhookEvent struct {
+	ID        string           `json:"id"`        // Event UUID, the same for every endpoint it is sent to
+	Type      WebhookEventType `json:"type"`      // What happened
+	CreatedAt time.Time        `json:"createdAt"` // When it happened
+	Data      WebhookEventData `json:"data"`      // The game and player involved
+}
+
+// WebhookEventData identifies what an event is about. Receivers fetch current details from the API.
+type WebhookEventData struct {
+	GameID            string             `json:"gameId"`                      // Game UUID
+	UserID            *string            `json:"userId,omitempty"`            // Player who joined or dropped
+	ParticipantStatus *ParticipantStatus `json:"participantStatus,omitempty"` // Where a joining player landed: confirmed or waitlist
+	Reason            *string            `json:"reason,omitempty"`            // Why the game was cancelled, when given
+}
@@ -0,0 +1,38 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ErrBounced is returned by a sender when the provider permanently rejects the recipient, such as
+// an address that doesn't exist or a number that can't receive texts. Retrying won't help.
+var ErrBounced = errors.New("recipient rejected by provider")
+
+// Email is a rendered email with a plain-text body and an HTML alternative
+type Email struct {
+	Subject        string
+	Text           string
+	HTML           string
+	UnsubscribeURL string // Set on emails the recipient can opt out of; providers should also send it as List-Unsubscribe
+}
+
+// EmailSender delivers an email to a single address
+type EmailSender interface {
+	SendEmail(ctx context.Context, to string, email Email) error
+}
+
+// LogEmailSender writes emails to the log instead of delivering them.
+// It is used when no email provider is configured (local development and tests).
+type LogEmailSender struct{}
+
+func NewLogEmailSender() *LogEmailSender {
+	return &LogEmailSender{}
+}
+
+func (s *LogEmailSender) SendEmail(ctx context.Context, to string, email Email) error {
+	log.Ctx(ctx).Info().Str("to", to).Str("subject", email.Subject).Str("body", email.Text).Msg("Email provider not configured - logging email instead of sending")
+	return nil
+}
@@ -0,0 +1,37 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ErrNoPushDevices is returned by a PushSender when the user hasn't enabled push on any device
+var ErrNoPushDevices = errors.New("user has no devices registered for push")
+
+// PushMessage is a push notification. Link is the deep link opened when it is tapped.
+type PushMessage struct {
+	Title string
+	Body  string
+	Link  string
+}
+
+// PushSender delivers a push notification to every device the user has registered with the
+// push provider, addressed by their user ID
+type PushSender interface {
+	SendPush(ctx context.Context, userID string, message PushMessage) error
+}
+
+// LogPushSender writes push notifications to the log instead of delivering them.
+// It is used when no push provider is configured (local development and tests).
+type LogPushSender struct{}
+
+func NewLogPushSender() *LogPushSender {
+	return &LogPushSender{}
+}
+
+func (s *LogPushSender) SendPush(ctx context.Context, userID string, message PushMessage) error {
+	log.Ctx(ctx).Info().Str("userId", userID).Str("title", message.Title).Str("body", message.Body).Str("link", message.Link).Msg("Push provider not configured - logging push notification instead of sending")
+	return nil
+}
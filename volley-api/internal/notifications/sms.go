@@ -0,0 +1,25 @@
+package notifications
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// SMSSender delivers a text message to a phone number in E.164 format
+type SMSSender interface {
+	SendSMS(ctx context.Context, to string, body string) error
+}
+
+// LogSMSSender writes messages to the log instead of delivering them.
+// It is used when no SMS provider is configured (local development and tests).
+type LogSMSSender struct{}
+
+func NewLogSMSSender() *LogSMSSender {
+	return &LogSMSSender{}
+}
+
+func (s *LogSMSSender) SendSMS(ctx context.Context, to string, body string) error {
+	log.Ctx(ctx).Info().Str("to", to).Str("body", body).Msg("SMS provider not configured - logging message instead of sending")
+	return nil
+}
@@ -0,0 +1,150 @@
+package notifications
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// EmailTemplate names a kind of email. Each one has a templates/<name>.txt file defining the
+// "subject" and "text" blocks and a templates/<name>.html file defining the "content" block,
+// which is placed in templates/layout.html.
+type EmailTemplate string
+
+const (
+	EmailMagicLink             EmailTemplate = "magic_link"
+	EmailChangeConfirmation    EmailTemplate = "email_change_confirmation"
+	EmailChanged               EmailTemplate = "email_changed"
+	EmailWaitlistPromotion     EmailTemplate = "waitlist_promotion"
+	EmailGameCancelled         EmailTemplate = "game_cancelled"
+	EmailGameAnnouncement      EmailTemplate = "game_announcement"
+	EmailDirectMessage         EmailTemplate = "direct_message"
+	EmailNewGameAlert          EmailTemplate = "new_game_alert"
+	EmailReceipt               EmailTemplate = "receipt"
+	EmailContactSharingRequest EmailTemplate = "contact_sharing_request"
+)
+
+// MagicLinkEmail fills EmailMagicLink
+type MagicLinkEmail struct {
+	Link             string
+	ExpiresInMinutes int
+}
+
+// EmailChangeEmail fills EmailChangeConfirmation and EmailChanged
+type EmailChangeEmail struct {
+	NewEmail       string
+	Link           string
+	ExpiresInHours int
+}
+
+// GameEmail fills the templates for notifications about a game
+type GameEmail struct {
+	RecipientName string
+	GameTitle     string
+	LocationName  string
+	StartTime     time.Time // Shown as is, so convert it to the recipient's timezone first
+	Link          string
+	Reason        string // Why the game was cancelled, if the host said
+	Message       string // What an organizer announced to the roster, or a direct message
+	SenderName    string // Who sent a direct message
+	SearchName    string // The saved search a new game alert matched
+}
+
+// ReceiptEmail fills EmailReceipt
+type ReceiptEmail struct {
+	RecipientName   string
+	Number          string
+	GameTitle       string
+	Venue           string
+	StartTime       time.Time // Shown as is, so convert it to the recipient's timezone first
+	DurationMinutes int
+	Organizer       string
+	Amount          string // Formatted with its currency, e.g. "12.50 USD"
+	AmountEstimated bool
+}
+
+//go:embed templates
+var templateFiles embed.FS
+
+var templateFuncs = map[string]any{
+	// when formats a time the way every email shows it, e.g. "Saturday, June 7 at 6:30 PM CDT"
+	"when": func(t time.Time) string {
+		return t.Format("Monday, January 2 at 3:04 PM MST")
+	},
+}
+
+type emailTemplates struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// layoutData fills templates/layout.html. Content has already been escaped by its own template.
+type layoutData struct {
+	Content        htmltemplate.HTML
+	UnsubscribeURL string
+}
+
+var layout = htmltemplate.Must(htmltemplate.ParseFS(templateFiles, "templates/layout.html"))
+
+var registeredTemplates = mustParseTemplates(
+	EmailMagicLink,
+	EmailChangeConfirmation,
+	EmailChanged,
+	EmailWaitlistPromotion,
+	EmailGameCancelled,
+	EmailGameAnnouncement,
+	EmailDirectMessage,
+	EmailNewGameAlert,
+	EmailReceipt,
+	EmailContactSharingRequest,
+)
+
+// mustParseTemplates parses the embedded templates at startup, so a broken template stops the
+// server from starting rather than failing the first send
+func mustParseTemplates(names ...EmailTemplate) map[EmailTemplate]emailTemplates {
+	parsed := make(map[EmailTemplate]emailTemplates, len(names))
+	for _, name := range names {
+		text := texttemplate.Must(texttemplate.New(string(name)).Funcs(templateFuncs).ParseFS(templateFiles, fmt.Sprintf("templates/%s.txt", name)))
+		html := htmltemplate.Must(htmltemplate.New(string(name)).Funcs(templateFuncs).ParseFS(templateFiles, fmt.Sprintf("templates/%s.html", name)))
+		parsed[name] = emailTemplates{text: text, html: html}
+	}
+	return parsed
+}
+
+// RenderEmail fills in the named template with data. When unsubscribeURL is set it is linked in the
+// footer; account emails such as sign-in links pass "" since they can't be opted out of.
+func RenderEmail(name EmailTemplate, data any, unsubscribeURL string) (Email, error) {
+	templates, ok := registeredTemplates[name]
+	if !ok {
+		return Email{}, fmt.Errorf("unknown email template %q", name)
+	}
+
+	var subject, text, content, html bytes.Buffer
+	if err := templates.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Email{}, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := templates.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return Email{}, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+	if err := templates.html.ExecuteTemplate(&content, "content", data); err != nil {
+		return Email{}, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+	if err := layout.Execute(&html, layoutData{Content: htmltemplate.HTML(content.String()), UnsubscribeURL: unsubscribeURL}); err != nil {
+		return Email{}, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+
+	email := Email{
+		Subject:        strings.TrimSpace(subject.String()),
+		Text:           strings.TrimSpace(text.String()),
+		HTML:           html.String(),
+		UnsubscribeURL: unsubscribeURL,
+	}
+	if unsubscribeURL != "" {
+		email.Text += "\n\n--\nUnsubscribe from game emails: " + unsubscribeURL
+	}
+	return email, nil
+}
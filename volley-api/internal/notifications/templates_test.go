@@ -0,0 +1,123 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderEmail(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	game := GameEmail{
+		RecipientName: "Sam",
+		GameTitle:     "Sunday Soccer",
+		LocationName:  "Golden Gate Park",
+		StartTime:     time.Date(2026, time.June, 7, 18, 30, 0, 0, chicago),
+		Link:          "https://app.volley.gg/games/123",
+	}
+	receipt := ReceiptEmail{
+		RecipientName:   "Sam",
+		Number:          "7c1e4f0a-2b9d-4e57-9a43-0d8f6b2c1e90",
+		GameTitle:       "Sunday Soccer",
+		Venue:           "Golden Gate Park",
+		StartTime:       time.Date(2026, time.June, 7, 18, 30, 0, 0, chicago),
+		DurationMinutes: 90,
+		Organizer:       "Ana Diaz",
+		Amount:          "3.34 USD",
+		AmountEstimated: true,
+	}
+
+	t.Run("every template renders a subject, text and html", func(t *testing.T) {
+		data := map[EmailTemplate]any{
+			EmailMagicLink:             MagicLinkEmail{Link: "https://app.volley.gg/magic-link?token=abc", ExpiresInMinutes: 15},
+			EmailChangeConfirmation:    EmailChangeEmail{NewEmail: "new@test.com", Link: "https://app.volley.gg/confirm-email?token=abc", ExpiresInHours: 24},
+			EmailChanged:               EmailChangeEmail{NewEmail: "new@test.com"},
+			EmailWaitlistPromotion:     game,
+			EmailGameCancelled:         game,
+			EmailGameAnnouncement:      game,
+			EmailDirectMessage:         game,
+			EmailNewGameAlert:          game,
+			EmailReceipt:               receipt,
+			EmailContactSharingRequest: game,
+		}
+		require.Len(t, data, len(registeredTemplates))
+
+		for name, d := range data {
+			email, err := RenderEmail(name, d, "")
+			require.NoError(t, err, name)
+			assert.NotEmpty(t, email.Subject, name)
+			assert.NotEmpty(t, email.Text, name)
+			assert.Contains(t, email.HTML, "<html>", name)
+		}
+	})
+
+	t.Run("game times are shown in the zone they are given in", func(t *testing.T) {
+		email, err := RenderEmail(EmailWaitlistPromotion, game, "")
+		require.NoError(t, err)
+		assert.Contains(t, email.Text, "Sunday, June 7 at 6:30 PM CDT")
+	})
+
+	t.Run("cancellation reason is included only when given", func(t *testing.T) {
+		email, err := RenderEmail(EmailGameCancelled, game, "")
+		require.NoError(t, err)
+		assert.NotContains(t, email.Text, "The host said")
+
+		game := game
+		game.Reason = `Field is <flooded>`
+		email, err = RenderEmail(EmailGameCancelled, game, "")
+		require.NoError(t, err)
+		assert.Contains(t, email.Text, `The host said: "Field is <flooded>"`)
+		assert.Contains(t, email.HTML, "Field is &lt;flooded&gt;")
+	})
+
+	t.Run("announcements quote the organizer's message", func(t *testing.T) {
+		game := game
+		game.Message = `Bring a <white> shirt`
+		email, err := RenderEmail(EmailGameAnnouncement, game, "")
+		require.NoError(t, err)
+		assert.Equal(t, "Update for Sunday Soccer", email.Subject)
+		assert.Contains(t, email.Text, `"Bring a <white> shirt"`)
+		assert.Contains(t, email.HTML, "Bring a &lt;white&gt; shirt")
+	})
+
+	t.Run("direct messages name the sender", func(t *testing.T) {
+		game := game
+		game.SenderName = "Alex"
+		game.Message = "Is there parking nearby?"
+		email, err := RenderEmail(EmailDirectMessage, game, "")
+		require.NoError(t, err)
+		assert.Equal(t, "Message from Alex", email.Subject)
+		assert.Contains(t, email.Text, `Alex sent you a message about Sunday Soccer`)
+		assert.Contains(t, email.Text, `"Is there parking nearby?"`)
+	})
+
+	t.Run("new game alerts name the saved search", func(t *testing.T) {
+		game := game
+		game.SearchName = "Near work"
+		email, err := RenderEmail(EmailNewGameAlert, game, "")
+		require.NoError(t, err)
+		assert.Equal(t, "New game: Sunday Soccer", email.Subject)
+		assert.Contains(t, email.Text, `matches your saved search "Near work"`)
+	})
+
+	t.Run("unsubscribe links go in both footers when given", func(t *testing.T) {
+		email, err := RenderEmail(EmailWaitlistPromotion, game, "https://app.volley.gg/unsubscribe?token=abc")
+		require.NoError(t, err)
+		assert.Equal(t, "https://app.volley.gg/unsubscribe?token=abc", email.UnsubscribeURL)
+		assert.Contains(t, email.Text, "Unsubscribe from game emails: https://app.volley.gg/unsubscribe?token=abc")
+		assert.Contains(t, email.HTML, `href="https://app.volley.gg/unsubscribe?token=abc"`)
+
+		email, err = RenderEmail(EmailWaitlistPromotion, game, "")
+		require.NoError(t, err)
+		assert.NotContains(t, email.Text, "Unsubscribe")
+		assert.NotContains(t, email.HTML, "Unsubscribe")
+	})
+
+	t.Run("unknown templates are an error", func(t *testing.T) {
+		_, err := RenderEmail("nope", game, "")
+		assert.Error(t, err)
+	})
+}
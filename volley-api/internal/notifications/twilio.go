@@ -0,0 +1,78 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const twilioAPIURL = "https://api.twilio.com/2010-04-01"
+
+// twilioBounceCodes are Twilio errors that mean the number can never be texted: invalid, opted
+// out, or not a mobile number
+var twilioBounceCodes = map[int]bool{21211: true, 21610: true, 21614: true}
+
+// TwilioSMSSender sends text messages through the Twilio Messaging API
+type TwilioSMSSender struct {
+	accountSID string
+	authToken  string
+	from       string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewTwilioSMSSender returns a sender for the Twilio account that texts from the given number
+// (E.164) or messaging service SID
+func NewTwilioSMSSender(accountSID string, authToken string, from string) *TwilioSMSSender {
+	return &TwilioSMSSender{
+		accountSID: accountSID,
+		authToken:  authToken,
+		from:       from,
+		baseURL:    twilioAPIURL,
+		httpClient: &http.Client{},
+	}
+}
+
+func (s *TwilioSMSSender) SendSMS(ctx context.Context, to string, body string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("Body", body)
+	if strings.HasPrefix(s.from, "MG") {
+		form.Set("MessagingServiceSid", s.from)
+	} else {
+		form.Set("From", s.from)
+	}
+
+	endpoint := fmt.Sprintf("%s/Accounts/%s/Messages.json", s.baseURL, url.PathEscape(s.accountSID))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpReq.SetBasicAuth(s.accountSID, s.authToken)
+
+	resp, err := s.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call Twilio API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		// Twilio explains rejections (unverified numbers, opted-out recipients) in the body
+		var twilioErr struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&twilioErr); err == nil && twilioErr.Message != "" {
+			if twilioBounceCodes[twilioErr.Code] {
+				return fmt.Errorf("%w: %s (code %d)", ErrBounced, twilioErr.Message, twilioErr.Code)
+			}
+			return fmt.Errorf("twilio API returned status %d: %s (code %d)", resp.StatusCode, twilioErr.Message, twilioErr.Code)
+		}
+		return fmt.Errorf("twilio API returned status %d", resp.StatusCode)
+	}
+	return nil
+}
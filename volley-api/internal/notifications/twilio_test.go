@@ -0,0 +1,75 @@
+package notifications
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwilioSMSSender(t *testing.T) {
+	t.Run("posts the message with the account's credentials", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/Accounts/AC123/Messages.json", r.URL.Path)
+			user, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "AC123", user)
+			assert.Equal(t, "secret", password)
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "+15551234567", r.PostForm.Get("To"))
+			assert.Equal(t, "+15557654321", r.PostForm.Get("From"))
+			assert.Equal(t, "You're in!", r.PostForm.Get("Body"))
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		sender := NewTwilioSMSSender("AC123", "secret", "+15557654321")
+		sender.baseURL = server.URL
+		require.NoError(t, sender.SendSMS(context.Background(), "+15551234567", "You're in!"))
+	})
+
+	t.Run("messaging service SIDs are sent as the sender", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "MG456", r.PostForm.Get("MessagingServiceSid"))
+			assert.Empty(t, r.PostForm.Get("From"))
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		sender := NewTwilioSMSSender("AC123", "secret", "MG456")
+		sender.baseURL = server.URL
+		require.NoError(t, sender.SendSMS(context.Background(), "+15551234567", "You're in!"))
+	})
+
+	t.Run("rejections include Twilio's explanation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":21610,"message":"Attempt to send to unsubscribed recipient"}`))
+		}))
+		defer server.Close()
+
+		sender := NewTwilioSMSSender("AC123", "secret", "+15557654321")
+		sender.baseURL = server.URL
+		err := sender.SendSMS(context.Background(), "+15551234567", "You're in!")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsubscribed recipient")
+		assert.ErrorIs(t, err, ErrBounced)
+	})
+
+	t.Run("server errors are not bounces", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		sender := NewTwilioSMSSender("AC123", "secret", "+15557654321")
+		sender.baseURL = server.URL
+		err := sender.SendSMS(context.Background(), "+15551234567", "You're in!")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrBounced)
+	})
+}
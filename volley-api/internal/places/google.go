@@ -0,0 +1,127 @@
+package places
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+)
+
+// GoogleClient calls the Google Places API v1
+type GoogleClient struct {
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewGoogleClient returns a client for the Google Places API. With an empty API key every call
+// fails with ErrNotConfigured.
+func NewGoogleClient(apiKey string) *GoogleClient {
+	return &GoogleClient{
+		apiKey:     apiKey,
+		httpClient: &http.Client{},
+	}
+}
+
+func (g *GoogleClient) Search(ctx context.Context, textQuery string) ([]models.PlacePrediction, error) {
+	if g.apiKey == "" {
+		return nil, ErrNotConfigured
+	}
+
+	requestBody, err := json.Marshal(map[string]any{
+		"textQuery":      textQuery,
+		"maxResultCount": 5,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", "https://places.googleapis.com/v1/places:searchText", bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-Goog-Api-Key", g.apiKey)
+	httpReq.Header.Set("X-Goog-FieldMask", "places.id,places.displayName,places.formattedAddress,places.location")
+
+	resp, err := g.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Google Places API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("google places API returned status %d", resp.StatusCode)
+	}
+
+	var googleResp models.GooglePlacesSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&googleResp); err != nil {
+		return nil, fmt.Errorf("failed to parse Google Places API response: %w", err)
+	}
+
+	predictions := make([]models.PlacePrediction, len(googleResp.Places))
+	for i, place := range googleResp.Places {
+		addressParts := strings.Split(place.FormattedAddress, ",")
+		mainText := place.DisplayName.Text
+		if mainText == "" && len(addressParts) > 0 {
+			mainText = strings.TrimSpace(addressParts[0])
+		}
+
+		secondaryText := ""
+		if len(addressParts) > 1 {
+			secondaryText = strings.TrimSpace(strings.Join(addressParts[1:], ","))
+		}
+
+		predictions[i] = models.PlacePrediction{
+			PlaceID:     place.ID,
+			Description: place.FormattedAddress,
+			Formatting: models.PlaceStructuredFormatting{
+				MainText:      mainText,
+				SecondaryText: secondaryText,
+			},
+		}
+	}
+	return predictions, nil
+}
+
+func (g *GoogleClient) Details(ctx context.Context, placeID string) (*models.PlaceDetailsResponse, error) {
+	if g.apiKey == "" {
+		return nil, ErrNotConfigured
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://places.googleapis.com/v1/places/%s", placeID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	httpReq.Header.Set("X-Goog-Api-Key", g.apiKey)
+	httpReq.Header.Set("X-Goog-FieldMask", "displayName,formattedAddress,location")
+
+	resp, err := g.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Google Places API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrPlaceNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("google places API returned status %d", resp.StatusCode)
+	}
+
+	var googleResp models.GooglePlaceDetailsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&googleResp); err != nil {
+		return nil, fmt.Errorf("failed to parse Google Places API response: %w", err)
+	}
+
+	return &models.PlaceDetailsResponse{
+		PlaceID:          googleResp.ID,
+		Name:             googleResp.DisplayName.Text,
+		FormattedAddress: googleResp.FormattedAddress,
+		Latitude:         googleResp.Location.Latitude,
+		Longitude:        googleResp.Location.Longitude,
+	}, nil
+}
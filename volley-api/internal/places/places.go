@@ -0,0 +1,19 @@
+package places
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+)
+
+var (
+	ErrNotConfigured = errors.New("places provider not configured")
+	ErrPlaceNotFound = errors.New("place not found")
+)
+
+// Client looks up places for location autocomplete
+type Client interface {
+	Search(ctx context.Context, textQuery string) ([]models.PlacePrediction, error)
+	Details(ctx context.Context, placeID string) (*models.PlaceDetailsResponse, error)
+}
@@ -0,0 +1,66 @@
+package places
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+)
+
+// sandboxPlaces are the fixed places returned in sandbox mode
+var sandboxPlaces = []models.PlaceDetailsResponse{
+	{PlaceID: "sandbox-golden-gate-park", Name: "Golden Gate Park", FormattedAddress: "501 Stanyan St, San Francisco, CA 94117, USA", Latitude: 37.7694, Longitude: -122.4862},
+	{PlaceID: "sandbox-dolores-park", Name: "Mission Dolores Park", FormattedAddress: "Dolores St & 19th St, San Francisco, CA 94114, USA", Latitude: 37.7596, Longitude: -122.4269},
+	{PlaceID: "sandbox-memorial-park", Name: "Memorial Park", FormattedAddress: "6501 Memorial Dr, Houston, TX 77007, USA", Latitude: 29.7645, Longitude: -95.4413},
+	{PlaceID: "sandbox-central-park", Name: "Central Park", FormattedAddress: "New York, NY 10024, USA", Latitude: 40.7829, Longitude: -73.9654},
+}
+
+// SandboxClient serves a fixed set of places without calling any external API. Search matches the
+// query case-insensitively against place names and addresses; a query matching nothing returns
+// every place so QA always has something to pick.
+type SandboxClient struct{}
+
+func NewSandboxClient() *SandboxClient {
+	return &SandboxClient{}
+}
+
+func (s *SandboxClient) Search(ctx context.Context, textQuery string) ([]models.PlacePrediction, error) {
+	query := strings.ToLower(textQuery)
+
+	var matches []models.PlaceDetailsResponse
+	for _, place := range sandboxPlaces {
+		if strings.Contains(strings.ToLower(place.Name), query) || strings.Contains(strings.ToLower(place.FormattedAddress), query) {
+			matches = append(matches, place)
+		}
+	}
+	if len(matches) == 0 {
+		matches = sandboxPlaces
+	}
+
+	predictions := make([]models.PlacePrediction, len(matches))
+	for i, place := range matches {
+		secondaryText := place.FormattedAddress
+		if _, rest, found := strings.Cut(place.FormattedAddress, ","); found {
+			secondaryText = strings.TrimSpace(rest)
+		}
+		predictions[i] = models.PlacePrediction{
+			PlaceID:     place.PlaceID,
+			Description: place.FormattedAddress,
+			Formatting: models.PlaceStructuredFormatting{
+				MainText:      place.Name,
+				SecondaryText: secondaryText,
+			},
+		}
+	}
+	return predictions, nil
+}
+
+func (s *SandboxClient) Details(ctx context.Context, placeID string) (*models.PlaceDetailsResponse, error) {
+	for _, place := range sandboxPlaces {
+		if place.PlaceID == placeID {
+			details := place
+			return &details, nil
+		}
+	}
+	return nil, ErrPlaceNotFound
+}
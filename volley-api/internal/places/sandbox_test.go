@@ -0,0 +1,43 @@
+package places
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandboxClient(t *testing.T) {
+	client := NewSandboxClient()
+	ctx := context.Background()
+
+	t.Run("search matches names case-insensitively", func(t *testing.T) {
+		predictions, err := client.Search(ctx, "dolores")
+		require.NoError(t, err)
+		require.Len(t, predictions, 1)
+		assert.Equal(t, "Mission Dolores Park", predictions[0].Formatting.MainText)
+		assert.Equal(t, "San Francisco, CA 94114, USA", predictions[0].Formatting.SecondaryText)
+	})
+
+	t.Run("search without matches returns every place", func(t *testing.T) {
+		predictions, err := client.Search(ctx, "nowhere in particular")
+		require.NoError(t, err)
+		assert.Len(t, predictions, len(sandboxPlaces))
+	})
+
+	t.Run("details round-trips search results", func(t *testing.T) {
+		predictions, err := client.Search(ctx, "memorial")
+		require.NoError(t, err)
+		require.NotEmpty(t, predictions)
+
+		details, err := client.Details(ctx, predictions[0].PlaceID)
+		require.NoError(t, err)
+		assert.Equal(t, "Memorial Park", details.Name)
+	})
+
+	t.Run("unknown place", func(t *testing.T) {
+		_, err := client.Details(ctx, "missing")
+		assert.ErrorIs(t, err, ErrPlaceNotFound)
+	})
+}
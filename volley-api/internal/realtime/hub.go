@@ -0,0 +1,193 @@
+// Package realtime pushes messages to clients holding a connection open, such as a game's chat
+// room, whichever replica they are connected to
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// notifyChannel is the Postgres channel every replica's hub listens on
+const notifyChannel = "volley_realtime"
+
+// maxNotifyPayload keeps a notification under Postgres's 8000 byte NOTIFY payload limit
+const maxNotifyPayload = 7900
+
+// subscriptionBuffer is how many messages a client can fall behind before it is disconnected
+const subscriptionBuffer = 32
+
+// listenRetryDelay is how long the hub waits to listen again after losing its connection
+const listenRetryDelay = 5 * time.Second
+
+// ErrMessageTooLarge is returned when a message is too large to send through Postgres
+var ErrMessageTooLarge = errors.New("realtime message too large")
+
+// Hub delivers messages to the clients that joined a room. Published messages go through Postgres
+// NOTIFY, and each replica's hub passes those it receives to its own clients, so a message reaches
+// a room's clients on every replica. Without a pool messages only reach this process's clients.
+// Delivery is best effort: messages sent while a hub is reconnecting are missed, so clients load
+// what they missed from the API after reconnecting.
+type Hub struct {
+	pool *pgxpool.Pool
+
+	mu    sync.Mutex
+	rooms map[string]map[*Subscription]struct{}
+}
+
+// notification is a published message as sent through Postgres
+type notification struct {
+	Room    string          `json:"room"`
+	Message json.RawMessage `json:"message"`
+}
+
+func NewHub(pool *pgxpool.Pool) *Hub {
+	return &Hub{
+		pool:  pool,
+		rooms: map[string]map[*Subscription]struct{}{},
+	}
+}
+
+// Subscription receives the messages published to a room after it joined
+type Subscription struct {
+	hub      *Hub
+	room     string
+	messages chan []byte
+}
+
+// Join subscribes to the room's messages. Close the subscription when the client disconnects.
+func (h *Hub) Join(room string) *Subscription {
+	sub := &Subscription{
+		hub:      h,
+		room:     room,
+		messages: make(chan []byte, subscriptionBuffer),
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.rooms[room] == nil {
+		h.rooms[room] = map[*Subscription]struct{}{}
+	}
+	h.rooms[room][sub] = struct{}{}
+	return sub
+}
+
+// Messages returns the room's messages. It is closed when the subscription is, including when the
+// client fell too far behind, in which case the client should be disconnected.
+func (s *Subscription) Messages() <-chan []byte {
+	return s.messages
+}
+
+// Close leaves the room. Closing more than once is a no-op.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+// remove drops the subscription and closes its channel; h.mu must be held
+func (h *Hub) remove(sub *Subscription) {
+	subs, ok := h.rooms[sub.room]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.rooms, sub.room)
+	}
+	close(sub.messages)
+}
+
+// Publish sends message, a JSON document, to the clients in room on every replica
+func (h *Hub) Publish(ctx context.Context, room string, message []byte) error {
+	if h.pool == nil {
+		h.deliver(room, message)
+		return nil
+	}
+
+	payload, err := json.Marshal(notification{Room: room, Message: message})
+	if err != nil {
+		return fmt.Errorf("failed to encode realtime message: %w", err)
+	}
+	if len(payload) > maxNotifyPayload {
+		return ErrMessageTooLarge
+	}
+	if _, err := h.pool.Exec(ctx, "SELECT pg_notify($1, $2)", notifyChannel, string(payload)); err != nil {
+		return fmt.Errorf("failed to publish realtime message: %w", err)
+	}
+	return nil
+}
+
+// deliver hands message to the room's clients on this replica. A client whose buffer is full is
+// dropped rather than holding up the others.
+func (h *Hub) deliver(room string, message []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.rooms[room] {
+		select {
+		case sub.messages <- message:
+		default:
+			log.Warn().Str("room", room).Msg("Realtime client fell behind - disconnecting it")
+			h.remove(sub)
+		}
+	}
+}
+
+// Run listens for the messages published by every replica and delivers them to this replica's
+// clients until ctx is cancelled, reconnecting when the connection is lost. It returns at once
+// without a pool.
+func (h *Hub) Run(ctx context.Context) {
+	if h.pool == nil {
+		return
+	}
+	for {
+		err := h.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error().Err(err).Msg("Realtime listener stopped - reconnecting")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+// listen holds a connection listening on notifyChannel until it fails. The connection is taken
+// out of the pool and closed afterwards, so no pooled connection is left listening.
+func (h *Hub) listen(ctx context.Context) error {
+	pooled, err := h.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	log.Info().Msg("Realtime listener started")
+
+	for {
+		received, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed waiting for notification: %w", err)
+		}
+		var n notification
+		if err := json.Unmarshal([]byte(received.Payload), &n); err != nil {
+			log.Error().Err(err).Msg("Failed to decode realtime message")
+			continue
+		}
+		h.deliver(n.Room, n.Message)
+	}
+}
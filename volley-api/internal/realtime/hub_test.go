@@ -0,0 +1,97 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("messages reach the clients in their room only", func(t *testing.T) {
+		hub := NewHub(nil)
+		first := hub.Join("game-chat:1")
+		second := hub.Join("game-chat:1")
+		other := hub.Join("game-chat:2")
+
+		require.NoError(t, hub.Publish(ctx, "game-chat:1", []byte(`{"n":1}`)))
+
+		assert.Equal(t, `{"n":1}`, string(<-first.Messages()))
+		assert.Equal(t, `{"n":1}`, string(<-second.Messages()))
+		assert.Empty(t, other.Messages())
+	})
+
+	t.Run("closed subscriptions get nothing more and can be closed again", func(t *testing.T) {
+		hub := NewHub(nil)
+		sub := hub.Join("game-chat:1")
+		sub.Close()
+		sub.Close()
+
+		require.NoError(t, hub.Publish(ctx, "game-chat:1", []byte(`{}`)))
+
+		_, open := <-sub.Messages()
+		assert.False(t, open)
+		assert.Empty(t, hub.rooms)
+	})
+
+	t.Run("clients that fall behind are disconnected", func(t *testing.T) {
+		hub := NewHub(nil)
+		slow := hub.Join("game-chat:1")
+
+		for i := 0; i <= subscriptionBuffer; i++ {
+			require.NoError(t, hub.Publish(ctx, "game-chat:1", []byte(`{}`)))
+		}
+
+		received := 0
+		for range slow.Messages() {
+			received++
+		}
+		assert.Equal(t, subscriptionBuffer, received)
+	})
+
+	t.Run("chat messages are relayed to the game's room", func(t *testing.T) {
+		hub := NewHub(nil)
+		bus := events.NewBus()
+		Relay(bus, hub)
+		sub := hub.Join(GameChatRoom("game-1"))
+
+		bus.Publish(ctx, events.ChatMessagePosted{Message: models.ChatMessage{ID: "message-1", GameID: "game-1", Body: "Running late"}})
+
+		var frame models.ChatFrame
+		require.NoError(t, json.Unmarshal(<-sub.Messages(), &frame))
+		assert.Equal(t, models.ChatFrameMessage, frame.Type)
+		require.NotNil(t, frame.Message)
+		assert.Equal(t, "Running late", frame.Message.Body)
+	})
+}
+
+func TestRelayGameEvents(t *testing.T) {
+	ctx := context.Background()
+	hub := NewHub(nil)
+	bus := events.NewBus()
+	Relay(bus, hub)
+	sub := hub.Join(GameEventsRoom("game-1"))
+
+	bus.Publish(ctx, events.ParticipantJoined{Game: events.Game{ID: "game-1"}, UserID: "user-1", Status: models.ParticipantStatusWaitlist})
+	bus.Publish(ctx, events.GameCancelled{Game: events.Game{ID: "game-2"}})
+	bus.Publish(ctx, events.GameCancelled{Game: events.Game{ID: "game-1"}})
+
+	message := <-sub.Messages()
+	assert.NotContains(t, string(message), "user-1", "events don't say who, since rosters can hide minors")
+	var joined models.GameLiveEvent
+	require.NoError(t, json.Unmarshal(message, &joined))
+	assert.Equal(t, models.GameLiveEventParticipantJoined, joined.Type)
+	require.NotNil(t, joined.Status)
+	assert.Equal(t, models.ParticipantStatusWaitlist, *joined.Status)
+
+	var cancelled models.GameLiveEvent
+	require.NoError(t, json.Unmarshal(<-sub.Messages(), &cancelled))
+	assert.Equal(t, models.GameLiveEventGameCancelled, cancelled.Type)
+	assert.Empty(t, sub.Messages())
+}
@@ -0,0 +1,56 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+)
+
+// GameChatRoom is the room a game's chat messages are published to
+func GameChatRoom(gameID string) string {
+	return "game-chat:" + gameID
+}
+
+// GameEventsRoom is the room changes to a game's roster and status are published to
+func GameEventsRoom(gameID string) string {
+	return "game-events:" + gameID
+}
+
+// Relay publishes the game events that connected clients show live to the hub's rooms
+func Relay(bus *events.Bus, hub *Hub) {
+	events.Subscribe(bus, func(ctx context.Context, event events.ChatMessagePosted) error {
+		message := event.Message
+		return publishJSON(ctx, hub, GameChatRoom(message.GameID), models.ChatFrame{Type: models.ChatFrameMessage, Message: &message})
+	})
+
+	events.Subscribe(bus, func(ctx context.Context, event events.ParticipantJoined) error {
+		status := event.Status
+		return publishGameEvent(ctx, hub, event.Game.ID, models.GameLiveEvent{Type: models.GameLiveEventParticipantJoined, Status: &status})
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.ParticipantDropped) error {
+		return publishGameEvent(ctx, hub, event.Game.ID, models.GameLiveEvent{Type: models.GameLiveEventParticipantDropped})
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.ParticipantPromoted) error {
+		return publishGameEvent(ctx, hub, event.Game.ID, models.GameLiveEvent{Type: models.GameLiveEventParticipantPromoted})
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.GameCancelled) error {
+		return publishGameEvent(ctx, hub, event.Game.ID, models.GameLiveEvent{Type: models.GameLiveEventGameCancelled})
+	})
+}
+
+func publishGameEvent(ctx context.Context, hub *Hub, gameID string, event models.GameLiveEvent) error {
+	event.OccurredAt = time.Now().UTC()
+	return publishJSON(ctx, hub, GameEventsRoom(gameID), event)
+}
+
+func publishJSON(ctx context.Context, hub *Hub, room string, v any) error {
+	message, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode realtime message: %w", err)
+	}
+	return hub.Publish(ctx, room, message)
+}
@@ -8,30 +8,301 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AdminAudit struct {
+	ID         pgtype.UUID        `json:"id"`
+	ActorID    pgtype.UUID        `json:"actor_id"`
+	Action     string             `json:"action"`
+	TargetType string             `json:"target_type"`
+	TargetID   pgtype.UUID        `json:"target_id"`
+	GameID     pgtype.UUID        `json:"game_id"`
+	Reason     pgtype.Text        `json:"reason"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type Attendance struct {
+	GameID   pgtype.UUID        `json:"game_id"`
+	UserID   pgtype.UUID        `json:"user_id"`
+	Status   string             `json:"status"`
+	MarkedBy pgtype.UUID        `json:"marked_by"`
+	MarkedAt pgtype.Timestamptz `json:"marked_at"`
+}
+
+type BrokerEvent struct {
+	ID            pgtype.UUID        `json:"id"`
+	EventType     string             `json:"event_type"`
+	PartitionKey  string             `json:"partition_key"`
+	Payload       []byte             `json:"payload"`
+	Status        string             `json:"status"`
+	Attempts      int32              `json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+	LastError     pgtype.Text        `json:"last_error"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	PublishedAt   pgtype.Timestamptz `json:"published_at"`
+}
+
+type ContactShareConsent struct {
+	GameID      pgtype.UUID        `json:"game_id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	ConsentedAt pgtype.Timestamptz `json:"consented_at"`
+}
+
+type ContactShareRequest struct {
+	GameID      pgtype.UUID        `json:"game_id"`
+	RequestedBy pgtype.UUID        `json:"requested_by"`
+	RequestedAt pgtype.Timestamptz `json:"requested_at"`
+}
+
+type Conversation struct {
+	ID            pgtype.UUID        `json:"id"`
+	GameID        pgtype.UUID        `json:"game_id"`
+	HostID        pgtype.UUID        `json:"host_id"`
+	PlayerID      pgtype.UUID        `json:"player_id"`
+	HostReadAt    pgtype.Timestamptz `json:"host_read_at"`
+	PlayerReadAt  pgtype.Timestamptz `json:"player_read_at"`
+	LastMessageAt pgtype.Timestamptz `json:"last_message_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type ConversationMessage struct {
+	ID             pgtype.UUID        `json:"id"`
+	ConversationID pgtype.UUID        `json:"conversation_id"`
+	SenderID       pgtype.UUID        `json:"sender_id"`
+	Body           string             `json:"body"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type CreditTransaction struct {
+	ID                pgtype.UUID        `json:"id"`
+	UserID            pgtype.UUID        `json:"user_id"`
+	AmountCents       int32              `json:"amount_cents"`
+	Kind              string             `json:"kind"`
+	GameID            pgtype.UUID        `json:"game_id"`
+	BalanceAfterCents int32              `json:"balance_after_cents"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+}
+
+type CreditWallet struct {
+	UserID       pgtype.UUID        `json:"user_id"`
+	BalanceCents int32              `json:"balance_cents"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type DeadLetter struct {
+	ID             pgtype.UUID        `json:"id"`
+	Source         string             `json:"source"`
+	SourceID       pgtype.UUID        `json:"source_id"`
+	Kind           string             `json:"kind"`
+	Payload        []byte             `json:"payload"`
+	Attempts       int32              `json:"attempts"`
+	AttemptHistory []byte             `json:"attempt_history"`
+	LastError      string             `json:"last_error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	RequeuedAt     pgtype.Timestamptz `json:"requeued_at"`
+	RequeuedBy     pgtype.UUID        `json:"requeued_by"`
+}
+
+type EmailChangeRequest struct {
+	ID          pgtype.UUID        `json:"id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	NewEmail    string             `json:"new_email"`
+	TokenHash   string             `json:"token_hash"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	ConfirmedAt pgtype.Timestamptz `json:"confirmed_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type FailedLogin struct {
+	ID          pgtype.UUID        `json:"id"`
+	Email       string             `json:"email"`
+	IpAddress   string             `json:"ip_address"`
+	AttemptedAt pgtype.Timestamptz `json:"attempted_at"`
+}
+
 type Game struct {
-	ID                 pgtype.UUID        `json:"id"`
-	OwnerID            pgtype.UUID        `json:"owner_id"`
-	Category           string             `json:"category"`
-	Title              pgtype.Text        `json:"title"`
-	Description        pgtype.Text        `json:"description"`
-	LocationName       string             `json:"location_name"`
-	LocationAddress    pgtype.Text        `json:"location_address"`
-	LocationPoint      interface{}        `json:"location_point"`
-	LocationNotes      pgtype.Text        `json:"location_notes"`
-	StartTime          pgtype.Timestamptz `json:"start_time"`
-	DurationMinutes    int32              `json:"duration_minutes"`
-	MaxParticipants    int32              `json:"max_participants"`
-	PricingType        string             `json:"pricing_type"`
-	PricingAmountCents int32              `json:"pricing_amount_cents"`
-	PricingCurrency    string             `json:"pricing_currency"`
-	SignupDeadline     pgtype.Timestamptz `json:"signup_deadline"`
-	DropDeadline       pgtype.Timestamptz `json:"drop_deadline"`
-	SkillLevel         string             `json:"skill_level"`
-	Notes              pgtype.Text        `json:"notes"`
-	Status             string             `json:"status"`
-	CancelledAt        pgtype.Timestamptz `json:"cancelled_at"`
-	CreatedAt          pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	ID                  pgtype.UUID        `json:"id"`
+	OwnerID             pgtype.UUID        `json:"owner_id"`
+	Category            string             `json:"category"`
+	Title               pgtype.Text        `json:"title"`
+	Description         pgtype.Text        `json:"description"`
+	LocationName        string             `json:"location_name"`
+	LocationAddress     pgtype.Text        `json:"location_address"`
+	LocationPoint       interface{}        `json:"location_point"`
+	LocationNotes       pgtype.Text        `json:"location_notes"`
+	StartTime           pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes     int32              `json:"duration_minutes"`
+	MaxParticipants     int32              `json:"max_participants"`
+	PricingType         string             `json:"pricing_type"`
+	PricingAmountCents  int32              `json:"pricing_amount_cents"`
+	PricingCurrency     string             `json:"pricing_currency"`
+	SignupDeadline      pgtype.Timestamptz `json:"signup_deadline"`
+	DropDeadline        pgtype.Timestamptz `json:"drop_deadline"`
+	SkillLevel          string             `json:"skill_level"`
+	AdultOnly           bool               `json:"adult_only"`
+	Notes               pgtype.Text        `json:"notes"`
+	Status              string             `json:"status"`
+	CancelledAt         pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	LateDropWindowHours pgtype.Int4        `json:"late_drop_window_hours"`
+}
+
+type GameAnnouncement struct {
+	ID        pgtype.UUID        `json:"id"`
+	GameID    pgtype.UUID        `json:"game_id"`
+	AuthorID  pgtype.UUID        `json:"author_id"`
+	Message   string             `json:"message"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type GameCancellationReason struct {
+	GameID    pgtype.UUID        `json:"game_id"`
+	Reason    string             `json:"reason"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type GameChange struct {
+	ID        pgtype.UUID        `json:"id"`
+	GameID    pgtype.UUID        `json:"game_id"`
+	ChangedBy pgtype.UUID        `json:"changed_by"`
+	Field     string             `json:"field"`
+	OldValue  pgtype.Text        `json:"old_value"`
+	NewValue  pgtype.Text        `json:"new_value"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type GameChatMessage struct {
+	ID        pgtype.UUID        `json:"id"`
+	GameID    pgtype.UUID        `json:"game_id"`
+	SenderID  pgtype.UUID        `json:"sender_id"`
+	Body      string             `json:"body"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type GameComment struct {
+	ID        pgtype.UUID        `json:"id"`
+	GameID    pgtype.UUID        `json:"game_id"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	AuthorID  pgtype.UUID        `json:"author_id"`
+	Body      string             `json:"body"`
+	EditedAt  pgtype.Timestamptz `json:"edited_at"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	DeletedBy pgtype.UUID        `json:"deleted_by"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type GameMvp struct {
+	GameID  pgtype.UUID        `json:"game_id"`
+	UserID  pgtype.UUID        `json:"user_id"`
+	NamedBy pgtype.UUID        `json:"named_by"`
+	NamedAt pgtype.Timestamptz `json:"named_at"`
+}
+
+type GameNotificationSetting struct {
+	GameID         pgtype.UUID        `json:"game_id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	RemindersMuted bool               `json:"reminders_muted"`
+	ChatMuted      bool               `json:"chat_muted"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type GamePosition struct {
+	GameID   pgtype.UUID `json:"game_id"`
+	Name     string      `json:"name"`
+	Capacity int32       `json:"capacity"`
+}
+
+type GameReservation struct {
+	GameID     pgtype.UUID        `json:"game_id"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	ReservedBy pgtype.UUID        `json:"reserved_by"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	ReleasedAt pgtype.Timestamptz `json:"released_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type GameTag struct {
+	GameID pgtype.UUID `json:"game_id"`
+	Tag    string      `json:"tag"`
+}
+
+type JobRun struct {
+	Name           string             `json:"name"`
+	LastRunBy      string             `json:"last_run_by"`
+	LastStartedAt  pgtype.Timestamptz `json:"last_started_at"`
+	LockedUntil    pgtype.Timestamptz `json:"locked_until"`
+	LastFinishedAt pgtype.Timestamptz `json:"last_finished_at"`
+	LastError      pgtype.Text        `json:"last_error"`
+}
+
+type LeaderboardCell struct {
+	UserID      pgtype.UUID        `json:"user_id"`
+	Category    string             `json:"category"`
+	CellX       int32              `json:"cell_x"`
+	CellY       int32              `json:"cell_y"`
+	CellPoint   interface{}        `json:"cell_point"`
+	GamesPlayed int32              `json:"games_played"`
+	GamesHosted int32              `json:"games_hosted"`
+	Mvps        int32              `json:"mvps"`
+	ComputedAt  pgtype.Timestamptz `json:"computed_at"`
+}
+
+type LegalAcceptance struct {
+	ID         pgtype.UUID        `json:"id"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	DocumentID pgtype.UUID        `json:"document_id"`
+	IpAddress  pgtype.Text        `json:"ip_address"`
+	UserAgent  pgtype.Text        `json:"user_agent"`
+	AcceptedAt pgtype.Timestamptz `json:"accepted_at"`
+}
+
+type LegalDocument struct {
+	ID           pgtype.UUID        `json:"id"`
+	DocumentType string             `json:"document_type"`
+	Version      string             `json:"version"`
+	Url          pgtype.Text        `json:"url"`
+	PublishedAt  pgtype.Timestamptz `json:"published_at"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type LoginCode struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	CodeHash  string             `json:"code_hash"`
+	Attempts  int32              `json:"attempts"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type MagicLinkToken struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type NewGameAlert struct {
+	UserID        pgtype.UUID        `json:"user_id"`
+	GameID        pgtype.UUID        `json:"game_id"`
+	SavedSearchID pgtype.UUID        `json:"saved_search_id"`
+	SentAt        pgtype.Timestamptz `json:"sent_at"`
+}
+
+type NotificationDelivery struct {
+	ID             pgtype.UUID        `json:"id"`
+	Channel        string             `json:"channel"`
+	Recipient      string             `json:"recipient"`
+	Summary        pgtype.Text        `json:"summary"`
+	Payload        []byte             `json:"payload"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	LastError      pgtype.Text        `json:"last_error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	SentAt         pgtype.Timestamptz `json:"sent_at"`
+	AttemptHistory []byte             `json:"attempt_history"`
 }
 
 type Participant struct {
@@ -45,6 +316,69 @@ type Participant struct {
 	Notes              pgtype.Text        `json:"notes"`
 	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	PlaceholderName    pgtype.Text        `json:"placeholder_name"`
+	DropReason         pgtype.Text        `json:"drop_reason"`
+	CheckedInAt        pgtype.Timestamptz `json:"checked_in_at"`
+	Position           pgtype.Text        `json:"position"`
+	LateDrop           bool               `json:"late_drop"`
+	WaitlistRank       int64              `json:"waitlist_rank"`
+}
+
+type ParticipationCorrection struct {
+	ID          pgtype.UUID        `json:"id"`
+	GameID      pgtype.UUID        `json:"game_id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	CorrectedBy pgtype.UUID        `json:"corrected_by"`
+	Field       string             `json:"field"`
+	OldValue    pgtype.Text        `json:"old_value"`
+	NewValue    pgtype.Text        `json:"new_value"`
+	Reason      string             `json:"reason"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type ParticipationJournal struct {
+	ID             int64              `json:"id"`
+	GameID         pgtype.UUID        `json:"game_id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	Action         string             `json:"action"`
+	RequestedAt    pgtype.Timestamptz `json:"requested_at"`
+	CompletedAt    pgtype.Timestamptz `json:"completed_at"`
+	Outcome        string             `json:"outcome"`
+	Error          pgtype.Text        `json:"error"`
+	PromotedUserID pgtype.UUID        `json:"promoted_user_id"`
+}
+
+type PhoneVerification struct {
+	ID          pgtype.UUID        `json:"id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	PhoneNumber string             `json:"phone_number"`
+	CodeHash    string             `json:"code_hash"`
+	Attempts    int32              `json:"attempts"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	VerifiedAt  pgtype.Timestamptz `json:"verified_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type PlayerReliability struct {
+	UserID     pgtype.UUID        `json:"user_id"`
+	Honored    int32              `json:"honored"`
+	LateDrops  int32              `json:"late_drops"`
+	NoShows    int32              `json:"no_shows"`
+	Score      int32              `json:"score"`
+	ComputedAt pgtype.Timestamptz `json:"computed_at"`
+}
+
+type Referral struct {
+	ReferredID pgtype.UUID        `json:"referred_id"`
+	ReferrerID pgtype.UUID        `json:"referrer_id"`
+	Code       string             `json:"code"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type ReferralCode struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	Code      string             `json:"code"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
 type RefreshToken struct {
@@ -57,6 +391,71 @@ type RefreshToken struct {
 	RevokedAt  pgtype.Timestamptz `json:"revoked_at"`
 }
 
+type Report struct {
+	ID         pgtype.UUID        `json:"id"`
+	ReporterID pgtype.UUID        `json:"reporter_id"`
+	TargetType string             `json:"target_type"`
+	TargetID   pgtype.UUID        `json:"target_id"`
+	Reason     string             `json:"reason"`
+	Details    pgtype.Text        `json:"details"`
+	Status     string             `json:"status"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	ReviewedBy pgtype.UUID        `json:"reviewed_by"`
+	ReviewedAt pgtype.Timestamptz `json:"reviewed_at"`
+}
+
+type RosterSnapshot struct {
+	GameID          pgtype.UUID        `json:"game_id"`
+	MaxParticipants int32              `json:"max_participants"`
+	TakenAt         pgtype.Timestamptz `json:"taken_at"`
+}
+
+type RosterSnapshotEntry struct {
+	GameID             pgtype.UUID        `json:"game_id"`
+	ParticipantID      pgtype.UUID        `json:"participant_id"`
+	UserID             pgtype.UUID        `json:"user_id"`
+	FirstName          string             `json:"first_name"`
+	LastName           string             `json:"last_name"`
+	Status             string             `json:"status"`
+	WaitlistPosition   pgtype.Int4        `json:"waitlist_position"`
+	TeamID             pgtype.UUID        `json:"team_id"`
+	Paid               bool               `json:"paid"`
+	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
+	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
+}
+
+type SavedSearch struct {
+	ID            pgtype.UUID        `json:"id"`
+	UserID        pgtype.UUID        `json:"user_id"`
+	Name          string             `json:"name"`
+	Filters       []byte             `json:"filters"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	AlertsEnabled bool               `json:"alerts_enabled"`
+	SearchPoint   interface{}        `json:"search_point"`
+}
+
+type SideEffect struct {
+	ID             pgtype.UUID        `json:"id"`
+	Kind           string             `json:"kind"`
+	GameID         pgtype.UUID        `json:"game_id"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	LastError      pgtype.Text        `json:"last_error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	CompletedAt    pgtype.Timestamptz `json:"completed_at"`
+	AttemptHistory []byte             `json:"attempt_history"`
+}
+
+type SkillEndorsement struct {
+	GameID     pgtype.UUID        `json:"game_id"`
+	EndorserID pgtype.UUID        `json:"endorser_id"`
+	EndorseeID pgtype.UUID        `json:"endorsee_id"`
+	SkillLevel string             `json:"skill_level"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
 type Team struct {
 	ID        pgtype.UUID        `json:"id"`
 	GameID    pgtype.UUID        `json:"game_id"`
@@ -65,11 +464,125 @@ type Team struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
+type UpcomingGame struct {
+	GameID         pgtype.UUID        `json:"game_id"`
+	LocationPoint  interface{}        `json:"location_point"`
+	StartTime      pgtype.Timestamptz `json:"start_time"`
+	Status         string             `json:"status"`
+	Category       string             `json:"category"`
+	AdultOnly      bool               `json:"adult_only"`
+	SignupCount    int32              `json:"signup_count"`
+	ConfirmedCount int32              `json:"confirmed_count"`
+	WaitlistCount  int32              `json:"waitlist_count"`
+}
+
 type User struct {
+	ID              pgtype.UUID        `json:"id"`
+	Email           string             `json:"email"`
+	FirstName       string             `json:"first_name"`
+	LastName        string             `json:"last_name"`
+	PasswordHash    string             `json:"password_hash"`
+	PhoneNumber     pgtype.Text        `json:"phone_number"`
+	PhoneVerifiedAt pgtype.Timestamptz `json:"phone_verified_at"`
+	Birthdate       pgtype.Date        `json:"birthdate"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	HomeRegion      string             `json:"home_region"`
+	TokenVersion    int32              `json:"token_version"`
+}
+
+type UserDeactivation struct {
+	UserID        pgtype.UUID        `json:"user_id"`
+	DeactivatedAt pgtype.Timestamptz `json:"deactivated_at"`
+}
+
+type UserNotificationPreference struct {
+	UserID       pgtype.UUID        `json:"user_id"`
+	SmsEnabled   bool               `json:"sms_enabled"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	EmailEnabled bool               `json:"email_enabled"`
+}
+
+type UserRole struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	Role      string             `json:"role"`
+	GrantedAt pgtype.Timestamptz `json:"granted_at"`
+}
+
+type UserSetting struct {
+	UserID              pgtype.UUID        `json:"user_id"`
+	HomeLatitude        pgtype.Float8      `json:"home_latitude"`
+	HomeLongitude       pgtype.Float8      `json:"home_longitude"`
+	HomePoint           interface{}        `json:"home_point"`
+	HomePlaceID         pgtype.Text        `json:"home_place_id"`
+	HomeName            pgtype.Text        `json:"home_name"`
+	DefaultRadiusMeters pgtype.Float8      `json:"default_radius_meters"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	AvailabilityWindows []string           `json:"availability_windows"`
+	Timezone            pgtype.Text        `json:"timezone"`
+	OnboardedAt         pgtype.Timestamptz `json:"onboarded_at"`
+}
+
+type UserShadowBan struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	BannedBy  pgtype.UUID        `json:"banned_by"`
+	Reason    string             `json:"reason"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserSportPreference struct {
+	UserID     pgtype.UUID        `json:"user_id"`
+	Category   string             `json:"category"`
+	SkillLevel pgtype.Text        `json:"skill_level"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserSuspension struct {
+	UserID      pgtype.UUID        `json:"user_id"`
+	SuspendedBy pgtype.UUID        `json:"suspended_by"`
+	Reason      string             `json:"reason"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type WebauthnChallenge struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	Ceremony  string             `json:"ceremony"`
+	Challenge string             `json:"challenge"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type WebauthnCredential struct {
 	ID           pgtype.UUID        `json:"id"`
-	Email        string             `json:"email"`
-	FirstName    string             `json:"first_name"`
-	LastName     string             `json:"last_name"`
-	PasswordHash string             `json:"password_hash"`
+	UserID       pgtype.UUID        `json:"user_id"`
+	CredentialID []byte             `json:"credential_id"`
+	PublicKey    []byte             `json:"public_key"`
+	SignCount    int64              `json:"sign_count"`
+	Name         pgtype.Text        `json:"name"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	LastUsedAt   pgtype.Timestamptz `json:"last_used_at"`
+}
+
+type WebhookDelivery struct {
+	ID             pgtype.UUID        `json:"id"`
+	SubscriptionID pgtype.UUID        `json:"subscription_id"`
+	EventType      string             `json:"event_type"`
+	Payload        []byte             `json:"payload"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	LastError      pgtype.Text        `json:"last_error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+	AttemptHistory []byte             `json:"attempt_history"`
+}
+
+type WebhookSubscription struct {
+	ID         pgtype.UUID        `json:"id"`
+	OwnerID    pgtype.UUID        `json:"owner_id"`
+	Url        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []string           `json:"event_types"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
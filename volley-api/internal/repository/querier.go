@@ -11,46 +11,339 @@ import (
 )
 
 type Querier interface {
+	AddGameTags(ctx context.Context, arg AddGameTagsParams) error
 	BatchUpdateParticipantsToConfirmed(ctx context.Context, participantIds []pgtype.UUID) error
 	BatchUpdateParticipantsToWaitlist(ctx context.Context, participantIds []pgtype.UUID) error
+	BlockPlayer(ctx context.Context, arg BlockPlayerParams) error
 	CancelGame(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error)
+	// Earlier links stop working once a newer change is requested or one is confirmed
+	CancelPendingEmailChangeRequests(ctx context.Context, userID pgtype.UUID) error
+	CheckInParticipant(ctx context.Context, id pgtype.UUID) (Participant, error)
+	// Leases due broker events the same way ClaimDueSideEffects does, oldest first so a backlog goes
+	// out in the order it happened
+	ClaimDueBrokerEvents(ctx context.Context, arg ClaimDueBrokerEventsParams) ([]BrokerEvent, error)
+	ClaimDueNotificationDeliveries(ctx context.Context, arg ClaimDueNotificationDeliveriesParams) ([]NotificationDelivery, error)
+	// Leases due side effects by pushing next_attempt_at past the lease, so a crashed worker's claims
+	// become due again and concurrent workers skip rows already being claimed
+	ClaimDueSideEffects(ctx context.Context, arg ClaimDueSideEffectsParams) ([]SideEffect, error)
+	// Leases due deliveries the same way ClaimDueSideEffects does, returning where each one goes
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error)
+	// Claims the next run of a job unless another replica holds its lease or started it less than
+	// min_gap_seconds ago. Affects no rows when the run isn't ours.
+	ClaimJobRun(ctx context.Context, arg ClaimJobRunParams) (int64, error)
+	ClearFailedLoginsByEmail(ctx context.Context, email string) error
+	CloseGamesPastSignupDeadline(ctx context.Context) (int64, error)
+	CompleteBrokerEvent(ctx context.Context, id pgtype.UUID) error
+	CompleteFinishedGames(ctx context.Context) (int64, error)
+	CompleteSideEffect(ctx context.Context, id pgtype.UUID) error
+	CompleteWebhookDelivery(ctx context.Context, id pgtype.UUID) error
+	// Marks an unexpired link as used in the same statement that finds it, so a link can only be redeemed once
+	ConsumeMagicLinkToken(ctx context.Context, tokenHash string) (MagicLinkToken, error)
+	ConsumeWebAuthnChallenge(ctx context.Context, arg ConsumeWebAuthnChallengeParams) (WebauthnChallenge, error)
 	CountConfirmedParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error)
+	// Spots still held for reserved players who haven't joined; expired reservations hold nothing
+	CountHeldReservations(ctx context.Context, gameID pgtype.UUID) (int64, error)
+	CountLoginCodesSince(ctx context.Context, arg CountLoginCodesSinceParams) (int64, error)
+	// Games a host has that haven't started and are still taking or holding signups
+	CountOpenGamesByOwner(ctx context.Context, ownerID pgtype.UUID) (int64, error)
+	CountPhoneVerificationsSince(ctx context.Context, arg CountPhoneVerificationsSinceParams) (int64, error)
+	CountReferrals(ctx context.Context, referrerID pgtype.UUID) (int64, error)
+	CountReportsByReporterSince(ctx context.Context, arg CountReportsByReporterSinceParams) (int64, error)
 	CountWaitlistParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error)
+	CreateAdminAuditEntry(ctx context.Context, arg CreateAdminAuditEntryParams) error
+	// Starting a conversation that already exists returns it
+	CreateConversation(ctx context.Context, arg CreateConversationParams) (Conversation, error)
+	CreateConversationMessage(ctx context.Context, arg CreateConversationMessageParams) (ConversationMessage, error)
+	CreateEmailChangeRequest(ctx context.Context, arg CreateEmailChangeRequestParams) (EmailChangeRequest, error)
 	// Game queries
 	CreateGame(ctx context.Context, arg CreateGameParams) (CreateGameRow, error)
+	CreateGameAnnouncement(ctx context.Context, arg CreateGameAnnouncementParams) (GameAnnouncement, error)
+	CreateGameChange(ctx context.Context, arg CreateGameChangeParams) (GameChange, error)
+	CreateGameChatMessage(ctx context.Context, arg CreateGameChatMessageParams) (GameChatMessage, error)
+	CreateGameComment(ctx context.Context, arg CreateGameCommentParams) (GameComment, error)
+	CreateGamePosition(ctx context.Context, arg CreateGamePositionParams) error
+	CreateLegalAcceptance(ctx context.Context, arg CreateLegalAcceptanceParams) error
+	CreateLoginCode(ctx context.Context, arg CreateLoginCodeParams) (LoginCode, error)
+	CreateMagicLinkToken(ctx context.Context, arg CreateMagicLinkTokenParams) (MagicLinkToken, error)
+	// Records a notification about to be sent. It is leased from the start, so the retry job only
+	// picks it up if the first attempt never reports back.
+	CreateNotificationDelivery(ctx context.Context, arg CreateNotificationDeliveryParams) (pgtype.UUID, error)
 	CreateParticipant(ctx context.Context, arg CreateParticipantParams) (Participant, error)
+	CreateParticipationCorrection(ctx context.Context, arg CreateParticipationCorrectionParams) (ParticipationCorrection, error)
+	CreateParticipationJournalEntry(ctx context.Context, arg CreateParticipationJournalEntryParams) error
+	CreatePhoneVerification(ctx context.Context, arg CreatePhoneVerificationParams) (PhoneVerification, error)
+	CreatePlaceholderParticipant(ctx context.Context, arg CreatePlaceholderParticipantParams) (Participant, error)
+	CreateReferral(ctx context.Context, arg CreateReferralParams) error
+	// Returns no rows when the user already has a code or the code is taken
+	CreateReferralCode(ctx context.Context, arg CreateReferralCodeParams) (ReferralCode, error)
 	// Refresh token queries
 	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error)
+	// Returns no rows if the reporter already has an open report on the target
+	CreateReport(ctx context.Context, arg CreateReportParams) (Report, error)
+	CreateSavedSearch(ctx context.Context, arg CreateSavedSearchParams) (SavedSearch, error)
 	CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error)
 	// User queries
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebAuthnChallenge(ctx context.Context, arg CreateWebAuthnChallengeParams) error
+	CreateWebAuthnCredential(ctx context.Context, arg CreateWebAuthnCredentialParams) (WebauthnCredential, error)
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
+	// Deactivating an already deactivated account keeps the original time
+	DeactivateUser(ctx context.Context, userID pgtype.UUID) (UserDeactivation, error)
+	// Marks a delivery that ran out of retries failed and copies it, message included, to
+	// dead_letters. The CTEs share one snapshot, so delivery still sees the payload being cleared.
+	DeadLetterNotificationDelivery(ctx context.Context, arg DeadLetterNotificationDeliveryParams) error
+	// Deletes the contact sharing requests of ended or cancelled games; consents cascade
+	DeleteExpiredContactShareRequests(ctx context.Context) (int64, error)
 	DeleteExpiredRefreshTokens(ctx context.Context) error
+	DeleteExpiredWebAuthnChallenges(ctx context.Context) error
+	DeleteFailedLoginsBefore(ctx context.Context, attemptedAt pgtype.Timestamptz) (int64, error)
 	DeleteGame(ctx context.Context, id pgtype.UUID) error
+	// Clears the text, which isn't kept once the comment is gone
+	DeleteGameComment(ctx context.Context, arg DeleteGameCommentParams) error
+	DeleteGameReservation(ctx context.Context, arg DeleteGameReservationParams) (int64, error)
+	DeleteGameTags(ctx context.Context, gameID pgtype.UUID) error
+	DeleteOldBrokerEvents(ctx context.Context) (int64, error)
+	DeleteOldDeadLetters(ctx context.Context) (int64, error)
+	DeleteOldNotificationDeliveries(ctx context.Context) (int64, error)
 	DeleteParticipant(ctx context.Context, id pgtype.UUID) error
+	DeleteParticipantsByGame(ctx context.Context, gameID pgtype.UUID) error
+	DeleteSavedSearch(ctx context.Context, arg DeleteSavedSearchParams) (int64, error)
 	DeleteTeam(ctx context.Context, id pgtype.UUID) error
 	DeleteUser(ctx context.Context, id pgtype.UUID) error
+	DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error)
+	EnqueueBrokerEvent(ctx context.Context, arg EnqueueBrokerEventParams) error
+	EnqueueSideEffect(ctx context.Context, arg EnqueueSideEffectParams) (SideEffect, error)
+	// Queues the event for each of the owner's subscriptions that asked for its type
+	EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error)
+	ExportGames(ctx context.Context, arg ExportGamesParams) ([]ExportGamesRow, error)
+	FailBrokerEvent(ctx context.Context, arg FailBrokerEventParams) error
+	// Finishes a delivery that won't be retried, as failed or bounced
+	FailNotificationDelivery(ctx context.Context, arg FailNotificationDeliveryParams) error
+	// Marks a side effect failed and copies it to dead_letters
+	FailSideEffect(ctx context.Context, arg FailSideEffectParams) error
+	// Marks a webhook delivery failed and copies it to dead_letters
+	FailWebhookDelivery(ctx context.Context, arg FailWebhookDeliveryParams) error
+	FinishJobRun(ctx context.Context, arg FinishJobRunParams) error
+	// Files a report from the content filter; a game already waiting for review keeps its open report
+	FlagGameContent(ctx context.Context, arg FlagGameContentParams) error
+	GetAttendance(ctx context.Context, arg GetAttendanceParams) (Attendance, error)
+	GetContactShareRequest(ctx context.Context, gameID pgtype.UUID) (ContactShareRequest, error)
+	GetConversation(ctx context.Context, id pgtype.UUID) (Conversation, error)
+	GetCreditBalance(ctx context.Context, userID pgtype.UUID) (int32, error)
+	GetCreditBalanceForUpdate(ctx context.Context, userID pgtype.UUID) (int32, error)
+	GetDeadLetterForUpdate(ctx context.Context, id pgtype.UUID) (DeadLetter, error)
 	GetGame(ctx context.Context, id pgtype.UUID) (GetGameRow, error)
+	GetGameCancellationReason(ctx context.Context, gameID pgtype.UUID) (string, error)
+	GetGameComment(ctx context.Context, arg GetGameCommentParams) (GameComment, error)
+	// Credit the user has spent on the game and not had refunded
+	GetGameCreditsSpent(ctx context.Context, arg GetGameCreditsSpentParams) (int32, error)
 	GetGameForUpdate(ctx context.Context, id pgtype.UUID) (GetGameForUpdateRow, error)
+	GetGameOwner(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error)
+	GetLatestMagicLinkToken(ctx context.Context, userID pgtype.UUID) (MagicLinkToken, error)
+	GetLatestPendingEmailChangeRequest(ctx context.Context, userID pgtype.UUID) (EmailChangeRequest, error)
+	GetLatestPendingLoginCode(ctx context.Context, userID pgtype.UUID) (LoginCode, error)
+	GetLatestPendingPhoneVerification(ctx context.Context, userID pgtype.UUID) (PhoneVerification, error)
+	GetNotificationPreferences(ctx context.Context, userID pgtype.UUID) (UserNotificationPreference, error)
 	GetParticipant(ctx context.Context, id pgtype.UUID) (Participant, error)
 	GetParticipantByGameAndUser(ctx context.Context, arg GetParticipantByGameAndUserParams) (Participant, error)
+	// A user's sign-up for a game with what a receipt for it shows
+	GetParticipationReceipt(ctx context.Context, arg GetParticipationReceiptParams) (GetParticipationReceiptRow, error)
+	GetPendingEmailChangeRequestByTokenHash(ctx context.Context, tokenHash string) (EmailChangeRequest, error)
+	GetReferralCodeByCode(ctx context.Context, code string) (ReferralCode, error)
+	GetReferralCodeByUser(ctx context.Context, userID pgtype.UUID) (ReferralCode, error)
 	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (RefreshToken, error)
+	GetRosterSnapshot(ctx context.Context, gameID pgtype.UUID) (RosterSnapshot, error)
+	// The number to text a user at: only set when they opted in to SMS and their phone is verified
+	GetSMSNumber(ctx context.Context, id pgtype.UUID) (pgtype.Text, error)
 	GetTeam(ctx context.Context, id pgtype.UUID) (Team, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id pgtype.UUID) (User, error)
+	GetUserByVerifiedPhone(ctx context.Context, phoneNumber pgtype.Text) (User, error)
+	// The category of the most completed games played; ties go to the most recently played
+	GetUserFavoriteCategory(ctx context.Context, userID pgtype.UUID) (string, error)
+	// Totals over completed games. A confirmed game counts as played unless the host marked a no-show.
+	GetUserPlayStats(ctx context.Context, userID pgtype.UUID) (GetUserPlayStatsRow, error)
+	// What every authenticated request checks: the current token version, whether an admin suspended
+	// the account and whether its owner deactivated it
+	GetUserSessionState(ctx context.Context, id pgtype.UUID) (GetUserSessionStateRow, error)
+	GetUserSettings(ctx context.Context, userID pgtype.UUID) (UserSetting, error)
+	GetWebAuthnCredential(ctx context.Context, credentialID []byte) (WebauthnCredential, error)
+	GrantContactShareConsent(ctx context.Context, arg GrantContactShareConsentParams) error
+	HasActiveReservation(ctx context.Context, arg HasActiveReservationParams) (bool, error)
+	IncrementLoginCodeAttempts(ctx context.Context, id pgtype.UUID) (int32, error)
+	IncrementPhoneVerificationAttempts(ctx context.Context, id pgtype.UUID) (int32, error)
+	IncrementUserTokenVersion(ctx context.Context, id pgtype.UUID) (int32, error)
+	IsOrganizerOfParticipant(ctx context.Context, arg IsOrganizerOfParticipantParams) (bool, error)
+	IsPlayerBlockedByHost(ctx context.Context, arg IsPlayerBlockedByHostParams) (bool, error)
+	IsUserDeactivated(ctx context.Context, userID pgtype.UUID) (bool, error)
+	IsUserShadowBanned(ctx context.Context, userID pgtype.UUID) (bool, error)
+	LiftShadowBan(ctx context.Context, userID pgtype.UUID) (int64, error)
+	LinkPlaceholderParticipant(ctx context.Context, arg LinkPlaceholderParticipantParams) (Participant, error)
 	ListActiveParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]ListActiveParticipantsByGameRow, error)
+	// Filtering by target also matches entries taken within that game (e.g. removing one of its players)
+	ListAdminAudit(ctx context.Context, arg ListAdminAuditParams) ([]AdminAudit, error)
+	ListAttendanceSummaries(ctx context.Context, arg ListAttendanceSummariesParams) ([]ListAttendanceSummariesRow, error)
+	ListBlockedPlayers(ctx context.Context, hostID pgtype.UUID) ([]ListBlockedPlayersRow, error)
+	// Newest first, optionally only those sent before a time for paging back
+	ListConversationMessages(ctx context.Context, arg ListConversationMessagesParams) ([]ConversationMessage, error)
+	// The user's conversations, latest activity first, each with its game, the other user, the last
+	// message and how many messages the user hasn't read. Narrowed to one by conversation_id.
+	ListConversations(ctx context.Context, arg ListConversationsParams) ([]ListConversationsRow, error)
+	ListCreditTransactions(ctx context.Context, arg ListCreditTransactionsParams) ([]CreditTransaction, error)
+	ListCurrentLegalDocuments(ctx context.Context) ([]LegalDocument, error)
+	// Newest first, optionally from one source and without the ones already re-enqueued
+	ListDeadLetters(ctx context.Context, arg ListDeadLettersParams) ([]DeadLetter, error)
+	ListGameAnnouncements(ctx context.Context, gameID pgtype.UUID) ([]GameAnnouncement, error)
+	ListGameChangesByGame(ctx context.Context, gameID pgtype.UUID) ([]GameChange, error)
+	// Newest first with the sender's name, optionally only those sent before a time for paging back
+	ListGameChatMessages(ctx context.Context, arg ListGameChatMessagesParams) ([]ListGameChatMessagesRow, error)
+	// Oldest first with the authors' names; the service nests replies under their threads
+	ListGameComments(ctx context.Context, gameID pgtype.UUID) ([]ListGameCommentsRow, error)
+	// Users with credit spent on the game that hasn't been refunded yet
+	ListGameCreditSpenders(ctx context.Context, gameID pgtype.UUID) ([]ListGameCreditSpendersRow, error)
+	ListGameNotificationSettings(ctx context.Context, gameID pgtype.UUID) ([]GameNotificationSetting, error)
+	ListGamePositions(ctx context.Context, gameID pgtype.UUID) ([]GamePosition, error)
+	ListGameReservations(ctx context.Context, gameID pgtype.UUID) ([]ListGameReservationsRow, error)
+	ListGameTags(ctx context.Context, gameID pgtype.UUID) ([]string, error)
 	ListGamesInRadius(ctx context.Context, arg ListGamesInRadiusParams) ([]ListGamesInRadiusRow, error)
+	// Games with reservations that expired since their roster was last reconciled
+	ListGamesWithExpiredReservations(ctx context.Context) ([]pgtype.UUID, error)
+	// Players ranked by metric (played, hosted or mvps) over the cells within radius meters, optionally
+	// of one category. Shadow-banned players are left off.
+	ListLeaderboard(ctx context.Context, arg ListLeaderboardParams) ([]ListLeaderboardRow, error)
+	ListLegalAcceptancesByUser(ctx context.Context, userID pgtype.UUID) ([]ListLegalAcceptancesByUserRow, error)
+	// Users with a saved search the game matches, each with their oldest matching search. A search
+	// without categories follows the user's sport preferences, like GET /v1/games. Users the game is
+	// hidden from, its host, and users already alerted about it are left out.
+	ListNewGameAlertRecipients(ctx context.Context, gameID pgtype.UUID) ([]ListNewGameAlertRecipientsRow, error)
+	ListOwnerUpcomingGames(ctx context.Context, ownerID pgtype.UUID) ([]ListOwnerUpcomingGamesRow, error)
 	ListParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]ListParticipantsByGameRow, error)
+	ListParticipantsByGamePage(ctx context.Context, arg ListParticipantsByGamePageParams) ([]ListParticipantsByGamePageRow, error)
 	ListParticipantsByGames(ctx context.Context, gameIds []pgtype.UUID) ([]ListParticipantsByGamesRow, error)
 	ListParticipantsByUser(ctx context.Context, userID pgtype.UUID) ([]Participant, error)
+	ListParticipationCorrections(ctx context.Context, gameID pgtype.UUID) ([]ParticipationCorrection, error)
+	ListParticipationJournalByGame(ctx context.Context, gameID pgtype.UUID) ([]ParticipationJournal, error)
+	ListPendingLegalDocuments(ctx context.Context, userID pgtype.UUID) ([]LegalDocument, error)
+	ListPlayerReliability(ctx context.Context, userIds []pgtype.UUID) ([]PlayerReliability, error)
+	// Newest first; with LIMIT n, the last row is the one whose expiry brings the email back under n failures
+	ListRecentFailedLoginsByEmail(ctx context.Context, arg ListRecentFailedLoginsByEmailParams) ([]pgtype.Timestamptz, error)
+	// Newest first; with LIMIT n, the last row is the one whose expiry brings the IP back under n failures
+	ListRecentFailedLoginsByIP(ctx context.Context, arg ListRecentFailedLoginsByIPParams) ([]pgtype.Timestamptz, error)
+	// Newest first; with LIMIT n, the last row is the one whose expiry brings the host back under n creations
+	ListRecentGameCreationsByOwner(ctx context.Context, arg ListRecentGameCreationsByOwnerParams) ([]pgtype.Timestamptz, error)
+	ListRecentReferrals(ctx context.Context, arg ListRecentReferralsParams) ([]ListRecentReferralsRow, error)
+	ListReports(ctx context.Context, arg ListReportsParams) ([]Report, error)
+	ListRosterSnapshotEntries(ctx context.Context, gameID pgtype.UUID) ([]ListRosterSnapshotEntriesRow, error)
+	ListSavedSearches(ctx context.Context, userID pgtype.UUID) ([]SavedSearch, error)
+	// Consenting players who are still confirmed, still have a phone number and haven't blocked the host
+	ListSharedContacts(ctx context.Context, gameID pgtype.UUID) ([]ListSharedContactsRow, error)
+	// Endorsements per skill level of each user. An endorser who played several games with a user
+	// counts once, at the level of their latest endorsement.
+	ListSkillEndorsementCounts(ctx context.Context, userIds []pgtype.UUID) ([]ListSkillEndorsementCountsRow, error)
 	ListTeamsByGame(ctx context.Context, gameID pgtype.UUID) ([]Team, error)
+	ListUpcomingGamesInRadius(ctx context.Context, arg ListUpcomingGamesInRadiusParams) ([]ListUpcomingGamesInRadiusRow, error)
+	// Deliveries to the user's account: push by user ID, email to their current address and SMS to
+	// their verified number
+	ListUserNotificationDeliveries(ctx context.Context, arg ListUserNotificationDeliveriesParams) ([]ListUserNotificationDeliveriesRow, error)
+	// Other games the user is confirmed for whose time overlaps the given game
+	ListUserOverlappingGames(ctx context.Context, arg ListUserOverlappingGamesParams) ([]ListUserOverlappingGamesRow, error)
+	// Games the user signed up for that have ended, most recent first, with how the sign-up ended
+	ListUserParticipationHistory(ctx context.Context, arg ListUserParticipationHistoryParams) ([]ListUserParticipationHistoryRow, error)
+	ListUserPlayedCategories(ctx context.Context, userID pgtype.UUID) ([]string, error)
+	ListUserSportPreferences(ctx context.Context, userID pgtype.UUID) ([]UserSportPreference, error)
+	ListUserUpcomingParticipations(ctx context.Context, userID pgtype.UUID) ([]ListUserUpcomingParticipationsRow, error)
+	ListWebAuthnCredentialsByUser(ctx context.Context, userID pgtype.UUID) ([]WebauthnCredential, error)
+	ListWebhookSubscriptions(ctx context.Context, ownerID pgtype.UUID) ([]WebhookSubscription, error)
+	MarkConversationRead(ctx context.Context, arg MarkConversationReadParams) error
+	MarkDeadLetterRequeued(ctx context.Context, arg MarkDeadLetterRequeuedParams) (DeadLetter, error)
+	MarkEmailChangeRequestConfirmed(ctx context.Context, id pgtype.UUID) error
+	MarkLoginCodeUsed(ctx context.Context, id pgtype.UUID) error
+	MarkNotificationDeliverySent(ctx context.Context, id pgtype.UUID) error
+	MarkParticipantDropped(ctx context.Context, arg MarkParticipantDroppedParams) (Participant, error)
+	MarkPhoneVerificationVerified(ctx context.Context, id pgtype.UUID) error
+	// Moves participants behind everyone else in the game's line, keeping their order in participant_ids
+	MoveParticipantsToBackOfLine(ctx context.Context, arg MoveParticipantsToBackOfLineParams) error
+	// Moves participants ahead of everyone else in the game's line, keeping their order in participant_ids
+	MoveParticipantsToFrontOfLine(ctx context.Context, arg MoveParticipantsToFrontOfLineParams) error
+	// Whether the user played the completed game: confirmed and not marked a no-show
+	PlayedCompletedGame(ctx context.Context, arg PlayedCompletedGameParams) (bool, error)
+	// Deletes the cells a refresh no longer produced, e.g. after a no-show correction
+	PruneLeaderboardCells(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error)
+	ReactivateUser(ctx context.Context, userID pgtype.UUID) (int64, error)
+	// Moves the user's balance by amount_cents and appends the ledger entry in one statement. The
+	// balance check rejects spends that would overdraw the wallet.
+	RecordCreditTransaction(ctx context.Context, arg RecordCreditTransactionParams) (CreditTransaction, error)
+	RecordFailedLogin(ctx context.Context, arg RecordFailedLoginParams) error
+	// Records an alert about to be sent, unless the user was already alerted about the game or has
+	// had max_per_day alerts in the last day
+	RecordNewGameAlert(ctx context.Context, arg RecordNewGameAlertParams) (int64, error)
+	// Recomputes every leaderboard cell from completed games, stamping the rows it writes with computed_at.
+	// A game counts as played the way GetUserPlayStats counts it.
+	RefreshLeaderboardCells(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error)
+	// Recomputes the reliability of every player, or only of user_id when it is set
+	RefreshPlayerReliability(ctx context.Context, userID pgtype.UUID) (int64, error)
+	ReleaseExpiredReservations(ctx context.Context, gameID pgtype.UUID) (int64, error)
+	// Rearranges waitlisted participants into the order of participant_ids. They trade the ranks they
+	// already hold, so the waitlist keeps its place in the line relative to everyone else.
+	ReorderWaitlist(ctx context.Context, arg ReorderWaitlistParams) error
+	// Replaces the user's sport preferences with the given categories; skill_levels pairs with categories,
+	// an empty string meaning no level
+	ReplaceUserSportPreferences(ctx context.Context, arg ReplaceUserSportPreferencesParams) error
+	// Requesting again keeps the original request
+	RequestContactSharing(ctx context.Context, arg RequestContactSharingParams) (ContactShareRequest, error)
+	// Queues a dead notification again, due now and with no attempts made
+	RequeueNotificationDelivery(ctx context.Context, arg RequeueNotificationDeliveryParams) error
+	// Queues a dead side effect again unless its game has since been deleted
+	RequeueSideEffect(ctx context.Context, arg RequeueSideEffectParams) (int64, error)
+	// Queues a dead webhook delivery again unless its subscription has since been deleted
+	RequeueWebhookDelivery(ctx context.Context, arg RequeueWebhookDeliveryParams) (int64, error)
+	RescheduleBrokerEvent(ctx context.Context, arg RescheduleBrokerEventParams) error
+	RescheduleNotificationDelivery(ctx context.Context, arg RescheduleNotificationDeliveryParams) error
+	RescheduleSideEffect(ctx context.Context, arg RescheduleSideEffectParams) error
+	RescheduleWebhookDelivery(ctx context.Context, arg RescheduleWebhookDeliveryParams) error
 	RevokeAllUserRefreshTokens(ctx context.Context, userID pgtype.UUID) error
+	RevokeContactShareConsent(ctx context.Context, arg RevokeContactShareConsentParams) (int64, error)
 	RevokeRefreshToken(ctx context.Context, tokenHash string) error
+	SaveGameCancellationReason(ctx context.Context, arg SaveGameCancellationReasonParams) error
+	SaveUserOnboarding(ctx context.Context, arg SaveUserOnboardingParams) (UserSetting, error)
+	SetSavedSearchAlerts(ctx context.Context, arg SetSavedSearchAlertsParams) (SavedSearch, error)
+	SetUserVerifiedPhone(ctx context.Context, arg SetUserVerifiedPhoneParams) (User, error)
+	// Shadow-banning an already shadow-banned user replaces the reason
+	ShadowBanUser(ctx context.Context, arg ShadowBanUserParams) (UserShadowBan, error)
+	StartGamesPastStartTime(ctx context.Context) (int64, error)
+	// Suspending an already suspended user replaces the reason
+	SuspendUser(ctx context.Context, arg SuspendUserParams) (UserSuspension, error)
+	// Records a new message, which the sender has read
+	TouchConversation(ctx context.Context, arg TouchConversationParams) error
+	// Takes everyone off a team before it is deleted, so their update time reflects the change
+	UnassignTeamParticipants(ctx context.Context, teamID pgtype.UUID) (int64, error)
+	UnblockPlayer(ctx context.Context, arg UnblockPlayerParams) (int64, error)
+	UnsuspendUser(ctx context.Context, userID pgtype.UUID) (int64, error)
 	UpdateGame(ctx context.Context, arg UpdateGameParams) (pgtype.UUID, error)
+	UpdateGameCommentBody(ctx context.Context, arg UpdateGameCommentBodyParams) (GameComment, error)
+	UpdateGameStatus(ctx context.Context, arg UpdateGameStatusParams) error
+	// Leaves updated_at alone: it records when the status last changed, which late drops are judged by
 	UpdateParticipantPayment(ctx context.Context, arg UpdateParticipantPaymentParams) (Participant, error)
 	UpdateParticipantStatus(ctx context.Context, arg UpdateParticipantStatusParams) (Participant, error)
 	UpdateParticipantStatusResetJoinedAt(ctx context.Context, arg UpdateParticipantStatusResetJoinedAtParams) (Participant, error)
 	UpdateParticipantTeam(ctx context.Context, arg UpdateParticipantTeamParams) (Participant, error)
+	UpdateReportStatus(ctx context.Context, arg UpdateReportStatusParams) (Report, error)
+	UpdateTeam(ctx context.Context, arg UpdateTeamParams) (Team, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	// Changing the password also bumps token_version so previously issued access tokens stop working
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error)
+	UpdateWebAuthnCredentialUsage(ctx context.Context, arg UpdateWebAuthnCredentialUsageParams) error
+	UpsertAttendance(ctx context.Context, arg UpsertAttendanceParams) (Attendance, error)
+	UpsertGameMVP(ctx context.Context, arg UpsertGameMVPParams) (GameMvp, error)
+	// Sets the given mutes, leaving NULL ones unchanged
+	UpsertGameNotificationSettings(ctx context.Context, arg UpsertGameNotificationSettingsParams) (GameNotificationSetting, error)
+	// Reserving again moves the expiry and holds the spot again if it had been released
+	UpsertGameReservation(ctx context.Context, arg UpsertGameReservationParams) (GameReservation, error)
+	// Sets the given preferences, leaving NULL ones unchanged
+	UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) (UserNotificationPreference, error)
+	UpsertSkillEndorsement(ctx context.Context, arg UpsertSkillEndorsementParams) (SkillEndorsement, error)
+	UpsertUserSettings(ctx context.Context, arg UpsertUserSettingsParams) (UserSetting, error)
+	UserHasRole(ctx context.Context, arg UserHasRoleParams) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -5,9 +5,11 @@ INSERT INTO users (
     email,
     first_name,
     last_name,
-    password_hash
+    password_hash,
+    birthdate,
+    home_region
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6
 )
 RETURNING *;
 
@@ -32,6 +34,53 @@ RETURNING *;
 DELETE FROM users
 WHERE id = $1;
 
+-- name: IsOrganizerOfParticipant :one
+SELECT EXISTS (
+    SELECT 1 FROM games g
+    INNER JOIN participants p ON p.game_id = g.id
+    WHERE g.owner_id = sqlc.arg('organizer_id')
+    AND p.user_id = sqlc.arg('participant_id')
+    AND p.status IN ('confirmed', 'waitlist')
+);
+
+-- name: GetUserByVerifiedPhone :one
+SELECT * FROM users
+WHERE phone_number = $1
+AND phone_verified_at IS NOT NULL;
+
+-- name: SetUserVerifiedPhone :one
+UPDATE users
+SET
+    phone_number = $2,
+    phone_verified_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- Changing the password also bumps token_version so previously issued access tokens stop working
+-- name: UpdateUserPassword :one
+UPDATE users
+SET
+    password_hash = $2,
+    token_version = token_version + 1
+WHERE id = $1
+RETURNING *;
+
+-- What every authenticated request checks: the current token version, whether an admin suspended
+-- the account and whether its owner deactivated it
+-- name: GetUserSessionState :one
+SELECT
+    u.token_version,
+    EXISTS (SELECT 1 FROM user_suspensions s WHERE s.user_id = u.id) AS suspended,
+    EXISTS (SELECT 1 FROM user_deactivations d WHERE d.user_id = u.id) AS deactivated
+FROM users u
+WHERE u.id = $1;
+
+-- name: IncrementUserTokenVersion :one
+UPDATE users
+SET token_version = token_version + 1
+WHERE id = $1
+RETURNING token_version;
+
 -- Refresh token queries
 
 -- name: CreateRefreshToken :one
@@ -66,6 +115,256 @@ AND revoked_at IS NULL;
 DELETE FROM refresh_tokens
 WHERE expires_at < NOW();
 
+-- name: CreatePhoneVerification :one
+INSERT INTO phone_verifications (
+    user_id,
+    phone_number,
+    code_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: CountPhoneVerificationsSince :one
+SELECT COUNT(*) FROM phone_verifications
+WHERE user_id = $1
+AND created_at > $2;
+
+-- name: GetLatestPendingPhoneVerification :one
+SELECT * FROM phone_verifications
+WHERE user_id = $1
+AND verified_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: IncrementPhoneVerificationAttempts :one
+UPDATE phone_verifications
+SET attempts = attempts + 1
+WHERE id = $1
+RETURNING attempts;
+
+-- name: MarkPhoneVerificationVerified :exec
+UPDATE phone_verifications
+SET verified_at = NOW()
+WHERE id = $1;
+
+-- name: CreateLoginCode :one
+INSERT INTO login_codes (
+    user_id,
+    code_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: CountLoginCodesSince :one
+SELECT COUNT(*) FROM login_codes
+WHERE user_id = $1
+AND created_at > $2;
+
+-- name: GetLatestPendingLoginCode :one
+SELECT * FROM login_codes
+WHERE user_id = $1
+AND used_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: IncrementLoginCodeAttempts :one
+UPDATE login_codes
+SET attempts = attempts + 1
+WHERE id = $1
+RETURNING attempts;
+
+-- name: MarkLoginCodeUsed :exec
+UPDATE login_codes
+SET used_at = NOW()
+WHERE id = $1;
+
+-- name: RecordFailedLogin :exec
+INSERT INTO failed_logins (email, ip_address)
+VALUES ($1, $2);
+
+-- Newest first; with LIMIT n, the last row is the one whose expiry brings the email back under n failures
+-- name: ListRecentFailedLoginsByEmail :many
+SELECT attempted_at FROM failed_logins
+WHERE email = $1
+AND attempted_at > $2
+ORDER BY attempted_at DESC
+LIMIT $3;
+
+-- Newest first; with LIMIT n, the last row is the one whose expiry brings the IP back under n failures
+-- name: ListRecentFailedLoginsByIP :many
+SELECT attempted_at FROM failed_logins
+WHERE ip_address = $1
+AND attempted_at > $2
+ORDER BY attempted_at DESC
+LIMIT $3;
+
+-- name: ClearFailedLoginsByEmail :exec
+DELETE FROM failed_logins
+WHERE email = $1;
+
+-- name: DeleteFailedLoginsBefore :execrows
+DELETE FROM failed_logins
+WHERE attempted_at < $1;
+
+-- name: CreateEmailChangeRequest :one
+INSERT INTO email_change_requests (
+    user_id,
+    new_email,
+    token_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetLatestPendingEmailChangeRequest :one
+SELECT * FROM email_change_requests
+WHERE user_id = $1
+AND confirmed_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: GetPendingEmailChangeRequestByTokenHash :one
+SELECT * FROM email_change_requests
+WHERE token_hash = $1
+AND confirmed_at IS NULL;
+
+-- name: MarkEmailChangeRequestConfirmed :exec
+UPDATE email_change_requests
+SET confirmed_at = NOW()
+WHERE id = $1;
+
+-- Earlier links stop working once a newer change is requested or one is confirmed
+-- name: CancelPendingEmailChangeRequests :exec
+DELETE FROM email_change_requests
+WHERE user_id = $1
+AND confirmed_at IS NULL;
+
+-- name: CreateMagicLinkToken :one
+INSERT INTO magic_link_tokens (
+    user_id,
+    token_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetLatestMagicLinkToken :one
+SELECT * FROM magic_link_tokens
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- Marks an unexpired link as used in the same statement that finds it, so a link can only be redeemed once
+-- name: ConsumeMagicLinkToken :one
+UPDATE magic_link_tokens
+SET used_at = NOW()
+WHERE token_hash = $1
+AND used_at IS NULL
+AND expires_at > NOW()
+RETURNING *;
+
+-- name: CreateWebAuthnChallenge :exec
+INSERT INTO webauthn_challenges (
+    user_id,
+    ceremony,
+    challenge,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: ConsumeWebAuthnChallenge :one
+DELETE FROM webauthn_challenges
+WHERE challenge = $1
+AND ceremony = $2
+AND expires_at > NOW()
+RETURNING *;
+
+-- name: DeleteExpiredWebAuthnChallenges :exec
+DELETE FROM webauthn_challenges
+WHERE expires_at <= NOW();
+
+-- name: CreateWebAuthnCredential :one
+INSERT INTO webauthn_credentials (
+    user_id,
+    credential_id,
+    public_key,
+    sign_count,
+    name
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetWebAuthnCredential :one
+SELECT * FROM webauthn_credentials
+WHERE credential_id = $1;
+
+-- name: ListWebAuthnCredentialsByUser :many
+SELECT * FROM webauthn_credentials
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: UpdateWebAuthnCredentialUsage :exec
+UPDATE webauthn_credentials
+SET
+    sign_count = $2,
+    last_used_at = NOW()
+WHERE id = $1;
+
+-- name: ListCurrentLegalDocuments :many
+SELECT DISTINCT ON (document_type) id, document_type, version, url, published_at, created_at
+FROM legal_documents
+WHERE published_at <= NOW()
+ORDER BY document_type, published_at DESC;
+
+-- name: ListPendingLegalDocuments :many
+SELECT d.id, d.document_type, d.version, d.url, d.published_at, d.created_at
+FROM legal_documents d
+WHERE d.published_at <= NOW()
+AND NOT EXISTS (
+    SELECT 1 FROM legal_documents newer
+    WHERE newer.document_type = d.document_type
+    AND newer.published_at <= NOW()
+    AND newer.published_at > d.published_at
+)
+AND NOT EXISTS (
+    SELECT 1 FROM legal_acceptances a
+    WHERE a.document_id = d.id
+    AND a.user_id = $1
+)
+ORDER BY d.document_type;
+
+-- name: CreateLegalAcceptance :exec
+INSERT INTO legal_acceptances (
+    user_id,
+    document_id,
+    ip_address,
+    user_agent
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (user_id, document_id) DO NOTHING;
+
+-- name: ListLegalAcceptancesByUser :many
+SELECT
+    a.id,
+    d.document_type,
+    d.version,
+    a.ip_address,
+    a.user_agent,
+    a.accepted_at
+FROM legal_acceptances a
+INNER JOIN legal_documents d ON a.document_id = d.id
+WHERE a.user_id = $1
+ORDER BY a.accepted_at DESC;
+
 -- Game queries
 
 -- name: CreateGame :one
@@ -88,7 +387,9 @@ INSERT INTO games (
     drop_deadline,
     skill_level,
     notes,
-    status
+    status,
+    adult_only,
+    late_drop_window_hours
 ) VALUES (
     sqlc.arg('owner_id'),
     sqlc.arg('category'),
@@ -96,7 +397,7 @@ INSERT INTO games (
     sqlc.arg('description'),
     sqlc.arg('location_name'),
     sqlc.arg('location_address'),
-    ST_SetSRID(ST_MakePoint(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8), 4326)::geography,
+    geo_point(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8),
     sqlc.arg('location_notes'),
     sqlc.arg('start_time'),
     sqlc.arg('duration_minutes'),
@@ -108,36 +409,61 @@ INSERT INTO games (
     sqlc.arg('drop_deadline'),
     sqlc.arg('skill_level'),
     sqlc.arg('notes'),
-    sqlc.arg('status')
+    sqlc.arg('status'),
+    sqlc.arg('adult_only'),
+    sqlc.narg('late_drop_window_hours')
 )
 RETURNING id, owner_id, category, title, description, location_name, location_address,
-    ST_Y(location_point::geometry) as latitude, ST_X(location_point::geometry) as longitude,
+    geo_latitude(location_point) as latitude, geo_longitude(location_point) as longitude,
     location_notes, start_time, duration_minutes, max_participants,
     0 as confirmed_count, 0 as waitlist_count,
     pricing_type, pricing_amount_cents, pricing_currency, signup_deadline,
-    drop_deadline, skill_level, notes, status, cancelled_at, created_at, updated_at;
+    drop_deadline, skill_level, adult_only, notes, status, cancelled_at, created_at, updated_at,
+    late_drop_window_hours;
 
 -- name: GetGame :one
 SELECT
     g.id, g.owner_id, g.category, g.title, g.description, g.location_name, g.location_address,
-    ST_Y(g.location_point::geometry) as latitude, ST_X(g.location_point::geometry) as longitude,
+    geo_latitude(g.location_point) as latitude, geo_longitude(g.location_point) as longitude,
     g.location_notes, g.start_time, g.duration_minutes, g.max_participants,
     COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'confirmed'), 0)::int as confirmed_count,
     COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'waitlist'), 0)::int as waitlist_count,
     g.pricing_type, g.pricing_amount_cents, g.pricing_currency, g.signup_deadline,
-    g.drop_deadline, g.skill_level, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at
+    g.drop_deadline, g.skill_level, g.adult_only, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at,
+    g.late_drop_window_hours
 FROM games g
 LEFT JOIN participants p ON p.game_id = g.id
 WHERE g.id = $1
 GROUP BY g.id;
 
+-- name: ExportGames :many
+SELECT
+    g.id, g.owner_id, g.category, g.title, g.status, g.location_name,
+    geo_latitude(g.location_point) as latitude, geo_longitude(g.location_point) as longitude,
+    g.start_time, g.duration_minutes, g.max_participants,
+    COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'confirmed'), 0)::int as confirmed_count,
+    COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'waitlist'), 0)::int as waitlist_count,
+    g.pricing_type, g.pricing_amount_cents, g.pricing_currency,
+    g.adult_only, g.cancelled_at, g.created_at
+FROM games g
+LEFT JOIN participants p ON p.game_id = g.id
+WHERE (sqlc.narg('created_from')::timestamptz IS NULL OR g.created_at >= sqlc.narg('created_from'))
+AND (sqlc.narg('created_to')::timestamptz IS NULL OR g.created_at < sqlc.narg('created_to'))
+GROUP BY g.id
+ORDER BY g.created_at ASC;
+
+-- name: GetGameOwner :one
+SELECT owner_id FROM games
+WHERE id = $1;
+
 -- name: GetGameForUpdate :one
 SELECT
     id, owner_id, category, title, description, location_name, location_address,
-    ST_Y(location_point::geometry) as latitude, ST_X(location_point::geometry) as longitude,
+    geo_latitude(location_point) as latitude, geo_longitude(location_point) as longitude,
     location_notes, start_time, duration_minutes, max_participants,
     pricing_type, pricing_amount_cents, pricing_currency, signup_deadline,
-    drop_deadline, skill_level, notes, status, cancelled_at, created_at, updated_at
+    drop_deadline, skill_level, adult_only, notes, status, cancelled_at, created_at, updated_at,
+    late_drop_window_hours
 FROM games
 WHERE id = $1
 FOR UPDATE;
@@ -145,28 +471,248 @@ FOR UPDATE;
 -- name: ListGamesInRadius :many
 SELECT
     g.id, g.owner_id, g.category, g.title, g.description, g.location_name, g.location_address,
-    ST_Y(g.location_point::geometry) as latitude, ST_X(g.location_point::geometry) as longitude,
+    geo_latitude(g.location_point) as latitude, geo_longitude(g.location_point) as longitude,
     g.location_notes, g.start_time, g.duration_minutes, g.max_participants,
     COALESCE(COUNT(p.id))::int as signup_count,
+    (COUNT(p.id) FILTER (WHERE p.status = 'confirmed'))::int as confirmed_count,
+    (COUNT(p.id) FILTER (WHERE p.status = 'waitlist'))::int as waitlist_count,
     g.pricing_type, g.pricing_amount_cents, g.pricing_currency, g.signup_deadline,
-    g.drop_deadline, g.skill_level, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at,
-    up.status as user_participation_status
+    g.drop_deadline, g.skill_level, g.adult_only, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at,
+    up.status as user_participation_status,
+    ST_Distance(g.location_point, geo_point(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8))::float8 as distance_meters,
+    ARRAY(SELECT t.tag FROM game_tags t WHERE t.game_id = g.id ORDER BY t.tag)::varchar[] as tags,
+    -- Every match, not just this page; counted before LIMIT and OFFSET apply
+    COUNT(*) OVER ()::int as total_count
 FROM games g
 LEFT JOIN participants p ON p.game_id = g.id AND p.status in ('confirmed', 'waitlist')
 LEFT JOIN participants up ON up.game_id = g.id AND up.user_id = sqlc.narg('user_id')
 WHERE ST_DWithin(
     g.location_point,
-    ST_SetSRID(ST_MakePoint(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8), 4326)::geography,
+    geo_point(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8),
     sqlc.arg('radius')::float8
 )
 AND g.start_time >= sqlc.arg('start_time')
 AND (sqlc.narg('end_time')::timestamptz IS NULL OR g.start_time <= sqlc.narg('end_time'))
 AND (sqlc.narg('status')::varchar IS NULL OR g.status = sqlc.narg('status'))
 AND g.category = ANY(sqlc.arg('categories')::varchar[])
+AND (sqlc.arg('include_adult_only')::bool OR NOT g.adult_only)
+-- Players the host blocked don't see their games, unless they're still confirmed or waitlisted in one
+AND (up.status IN ('confirmed', 'waitlist') OR NOT EXISTS (
+    SELECT 1 FROM host_blocked_players b
+    WHERE b.host_id = g.owner_id AND b.player_id = sqlc.narg('user_id')
+))
+AND (g.owner_id = sqlc.narg('user_id') OR NOT EXISTS (
+    SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = g.owner_id
+))
+AND (sqlc.narg('skill_levels')::varchar[] IS NULL OR g.skill_level = ANY(sqlc.narg('skill_levels')::varchar[]))
+AND (NOT sqlc.arg('free_only')::bool OR g.pricing_type = 'free' OR g.pricing_amount_cents = 0)
+-- A total is split among the confirmed players, so the share is what it would be with the user confirmed too
+AND (sqlc.narg('max_price_per_person_cents')::int IS NULL OR (CASE g.pricing_type
+    WHEN 'free' THEN 0
+    WHEN 'per_person' THEN g.pricing_amount_cents
+    ELSE CEIL(g.pricing_amount_cents::numeric / GREATEST(LEAST(
+        (SELECT COUNT(*) FROM participants c WHERE c.game_id = g.id AND c.status = 'confirmed') + 1,
+        g.max_participants), 1))
+END) <= sqlc.narg('max_price_per_person_cents'))
+-- Days (ISO, Monday = 1) and times of day are read in the searcher's timezone
+AND (sqlc.narg('days_of_week')::int[] IS NULL OR EXTRACT(ISODOW FROM g.start_time AT TIME ZONE sqlc.arg('time_zone')::text)::int = ANY(sqlc.narg('days_of_week')::int[]))
+AND (sqlc.narg('starts_after')::time IS NULL OR (g.start_time AT TIME ZONE sqlc.arg('time_zone')::text)::time >= sqlc.narg('starts_after')::time)
+AND (sqlc.narg('starts_before')::time IS NULL OR (g.start_time AT TIME ZONE sqlc.arg('time_zone')::text)::time < sqlc.narg('starts_before')::time)
+-- A map viewport; the service also passes the circle around it so ST_DWithin can still use the index
+AND (sqlc.narg('min_longitude')::float8 IS NULL OR ST_Intersects(g.location_point::geometry, ST_MakeEnvelope(
+    sqlc.narg('min_longitude')::float8, sqlc.narg('min_latitude')::float8,
+    sqlc.narg('max_longitude')::float8, sqlc.narg('max_latitude')::float8, 4326)))
+-- Tags are deduplicated, so matching all of them means matching as many as were given
+AND (sqlc.narg('tags')::varchar[] IS NULL OR (
+    SELECT COUNT(*) FROM game_tags t WHERE t.game_id = g.id AND t.tag = ANY(sqlc.narg('tags')::varchar[])
+) >= CASE WHEN sqlc.arg('match_all_tags')::bool THEN cardinality(sqlc.narg('tags')::varchar[]) ELSE 1 END)
+-- The signed-in user's relationship to the game: hosting it, confirmed in it or waitlisted for it
+AND (sqlc.narg('relationship')::varchar IS NULL OR CASE sqlc.narg('relationship')::varchar
+    WHEN 'hosting' THEN g.owner_id = sqlc.narg('user_id')
+    WHEN 'joined' THEN up.status = 'confirmed'
+    WHEN 'waitlisted' THEN up.status = 'waitlist'
+    ELSE FALSE
+END)
 GROUP BY g.id, up.user_id, up.status
 ORDER BY g.start_time ASC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
+-- name: ListUpcomingGamesInRadius :many
+SELECT
+    g.id, g.owner_id, g.category, g.title, g.description, g.location_name, g.location_address,
+    geo_latitude(g.location_point) as latitude, geo_longitude(g.location_point) as longitude,
+    g.location_notes, g.start_time, g.duration_minutes, g.max_participants,
+    ug.signup_count, ug.confirmed_count, ug.waitlist_count,
+    g.pricing_type, g.pricing_amount_cents, g.pricing_currency, g.signup_deadline,
+    g.drop_deadline, g.skill_level, g.adult_only, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at,
+    up.status as user_participation_status,
+    ST_Distance(ug.location_point, geo_point(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8))::float8 as distance_meters,
+    ARRAY(SELECT t.tag FROM game_tags t WHERE t.game_id = g.id ORDER BY t.tag)::varchar[] as tags,
+    -- Every match, not just this page; counted before LIMIT and OFFSET apply
+    COUNT(*) OVER ()::int as total_count
+FROM upcoming_games ug
+INNER JOIN games g ON g.id = ug.game_id
+LEFT JOIN participants up ON up.game_id = ug.game_id AND up.user_id = sqlc.narg('user_id')
+WHERE ST_DWithin(
+    ug.location_point,
+    geo_point(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8),
+    sqlc.arg('radius')::float8
+)
+AND ug.start_time >= sqlc.arg('start_time')
+AND (sqlc.narg('end_time')::timestamptz IS NULL OR ug.start_time <= sqlc.narg('end_time'))
+AND (sqlc.narg('status')::varchar IS NULL OR ug.status = sqlc.narg('status'))
+AND ug.category = ANY(sqlc.arg('categories')::varchar[])
+AND (sqlc.arg('include_adult_only')::bool OR NOT ug.adult_only)
+-- Players the host blocked don't see their games, unless they're still confirmed or waitlisted in one
+AND (up.status IN ('confirmed', 'waitlist') OR NOT EXISTS (
+    SELECT 1 FROM host_blocked_players b
+    WHERE b.host_id = g.owner_id AND b.player_id = sqlc.narg('user_id')
+))
+AND (g.owner_id = sqlc.narg('user_id') OR NOT EXISTS (
+    SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = g.owner_id
+))
+AND (sqlc.narg('skill_levels')::varchar[] IS NULL OR g.skill_level = ANY(sqlc.narg('skill_levels')::varchar[]))
+AND (NOT sqlc.arg('free_only')::bool OR g.pricing_type = 'free' OR g.pricing_amount_cents = 0)
+-- A total is split among the confirmed players, so the share is what it would be with the user confirmed too
+AND (sqlc.narg('max_price_per_person_cents')::int IS NULL OR (CASE g.pricing_type
+    WHEN 'free' THEN 0
+    WHEN 'per_person' THEN g.pricing_amount_cents
+    ELSE CEIL(g.pricing_amount_cents::numeric / GREATEST(LEAST(ug.confirmed_count + 1, g.max_participants), 1))
+END) <= sqlc.narg('max_price_per_person_cents'))
+-- Days (ISO, Monday = 1) and times of day are read in the searcher's timezone
+AND (sqlc.narg('days_of_week')::int[] IS NULL OR EXTRACT(ISODOW FROM ug.start_time AT TIME ZONE sqlc.arg('time_zone')::text)::int = ANY(sqlc.narg('days_of_week')::int[]))
+AND (sqlc.narg('starts_after')::time IS NULL OR (ug.start_time AT TIME ZONE sqlc.arg('time_zone')::text)::time >= sqlc.narg('starts_after')::time)
+AND (sqlc.narg('starts_before')::time IS NULL OR (ug.start_time AT TIME ZONE sqlc.arg('time_zone')::text)::time < sqlc.narg('starts_before')::time)
+-- A map viewport; the service also passes the circle around it so ST_DWithin can still use the index
+AND (sqlc.narg('min_longitude')::float8 IS NULL OR ST_Intersects(ug.location_point::geometry, ST_MakeEnvelope(
+    sqlc.narg('min_longitude')::float8, sqlc.narg('min_latitude')::float8,
+    sqlc.narg('max_longitude')::float8, sqlc.narg('max_latitude')::float8, 4326)))
+-- Tags are deduplicated, so matching all of them means matching as many as were given
+AND (sqlc.narg('tags')::varchar[] IS NULL OR (
+    SELECT COUNT(*) FROM game_tags t WHERE t.game_id = g.id AND t.tag = ANY(sqlc.narg('tags')::varchar[])
+) >= CASE WHEN sqlc.arg('match_all_tags')::bool THEN cardinality(sqlc.narg('tags')::varchar[]) ELSE 1 END)
+-- The signed-in user's relationship to the game: hosting it, confirmed in it or waitlisted for it
+AND (sqlc.narg('relationship')::varchar IS NULL OR CASE sqlc.narg('relationship')::varchar
+    WHEN 'hosting' THEN g.owner_id = sqlc.narg('user_id')
+    WHEN 'joined' THEN up.status = 'confirmed'
+    WHEN 'waitlisted' THEN up.status = 'waitlist'
+    ELSE FALSE
+END)
+ORDER BY ug.start_time ASC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- Games a host has that haven't started and are still taking or holding signups
+-- name: CountOpenGamesByOwner :one
+SELECT COUNT(*) FROM games
+WHERE owner_id = $1
+AND status IN ('open', 'full', 'closed')
+AND start_time > NOW();
+
+-- Newest first; with LIMIT n, the last row is the one whose expiry brings the host back under n creations
+-- name: ListRecentGameCreationsByOwner :many
+SELECT created_at FROM games
+WHERE owner_id = $1
+AND created_at > $2
+ORDER BY created_at DESC
+LIMIT $3;
+
+-- name: ListOwnerUpcomingGames :many
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.start_time,
+    g.max_participants,
+    g.pricing_type,
+    g.status,
+    COUNT(p.id) FILTER (WHERE p.status = 'confirmed') AS confirmed_count
+FROM games g
+LEFT JOIN participants p ON p.game_id = g.id
+WHERE g.owner_id = $1
+AND g.status IN ('open', 'full', 'closed')
+AND g.start_time > NOW()
+GROUP BY g.id
+ORDER BY g.start_time ASC;
+
+-- name: ListUserUpcomingParticipations :many
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.start_time,
+    g.status,
+    p.status AS participant_status,
+    (
+        SELECT COUNT(*)
+        FROM participants w
+        WHERE w.game_id = p.game_id
+        AND w.status = 'waitlist'
+        AND w.waitlist_rank <= p.waitlist_rank
+    ) AS waitlist_position
+FROM participants p
+JOIN games g ON g.id = p.game_id
+WHERE p.user_id = $1
+AND p.status IN ('confirmed', 'waitlist')
+AND g.status IN ('open', 'full', 'closed')
+AND g.start_time > NOW()
+ORDER BY g.start_time ASC;
+
+-- Games the user signed up for that have ended, most recent first, with how the sign-up ended
+-- name: ListUserParticipationHistory :many
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.start_time,
+    g.status,
+    p.status AS participant_status,
+    a.status AS attendance_status,
+    p.paid,
+    p.payment_amount_cents
+FROM participants p
+JOIN games g ON g.id = p.game_id
+LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+WHERE p.user_id = sqlc.arg('user_id')
+AND g.start_time + make_interval(mins => g.duration_minutes) <= NOW()
+ORDER BY g.start_time DESC, g.id
+LIMIT sqlc.arg('page_limit') OFFSET sqlc.arg('page_offset');
+
+-- A user's sign-up for a game with what a receipt for it shows
+-- name: GetParticipationReceipt :one
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.location_address,
+    g.start_time,
+    g.duration_minutes,
+    g.status,
+    g.pricing_type,
+    g.pricing_amount_cents,
+    g.pricing_currency,
+    (SELECT COUNT(*) FROM participants c WHERE c.game_id = g.id AND c.status = 'confirmed') AS confirmed_count,
+    p.id AS participant_id,
+    p.paid,
+    p.payment_amount_cents,
+    o.first_name AS organizer_first_name,
+    o.last_name AS organizer_last_name
+FROM participants p
+JOIN games g ON g.id = p.game_id
+JOIN users o ON o.id = g.owner_id
+WHERE p.game_id = sqlc.arg('game_id') AND p.user_id = sqlc.arg('user_id');
+
+-- name: ListUserPlayedCategories :many
+SELECT DISTINCT g.category
+FROM participants p
+JOIN games g ON g.id = p.game_id
+WHERE p.user_id = $1
+AND p.status = 'confirmed'
+ORDER BY g.category;
+
 -- name: UpdateGame :one
 UPDATE games
 SET
@@ -177,7 +723,7 @@ SET
     location_point = CASE
         WHEN sqlc.narg('location_longitude')::float8 IS NOT NULL
             AND sqlc.narg('location_latitude')::float8 IS NOT NULL
-        THEN ST_SetSRID(ST_MakePoint(sqlc.narg('location_longitude'), sqlc.narg('location_latitude')), 4326)::geography
+        THEN geo_point(sqlc.narg('location_longitude'), sqlc.narg('location_latitude'))
         ELSE location_point
     END,
     location_notes = COALESCE(sqlc.narg('location_notes'), location_notes),
@@ -192,6 +738,8 @@ SET
     skill_level = COALESCE(sqlc.narg('skill_level'), skill_level),
     notes = COALESCE(sqlc.narg('notes'), notes),
     status = COALESCE(sqlc.narg('status'), status),
+    adult_only = COALESCE(sqlc.narg('adult_only'), adult_only),
+    late_drop_window_hours = COALESCE(sqlc.narg('late_drop_window_hours'), late_drop_window_hours),
     updated_at = NOW()
 WHERE id = sqlc.arg('id')
 RETURNING id;
@@ -205,10 +753,70 @@ SET
 WHERE id = sqlc.arg('id')
 RETURNING id;
 
+-- name: SaveGameCancellationReason :exec
+INSERT INTO game_cancellation_reasons (game_id, reason)
+VALUES ($1, $2)
+ON CONFLICT (game_id) DO UPDATE SET
+    reason = EXCLUDED.reason,
+    created_at = NOW();
+
+-- name: GetGameCancellationReason :one
+SELECT reason FROM game_cancellation_reasons
+WHERE game_id = $1;
+
+-- name: CloseGamesPastSignupDeadline :execrows
+UPDATE games
+SET
+    status = 'closed',
+    updated_at = NOW()
+WHERE status IN ('open', 'full')
+AND signup_deadline <= NOW();
+
+-- name: CompleteFinishedGames :execrows
+UPDATE games
+SET
+    status = 'completed',
+    updated_at = NOW()
+WHERE status IN ('open', 'full', 'closed', 'in_progress')
+AND start_time + make_interval(mins => duration_minutes) <= NOW();
+
+-- name: StartGamesPastStartTime :execrows
+UPDATE games
+SET
+    status = 'in_progress',
+    updated_at = NOW()
+WHERE status IN ('open', 'full', 'closed')
+AND start_time <= NOW()
+AND start_time + make_interval(mins => duration_minutes) > NOW();
+
+-- name: UpdateGameStatus :exec
+UPDATE games
+SET
+    status = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
 -- name: DeleteGame :exec
 DELETE FROM games
 WHERE id = $1;
 
+-- name: CreateGameChange :one
+INSERT INTO game_changes (
+    game_id,
+    changed_by,
+    field,
+    old_value,
+    new_value
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: ListGameChangesByGame :many
+SELECT * FROM game_changes
+WHERE game_id = $1
+ORDER BY created_at DESC;
+
 -- name: CreateTeam :one
 INSERT INTO teams (
     game_id,
@@ -232,6 +840,22 @@ ORDER BY created_at ASC;
 DELETE FROM teams
 WHERE id = $1;
 
+-- name: UpdateTeam :one
+UPDATE teams
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    color = COALESCE(sqlc.narg('color'), color)
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- Takes everyone off a team before it is deleted, so their update time reflects the change
+-- name: UnassignTeamParticipants :execrows
+UPDATE participants
+SET
+    team_id = NULL,
+    updated_at = NOW()
+WHERE team_id = $1;
+
 -- name: CreateParticipant :one
 INSERT INTO participants (
     game_id,
@@ -240,9 +864,20 @@ INSERT INTO participants (
     status,
     paid,
     payment_amount_cents,
-    notes
+    notes,
+    position
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: CreatePlaceholderParticipant :one
+INSERT INTO participants (
+    game_id,
+    placeholder_name,
+    status
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3
 )
 RETURNING *;
 
@@ -266,13 +901,17 @@ SELECT
     p.notes,
     p.joined_at,
     p.updated_at,
-    u.email,
-    u.first_name,
-    u.last_name
+    COALESCE(u.email, '')::text AS email,
+    COALESCE(u.first_name, p.placeholder_name)::text AS first_name,
+    COALESCE(u.last_name, '')::text AS last_name,
+    u.birthdate,
+    p.checked_in_at,
+    p.position,
+    EXISTS (SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = p.user_id) AS shadow_banned
 FROM participants p
-INNER JOIN users u ON p.user_id = u.id
+LEFT JOIN users u ON p.user_id = u.id
 WHERE p.game_id = $1
-ORDER BY p.joined_at ASC;
+ORDER BY p.waitlist_rank ASC;
 
 -- name: ListActiveParticipantsByGame :many
 SELECT
@@ -286,20 +925,98 @@ SELECT
     p.notes,
     p.joined_at,
     p.updated_at,
-    u.email,
-    u.first_name,
-    u.last_name
+    COALESCE(u.email, '')::text AS email,
+    COALESCE(u.first_name, p.placeholder_name)::text AS first_name,
+    COALESCE(u.last_name, '')::text AS last_name,
+    u.birthdate,
+    p.checked_in_at,
+    p.position,
+    EXISTS (SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = p.user_id) AS shadow_banned
 FROM participants p
-INNER JOIN users u ON p.user_id = u.id
+LEFT JOIN users u ON p.user_id = u.id
 WHERE p.game_id = $1
 AND p.status in ('confirmed', 'waitlist')
-ORDER BY p.joined_at ASC;
+ORDER BY p.waitlist_rank ASC;
+
+-- name: ListParticipantsByGamePage :many
+WITH roster AS (
+    SELECT
+        p.id,
+        p.game_id,
+        p.user_id,
+        p.team_id,
+        p.status,
+        p.paid,
+        p.payment_amount_cents,
+        p.notes,
+        p.joined_at,
+        p.updated_at,
+        COALESCE(u.email, '')::text AS email,
+        COALESCE(u.first_name, p.placeholder_name)::text AS first_name,
+        COALESCE(u.last_name, '')::text AS last_name,
+        u.birthdate,
+        p.drop_reason,
+        p.checked_in_at,
+        p.position,
+        p.late_drop,
+        p.waitlist_rank,
+        CASE WHEN p.status = 'waitlist'
+            THEN ROW_NUMBER() OVER (PARTITION BY p.status ORDER BY p.waitlist_rank ASC)
+        END AS waitlist_position
+    FROM participants p
+    LEFT JOIN users u ON p.user_id = u.id
+    WHERE p.game_id = sqlc.arg('game_id')
+)
+SELECT
+    id,
+    game_id,
+    user_id,
+    team_id,
+    status,
+    paid,
+    payment_amount_cents,
+    notes,
+    joined_at,
+    updated_at,
+    email,
+    first_name,
+    last_name,
+    birthdate,
+    waitlist_position,
+    drop_reason,
+    checked_in_at,
+    position,
+    late_drop
+FROM roster
+WHERE (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+AND (
+    sqlc.arg('include_minors')::boolean
+    OR user_id = sqlc.arg('viewer_id')
+    OR birthdate IS NULL
+    OR birthdate <= CURRENT_DATE - INTERVAL '18 years'
+)
+ORDER BY
+    CASE WHEN sqlc.arg('sort')::text = 'name' THEN lower(last_name) END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'name' THEN lower(first_name) END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'joined_at_desc' THEN joined_at END DESC,
+    waitlist_rank ASC,
+    id ASC
+LIMIT sqlc.arg('page_limit') OFFSET sqlc.arg('page_offset');
 
 -- name: ListParticipantsByUser :many
 SELECT * FROM participants
 WHERE user_id = $1
 ORDER BY joined_at DESC;
 
+-- name: LinkPlaceholderParticipant :one
+UPDATE participants
+SET
+    user_id = sqlc.arg('user_id'),
+    placeholder_name = NULL,
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND user_id IS NULL
+RETURNING *;
+
 -- name: UpdateParticipantStatus :one
 UPDATE participants
 SET
@@ -312,8 +1029,21 @@ RETURNING *;
 UPDATE participants
 SET
     status = $2,
+    position = $3,
+    drop_reason = NULL,
+    late_drop = FALSE,
+    checked_in_at = NULL,
     updated_at = NOW(),
-    joined_at = NOW()
+    joined_at = NOW(),
+    waitlist_rank = nextval('participants_waitlist_rank_seq')
+WHERE id = $1
+RETURNING *;
+
+-- name: CheckInParticipant :one
+UPDATE participants
+SET
+    checked_in_at = NOW(),
+    updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
@@ -331,12 +1061,22 @@ SET
     updated_at = NOW()
 WHERE id = ANY(sqlc.arg('participant_ids')::uuid[]);
 
+-- name: MarkParticipantDropped :one
+UPDATE participants
+SET
+    status = 'dropped',
+    drop_reason = $2,
+    late_drop = $3,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- Leaves updated_at alone: it records when the status last changed, which late drops are judged by
 -- name: UpdateParticipantPayment :one
 UPDATE participants
 SET
     paid = $2,
-    payment_amount_cents = $3,
-    updated_at = NOW()
+    payment_amount_cents = $3
 WHERE id = $1
 RETURNING *;
 
@@ -352,6 +1092,10 @@ RETURNING *;
 DELETE FROM participants
 WHERE id = $1;
 
+-- name: DeleteParticipantsByGame :exec
+DELETE FROM participants
+WHERE game_id = $1;
+
 -- name: CountConfirmedParticipants :one
 SELECT COUNT(*) FROM participants
 WHERE game_id = $1 AND status = 'confirmed';
@@ -372,10 +1116,1342 @@ SELECT
     p.notes,
     p.joined_at,
     p.updated_at,
-    u.email,
-    u.first_name,
-    u.last_name
+    COALESCE(u.email, '')::text AS email,
+    COALESCE(u.first_name, p.placeholder_name)::text AS first_name,
+    COALESCE(u.last_name, '')::text AS last_name,
+    u.birthdate
 FROM participants p
-INNER JOIN users u ON p.user_id = u.id
+LEFT JOIN users u ON p.user_id = u.id
 WHERE p.game_id = ANY(sqlc.arg('game_ids')::uuid[])
-ORDER BY p.game_id, p.joined_at ASC;
+ORDER BY p.game_id, p.waitlist_rank ASC;
+
+-- name: UserHasRole :one
+SELECT EXISTS (
+    SELECT 1 FROM user_roles WHERE user_id = $1 AND role = $2
+) AS has_role;
+
+-- name: CreateParticipationJournalEntry :exec
+INSERT INTO participation_journal (
+    game_id,
+    user_id,
+    action,
+    requested_at,
+    completed_at,
+    outcome,
+    error,
+    promoted_user_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: ListParticipationJournalByGame :many
+SELECT * FROM participation_journal
+WHERE game_id = $1
+ORDER BY requested_at ASC, id ASC;
+
+-- name: GetRosterSnapshot :one
+SELECT * FROM roster_snapshots
+WHERE game_id = $1;
+
+-- name: ListRosterSnapshotEntries :many
+SELECT
+    e.participant_id,
+    e.user_id,
+    e.first_name,
+    e.last_name,
+    e.status,
+    e.waitlist_position,
+    e.team_id,
+    e.paid,
+    e.payment_amount_cents,
+    e.joined_at,
+    u.birthdate
+FROM roster_snapshot_entries e
+LEFT JOIN users u ON e.user_id = u.id
+WHERE e.game_id = $1
+ORDER BY e.waitlist_position ASC NULLS FIRST, e.joined_at ASC;
+
+-- name: UpsertAttendance :one
+INSERT INTO attendance (game_id, user_id, status, marked_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (game_id, user_id) DO UPDATE
+SET status = EXCLUDED.status,
+    marked_by = EXCLUDED.marked_by,
+    marked_at = NOW()
+RETURNING *;
+
+-- name: ListAttendanceSummaries :many
+WITH recent AS (
+    SELECT
+        a.user_id,
+        a.status,
+        ROW_NUMBER() OVER (PARTITION BY a.user_id ORDER BY g.start_time DESC) AS n
+    FROM attendance a
+    JOIN games g ON g.id = a.game_id
+    WHERE a.user_id = ANY(sqlc.arg('user_ids')::uuid[])
+)
+SELECT
+    user_id,
+    COUNT(*) AS games,
+    COUNT(*) FILTER (WHERE status = 'no_show') AS no_shows
+FROM recent
+WHERE n <= sqlc.arg('window_size')::int
+GROUP BY user_id;
+
+-- Recomputes the reliability of every player, or only of user_id when it is set
+-- name: RefreshPlayerReliability :execrows
+INSERT INTO player_reliability (user_id, honored, late_drops, no_shows, score, computed_at)
+SELECT
+    user_id,
+    honored,
+    late_drops,
+    no_shows,
+    ROUND(100.0 * honored / (honored + late_drops + no_shows))::int,
+    NOW()
+FROM (
+    SELECT
+        p.user_id,
+        COUNT(*) FILTER (WHERE p.status = 'confirmed' AND a.status IS DISTINCT FROM 'no_show')::int AS honored,
+        COUNT(*) FILTER (WHERE p.status = 'dropped' AND p.late_drop)::int AS late_drops,
+        COUNT(*) FILTER (WHERE p.status = 'confirmed' AND a.status = 'no_show')::int AS no_shows
+    FROM participants p
+    JOIN games g ON g.id = p.game_id
+    LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+    WHERE p.user_id IS NOT NULL
+    AND g.status = 'completed'
+    AND (sqlc.narg('user_id')::uuid IS NULL OR p.user_id = sqlc.narg('user_id'))
+    GROUP BY p.user_id
+) totals
+WHERE honored + late_drops + no_shows > 0
+ON CONFLICT (user_id) DO UPDATE SET
+    honored = EXCLUDED.honored,
+    late_drops = EXCLUDED.late_drops,
+    no_shows = EXCLUDED.no_shows,
+    score = EXCLUDED.score,
+    computed_at = EXCLUDED.computed_at;
+
+-- name: ListPlayerReliability :many
+SELECT * FROM player_reliability
+WHERE user_id = ANY(sqlc.arg('user_ids')::uuid[]);
+
+-- Whether the user played the completed game: confirmed and not marked a no-show
+-- name: PlayedCompletedGame :one
+SELECT EXISTS (
+    SELECT 1
+    FROM participants p
+    JOIN games g ON g.id = p.game_id
+    LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+    WHERE p.game_id = sqlc.arg('game_id')
+    AND p.user_id = sqlc.arg('user_id')
+    AND p.status = 'confirmed'
+    AND g.status = 'completed'
+    AND a.status IS DISTINCT FROM 'no_show'
+);
+
+-- name: UpsertSkillEndorsement :one
+INSERT INTO skill_endorsements (game_id, endorser_id, endorsee_id, skill_level)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (game_id, endorser_id, endorsee_id) DO UPDATE
+SET skill_level = EXCLUDED.skill_level,
+    updated_at = NOW()
+RETURNING *;
+
+-- Endorsements per skill level of each user. An endorser who played several games with a user
+-- counts once, at the level of their latest endorsement.
+-- name: ListSkillEndorsementCounts :many
+SELECT endorsee_id, skill_level, COUNT(*)::int AS endorsers
+FROM (
+    SELECT DISTINCT ON (endorsee_id, endorser_id) endorsee_id, skill_level
+    FROM skill_endorsements
+    WHERE endorsee_id = ANY(sqlc.arg('user_ids')::uuid[])
+    ORDER BY endorsee_id, endorser_id, updated_at DESC
+) latest
+GROUP BY endorsee_id, skill_level;
+
+-- name: UpsertGameMVP :one
+INSERT INTO game_mvps (game_id, user_id, named_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (game_id) DO UPDATE
+SET user_id = EXCLUDED.user_id,
+    named_by = EXCLUDED.named_by,
+    named_at = NOW()
+RETURNING *;
+
+-- Recomputes every leaderboard cell from completed games, stamping the rows it writes with computed_at.
+-- A game counts as played the way GetUserPlayStats counts it.
+-- name: RefreshLeaderboardCells :execrows
+INSERT INTO leaderboard_cells (user_id, category, cell_x, cell_y, cell_point, games_played, games_hosted, mvps, computed_at)
+SELECT
+    user_id,
+    category,
+    cell_x,
+    cell_y,
+    geo_point((cell_x + 0.5) / 10.0, (cell_y + 0.5) / 10.0),
+    SUM(played)::int,
+    SUM(hosted)::int,
+    SUM(mvp)::int,
+    sqlc.arg('computed_at')::timestamptz
+FROM (
+    SELECT p.user_id, g.category, g.location_point, 1 AS played, 0 AS hosted, 0 AS mvp
+    FROM participants p
+    JOIN games g ON g.id = p.game_id
+    LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+    WHERE p.user_id IS NOT NULL
+    AND p.status = 'confirmed'
+    AND g.status = 'completed'
+    AND a.status IS DISTINCT FROM 'no_show'
+    UNION ALL
+    SELECT g.owner_id, g.category, g.location_point, 0, 1, 0
+    FROM games g
+    WHERE g.status = 'completed'
+    UNION ALL
+    SELECT m.user_id, g.category, g.location_point, 0, 0, 1
+    FROM game_mvps m
+    JOIN games g ON g.id = m.game_id
+    WHERE g.status = 'completed'
+) contributions
+CROSS JOIN LATERAL (
+    SELECT
+        floor(geo_longitude(contributions.location_point) * 10)::int AS cell_x,
+        floor(geo_latitude(contributions.location_point) * 10)::int AS cell_y
+) cell
+GROUP BY user_id, category, cell_x, cell_y
+ON CONFLICT (user_id, category, cell_x, cell_y) DO UPDATE SET
+    games_played = EXCLUDED.games_played,
+    games_hosted = EXCLUDED.games_hosted,
+    mvps = EXCLUDED.mvps,
+    computed_at = EXCLUDED.computed_at;
+
+-- Deletes the cells a refresh no longer produced, e.g. after a no-show correction
+-- name: PruneLeaderboardCells :execrows
+DELETE FROM leaderboard_cells
+WHERE computed_at < sqlc.arg('computed_at')::timestamptz;
+
+-- Players ranked by metric (played, hosted or mvps) over the cells within radius meters, optionally
+-- of one category. Shadow-banned players are left off.
+-- name: ListLeaderboard :many
+SELECT
+    l.user_id,
+    u.first_name,
+    u.last_name,
+    SUM(l.games_played)::int AS games_played,
+    SUM(l.games_hosted)::int AS games_hosted,
+    SUM(l.mvps)::int AS mvps
+FROM leaderboard_cells l
+JOIN users u ON u.id = l.user_id
+WHERE ST_DWithin(
+    l.cell_point,
+    geo_point(sqlc.arg('longitude')::float8, sqlc.arg('latitude')::float8),
+    sqlc.arg('radius')::float8
+)
+AND (sqlc.narg('category')::varchar IS NULL OR l.category = sqlc.narg('category'))
+AND NOT EXISTS (SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = l.user_id)
+GROUP BY l.user_id, u.first_name, u.last_name
+HAVING CASE sqlc.arg('metric')::text
+    WHEN 'hosted' THEN SUM(l.games_hosted)
+    WHEN 'mvps' THEN SUM(l.mvps)
+    ELSE SUM(l.games_played)
+END > 0
+ORDER BY CASE sqlc.arg('metric')::text
+    WHEN 'hosted' THEN SUM(l.games_hosted)
+    WHEN 'mvps' THEN SUM(l.mvps)
+    ELSE SUM(l.games_played)
+END DESC, l.user_id
+LIMIT sqlc.arg('page_limit') OFFSET sqlc.arg('page_offset');
+
+-- Totals over completed games. A confirmed game counts as played unless the host marked a no-show.
+-- name: GetUserPlayStats :one
+SELECT
+    (SELECT COUNT(*)
+     FROM participants p
+     JOIN games g ON g.id = p.game_id
+     LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+     WHERE p.user_id = $1
+     AND p.status = 'confirmed'
+     AND g.status = 'completed'
+     AND a.status IS DISTINCT FROM 'no_show')::int AS games_played,
+    (SELECT COUNT(*)
+     FROM attendance a
+     JOIN games g ON g.id = a.game_id
+     WHERE a.user_id = $1
+     AND a.status = 'no_show'
+     AND g.status = 'completed')::int AS no_shows,
+    (SELECT COUNT(*)
+     FROM games g
+     WHERE g.owner_id = $1
+     AND g.status = 'completed')::int AS games_hosted;
+
+-- The category of the most completed games played; ties go to the most recently played
+-- name: GetUserFavoriteCategory :one
+SELECT g.category
+FROM participants p
+JOIN games g ON g.id = p.game_id
+LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+WHERE p.user_id = $1
+AND p.status = 'confirmed'
+AND g.status = 'completed'
+AND a.status IS DISTINCT FROM 'no_show'
+GROUP BY g.category
+ORDER BY COUNT(*) DESC, MAX(g.start_time) DESC
+LIMIT 1;
+
+-- name: EnqueueSideEffect :one
+INSERT INTO side_effects (kind, game_id)
+VALUES ($1, $2)
+RETURNING *;
+
+-- Leases due side effects by pushing next_attempt_at past the lease, so a crashed worker's claims
+-- become due again and concurrent workers skip rows already being claimed
+-- name: ClaimDueSideEffects :many
+UPDATE side_effects
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => sqlc.arg('lease_seconds')::int)
+WHERE id IN (
+    SELECT id FROM side_effects
+    WHERE status = 'pending'
+    AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT sqlc.arg('batch_size')::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteSideEffect :exec
+UPDATE side_effects
+SET
+    status = 'done',
+    last_error = NULL,
+    completed_at = NOW()
+WHERE id = $1;
+
+-- name: RescheduleSideEffect :exec
+UPDATE side_effects
+SET
+    last_error = $2,
+    next_attempt_at = $3,
+    attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+WHERE id = $1;
+
+-- Marks a side effect failed and copies it to dead_letters
+-- name: FailSideEffect :exec
+WITH failed AS (
+    UPDATE side_effects
+    SET
+        status = 'failed',
+        last_error = $2,
+        attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+    WHERE id = $1
+    RETURNING *
+)
+INSERT INTO dead_letters (source, source_id, kind, payload, attempts, attempt_history, last_error)
+SELECT 'side_effect', id, kind, jsonb_build_object('gameId', game_id), attempts, attempt_history, last_error
+FROM failed;
+
+-- name: BlockPlayer :exec
+INSERT INTO host_blocked_players (host_id, player_id)
+VALUES ($1, $2)
+ON CONFLICT (host_id, player_id) DO NOTHING;
+
+-- name: UnblockPlayer :execrows
+DELETE FROM host_blocked_players
+WHERE host_id = $1 AND player_id = $2;
+
+-- name: ListBlockedPlayers :many
+SELECT
+    b.player_id,
+    u.first_name,
+    u.last_name,
+    b.created_at
+FROM host_blocked_players b
+INNER JOIN users u ON u.id = b.player_id
+WHERE b.host_id = $1
+ORDER BY b.created_at DESC;
+
+-- name: IsPlayerBlockedByHost :one
+SELECT EXISTS (
+    SELECT 1 FROM host_blocked_players
+    WHERE host_id = $1 AND player_id = $2
+);
+
+-- Requesting again keeps the original request
+-- name: RequestContactSharing :one
+INSERT INTO contact_share_requests (game_id, requested_by)
+VALUES ($1, $2)
+ON CONFLICT (game_id) DO UPDATE SET game_id = EXCLUDED.game_id
+RETURNING *;
+
+-- name: GetContactShareRequest :one
+SELECT * FROM contact_share_requests
+WHERE game_id = $1;
+
+-- name: GrantContactShareConsent :exec
+INSERT INTO contact_share_consents (game_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT (game_id, user_id) DO NOTHING;
+
+-- name: RevokeContactShareConsent :execrows
+DELETE FROM contact_share_consents
+WHERE game_id = $1 AND user_id = $2;
+
+-- Consenting players who are still confirmed, still have a phone number and haven't blocked the host
+-- name: ListSharedContacts :many
+SELECT
+    c.user_id,
+    u.first_name,
+    u.last_name,
+    u.phone_number,
+    c.consented_at
+FROM contact_share_consents c
+JOIN participants p ON p.game_id = c.game_id AND p.user_id = c.user_id
+JOIN users u ON u.id = c.user_id
+JOIN games g ON g.id = c.game_id
+WHERE c.game_id = $1
+AND p.status = 'confirmed'
+AND u.phone_number IS NOT NULL
+AND NOT EXISTS (
+    SELECT 1 FROM host_blocked_players b
+    WHERE b.host_id = c.user_id AND b.player_id = g.owner_id
+)
+ORDER BY u.last_name, u.first_name;
+
+-- Deletes the contact sharing requests of ended or cancelled games; consents cascade
+-- name: DeleteExpiredContactShareRequests :execrows
+DELETE FROM contact_share_requests r
+USING games g
+WHERE g.id = r.game_id
+AND (g.status = 'cancelled' OR g.start_time + make_interval(mins => g.duration_minutes) <= NOW());
+
+-- name: CreateGamePosition :exec
+INSERT INTO game_positions (game_id, name, capacity)
+VALUES ($1, $2, $3);
+
+-- name: ListGamePositions :many
+SELECT * FROM game_positions
+WHERE game_id = $1
+ORDER BY name;
+
+-- Other games the user is confirmed for whose time overlaps the given game
+-- name: ListUserOverlappingGames :many
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.start_time,
+    g.status
+FROM participants p
+JOIN games g ON g.id = p.game_id
+JOIN games target ON target.id = sqlc.arg('game_id')
+WHERE p.user_id = sqlc.arg('user_id')
+AND p.status = 'confirmed'
+AND g.id <> target.id
+AND g.status NOT IN ('cancelled', 'completed')
+AND g.start_time < target.start_time + make_interval(mins => target.duration_minutes)
+AND target.start_time < g.start_time + make_interval(mins => g.duration_minutes)
+ORDER BY g.start_time ASC;
+
+-- Sets the given mutes, leaving NULL ones unchanged
+-- name: UpsertGameNotificationSettings :one
+INSERT INTO game_notification_settings (game_id, user_id, reminders_muted, chat_muted)
+VALUES (
+    sqlc.arg('game_id'),
+    sqlc.arg('user_id'),
+    COALESCE(sqlc.narg('reminders_muted')::bool, FALSE),
+    COALESCE(sqlc.narg('chat_muted')::bool, FALSE)
+)
+ON CONFLICT (game_id, user_id) DO UPDATE SET
+    reminders_muted = COALESCE(sqlc.narg('reminders_muted')::bool, game_notification_settings.reminders_muted),
+    chat_muted = COALESCE(sqlc.narg('chat_muted')::bool, game_notification_settings.chat_muted),
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListGameNotificationSettings :many
+SELECT * FROM game_notification_settings
+WHERE game_id = $1;
+
+-- name: GetNotificationPreferences :one
+SELECT * FROM user_notification_preferences
+WHERE user_id = $1;
+
+-- Sets the given preferences, leaving NULL ones unchanged
+-- name: UpsertNotificationPreferences :one
+INSERT INTO user_notification_preferences (user_id, sms_enabled, email_enabled)
+VALUES (
+    sqlc.arg('user_id'),
+    COALESCE(sqlc.narg('sms_enabled')::bool, FALSE),
+    COALESCE(sqlc.narg('email_enabled')::bool, TRUE)
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    sms_enabled = COALESCE(sqlc.narg('sms_enabled')::bool, user_notification_preferences.sms_enabled),
+    email_enabled = COALESCE(sqlc.narg('email_enabled')::bool, user_notification_preferences.email_enabled),
+    updated_at = NOW()
+RETURNING *;
+
+-- The number to text a user at: only set when they opted in to SMS and their phone is verified
+-- name: GetSMSNumber :one
+SELECT u.phone_number FROM users u
+JOIN user_notification_preferences p ON p.user_id = u.id
+WHERE u.id = $1
+AND p.sms_enabled
+AND u.phone_verified_at IS NOT NULL;
+
+-- name: GetAttendance :one
+SELECT * FROM attendance
+WHERE game_id = $1 AND user_id = $2;
+
+-- name: CreateParticipationCorrection :one
+INSERT INTO participation_corrections (game_id, user_id, corrected_by, field, old_value, new_value, reason)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListParticipationCorrections :many
+SELECT * FROM participation_corrections
+WHERE game_id = $1
+ORDER BY created_at DESC;
+
+-- Moves participants ahead of everyone else in the game's line, keeping their order in participant_ids
+-- name: MoveParticipantsToFrontOfLine :exec
+UPDATE participants p
+SET waitlist_rank = line.front - (
+    cardinality(sqlc.arg('participant_ids')::uuid[]) - array_position(sqlc.arg('participant_ids')::uuid[], p.id) + 1
+)
+FROM (SELECT MIN(waitlist_rank) AS front FROM participants WHERE game_id = sqlc.arg('game_id')) line
+WHERE p.game_id = sqlc.arg('game_id')
+AND p.id = ANY(sqlc.arg('participant_ids')::uuid[]);
+
+-- Moves participants behind everyone else in the game's line, keeping their order in participant_ids
+-- name: MoveParticipantsToBackOfLine :exec
+UPDATE participants p
+SET waitlist_rank = line.back + array_position(sqlc.arg('participant_ids')::uuid[], p.id)
+FROM (
+    -- Claims the next cardinality(participant_ids) ranks so later sign-ups still land behind them
+    SELECT setval(
+        'participants_waitlist_rank_seq',
+        nextval('participants_waitlist_rank_seq') + cardinality(sqlc.arg('participant_ids')::uuid[])
+    ) - cardinality(sqlc.arg('participant_ids')::uuid[]) AS back
+) line
+WHERE p.game_id = sqlc.arg('game_id')
+AND p.id = ANY(sqlc.arg('participant_ids')::uuid[]);
+
+-- Rearranges waitlisted participants into the order of participant_ids. They trade the ranks they
+-- already hold, so the waitlist keeps its place in the line relative to everyone else.
+-- name: ReorderWaitlist :exec
+UPDATE participants p
+SET waitlist_rank = slots.rank
+FROM (
+    SELECT ordered.id, ranks.rank
+    FROM unnest(sqlc.arg('participant_ids')::uuid[]) WITH ORDINALITY AS ordered(id, ord)
+    JOIN (
+        SELECT waitlist_rank AS rank, ROW_NUMBER() OVER (ORDER BY waitlist_rank ASC) AS ord
+        FROM participants
+        WHERE game_id = sqlc.arg('game_id')
+        AND id = ANY(sqlc.arg('participant_ids')::uuid[])
+    ) ranks ON ranks.ord = ordered.ord
+) slots
+WHERE p.id = slots.id
+AND p.game_id = sqlc.arg('game_id');
+
+-- Reserving again moves the expiry and holds the spot again if it had been released
+-- name: UpsertGameReservation :one
+INSERT INTO game_reservations (game_id, user_id, reserved_by, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (game_id, user_id) DO UPDATE SET
+    reserved_by = EXCLUDED.reserved_by,
+    expires_at = EXCLUDED.expires_at,
+    released_at = NULL
+RETURNING *;
+
+-- name: DeleteGameReservation :execrows
+DELETE FROM game_reservations
+WHERE game_id = $1 AND user_id = $2;
+
+-- name: ListGameReservations :many
+SELECT
+    r.user_id,
+    r.expires_at,
+    r.created_at,
+    u.first_name,
+    u.last_name,
+    EXISTS (
+        SELECT 1 FROM participants p
+        WHERE p.game_id = r.game_id AND p.user_id = r.user_id AND p.status IN ('confirmed', 'waitlist')
+    ) AS joined
+FROM game_reservations r
+JOIN users u ON u.id = r.user_id
+WHERE r.game_id = $1
+ORDER BY r.created_at, r.user_id;
+
+-- name: HasActiveReservation :one
+SELECT EXISTS (
+    SELECT 1 FROM game_reservations
+    WHERE game_id = $1 AND user_id = $2 AND expires_at > NOW()
+);
+
+-- Spots still held for reserved players who haven't joined; expired reservations hold nothing
+-- name: CountHeldReservations :one
+SELECT COUNT(*) FROM game_reservations r
+WHERE r.game_id = $1
+AND r.expires_at > NOW()
+AND NOT EXISTS (
+    SELECT 1 FROM participants p
+    WHERE p.game_id = r.game_id AND p.user_id = r.user_id AND p.status IN ('confirmed', 'waitlist')
+);
+
+-- Games with reservations that expired since their roster was last reconciled
+-- name: ListGamesWithExpiredReservations :many
+SELECT DISTINCT game_id FROM game_reservations
+WHERE expires_at <= NOW() AND released_at IS NULL;
+
+-- name: ReleaseExpiredReservations :execrows
+UPDATE game_reservations
+SET released_at = NOW()
+WHERE game_id = $1 AND expires_at <= NOW() AND released_at IS NULL;
+
+-- Returns no rows if the reporter already has an open report on the target
+-- name: CreateReport :one
+INSERT INTO reports (
+    reporter_id,
+    target_type,
+    target_id,
+    reason,
+    details
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (reporter_id, target_type, target_id) WHERE status = 'open' DO NOTHING
+RETURNING *;
+
+-- name: CountReportsByReporterSince :one
+SELECT COUNT(*) FROM reports
+WHERE reporter_id = $1
+AND created_at > $2;
+
+-- Files a report from the content filter; a game already waiting for review keeps its open report
+-- name: FlagGameContent :exec
+INSERT INTO reports (
+    target_type,
+    target_id,
+    reason,
+    details
+) VALUES (
+    'game', $1, 'inappropriate', $2
+)
+ON CONFLICT (target_type, target_id) WHERE status = 'open' AND reporter_id IS NULL
+DO UPDATE SET details = EXCLUDED.details;
+
+-- name: ListReports :many
+SELECT * FROM reports
+WHERE (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'))
+AND (sqlc.narg('target_type')::varchar IS NULL OR target_type = sqlc.narg('target_type'))
+ORDER BY created_at ASC, id
+LIMIT sqlc.arg('page_limit') OFFSET sqlc.arg('page_offset');
+
+-- name: UpdateReportStatus :one
+UPDATE reports
+SET
+    status = $2,
+    reviewed_by = $3,
+    reviewed_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- Suspending an already suspended user replaces the reason
+-- name: SuspendUser :one
+INSERT INTO user_suspensions (
+    user_id,
+    suspended_by,
+    reason
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    suspended_by = EXCLUDED.suspended_by,
+    reason = EXCLUDED.reason,
+    created_at = NOW()
+RETURNING *;
+
+-- name: UnsuspendUser :execrows
+DELETE FROM user_suspensions
+WHERE user_id = $1;
+
+-- Shadow-banning an already shadow-banned user replaces the reason
+-- name: ShadowBanUser :one
+INSERT INTO user_shadow_bans (
+    user_id,
+    banned_by,
+    reason
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    banned_by = EXCLUDED.banned_by,
+    reason = EXCLUDED.reason,
+    created_at = NOW()
+RETURNING *;
+
+-- name: LiftShadowBan :execrows
+DELETE FROM user_shadow_bans
+WHERE user_id = $1;
+
+-- name: IsUserShadowBanned :one
+SELECT EXISTS (
+    SELECT 1 FROM user_shadow_bans
+    WHERE user_id = $1
+);
+
+-- name: CreateAdminAuditEntry :exec
+INSERT INTO admin_audit (
+    actor_id,
+    action,
+    target_type,
+    target_id,
+    game_id,
+    reason
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- Filtering by target also matches entries taken within that game (e.g. removing one of its players)
+-- name: ListAdminAudit :many
+SELECT * FROM admin_audit
+WHERE (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id'))
+AND (sqlc.narg('target_id')::uuid IS NULL OR target_id = sqlc.narg('target_id') OR game_id = sqlc.narg('target_id'))
+AND (sqlc.narg('action')::varchar IS NULL OR action = sqlc.narg('action'))
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg('page_limit') OFFSET sqlc.arg('page_offset');
+
+-- name: ListUserSportPreferences :many
+SELECT * FROM user_sport_preferences
+WHERE user_id = $1
+ORDER BY category;
+
+-- Replaces the user's sport preferences with the given categories; skill_levels pairs with categories,
+-- an empty string meaning no level
+-- name: ReplaceUserSportPreferences :exec
+WITH removed AS (
+    DELETE FROM user_sport_preferences
+    WHERE user_id = sqlc.arg('user_id')
+    AND category <> ALL(sqlc.arg('categories')::varchar[])
+)
+INSERT INTO user_sport_preferences (user_id, category, skill_level)
+SELECT sqlc.arg('user_id'), category, NULLIF(skill_level, '')
+FROM unnest(sqlc.arg('categories')::varchar[], sqlc.arg('skill_levels')::varchar[]) AS p(category, skill_level)
+ON CONFLICT (user_id, category) DO UPDATE
+SET skill_level = EXCLUDED.skill_level,
+    updated_at = NOW();
+
+-- name: GetUserSettings :one
+SELECT * FROM user_settings
+WHERE user_id = $1;
+
+-- name: UpsertUserSettings :one
+INSERT INTO user_settings (user_id, home_latitude, home_longitude, home_place_id, home_name, default_radius_meters)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id) DO UPDATE
+SET home_latitude = EXCLUDED.home_latitude,
+    home_longitude = EXCLUDED.home_longitude,
+    home_place_id = EXCLUDED.home_place_id,
+    home_name = EXCLUDED.home_name,
+    default_radius_meters = EXCLUDED.default_radius_meters,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: SaveUserOnboarding :one
+INSERT INTO user_settings (user_id, default_radius_meters, availability_windows, timezone, onboarded_at)
+VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET default_radius_meters = EXCLUDED.default_radius_meters,
+    availability_windows = EXCLUDED.availability_windows,
+    timezone = EXCLUDED.timezone,
+    onboarded_at = NOW(),
+    updated_at = NOW()
+RETURNING *;
+
+-- name: CreateReferralCode :one
+-- Returns no rows when the user already has a code or the code is taken
+INSERT INTO referral_codes (user_id, code)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: GetReferralCodeByUser :one
+SELECT * FROM referral_codes
+WHERE user_id = $1;
+
+-- name: GetReferralCodeByCode :one
+SELECT * FROM referral_codes
+WHERE code = $1;
+
+-- name: CreateReferral :exec
+INSERT INTO referrals (referred_id, referrer_id, code)
+VALUES ($1, $2, $3)
+ON CONFLICT (referred_id) DO NOTHING;
+
+-- name: CountReferrals :one
+SELECT COUNT(*) FROM referrals
+WHERE referrer_id = $1;
+
+-- name: ListRecentReferrals :many
+SELECT u.first_name, r.created_at
+FROM referrals r
+JOIN users u ON u.id = r.referred_id
+WHERE r.referrer_id = $1
+ORDER BY r.created_at DESC
+LIMIT $2;
+
+-- name: RecordCreditTransaction :one
+-- Moves the user's balance by amount_cents and appends the ledger entry in one statement. The
+-- balance check rejects spends that would overdraw the wallet.
+WITH wallet AS (
+    INSERT INTO credit_wallets (user_id, balance_cents)
+    VALUES (@user_id, @amount_cents::INTEGER)
+    ON CONFLICT (user_id) DO UPDATE
+    SET balance_cents = credit_wallets.balance_cents + EXCLUDED.balance_cents,
+        updated_at = NOW()
+    RETURNING balance_cents
+)
+INSERT INTO credit_transactions (user_id, amount_cents, kind, game_id, balance_after_cents)
+SELECT @user_id, @amount_cents::INTEGER, @kind, sqlc.narg('game_id'), wallet.balance_cents
+FROM wallet
+RETURNING *;
+
+-- name: GetCreditBalance :one
+SELECT balance_cents FROM credit_wallets
+WHERE user_id = $1;
+
+-- name: GetCreditBalanceForUpdate :one
+SELECT balance_cents FROM credit_wallets
+WHERE user_id = $1
+FOR UPDATE;
+
+-- name: ListCreditTransactions :many
+SELECT * FROM credit_transactions
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: GetGameCreditsSpent :one
+-- Credit the user has spent on the game and not had refunded
+SELECT COALESCE(-SUM(amount_cents), 0)::INTEGER AS spent_cents
+FROM credit_transactions
+WHERE user_id = $1 AND game_id = $2;
+
+-- name: ListGameCreditSpenders :many
+-- Users with credit spent on the game that hasn't been refunded yet
+SELECT user_id, (-SUM(amount_cents))::INTEGER AS spent_cents
+FROM credit_transactions
+WHERE game_id = $1
+GROUP BY user_id
+HAVING SUM(amount_cents) < 0;
+
+-- Deactivating an already deactivated account keeps the original time
+-- name: DeactivateUser :one
+INSERT INTO user_deactivations (user_id)
+VALUES ($1)
+ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
+RETURNING *;
+
+-- name: ReactivateUser :execrows
+DELETE FROM user_deactivations
+WHERE user_id = $1;
+
+-- name: IsUserDeactivated :one
+SELECT EXISTS (
+    SELECT 1 FROM user_deactivations
+    WHERE user_id = $1
+) AS deactivated;
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (owner_id, url, secret, event_types)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE owner_id = $1
+ORDER BY created_at;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND owner_id = $2;
+
+-- Queues the event for each of the owner's subscriptions that asked for its type
+-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+SELECT id, sqlc.arg('event_type')::text, sqlc.arg('payload')::jsonb
+FROM webhook_subscriptions
+WHERE owner_id = sqlc.arg('owner_id')
+AND sqlc.arg('event_type')::text = ANY(event_types);
+
+-- Leases due deliveries the same way ClaimDueSideEffects does, returning where each one goes
+-- name: ClaimDueWebhookDeliveries :many
+WITH claimed AS (
+    UPDATE webhook_deliveries
+    SET
+        attempts = attempts + 1,
+        next_attempt_at = NOW() + make_interval(secs => sqlc.arg('lease_seconds')::int)
+    WHERE webhook_deliveries.id IN (
+        SELECT id FROM webhook_deliveries
+        WHERE status = 'pending'
+        AND next_attempt_at <= NOW()
+        ORDER BY next_attempt_at
+        LIMIT sqlc.arg('batch_size')::int
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING *
+)
+SELECT
+    claimed.id,
+    claimed.subscription_id,
+    claimed.event_type,
+    claimed.payload,
+    claimed.attempts,
+    s.url,
+    s.secret
+FROM claimed
+JOIN webhook_subscriptions s ON s.id = claimed.subscription_id;
+
+-- name: CompleteWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET
+    status = 'delivered',
+    last_error = NULL,
+    delivered_at = NOW()
+WHERE id = $1;
+
+-- name: RescheduleWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET
+    last_error = $2,
+    next_attempt_at = $3,
+    attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+WHERE id = $1;
+
+-- Marks a webhook delivery failed and copies it to dead_letters
+-- name: FailWebhookDelivery :exec
+WITH failed AS (
+    UPDATE webhook_deliveries
+    SET
+        status = 'failed',
+        last_error = $2,
+        attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+    WHERE id = $1
+    RETURNING *
+)
+INSERT INTO dead_letters (source, source_id, kind, payload, attempts, attempt_history, last_error)
+SELECT 'webhook_delivery', id, event_type, jsonb_build_object('subscriptionId', subscription_id, 'event', payload), attempts, attempt_history, last_error
+FROM failed;
+
+-- Records a notification about to be sent. It is leased from the start, so the retry job only
+-- picks it up if the first attempt never reports back.
+-- name: CreateNotificationDelivery :one
+INSERT INTO notification_deliveries (channel, recipient, summary, payload, next_attempt_at)
+VALUES ($1, $2, $3, $4, NOW() + make_interval(secs => sqlc.arg('lease_seconds')::int))
+RETURNING id;
+
+-- name: ClaimDueNotificationDeliveries :many
+UPDATE notification_deliveries
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => sqlc.arg('lease_seconds')::int)
+WHERE id IN (
+    SELECT id FROM notification_deliveries
+    WHERE status = 'queued'
+    AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT sqlc.arg('batch_size')::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkNotificationDeliverySent :exec
+UPDATE notification_deliveries
+SET
+    status = 'sent',
+    payload = NULL,
+    last_error = NULL,
+    sent_at = NOW()
+WHERE id = $1;
+
+-- name: RescheduleNotificationDelivery :exec
+UPDATE notification_deliveries
+SET
+    last_error = $2,
+    next_attempt_at = $3,
+    attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+WHERE id = $1;
+
+-- Finishes a delivery that won't be retried, as failed or bounced
+-- name: FailNotificationDelivery :exec
+UPDATE notification_deliveries
+SET
+    status = $2,
+    payload = NULL,
+    last_error = $3,
+    attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $3::text, 'at', NOW()))
+WHERE id = $1;
+
+-- Marks a delivery that ran out of retries failed and copies it, message included, to
+-- dead_letters. The CTEs share one snapshot, so delivery still sees the payload being cleared.
+-- name: DeadLetterNotificationDelivery :exec
+WITH delivery AS (
+    SELECT * FROM notification_deliveries
+    WHERE notification_deliveries.id = $1
+),
+failed AS (
+    UPDATE notification_deliveries
+    SET
+        status = 'failed',
+        payload = NULL,
+        last_error = $2,
+        attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+    WHERE notification_deliveries.id = $1
+    RETURNING *
+)
+INSERT INTO dead_letters (source, source_id, kind, payload, attempts, attempt_history, last_error)
+SELECT
+    'notification_delivery',
+    failed.id,
+    failed.channel,
+    jsonb_build_object('recipient', failed.recipient, 'summary', failed.summary, 'message', delivery.payload),
+    failed.attempts,
+    failed.attempt_history,
+    failed.last_error
+FROM failed
+JOIN delivery ON delivery.id = failed.id;
+
+-- Deliveries to the user's account: push by user ID, email to their current address and SMS to
+-- their verified number
+-- name: ListUserNotificationDeliveries :many
+SELECT d.id, d.channel, d.recipient, d.summary, d.status, d.attempts, d.next_attempt_at, d.last_error, d.created_at, d.sent_at
+FROM notification_deliveries d
+JOIN users u ON u.id = sqlc.arg('user_id')
+WHERE (d.channel = 'push' AND d.recipient = u.id::text)
+OR (d.channel = 'email' AND lower(d.recipient) = lower(u.email))
+OR (d.channel = 'sms' AND d.recipient = u.phone_number)
+ORDER BY d.created_at DESC
+LIMIT sqlc.arg('max_results')::int;
+
+-- name: DeleteOldNotificationDeliveries :execrows
+DELETE FROM notification_deliveries
+WHERE created_at < NOW() - INTERVAL '30 days';
+
+-- Claims the next run of a job unless another replica holds its lease or started it less than
+-- min_gap_seconds ago. Affects no rows when the run isn't ours.
+-- name: ClaimJobRun :execrows
+INSERT INTO job_runs (name, last_run_by, last_started_at, locked_until)
+VALUES (sqlc.arg('name'), sqlc.arg('run_by'), NOW(), NOW() + make_interval(secs => sqlc.arg('lease_seconds')::int))
+ON CONFLICT (name) DO UPDATE
+SET
+    last_run_by = EXCLUDED.last_run_by,
+    last_started_at = EXCLUDED.last_started_at,
+    locked_until = EXCLUDED.locked_until
+WHERE job_runs.locked_until <= NOW()
+AND job_runs.last_started_at <= NOW() - make_interval(secs => sqlc.arg('min_gap_seconds')::float8);
+
+-- name: FinishJobRun :exec
+UPDATE job_runs
+SET
+    locked_until = NOW(),
+    last_finished_at = NOW(),
+    last_error = $3
+WHERE name = $1 AND last_run_by = $2;
+
+-- Newest first, optionally from one source and without the ones already re-enqueued
+-- name: ListDeadLetters :many
+SELECT * FROM dead_letters
+WHERE (sqlc.narg('source')::varchar IS NULL OR source = sqlc.narg('source'))
+AND (sqlc.arg('include_requeued')::boolean OR requeued_at IS NULL)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('max_results')::int;
+
+-- name: GetDeadLetterForUpdate :one
+SELECT * FROM dead_letters
+WHERE id = $1
+FOR UPDATE;
+
+-- name: MarkDeadLetterRequeued :one
+UPDATE dead_letters
+SET
+    requeued_at = NOW(),
+    requeued_by = $2
+WHERE id = $1
+RETURNING *;
+
+-- Queues a dead side effect again unless its game has since been deleted
+-- name: RequeueSideEffect :execrows
+INSERT INTO side_effects (kind, game_id)
+SELECT sqlc.arg('kind')::text, id
+FROM games
+WHERE id = sqlc.arg('game_id');
+
+-- Queues a dead webhook delivery again unless its subscription has since been deleted
+-- name: RequeueWebhookDelivery :execrows
+INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+SELECT id, sqlc.arg('event_type')::text, sqlc.arg('payload')::jsonb
+FROM webhook_subscriptions
+WHERE id = sqlc.arg('subscription_id');
+
+-- Queues a dead notification again, due now and with no attempts made
+-- name: RequeueNotificationDelivery :exec
+INSERT INTO notification_deliveries (channel, recipient, summary, payload, attempts, next_attempt_at)
+VALUES ($1, $2, $3, $4, 0, NOW());
+
+-- name: DeleteOldDeadLetters :execrows
+DELETE FROM dead_letters
+WHERE created_at < NOW() - INTERVAL '30 days';
+
+-- name: EnqueueBrokerEvent :exec
+INSERT INTO broker_events (id, event_type, partition_key, payload)
+VALUES ($1, $2, $3, $4);
+
+-- Leases due broker events the same way ClaimDueSideEffects does, oldest first so a backlog goes
+-- out in the order it happened
+-- name: ClaimDueBrokerEvents :many
+UPDATE broker_events
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => sqlc.arg('lease_seconds')::int)
+WHERE id IN (
+    SELECT id FROM broker_events
+    WHERE status = 'pending'
+    AND next_attempt_at <= NOW()
+    ORDER BY created_at
+    LIMIT sqlc.arg('batch_size')::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteBrokerEvent :exec
+UPDATE broker_events
+SET
+    status = 'published',
+    last_error = NULL,
+    published_at = NOW()
+WHERE id = $1;
+
+-- name: RescheduleBrokerEvent :exec
+UPDATE broker_events
+SET
+    last_error = $2,
+    next_attempt_at = $3
+WHERE id = $1;
+
+-- name: FailBrokerEvent :exec
+UPDATE broker_events
+SET
+    status = 'failed',
+    last_error = $2
+WHERE id = $1;
+
+-- name: DeleteOldBrokerEvents :execrows
+DELETE FROM broker_events
+WHERE status <> 'pending'
+AND created_at < NOW() - INTERVAL '7 days';
+
+-- name: CreateGameAnnouncement :one
+INSERT INTO game_announcements (game_id, author_id, message)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: ListGameAnnouncements :many
+SELECT * FROM game_announcements
+WHERE game_id = $1
+ORDER BY created_at DESC;
+
+-- Starting a conversation that already exists returns it
+-- name: CreateConversation :one
+INSERT INTO conversations (game_id, host_id, player_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (game_id, player_id) DO UPDATE SET game_id = conversations.game_id
+RETURNING *;
+
+-- name: GetConversation :one
+SELECT * FROM conversations
+WHERE id = $1;
+
+-- The user's conversations, latest activity first, each with its game, the other user, the last
+-- message and how many messages the user hasn't read. Narrowed to one by conversation_id.
+-- name: ListConversations :many
+SELECT
+    c.id, c.game_id, g.title AS game_title, g.category AS game_category, g.start_time AS game_start_time,
+    c.host_id, other.id AS other_user_id, other.first_name AS other_first_name, other.last_name AS other_last_name,
+    last_message.body AS last_message_body, last_message.sender_id AS last_message_sender_id, c.last_message_at,
+    (
+        SELECT COUNT(*) FROM conversation_messages m
+        WHERE m.conversation_id = c.id
+        AND m.sender_id <> sqlc.arg('user_id')
+        AND m.created_at > COALESCE(CASE WHEN c.host_id = sqlc.arg('user_id') THEN c.host_read_at ELSE c.player_read_at END, '-infinity')
+    ) AS unread_count,
+    c.created_at
+FROM conversations c
+INNER JOIN games g ON g.id = c.game_id
+INNER JOIN users other ON other.id = CASE WHEN c.host_id = sqlc.arg('user_id') THEN c.player_id ELSE c.host_id END
+LEFT JOIN LATERAL (
+    SELECT body, sender_id FROM conversation_messages
+    WHERE conversation_id = c.id
+    ORDER BY created_at DESC
+    LIMIT 1
+) last_message ON TRUE
+WHERE (c.host_id = sqlc.arg('user_id') OR c.player_id = sqlc.arg('user_id'))
+AND (sqlc.narg('conversation_id')::uuid IS NULL OR c.id = sqlc.narg('conversation_id'))
+ORDER BY COALESCE(c.last_message_at, c.created_at) DESC
+LIMIT 100;
+
+-- name: CreateConversationMessage :one
+INSERT INTO conversation_messages (conversation_id, sender_id, body)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- Records a new message, which the sender has read
+-- name: TouchConversation :exec
+UPDATE conversations
+SET
+    last_message_at = sqlc.arg('sent_at'),
+    host_read_at = CASE WHEN host_id = sqlc.arg('sender_id') THEN sqlc.arg('sent_at') ELSE host_read_at END,
+    player_read_at = CASE WHEN player_id = sqlc.arg('sender_id') THEN sqlc.arg('sent_at') ELSE player_read_at END
+WHERE id = sqlc.arg('id');
+
+-- Newest first, optionally only those sent before a time for paging back
+-- name: ListConversationMessages :many
+SELECT * FROM conversation_messages
+WHERE conversation_id = sqlc.arg('conversation_id')
+AND (sqlc.narg('before')::timestamptz IS NULL OR created_at < sqlc.narg('before'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('max_results')::int;
+
+-- name: MarkConversationRead :exec
+UPDATE conversations
+SET
+    host_read_at = CASE WHEN host_id = sqlc.arg('user_id') THEN NOW() ELSE host_read_at END,
+    player_read_at = CASE WHEN player_id = sqlc.arg('user_id') THEN NOW() ELSE player_read_at END
+WHERE id = sqlc.arg('id');
+
+-- name: CreateGameChatMessage :one
+INSERT INTO game_chat_messages (game_id, sender_id, body)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- Newest first with the sender's name, optionally only those sent before a time for paging back
+-- name: ListGameChatMessages :many
+SELECT m.id, m.game_id, m.sender_id, u.first_name AS sender_first_name, u.last_name AS sender_last_name, m.body, m.created_at
+FROM game_chat_messages m
+LEFT JOIN users u ON u.id = m.sender_id
+WHERE m.game_id = sqlc.arg('game_id')
+AND (sqlc.narg('before')::timestamptz IS NULL OR m.created_at < sqlc.narg('before'))
+ORDER BY m.created_at DESC
+LIMIT sqlc.arg('max_results')::int;
+
+-- name: CreateGameComment :one
+INSERT INTO game_comments (game_id, parent_id, author_id, body)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetGameComment :one
+SELECT * FROM game_comments
+WHERE id = $1 AND game_id = $2;
+
+-- Oldest first with the authors' names; the service nests replies under their threads
+-- name: ListGameComments :many
+SELECT c.id, c.parent_id, c.author_id, u.first_name AS author_first_name, u.last_name AS author_last_name,
+    c.body, c.edited_at, c.deleted_at, c.created_at
+FROM game_comments c
+LEFT JOIN users u ON u.id = c.author_id
+WHERE c.game_id = $1
+ORDER BY c.created_at
+LIMIT 500;
+
+-- name: UpdateGameCommentBody :one
+UPDATE game_comments
+SET
+    body = $2,
+    edited_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- Clears the text, which isn't kept once the comment is gone
+-- name: DeleteGameComment :exec
+UPDATE game_comments
+SET
+    body = '',
+    deleted_at = NOW(),
+    deleted_by = $2
+WHERE id = $1;
+
+-- name: ListGameTags :many
+SELECT tag FROM game_tags
+WHERE game_id = $1
+ORDER BY tag;
+
+-- name: AddGameTags :exec
+INSERT INTO game_tags (game_id, tag)
+SELECT sqlc.arg('game_id'), unnest(sqlc.arg('tags')::varchar[])
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteGameTags :exec
+DELETE FROM game_tags
+WHERE game_id = $1;
+
+-- name: CreateSavedSearch :one
+INSERT INTO saved_searches (user_id, name, filters, alerts_enabled)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListSavedSearches :many
+SELECT * FROM saved_searches
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: DeleteSavedSearch :execrows
+DELETE FROM saved_searches
+WHERE id = $1 AND user_id = $2;
+
+-- name: SetSavedSearchAlerts :one
+UPDATE saved_searches
+SET alerts_enabled = $3
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- Users with a saved search the game matches, each with their oldest matching search. A search
+-- without categories follows the user's sport preferences, like GET /v1/games. Users the game is
+-- hidden from, its host, and users already alerted about it are left out.
+-- name: ListNewGameAlertRecipients :many
+SELECT DISTINCT ON (s.user_id)
+    s.id AS saved_search_id,
+    s.name AS saved_search_name,
+    u.id AS user_id,
+    u.email,
+    u.first_name
+FROM games g
+JOIN saved_searches s ON s.alerts_enabled
+    AND ST_DWithin(s.search_point, g.location_point, (s.filters->>'radius')::float8)
+JOIN users u ON u.id = s.user_id
+WHERE g.id = $1
+  AND g.status = 'open'
+  AND s.user_id <> g.owner_id
+  AND CASE
+      WHEN jsonb_array_length(COALESCE(s.filters->'categories', '[]'::jsonb)) > 0
+          THEN s.filters->'categories' @> jsonb_build_array(g.category)
+      ELSE NOT EXISTS (SELECT 1 FROM user_sport_preferences p WHERE p.user_id = s.user_id)
+          OR EXISTS (SELECT 1 FROM user_sport_preferences p WHERE p.user_id = s.user_id AND p.category = g.category)
+  END
+  AND (jsonb_array_length(COALESCE(s.filters->'skillLevels', '[]'::jsonb)) = 0
+      OR s.filters->'skillLevels' @> jsonb_build_array(g.skill_level))
+  AND NOT (g.adult_only AND COALESCE(u.birthdate > CURRENT_DATE - INTERVAL '18 years', FALSE))
+  AND NOT EXISTS (SELECT 1 FROM host_blocked_players b WHERE b.host_id = g.owner_id AND b.player_id = s.user_id)
+  AND NOT EXISTS (SELECT 1 FROM user_deactivations d WHERE d.user_id = s.user_id)
+  AND NOT EXISTS (SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = g.owner_id)
+  AND NOT EXISTS (SELECT 1 FROM new_game_alerts a WHERE a.user_id = s.user_id AND a.game_id = g.id)
+ORDER BY s.user_id, s.created_at;
+
+-- Records an alert about to be sent, unless the user was already alerted about the game or has
+-- had max_per_day alerts in the last day
+-- name: RecordNewGameAlert :execrows
+INSERT INTO new_game_alerts (user_id, game_id, saved_search_id)
+SELECT sqlc.arg('user_id')::uuid, sqlc.arg('game_id')::uuid, sqlc.arg('saved_search_id')::uuid
+WHERE (
+    SELECT COUNT(*) FROM new_game_alerts
+    WHERE user_id = sqlc.arg('user_id') AND sent_at > NOW() - INTERVAL '1 day'
+) < sqlc.arg('max_per_day')::int
+ON CONFLICT DO NOTHING;
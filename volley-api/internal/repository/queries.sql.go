@@ -11,6 +11,22 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addGameTags = `-- name: AddGameTags :exec
+INSERT INTO game_tags (game_id, tag)
+SELECT $1, unnest($2::varchar[])
+ON CONFLICT DO NOTHING
+`
+
+type AddGameTagsParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	Tags   []string    `json:"tags"`
+}
+
+func (q *Queries) AddGameTags(ctx context.Context, arg AddGameTagsParams) error {
+	_, err := q.db.Exec(ctx, addGameTags, arg.GameID, arg.Tags)
+	return err
+}
+
 const batchUpdateParticipantsToConfirmed = `-- name: BatchUpdateParticipantsToConfirmed :exec
 UPDATE participants
 SET
@@ -37,6 +53,22 @@ func (q *Queries) BatchUpdateParticipantsToWaitlist(ctx context.Context, partici
 	return err
 }
 
+const blockPlayer = `-- name: BlockPlayer :exec
+INSERT INTO host_blocked_players (host_id, player_id)
+VALUES ($1, $2)
+ON CONFLICT (host_id, player_id) DO NOTHING
+`
+
+type BlockPlayerParams struct {
+	HostID   pgtype.UUID `json:"host_id"`
+	PlayerID pgtype.UUID `json:"player_id"`
+}
+
+func (q *Queries) BlockPlayer(ctx context.Context, arg BlockPlayerParams) error {
+	_, err := q.db.Exec(ctx, blockPlayer, arg.HostID, arg.PlayerID)
+	return err
+}
+
 const cancelGame = `-- name: CancelGame :one
 UPDATE games
 SET
@@ -53,224 +85,29 @@ func (q *Queries) CancelGame(ctx context.Context, id pgtype.UUID) (pgtype.UUID,
 	return id, err
 }
 
-const countConfirmedParticipants = `-- name: CountConfirmedParticipants :one
-SELECT COUNT(*) FROM participants
-WHERE game_id = $1 AND status = 'confirmed'
-`
-
-func (q *Queries) CountConfirmedParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error) {
-	row := q.db.QueryRow(ctx, countConfirmedParticipants, gameID)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
-}
-
-const countWaitlistParticipants = `-- name: CountWaitlistParticipants :one
-SELECT COUNT(*) FROM participants
-WHERE game_id = $1 AND status = 'waitlist'
-`
-
-func (q *Queries) CountWaitlistParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error) {
-	row := q.db.QueryRow(ctx, countWaitlistParticipants, gameID)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
-}
-
-const createGame = `-- name: CreateGame :one
-
-INSERT INTO games (
-    owner_id,
-    category,
-    title,
-    description,
-    location_name,
-    location_address,
-    location_point,
-    location_notes,
-    start_time,
-    duration_minutes,
-    max_participants,
-    pricing_type,
-    pricing_amount_cents,
-    pricing_currency,
-    signup_deadline,
-    drop_deadline,
-    skill_level,
-    notes,
-    status
-) VALUES (
-    $1,
-    $2,
-    $3,
-    $4,
-    $5,
-    $6,
-    ST_SetSRID(ST_MakePoint($7::float8, $8::float8), 4326)::geography,
-    $9,
-    $10,
-    $11,
-    $12,
-    $13,
-    $14,
-    $15,
-    $16,
-    $17,
-    $18,
-    $19,
-    $20
-)
-RETURNING id, owner_id, category, title, description, location_name, location_address,
-    ST_Y(location_point::geometry) as latitude, ST_X(location_point::geometry) as longitude,
-    location_notes, start_time, duration_minutes, max_participants,
-    0 as confirmed_count, 0 as waitlist_count,
-    pricing_type, pricing_amount_cents, pricing_currency, signup_deadline,
-    drop_deadline, skill_level, notes, status, cancelled_at, created_at, updated_at
+const cancelPendingEmailChangeRequests = `-- name: CancelPendingEmailChangeRequests :exec
+DELETE FROM email_change_requests
+WHERE user_id = $1
+AND confirmed_at IS NULL
 `
 
-type CreateGameParams struct {
-	OwnerID            pgtype.UUID        `json:"owner_id"`
-	Category           string             `json:"category"`
-	Title              pgtype.Text        `json:"title"`
-	Description        pgtype.Text        `json:"description"`
-	LocationName       string             `json:"location_name"`
-	LocationAddress    pgtype.Text        `json:"location_address"`
-	Longitude          float64            `json:"longitude"`
-	Latitude           float64            `json:"latitude"`
-	LocationNotes      pgtype.Text        `json:"location_notes"`
-	StartTime          pgtype.Timestamptz `json:"start_time"`
-	DurationMinutes    int32              `json:"duration_minutes"`
-	MaxParticipants    int32              `json:"max_participants"`
-	PricingType        string             `json:"pricing_type"`
-	PricingAmountCents int32              `json:"pricing_amount_cents"`
-	PricingCurrency    string             `json:"pricing_currency"`
-	SignupDeadline     pgtype.Timestamptz `json:"signup_deadline"`
-	DropDeadline       pgtype.Timestamptz `json:"drop_deadline"`
-	SkillLevel         string             `json:"skill_level"`
-	Notes              pgtype.Text        `json:"notes"`
-	Status             string             `json:"status"`
-}
-
-type CreateGameRow struct {
-	ID                 pgtype.UUID        `json:"id"`
-	OwnerID            pgtype.UUID        `json:"owner_id"`
-	Category           string             `json:"category"`
-	Title              pgtype.Text        `json:"title"`
-	Description        pgtype.Text        `json:"description"`
-	LocationName       string             `json:"location_name"`
-	LocationAddress    pgtype.Text        `json:"location_address"`
-	Latitude           interface{}        `json:"latitude"`
-	Longitude          interface{}        `json:"longitude"`
-	LocationNotes      pgtype.Text        `json:"location_notes"`
-	StartTime          pgtype.Timestamptz `json:"start_time"`
-	DurationMinutes    int32              `json:"duration_minutes"`
-	MaxParticipants    int32              `json:"max_participants"`
-	ConfirmedCount     int32              `json:"confirmed_count"`
-	WaitlistCount      int32              `json:"waitlist_count"`
-	PricingType        string             `json:"pricing_type"`
-	PricingAmountCents int32              `json:"pricing_amount_cents"`
-	PricingCurrency    string             `json:"pricing_currency"`
-	SignupDeadline     pgtype.Timestamptz `json:"signup_deadline"`
-	DropDeadline       pgtype.Timestamptz `json:"drop_deadline"`
-	SkillLevel         string             `json:"skill_level"`
-	Notes              pgtype.Text        `json:"notes"`
-	Status             string             `json:"status"`
-	CancelledAt        pgtype.Timestamptz `json:"cancelled_at"`
-	CreatedAt          pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
-}
-
-// Game queries
-func (q *Queries) CreateGame(ctx context.Context, arg CreateGameParams) (CreateGameRow, error) {
-	row := q.db.QueryRow(ctx, createGame,
-		arg.OwnerID,
-		arg.Category,
-		arg.Title,
-		arg.Description,
-		arg.LocationName,
-		arg.LocationAddress,
-		arg.Longitude,
-		arg.Latitude,
-		arg.LocationNotes,
-		arg.StartTime,
-		arg.DurationMinutes,
-		arg.MaxParticipants,
-		arg.PricingType,
-		arg.PricingAmountCents,
-		arg.PricingCurrency,
-		arg.SignupDeadline,
-		arg.DropDeadline,
-		arg.SkillLevel,
-		arg.Notes,
-		arg.Status,
-	)
-	var i CreateGameRow
-	err := row.Scan(
-		&i.ID,
-		&i.OwnerID,
-		&i.Category,
-		&i.Title,
-		&i.Description,
-		&i.LocationName,
-		&i.LocationAddress,
-		&i.Latitude,
-		&i.Longitude,
-		&i.LocationNotes,
-		&i.StartTime,
-		&i.DurationMinutes,
-		&i.MaxParticipants,
-		&i.ConfirmedCount,
-		&i.WaitlistCount,
-		&i.PricingType,
-		&i.PricingAmountCents,
-		&i.PricingCurrency,
-		&i.SignupDeadline,
-		&i.DropDeadline,
-		&i.SkillLevel,
-		&i.Notes,
-		&i.Status,
-		&i.CancelledAt,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+// Earlier links stop working once a newer change is requested or one is confirmed
+func (q *Queries) CancelPendingEmailChangeRequests(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, cancelPendingEmailChangeRequests, userID)
+	return err
 }
 
-const createParticipant = `-- name: CreateParticipant :one
-INSERT INTO participants (
-    game_id,
-    user_id,
-    team_id,
-    status,
-    paid,
-    payment_amount_cents,
-    notes
-) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
-)
-RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at
+const checkInParticipant = `-- name: CheckInParticipant :one
+UPDATE participants
+SET
+    checked_in_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
 `
 
-type CreateParticipantParams struct {
-	GameID             pgtype.UUID `json:"game_id"`
-	UserID             pgtype.UUID `json:"user_id"`
-	TeamID             pgtype.UUID `json:"team_id"`
-	Status             string      `json:"status"`
-	Paid               bool        `json:"paid"`
-	PaymentAmountCents pgtype.Int4 `json:"payment_amount_cents"`
-	Notes              pgtype.Text `json:"notes"`
-}
-
-func (q *Queries) CreateParticipant(ctx context.Context, arg CreateParticipantParams) (Participant, error) {
-	row := q.db.QueryRow(ctx, createParticipant,
-		arg.GameID,
-		arg.UserID,
-		arg.TeamID,
-		arg.Status,
-		arg.Paid,
-		arg.PaymentAmountCents,
-		arg.Notes,
-	)
+func (q *Queries) CheckInParticipant(ctx context.Context, id pgtype.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, checkInParticipant, id)
 	var i Participant
 	err := row.Scan(
 		&i.ID,
@@ -283,879 +120,7159 @@ func (q *Queries) CreateParticipant(ctx context.Context, arg CreateParticipantPa
 		&i.Notes,
 		&i.JoinedAt,
 		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
 	)
 	return i, err
 }
 
-const createRefreshToken = `-- name: CreateRefreshToken :one
+const claimDueBrokerEvents = `-- name: ClaimDueBrokerEvents :many
+UPDATE broker_events
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => $1::int)
+WHERE id IN (
+    SELECT id FROM broker_events
+    WHERE status = 'pending'
+    AND next_attempt_at <= NOW()
+    ORDER BY created_at
+    LIMIT $2::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, event_type, partition_key, payload, status, attempts, next_attempt_at, last_error, created_at, published_at
+`
 
-INSERT INTO refresh_tokens (
-    user_id,
-    token_hash,
-    device_info,
-    expires_at
-) VALUES (
-    $1, $2, $3, $4
+type ClaimDueBrokerEventsParams struct {
+	LeaseSeconds int32 `json:"lease_seconds"`
+	BatchSize    int32 `json:"batch_size"`
+}
+
+// Leases due broker events the same way ClaimDueSideEffects does, oldest first so a backlog goes
+// out in the order it happened
+func (q *Queries) ClaimDueBrokerEvents(ctx context.Context, arg ClaimDueBrokerEventsParams) ([]BrokerEvent, error) {
+	rows, err := q.db.Query(ctx, claimDueBrokerEvents, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BrokerEvent{}
+	for rows.Next() {
+		var i BrokerEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.PartitionKey,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimDueNotificationDeliveries = `-- name: ClaimDueNotificationDeliveries :many
+UPDATE notification_deliveries
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => $1::int)
+WHERE id IN (
+    SELECT id FROM notification_deliveries
+    WHERE status = 'queued'
+    AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT $2::int
+    FOR UPDATE SKIP LOCKED
 )
-RETURNING id, user_id, token_hash, device_info, expires_at, created_at, revoked_at
+RETURNING id, channel, recipient, summary, payload, status, attempts, next_attempt_at, last_error, created_at, sent_at, attempt_history
 `
 
-type CreateRefreshTokenParams struct {
-	UserID     pgtype.UUID        `json:"user_id"`
-	TokenHash  string             `json:"token_hash"`
-	DeviceInfo pgtype.Text        `json:"device_info"`
-	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+type ClaimDueNotificationDeliveriesParams struct {
+	LeaseSeconds int32 `json:"lease_seconds"`
+	BatchSize    int32 `json:"batch_size"`
 }
 
-// Refresh token queries
-func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error) {
-	row := q.db.QueryRow(ctx, createRefreshToken,
-		arg.UserID,
-		arg.TokenHash,
-		arg.DeviceInfo,
-		arg.ExpiresAt,
-	)
-	var i RefreshToken
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.TokenHash,
-		&i.DeviceInfo,
-		&i.ExpiresAt,
-		&i.CreatedAt,
-		&i.RevokedAt,
-	)
-	return i, err
+func (q *Queries) ClaimDueNotificationDeliveries(ctx context.Context, arg ClaimDueNotificationDeliveriesParams) ([]NotificationDelivery, error) {
+	rows, err := q.db.Query(ctx, claimDueNotificationDeliveries, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NotificationDelivery{}
+	for rows.Next() {
+		var i NotificationDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.Channel,
+			&i.Recipient,
+			&i.Summary,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.SentAt,
+			&i.AttemptHistory,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const createTeam = `-- name: CreateTeam :one
-INSERT INTO teams (
-    game_id,
-    name,
-    color
-) VALUES (
-    $1, $2, $3
+const claimDueSideEffects = `-- name: ClaimDueSideEffects :many
+UPDATE side_effects
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => $1::int)
+WHERE id IN (
+    SELECT id FROM side_effects
+    WHERE status = 'pending'
+    AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT $2::int
+    FOR UPDATE SKIP LOCKED
 )
-RETURNING id, game_id, name, color, created_at
+RETURNING id, kind, game_id, status, attempts, next_attempt_at, last_error, created_at, completed_at, attempt_history
 `
 
-type CreateTeamParams struct {
-	GameID pgtype.UUID `json:"game_id"`
-	Name   string      `json:"name"`
-	Color  pgtype.Text `json:"color"`
+type ClaimDueSideEffectsParams struct {
+	LeaseSeconds int32 `json:"lease_seconds"`
+	BatchSize    int32 `json:"batch_size"`
 }
 
-func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error) {
-	row := q.db.QueryRow(ctx, createTeam, arg.GameID, arg.Name, arg.Color)
-	var i Team
-	err := row.Scan(
-		&i.ID,
-		&i.GameID,
-		&i.Name,
-		&i.Color,
-		&i.CreatedAt,
-	)
-	return i, err
+// Leases due side effects by pushing next_attempt_at past the lease, so a crashed worker's claims
+// become due again and concurrent workers skip rows already being claimed
+func (q *Queries) ClaimDueSideEffects(ctx context.Context, arg ClaimDueSideEffectsParams) ([]SideEffect, error) {
+	rows, err := q.db.Query(ctx, claimDueSideEffects, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SideEffect{}
+	for rows.Next() {
+		var i SideEffect
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.GameID,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.CompletedAt,
+			&i.AttemptHistory,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const createUser = `-- name: CreateUser :one
-
-INSERT INTO users (
-    email,
-    first_name,
-    last_name,
-    password_hash
-) VALUES (
-    $1, $2, $3, $4
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+WITH claimed AS (
+    UPDATE webhook_deliveries
+    SET
+        attempts = attempts + 1,
+        next_attempt_at = NOW() + make_interval(secs => $1::int)
+    WHERE webhook_deliveries.id IN (
+        SELECT id FROM webhook_deliveries
+        WHERE status = 'pending'
+        AND next_attempt_at <= NOW()
+        ORDER BY next_attempt_at
+        LIMIT $2::int
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING id, subscription_id, event_type, payload, status, attempts, next_attempt_at, last_error, created_at, delivered_at, attempt_history
 )
-RETURNING id, email, first_name, last_name, password_hash, created_at
+SELECT
+    claimed.id,
+    claimed.subscription_id,
+    claimed.event_type,
+    claimed.payload,
+    claimed.attempts,
+    s.url,
+    s.secret
+FROM claimed
+JOIN webhook_subscriptions s ON s.id = claimed.subscription_id
 `
 
-type CreateUserParams struct {
-	Email        string `json:"email"`
-	FirstName    string `json:"first_name"`
-	LastName     string `json:"last_name"`
-	PasswordHash string `json:"password_hash"`
+type ClaimDueWebhookDeliveriesParams struct {
+	LeaseSeconds int32 `json:"lease_seconds"`
+	BatchSize    int32 `json:"batch_size"`
 }
 
-// User queries
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, createUser,
-		arg.Email,
-		arg.FirstName,
-		arg.LastName,
-		arg.PasswordHash,
-	)
-	var i User
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.FirstName,
-		&i.LastName,
-		&i.PasswordHash,
-		&i.CreatedAt,
+type ClaimDueWebhookDeliveriesRow struct {
+	ID             pgtype.UUID `json:"id"`
+	SubscriptionID pgtype.UUID `json:"subscription_id"`
+	EventType      string      `json:"event_type"`
+	Payload        []byte      `json:"payload"`
+	Attempts       int32       `json:"attempts"`
+	Url            string      `json:"url"`
+	Secret         string      `json:"secret"`
+}
+
+// Leases due deliveries the same way ClaimDueSideEffects does, returning where each one goes
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClaimDueWebhookDeliveriesRow{}
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimJobRun = `-- name: ClaimJobRun :execrows
+INSERT INTO job_runs (name, last_run_by, last_started_at, locked_until)
+VALUES ($1, $2, NOW(), NOW() + make_interval(secs => $3::int))
+ON CONFLICT (name) DO UPDATE
+SET
+    last_run_by = EXCLUDED.last_run_by,
+    last_started_at = EXCLUDED.last_started_at,
+    locked_until = EXCLUDED.locked_until
+WHERE job_runs.locked_until <= NOW()
+AND job_runs.last_started_at <= NOW() - make_interval(secs => $4::float8)
+`
+
+type ClaimJobRunParams struct {
+	Name          string  `json:"name"`
+	RunBy         string  `json:"run_by"`
+	LeaseSeconds  int32   `json:"lease_seconds"`
+	MinGapSeconds float64 `json:"min_gap_seconds"`
+}
+
+// Claims the next run of a job unless another replica holds its lease or started it less than
+// min_gap_seconds ago. Affects no rows when the run isn't ours.
+func (q *Queries) ClaimJobRun(ctx context.Context, arg ClaimJobRunParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimJobRun,
+		arg.Name,
+		arg.RunBy,
+		arg.LeaseSeconds,
+		arg.MinGapSeconds,
 	)
-	return i, err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const deleteExpiredRefreshTokens = `-- name: DeleteExpiredRefreshTokens :exec
-DELETE FROM refresh_tokens
-WHERE expires_at < NOW()
+const clearFailedLoginsByEmail = `-- name: ClearFailedLoginsByEmail :exec
+DELETE FROM failed_logins
+WHERE email = $1
 `
 
-func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, deleteExpiredRefreshTokens)
+func (q *Queries) ClearFailedLoginsByEmail(ctx context.Context, email string) error {
+	_, err := q.db.Exec(ctx, clearFailedLoginsByEmail, email)
 	return err
 }
 
-const deleteGame = `-- name: DeleteGame :exec
-DELETE FROM games
-WHERE id = $1
+const closeGamesPastSignupDeadline = `-- name: CloseGamesPastSignupDeadline :execrows
+UPDATE games
+SET
+    status = 'closed',
+    updated_at = NOW()
+WHERE status IN ('open', 'full')
+AND signup_deadline <= NOW()
 `
 
-func (q *Queries) DeleteGame(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteGame, id)
-	return err
+func (q *Queries) CloseGamesPastSignupDeadline(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, closeGamesPastSignupDeadline)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const deleteParticipant = `-- name: DeleteParticipant :exec
-DELETE FROM participants
+const completeBrokerEvent = `-- name: CompleteBrokerEvent :exec
+UPDATE broker_events
+SET
+    status = 'published',
+    last_error = NULL,
+    published_at = NOW()
 WHERE id = $1
 `
 
-func (q *Queries) DeleteParticipant(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteParticipant, id)
+func (q *Queries) CompleteBrokerEvent(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, completeBrokerEvent, id)
 	return err
 }
 
-const deleteTeam = `-- name: DeleteTeam :exec
-DELETE FROM teams
-WHERE id = $1
+const completeFinishedGames = `-- name: CompleteFinishedGames :execrows
+UPDATE games
+SET
+    status = 'completed',
+    updated_at = NOW()
+WHERE status IN ('open', 'full', 'closed', 'in_progress')
+AND start_time + make_interval(mins => duration_minutes) <= NOW()
 `
 
-func (q *Queries) DeleteTeam(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteTeam, id)
-	return err
+func (q *Queries) CompleteFinishedGames(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, completeFinishedGames)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const deleteUser = `-- name: DeleteUser :exec
-DELETE FROM users
+const completeSideEffect = `-- name: CompleteSideEffect :exec
+UPDATE side_effects
+SET
+    status = 'done',
+    last_error = NULL,
+    completed_at = NOW()
 WHERE id = $1
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteUser, id)
+func (q *Queries) CompleteSideEffect(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, completeSideEffect, id)
 	return err
 }
 
-const getGame = `-- name: GetGame :one
-SELECT
-    g.id, g.owner_id, g.category, g.title, g.description, g.location_name, g.location_address,
-    ST_Y(g.location_point::geometry) as latitude, ST_X(g.location_point::geometry) as longitude,
-    g.location_notes, g.start_time, g.duration_minutes, g.max_participants,
-    COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'confirmed'), 0)::int as confirmed_count,
-    COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'waitlist'), 0)::int as waitlist_count,
-    g.pricing_type, g.pricing_amount_cents, g.pricing_currency, g.signup_deadline,
-    g.drop_deadline, g.skill_level, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at
-FROM games g
-LEFT JOIN participants p ON p.game_id = g.id
-WHERE g.id = $1
-GROUP BY g.id
+const completeWebhookDelivery = `-- name: CompleteWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET
+    status = 'delivered',
+    last_error = NULL,
+    delivered_at = NOW()
+WHERE id = $1
 `
 
-type GetGameRow struct {
-	ID                 pgtype.UUID        `json:"id"`
-	OwnerID            pgtype.UUID        `json:"owner_id"`
-	Category           string             `json:"category"`
-	Title              pgtype.Text        `json:"title"`
-	Description        pgtype.Text        `json:"description"`
-	LocationName       string             `json:"location_name"`
-	LocationAddress    pgtype.Text        `json:"location_address"`
-	Latitude           interface{}        `json:"latitude"`
-	Longitude          interface{}        `json:"longitude"`
-	LocationNotes      pgtype.Text        `json:"location_notes"`
-	StartTime          pgtype.Timestamptz `json:"start_time"`
-	DurationMinutes    int32              `json:"duration_minutes"`
-	MaxParticipants    int32              `json:"max_participants"`
-	ConfirmedCount     int32              `json:"confirmed_count"`
-	WaitlistCount      int32              `json:"waitlist_count"`
-	PricingType        string             `json:"pricing_type"`
-	PricingAmountCents int32              `json:"pricing_amount_cents"`
-	PricingCurrency    string             `json:"pricing_currency"`
-	SignupDeadline     pgtype.Timestamptz `json:"signup_deadline"`
-	DropDeadline       pgtype.Timestamptz `json:"drop_deadline"`
-	SkillLevel         string             `json:"skill_level"`
-	Notes              pgtype.Text        `json:"notes"`
-	Status             string             `json:"status"`
-	CancelledAt        pgtype.Timestamptz `json:"cancelled_at"`
-	CreatedAt          pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+func (q *Queries) CompleteWebhookDelivery(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, completeWebhookDelivery, id)
+	return err
 }
 
-func (q *Queries) GetGame(ctx context.Context, id pgtype.UUID) (GetGameRow, error) {
-	row := q.db.QueryRow(ctx, getGame, id)
-	var i GetGameRow
+const consumeMagicLinkToken = `-- name: ConsumeMagicLinkToken :one
+UPDATE magic_link_tokens
+SET used_at = NOW()
+WHERE token_hash = $1
+AND used_at IS NULL
+AND expires_at > NOW()
+RETURNING id, user_id, token_hash, expires_at, used_at, created_at
+`
+
+// Marks an unexpired link as used in the same statement that finds it, so a link can only be redeemed once
+func (q *Queries) ConsumeMagicLinkToken(ctx context.Context, tokenHash string) (MagicLinkToken, error) {
+	row := q.db.QueryRow(ctx, consumeMagicLinkToken, tokenHash)
+	var i MagicLinkToken
 	err := row.Scan(
 		&i.ID,
-		&i.OwnerID,
-		&i.Category,
-		&i.Title,
-		&i.Description,
-		&i.LocationName,
-		&i.LocationAddress,
-		&i.Latitude,
-		&i.Longitude,
-		&i.LocationNotes,
-		&i.StartTime,
-		&i.DurationMinutes,
-		&i.MaxParticipants,
-		&i.ConfirmedCount,
-		&i.WaitlistCount,
-		&i.PricingType,
-		&i.PricingAmountCents,
-		&i.PricingCurrency,
-		&i.SignupDeadline,
-		&i.DropDeadline,
-		&i.SkillLevel,
-		&i.Notes,
-		&i.Status,
-		&i.CancelledAt,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
 		&i.CreatedAt,
-		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const getGameForUpdate = `-- name: GetGameForUpdate :one
-SELECT
-    id, owner_id, category, title, description, location_name, location_address,
-    ST_Y(location_point::geometry) as latitude, ST_X(location_point::geometry) as longitude,
-    location_notes, start_time, duration_minutes, max_participants,
-    pricing_type, pricing_amount_cents, pricing_currency, signup_deadline,
-    drop_deadline, skill_level, notes, status, cancelled_at, created_at, updated_at
-FROM games
-WHERE id = $1
-FOR UPDATE
+const consumeWebAuthnChallenge = `-- name: ConsumeWebAuthnChallenge :one
+DELETE FROM webauthn_challenges
+WHERE challenge = $1
+AND ceremony = $2
+AND expires_at > NOW()
+RETURNING id, user_id, ceremony, challenge, expires_at, created_at
 `
 
-type GetGameForUpdateRow struct {
-	ID                 pgtype.UUID        `json:"id"`
-	OwnerID            pgtype.UUID        `json:"owner_id"`
-	Category           string             `json:"category"`
-	Title              pgtype.Text        `json:"title"`
-	Description        pgtype.Text        `json:"description"`
-	LocationName       string             `json:"location_name"`
-	LocationAddress    pgtype.Text        `json:"location_address"`
-	Latitude           interface{}        `json:"latitude"`
-	Longitude          interface{}        `json:"longitude"`
-	LocationNotes      pgtype.Text        `json:"location_notes"`
-	StartTime          pgtype.Timestamptz `json:"start_time"`
-	DurationMinutes    int32              `json:"duration_minutes"`
-	MaxParticipants    int32              `json:"max_participants"`
-	PricingType        string             `json:"pricing_type"`
-	PricingAmountCents int32              `json:"pricing_amount_cents"`
-	PricingCurrency    string             `json:"pricing_currency"`
-	SignupDeadline     pgtype.Timestamptz `json:"signup_deadline"`
-	DropDeadline       pgtype.Timestamptz `json:"drop_deadline"`
-	SkillLevel         string             `json:"skill_level"`
-	Notes              pgtype.Text        `json:"notes"`
-	Status             string             `json:"status"`
-	CancelledAt        pgtype.Timestamptz `json:"cancelled_at"`
-	CreatedAt          pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+type ConsumeWebAuthnChallengeParams struct {
+	Challenge string `json:"challenge"`
+	Ceremony  string `json:"ceremony"`
 }
 
-func (q *Queries) GetGameForUpdate(ctx context.Context, id pgtype.UUID) (GetGameForUpdateRow, error) {
-	row := q.db.QueryRow(ctx, getGameForUpdate, id)
-	var i GetGameForUpdateRow
+func (q *Queries) ConsumeWebAuthnChallenge(ctx context.Context, arg ConsumeWebAuthnChallengeParams) (WebauthnChallenge, error) {
+	row := q.db.QueryRow(ctx, consumeWebAuthnChallenge, arg.Challenge, arg.Ceremony)
+	var i WebauthnChallenge
 	err := row.Scan(
 		&i.ID,
-		&i.OwnerID,
-		&i.Category,
-		&i.Title,
-		&i.Description,
-		&i.LocationName,
-		&i.LocationAddress,
-		&i.Latitude,
-		&i.Longitude,
-		&i.LocationNotes,
-		&i.StartTime,
-		&i.DurationMinutes,
-		&i.MaxParticipants,
-		&i.PricingType,
-		&i.PricingAmountCents,
-		&i.PricingCurrency,
-		&i.SignupDeadline,
-		&i.DropDeadline,
-		&i.SkillLevel,
-		&i.Notes,
-		&i.Status,
-		&i.CancelledAt,
+		&i.UserID,
+		&i.Ceremony,
+		&i.Challenge,
+		&i.ExpiresAt,
 		&i.CreatedAt,
-		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const getParticipant = `-- name: GetParticipant :one
-SELECT id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at FROM participants
-WHERE id = $1
+const countConfirmedParticipants = `-- name: CountConfirmedParticipants :one
+SELECT COUNT(*) FROM participants
+WHERE game_id = $1 AND status = 'confirmed'
 `
 
-func (q *Queries) GetParticipant(ctx context.Context, id pgtype.UUID) (Participant, error) {
-	row := q.db.QueryRow(ctx, getParticipant, id)
-	var i Participant
-	err := row.Scan(
-		&i.ID,
-		&i.GameID,
-		&i.UserID,
-		&i.TeamID,
-		&i.Status,
-		&i.Paid,
-		&i.PaymentAmountCents,
-		&i.Notes,
-		&i.JoinedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+func (q *Queries) CountConfirmedParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countConfirmedParticipants, gameID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-const getParticipantByGameAndUser = `-- name: GetParticipantByGameAndUser :one
-SELECT id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at FROM participants
-WHERE game_id = $1 AND user_id = $2
+const countHeldReservations = `-- name: CountHeldReservations :one
+SELECT COUNT(*) FROM game_reservations r
+WHERE r.game_id = $1
+AND r.expires_at > NOW()
+AND NOT EXISTS (
+    SELECT 1 FROM participants p
+    WHERE p.game_id = r.game_id AND p.user_id = r.user_id AND p.status IN ('confirmed', 'waitlist')
+)
 `
 
-type GetParticipantByGameAndUserParams struct {
-	GameID pgtype.UUID `json:"game_id"`
-	UserID pgtype.UUID `json:"user_id"`
+// Spots still held for reserved players who haven't joined; expired reservations hold nothing
+func (q *Queries) CountHeldReservations(ctx context.Context, gameID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countHeldReservations, gameID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-func (q *Queries) GetParticipantByGameAndUser(ctx context.Context, arg GetParticipantByGameAndUserParams) (Participant, error) {
-	row := q.db.QueryRow(ctx, getParticipantByGameAndUser, arg.GameID, arg.UserID)
-	var i Participant
-	err := row.Scan(
-		&i.ID,
-		&i.GameID,
-		&i.UserID,
-		&i.TeamID,
-		&i.Status,
-		&i.Paid,
-		&i.PaymentAmountCents,
-		&i.Notes,
-		&i.JoinedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+const countLoginCodesSince = `-- name: CountLoginCodesSince :one
+SELECT COUNT(*) FROM login_codes
+WHERE user_id = $1
+AND created_at > $2
+`
+
+type CountLoginCodesSinceParams struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
-const getRefreshTokenByHash = `-- name: GetRefreshTokenByHash :one
-SELECT id, user_id, token_hash, device_info, expires_at, created_at, revoked_at FROM refresh_tokens
-WHERE token_hash = $1
-AND revoked_at IS NULL
-AND expires_at > NOW()
+func (q *Queries) CountLoginCodesSince(ctx context.Context, arg CountLoginCodesSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLoginCodesSince, arg.UserID, arg.CreatedAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOpenGamesByOwner = `-- name: CountOpenGamesByOwner :one
+SELECT COUNT(*) FROM games
+WHERE owner_id = $1
+AND status IN ('open', 'full', 'closed')
+AND start_time > NOW()
 `
 
-func (q *Queries) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (RefreshToken, error) {
-	row := q.db.QueryRow(ctx, getRefreshTokenByHash, tokenHash)
-	var i RefreshToken
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.TokenHash,
-		&i.DeviceInfo,
-		&i.ExpiresAt,
-		&i.CreatedAt,
-		&i.RevokedAt,
+// Games a host has that haven't started and are still taking or holding signups
+func (q *Queries) CountOpenGamesByOwner(ctx context.Context, ownerID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countOpenGamesByOwner, ownerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPhoneVerificationsSince = `-- name: CountPhoneVerificationsSince :one
+SELECT COUNT(*) FROM phone_verifications
+WHERE user_id = $1
+AND created_at > $2
+`
+
+type CountPhoneVerificationsSinceParams struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CountPhoneVerificationsSince(ctx context.Context, arg CountPhoneVerificationsSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPhoneVerificationsSince, arg.UserID, arg.CreatedAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countReferrals = `-- name: CountReferrals :one
+SELECT COUNT(*) FROM referrals
+WHERE referrer_id = $1
+`
+
+func (q *Queries) CountReferrals(ctx context.Context, referrerID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countReferrals, referrerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countReportsByReporterSince = `-- name: CountReportsByReporterSince :one
+SELECT COUNT(*) FROM reports
+WHERE reporter_id = $1
+AND created_at > $2
+`
+
+type CountReportsByReporterSinceParams struct {
+	ReporterID pgtype.UUID        `json:"reporter_id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CountReportsByReporterSince(ctx context.Context, arg CountReportsByReporterSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countReportsByReporterSince, arg.ReporterID, arg.CreatedAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countWaitlistParticipants = `-- name: CountWaitlistParticipants :one
+SELECT COUNT(*) FROM participants
+WHERE game_id = $1 AND status = 'waitlist'
+`
+
+func (q *Queries) CountWaitlistParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countWaitlistParticipants, gameID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAdminAuditEntry = `-- name: CreateAdminAuditEntry :exec
+INSERT INTO admin_audit (
+    actor_id,
+    action,
+    target_type,
+    target_id,
+    game_id,
+    reason
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+type CreateAdminAuditEntryParams struct {
+	ActorID    pgtype.UUID `json:"actor_id"`
+	Action     string      `json:"action"`
+	TargetType string      `json:"target_type"`
+	TargetID   pgtype.UUID `json:"target_id"`
+	GameID     pgtype.UUID `json:"game_id"`
+	Reason     pgtype.Text `json:"reason"`
+}
+
+func (q *Queries) CreateAdminAuditEntry(ctx context.Context, arg CreateAdminAuditEntryParams) error {
+	_, err := q.db.Exec(ctx, createAdminAuditEntry,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.GameID,
+		arg.Reason,
 	)
-	return i, err
+	return err
 }
 
-const getTeam = `-- name: GetTeam :one
-SELECT id, game_id, name, color, created_at FROM teams
-WHERE id = $1
+const createConversation = `-- name: CreateConversation :one
+INSERT INTO conversations (game_id, host_id, player_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (game_id, player_id) DO UPDATE SET game_id = conversations.game_id
+RETURNING id, game_id, host_id, player_id, host_read_at, player_read_at, last_message_at, created_at
 `
 
-func (q *Queries) GetTeam(ctx context.Context, id pgtype.UUID) (Team, error) {
-	row := q.db.QueryRow(ctx, getTeam, id)
-	var i Team
+type CreateConversationParams struct {
+	GameID   pgtype.UUID `json:"game_id"`
+	HostID   pgtype.UUID `json:"host_id"`
+	PlayerID pgtype.UUID `json:"player_id"`
+}
+
+// Starting a conversation that already exists returns it
+func (q *Queries) CreateConversation(ctx context.Context, arg CreateConversationParams) (Conversation, error) {
+	row := q.db.QueryRow(ctx, createConversation, arg.GameID, arg.HostID, arg.PlayerID)
+	var i Conversation
 	err := row.Scan(
 		&i.ID,
 		&i.GameID,
-		&i.Name,
-		&i.Color,
+		&i.HostID,
+		&i.PlayerID,
+		&i.HostReadAt,
+		&i.PlayerReadAt,
+		&i.LastMessageAt,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, first_name, last_name, password_hash, created_at FROM users
-WHERE email = $1
+const createConversationMessage = `-- name: CreateConversationMessage :one
+INSERT INTO conversation_messages (conversation_id, sender_id, body)
+VALUES ($1, $2, $3)
+RETURNING id, conversation_id, sender_id, body, created_at
 `
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByEmail, email)
-	var i User
+type CreateConversationMessageParams struct {
+	ConversationID pgtype.UUID `json:"conversation_id"`
+	SenderID       pgtype.UUID `json:"sender_id"`
+	Body           string      `json:"body"`
+}
+
+func (q *Queries) CreateConversationMessage(ctx context.Context, arg CreateConversationMessageParams) (ConversationMessage, error) {
+	row := q.db.QueryRow(ctx, createConversationMessage, arg.ConversationID, arg.SenderID, arg.Body)
+	var i ConversationMessage
 	err := row.Scan(
 		&i.ID,
-		&i.Email,
-		&i.FirstName,
-		&i.LastName,
-		&i.PasswordHash,
+		&i.ConversationID,
+		&i.SenderID,
+		&i.Body,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, first_name, last_name, password_hash, created_at FROM users
-WHERE id = $1
+const createEmailChangeRequest = `-- name: CreateEmailChangeRequest :one
+INSERT INTO email_change_requests (
+    user_id,
+    new_email,
+    token_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING id, user_id, new_email, token_hash, expires_at, confirmed_at, created_at
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id pgtype.UUID) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByID, id)
-	var i User
+type CreateEmailChangeRequestParams struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	NewEmail  string             `json:"new_email"`
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateEmailChangeRequest(ctx context.Context, arg CreateEmailChangeRequestParams) (EmailChangeRequest, error) {
+	row := q.db.QueryRow(ctx, createEmailChangeRequest,
+		arg.UserID,
+		arg.NewEmail,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	var i EmailChangeRequest
 	err := row.Scan(
 		&i.ID,
-		&i.Email,
-		&i.FirstName,
-		&i.LastName,
-		&i.PasswordHash,
+		&i.UserID,
+		&i.NewEmail,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.ConfirmedAt,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const listActiveParticipantsByGame = `-- name: ListActiveParticipantsByGame :many
-SELECT
-    p.id,
-    p.game_id,
-    p.user_id,
-    p.team_id,
-    p.status,
-    p.paid,
-    p.payment_amount_cents,
-    p.notes,
-    p.joined_at,
-    p.updated_at,
-    u.email,
-    u.first_name,
-    u.last_name
-FROM participants p
-INNER JOIN users u ON p.user_id = u.id
-WHERE p.game_id = $1
-AND p.status in ('confirmed', 'waitlist')
-ORDER BY p.joined_at ASC
-`
-
-type ListActiveParticipantsByGameRow struct {
-	ID                 pgtype.UUID        `json:"id"`
-	GameID             pgtype.UUID        `json:"game_id"`
-	UserID             pgtype.UUID        `json:"user_id"`
-	TeamID             pgtype.UUID        `json:"team_id"`
-	Status             string             `json:"status"`
-	Paid               bool               `json:"paid"`
-	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
-	Notes              pgtype.Text        `json:"notes"`
-	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
-	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
-	Email              string             `json:"email"`
-	FirstName          string             `json:"first_name"`
-	LastName           string             `json:"last_name"`
-}
+const createGame = `-- name: CreateGame :one
 
-func (q *Queries) ListActiveParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]ListActiveParticipantsByGameRow, error) {
-	rows, err := q.db.Query(ctx, listActiveParticipantsByGame, gameID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListActiveParticipantsByGameRow{}
-	for rows.Next() {
+INSERT INTO games (
+    owner_id,
+    category,
+    title,
+    description,
+    location_name,
+    location_address,
+    location_point,
+    location_notes,
+    start_time,
+    duration_minutes,
+    max_participants,
+    pricing_type,
+    pricing_amount_cents,
+    pricing_currency,
+    signup_deadline,
+    drop_deadline,
+    skill_level,
+    notes,
+    status,
+    adult_only,
+    late_drop_window_hours
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    geo_point($7::float8, $8::float8),
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14,
+    $15,
+    $16,
+    $17,
+    $18,
+    $19,
+    $20,
+    $21,
+    $22
+)
+RETURNING id, owner_id, category, title, description, location_name, location_address,
+    geo_latitude(location_point) as latitude, geo_longitude(location_point) as longitude,
+    location_notes, start_time, duration_minutes, max_participants,
+    0 as confirmed_count, 0 as waitlist_count,
+    pricing_type, pricing_amount_cents, pricing_currency, signup_deadline,
+    drop_deadline, skill_level, adult_only, notes, status, cancelled_at, created_at, updated_at,
+    late_drop_window_hours
+`
+
+type CreateGameParams struct {
+	OwnerID             pgtype.UUID        `json:"owner_id"`
+	Category            string             `json:"category"`
+	Title               pgtype.Text        `json:"title"`
+	Description         pgtype.Text        `json:"description"`
+	LocationName        string             `json:"location_name"`
+	LocationAddress     pgtype.Text        `json:"location_address"`
+	Longitude           float64            `json:"longitude"`
+	Latitude            float64            `json:"latitude"`
+	LocationNotes       pgtype.Text        `json:"location_notes"`
+	StartTime           pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes     int32              `json:"duration_minutes"`
+	MaxParticipants     int32              `json:"max_participants"`
+	PricingType         string             `json:"pricing_type"`
+	PricingAmountCents  int32              `json:"pricing_amount_cents"`
+	PricingCurrency     string             `json:"pricing_currency"`
+	SignupDeadline      pgtype.Timestamptz `json:"signup_deadline"`
+	DropDeadline        pgtype.Timestamptz `json:"drop_deadline"`
+	SkillLevel          string             `json:"skill_level"`
+	Notes               pgtype.Text        `json:"notes"`
+	Status              string             `json:"status"`
+	AdultOnly           bool               `json:"adult_only"`
+	LateDropWindowHours pgtype.Int4        `json:"late_drop_window_hours"`
+}
+
+type CreateGameRow struct {
+	ID                  pgtype.UUID        `json:"id"`
+	OwnerID             pgtype.UUID        `json:"owner_id"`
+	Category            string             `json:"category"`
+	Title               pgtype.Text        `json:"title"`
+	Description         pgtype.Text        `json:"description"`
+	LocationName        string             `json:"location_name"`
+	LocationAddress     pgtype.Text        `json:"location_address"`
+	Latitude            interface{}        `json:"latitude"`
+	Longitude           interface{}        `json:"longitude"`
+	LocationNotes       pgtype.Text        `json:"location_notes"`
+	StartTime           pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes     int32              `json:"duration_minutes"`
+	MaxParticipants     int32              `json:"max_participants"`
+	ConfirmedCount      int32              `json:"confirmed_count"`
+	WaitlistCount       int32              `json:"waitlist_count"`
+	PricingType         string             `json:"pricing_type"`
+	PricingAmountCents  int32              `json:"pricing_amount_cents"`
+	PricingCurrency     string             `json:"pricing_currency"`
+	SignupDeadline      pgtype.Timestamptz `json:"signup_deadline"`
+	DropDeadline        pgtype.Timestamptz `json:"drop_deadline"`
+	SkillLevel          string             `json:"skill_level"`
+	AdultOnly           bool               `json:"adult_only"`
+	Notes               pgtype.Text        `json:"notes"`
+	Status              string             `json:"status"`
+	CancelledAt         pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	LateDropWindowHours pgtype.Int4        `json:"late_drop_window_hours"`
+}
+
+// Game queries
+func (q *Queries) CreateGame(ctx context.Context, arg CreateGameParams) (CreateGameRow, error) {
+	row := q.db.QueryRow(ctx, createGame,
+		arg.OwnerID,
+		arg.Category,
+		arg.Title,
+		arg.Description,
+		arg.LocationName,
+		arg.LocationAddress,
+		arg.Longitude,
+		arg.Latitude,
+		arg.LocationNotes,
+		arg.StartTime,
+		arg.DurationMinutes,
+		arg.MaxParticipants,
+		arg.PricingType,
+		arg.PricingAmountCents,
+		arg.PricingCurrency,
+		arg.SignupDeadline,
+		arg.DropDeadline,
+		arg.SkillLevel,
+		arg.Notes,
+		arg.Status,
+		arg.AdultOnly,
+		arg.LateDropWindowHours,
+	)
+	var i CreateGameRow
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Category,
+		&i.Title,
+		&i.Description,
+		&i.LocationName,
+		&i.LocationAddress,
+		&i.Latitude,
+		&i.Longitude,
+		&i.LocationNotes,
+		&i.StartTime,
+		&i.DurationMinutes,
+		&i.MaxParticipants,
+		&i.ConfirmedCount,
+		&i.WaitlistCount,
+		&i.PricingType,
+		&i.PricingAmountCents,
+		&i.PricingCurrency,
+		&i.SignupDeadline,
+		&i.DropDeadline,
+		&i.SkillLevel,
+		&i.AdultOnly,
+		&i.Notes,
+		&i.Status,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LateDropWindowHours,
+	)
+	return i, err
+}
+
+const createGameAnnouncement = `-- name: CreateGameAnnouncement :one
+INSERT INTO game_announcements (game_id, author_id, message)
+VALUES ($1, $2, $3)
+RETURNING id, game_id, author_id, message, created_at
+`
+
+type CreateGameAnnouncementParams struct {
+	GameID   pgtype.UUID `json:"game_id"`
+	AuthorID pgtype.UUID `json:"author_id"`
+	Message  string      `json:"message"`
+}
+
+func (q *Queries) CreateGameAnnouncement(ctx context.Context, arg CreateGameAnnouncementParams) (GameAnnouncement, error) {
+	row := q.db.QueryRow(ctx, createGameAnnouncement, arg.GameID, arg.AuthorID, arg.Message)
+	var i GameAnnouncement
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.AuthorID,
+		&i.Message,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createGameChange = `-- name: CreateGameChange :one
+INSERT INTO game_changes (
+    game_id,
+    changed_by,
+    field,
+    old_value,
+    new_value
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING id, game_id, changed_by, field, old_value, new_value, created_at
+`
+
+type CreateGameChangeParams struct {
+	GameID    pgtype.UUID `json:"game_id"`
+	ChangedBy pgtype.UUID `json:"changed_by"`
+	Field     string      `json:"field"`
+	OldValue  pgtype.Text `json:"old_value"`
+	NewValue  pgtype.Text `json:"new_value"`
+}
+
+func (q *Queries) CreateGameChange(ctx context.Context, arg CreateGameChangeParams) (GameChange, error) {
+	row := q.db.QueryRow(ctx, createGameChange,
+		arg.GameID,
+		arg.ChangedBy,
+		arg.Field,
+		arg.OldValue,
+		arg.NewValue,
+	)
+	var i GameChange
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.ChangedBy,
+		&i.Field,
+		&i.OldValue,
+		&i.NewValue,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createGameChatMessage = `-- name: CreateGameChatMessage :one
+INSERT INTO game_chat_messages (game_id, sender_id, body)
+VALUES ($1, $2, $3)
+RETURNING id, game_id, sender_id, body, created_at
+`
+
+type CreateGameChatMessageParams struct {
+	GameID   pgtype.UUID `json:"game_id"`
+	SenderID pgtype.UUID `json:"sender_id"`
+	Body     string      `json:"body"`
+}
+
+func (q *Queries) CreateGameChatMessage(ctx context.Context, arg CreateGameChatMessageParams) (GameChatMessage, error) {
+	row := q.db.QueryRow(ctx, createGameChatMessage, arg.GameID, arg.SenderID, arg.Body)
+	var i GameChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.SenderID,
+		&i.Body,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createGameComment = `-- name: CreateGameComment :one
+INSERT INTO game_comments (game_id, parent_id, author_id, body)
+VALUES ($1, $2, $3, $4)
+RETURNING id, game_id, parent_id, author_id, body, edited_at, deleted_at, deleted_by, created_at
+`
+
+type CreateGameCommentParams struct {
+	GameID   pgtype.UUID `json:"game_id"`
+	ParentID pgtype.UUID `json:"parent_id"`
+	AuthorID pgtype.UUID `json:"author_id"`
+	Body     string      `json:"body"`
+}
+
+func (q *Queries) CreateGameComment(ctx context.Context, arg CreateGameCommentParams) (GameComment, error) {
+	row := q.db.QueryRow(ctx, createGameComment,
+		arg.GameID,
+		arg.ParentID,
+		arg.AuthorID,
+		arg.Body,
+	)
+	var i GameComment
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.ParentID,
+		&i.AuthorID,
+		&i.Body,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createGamePosition = `-- name: CreateGamePosition :exec
+INSERT INTO game_positions (game_id, name, capacity)
+VALUES ($1, $2, $3)
+`
+
+type CreateGamePositionParams struct {
+	GameID   pgtype.UUID `json:"game_id"`
+	Name     string      `json:"name"`
+	Capacity int32       `json:"capacity"`
+}
+
+func (q *Queries) CreateGamePosition(ctx context.Context, arg CreateGamePositionParams) error {
+	_, err := q.db.Exec(ctx, createGamePosition, arg.GameID, arg.Name, arg.Capacity)
+	return err
+}
+
+const createLegalAcceptance = `-- name: CreateLegalAcceptance :exec
+INSERT INTO legal_acceptances (
+    user_id,
+    document_id,
+    ip_address,
+    user_agent
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (user_id, document_id) DO NOTHING
+`
+
+type CreateLegalAcceptanceParams struct {
+	UserID     pgtype.UUID `json:"user_id"`
+	DocumentID pgtype.UUID `json:"document_id"`
+	IpAddress  pgtype.Text `json:"ip_address"`
+	UserAgent  pgtype.Text `json:"user_agent"`
+}
+
+func (q *Queries) CreateLegalAcceptance(ctx context.Context, arg CreateLegalAcceptanceParams) error {
+	_, err := q.db.Exec(ctx, createLegalAcceptance,
+		arg.UserID,
+		arg.DocumentID,
+		arg.IpAddress,
+		arg.UserAgent,
+	)
+	return err
+}
+
+const createLoginCode = `-- name: CreateLoginCode :one
+INSERT INTO login_codes (
+    user_id,
+    code_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id, user_id, code_hash, attempts, expires_at, used_at, created_at
+`
+
+type CreateLoginCodeParams struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	CodeHash  string             `json:"code_hash"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateLoginCode(ctx context.Context, arg CreateLoginCodeParams) (LoginCode, error) {
+	row := q.db.QueryRow(ctx, createLoginCode, arg.UserID, arg.CodeHash, arg.ExpiresAt)
+	var i LoginCode
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CodeHash,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createMagicLinkToken = `-- name: CreateMagicLinkToken :one
+INSERT INTO magic_link_tokens (
+    user_id,
+    token_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id, user_id, token_hash, expires_at, used_at, created_at
+`
+
+type CreateMagicLinkTokenParams struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateMagicLinkToken(ctx context.Context, arg CreateMagicLinkTokenParams) (MagicLinkToken, error) {
+	row := q.db.QueryRow(ctx, createMagicLinkToken, arg.UserID, arg.TokenHash, arg.ExpiresAt)
+	var i MagicLinkToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createNotificationDelivery = `-- name: CreateNotificationDelivery :one
+INSERT INTO notification_deliveries (channel, recipient, summary, payload, next_attempt_at)
+VALUES ($1, $2, $3, $4, NOW() + make_interval(secs => $5::int))
+RETURNING id
+`
+
+type CreateNotificationDeliveryParams struct {
+	Channel      string      `json:"channel"`
+	Recipient    string      `json:"recipient"`
+	Summary      pgtype.Text `json:"summary"`
+	Payload      []byte      `json:"payload"`
+	LeaseSeconds int32       `json:"lease_seconds"`
+}
+
+// Records a notification about to be sent. It is leased from the start, so the retry job only
+// picks it up if the first attempt never reports back.
+func (q *Queries) CreateNotificationDelivery(ctx context.Context, arg CreateNotificationDeliveryParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, createNotificationDelivery,
+		arg.Channel,
+		arg.Recipient,
+		arg.Summary,
+		arg.Payload,
+		arg.LeaseSeconds,
+	)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createParticipant = `-- name: CreateParticipant :one
+INSERT INTO participants (
+    game_id,
+    user_id,
+    team_id,
+    status,
+    paid,
+    payment_amount_cents,
+    notes,
+    position
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
+`
+
+type CreateParticipantParams struct {
+	GameID             pgtype.UUID `json:"game_id"`
+	UserID             pgtype.UUID `json:"user_id"`
+	TeamID             pgtype.UUID `json:"team_id"`
+	Status             string      `json:"status"`
+	Paid               bool        `json:"paid"`
+	PaymentAmountCents pgtype.Int4 `json:"payment_amount_cents"`
+	Notes              pgtype.Text `json:"notes"`
+	Position           pgtype.Text `json:"position"`
+}
+
+func (q *Queries) CreateParticipant(ctx context.Context, arg CreateParticipantParams) (Participant, error) {
+	row := q.db.QueryRow(ctx, createParticipant,
+		arg.GameID,
+		arg.UserID,
+		arg.TeamID,
+		arg.Status,
+		arg.Paid,
+		arg.PaymentAmountCents,
+		arg.Notes,
+		arg.Position,
+	)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.UserID,
+		&i.TeamID,
+		&i.Status,
+		&i.Paid,
+		&i.PaymentAmountCents,
+		&i.Notes,
+		&i.JoinedAt,
+		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
+	)
+	return i, err
+}
+
+const createParticipationCorrection = `-- name: CreateParticipationCorrection :one
+INSERT INTO participation_corrections (game_id, user_id, corrected_by, field, old_value, new_value, reason)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, game_id, user_id, corrected_by, field, old_value, new_value, reason, created_at
+`
+
+type CreateParticipationCorrectionParams struct {
+	GameID      pgtype.UUID `json:"game_id"`
+	UserID      pgtype.UUID `json:"user_id"`
+	CorrectedBy pgtype.UUID `json:"corrected_by"`
+	Field       string      `json:"field"`
+	OldValue    pgtype.Text `json:"old_value"`
+	NewValue    pgtype.Text `json:"new_value"`
+	Reason      string      `json:"reason"`
+}
+
+func (q *Queries) CreateParticipationCorrection(ctx context.Context, arg CreateParticipationCorrectionParams) (ParticipationCorrection, error) {
+	row := q.db.QueryRow(ctx, createParticipationCorrection,
+		arg.GameID,
+		arg.UserID,
+		arg.CorrectedBy,
+		arg.Field,
+		arg.OldValue,
+		arg.NewValue,
+		arg.Reason,
+	)
+	var i ParticipationCorrection
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.UserID,
+		&i.CorrectedBy,
+		&i.Field,
+		&i.OldValue,
+		&i.NewValue,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createParticipationJournalEntry = `-- name: CreateParticipationJournalEntry :exec
+INSERT INTO participation_journal (
+    game_id,
+    user_id,
+    action,
+    requested_at,
+    completed_at,
+    outcome,
+    error,
+    promoted_user_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+`
+
+type CreateParticipationJournalEntryParams struct {
+	GameID         pgtype.UUID        `json:"game_id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	Action         string             `json:"action"`
+	RequestedAt    pgtype.Timestamptz `json:"requested_at"`
+	CompletedAt    pgtype.Timestamptz `json:"completed_at"`
+	Outcome        string             `json:"outcome"`
+	Error          pgtype.Text        `json:"error"`
+	PromotedUserID pgtype.UUID        `json:"promoted_user_id"`
+}
+
+func (q *Queries) CreateParticipationJournalEntry(ctx context.Context, arg CreateParticipationJournalEntryParams) error {
+	_, err := q.db.Exec(ctx, createParticipationJournalEntry,
+		arg.GameID,
+		arg.UserID,
+		arg.Action,
+		arg.RequestedAt,
+		arg.CompletedAt,
+		arg.Outcome,
+		arg.Error,
+		arg.PromotedUserID,
+	)
+	return err
+}
+
+const createPhoneVerification = `-- name: CreatePhoneVerification :one
+INSERT INTO phone_verifications (
+    user_id,
+    phone_number,
+    code_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING id, user_id, phone_number, code_hash, attempts, expires_at, verified_at, created_at
+`
+
+type CreatePhoneVerificationParams struct {
+	UserID      pgtype.UUID        `json:"user_id"`
+	PhoneNumber string             `json:"phone_number"`
+	CodeHash    string             `json:"code_hash"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreatePhoneVerification(ctx context.Context, arg CreatePhoneVerificationParams) (PhoneVerification, error) {
+	row := q.db.QueryRow(ctx, createPhoneVerification,
+		arg.UserID,
+		arg.PhoneNumber,
+		arg.CodeHash,
+		arg.ExpiresAt,
+	)
+	var i PhoneVerification
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.PhoneNumber,
+		&i.CodeHash,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.VerifiedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPlaceholderParticipant = `-- name: CreatePlaceholderParticipant :one
+INSERT INTO participants (
+    game_id,
+    placeholder_name,
+    status
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
+`
+
+type CreatePlaceholderParticipantParams struct {
+	GameID          pgtype.UUID `json:"game_id"`
+	PlaceholderName pgtype.Text `json:"placeholder_name"`
+	Status          string      `json:"status"`
+}
+
+func (q *Queries) CreatePlaceholderParticipant(ctx context.Context, arg CreatePlaceholderParticipantParams) (Participant, error) {
+	row := q.db.QueryRow(ctx, createPlaceholderParticipant, arg.GameID, arg.PlaceholderName, arg.Status)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.UserID,
+		&i.TeamID,
+		&i.Status,
+		&i.Paid,
+		&i.PaymentAmountCents,
+		&i.Notes,
+		&i.JoinedAt,
+		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
+	)
+	return i, err
+}
+
+const createReferral = `-- name: CreateReferral :exec
+INSERT INTO referrals (referred_id, referrer_id, code)
+VALUES ($1, $2, $3)
+ON CONFLICT (referred_id) DO NOTHING
+`
+
+type CreateReferralParams struct {
+	ReferredID pgtype.UUID `json:"referred_id"`
+	ReferrerID pgtype.UUID `json:"referrer_id"`
+	Code       string      `json:"code"`
+}
+
+func (q *Queries) CreateReferral(ctx context.Context, arg CreateReferralParams) error {
+	_, err := q.db.Exec(ctx, createReferral, arg.ReferredID, arg.ReferrerID, arg.Code)
+	return err
+}
+
+const createReferralCode = `-- name: CreateReferralCode :one
+INSERT INTO referral_codes (user_id, code)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+RETURNING user_id, code, created_at
+`
+
+type CreateReferralCodeParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	Code   string      `json:"code"`
+}
+
+// Returns no rows when the user already has a code or the code is taken
+func (q *Queries) CreateReferralCode(ctx context.Context, arg CreateReferralCodeParams) (ReferralCode, error) {
+	row := q.db.QueryRow(ctx, createReferralCode, arg.UserID, arg.Code)
+	var i ReferralCode
+	err := row.Scan(&i.UserID, &i.Code, &i.CreatedAt)
+	return i, err
+}
+
+const createRefreshToken = `-- name: CreateRefreshToken :one
+
+INSERT INTO refresh_tokens (
+    user_id,
+    token_hash,
+    device_info,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING id, user_id, token_hash, device_info, expires_at, created_at, revoked_at
+`
+
+type CreateRefreshTokenParams struct {
+	UserID     pgtype.UUID        `json:"user_id"`
+	TokenHash  string             `json:"token_hash"`
+	DeviceInfo pgtype.Text        `json:"device_info"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+}
+
+// Refresh token queries
+func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error) {
+	row := q.db.QueryRow(ctx, createRefreshToken,
+		arg.UserID,
+		arg.TokenHash,
+		arg.DeviceInfo,
+		arg.ExpiresAt,
+	)
+	var i RefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.DeviceInfo,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const createReport = `-- name: CreateReport :one
+INSERT INTO reports (
+    reporter_id,
+    target_type,
+    target_id,
+    reason,
+    details
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (reporter_id, target_type, target_id) WHERE status = 'open' DO NOTHING
+RETURNING id, reporter_id, target_type, target_id, reason, details, status, created_at, reviewed_by, reviewed_at
+`
+
+type CreateReportParams struct {
+	ReporterID pgtype.UUID `json:"reporter_id"`
+	TargetType string      `json:"target_type"`
+	TargetID   pgtype.UUID `json:"target_id"`
+	Reason     string      `json:"reason"`
+	Details    pgtype.Text `json:"details"`
+}
+
+// Returns no rows if the reporter already has an open report on the target
+func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) (Report, error) {
+	row := q.db.QueryRow(ctx, createReport,
+		arg.ReporterID,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Reason,
+		arg.Details,
+	)
+	var i Report
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterID,
+		&i.TargetType,
+		&i.TargetID,
+		&i.Reason,
+		&i.Details,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+	)
+	return i, err
+}
+
+const createSavedSearch = `-- name: CreateSavedSearch :one
+INSERT INTO saved_searches (user_id, name, filters, alerts_enabled)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, name, filters, created_at, alerts_enabled, search_point
+`
+
+type CreateSavedSearchParams struct {
+	UserID        pgtype.UUID `json:"user_id"`
+	Name          string      `json:"name"`
+	Filters       []byte      `json:"filters"`
+	AlertsEnabled bool        `json:"alerts_enabled"`
+}
+
+func (q *Queries) CreateSavedSearch(ctx context.Context, arg CreateSavedSearchParams) (SavedSearch, error) {
+	row := q.db.QueryRow(ctx, createSavedSearch,
+		arg.UserID,
+		arg.Name,
+		arg.Filters,
+		arg.AlertsEnabled,
+	)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Filters,
+		&i.CreatedAt,
+		&i.AlertsEnabled,
+		&i.SearchPoint,
+	)
+	return i, err
+}
+
+const createTeam = `-- name: CreateTeam :one
+INSERT INTO teams (
+    game_id,
+    name,
+    color
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id, game_id, name, color, created_at
+`
+
+type CreateTeamParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	Name   string      `json:"name"`
+	Color  pgtype.Text `json:"color"`
+}
+
+func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error) {
+	row := q.db.QueryRow(ctx, createTeam, arg.GameID, arg.Name, arg.Color)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.Name,
+		&i.Color,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createUser = `-- name: CreateUser :one
+
+INSERT INTO users (
+    email,
+    first_name,
+    last_name,
+    password_hash,
+    birthdate,
+    home_region
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING id, email, first_name, last_name, password_hash, phone_number, phone_verified_at, birthdate, created_at, home_region, token_version
+`
+
+type CreateUserParams struct {
+	Email        string      `json:"email"`
+	FirstName    string      `json:"first_name"`
+	LastName     string      `json:"last_name"`
+	PasswordHash string      `json:"password_hash"`
+	Birthdate    pgtype.Date `json:"birthdate"`
+	HomeRegion   string      `json:"home_region"`
+}
+
+// User queries
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUser,
+		arg.Email,
+		arg.FirstName,
+		arg.LastName,
+		arg.PasswordHash,
+		arg.Birthdate,
+		arg.HomeRegion,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.PasswordHash,
+		&i.PhoneNumber,
+		&i.PhoneVerifiedAt,
+		&i.Birthdate,
+		&i.CreatedAt,
+		&i.HomeRegion,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const createWebAuthnChallenge = `-- name: CreateWebAuthnChallenge :exec
+INSERT INTO webauthn_challenges (
+    user_id,
+    ceremony,
+    challenge,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+)
+`
+
+type CreateWebAuthnChallengeParams struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	Ceremony  string             `json:"ceremony"`
+	Challenge string             `json:"challenge"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateWebAuthnChallenge(ctx context.Context, arg CreateWebAuthnChallengeParams) error {
+	_, err := q.db.Exec(ctx, createWebAuthnChallenge,
+		arg.UserID,
+		arg.Ceremony,
+		arg.Challenge,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createWebAuthnCredential = `-- name: CreateWebAuthnCredential :one
+INSERT INTO webauthn_credentials (
+    user_id,
+    credential_id,
+    public_key,
+    sign_count,
+    name
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING id, user_id, credential_id, public_key, sign_count, name, created_at, last_used_at
+`
+
+type CreateWebAuthnCredentialParams struct {
+	UserID       pgtype.UUID `json:"user_id"`
+	CredentialID []byte      `json:"credential_id"`
+	PublicKey    []byte      `json:"public_key"`
+	SignCount    int64       `json:"sign_count"`
+	Name         pgtype.Text `json:"name"`
+}
+
+func (q *Queries) CreateWebAuthnCredential(ctx context.Context, arg CreateWebAuthnCredentialParams) (WebauthnCredential, error) {
+	row := q.db.QueryRow(ctx, createWebAuthnCredential,
+		arg.UserID,
+		arg.CredentialID,
+		arg.PublicKey,
+		arg.SignCount,
+		arg.Name,
+	)
+	var i WebauthnCredential
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CredentialID,
+		&i.PublicKey,
+		&i.SignCount,
+		&i.Name,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (owner_id, url, secret, event_types)
+VALUES ($1, $2, $3, $4)
+RETURNING id, owner_id, url, secret, event_types, created_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	OwnerID    pgtype.UUID `json:"owner_id"`
+	Url        string      `json:"url"`
+	Secret     string      `json:"secret"`
+	EventTypes []string    `json:"event_types"`
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.OwnerID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deactivateUser = `-- name: DeactivateUser :one
+INSERT INTO user_deactivations (user_id)
+VALUES ($1)
+ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
+RETURNING user_id, deactivated_at
+`
+
+// Deactivating an already deactivated account keeps the original time
+func (q *Queries) DeactivateUser(ctx context.Context, userID pgtype.UUID) (UserDeactivation, error) {
+	row := q.db.QueryRow(ctx, deactivateUser, userID)
+	var i UserDeactivation
+	err := row.Scan(&i.UserID, &i.DeactivatedAt)
+	return i, err
+}
+
+const deadLetterNotificationDelivery = `-- name: DeadLetterNotificationDelivery :exec
+WITH delivery AS (
+    SELECT id, channel, recipient, summary, payload, status, attempts, next_attempt_at, last_error, created_at, sent_at, attempt_history FROM notification_deliveries
+    WHERE notification_deliveries.id = $1
+),
+failed AS (
+    UPDATE notification_deliveries
+    SET
+        status = 'failed',
+        payload = NULL,
+        last_error = $2,
+        attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+    WHERE notification_deliveries.id = $1
+    RETURNING id, channel, recipient, summary, payload, status, attempts, next_attempt_at, last_error, created_at, sent_at, attempt_history
+)
+INSERT INTO dead_letters (source, source_id, kind, payload, attempts, attempt_history, last_error)
+SELECT
+    'notification_delivery',
+    failed.id,
+    failed.channel,
+    jsonb_build_object('recipient', failed.recipient, 'summary', failed.summary, 'message', delivery.payload),
+    failed.attempts,
+    failed.attempt_history,
+    failed.last_error
+FROM failed
+JOIN delivery ON delivery.id = failed.id
+`
+
+type DeadLetterNotificationDeliveryParams struct {
+	ID        pgtype.UUID `json:"id"`
+	LastError pgtype.Text `json:"last_error"`
+}
+
+// Marks a delivery that ran out of retries failed and copies it, message included, to
+// dead_letters. The CTEs share one snapshot, so delivery still sees the payload being cleared.
+func (q *Queries) DeadLetterNotificationDelivery(ctx context.Context, arg DeadLetterNotificationDeliveryParams) error {
+	_, err := q.db.Exec(ctx, deadLetterNotificationDelivery, arg.ID, arg.LastError)
+	return err
+}
+
+const deleteExpiredContactShareRequests = `-- name: DeleteExpiredContactShareRequests :execrows
+DELETE FROM contact_share_requests r
+USING games g
+WHERE g.id = r.game_id
+AND (g.status = 'cancelled' OR g.start_time + make_interval(mins => g.duration_minutes) <= NOW())
+`
+
+// Deletes the contact sharing requests of ended or cancelled games; consents cascade
+func (q *Queries) DeleteExpiredContactShareRequests(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredContactShareRequests)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpiredRefreshTokens = `-- name: DeleteExpiredRefreshTokens :exec
+DELETE FROM refresh_tokens
+WHERE expires_at < NOW()
+`
+
+func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredRefreshTokens)
+	return err
+}
+
+const deleteExpiredWebAuthnChallenges = `-- name: DeleteExpiredWebAuthnChallenges :exec
+DELETE FROM webauthn_challenges
+WHERE expires_at <= NOW()
+`
+
+func (q *Queries) DeleteExpiredWebAuthnChallenges(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredWebAuthnChallenges)
+	return err
+}
+
+const deleteFailedLoginsBefore = `-- name: DeleteFailedLoginsBefore :execrows
+DELETE FROM failed_logins
+WHERE attempted_at < $1
+`
+
+func (q *Queries) DeleteFailedLoginsBefore(ctx context.Context, attemptedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFailedLoginsBefore, attemptedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteGame = `-- name: DeleteGame :exec
+DELETE FROM games
+WHERE id = $1
+`
+
+func (q *Queries) DeleteGame(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteGame, id)
+	return err
+}
+
+const deleteGameComment = `-- name: DeleteGameComment :exec
+UPDATE game_comments
+SET
+    body = '',
+    deleted_at = NOW(),
+    deleted_by = $2
+WHERE id = $1
+`
+
+type DeleteGameCommentParams struct {
+	ID        pgtype.UUID `json:"id"`
+	DeletedBy pgtype.UUID `json:"deleted_by"`
+}
+
+// Clears the text, which isn't kept once the comment is gone
+func (q *Queries) DeleteGameComment(ctx context.Context, arg DeleteGameCommentParams) error {
+	_, err := q.db.Exec(ctx, deleteGameComment, arg.ID, arg.DeletedBy)
+	return err
+}
+
+const deleteGameReservation = `-- name: DeleteGameReservation :execrows
+DELETE FROM game_reservations
+WHERE game_id = $1 AND user_id = $2
+`
+
+type DeleteGameReservationParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteGameReservation(ctx context.Context, arg DeleteGameReservationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGameReservation, arg.GameID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteGameTags = `-- name: DeleteGameTags :exec
+DELETE FROM game_tags
+WHERE game_id = $1
+`
+
+func (q *Queries) DeleteGameTags(ctx context.Context, gameID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteGameTags, gameID)
+	return err
+}
+
+const deleteOldBrokerEvents = `-- name: DeleteOldBrokerEvents :execrows
+DELETE FROM broker_events
+WHERE status <> 'pending'
+AND created_at < NOW() - INTERVAL '7 days'
+`
+
+func (q *Queries) DeleteOldBrokerEvents(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOldBrokerEvents)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOldDeadLetters = `-- name: DeleteOldDeadLetters :execrows
+DELETE FROM dead_letters
+WHERE created_at < NOW() - INTERVAL '30 days'
+`
+
+func (q *Queries) DeleteOldDeadLetters(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOldDeadLetters)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOldNotificationDeliveries = `-- name: DeleteOldNotificationDeliveries :execrows
+DELETE FROM notification_deliveries
+WHERE created_at < NOW() - INTERVAL '30 days'
+`
+
+func (q *Queries) DeleteOldNotificationDeliveries(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOldNotificationDeliveries)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteParticipant = `-- name: DeleteParticipant :exec
+DELETE FROM participants
+WHERE id = $1
+`
+
+func (q *Queries) DeleteParticipant(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteParticipant, id)
+	return err
+}
+
+const deleteParticipantsByGame = `-- name: DeleteParticipantsByGame :exec
+DELETE FROM participants
+WHERE game_id = $1
+`
+
+func (q *Queries) DeleteParticipantsByGame(ctx context.Context, gameID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteParticipantsByGame, gameID)
+	return err
+}
+
+const deleteSavedSearch = `-- name: DeleteSavedSearch :execrows
+DELETE FROM saved_searches
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteSavedSearchParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteSavedSearch(ctx context.Context, arg DeleteSavedSearchParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSavedSearch, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeam = `-- name: DeleteTeam :exec
+DELETE FROM teams
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTeam(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTeam, id)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUser, id)
+	return err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND owner_id = $2
+`
+
+type DeleteWebhookSubscriptionParams struct {
+	ID      pgtype.UUID `json:"id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookSubscription, arg.ID, arg.OwnerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueBrokerEvent = `-- name: EnqueueBrokerEvent :exec
+INSERT INTO broker_events (id, event_type, partition_key, payload)
+VALUES ($1, $2, $3, $4)
+`
+
+type EnqueueBrokerEventParams struct {
+	ID           pgtype.UUID `json:"id"`
+	EventType    string      `json:"event_type"`
+	PartitionKey string      `json:"partition_key"`
+	Payload      []byte      `json:"payload"`
+}
+
+func (q *Queries) EnqueueBrokerEvent(ctx context.Context, arg EnqueueBrokerEventParams) error {
+	_, err := q.db.Exec(ctx, enqueueBrokerEvent,
+		arg.ID,
+		arg.EventType,
+		arg.PartitionKey,
+		arg.Payload,
+	)
+	return err
+}
+
+const enqueueSideEffect = `-- name: EnqueueSideEffect :one
+INSERT INTO side_effects (kind, game_id)
+VALUES ($1, $2)
+RETURNING id, kind, game_id, status, attempts, next_attempt_at, last_error, created_at, completed_at, attempt_history
+`
+
+type EnqueueSideEffectParams struct {
+	Kind   string      `json:"kind"`
+	GameID pgtype.UUID `json:"game_id"`
+}
+
+func (q *Queries) EnqueueSideEffect(ctx context.Context, arg EnqueueSideEffectParams) (SideEffect, error) {
+	row := q.db.QueryRow(ctx, enqueueSideEffect, arg.Kind, arg.GameID)
+	var i SideEffect
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.GameID,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.AttemptHistory,
+	)
+	return i, err
+}
+
+const enqueueWebhookDeliveries = `-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+SELECT id, $1::text, $2::jsonb
+FROM webhook_subscriptions
+WHERE owner_id = $3
+AND $1::text = ANY(event_types)
+`
+
+type EnqueueWebhookDeliveriesParams struct {
+	EventType string      `json:"event_type"`
+	Payload   []byte      `json:"payload"`
+	OwnerID   pgtype.UUID `json:"owner_id"`
+}
+
+// Queues the event for each of the owner's subscriptions that asked for its type
+func (q *Queries) EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueWebhookDeliveries, arg.EventType, arg.Payload, arg.OwnerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const exportGames = `-- name: ExportGames :many
+SELECT
+    g.id, g.owner_id, g.category, g.title, g.status, g.location_name,
+    geo_latitude(g.location_point) as latitude, geo_longitude(g.location_point) as longitude,
+    g.start_time, g.duration_minutes, g.max_participants,
+    COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'confirmed'), 0)::int as confirmed_count,
+    COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'waitlist'), 0)::int as waitlist_count,
+    g.pricing_type, g.pricing_amount_cents, g.pricing_currency,
+    g.adult_only, g.cancelled_at, g.created_at
+FROM games g
+LEFT JOIN participants p ON p.game_id = g.id
+WHERE ($1::timestamptz IS NULL OR g.created_at >= $1)
+AND ($2::timestamptz IS NULL OR g.created_at < $2)
+GROUP BY g.id
+ORDER BY g.created_at ASC
+`
+
+type ExportGamesParams struct {
+	CreatedFrom pgtype.Timestamptz `json:"created_from"`
+	CreatedTo   pgtype.Timestamptz `json:"created_to"`
+}
+
+type ExportGamesRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	OwnerID            pgtype.UUID        `json:"owner_id"`
+	Category           string             `json:"category"`
+	Title              pgtype.Text        `json:"title"`
+	Status             string             `json:"status"`
+	LocationName       string             `json:"location_name"`
+	Latitude           interface{}        `json:"latitude"`
+	Longitude          interface{}        `json:"longitude"`
+	StartTime          pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes    int32              `json:"duration_minutes"`
+	MaxParticipants    int32              `json:"max_participants"`
+	ConfirmedCount     int32              `json:"confirmed_count"`
+	WaitlistCount      int32              `json:"waitlist_count"`
+	PricingType        string             `json:"pricing_type"`
+	PricingAmountCents int32              `json:"pricing_amount_cents"`
+	PricingCurrency    string             `json:"pricing_currency"`
+	AdultOnly          bool               `json:"adult_only"`
+	CancelledAt        pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ExportGames(ctx context.Context, arg ExportGamesParams) ([]ExportGamesRow, error) {
+	rows, err := q.db.Query(ctx, exportGames, arg.CreatedFrom, arg.CreatedTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExportGamesRow{}
+	for rows.Next() {
+		var i ExportGamesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Category,
+			&i.Title,
+			&i.Status,
+			&i.LocationName,
+			&i.Latitude,
+			&i.Longitude,
+			&i.StartTime,
+			&i.DurationMinutes,
+			&i.MaxParticipants,
+			&i.ConfirmedCount,
+			&i.WaitlistCount,
+			&i.PricingType,
+			&i.PricingAmountCents,
+			&i.PricingCurrency,
+			&i.AdultOnly,
+			&i.CancelledAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const failBrokerEvent = `-- name: FailBrokerEvent :exec
+UPDATE broker_events
+SET
+    status = 'failed',
+    last_error = $2
+WHERE id = $1
+`
+
+type FailBrokerEventParams struct {
+	ID        pgtype.UUID `json:"id"`
+	LastError pgtype.Text `json:"last_error"`
+}
+
+func (q *Queries) FailBrokerEvent(ctx context.Context, arg FailBrokerEventParams) error {
+	_, err := q.db.Exec(ctx, failBrokerEvent, arg.ID, arg.LastError)
+	return err
+}
+
+const failNotificationDelivery = `-- name: FailNotificationDelivery :exec
+UPDATE notification_deliveries
+SET
+    status = $2,
+    payload = NULL,
+    last_error = $3,
+    attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $3::text, 'at', NOW()))
+WHERE id = $1
+`
+
+type FailNotificationDeliveryParams struct {
+	ID        pgtype.UUID `json:"id"`
+	Status    string      `json:"status"`
+	LastError pgtype.Text `json:"last_error"`
+}
+
+// Finishes a delivery that won't be retried, as failed or bounced
+func (q *Queries) FailNotificationDelivery(ctx context.Context, arg FailNotificationDeliveryParams) error {
+	_, err := q.db.Exec(ctx, failNotificationDelivery, arg.ID, arg.Status, arg.LastError)
+	return err
+}
+
+const failSideEffect = `-- name: FailSideEffect :exec
+WITH failed AS (
+    UPDATE side_effects
+    SET
+        status = 'failed',
+        last_error = $2,
+        attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+    WHERE id = $1
+    RETURNING id, kind, game_id, status, attempts, next_attempt_at, last_error, created_at, completed_at, attempt_history
+)
+INSERT INTO dead_letters (source, source_id, kind, payload, attempts, attempt_history, last_error)
+SELECT 'side_effect', id, kind, jsonb_build_object('gameId', game_id), attempts, attempt_history, last_error
+FROM failed
+`
+
+type FailSideEffectParams struct {
+	ID        pgtype.UUID `json:"id"`
+	LastError pgtype.Text `json:"last_error"`
+}
+
+// Marks a side effect failed and copies it to dead_letters
+func (q *Queries) FailSideEffect(ctx context.Context, arg FailSideEffectParams) error {
+	_, err := q.db.Exec(ctx, failSideEffect, arg.ID, arg.LastError)
+	return err
+}
+
+const failWebhookDelivery = `-- name: FailWebhookDelivery :exec
+WITH failed AS (
+    UPDATE webhook_deliveries
+    SET
+        status = 'failed',
+        last_error = $2,
+        attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+    WHERE id = $1
+    RETURNING id, subscription_id, event_type, payload, status, attempts, next_attempt_at, last_error, created_at, delivered_at, attempt_history
+)
+INSERT INTO dead_letters (source, source_id, kind, payload, attempts, attempt_history, last_error)
+SELECT 'webhook_delivery', id, event_type, jsonb_build_object('subscriptionId', subscription_id, 'event', payload), attempts, attempt_history, last_error
+FROM failed
+`
+
+type FailWebhookDeliveryParams struct {
+	ID        pgtype.UUID `json:"id"`
+	LastError pgtype.Text `json:"last_error"`
+}
+
+// Marks a webhook delivery failed and copies it to dead_letters
+func (q *Queries) FailWebhookDelivery(ctx context.Context, arg FailWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, failWebhookDelivery, arg.ID, arg.LastError)
+	return err
+}
+
+const finishJobRun = `-- name: FinishJobRun :exec
+UPDATE job_runs
+SET
+    locked_until = NOW(),
+    last_finished_at = NOW(),
+    last_error = $3
+WHERE name = $1 AND last_run_by = $2
+`
+
+type FinishJobRunParams struct {
+	Name      string      `json:"name"`
+	LastRunBy string      `json:"last_run_by"`
+	LastError pgtype.Text `json:"last_error"`
+}
+
+func (q *Queries) FinishJobRun(ctx context.Context, arg FinishJobRunParams) error {
+	_, err := q.db.Exec(ctx, finishJobRun, arg.Name, arg.LastRunBy, arg.LastError)
+	return err
+}
+
+const flagGameContent = `-- name: FlagGameContent :exec
+INSERT INTO reports (
+    target_type,
+    target_id,
+    reason,
+    details
+) VALUES (
+    'game', $1, 'inappropriate', $2
+)
+ON CONFLICT (target_type, target_id) WHERE status = 'open' AND reporter_id IS NULL
+DO UPDATE SET details = EXCLUDED.details
+`
+
+type FlagGameContentParams struct {
+	TargetID pgtype.UUID `json:"target_id"`
+	Details  pgtype.Text `json:"details"`
+}
+
+// Files a report from the content filter; a game already waiting for review keeps its open report
+func (q *Queries) FlagGameContent(ctx context.Context, arg FlagGameContentParams) error {
+	_, err := q.db.Exec(ctx, flagGameContent, arg.TargetID, arg.Details)
+	return err
+}
+
+const getAttendance = `-- name: GetAttendance :one
+SELECT game_id, user_id, status, marked_by, marked_at FROM attendance
+WHERE game_id = $1 AND user_id = $2
+`
+
+type GetAttendanceParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetAttendance(ctx context.Context, arg GetAttendanceParams) (Attendance, error) {
+	row := q.db.QueryRow(ctx, getAttendance, arg.GameID, arg.UserID)
+	var i Attendance
+	err := row.Scan(
+		&i.GameID,
+		&i.UserID,
+		&i.Status,
+		&i.MarkedBy,
+		&i.MarkedAt,
+	)
+	return i, err
+}
+
+const getContactShareRequest = `-- name: GetContactShareRequest :one
+SELECT game_id, requested_by, requested_at FROM contact_share_requests
+WHERE game_id = $1
+`
+
+func (q *Queries) GetContactShareRequest(ctx context.Context, gameID pgtype.UUID) (ContactShareRequest, error) {
+	row := q.db.QueryRow(ctx, getContactShareRequest, gameID)
+	var i ContactShareRequest
+	err := row.Scan(&i.GameID, &i.RequestedBy, &i.RequestedAt)
+	return i, err
+}
+
+const getConversation = `-- name: GetConversation :one
+SELECT id, game_id, host_id, player_id, host_read_at, player_read_at, last_message_at, created_at FROM conversations
+WHERE id = $1
+`
+
+func (q *Queries) GetConversation(ctx context.Context, id pgtype.UUID) (Conversation, error) {
+	row := q.db.QueryRow(ctx, getConversation, id)
+	var i Conversation
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.HostID,
+		&i.PlayerID,
+		&i.HostReadAt,
+		&i.PlayerReadAt,
+		&i.LastMessageAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCreditBalance = `-- name: GetCreditBalance :one
+SELECT balance_cents FROM credit_wallets
+WHERE user_id = $1
+`
+
+func (q *Queries) GetCreditBalance(ctx context.Context, userID pgtype.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, getCreditBalance, userID)
+	var balance_cents int32
+	err := row.Scan(&balance_cents)
+	return balance_cents, err
+}
+
+const getCreditBalanceForUpdate = `-- name: GetCreditBalanceForUpdate :one
+SELECT balance_cents FROM credit_wallets
+WHERE user_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCreditBalanceForUpdate(ctx context.Context, userID pgtype.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, getCreditBalanceForUpdate, userID)
+	var balance_cents int32
+	err := row.Scan(&balance_cents)
+	return balance_cents, err
+}
+
+const getDeadLetterForUpdate = `-- name: GetDeadLetterForUpdate :one
+SELECT id, source, source_id, kind, payload, attempts, attempt_history, last_error, created_at, requeued_at, requeued_by FROM dead_letters
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetDeadLetterForUpdate(ctx context.Context, id pgtype.UUID) (DeadLetter, error) {
+	row := q.db.QueryRow(ctx, getDeadLetterForUpdate, id)
+	var i DeadLetter
+	err := row.Scan(
+		&i.ID,
+		&i.Source,
+		&i.SourceID,
+		&i.Kind,
+		&i.Payload,
+		&i.Attempts,
+		&i.AttemptHistory,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.RequeuedAt,
+		&i.RequeuedBy,
+	)
+	return i, err
+}
+
+const getGame = `-- name: GetGame :one
+SELECT
+    g.id, g.owner_id, g.category, g.title, g.description, g.location_name, g.location_address,
+    geo_latitude(g.location_point) as latitude, geo_longitude(g.location_point) as longitude,
+    g.location_notes, g.start_time, g.duration_minutes, g.max_participants,
+    COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'confirmed'), 0)::int as confirmed_count,
+    COALESCE(COUNT(p.id) FILTER (WHERE p.status = 'waitlist'), 0)::int as waitlist_count,
+    g.pricing_type, g.pricing_amount_cents, g.pricing_currency, g.signup_deadline,
+    g.drop_deadline, g.skill_level, g.adult_only, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at,
+    g.late_drop_window_hours
+FROM games g
+LEFT JOIN participants p ON p.game_id = g.id
+WHERE g.id = $1
+GROUP BY g.id
+`
+
+type GetGameRow struct {
+	ID                  pgtype.UUID        `json:"id"`
+	OwnerID             pgtype.UUID        `json:"owner_id"`
+	Category            string             `json:"category"`
+	Title               pgtype.Text        `json:"title"`
+	Description         pgtype.Text        `json:"description"`
+	LocationName        string             `json:"location_name"`
+	LocationAddress     pgtype.Text        `json:"location_address"`
+	Latitude            interface{}        `json:"latitude"`
+	Longitude           interface{}        `json:"longitude"`
+	LocationNotes       pgtype.Text        `json:"location_notes"`
+	StartTime           pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes     int32              `json:"duration_minutes"`
+	MaxParticipants     int32              `json:"max_participants"`
+	ConfirmedCount      int32              `json:"confirmed_count"`
+	WaitlistCount       int32              `json:"waitlist_count"`
+	PricingType         string             `json:"pricing_type"`
+	PricingAmountCents  int32              `json:"pricing_amount_cents"`
+	PricingCurrency     string             `json:"pricing_currency"`
+	SignupDeadline      pgtype.Timestamptz `json:"signup_deadline"`
+	DropDeadline        pgtype.Timestamptz `json:"drop_deadline"`
+	SkillLevel          string             `json:"skill_level"`
+	AdultOnly           bool               `json:"adult_only"`
+	Notes               pgtype.Text        `json:"notes"`
+	Status              string             `json:"status"`
+	CancelledAt         pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	LateDropWindowHours pgtype.Int4        `json:"late_drop_window_hours"`
+}
+
+func (q *Queries) GetGame(ctx context.Context, id pgtype.UUID) (GetGameRow, error) {
+	row := q.db.QueryRow(ctx, getGame, id)
+	var i GetGameRow
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Category,
+		&i.Title,
+		&i.Description,
+		&i.LocationName,
+		&i.LocationAddress,
+		&i.Latitude,
+		&i.Longitude,
+		&i.LocationNotes,
+		&i.StartTime,
+		&i.DurationMinutes,
+		&i.MaxParticipants,
+		&i.ConfirmedCount,
+		&i.WaitlistCount,
+		&i.PricingType,
+		&i.PricingAmountCents,
+		&i.PricingCurrency,
+		&i.SignupDeadline,
+		&i.DropDeadline,
+		&i.SkillLevel,
+		&i.AdultOnly,
+		&i.Notes,
+		&i.Status,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LateDropWindowHours,
+	)
+	return i, err
+}
+
+const getGameCancellationReason = `-- name: GetGameCancellationReason :one
+SELECT reason FROM game_cancellation_reasons
+WHERE game_id = $1
+`
+
+func (q *Queries) GetGameCancellationReason(ctx context.Context, gameID pgtype.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getGameCancellationReason, gameID)
+	var reason string
+	err := row.Scan(&reason)
+	return reason, err
+}
+
+const getGameComment = `-- name: GetGameComment :one
+SELECT id, game_id, parent_id, author_id, body, edited_at, deleted_at, deleted_by, created_at FROM game_comments
+WHERE id = $1 AND game_id = $2
+`
+
+type GetGameCommentParams struct {
+	ID     pgtype.UUID `json:"id"`
+	GameID pgtype.UUID `json:"game_id"`
+}
+
+func (q *Queries) GetGameComment(ctx context.Context, arg GetGameCommentParams) (GameComment, error) {
+	row := q.db.QueryRow(ctx, getGameComment, arg.ID, arg.GameID)
+	var i GameComment
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.ParentID,
+		&i.AuthorID,
+		&i.Body,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getGameCreditsSpent = `-- name: GetGameCreditsSpent :one
+SELECT COALESCE(-SUM(amount_cents), 0)::INTEGER AS spent_cents
+FROM credit_transactions
+WHERE user_id = $1 AND game_id = $2
+`
+
+type GetGameCreditsSpentParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	GameID pgtype.UUID `json:"game_id"`
+}
+
+// Credit the user has spent on the game and not had refunded
+func (q *Queries) GetGameCreditsSpent(ctx context.Context, arg GetGameCreditsSpentParams) (int32, error) {
+	row := q.db.QueryRow(ctx, getGameCreditsSpent, arg.UserID, arg.GameID)
+	var spent_cents int32
+	err := row.Scan(&spent_cents)
+	return spent_cents, err
+}
+
+const getGameForUpdate = `-- name: GetGameForUpdate :one
+SELECT
+    id, owner_id, category, title, description, location_name, location_address,
+    geo_latitude(location_point) as latitude, geo_longitude(location_point) as longitude,
+    location_notes, start_time, duration_minutes, max_participants,
+    pricing_type, pricing_amount_cents, pricing_currency, signup_deadline,
+    drop_deadline, skill_level, adult_only, notes, status, cancelled_at, created_at, updated_at,
+    late_drop_window_hours
+FROM games
+WHERE id = $1
+FOR UPDATE
+`
+
+type GetGameForUpdateRow struct {
+	ID                  pgtype.UUID        `json:"id"`
+	OwnerID             pgtype.UUID        `json:"owner_id"`
+	Category            string             `json:"category"`
+	Title               pgtype.Text        `json:"title"`
+	Description         pgtype.Text        `json:"description"`
+	LocationName        string             `json:"location_name"`
+	LocationAddress     pgtype.Text        `json:"location_address"`
+	Latitude            interface{}        `json:"latitude"`
+	Longitude           interface{}        `json:"longitude"`
+	LocationNotes       pgtype.Text        `json:"location_notes"`
+	StartTime           pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes     int32              `json:"duration_minutes"`
+	MaxParticipants     int32              `json:"max_participants"`
+	PricingType         string             `json:"pricing_type"`
+	PricingAmountCents  int32              `json:"pricing_amount_cents"`
+	PricingCurrency     string             `json:"pricing_currency"`
+	SignupDeadline      pgtype.Timestamptz `json:"signup_deadline"`
+	DropDeadline        pgtype.Timestamptz `json:"drop_deadline"`
+	SkillLevel          string             `json:"skill_level"`
+	AdultOnly           bool               `json:"adult_only"`
+	Notes               pgtype.Text        `json:"notes"`
+	Status              string             `json:"status"`
+	CancelledAt         pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	LateDropWindowHours pgtype.Int4        `json:"late_drop_window_hours"`
+}
+
+func (q *Queries) GetGameForUpdate(ctx context.Context, id pgtype.UUID) (GetGameForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getGameForUpdate, id)
+	var i GetGameForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Category,
+		&i.Title,
+		&i.Description,
+		&i.LocationName,
+		&i.LocationAddress,
+		&i.Latitude,
+		&i.Longitude,
+		&i.LocationNotes,
+		&i.StartTime,
+		&i.DurationMinutes,
+		&i.MaxParticipants,
+		&i.PricingType,
+		&i.PricingAmountCents,
+		&i.PricingCurrency,
+		&i.SignupDeadline,
+		&i.DropDeadline,
+		&i.SkillLevel,
+		&i.AdultOnly,
+		&i.Notes,
+		&i.Status,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LateDropWindowHours,
+	)
+	return i, err
+}
+
+const getGameOwner = `-- name: GetGameOwner :one
+SELECT owner_id FROM games
+WHERE id = $1
+`
+
+func (q *Queries) GetGameOwner(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, getGameOwner, id)
+	var owner_id pgtype.UUID
+	err := row.Scan(&owner_id)
+	return owner_id, err
+}
+
+const getLatestMagicLinkToken = `-- name: GetLatestMagicLinkToken :one
+SELECT id, user_id, token_hash, expires_at, used_at, created_at FROM magic_link_tokens
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestMagicLinkToken(ctx context.Context, userID pgtype.UUID) (MagicLinkToken, error) {
+	row := q.db.QueryRow(ctx, getLatestMagicLinkToken, userID)
+	var i MagicLinkToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestPendingEmailChangeRequest = `-- name: GetLatestPendingEmailChangeRequest :one
+SELECT id, user_id, new_email, token_hash, expires_at, confirmed_at, created_at FROM email_change_requests
+WHERE user_id = $1
+AND confirmed_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestPendingEmailChangeRequest(ctx context.Context, userID pgtype.UUID) (EmailChangeRequest, error) {
+	row := q.db.QueryRow(ctx, getLatestPendingEmailChangeRequest, userID)
+	var i EmailChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.NewEmail,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestPendingLoginCode = `-- name: GetLatestPendingLoginCode :one
+SELECT id, user_id, code_hash, attempts, expires_at, used_at, created_at FROM login_codes
+WHERE user_id = $1
+AND used_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestPendingLoginCode(ctx context.Context, userID pgtype.UUID) (LoginCode, error) {
+	row := q.db.QueryRow(ctx, getLatestPendingLoginCode, userID)
+	var i LoginCode
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CodeHash,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestPendingPhoneVerification = `-- name: GetLatestPendingPhoneVerification :one
+SELECT id, user_id, phone_number, code_hash, attempts, expires_at, verified_at, created_at FROM phone_verifications
+WHERE user_id = $1
+AND verified_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestPendingPhoneVerification(ctx context.Context, userID pgtype.UUID) (PhoneVerification, error) {
+	row := q.db.QueryRow(ctx, getLatestPendingPhoneVerification, userID)
+	var i PhoneVerification
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.PhoneNumber,
+		&i.CodeHash,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.VerifiedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getNotificationPreferences = `-- name: GetNotificationPreferences :one
+SELECT user_id, sms_enabled, updated_at, email_enabled FROM user_notification_preferences
+WHERE user_id = $1
+`
+
+func (q *Queries) GetNotificationPreferences(ctx context.Context, userID pgtype.UUID) (UserNotificationPreference, error) {
+	row := q.db.QueryRow(ctx, getNotificationPreferences, userID)
+	var i UserNotificationPreference
+	err := row.Scan(
+		&i.UserID,
+		&i.SmsEnabled,
+		&i.UpdatedAt,
+		&i.EmailEnabled,
+	)
+	return i, err
+}
+
+const getParticipant = `-- name: GetParticipant :one
+SELECT id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank FROM participants
+WHERE id = $1
+`
+
+func (q *Queries) GetParticipant(ctx context.Context, id pgtype.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipant, id)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.UserID,
+		&i.TeamID,
+		&i.Status,
+		&i.Paid,
+		&i.PaymentAmountCents,
+		&i.Notes,
+		&i.JoinedAt,
+		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
+	)
+	return i, err
+}
+
+const getParticipantByGameAndUser = `-- name: GetParticipantByGameAndUser :one
+SELECT id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank FROM participants
+WHERE game_id = $1 AND user_id = $2
+`
+
+type GetParticipantByGameAndUserParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetParticipantByGameAndUser(ctx context.Context, arg GetParticipantByGameAndUserParams) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipantByGameAndUser, arg.GameID, arg.UserID)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.UserID,
+		&i.TeamID,
+		&i.Status,
+		&i.Paid,
+		&i.PaymentAmountCents,
+		&i.Notes,
+		&i.JoinedAt,
+		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
+	)
+	return i, err
+}
+
+const getParticipationReceipt = `-- name: GetParticipationReceipt :one
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.location_address,
+    g.start_time,
+    g.duration_minutes,
+    g.status,
+    g.pricing_type,
+    g.pricing_amount_cents,
+    g.pricing_currency,
+    (SELECT COUNT(*) FROM participants c WHERE c.game_id = g.id AND c.status = 'confirmed') AS confirmed_count,
+    p.id AS participant_id,
+    p.paid,
+    p.payment_amount_cents,
+    o.first_name AS organizer_first_name,
+    o.last_name AS organizer_last_name
+FROM participants p
+JOIN games g ON g.id = p.game_id
+JOIN users o ON o.id = g.owner_id
+WHERE p.game_id = $1 AND p.user_id = $2
+`
+
+type GetParticipationReceiptParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+type GetParticipationReceiptRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	Title              pgtype.Text        `json:"title"`
+	Category           string             `json:"category"`
+	LocationName       string             `json:"location_name"`
+	LocationAddress    pgtype.Text        `json:"location_address"`
+	StartTime          pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes    int32              `json:"duration_minutes"`
+	Status             string             `json:"status"`
+	PricingType        string             `json:"pricing_type"`
+	PricingAmountCents int32              `json:"pricing_amount_cents"`
+	PricingCurrency    string             `json:"pricing_currency"`
+	ConfirmedCount     int64              `json:"confirmed_count"`
+	ParticipantID      pgtype.UUID        `json:"participant_id"`
+	Paid               bool               `json:"paid"`
+	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
+	OrganizerFirstName string             `json:"organizer_first_name"`
+	OrganizerLastName  string             `json:"organizer_last_name"`
+}
+
+// A user's sign-up for a game with what a receipt for it shows
+func (q *Queries) GetParticipationReceipt(ctx context.Context, arg GetParticipationReceiptParams) (GetParticipationReceiptRow, error) {
+	row := q.db.QueryRow(ctx, getParticipationReceipt, arg.GameID, arg.UserID)
+	var i GetParticipationReceiptRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Category,
+		&i.LocationName,
+		&i.LocationAddress,
+		&i.StartTime,
+		&i.DurationMinutes,
+		&i.Status,
+		&i.PricingType,
+		&i.PricingAmountCents,
+		&i.PricingCurrency,
+		&i.ConfirmedCount,
+		&i.ParticipantID,
+		&i.Paid,
+		&i.PaymentAmountCents,
+		&i.OrganizerFirstName,
+		&i.OrganizerLastName,
+	)
+	return i, err
+}
+
+const getPendingEmailChangeRequestByTokenHash = `-- name: GetPendingEmailChangeRequestByTokenHash :one
+SELECT id, user_id, new_email, token_hash, expires_at, confirmed_at, created_at FROM email_change_requests
+WHERE token_hash = $1
+AND confirmed_at IS NULL
+`
+
+func (q *Queries) GetPendingEmailChangeRequestByTokenHash(ctx context.Context, tokenHash string) (EmailChangeRequest, error) {
+	row := q.db.QueryRow(ctx, getPendingEmailChangeRequestByTokenHash, tokenHash)
+	var i EmailChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.NewEmail,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getReferralCodeByCode = `-- name: GetReferralCodeByCode :one
+SELECT user_id, code, created_at FROM referral_codes
+WHERE code = $1
+`
+
+func (q *Queries) GetReferralCodeByCode(ctx context.Context, code string) (ReferralCode, error) {
+	row := q.db.QueryRow(ctx, getReferralCodeByCode, code)
+	var i ReferralCode
+	err := row.Scan(&i.UserID, &i.Code, &i.CreatedAt)
+	return i, err
+}
+
+const getReferralCodeByUser = `-- name: GetReferralCodeByUser :one
+SELECT user_id, code, created_at FROM referral_codes
+WHERE user_id = $1
+`
+
+func (q *Queries) GetReferralCodeByUser(ctx context.Context, userID pgtype.UUID) (ReferralCode, error) {
+	row := q.db.QueryRow(ctx, getReferralCodeByUser, userID)
+	var i ReferralCode
+	err := row.Scan(&i.UserID, &i.Code, &i.CreatedAt)
+	return i, err
+}
+
+const getRefreshTokenByHash = `-- name: GetRefreshTokenByHash :one
+SELECT id, user_id, token_hash, device_info, expires_at, created_at, revoked_at FROM refresh_tokens
+WHERE token_hash = $1
+AND revoked_at IS NULL
+AND expires_at > NOW()
+`
+
+func (q *Queries) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (RefreshToken, error) {
+	row := q.db.QueryRow(ctx, getRefreshTokenByHash, tokenHash)
+	var i RefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.DeviceInfo,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getRosterSnapshot = `-- name: GetRosterSnapshot :one
+SELECT game_id, max_participants, taken_at FROM roster_snapshots
+WHERE game_id = $1
+`
+
+func (q *Queries) GetRosterSnapshot(ctx context.Context, gameID pgtype.UUID) (RosterSnapshot, error) {
+	row := q.db.QueryRow(ctx, getRosterSnapshot, gameID)
+	var i RosterSnapshot
+	err := row.Scan(&i.GameID, &i.MaxParticipants, &i.TakenAt)
+	return i, err
+}
+
+const getSMSNumber = `-- name: GetSMSNumber :one
+SELECT u.phone_number FROM users u
+JOIN user_notification_preferences p ON p.user_id = u.id
+WHERE u.id = $1
+AND p.sms_enabled
+AND u.phone_verified_at IS NOT NULL
+`
+
+// The number to text a user at: only set when they opted in to SMS and their phone is verified
+func (q *Queries) GetSMSNumber(ctx context.Context, id pgtype.UUID) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getSMSNumber, id)
+	var phone_number pgtype.Text
+	err := row.Scan(&phone_number)
+	return phone_number, err
+}
+
+const getTeam = `-- name: GetTeam :one
+SELECT id, game_id, name, color, created_at FROM teams
+WHERE id = $1
+`
+
+func (q *Queries) GetTeam(ctx context.Context, id pgtype.UUID) (Team, error) {
+	row := q.db.QueryRow(ctx, getTeam, id)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.Name,
+		&i.Color,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, email, first_name, last_name, password_hash, phone_number, phone_verified_at, birthdate, created_at, home_region, token_version FROM users
+WHERE email = $1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.PasswordHash,
+		&i.PhoneNumber,
+		&i.PhoneVerifiedAt,
+		&i.Birthdate,
+		&i.CreatedAt,
+		&i.HomeRegion,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, email, first_name, last_name, password_hash, phone_number, phone_verified_at, birthdate, created_at, home_region, token_version FROM users
+WHERE id = $1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id pgtype.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.PasswordHash,
+		&i.PhoneNumber,
+		&i.PhoneVerifiedAt,
+		&i.Birthdate,
+		&i.CreatedAt,
+		&i.HomeRegion,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const getUserByVerifiedPhone = `-- name: GetUserByVerifiedPhone :one
+SELECT id, email, first_name, last_name, password_hash, phone_number, phone_verified_at, birthdate, created_at, home_region, token_version FROM users
+WHERE phone_number = $1
+AND phone_verified_at IS NOT NULL
+`
+
+func (q *Queries) GetUserByVerifiedPhone(ctx context.Context, phoneNumber pgtype.Text) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByVerifiedPhone, phoneNumber)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.PasswordHash,
+		&i.PhoneNumber,
+		&i.PhoneVerifiedAt,
+		&i.Birthdate,
+		&i.CreatedAt,
+		&i.HomeRegion,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const getUserFavoriteCategory = `-- name: GetUserFavoriteCategory :one
+SELECT g.category
+FROM participants p
+JOIN games g ON g.id = p.game_id
+LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+WHERE p.user_id = $1
+AND p.status = 'confirmed'
+AND g.status = 'completed'
+AND a.status IS DISTINCT FROM 'no_show'
+GROUP BY g.category
+ORDER BY COUNT(*) DESC, MAX(g.start_time) DESC
+LIMIT 1
+`
+
+// The category of the most completed games played; ties go to the most recently played
+func (q *Queries) GetUserFavoriteCategory(ctx context.Context, userID pgtype.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getUserFavoriteCategory, userID)
+	var category string
+	err := row.Scan(&category)
+	return category, err
+}
+
+const getUserPlayStats = `-- name: GetUserPlayStats :one
+SELECT
+    (SELECT COUNT(*)
+     FROM participants p
+     JOIN games g ON g.id = p.game_id
+     LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+     WHERE p.user_id = $1
+     AND p.status = 'confirmed'
+     AND g.status = 'completed'
+     AND a.status IS DISTINCT FROM 'no_show')::int AS games_played,
+    (SELECT COUNT(*)
+     FROM attendance a
+     JOIN games g ON g.id = a.game_id
+     WHERE a.user_id = $1
+     AND a.status = 'no_show'
+     AND g.status = 'completed')::int AS no_shows,
+    (SELECT COUNT(*)
+     FROM games g
+     WHERE g.owner_id = $1
+     AND g.status = 'completed')::int AS games_hosted
+`
+
+type GetUserPlayStatsRow struct {
+	GamesPlayed int32 `json:"games_played"`
+	NoShows     int32 `json:"no_shows"`
+	GamesHosted int32 `json:"games_hosted"`
+}
+
+// Totals over completed games. A confirmed game counts as played unless the host marked a no-show.
+func (q *Queries) GetUserPlayStats(ctx context.Context, userID pgtype.UUID) (GetUserPlayStatsRow, error) {
+	row := q.db.QueryRow(ctx, getUserPlayStats, userID)
+	var i GetUserPlayStatsRow
+	err := row.Scan(&i.GamesPlayed, &i.NoShows, &i.GamesHosted)
+	return i, err
+}
+
+const getUserSessionState = `-- name: GetUserSessionState :one
+SELECT
+    u.token_version,
+    EXISTS (SELECT 1 FROM user_suspensions s WHERE s.user_id = u.id) AS suspended,
+    EXISTS (SELECT 1 FROM user_deactivations d WHERE d.user_id = u.id) AS deactivated
+FROM users u
+WHERE u.id = $1
+`
+
+type GetUserSessionStateRow struct {
+	TokenVersion int32 `json:"token_version"`
+	Suspended    bool  `json:"suspended"`
+	Deactivated  bool  `json:"deactivated"`
+}
+
+// What every authenticated request checks: the current token version, whether an admin suspended
+// the account and whether its owner deactivated it
+func (q *Queries) GetUserSessionState(ctx context.Context, id pgtype.UUID) (GetUserSessionStateRow, error) {
+	row := q.db.QueryRow(ctx, getUserSessionState, id)
+	var i GetUserSessionStateRow
+	err := row.Scan(&i.TokenVersion, &i.Suspended, &i.Deactivated)
+	return i, err
+}
+
+const getUserSettings = `-- name: GetUserSettings :one
+SELECT user_id, home_latitude, home_longitude, home_point, home_place_id, home_name, default_radius_meters, updated_at, availability_windows, timezone, onboarded_at FROM user_settings
+WHERE user_id = $1
+`
+
+func (q *Queries) GetUserSettings(ctx context.Context, userID pgtype.UUID) (UserSetting, error) {
+	row := q.db.QueryRow(ctx, getUserSettings, userID)
+	var i UserSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.HomeLatitude,
+		&i.HomeLongitude,
+		&i.HomePoint,
+		&i.HomePlaceID,
+		&i.HomeName,
+		&i.DefaultRadiusMeters,
+		&i.UpdatedAt,
+		&i.AvailabilityWindows,
+		&i.Timezone,
+		&i.OnboardedAt,
+	)
+	return i, err
+}
+
+const getWebAuthnCredential = `-- name: GetWebAuthnCredential :one
+SELECT id, user_id, credential_id, public_key, sign_count, name, created_at, last_used_at FROM webauthn_credentials
+WHERE credential_id = $1
+`
+
+func (q *Queries) GetWebAuthnCredential(ctx context.Context, credentialID []byte) (WebauthnCredential, error) {
+	row := q.db.QueryRow(ctx, getWebAuthnCredential, credentialID)
+	var i WebauthnCredential
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CredentialID,
+		&i.PublicKey,
+		&i.SignCount,
+		&i.Name,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const grantContactShareConsent = `-- name: GrantContactShareConsent :exec
+INSERT INTO contact_share_consents (game_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT (game_id, user_id) DO NOTHING
+`
+
+type GrantContactShareConsentParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) GrantContactShareConsent(ctx context.Context, arg GrantContactShareConsentParams) error {
+	_, err := q.db.Exec(ctx, grantContactShareConsent, arg.GameID, arg.UserID)
+	return err
+}
+
+const hasActiveReservation = `-- name: HasActiveReservation :one
+SELECT EXISTS (
+    SELECT 1 FROM game_reservations
+    WHERE game_id = $1 AND user_id = $2 AND expires_at > NOW()
+)
+`
+
+type HasActiveReservationParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) HasActiveReservation(ctx context.Context, arg HasActiveReservationParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasActiveReservation, arg.GameID, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const incrementLoginCodeAttempts = `-- name: IncrementLoginCodeAttempts :one
+UPDATE login_codes
+SET attempts = attempts + 1
+WHERE id = $1
+RETURNING attempts
+`
+
+func (q *Queries) IncrementLoginCodeAttempts(ctx context.Context, id pgtype.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementLoginCodeAttempts, id)
+	var attempts int32
+	err := row.Scan(&attempts)
+	return attempts, err
+}
+
+const incrementPhoneVerificationAttempts = `-- name: IncrementPhoneVerificationAttempts :one
+UPDATE phone_verifications
+SET attempts = attempts + 1
+WHERE id = $1
+RETURNING attempts
+`
+
+func (q *Queries) IncrementPhoneVerificationAttempts(ctx context.Context, id pgtype.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementPhoneVerificationAttempts, id)
+	var attempts int32
+	err := row.Scan(&attempts)
+	return attempts, err
+}
+
+const incrementUserTokenVersion = `-- name: IncrementUserTokenVersion :one
+UPDATE users
+SET token_version = token_version + 1
+WHERE id = $1
+RETURNING token_version
+`
+
+func (q *Queries) IncrementUserTokenVersion(ctx context.Context, id pgtype.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementUserTokenVersion, id)
+	var token_version int32
+	err := row.Scan(&token_version)
+	return token_version, err
+}
+
+const isOrganizerOfParticipant = `-- name: IsOrganizerOfParticipant :one
+SELECT EXISTS (
+    SELECT 1 FROM games g
+    INNER JOIN participants p ON p.game_id = g.id
+    WHERE g.owner_id = $1
+    AND p.user_id = $2
+    AND p.status IN ('confirmed', 'waitlist')
+)
+`
+
+type IsOrganizerOfParticipantParams struct {
+	OrganizerID   pgtype.UUID `json:"organizer_id"`
+	ParticipantID pgtype.UUID `json:"participant_id"`
+}
+
+func (q *Queries) IsOrganizerOfParticipant(ctx context.Context, arg IsOrganizerOfParticipantParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isOrganizerOfParticipant, arg.OrganizerID, arg.ParticipantID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isPlayerBlockedByHost = `-- name: IsPlayerBlockedByHost :one
+SELECT EXISTS (
+    SELECT 1 FROM host_blocked_players
+    WHERE host_id = $1 AND player_id = $2
+)
+`
+
+type IsPlayerBlockedByHostParams struct {
+	HostID   pgtype.UUID `json:"host_id"`
+	PlayerID pgtype.UUID `json:"player_id"`
+}
+
+func (q *Queries) IsPlayerBlockedByHost(ctx context.Context, arg IsPlayerBlockedByHostParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isPlayerBlockedByHost, arg.HostID, arg.PlayerID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isUserDeactivated = `-- name: IsUserDeactivated :one
+SELECT EXISTS (
+    SELECT 1 FROM user_deactivations
+    WHERE user_id = $1
+) AS deactivated
+`
+
+func (q *Queries) IsUserDeactivated(ctx context.Context, userID pgtype.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, isUserDeactivated, userID)
+	var deactivated bool
+	err := row.Scan(&deactivated)
+	return deactivated, err
+}
+
+const isUserShadowBanned = `-- name: IsUserShadowBanned :one
+SELECT EXISTS (
+    SELECT 1 FROM user_shadow_bans
+    WHERE user_id = $1
+)
+`
+
+func (q *Queries) IsUserShadowBanned(ctx context.Context, userID pgtype.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, isUserShadowBanned, userID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const liftShadowBan = `-- name: LiftShadowBan :execrows
+DELETE FROM user_shadow_bans
+WHERE user_id = $1
+`
+
+func (q *Queries) LiftShadowBan(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, liftShadowBan, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const linkPlaceholderParticipant = `-- name: LinkPlaceholderParticipant :one
+UPDATE participants
+SET
+    user_id = $1,
+    placeholder_name = NULL,
+    updated_at = NOW()
+WHERE id = $2 AND user_id IS NULL
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
+`
+
+type LinkPlaceholderParticipantParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	ID     pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) LinkPlaceholderParticipant(ctx context.Context, arg LinkPlaceholderParticipantParams) (Participant, error) {
+	row := q.db.QueryRow(ctx, linkPlaceholderParticipant, arg.UserID, arg.ID)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.UserID,
+		&i.TeamID,
+		&i.Status,
+		&i.Paid,
+		&i.PaymentAmountCents,
+		&i.Notes,
+		&i.JoinedAt,
+		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
+	)
+	return i, err
+}
+
+const listActiveParticipantsByGame = `-- name: ListActiveParticipantsByGame :many
+SELECT
+    p.id,
+    p.game_id,
+    p.user_id,
+    p.team_id,
+    p.status,
+    p.paid,
+    p.payment_amount_cents,
+    p.notes,
+    p.joined_at,
+    p.updated_at,
+    COALESCE(u.email, '')::text AS email,
+    COALESCE(u.first_name, p.placeholder_name)::text AS first_name,
+    COALESCE(u.last_name, '')::text AS last_name,
+    u.birthdate,
+    p.checked_in_at,
+    p.position,
+    EXISTS (SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = p.user_id) AS shadow_banned
+FROM participants p
+LEFT JOIN users u ON p.user_id = u.id
+WHERE p.game_id = $1
+AND p.status in ('confirmed', 'waitlist')
+ORDER BY p.waitlist_rank ASC
+`
+
+type ListActiveParticipantsByGameRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	GameID             pgtype.UUID        `json:"game_id"`
+	UserID             pgtype.UUID        `json:"user_id"`
+	TeamID             pgtype.UUID        `json:"team_id"`
+	Status             string             `json:"status"`
+	Paid               bool               `json:"paid"`
+	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
+	Notes              pgtype.Text        `json:"notes"`
+	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	Email              string             `json:"email"`
+	FirstName          string             `json:"first_name"`
+	LastName           string             `json:"last_name"`
+	Birthdate          pgtype.Date        `json:"birthdate"`
+	CheckedInAt        pgtype.Timestamptz `json:"checked_in_at"`
+	Position           pgtype.Text        `json:"position"`
+	ShadowBanned       bool               `json:"shadow_banned"`
+}
+
+func (q *Queries) ListActiveParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]ListActiveParticipantsByGameRow, error) {
+	rows, err := q.db.Query(ctx, listActiveParticipantsByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActiveParticipantsByGameRow{}
+	for rows.Next() {
 		var i ListActiveParticipantsByGameRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.GameID,
-			&i.UserID,
-			&i.TeamID,
+			&i.GameID,
+			&i.UserID,
+			&i.TeamID,
+			&i.Status,
+			&i.Paid,
+			&i.PaymentAmountCents,
+			&i.Notes,
+			&i.JoinedAt,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.Birthdate,
+			&i.CheckedInAt,
+			&i.Position,
+			&i.ShadowBanned,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAdminAudit = `-- name: ListAdminAudit :many
+SELECT id, actor_id, action, target_type, target_id, game_id, reason, created_at FROM admin_audit
+WHERE ($1::uuid IS NULL OR actor_id = $1)
+AND ($2::uuid IS NULL OR target_id = $2 OR game_id = $2)
+AND ($3::varchar IS NULL OR action = $3)
+ORDER BY created_at DESC, id
+LIMIT $4 OFFSET $5
+`
+
+type ListAdminAuditParams struct {
+	ActorID    pgtype.UUID `json:"actor_id"`
+	TargetID   pgtype.UUID `json:"target_id"`
+	Action     pgtype.Text `json:"action"`
+	PageLimit  int32       `json:"page_limit"`
+	PageOffset int32       `json:"page_offset"`
+}
+
+// Filtering by target also matches entries taken within that game (e.g. removing one of its players)
+func (q *Queries) ListAdminAudit(ctx context.Context, arg ListAdminAuditParams) ([]AdminAudit, error) {
+	rows, err := q.db.Query(ctx, listAdminAudit,
+		arg.ActorID,
+		arg.TargetID,
+		arg.Action,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AdminAudit{}
+	for rows.Next() {
+		var i AdminAudit
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorID,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.GameID,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAttendanceSummaries = `-- name: ListAttendanceSummaries :many
+WITH recent AS (
+    SELECT
+        a.user_id,
+        a.status,
+        ROW_NUMBER() OVER (PARTITION BY a.user_id ORDER BY g.start_time DESC) AS n
+    FROM attendance a
+    JOIN games g ON g.id = a.game_id
+    WHERE a.user_id = ANY($1::uuid[])
+)
+SELECT
+    user_id,
+    COUNT(*) AS games,
+    COUNT(*) FILTER (WHERE status = 'no_show') AS no_shows
+FROM recent
+WHERE n <= $2::int
+GROUP BY user_id
+`
+
+type ListAttendanceSummariesParams struct {
+	UserIds    []pgtype.UUID `json:"user_ids"`
+	WindowSize int32         `json:"window_size"`
+}
+
+type ListAttendanceSummariesRow struct {
+	UserID  pgtype.UUID `json:"user_id"`
+	Games   int64       `json:"games"`
+	NoShows int64       `json:"no_shows"`
+}
+
+func (q *Queries) ListAttendanceSummaries(ctx context.Context, arg ListAttendanceSummariesParams) ([]ListAttendanceSummariesRow, error) {
+	rows, err := q.db.Query(ctx, listAttendanceSummaries, arg.UserIds, arg.WindowSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAttendanceSummariesRow{}
+	for rows.Next() {
+		var i ListAttendanceSummariesRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Games,
+			&i.NoShows,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBlockedPlayers = `-- name: ListBlockedPlayers :many
+SELECT
+    b.player_id,
+    u.first_name,
+    u.last_name,
+    b.created_at
+FROM host_blocked_players b
+INNER JOIN users u ON u.id = b.player_id
+WHERE b.host_id = $1
+ORDER BY b.created_at DESC
+`
+
+type ListBlockedPlayersRow struct {
+	PlayerID  pgtype.UUID        `json:"player_id"`
+	FirstName string             `json:"first_name"`
+	LastName  string             `json:"last_name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListBlockedPlayers(ctx context.Context, hostID pgtype.UUID) ([]ListBlockedPlayersRow, error) {
+	rows, err := q.db.Query(ctx, listBlockedPlayers, hostID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBlockedPlayersRow{}
+	for rows.Next() {
+		var i ListBlockedPlayersRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.FirstName,
+			&i.LastName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConversationMessages = `-- name: ListConversationMessages :many
+SELECT id, conversation_id, sender_id, body, created_at FROM conversation_messages
+WHERE conversation_id = $1
+AND ($2::timestamptz IS NULL OR created_at < $2)
+ORDER BY created_at DESC
+LIMIT $3::int
+`
+
+type ListConversationMessagesParams struct {
+	ConversationID pgtype.UUID        `json:"conversation_id"`
+	Before         pgtype.Timestamptz `json:"before"`
+	MaxResults     int32              `json:"max_results"`
+}
+
+// Newest first, optionally only those sent before a time for paging back
+func (q *Queries) ListConversationMessages(ctx context.Context, arg ListConversationMessagesParams) ([]ConversationMessage, error) {
+	rows, err := q.db.Query(ctx, listConversationMessages, arg.ConversationID, arg.Before, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ConversationMessage{}
+	for rows.Next() {
+		var i ConversationMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConversationID,
+			&i.SenderID,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConversations = `-- name: ListConversations :many
+SELECT
+    c.id, c.game_id, g.title AS game_title, g.category AS game_category, g.start_time AS game_start_time,
+    c.host_id, other.id AS other_user_id, other.first_name AS other_first_name, other.last_name AS other_last_name,
+    last_message.body AS last_message_body, last_message.sender_id AS last_message_sender_id, c.last_message_at,
+    (
+        SELECT COUNT(*) FROM conversation_messages m
+        WHERE m.conversation_id = c.id
+        AND m.sender_id <> $1
+        AND m.created_at > COALESCE(CASE WHEN c.host_id = $1 THEN c.host_read_at ELSE c.player_read_at END, '-infinity')
+    ) AS unread_count,
+    c.created_at
+FROM conversations c
+INNER JOIN games g ON g.id = c.game_id
+INNER JOIN users other ON other.id = CASE WHEN c.host_id = $1 THEN c.player_id ELSE c.host_id END
+LEFT JOIN LATERAL (
+    SELECT body, sender_id FROM conversation_messages
+    WHERE conversation_id = c.id
+    ORDER BY created_at DESC
+    LIMIT 1
+) last_message ON TRUE
+WHERE (c.host_id = $1 OR c.player_id = $1)
+AND ($2::uuid IS NULL OR c.id = $2)
+ORDER BY COALESCE(c.last_message_at, c.created_at) DESC
+LIMIT 100
+`
+
+type ListConversationsParams struct {
+	UserID         pgtype.UUID `json:"user_id"`
+	ConversationID pgtype.UUID `json:"conversation_id"`
+}
+
+type ListConversationsRow struct {
+	ID                  pgtype.UUID        `json:"id"`
+	GameID              pgtype.UUID        `json:"game_id"`
+	GameTitle           pgtype.Text        `json:"game_title"`
+	GameCategory        string             `json:"game_category"`
+	GameStartTime       pgtype.Timestamptz `json:"game_start_time"`
+	HostID              pgtype.UUID        `json:"host_id"`
+	OtherUserID         pgtype.UUID        `json:"other_user_id"`
+	OtherFirstName      string             `json:"other_first_name"`
+	OtherLastName       string             `json:"other_last_name"`
+	LastMessageBody     pgtype.Text        `json:"last_message_body"`
+	LastMessageSenderID pgtype.UUID        `json:"last_message_sender_id"`
+	LastMessageAt       pgtype.Timestamptz `json:"last_message_at"`
+	UnreadCount         int64              `json:"unread_count"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+}
+
+// The user's conversations, latest activity first, each with its game, the other user, the last
+// message and how many messages the user hasn't read. Narrowed to one by conversation_id.
+func (q *Queries) ListConversations(ctx context.Context, arg ListConversationsParams) ([]ListConversationsRow, error) {
+	rows, err := q.db.Query(ctx, listConversations, arg.UserID, arg.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListConversationsRow{}
+	for rows.Next() {
+		var i ListConversationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.GameTitle,
+			&i.GameCategory,
+			&i.GameStartTime,
+			&i.HostID,
+			&i.OtherUserID,
+			&i.OtherFirstName,
+			&i.OtherLastName,
+			&i.LastMessageBody,
+			&i.LastMessageSenderID,
+			&i.LastMessageAt,
+			&i.UnreadCount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCreditTransactions = `-- name: ListCreditTransactions :many
+SELECT id, user_id, amount_cents, kind, game_id, balance_after_cents, created_at FROM credit_transactions
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListCreditTransactionsParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+}
+
+func (q *Queries) ListCreditTransactions(ctx context.Context, arg ListCreditTransactionsParams) ([]CreditTransaction, error) {
+	rows, err := q.db.Query(ctx, listCreditTransactions, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CreditTransaction
+	for rows.Next() {
+		var i CreditTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AmountCents,
+			&i.Kind,
+			&i.GameID,
+			&i.BalanceAfterCents,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCurrentLegalDocuments = `-- name: ListCurrentLegalDocuments :many
+SELECT DISTINCT ON (document_type) id, document_type, version, url, published_at, created_at
+FROM legal_documents
+WHERE published_at <= NOW()
+ORDER BY document_type, published_at DESC
+`
+
+func (q *Queries) ListCurrentLegalDocuments(ctx context.Context) ([]LegalDocument, error) {
+	rows, err := q.db.Query(ctx, listCurrentLegalDocuments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LegalDocument{}
+	for rows.Next() {
+		var i LegalDocument
+		if err := rows.Scan(
+			&i.ID,
+			&i.DocumentType,
+			&i.Version,
+			&i.Url,
+			&i.PublishedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeadLetters = `-- name: ListDeadLetters :many
+SELECT id, source, source_id, kind, payload, attempts, attempt_history, last_error, created_at, requeued_at, requeued_by FROM dead_letters
+WHERE ($1::varchar IS NULL OR source = $1)
+AND ($2::boolean OR requeued_at IS NULL)
+ORDER BY created_at DESC
+LIMIT $3::int
+`
+
+type ListDeadLettersParams struct {
+	Source          pgtype.Text `json:"source"`
+	IncludeRequeued bool        `json:"include_requeued"`
+	MaxResults      int32       `json:"max_results"`
+}
+
+// Newest first, optionally from one source and without the ones already re-enqueued
+func (q *Queries) ListDeadLetters(ctx context.Context, arg ListDeadLettersParams) ([]DeadLetter, error) {
+	rows, err := q.db.Query(ctx, listDeadLetters, arg.Source, arg.IncludeRequeued, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DeadLetter{}
+	for rows.Next() {
+		var i DeadLetter
+		if err := rows.Scan(
+			&i.ID,
+			&i.Source,
+			&i.SourceID,
+			&i.Kind,
+			&i.Payload,
+			&i.Attempts,
+			&i.AttemptHistory,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.RequeuedAt,
+			&i.RequeuedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameAnnouncements = `-- name: ListGameAnnouncements :many
+SELECT id, game_id, author_id, message, created_at FROM game_announcements
+WHERE game_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListGameAnnouncements(ctx context.Context, gameID pgtype.UUID) ([]GameAnnouncement, error) {
+	rows, err := q.db.Query(ctx, listGameAnnouncements, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GameAnnouncement{}
+	for rows.Next() {
+		var i GameAnnouncement
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.AuthorID,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameChangesByGame = `-- name: ListGameChangesByGame :many
+SELECT id, game_id, changed_by, field, old_value, new_value, created_at FROM game_changes
+WHERE game_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListGameChangesByGame(ctx context.Context, gameID pgtype.UUID) ([]GameChange, error) {
+	rows, err := q.db.Query(ctx, listGameChangesByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GameChange{}
+	for rows.Next() {
+		var i GameChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.ChangedBy,
+			&i.Field,
+			&i.OldValue,
+			&i.NewValue,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameChatMessages = `-- name: ListGameChatMessages :many
+SELECT m.id, m.game_id, m.sender_id, u.first_name AS sender_first_name, u.last_name AS sender_last_name, m.body, m.created_at
+FROM game_chat_messages m
+LEFT JOIN users u ON u.id = m.sender_id
+WHERE m.game_id = $1
+AND ($2::timestamptz IS NULL OR m.created_at < $2)
+ORDER BY m.created_at DESC
+LIMIT $3::int
+`
+
+type ListGameChatMessagesParams struct {
+	GameID     pgtype.UUID        `json:"game_id"`
+	Before     pgtype.Timestamptz `json:"before"`
+	MaxResults int32              `json:"max_results"`
+}
+
+type ListGameChatMessagesRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	GameID          pgtype.UUID        `json:"game_id"`
+	SenderID        pgtype.UUID        `json:"sender_id"`
+	SenderFirstName pgtype.Text        `json:"sender_first_name"`
+	SenderLastName  pgtype.Text        `json:"sender_last_name"`
+	Body            string             `json:"body"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+// Newest first with the sender's name, optionally only those sent before a time for paging back
+func (q *Queries) ListGameChatMessages(ctx context.Context, arg ListGameChatMessagesParams) ([]ListGameChatMessagesRow, error) {
+	rows, err := q.db.Query(ctx, listGameChatMessages, arg.GameID, arg.Before, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGameChatMessagesRow{}
+	for rows.Next() {
+		var i ListGameChatMessagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.SenderID,
+			&i.SenderFirstName,
+			&i.SenderLastName,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameComments = `-- name: ListGameComments :many
+SELECT c.id, c.parent_id, c.author_id, u.first_name AS author_first_name, u.last_name AS author_last_name,
+    c.body, c.edited_at, c.deleted_at, c.created_at
+FROM game_comments c
+LEFT JOIN users u ON u.id = c.author_id
+WHERE c.game_id = $1
+ORDER BY c.created_at
+LIMIT 500
+`
+
+type ListGameCommentsRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	ParentID        pgtype.UUID        `json:"parent_id"`
+	AuthorID        pgtype.UUID        `json:"author_id"`
+	AuthorFirstName pgtype.Text        `json:"author_first_name"`
+	AuthorLastName  pgtype.Text        `json:"author_last_name"`
+	Body            string             `json:"body"`
+	EditedAt        pgtype.Timestamptz `json:"edited_at"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+// Oldest first with the authors' names; the service nests replies under their threads
+func (q *Queries) ListGameComments(ctx context.Context, gameID pgtype.UUID) ([]ListGameCommentsRow, error) {
+	rows, err := q.db.Query(ctx, listGameComments, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGameCommentsRow{}
+	for rows.Next() {
+		var i ListGameCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParentID,
+			&i.AuthorID,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.Body,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameCreditSpenders = `-- name: ListGameCreditSpenders :many
+SELECT user_id, (-SUM(amount_cents))::INTEGER AS spent_cents
+FROM credit_transactions
+WHERE game_id = $1
+GROUP BY user_id
+HAVING SUM(amount_cents) < 0
+`
+
+type ListGameCreditSpendersRow struct {
+	UserID     pgtype.UUID `json:"user_id"`
+	SpentCents int32       `json:"spent_cents"`
+}
+
+// Users with credit spent on the game that hasn't been refunded yet
+func (q *Queries) ListGameCreditSpenders(ctx context.Context, gameID pgtype.UUID) ([]ListGameCreditSpendersRow, error) {
+	rows, err := q.db.Query(ctx, listGameCreditSpenders, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGameCreditSpendersRow
+	for rows.Next() {
+		var i ListGameCreditSpendersRow
+		if err := rows.Scan(&i.UserID, &i.SpentCents); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameNotificationSettings = `-- name: ListGameNotificationSettings :many
+SELECT game_id, user_id, reminders_muted, chat_muted, updated_at FROM game_notification_settings
+WHERE game_id = $1
+`
+
+func (q *Queries) ListGameNotificationSettings(ctx context.Context, gameID pgtype.UUID) ([]GameNotificationSetting, error) {
+	rows, err := q.db.Query(ctx, listGameNotificationSettings, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GameNotificationSetting{}
+	for rows.Next() {
+		var i GameNotificationSetting
+		if err := rows.Scan(
+			&i.GameID,
+			&i.UserID,
+			&i.RemindersMuted,
+			&i.ChatMuted,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGamePositions = `-- name: ListGamePositions :many
+SELECT game_id, name, capacity FROM game_positions
+WHERE game_id = $1
+ORDER BY name
+`
+
+func (q *Queries) ListGamePositions(ctx context.Context, gameID pgtype.UUID) ([]GamePosition, error) {
+	rows, err := q.db.Query(ctx, listGamePositions, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GamePosition{}
+	for rows.Next() {
+		var i GamePosition
+		if err := rows.Scan(&i.GameID, &i.Name, &i.Capacity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameReservations = `-- name: ListGameReservations :many
+SELECT
+    r.user_id,
+    r.expires_at,
+    r.created_at,
+    u.first_name,
+    u.last_name,
+    EXISTS (
+        SELECT 1 FROM participants p
+        WHERE p.game_id = r.game_id AND p.user_id = r.user_id AND p.status IN ('confirmed', 'waitlist')
+    ) AS joined
+FROM game_reservations r
+JOIN users u ON u.id = r.user_id
+WHERE r.game_id = $1
+ORDER BY r.created_at, r.user_id
+`
+
+type ListGameReservationsRow struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	FirstName string             `json:"first_name"`
+	LastName  string             `json:"last_name"`
+	Joined    bool               `json:"joined"`
+}
+
+func (q *Queries) ListGameReservations(ctx context.Context, gameID pgtype.UUID) ([]ListGameReservationsRow, error) {
+	rows, err := q.db.Query(ctx, listGameReservations, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGameReservationsRow{}
+	for rows.Next() {
+		var i ListGameReservationsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.FirstName,
+			&i.LastName,
+			&i.Joined,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGamesInRadius = `-- name: ListGamesInRadius :many
+SELECT
+    g.id, g.owner_id, g.category, g.title, g.description, g.location_name, g.location_address,
+    geo_latitude(g.location_point) as latitude, geo_longitude(g.location_point) as longitude,
+    g.location_notes, g.start_time, g.duration_minutes, g.max_participants,
+    COALESCE(COUNT(p.id))::int as signup_count,
+    (COUNT(p.id) FILTER (WHERE p.status = 'confirmed'))::int as confirmed_count,
+    (COUNT(p.id) FILTER (WHERE p.status = 'waitlist'))::int as waitlist_count,
+    g.pricing_type, g.pricing_amount_cents, g.pricing_currency, g.signup_deadline,
+    g.drop_deadline, g.skill_level, g.adult_only, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at,
+    up.status as user_participation_status,
+    ST_Distance(g.location_point, geo_point($2::float8, $3::float8))::float8 as distance_meters,
+    ARRAY(SELECT t.tag FROM game_tags t WHERE t.game_id = g.id ORDER BY t.tag)::varchar[] as tags,
+    -- Every match, not just this page; counted before LIMIT and OFFSET apply
+    COUNT(*) OVER ()::int as total_count
+FROM games g
+LEFT JOIN participants p ON p.game_id = g.id AND p.status in ('confirmed', 'waitlist')
+LEFT JOIN participants up ON up.game_id = g.id AND up.user_id = $1
+WHERE ST_DWithin(
+    g.location_point,
+    geo_point($2::float8, $3::float8),
+    $4::float8
+)
+AND g.start_time >= $5
+AND ($6::timestamptz IS NULL OR g.start_time <= $6)
+AND ($7::varchar IS NULL OR g.status = $7)
+AND g.category = ANY($8::varchar[])
+AND ($9::bool OR NOT g.adult_only)
+-- Players the host blocked don't see their games, unless they're still confirmed or waitlisted in one
+AND (up.status IN ('confirmed', 'waitlist') OR NOT EXISTS (
+    SELECT 1 FROM host_blocked_players b
+    WHERE b.host_id = g.owner_id AND b.player_id = $1
+))
+AND (g.owner_id = $1 OR NOT EXISTS (
+    SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = g.owner_id
+))
+AND ($10::varchar[] IS NULL OR g.skill_level = ANY($10::varchar[]))
+AND (NOT $11::bool OR g.pricing_type = 'free' OR g.pricing_amount_cents = 0)
+-- A total is split among the confirmed players, so the share is what it would be with the user confirmed too
+AND ($12::int IS NULL OR (CASE g.pricing_type
+    WHEN 'free' THEN 0
+    WHEN 'per_person' THEN g.pricing_amount_cents
+    ELSE CEIL(g.pricing_amount_cents::numeric / GREATEST(LEAST(
+        (SELECT COUNT(*) FROM participants c WHERE c.game_id = g.id AND c.status = 'confirmed') + 1,
+        g.max_participants), 1))
+END) <= $12)
+-- Days (ISO, Monday = 1) and times of day are read in the searcher's timezone
+AND ($13::int[] IS NULL OR EXTRACT(ISODOW FROM g.start_time AT TIME ZONE $14::text)::int = ANY($13::int[]))
+AND ($15::time IS NULL OR (g.start_time AT TIME ZONE $14::text)::time >= $15::time)
+AND ($16::time IS NULL OR (g.start_time AT TIME ZONE $14::text)::time < $16::time)
+-- A map viewport; the service also passes the circle around it so ST_DWithin can still use the index
+AND ($17::float8 IS NULL OR ST_Intersects(g.location_point::geometry, ST_MakeEnvelope(
+    $17::float8, $18::float8,
+    $19::float8, $20::float8, 4326)))
+-- Tags are deduplicated, so matching all of them means matching as many as were given
+AND ($21::varchar[] IS NULL OR (
+    SELECT COUNT(*) FROM game_tags t WHERE t.game_id = g.id AND t.tag = ANY($21::varchar[])
+) >= CASE WHEN $22::bool THEN cardinality($21::varchar[]) ELSE 1 END)
+-- The signed-in user's relationship to the game: hosting it, confirmed in it or waitlisted for it
+AND ($23::varchar IS NULL OR CASE $23::varchar
+    WHEN 'hosting' THEN g.owner_id = $1
+    WHEN 'joined' THEN up.status = 'confirmed'
+    WHEN 'waitlisted' THEN up.status = 'waitlist'
+    ELSE FALSE
+END)
+GROUP BY g.id, up.user_id, up.status
+ORDER BY g.start_time ASC
+LIMIT $25 OFFSET $24
+`
+
+type ListGamesInRadiusParams struct {
+	UserID                 pgtype.UUID        `json:"user_id"`
+	Longitude              float64            `json:"longitude"`
+	Latitude               float64            `json:"latitude"`
+	Radius                 float64            `json:"radius"`
+	StartTime              pgtype.Timestamptz `json:"start_time"`
+	EndTime                pgtype.Timestamptz `json:"end_time"`
+	Status                 pgtype.Text        `json:"status"`
+	Categories             []string           `json:"categories"`
+	IncludeAdultOnly       bool               `json:"include_adult_only"`
+	SkillLevels            []string           `json:"skill_levels"`
+	FreeOnly               bool               `json:"free_only"`
+	MaxPricePerPersonCents pgtype.Int4        `json:"max_price_per_person_cents"`
+	DaysOfWeek             []int32            `json:"days_of_week"`
+	TimeZone               string             `json:"time_zone"`
+	StartsAfter            pgtype.Time        `json:"starts_after"`
+	StartsBefore           pgtype.Time        `json:"starts_before"`
+	MinLongitude           pgtype.Float8      `json:"min_longitude"`
+	MinLatitude            pgtype.Float8      `json:"min_latitude"`
+	MaxLongitude           pgtype.Float8      `json:"max_longitude"`
+	MaxLatitude            pgtype.Float8      `json:"max_latitude"`
+	Tags                   []string           `json:"tags"`
+	MatchAllTags           bool               `json:"match_all_tags"`
+	Relationship           pgtype.Text        `json:"relationship"`
+	Offset                 int32              `json:"offset"`
+	Limit                  int32              `json:"limit"`
+}
+
+type ListGamesInRadiusRow struct {
+	ID                      pgtype.UUID        `json:"id"`
+	OwnerID                 pgtype.UUID        `json:"owner_id"`
+	Category                string             `json:"category"`
+	Title                   pgtype.Text        `json:"title"`
+	Description             pgtype.Text        `json:"description"`
+	LocationName            string             `json:"location_name"`
+	LocationAddress         pgtype.Text        `json:"location_address"`
+	Latitude                interface{}        `json:"latitude"`
+	Longitude               interface{}        `json:"longitude"`
+	LocationNotes           pgtype.Text        `json:"location_notes"`
+	StartTime               pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes         int32              `json:"duration_minutes"`
+	MaxParticipants         int32              `json:"max_participants"`
+	SignupCount             int32              `json:"signup_count"`
+	ConfirmedCount          int32              `json:"confirmed_count"`
+	WaitlistCount           int32              `json:"waitlist_count"`
+	PricingType             string             `json:"pricing_type"`
+	PricingAmountCents      int32              `json:"pricing_amount_cents"`
+	PricingCurrency         string             `json:"pricing_currency"`
+	SignupDeadline          pgtype.Timestamptz `json:"signup_deadline"`
+	DropDeadline            pgtype.Timestamptz `json:"drop_deadline"`
+	SkillLevel              string             `json:"skill_level"`
+	AdultOnly               bool               `json:"adult_only"`
+	Notes                   pgtype.Text        `json:"notes"`
+	Status                  string             `json:"status"`
+	CancelledAt             pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt               pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt               pgtype.Timestamptz `json:"updated_at"`
+	UserParticipationStatus pgtype.Text        `json:"user_participation_status"`
+	DistanceMeters          float64            `json:"distance_meters"`
+	Tags                    []string           `json:"tags"`
+	TotalCount              int32              `json:"total_count"`
+}
+
+func (q *Queries) ListGamesInRadius(ctx context.Context, arg ListGamesInRadiusParams) ([]ListGamesInRadiusRow, error) {
+	rows, err := q.db.Query(ctx, listGamesInRadius,
+		arg.UserID,
+		arg.Longitude,
+		arg.Latitude,
+		arg.Radius,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Status,
+		arg.Categories,
+		arg.IncludeAdultOnly,
+		arg.SkillLevels,
+		arg.FreeOnly,
+		arg.MaxPricePerPersonCents,
+		arg.DaysOfWeek,
+		arg.TimeZone,
+		arg.StartsAfter,
+		arg.StartsBefore,
+		arg.MinLongitude,
+		arg.MinLatitude,
+		arg.MaxLongitude,
+		arg.MaxLatitude,
+		arg.Tags,
+		arg.MatchAllTags,
+		arg.Relationship,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGamesInRadiusRow{}
+	for rows.Next() {
+		var i ListGamesInRadiusRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Category,
+			&i.Title,
+			&i.Description,
+			&i.LocationName,
+			&i.LocationAddress,
+			&i.Latitude,
+			&i.Longitude,
+			&i.LocationNotes,
+			&i.StartTime,
+			&i.DurationMinutes,
+			&i.MaxParticipants,
+			&i.SignupCount,
+			&i.ConfirmedCount,
+			&i.WaitlistCount,
+			&i.PricingType,
+			&i.PricingAmountCents,
+			&i.PricingCurrency,
+			&i.SignupDeadline,
+			&i.DropDeadline,
+			&i.SkillLevel,
+			&i.AdultOnly,
+			&i.Notes,
+			&i.Status,
+			&i.CancelledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserParticipationStatus,
+			&i.DistanceMeters,
+			&i.Tags,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGamesWithExpiredReservations = `-- name: ListGamesWithExpiredReservations :many
+SELECT DISTINCT game_id FROM game_reservations
+WHERE expires_at <= NOW() AND released_at IS NULL
+`
+
+// Games with reservations that expired since their roster was last reconciled
+func (q *Queries) ListGamesWithExpiredReservations(ctx context.Context) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listGamesWithExpiredReservations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var game_id pgtype.UUID
+		if err := rows.Scan(&game_id); err != nil {
+			return nil, err
+		}
+		items = append(items, game_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameTags = `-- name: ListGameTags :many
+SELECT tag FROM game_tags
+WHERE game_id = $1
+ORDER BY tag
+`
+
+func (q *Queries) ListGameTags(ctx context.Context, gameID pgtype.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listGameTags, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLeaderboard = `-- name: ListLeaderboard :many
+SELECT
+    l.user_id,
+    u.first_name,
+    u.last_name,
+    SUM(l.games_played)::int AS games_played,
+    SUM(l.games_hosted)::int AS games_hosted,
+    SUM(l.mvps)::int AS mvps
+FROM leaderboard_cells l
+JOIN users u ON u.id = l.user_id
+WHERE ST_DWithin(
+    l.cell_point,
+    geo_point($1::float8, $2::float8),
+    $3::float8
+)
+AND ($4::varchar IS NULL OR l.category = $4)
+AND NOT EXISTS (SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = l.user_id)
+GROUP BY l.user_id, u.first_name, u.last_name
+HAVING CASE $5::text
+    WHEN 'hosted' THEN SUM(l.games_hosted)
+    WHEN 'mvps' THEN SUM(l.mvps)
+    ELSE SUM(l.games_played)
+END > 0
+ORDER BY CASE $5::text
+    WHEN 'hosted' THEN SUM(l.games_hosted)
+    WHEN 'mvps' THEN SUM(l.mvps)
+    ELSE SUM(l.games_played)
+END DESC, l.user_id
+LIMIT $6 OFFSET $7
+`
+
+type ListLeaderboardParams struct {
+	Longitude  float64     `json:"longitude"`
+	Latitude   float64     `json:"latitude"`
+	Radius     float64     `json:"radius"`
+	Category   pgtype.Text `json:"category"`
+	Metric     string      `json:"metric"`
+	PageLimit  int32       `json:"page_limit"`
+	PageOffset int32       `json:"page_offset"`
+}
+
+type ListLeaderboardRow struct {
+	UserID      pgtype.UUID `json:"user_id"`
+	FirstName   string      `json:"first_name"`
+	LastName    string      `json:"last_name"`
+	GamesPlayed int32       `json:"games_played"`
+	GamesHosted int32       `json:"games_hosted"`
+	Mvps        int32       `json:"mvps"`
+}
+
+// Players ranked by metric (played, hosted or mvps) over the cells within radius meters, optionally
+// of one category. Shadow-banned players are left off.
+func (q *Queries) ListLeaderboard(ctx context.Context, arg ListLeaderboardParams) ([]ListLeaderboardRow, error) {
+	rows, err := q.db.Query(ctx, listLeaderboard,
+		arg.Longitude,
+		arg.Latitude,
+		arg.Radius,
+		arg.Category,
+		arg.Metric,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLeaderboardRow{}
+	for rows.Next() {
+		var i ListLeaderboardRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.FirstName,
+			&i.LastName,
+			&i.GamesPlayed,
+			&i.GamesHosted,
+			&i.Mvps,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLegalAcceptancesByUser = `-- name: ListLegalAcceptancesByUser :many
+SELECT
+    a.id,
+    d.document_type,
+    d.version,
+    a.ip_address,
+    a.user_agent,
+    a.accepted_at
+FROM legal_acceptances a
+INNER JOIN legal_documents d ON a.document_id = d.id
+WHERE a.user_id = $1
+ORDER BY a.accepted_at DESC
+`
+
+type ListLegalAcceptancesByUserRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	DocumentType string             `json:"document_type"`
+	Version      string             `json:"version"`
+	IpAddress    pgtype.Text        `json:"ip_address"`
+	UserAgent    pgtype.Text        `json:"user_agent"`
+	AcceptedAt   pgtype.Timestamptz `json:"accepted_at"`
+}
+
+func (q *Queries) ListLegalAcceptancesByUser(ctx context.Context, userID pgtype.UUID) ([]ListLegalAcceptancesByUserRow, error) {
+	rows, err := q.db.Query(ctx, listLegalAcceptancesByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLegalAcceptancesByUserRow{}
+	for rows.Next() {
+		var i ListLegalAcceptancesByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DocumentType,
+			&i.Version,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.AcceptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNewGameAlertRecipients = `-- name: ListNewGameAlertRecipients :many
+SELECT DISTINCT ON (s.user_id)
+    s.id AS saved_search_id,
+    s.name AS saved_search_name,
+    u.id AS user_id,
+    u.email,
+    u.first_name
+FROM games g
+JOIN saved_searches s ON s.alerts_enabled
+    AND ST_DWithin(s.search_point, g.location_point, (s.filters->>'radius')::float8)
+JOIN users u ON u.id = s.user_id
+WHERE g.id = $1
+  AND g.status = 'open'
+  AND s.user_id <> g.owner_id
+  AND CASE
+      WHEN jsonb_array_length(COALESCE(s.filters->'categories', '[]'::jsonb)) > 0
+          THEN s.filters->'categories' @> jsonb_build_array(g.category)
+      ELSE NOT EXISTS (SELECT 1 FROM user_sport_preferences p WHERE p.user_id = s.user_id)
+          OR EXISTS (SELECT 1 FROM user_sport_preferences p WHERE p.user_id = s.user_id AND p.category = g.category)
+  END
+  AND (jsonb_array_length(COALESCE(s.filters->'skillLevels', '[]'::jsonb)) = 0
+      OR s.filters->'skillLevels' @> jsonb_build_array(g.skill_level))
+  AND NOT (g.adult_only AND COALESCE(u.birthdate > CURRENT_DATE - INTERVAL '18 years', FALSE))
+  AND NOT EXISTS (SELECT 1 FROM host_blocked_players b WHERE b.host_id = g.owner_id AND b.player_id = s.user_id)
+  AND NOT EXISTS (SELECT 1 FROM user_deactivations d WHERE d.user_id = s.user_id)
+  AND NOT EXISTS (SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = g.owner_id)
+  AND NOT EXISTS (SELECT 1 FROM new_game_alerts a WHERE a.user_id = s.user_id AND a.game_id = g.id)
+ORDER BY s.user_id, s.created_at
+`
+
+type ListNewGameAlertRecipientsRow struct {
+	SavedSearchID   pgtype.UUID `json:"saved_search_id"`
+	SavedSearchName string      `json:"saved_search_name"`
+	UserID          pgtype.UUID `json:"user_id"`
+	Email           string      `json:"email"`
+	FirstName       string      `json:"first_name"`
+}
+
+// Users with a saved search the game matches, each with their oldest matching search. A search
+// without categories follows the user's sport preferences, like GET /v1/games. Users the game is
+// hidden from, its host, and users already alerted about it are left out.
+func (q *Queries) ListNewGameAlertRecipients(ctx context.Context, gameID pgtype.UUID) ([]ListNewGameAlertRecipientsRow, error) {
+	rows, err := q.db.Query(ctx, listNewGameAlertRecipients, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListNewGameAlertRecipientsRow{}
+	for rows.Next() {
+		var i ListNewGameAlertRecipientsRow
+		if err := rows.Scan(
+			&i.SavedSearchID,
+			&i.SavedSearchName,
+			&i.UserID,
+			&i.Email,
+			&i.FirstName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOwnerUpcomingGames = `-- name: ListOwnerUpcomingGames :many
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.start_time,
+    g.max_participants,
+    g.pricing_type,
+    g.status,
+    COUNT(p.id) FILTER (WHERE p.status = 'confirmed') AS confirmed_count
+FROM games g
+LEFT JOIN participants p ON p.game_id = g.id
+WHERE g.owner_id = $1
+AND g.status IN ('open', 'full', 'closed')
+AND g.start_time > NOW()
+GROUP BY g.id
+ORDER BY g.start_time ASC
+`
+
+type ListOwnerUpcomingGamesRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	Title           pgtype.Text        `json:"title"`
+	Category        string             `json:"category"`
+	LocationName    string             `json:"location_name"`
+	StartTime       pgtype.Timestamptz `json:"start_time"`
+	MaxParticipants int32              `json:"max_participants"`
+	PricingType     string             `json:"pricing_type"`
+	Status          string             `json:"status"`
+	ConfirmedCount  int64              `json:"confirmed_count"`
+}
+
+func (q *Queries) ListOwnerUpcomingGames(ctx context.Context, ownerID pgtype.UUID) ([]ListOwnerUpcomingGamesRow, error) {
+	rows, err := q.db.Query(ctx, listOwnerUpcomingGames, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOwnerUpcomingGamesRow{}
+	for rows.Next() {
+		var i ListOwnerUpcomingGamesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Category,
+			&i.LocationName,
+			&i.StartTime,
+			&i.MaxParticipants,
+			&i.PricingType,
+			&i.Status,
+			&i.ConfirmedCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listParticipantsByGame = `-- name: ListParticipantsByGame :many
+SELECT
+    p.id,
+    p.game_id,
+    p.user_id,
+    p.team_id,
+    p.status,
+    p.paid,
+    p.payment_amount_cents,
+    p.notes,
+    p.joined_at,
+    p.updated_at,
+    COALESCE(u.email, '')::text AS email,
+    COALESCE(u.first_name, p.placeholder_name)::text AS first_name,
+    COALESCE(u.last_name, '')::text AS last_name,
+    u.birthdate,
+    p.checked_in_at,
+    p.position,
+    EXISTS (SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = p.user_id) AS shadow_banned
+FROM participants p
+LEFT JOIN users u ON p.user_id = u.id
+WHERE p.game_id = $1
+ORDER BY p.waitlist_rank ASC
+`
+
+type ListParticipantsByGameRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	GameID             pgtype.UUID        `json:"game_id"`
+	UserID             pgtype.UUID        `json:"user_id"`
+	TeamID             pgtype.UUID        `json:"team_id"`
+	Status             string             `json:"status"`
+	Paid               bool               `json:"paid"`
+	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
+	Notes              pgtype.Text        `json:"notes"`
+	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	Email              string             `json:"email"`
+	FirstName          string             `json:"first_name"`
+	LastName           string             `json:"last_name"`
+	Birthdate          pgtype.Date        `json:"birthdate"`
+	CheckedInAt        pgtype.Timestamptz `json:"checked_in_at"`
+	Position           pgtype.Text        `json:"position"`
+	ShadowBanned       bool               `json:"shadow_banned"`
+}
+
+func (q *Queries) ListParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]ListParticipantsByGameRow, error) {
+	rows, err := q.db.Query(ctx, listParticipantsByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListParticipantsByGameRow{}
+	for rows.Next() {
+		var i ListParticipantsByGameRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.UserID,
+			&i.TeamID,
+			&i.Status,
+			&i.Paid,
+			&i.PaymentAmountCents,
+			&i.Notes,
+			&i.JoinedAt,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.Birthdate,
+			&i.CheckedInAt,
+			&i.Position,
+			&i.ShadowBanned,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listParticipantsByGamePage = `-- name: ListParticipantsByGamePage :many
+WITH roster AS (
+    SELECT
+        p.id,
+        p.game_id,
+        p.user_id,
+        p.team_id,
+        p.status,
+        p.paid,
+        p.payment_amount_cents,
+        p.notes,
+        p.joined_at,
+        p.updated_at,
+        COALESCE(u.email, '')::text AS email,
+        COALESCE(u.first_name, p.placeholder_name)::text AS first_name,
+        COALESCE(u.last_name, '')::text AS last_name,
+        u.birthdate,
+        p.drop_reason,
+        p.checked_in_at,
+        p.position,
+        p.late_drop,
+        p.waitlist_rank,
+        CASE WHEN p.status = 'waitlist'
+            THEN ROW_NUMBER() OVER (PARTITION BY p.status ORDER BY p.waitlist_rank ASC)
+        END AS waitlist_position
+    FROM participants p
+    LEFT JOIN users u ON p.user_id = u.id
+    WHERE p.game_id = $1
+)
+SELECT
+    id,
+    game_id,
+    user_id,
+    team_id,
+    status,
+    paid,
+    payment_amount_cents,
+    notes,
+    joined_at,
+    updated_at,
+    email,
+    first_name,
+    last_name,
+    birthdate,
+    waitlist_position,
+    drop_reason,
+    checked_in_at,
+    position,
+    late_drop
+FROM roster
+WHERE ($2::text IS NULL OR status = $2::text)
+AND (
+    $3::boolean
+    OR user_id = $4
+    OR birthdate IS NULL
+    OR birthdate <= CURRENT_DATE - INTERVAL '18 years'
+)
+ORDER BY
+    CASE WHEN $5::text = 'name' THEN lower(last_name) END ASC,
+    CASE WHEN $5::text = 'name' THEN lower(first_name) END ASC,
+    CASE WHEN $5::text = 'joined_at_desc' THEN joined_at END DESC,
+    waitlist_rank ASC,
+    id ASC
+LIMIT $6 OFFSET $7
+`
+
+type ListParticipantsByGamePageParams struct {
+	GameID        pgtype.UUID `json:"game_id"`
+	Status        pgtype.Text `json:"status"`
+	IncludeMinors bool        `json:"include_minors"`
+	ViewerID      pgtype.UUID `json:"viewer_id"`
+	Sort          string      `json:"sort"`
+	PageLimit     int32       `json:"page_limit"`
+	PageOffset    int32       `json:"page_offset"`
+}
+
+type ListParticipantsByGamePageRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	GameID             pgtype.UUID        `json:"game_id"`
+	UserID             pgtype.UUID        `json:"user_id"`
+	TeamID             pgtype.UUID        `json:"team_id"`
+	Status             string             `json:"status"`
+	Paid               bool               `json:"paid"`
+	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
+	Notes              pgtype.Text        `json:"notes"`
+	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	Email              string             `json:"email"`
+	FirstName          string             `json:"first_name"`
+	LastName           string             `json:"last_name"`
+	Birthdate          pgtype.Date        `json:"birthdate"`
+	WaitlistPosition   pgtype.Int8        `json:"waitlist_position"`
+	DropReason         pgtype.Text        `json:"drop_reason"`
+	CheckedInAt        pgtype.Timestamptz `json:"checked_in_at"`
+	Position           pgtype.Text        `json:"position"`
+	LateDrop           bool               `json:"late_drop"`
+}
+
+func (q *Queries) ListParticipantsByGamePage(ctx context.Context, arg ListParticipantsByGamePageParams) ([]ListParticipantsByGamePageRow, error) {
+	rows, err := q.db.Query(ctx, listParticipantsByGamePage,
+		arg.GameID,
+		arg.Status,
+		arg.IncludeMinors,
+		arg.ViewerID,
+		arg.Sort,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListParticipantsByGamePageRow{}
+	for rows.Next() {
+		var i ListParticipantsByGamePageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.UserID,
+			&i.TeamID,
+			&i.Status,
+			&i.Paid,
+			&i.PaymentAmountCents,
+			&i.Notes,
+			&i.JoinedAt,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.Birthdate,
+			&i.WaitlistPosition,
+			&i.DropReason,
+			&i.CheckedInAt,
+			&i.Position,
+			&i.LateDrop,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listParticipantsByGames = `-- name: ListParticipantsByGames :many
+SELECT
+    p.id,
+    p.game_id,
+    p.user_id,
+    p.team_id,
+    p.status,
+    p.paid,
+    p.payment_amount_cents,
+    p.notes,
+    p.joined_at,
+    p.updated_at,
+    COALESCE(u.email, '')::text AS email,
+    COALESCE(u.first_name, p.placeholder_name)::text AS first_name,
+    COALESCE(u.last_name, '')::text AS last_name,
+    u.birthdate
+FROM participants p
+LEFT JOIN users u ON p.user_id = u.id
+WHERE p.game_id = ANY($1::uuid[])
+ORDER BY p.game_id, p.waitlist_rank ASC
+`
+
+type ListParticipantsByGamesRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	GameID             pgtype.UUID        `json:"game_id"`
+	UserID             pgtype.UUID        `json:"user_id"`
+	TeamID             pgtype.UUID        `json:"team_id"`
+	Status             string             `json:"status"`
+	Paid               bool               `json:"paid"`
+	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
+	Notes              pgtype.Text        `json:"notes"`
+	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	Email              string             `json:"email"`
+	FirstName          string             `json:"first_name"`
+	LastName           string             `json:"last_name"`
+	Birthdate          pgtype.Date        `json:"birthdate"`
+}
+
+func (q *Queries) ListParticipantsByGames(ctx context.Context, gameIds []pgtype.UUID) ([]ListParticipantsByGamesRow, error) {
+	rows, err := q.db.Query(ctx, listParticipantsByGames, gameIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListParticipantsByGamesRow{}
+	for rows.Next() {
+		var i ListParticipantsByGamesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.UserID,
+			&i.TeamID,
+			&i.Status,
+			&i.Paid,
+			&i.PaymentAmountCents,
+			&i.Notes,
+			&i.JoinedAt,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.Birthdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listParticipantsByUser = `-- name: ListParticipantsByUser :many
+SELECT id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank FROM participants
+WHERE user_id = $1
+ORDER BY joined_at DESC
+`
+
+func (q *Queries) ListParticipantsByUser(ctx context.Context, userID pgtype.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, listParticipantsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Participant{}
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.UserID,
+			&i.TeamID,
+			&i.Status,
+			&i.Paid,
+			&i.PaymentAmountCents,
+			&i.Notes,
+			&i.JoinedAt,
+			&i.UpdatedAt,
+			&i.PlaceholderName,
+			&i.DropReason,
+			&i.CheckedInAt,
+			&i.Position,
+			&i.LateDrop,
+			&i.WaitlistRank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listParticipationCorrections = `-- name: ListParticipationCorrections :many
+SELECT id, game_id, user_id, corrected_by, field, old_value, new_value, reason, created_at FROM participation_corrections
+WHERE game_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListParticipationCorrections(ctx context.Context, gameID pgtype.UUID) ([]ParticipationCorrection, error) {
+	rows, err := q.db.Query(ctx, listParticipationCorrections, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ParticipationCorrection{}
+	for rows.Next() {
+		var i ParticipationCorrection
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.UserID,
+			&i.CorrectedBy,
+			&i.Field,
+			&i.OldValue,
+			&i.NewValue,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listParticipationJournalByGame = `-- name: ListParticipationJournalByGame :many
+SELECT id, game_id, user_id, action, requested_at, completed_at, outcome, error, promoted_user_id FROM participation_journal
+WHERE game_id = $1
+ORDER BY requested_at ASC, id ASC
+`
+
+func (q *Queries) ListParticipationJournalByGame(ctx context.Context, gameID pgtype.UUID) ([]ParticipationJournal, error) {
+	rows, err := q.db.Query(ctx, listParticipationJournalByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ParticipationJournal{}
+	for rows.Next() {
+		var i ParticipationJournal
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.UserID,
+			&i.Action,
+			&i.RequestedAt,
+			&i.CompletedAt,
+			&i.Outcome,
+			&i.Error,
+			&i.PromotedUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingLegalDocuments = `-- name: ListPendingLegalDocuments :many
+SELECT d.id, d.document_type, d.version, d.url, d.published_at, d.created_at
+FROM legal_documents d
+WHERE d.published_at <= NOW()
+AND NOT EXISTS (
+    SELECT 1 FROM legal_documents newer
+    WHERE newer.document_type = d.document_type
+    AND newer.published_at <= NOW()
+    AND newer.published_at > d.published_at
+)
+AND NOT EXISTS (
+    SELECT 1 FROM legal_acceptances a
+    WHERE a.document_id = d.id
+    AND a.user_id = $1
+)
+ORDER BY d.document_type
+`
+
+func (q *Queries) ListPendingLegalDocuments(ctx context.Context, userID pgtype.UUID) ([]LegalDocument, error) {
+	rows, err := q.db.Query(ctx, listPendingLegalDocuments, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LegalDocument{}
+	for rows.Next() {
+		var i LegalDocument
+		if err := rows.Scan(
+			&i.ID,
+			&i.DocumentType,
+			&i.Version,
+			&i.Url,
+			&i.PublishedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlayerReliability = `-- name: ListPlayerReliability :many
+SELECT user_id, honored, late_drops, no_shows, score, computed_at FROM player_reliability
+WHERE user_id = ANY($1::uuid[])
+`
+
+func (q *Queries) ListPlayerReliability(ctx context.Context, userIds []pgtype.UUID) ([]PlayerReliability, error) {
+	rows, err := q.db.Query(ctx, listPlayerReliability, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PlayerReliability{}
+	for rows.Next() {
+		var i PlayerReliability
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Honored,
+			&i.LateDrops,
+			&i.NoShows,
+			&i.Score,
+			&i.ComputedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentFailedLoginsByEmail = `-- name: ListRecentFailedLoginsByEmail :many
+SELECT attempted_at FROM failed_logins
+WHERE email = $1
+AND attempted_at > $2
+ORDER BY attempted_at DESC
+LIMIT $3
+`
+
+type ListRecentFailedLoginsByEmailParams struct {
+	Email       string             `json:"email"`
+	AttemptedAt pgtype.Timestamptz `json:"attempted_at"`
+	Limit       int32              `json:"limit"`
+}
+
+// Newest first; with LIMIT n, the last row is the one whose expiry brings the email back under n failures
+func (q *Queries) ListRecentFailedLoginsByEmail(ctx context.Context, arg ListRecentFailedLoginsByEmailParams) ([]pgtype.Timestamptz, error) {
+	rows, err := q.db.Query(ctx, listRecentFailedLoginsByEmail, arg.Email, arg.AttemptedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Timestamptz{}
+	for rows.Next() {
+		var attempted_at pgtype.Timestamptz
+		if err := rows.Scan(&attempted_at); err != nil {
+			return nil, err
+		}
+		items = append(items, attempted_at)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentFailedLoginsByIP = `-- name: ListRecentFailedLoginsByIP :many
+SELECT attempted_at FROM failed_logins
+WHERE ip_address = $1
+AND attempted_at > $2
+ORDER BY attempted_at DESC
+LIMIT $3
+`
+
+type ListRecentFailedLoginsByIPParams struct {
+	IpAddress   string             `json:"ip_address"`
+	AttemptedAt pgtype.Timestamptz `json:"attempted_at"`
+	Limit       int32              `json:"limit"`
+}
+
+// Newest first; with LIMIT n, the last row is the one whose expiry brings the IP back under n failures
+func (q *Queries) ListRecentFailedLoginsByIP(ctx context.Context, arg ListRecentFailedLoginsByIPParams) ([]pgtype.Timestamptz, error) {
+	rows, err := q.db.Query(ctx, listRecentFailedLoginsByIP, arg.IpAddress, arg.AttemptedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Timestamptz{}
+	for rows.Next() {
+		var attempted_at pgtype.Timestamptz
+		if err := rows.Scan(&attempted_at); err != nil {
+			return nil, err
+		}
+		items = append(items, attempted_at)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentGameCreationsByOwner = `-- name: ListRecentGameCreationsByOwner :many
+SELECT created_at FROM games
+WHERE owner_id = $1
+AND created_at > $2
+ORDER BY created_at DESC
+LIMIT $3
+`
+
+type ListRecentGameCreationsByOwnerParams struct {
+	OwnerID   pgtype.UUID        `json:"owner_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Limit     int32              `json:"limit"`
+}
+
+// Newest first; with LIMIT n, the last row is the one whose expiry brings the host back under n creations
+func (q *Queries) ListRecentGameCreationsByOwner(ctx context.Context, arg ListRecentGameCreationsByOwnerParams) ([]pgtype.Timestamptz, error) {
+	rows, err := q.db.Query(ctx, listRecentGameCreationsByOwner, arg.OwnerID, arg.CreatedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Timestamptz{}
+	for rows.Next() {
+		var created_at pgtype.Timestamptz
+		if err := rows.Scan(&created_at); err != nil {
+			return nil, err
+		}
+		items = append(items, created_at)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentReferrals = `-- name: ListRecentReferrals :many
+SELECT u.first_name, r.created_at
+FROM referrals r
+JOIN users u ON u.id = r.referred_id
+WHERE r.referrer_id = $1
+ORDER BY r.created_at DESC
+LIMIT $2
+`
+
+type ListRecentReferralsParams struct {
+	ReferrerID pgtype.UUID `json:"referrer_id"`
+	Limit      int32       `json:"limit"`
+}
+
+type ListRecentReferralsRow struct {
+	FirstName string             `json:"first_name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListRecentReferrals(ctx context.Context, arg ListRecentReferralsParams) ([]ListRecentReferralsRow, error) {
+	rows, err := q.db.Query(ctx, listRecentReferrals, arg.ReferrerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecentReferralsRow
+	for rows.Next() {
+		var i ListRecentReferralsRow
+		if err := rows.Scan(&i.FirstName, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReports = `-- name: ListReports :many
+SELECT id, reporter_id, target_type, target_id, reason, details, status, created_at, reviewed_by, reviewed_at FROM reports
+WHERE ($1::varchar IS NULL OR status = $1)
+AND ($2::varchar IS NULL OR target_type = $2)
+ORDER BY created_at ASC, id
+LIMIT $3 OFFSET $4
+`
+
+type ListReportsParams struct {
+	Status     pgtype.Text `json:"status"`
+	TargetType pgtype.Text `json:"target_type"`
+	PageLimit  int32       `json:"page_limit"`
+	PageOffset int32       `json:"page_offset"`
+}
+
+func (q *Queries) ListReports(ctx context.Context, arg ListReportsParams) ([]Report, error) {
+	rows, err := q.db.Query(ctx, listReports,
+		arg.Status,
+		arg.TargetType,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Report{}
+	for rows.Next() {
+		var i Report
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReporterID,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Reason,
+			&i.Details,
+			&i.Status,
+			&i.CreatedAt,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRosterSnapshotEntries = `-- name: ListRosterSnapshotEntries :many
+SELECT
+    e.participant_id,
+    e.user_id,
+    e.first_name,
+    e.last_name,
+    e.status,
+    e.waitlist_position,
+    e.team_id,
+    e.paid,
+    e.payment_amount_cents,
+    e.joined_at,
+    u.birthdate
+FROM roster_snapshot_entries e
+LEFT JOIN users u ON e.user_id = u.id
+WHERE e.game_id = $1
+ORDER BY e.waitlist_position ASC NULLS FIRST, e.joined_at ASC
+`
+
+type ListRosterSnapshotEntriesRow struct {
+	ParticipantID      pgtype.UUID        `json:"participant_id"`
+	UserID             pgtype.UUID        `json:"user_id"`
+	FirstName          string             `json:"first_name"`
+	LastName           string             `json:"last_name"`
+	Status             string             `json:"status"`
+	WaitlistPosition   pgtype.Int4        `json:"waitlist_position"`
+	TeamID             pgtype.UUID        `json:"team_id"`
+	Paid               bool               `json:"paid"`
+	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
+	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
+	Birthdate          pgtype.Date        `json:"birthdate"`
+}
+
+func (q *Queries) ListRosterSnapshotEntries(ctx context.Context, gameID pgtype.UUID) ([]ListRosterSnapshotEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listRosterSnapshotEntries, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRosterSnapshotEntriesRow{}
+	for rows.Next() {
+		var i ListRosterSnapshotEntriesRow
+		if err := rows.Scan(
+			&i.ParticipantID,
+			&i.UserID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Status,
+			&i.WaitlistPosition,
+			&i.TeamID,
+			&i.Paid,
+			&i.PaymentAmountCents,
+			&i.JoinedAt,
+			&i.Birthdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSavedSearches = `-- name: ListSavedSearches :many
+SELECT id, user_id, name, filters, created_at, alerts_enabled, search_point FROM saved_searches
+WHERE user_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListSavedSearches(ctx context.Context, userID pgtype.UUID) ([]SavedSearch, error) {
+	rows, err := q.db.Query(ctx, listSavedSearches, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SavedSearch{}
+	for rows.Next() {
+		var i SavedSearch
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Filters,
+			&i.CreatedAt,
+			&i.AlertsEnabled,
+			&i.SearchPoint,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSharedContacts = `-- name: ListSharedContacts :many
+SELECT
+    c.user_id,
+    u.first_name,
+    u.last_name,
+    u.phone_number,
+    c.consented_at
+FROM contact_share_consents c
+JOIN participants p ON p.game_id = c.game_id AND p.user_id = c.user_id
+JOIN users u ON u.id = c.user_id
+JOIN games g ON g.id = c.game_id
+WHERE c.game_id = $1
+AND p.status = 'confirmed'
+AND u.phone_number IS NOT NULL
+AND NOT EXISTS (
+    SELECT 1 FROM host_blocked_players b
+    WHERE b.host_id = c.user_id AND b.player_id = g.owner_id
+)
+ORDER BY u.last_name, u.first_name
+`
+
+type ListSharedContactsRow struct {
+	UserID      pgtype.UUID        `json:"user_id"`
+	FirstName   string             `json:"first_name"`
+	LastName    string             `json:"last_name"`
+	PhoneNumber pgtype.Text        `json:"phone_number"`
+	ConsentedAt pgtype.Timestamptz `json:"consented_at"`
+}
+
+// Consenting players who are still confirmed, still have a phone number and haven't blocked the host
+func (q *Queries) ListSharedContacts(ctx context.Context, gameID pgtype.UUID) ([]ListSharedContactsRow, error) {
+	rows, err := q.db.Query(ctx, listSharedContacts, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSharedContactsRow{}
+	for rows.Next() {
+		var i ListSharedContactsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.FirstName,
+			&i.LastName,
+			&i.PhoneNumber,
+			&i.ConsentedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSkillEndorsementCounts = `-- name: ListSkillEndorsementCounts :many
+SELECT endorsee_id, skill_level, COUNT(*)::int AS endorsers
+FROM (
+    SELECT DISTINCT ON (endorsee_id, endorser_id) endorsee_id, skill_level
+    FROM skill_endorsements
+    WHERE endorsee_id = ANY($1::uuid[])
+    ORDER BY endorsee_id, endorser_id, updated_at DESC
+) latest
+GROUP BY endorsee_id, skill_level
+`
+
+type ListSkillEndorsementCountsRow struct {
+	EndorseeID pgtype.UUID `json:"endorsee_id"`
+	SkillLevel string      `json:"skill_level"`
+	Endorsers  int32       `json:"endorsers"`
+}
+
+// Endorsements per skill level of each user. An endorser who played several games with a user
+// counts once, at the level of their latest endorsement.
+func (q *Queries) ListSkillEndorsementCounts(ctx context.Context, userIds []pgtype.UUID) ([]ListSkillEndorsementCountsRow, error) {
+	rows, err := q.db.Query(ctx, listSkillEndorsementCounts, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSkillEndorsementCountsRow{}
+	for rows.Next() {
+		var i ListSkillEndorsementCountsRow
+		if err := rows.Scan(&i.EndorseeID, &i.SkillLevel, &i.Endorsers); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamsByGame = `-- name: ListTeamsByGame :many
+SELECT id, game_id, name, color, created_at FROM teams
+WHERE game_id = $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListTeamsByGame(ctx context.Context, gameID pgtype.UUID) ([]Team, error) {
+	rows, err := q.db.Query(ctx, listTeamsByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Team{}
+	for rows.Next() {
+		var i Team
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.Name,
+			&i.Color,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUpcomingGamesInRadius = `-- name: ListUpcomingGamesInRadius :many
+SELECT
+    g.id, g.owner_id, g.category, g.title, g.description, g.location_name, g.location_address,
+    geo_latitude(g.location_point) as latitude, geo_longitude(g.location_point) as longitude,
+    g.location_notes, g.start_time, g.duration_minutes, g.max_participants,
+    ug.signup_count, ug.confirmed_count, ug.waitlist_count,
+    g.pricing_type, g.pricing_amount_cents, g.pricing_currency, g.signup_deadline,
+    g.drop_deadline, g.skill_level, g.adult_only, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at,
+    up.status as user_participation_status,
+    ST_Distance(ug.location_point, geo_point($2::float8, $3::float8))::float8 as distance_meters,
+    ARRAY(SELECT t.tag FROM game_tags t WHERE t.game_id = g.id ORDER BY t.tag)::varchar[] as tags,
+    -- Every match, not just this page; counted before LIMIT and OFFSET apply
+    COUNT(*) OVER ()::int as total_count
+FROM upcoming_games ug
+INNER JOIN games g ON g.id = ug.game_id
+LEFT JOIN participants up ON up.game_id = ug.game_id AND up.user_id = $1
+WHERE ST_DWithin(
+    ug.location_point,
+    geo_point($2::float8, $3::float8),
+    $4::float8
+)
+AND ug.start_time >= $5
+AND ($6::timestamptz IS NULL OR ug.start_time <= $6)
+AND ($7::varchar IS NULL OR ug.status = $7)
+AND ug.category = ANY($8::varchar[])
+AND ($9::bool OR NOT ug.adult_only)
+-- Players the host blocked don't see their games, unless they're still confirmed or waitlisted in one
+AND (up.status IN ('confirmed', 'waitlist') OR NOT EXISTS (
+    SELECT 1 FROM host_blocked_players b
+    WHERE b.host_id = g.owner_id AND b.player_id = $1
+))
+AND (g.owner_id = $1 OR NOT EXISTS (
+    SELECT 1 FROM user_shadow_bans sb WHERE sb.user_id = g.owner_id
+))
+AND ($10::varchar[] IS NULL OR g.skill_level = ANY($10::varchar[]))
+AND (NOT $11::bool OR g.pricing_type = 'free' OR g.pricing_amount_cents = 0)
+-- A total is split among the confirmed players, so the share is what it would be with the user confirmed too
+AND ($12::int IS NULL OR (CASE g.pricing_type
+    WHEN 'free' THEN 0
+    WHEN 'per_person' THEN g.pricing_amount_cents
+    ELSE CEIL(g.pricing_amount_cents::numeric / GREATEST(LEAST(ug.confirmed_count + 1, g.max_participants), 1))
+END) <= $12)
+-- Days (ISO, Monday = 1) and times of day are read in the searcher's timezone
+AND ($13::int[] IS NULL OR EXTRACT(ISODOW FROM ug.start_time AT TIME ZONE $14::text)::int = ANY($13::int[]))
+AND ($15::time IS NULL OR (ug.start_time AT TIME ZONE $14::text)::time >= $15::time)
+AND ($16::time IS NULL OR (ug.start_time AT TIME ZONE $14::text)::time < $16::time)
+-- A map viewport; the service also passes the circle around it so ST_DWithin can still use the index
+AND ($17::float8 IS NULL OR ST_Intersects(ug.location_point::geometry, ST_MakeEnvelope(
+    $17::float8, $18::float8,
+    $19::float8, $20::float8, 4326)))
+-- Tags are deduplicated, so matching all of them means matching as many as were given
+AND ($21::varchar[] IS NULL OR (
+    SELECT COUNT(*) FROM game_tags t WHERE t.game_id = g.id AND t.tag = ANY($21::varchar[])
+) >= CASE WHEN $22::bool THEN cardinality($21::varchar[]) ELSE 1 END)
+-- The signed-in user's relationship to the game: hosting it, confirmed in it or waitlisted for it
+AND ($23::varchar IS NULL OR CASE $23::varchar
+    WHEN 'hosting' THEN g.owner_id = $1
+    WHEN 'joined' THEN up.status = 'confirmed'
+    WHEN 'waitlisted' THEN up.status = 'waitlist'
+    ELSE FALSE
+END)
+ORDER BY ug.start_time ASC
+LIMIT $25 OFFSET $24
+`
+
+type ListUpcomingGamesInRadiusParams struct {
+	UserID                 pgtype.UUID        `json:"user_id"`
+	Longitude              float64            `json:"longitude"`
+	Latitude               float64            `json:"latitude"`
+	Radius                 float64            `json:"radius"`
+	StartTime              pgtype.Timestamptz `json:"start_time"`
+	EndTime                pgtype.Timestamptz `json:"end_time"`
+	Status                 pgtype.Text        `json:"status"`
+	Categories             []string           `json:"categories"`
+	IncludeAdultOnly       bool               `json:"include_adult_only"`
+	SkillLevels            []string           `json:"skill_levels"`
+	FreeOnly               bool               `json:"free_only"`
+	MaxPricePerPersonCents pgtype.Int4        `json:"max_price_per_person_cents"`
+	DaysOfWeek             []int32            `json:"days_of_week"`
+	TimeZone               string             `json:"time_zone"`
+	StartsAfter            pgtype.Time        `json:"starts_after"`
+	StartsBefore           pgtype.Time        `json:"starts_before"`
+	MinLongitude           pgtype.Float8      `json:"min_longitude"`
+	MinLatitude            pgtype.Float8      `json:"min_latitude"`
+	MaxLongitude           pgtype.Float8      `json:"max_longitude"`
+	MaxLatitude            pgtype.Float8      `json:"max_latitude"`
+	Tags                   []string           `json:"tags"`
+	MatchAllTags           bool               `json:"match_all_tags"`
+	Relationship           pgtype.Text        `json:"relationship"`
+	Offset                 int32              `json:"offset"`
+	Limit                  int32              `json:"limit"`
+}
+
+type ListUpcomingGamesInRadiusRow struct {
+	ID                      pgtype.UUID        `json:"id"`
+	OwnerID                 pgtype.UUID        `json:"owner_id"`
+	Category                string             `json:"category"`
+	Title                   pgtype.Text        `json:"title"`
+	Description             pgtype.Text        `json:"description"`
+	LocationName            string             `json:"location_name"`
+	LocationAddress         pgtype.Text        `json:"location_address"`
+	Latitude                interface{}        `json:"latitude"`
+	Longitude               interface{}        `json:"longitude"`
+	LocationNotes           pgtype.Text        `json:"location_notes"`
+	StartTime               pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes         int32              `json:"duration_minutes"`
+	MaxParticipants         int32              `json:"max_participants"`
+	SignupCount             int32              `json:"signup_count"`
+	ConfirmedCount          int32              `json:"confirmed_count"`
+	WaitlistCount           int32              `json:"waitlist_count"`
+	PricingType             string             `json:"pricing_type"`
+	PricingAmountCents      int32              `json:"pricing_amount_cents"`
+	PricingCurrency         string             `json:"pricing_currency"`
+	SignupDeadline          pgtype.Timestamptz `json:"signup_deadline"`
+	DropDeadline            pgtype.Timestamptz `json:"drop_deadline"`
+	SkillLevel              string             `json:"skill_level"`
+	AdultOnly               bool               `json:"adult_only"`
+	Notes                   pgtype.Text        `json:"notes"`
+	Status                  string             `json:"status"`
+	CancelledAt             pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt               pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt               pgtype.Timestamptz `json:"updated_at"`
+	UserParticipationStatus pgtype.Text        `json:"user_participation_status"`
+	DistanceMeters          float64            `json:"distance_meters"`
+	Tags                    []string           `json:"tags"`
+	TotalCount              int32              `json:"total_count"`
+}
+
+func (q *Queries) ListUpcomingGamesInRadius(ctx context.Context, arg ListUpcomingGamesInRadiusParams) ([]ListUpcomingGamesInRadiusRow, error) {
+	rows, err := q.db.Query(ctx, listUpcomingGamesInRadius,
+		arg.UserID,
+		arg.Longitude,
+		arg.Latitude,
+		arg.Radius,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Status,
+		arg.Categories,
+		arg.IncludeAdultOnly,
+		arg.SkillLevels,
+		arg.FreeOnly,
+		arg.MaxPricePerPersonCents,
+		arg.DaysOfWeek,
+		arg.TimeZone,
+		arg.StartsAfter,
+		arg.StartsBefore,
+		arg.MinLongitude,
+		arg.MinLatitude,
+		arg.MaxLongitude,
+		arg.MaxLatitude,
+		arg.Tags,
+		arg.MatchAllTags,
+		arg.Relationship,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUpcomingGamesInRadiusRow{}
+	for rows.Next() {
+		var i ListUpcomingGamesInRadiusRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Category,
+			&i.Title,
+			&i.Description,
+			&i.LocationName,
+			&i.LocationAddress,
+			&i.Latitude,
+			&i.Longitude,
+			&i.LocationNotes,
+			&i.StartTime,
+			&i.DurationMinutes,
+			&i.MaxParticipants,
+			&i.SignupCount,
+			&i.ConfirmedCount,
+			&i.WaitlistCount,
+			&i.PricingType,
+			&i.PricingAmountCents,
+			&i.PricingCurrency,
+			&i.SignupDeadline,
+			&i.DropDeadline,
+			&i.SkillLevel,
+			&i.AdultOnly,
+			&i.Notes,
+			&i.Status,
+			&i.CancelledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserParticipationStatus,
+			&i.DistanceMeters,
+			&i.Tags,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserNotificationDeliveries = `-- name: ListUserNotificationDeliveries :many
+SELECT d.id, d.channel, d.recipient, d.summary, d.status, d.attempts, d.next_attempt_at, d.last_error, d.created_at, d.sent_at
+FROM notification_deliveries d
+JOIN users u ON u.id = $1
+WHERE (d.channel = 'push' AND d.recipient = u.id::text)
+OR (d.channel = 'email' AND lower(d.recipient) = lower(u.email))
+OR (d.channel = 'sms' AND d.recipient = u.phone_number)
+ORDER BY d.created_at DESC
+LIMIT $2::int
+`
+
+type ListUserNotificationDeliveriesParams struct {
+	UserID     pgtype.UUID `json:"user_id"`
+	MaxResults int32       `json:"max_results"`
+}
+
+type ListUserNotificationDeliveriesRow struct {
+	ID            pgtype.UUID        `json:"id"`
+	Channel       string             `json:"channel"`
+	Recipient     string             `json:"recipient"`
+	Summary       pgtype.Text        `json:"summary"`
+	Status        string             `json:"status"`
+	Attempts      int32              `json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+	LastError     pgtype.Text        `json:"last_error"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	SentAt        pgtype.Timestamptz `json:"sent_at"`
+}
+
+// Deliveries to the user's account: push by user ID, email to their current address and SMS to
+// their verified number
+func (q *Queries) ListUserNotificationDeliveries(ctx context.Context, arg ListUserNotificationDeliveriesParams) ([]ListUserNotificationDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, listUserNotificationDeliveries, arg.UserID, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserNotificationDeliveriesRow{}
+	for rows.Next() {
+		var i ListUserNotificationDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Channel,
+			&i.Recipient,
+			&i.Summary,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserOverlappingGames = `-- name: ListUserOverlappingGames :many
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.start_time,
+    g.status
+FROM participants p
+JOIN games g ON g.id = p.game_id
+JOIN games target ON target.id = $1
+WHERE p.user_id = $2
+AND p.status = 'confirmed'
+AND g.id <> target.id
+AND g.status NOT IN ('cancelled', 'completed')
+AND g.start_time < target.start_time + make_interval(mins => target.duration_minutes)
+AND target.start_time < g.start_time + make_interval(mins => g.duration_minutes)
+ORDER BY g.start_time ASC
+`
+
+type ListUserOverlappingGamesParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+type ListUserOverlappingGamesRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	Title        pgtype.Text        `json:"title"`
+	Category     string             `json:"category"`
+	LocationName string             `json:"location_name"`
+	StartTime    pgtype.Timestamptz `json:"start_time"`
+	Status       string             `json:"status"`
+}
+
+// Other games the user is confirmed for whose time overlaps the given game
+func (q *Queries) ListUserOverlappingGames(ctx context.Context, arg ListUserOverlappingGamesParams) ([]ListUserOverlappingGamesRow, error) {
+	rows, err := q.db.Query(ctx, listUserOverlappingGames, arg.GameID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserOverlappingGamesRow{}
+	for rows.Next() {
+		var i ListUserOverlappingGamesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Category,
+			&i.LocationName,
+			&i.StartTime,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserParticipationHistory = `-- name: ListUserParticipationHistory :many
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.start_time,
+    g.status,
+    p.status AS participant_status,
+    a.status AS attendance_status,
+    p.paid,
+    p.payment_amount_cents
+FROM participants p
+JOIN games g ON g.id = p.game_id
+LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+WHERE p.user_id = $1
+AND g.start_time + make_interval(mins => g.duration_minutes) <= NOW()
+ORDER BY g.start_time DESC, g.id
+LIMIT $2 OFFSET $3
+`
+
+type ListUserParticipationHistoryParams struct {
+	UserID     pgtype.UUID `json:"user_id"`
+	PageLimit  int32       `json:"page_limit"`
+	PageOffset int32       `json:"page_offset"`
+}
+
+type ListUserParticipationHistoryRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	Title              pgtype.Text        `json:"title"`
+	Category           string             `json:"category"`
+	LocationName       string             `json:"location_name"`
+	StartTime          pgtype.Timestamptz `json:"start_time"`
+	Status             string             `json:"status"`
+	ParticipantStatus  string             `json:"participant_status"`
+	AttendanceStatus   pgtype.Text        `json:"attendance_status"`
+	Paid               bool               `json:"paid"`
+	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
+}
+
+// Games the user signed up for that have ended, most recent first, with how the sign-up ended
+func (q *Queries) ListUserParticipationHistory(ctx context.Context, arg ListUserParticipationHistoryParams) ([]ListUserParticipationHistoryRow, error) {
+	rows, err := q.db.Query(ctx, listUserParticipationHistory, arg.UserID, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserParticipationHistoryRow{}
+	for rows.Next() {
+		var i ListUserParticipationHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Category,
+			&i.LocationName,
+			&i.StartTime,
 			&i.Status,
+			&i.ParticipantStatus,
+			&i.AttendanceStatus,
 			&i.Paid,
 			&i.PaymentAmountCents,
-			&i.Notes,
-			&i.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserPlayedCategories = `-- name: ListUserPlayedCategories :many
+SELECT DISTINCT g.category
+FROM participants p
+JOIN games g ON g.id = p.game_id
+WHERE p.user_id = $1
+AND p.status = 'confirmed'
+ORDER BY g.category
+`
+
+func (q *Queries) ListUserPlayedCategories(ctx context.Context, userID pgtype.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listUserPlayedCategories, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var category string
+		if err := rows.Scan(&category); err != nil {
+			return nil, err
+		}
+		items = append(items, category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserSportPreferences = `-- name: ListUserSportPreferences :many
+SELECT user_id, category, skill_level, created_at, updated_at FROM user_sport_preferences
+WHERE user_id = $1
+ORDER BY category
+`
+
+func (q *Queries) ListUserSportPreferences(ctx context.Context, userID pgtype.UUID) ([]UserSportPreference, error) {
+	rows, err := q.db.Query(ctx, listUserSportPreferences, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserSportPreference{}
+	for rows.Next() {
+		var i UserSportPreference
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Category,
+			&i.SkillLevel,
+			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.Email,
-			&i.FirstName,
-			&i.LastName,
 		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserUpcomingParticipations = `-- name: ListUserUpcomingParticipations :many
+SELECT
+    g.id,
+    g.title,
+    g.category,
+    g.location_name,
+    g.start_time,
+    g.status,
+    p.status AS participant_status,
+    (
+        SELECT COUNT(*)
+        FROM participants w
+        WHERE w.game_id = p.game_id
+        AND w.status = 'waitlist'
+        AND w.waitlist_rank <= p.waitlist_rank
+    ) AS waitlist_position
+FROM participants p
+JOIN games g ON g.id = p.game_id
+WHERE p.user_id = $1
+AND p.status IN ('confirmed', 'waitlist')
+AND g.status IN ('open', 'full', 'closed')
+AND g.start_time > NOW()
+ORDER BY g.start_time ASC
+`
+
+type ListUserUpcomingParticipationsRow struct {
+	ID                pgtype.UUID        `json:"id"`
+	Title             pgtype.Text        `json:"title"`
+	Category          string             `json:"category"`
+	LocationName      string             `json:"location_name"`
+	StartTime         pgtype.Timestamptz `json:"start_time"`
+	Status            string             `json:"status"`
+	ParticipantStatus string             `json:"participant_status"`
+	WaitlistPosition  int64              `json:"waitlist_position"`
+}
+
+func (q *Queries) ListUserUpcomingParticipations(ctx context.Context, userID pgtype.UUID) ([]ListUserUpcomingParticipationsRow, error) {
+	rows, err := q.db.Query(ctx, listUserUpcomingParticipations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserUpcomingParticipationsRow{}
+	for rows.Next() {
+		var i ListUserUpcomingParticipationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Category,
+			&i.LocationName,
+			&i.StartTime,
+			&i.Status,
+			&i.ParticipantStatus,
+			&i.WaitlistPosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebAuthnCredentialsByUser = `-- name: ListWebAuthnCredentialsByUser :many
+SELECT id, user_id, credential_id, public_key, sign_count, name, created_at, last_used_at FROM webauthn_credentials
+WHERE user_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListWebAuthnCredentialsByUser(ctx context.Context, userID pgtype.UUID) ([]WebauthnCredential, error) {
+	rows, err := q.db.Query(ctx, listWebAuthnCredentialsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebauthnCredential{}
+	for rows.Next() {
+		var i WebauthnCredential
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CredentialID,
+			&i.PublicKey,
+			&i.SignCount,
+			&i.Name,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptions = `-- name: ListWebhookSubscriptions :many
+SELECT id, owner_id, url, secret, event_types, created_at FROM webhook_subscriptions
+WHERE owner_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListWebhookSubscriptions(ctx context.Context, ownerID pgtype.UUID) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptions, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookSubscription{}
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markConversationRead = `-- name: MarkConversationRead :exec
+UPDATE conversations
+SET
+    host_read_at = CASE WHEN host_id = $1 THEN NOW() ELSE host_read_at END,
+    player_read_at = CASE WHEN player_id = $1 THEN NOW() ELSE player_read_at END
+WHERE id = $2
+`
+
+type MarkConversationReadParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	ID     pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) MarkConversationRead(ctx context.Context, arg MarkConversationReadParams) error {
+	_, err := q.db.Exec(ctx, markConversationRead, arg.UserID, arg.ID)
+	return err
+}
+
+const markDeadLetterRequeued = `-- name: MarkDeadLetterRequeued :one
+UPDATE dead_letters
+SET
+    requeued_at = NOW(),
+    requeued_by = $2
+WHERE id = $1
+RETURNING id, source, source_id, kind, payload, attempts, attempt_history, last_error, created_at, requeued_at, requeued_by
+`
+
+type MarkDeadLetterRequeuedParams struct {
+	ID         pgtype.UUID `json:"id"`
+	RequeuedBy pgtype.UUID `json:"requeued_by"`
+}
+
+func (q *Queries) MarkDeadLetterRequeued(ctx context.Context, arg MarkDeadLetterRequeuedParams) (DeadLetter, error) {
+	row := q.db.QueryRow(ctx, markDeadLetterRequeued, arg.ID, arg.RequeuedBy)
+	var i DeadLetter
+	err := row.Scan(
+		&i.ID,
+		&i.Source,
+		&i.SourceID,
+		&i.Kind,
+		&i.Payload,
+		&i.Attempts,
+		&i.AttemptHistory,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.RequeuedAt,
+		&i.RequeuedBy,
+	)
+	return i, err
+}
+
+const markEmailChangeRequestConfirmed = `-- name: MarkEmailChangeRequestConfirmed :exec
+UPDATE email_change_requests
+SET confirmed_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) MarkEmailChangeRequestConfirmed(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markEmailChangeRequestConfirmed, id)
+	return err
+}
+
+const markLoginCodeUsed = `-- name: MarkLoginCodeUsed :exec
+UPDATE login_codes
+SET used_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) MarkLoginCodeUsed(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markLoginCodeUsed, id)
+	return err
+}
+
+const markNotificationDeliverySent = `-- name: MarkNotificationDeliverySent :exec
+UPDATE notification_deliveries
+SET
+    status = 'sent',
+    payload = NULL,
+    last_error = NULL,
+    sent_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) MarkNotificationDeliverySent(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markNotificationDeliverySent, id)
+	return err
+}
+
+const markParticipantDropped = `-- name: MarkParticipantDropped :one
+UPDATE participants
+SET
+    status = 'dropped',
+    drop_reason = $2,
+    late_drop = $3,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
+`
+
+type MarkParticipantDroppedParams struct {
+	ID         pgtype.UUID `json:"id"`
+	DropReason pgtype.Text `json:"drop_reason"`
+	LateDrop   bool        `json:"late_drop"`
+}
+
+func (q *Queries) MarkParticipantDropped(ctx context.Context, arg MarkParticipantDroppedParams) (Participant, error) {
+	row := q.db.QueryRow(ctx, markParticipantDropped, arg.ID, arg.DropReason, arg.LateDrop)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.UserID,
+		&i.TeamID,
+		&i.Status,
+		&i.Paid,
+		&i.PaymentAmountCents,
+		&i.Notes,
+		&i.JoinedAt,
+		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
+	)
+	return i, err
+}
+
+const markPhoneVerificationVerified = `-- name: MarkPhoneVerificationVerified :exec
+UPDATE phone_verifications
+SET verified_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) MarkPhoneVerificationVerified(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markPhoneVerificationVerified, id)
+	return err
+}
+
+const moveParticipantsToBackOfLine = `-- name: MoveParticipantsToBackOfLine :exec
+UPDATE participants p
+SET waitlist_rank = line.back + array_position($1::uuid[], p.id)
+FROM (
+    -- Claims the next cardinality(participant_ids) ranks so later sign-ups still land behind them
+    SELECT setval(
+        'participants_waitlist_rank_seq',
+        nextval('participants_waitlist_rank_seq') + cardinality($1::uuid[])
+    ) - cardinality($1::uuid[]) AS back
+) line
+WHERE p.game_id = $2
+AND p.id = ANY($1::uuid[])
+`
+
+type MoveParticipantsToBackOfLineParams struct {
+	ParticipantIds []pgtype.UUID `json:"participant_ids"`
+	GameID         pgtype.UUID   `json:"game_id"`
+}
+
+// Moves participants behind everyone else in the game's line, keeping their order in participant_ids
+func (q *Queries) MoveParticipantsToBackOfLine(ctx context.Context, arg MoveParticipantsToBackOfLineParams) error {
+	_, err := q.db.Exec(ctx, moveParticipantsToBackOfLine, arg.ParticipantIds, arg.GameID)
+	return err
+}
+
+const moveParticipantsToFrontOfLine = `-- name: MoveParticipantsToFrontOfLine :exec
+UPDATE participants p
+SET waitlist_rank = line.front - (
+    cardinality($1::uuid[]) - array_position($1::uuid[], p.id) + 1
+)
+FROM (SELECT MIN(waitlist_rank) AS front FROM participants WHERE game_id = $2) line
+WHERE p.game_id = $2
+AND p.id = ANY($1::uuid[])
+`
+
+type MoveParticipantsToFrontOfLineParams struct {
+	ParticipantIds []pgtype.UUID `json:"participant_ids"`
+	GameID         pgtype.UUID   `json:"game_id"`
+}
+
+// Moves participants ahead of everyone else in the game's line, keeping their order in participant_ids
+func (q *Queries) MoveParticipantsToFrontOfLine(ctx context.Context, arg MoveParticipantsToFrontOfLineParams) error {
+	_, err := q.db.Exec(ctx, moveParticipantsToFrontOfLine, arg.ParticipantIds, arg.GameID)
+	return err
+}
+
+const playedCompletedGame = `-- name: PlayedCompletedGame :one
+SELECT EXISTS (
+    SELECT 1
+    FROM participants p
+    JOIN games g ON g.id = p.game_id
+    LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+    WHERE p.game_id = $1
+    AND p.user_id = $2
+    AND p.status = 'confirmed'
+    AND g.status = 'completed'
+    AND a.status IS DISTINCT FROM 'no_show'
+)
+`
+
+type PlayedCompletedGameParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+// Whether the user played the completed game: confirmed and not marked a no-show
+func (q *Queries) PlayedCompletedGame(ctx context.Context, arg PlayedCompletedGameParams) (bool, error) {
+	row := q.db.QueryRow(ctx, playedCompletedGame, arg.GameID, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const pruneLeaderboardCells = `-- name: PruneLeaderboardCells :execrows
+DELETE FROM leaderboard_cells
+WHERE computed_at < $1::timestamptz
+`
+
+// Deletes the cells a refresh no longer produced, e.g. after a no-show correction
+func (q *Queries) PruneLeaderboardCells(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, pruneLeaderboardCells, computedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reactivateUser = `-- name: ReactivateUser :execrows
+DELETE FROM user_deactivations
+WHERE user_id = $1
+`
+
+func (q *Queries) ReactivateUser(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, reactivateUser, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const recordCreditTransaction = `-- name: RecordCreditTransaction :one
+WITH wallet AS (
+    INSERT INTO credit_wallets (user_id, balance_cents)
+    VALUES ($1, $2::INTEGER)
+    ON CONFLICT (user_id) DO UPDATE
+    SET balance_cents = credit_wallets.balance_cents + EXCLUDED.balance_cents,
+        updated_at = NOW()
+    RETURNING balance_cents
+)
+INSERT INTO credit_transactions (user_id, amount_cents, kind, game_id, balance_after_cents)
+SELECT $1, $2::INTEGER, $3, $4, wallet.balance_cents
+FROM wallet
+RETURNING id, user_id, amount_cents, kind, game_id, balance_after_cents, created_at
+`
+
+type RecordCreditTransactionParams struct {
+	UserID      pgtype.UUID `json:"user_id"`
+	AmountCents int32       `json:"amount_cents"`
+	Kind        string      `json:"kind"`
+	GameID      pgtype.UUID `json:"game_id"`
+}
+
+// Moves the user's balance by amount_cents and appends the ledger entry in one statement. The
+// balance check rejects spends that would overdraw the wallet.
+func (q *Queries) RecordCreditTransaction(ctx context.Context, arg RecordCreditTransactionParams) (CreditTransaction, error) {
+	row := q.db.QueryRow(ctx, recordCreditTransaction,
+		arg.UserID,
+		arg.AmountCents,
+		arg.Kind,
+		arg.GameID,
+	)
+	var i CreditTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AmountCents,
+		&i.Kind,
+		&i.GameID,
+		&i.BalanceAfterCents,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const recordFailedLogin = `-- name: RecordFailedLogin :exec
+INSERT INTO failed_logins (email, ip_address)
+VALUES ($1, $2)
+`
+
+type RecordFailedLoginParams struct {
+	Email     string `json:"email"`
+	IpAddress string `json:"ip_address"`
+}
+
+func (q *Queries) RecordFailedLogin(ctx context.Context, arg RecordFailedLoginParams) error {
+	_, err := q.db.Exec(ctx, recordFailedLogin, arg.Email, arg.IpAddress)
+	return err
+}
+
+const recordNewGameAlert = `-- name: RecordNewGameAlert :execrows
+INSERT INTO new_game_alerts (user_id, game_id, saved_search_id)
+SELECT $1::uuid, $2::uuid, $3::uuid
+WHERE (
+    SELECT COUNT(*) FROM new_game_alerts
+    WHERE user_id = $1 AND sent_at > NOW() - INTERVAL '1 day'
+) < $4::int
+ON CONFLICT DO NOTHING
+`
+
+type RecordNewGameAlertParams struct {
+	UserID        pgtype.UUID `json:"user_id"`
+	GameID        pgtype.UUID `json:"game_id"`
+	SavedSearchID pgtype.UUID `json:"saved_search_id"`
+	MaxPerDay     int32       `json:"max_per_day"`
+}
+
+// Records an alert about to be sent, unless the user was already alerted about the game or has
+// had max_per_day alerts in the last day
+func (q *Queries) RecordNewGameAlert(ctx context.Context, arg RecordNewGameAlertParams) (int64, error) {
+	result, err := q.db.Exec(ctx, recordNewGameAlert,
+		arg.UserID,
+		arg.GameID,
+		arg.SavedSearchID,
+		arg.MaxPerDay,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const refreshLeaderboardCells = `-- name: RefreshLeaderboardCells :execrows
+INSERT INTO leaderboard_cells (user_id, category, cell_x, cell_y, cell_point, games_played, games_hosted, mvps, computed_at)
+SELECT
+    user_id,
+    category,
+    cell_x,
+    cell_y,
+    geo_point((cell_x + 0.5) / 10.0, (cell_y + 0.5) / 10.0),
+    SUM(played)::int,
+    SUM(hosted)::int,
+    SUM(mvp)::int,
+    $1::timestamptz
+FROM (
+    SELECT p.user_id, g.category, g.location_point, 1 AS played, 0 AS hosted, 0 AS mvp
+    FROM participants p
+    JOIN games g ON g.id = p.game_id
+    LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+    WHERE p.user_id IS NOT NULL
+    AND p.status = 'confirmed'
+    AND g.status = 'completed'
+    AND a.status IS DISTINCT FROM 'no_show'
+    UNION ALL
+    SELECT g.owner_id, g.category, g.location_point, 0, 1, 0
+    FROM games g
+    WHERE g.status = 'completed'
+    UNION ALL
+    SELECT m.user_id, g.category, g.location_point, 0, 0, 1
+    FROM game_mvps m
+    JOIN games g ON g.id = m.game_id
+    WHERE g.status = 'completed'
+) contributions
+CROSS JOIN LATERAL (
+    SELECT
+        floor(geo_longitude(contributions.location_point) * 10)::int AS cell_x,
+        floor(geo_latitude(contributions.location_point) * 10)::int AS cell_y
+) cell
+GROUP BY user_id, category, cell_x, cell_y
+ON CONFLICT (user_id, category, cell_x, cell_y) DO UPDATE SET
+    games_played = EXCLUDED.games_played,
+    games_hosted = EXCLUDED.games_hosted,
+    mvps = EXCLUDED.mvps,
+    computed_at = EXCLUDED.computed_at
+`
+
+// Recomputes every leaderboard cell from completed games, stamping the rows it writes with computed_at.
+// A game counts as played the way GetUserPlayStats counts it.
+func (q *Queries) RefreshLeaderboardCells(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, refreshLeaderboardCells, computedAt)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const listGamesInRadius = `-- name: ListGamesInRadius :many
+const refreshPlayerReliability = `-- name: RefreshPlayerReliability :execrows
+INSERT INTO player_reliability (user_id, honored, late_drops, no_shows, score, computed_at)
 SELECT
-    g.id, g.owner_id, g.category, g.title, g.description, g.location_name, g.location_address,
-    ST_Y(g.location_point::geometry) as latitude, ST_X(g.location_point::geometry) as longitude,
-    g.location_notes, g.start_time, g.duration_minutes, g.max_participants,
-    COALESCE(COUNT(p.id))::int as signup_count,
-    g.pricing_type, g.pricing_amount_cents, g.pricing_currency, g.signup_deadline,
-    g.drop_deadline, g.skill_level, g.notes, g.status, g.cancelled_at, g.created_at, g.updated_at,
-    up.status as user_participation_status
-FROM games g
-LEFT JOIN participants p ON p.game_id = g.id AND p.status in ('confirmed', 'waitlist')
-LEFT JOIN participants up ON up.game_id = g.id AND up.user_id = $1
-WHERE ST_DWithin(
-    g.location_point,
-    ST_SetSRID(ST_MakePoint($2::float8, $3::float8), 4326)::geography,
-    $4::float8
+    user_id,
+    honored,
+    late_drops,
+    no_shows,
+    ROUND(100.0 * honored / (honored + late_drops + no_shows))::int,
+    NOW()
+FROM (
+    SELECT
+        p.user_id,
+        COUNT(*) FILTER (WHERE p.status = 'confirmed' AND a.status IS DISTINCT FROM 'no_show')::int AS honored,
+        COUNT(*) FILTER (WHERE p.status = 'dropped' AND p.late_drop)::int AS late_drops,
+        COUNT(*) FILTER (WHERE p.status = 'confirmed' AND a.status = 'no_show')::int AS no_shows
+    FROM participants p
+    JOIN games g ON g.id = p.game_id
+    LEFT JOIN attendance a ON a.game_id = p.game_id AND a.user_id = p.user_id
+    WHERE p.user_id IS NOT NULL
+    AND g.status = 'completed'
+    AND ($1::uuid IS NULL OR p.user_id = $1)
+    GROUP BY p.user_id
+) totals
+WHERE honored + late_drops + no_shows > 0
+ON CONFLICT (user_id) DO UPDATE SET
+    honored = EXCLUDED.honored,
+    late_drops = EXCLUDED.late_drops,
+    no_shows = EXCLUDED.no_shows,
+    score = EXCLUDED.score,
+    computed_at = EXCLUDED.computed_at
+`
+
+// Recomputes the reliability of every player, or only of user_id when it is set
+func (q *Queries) RefreshPlayerReliability(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, refreshPlayerReliability, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const releaseExpiredReservations = `-- name: ReleaseExpiredReservations :execrows
+UPDATE game_reservations
+SET released_at = NOW()
+WHERE game_id = $1 AND expires_at <= NOW() AND released_at IS NULL
+`
+
+func (q *Queries) ReleaseExpiredReservations(ctx context.Context, gameID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseExpiredReservations, gameID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reorderWaitlist = `-- name: ReorderWaitlist :exec
+UPDATE participants p
+SET waitlist_rank = slots.rank
+FROM (
+    SELECT ordered.id, ranks.rank
+    FROM unnest($1::uuid[]) WITH ORDINALITY AS ordered(id, ord)
+    JOIN (
+        SELECT waitlist_rank AS rank, ROW_NUMBER() OVER (ORDER BY waitlist_rank ASC) AS ord
+        FROM participants
+        WHERE game_id = $2
+        AND id = ANY($1::uuid[])
+    ) ranks ON ranks.ord = ordered.ord
+) slots
+WHERE p.id = slots.id
+AND p.game_id = $2
+`
+
+type ReorderWaitlistParams struct {
+	ParticipantIds []pgtype.UUID `json:"participant_ids"`
+	GameID         pgtype.UUID   `json:"game_id"`
+}
+
+// Rearranges waitlisted participants into the order of participant_ids. They trade the ranks they
+// already hold, so the waitlist keeps its place in the line relative to everyone else.
+func (q *Queries) ReorderWaitlist(ctx context.Context, arg ReorderWaitlistParams) error {
+	_, err := q.db.Exec(ctx, reorderWaitlist, arg.ParticipantIds, arg.GameID)
+	return err
+}
+
+const replaceUserSportPreferences = `-- name: ReplaceUserSportPreferences :exec
+WITH removed AS (
+    DELETE FROM user_sport_preferences
+    WHERE user_id = $1
+    AND category <> ALL($2::varchar[])
 )
-AND g.start_time >= $5
-AND ($6::timestamptz IS NULL OR g.start_time <= $6)
-AND ($7::varchar IS NULL OR g.status = $7)
-AND g.category = ANY($8::varchar[])
-GROUP BY g.id, up.user_id, up.status
-ORDER BY g.start_time ASC
-LIMIT $10 OFFSET $9
+INSERT INTO user_sport_preferences (user_id, category, skill_level)
+SELECT $1, category, NULLIF(skill_level, '')
+FROM unnest($2::varchar[], $3::varchar[]) AS p(category, skill_level)
+ON CONFLICT (user_id, category) DO UPDATE
+SET skill_level = EXCLUDED.skill_level,
+    updated_at = NOW()
 `
 
-type ListGamesInRadiusParams struct {
-	UserID     pgtype.UUID        `json:"user_id"`
-	Longitude  float64            `json:"longitude"`
-	Latitude   float64            `json:"latitude"`
-	Radius     float64            `json:"radius"`
-	StartTime  pgtype.Timestamptz `json:"start_time"`
-	EndTime    pgtype.Timestamptz `json:"end_time"`
-	Status     pgtype.Text        `json:"status"`
-	Categories []string           `json:"categories"`
-	Offset     int32              `json:"offset"`
-	Limit      int32              `json:"limit"`
+type ReplaceUserSportPreferencesParams struct {
+	UserID      pgtype.UUID `json:"user_id"`
+	Categories  []string    `json:"categories"`
+	SkillLevels []string    `json:"skill_levels"`
 }
 
-type ListGamesInRadiusRow struct {
-	ID                      pgtype.UUID        `json:"id"`
-	OwnerID                 pgtype.UUID        `json:"owner_id"`
-	Category                string             `json:"category"`
-	Title                   pgtype.Text        `json:"title"`
-	Description             pgtype.Text        `json:"description"`
-	LocationName            string             `json:"location_name"`
-	LocationAddress         pgtype.Text        `json:"location_address"`
-	Latitude                interface{}        `json:"latitude"`
-	Longitude               interface{}        `json:"longitude"`
-	LocationNotes           pgtype.Text        `json:"location_notes"`
-	StartTime               pgtype.Timestamptz `json:"start_time"`
-	DurationMinutes         int32              `json:"duration_minutes"`
-	MaxParticipants         int32              `json:"max_participants"`
-	SignupCount             int32              `json:"signup_count"`
-	PricingType             string             `json:"pricing_type"`
-	PricingAmountCents      int32              `json:"pricing_amount_cents"`
-	PricingCurrency         string             `json:"pricing_currency"`
-	SignupDeadline          pgtype.Timestamptz `json:"signup_deadline"`
-	DropDeadline            pgtype.Timestamptz `json:"drop_deadline"`
-	SkillLevel              string             `json:"skill_level"`
-	Notes                   pgtype.Text        `json:"notes"`
-	Status                  string             `json:"status"`
-	CancelledAt             pgtype.Timestamptz `json:"cancelled_at"`
-	CreatedAt               pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt               pgtype.Timestamptz `json:"updated_at"`
-	UserParticipationStatus pgtype.Text        `json:"user_participation_status"`
+// Replaces the user's sport preferences with the given categories; skill_levels pairs with categories,
+// an empty string meaning no level
+func (q *Queries) ReplaceUserSportPreferences(ctx context.Context, arg ReplaceUserSportPreferencesParams) error {
+	_, err := q.db.Exec(ctx, replaceUserSportPreferences, arg.UserID, arg.Categories, arg.SkillLevels)
+	return err
+}
+
+const requestContactSharing = `-- name: RequestContactSharing :one
+INSERT INTO contact_share_requests (game_id, requested_by)
+VALUES ($1, $2)
+ON CONFLICT (game_id) DO UPDATE SET game_id = EXCLUDED.game_id
+RETURNING game_id, requested_by, requested_at
+`
+
+type RequestContactSharingParams struct {
+	GameID      pgtype.UUID `json:"game_id"`
+	RequestedBy pgtype.UUID `json:"requested_by"`
+}
+
+// Requesting again keeps the original request
+func (q *Queries) RequestContactSharing(ctx context.Context, arg RequestContactSharingParams) (ContactShareRequest, error) {
+	row := q.db.QueryRow(ctx, requestContactSharing, arg.GameID, arg.RequestedBy)
+	var i ContactShareRequest
+	err := row.Scan(&i.GameID, &i.RequestedBy, &i.RequestedAt)
+	return i, err
+}
+
+const requeueNotificationDelivery = `-- name: RequeueNotificationDelivery :exec
+INSERT INTO notification_deliveries (channel, recipient, summary, payload, attempts, next_attempt_at)
+VALUES ($1, $2, $3, $4, 0, NOW())
+`
+
+type RequeueNotificationDeliveryParams struct {
+	Channel   string      `json:"channel"`
+	Recipient string      `json:"recipient"`
+	Summary   pgtype.Text `json:"summary"`
+	Payload   []byte      `json:"payload"`
+}
+
+// Queues a dead notification again, due now and with no attempts made
+func (q *Queries) RequeueNotificationDelivery(ctx context.Context, arg RequeueNotificationDeliveryParams) error {
+	_, err := q.db.Exec(ctx, requeueNotificationDelivery,
+		arg.Channel,
+		arg.Recipient,
+		arg.Summary,
+		arg.Payload,
+	)
+	return err
+}
+
+const requeueSideEffect = `-- name: RequeueSideEffect :execrows
+INSERT INTO side_effects (kind, game_id)
+SELECT $1::text, id
+FROM games
+WHERE id = $2
+`
+
+type RequeueSideEffectParams struct {
+	Kind   string      `json:"kind"`
+	GameID pgtype.UUID `json:"game_id"`
+}
+
+// Queues a dead side effect again unless its game has since been deleted
+func (q *Queries) RequeueSideEffect(ctx context.Context, arg RequeueSideEffectParams) (int64, error) {
+	result, err := q.db.Exec(ctx, requeueSideEffect, arg.Kind, arg.GameID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const requeueWebhookDelivery = `-- name: RequeueWebhookDelivery :execrows
+INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+SELECT id, $1::text, $2::jsonb
+FROM webhook_subscriptions
+WHERE id = $3
+`
+
+type RequeueWebhookDeliveryParams struct {
+	EventType      string      `json:"event_type"`
+	Payload        []byte      `json:"payload"`
+	SubscriptionID pgtype.UUID `json:"subscription_id"`
+}
+
+// Queues a dead webhook delivery again unless its subscription has since been deleted
+func (q *Queries) RequeueWebhookDelivery(ctx context.Context, arg RequeueWebhookDeliveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, requeueWebhookDelivery, arg.EventType, arg.Payload, arg.SubscriptionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const rescheduleBrokerEvent = `-- name: RescheduleBrokerEvent :exec
+UPDATE broker_events
+SET
+    last_error = $2,
+    next_attempt_at = $3
+WHERE id = $1
+`
+
+type RescheduleBrokerEventParams struct {
+	ID            pgtype.UUID        `json:"id"`
+	LastError     pgtype.Text        `json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+}
+
+func (q *Queries) RescheduleBrokerEvent(ctx context.Context, arg RescheduleBrokerEventParams) error {
+	_, err := q.db.Exec(ctx, rescheduleBrokerEvent, arg.ID, arg.LastError, arg.NextAttemptAt)
+	return err
+}
+
+const rescheduleNotificationDelivery = `-- name: RescheduleNotificationDelivery :exec
+UPDATE notification_deliveries
+SET
+    last_error = $2,
+    next_attempt_at = $3,
+    attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+WHERE id = $1
+`
+
+type RescheduleNotificationDeliveryParams struct {
+	ID            pgtype.UUID        `json:"id"`
+	LastError     pgtype.Text        `json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+}
+
+func (q *Queries) RescheduleNotificationDelivery(ctx context.Context, arg RescheduleNotificationDeliveryParams) error {
+	_, err := q.db.Exec(ctx, rescheduleNotificationDelivery, arg.ID, arg.LastError, arg.NextAttemptAt)
+	return err
+}
+
+const rescheduleSideEffect = `-- name: RescheduleSideEffect :exec
+UPDATE side_effects
+SET
+    last_error = $2,
+    next_attempt_at = $3,
+    attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+WHERE id = $1
+`
+
+type RescheduleSideEffectParams struct {
+	ID            pgtype.UUID        `json:"id"`
+	LastError     pgtype.Text        `json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+}
+
+func (q *Queries) RescheduleSideEffect(ctx context.Context, arg RescheduleSideEffectParams) error {
+	_, err := q.db.Exec(ctx, rescheduleSideEffect, arg.ID, arg.LastError, arg.NextAttemptAt)
+	return err
+}
+
+const rescheduleWebhookDelivery = `-- name: RescheduleWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET
+    last_error = $2,
+    next_attempt_at = $3,
+    attempt_history = attempt_history || jsonb_build_array(jsonb_build_object('attempt', attempts, 'error', $2::text, 'at', NOW()))
+WHERE id = $1
+`
+
+type RescheduleWebhookDeliveryParams struct {
+	ID            pgtype.UUID        `json:"id"`
+	LastError     pgtype.Text        `json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+}
+
+func (q *Queries) RescheduleWebhookDelivery(ctx context.Context, arg RescheduleWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, rescheduleWebhookDelivery, arg.ID, arg.LastError, arg.NextAttemptAt)
+	return err
+}
+
+const revokeAllUserRefreshTokens = `-- name: RevokeAllUserRefreshTokens :exec
+UPDATE refresh_tokens
+SET revoked_at = NOW()
+WHERE user_id = $1
+AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeAllUserRefreshTokens(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, revokeAllUserRefreshTokens, userID)
+	return err
+}
+
+const revokeContactShareConsent = `-- name: RevokeContactShareConsent :execrows
+DELETE FROM contact_share_consents
+WHERE game_id = $1 AND user_id = $2
+`
+
+type RevokeContactShareConsentParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) RevokeContactShareConsent(ctx context.Context, arg RevokeContactShareConsentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeContactShareConsent, arg.GameID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeRefreshToken = `-- name: RevokeRefreshToken :exec
+UPDATE refresh_tokens
+SET revoked_at = NOW()
+WHERE token_hash = $1
+`
+
+func (q *Queries) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
+	_, err := q.db.Exec(ctx, revokeRefreshToken, tokenHash)
+	return err
+}
+
+const saveGameCancellationReason = `-- name: SaveGameCancellationReason :exec
+INSERT INTO game_cancellation_reasons (game_id, reason)
+VALUES ($1, $2)
+ON CONFLICT (game_id) DO UPDATE SET
+    reason = EXCLUDED.reason,
+    created_at = NOW()
+`
+
+type SaveGameCancellationReasonParams struct {
+	GameID pgtype.UUID `json:"game_id"`
+	Reason string      `json:"reason"`
+}
+
+func (q *Queries) SaveGameCancellationReason(ctx context.Context, arg SaveGameCancellationReasonParams) error {
+	_, err := q.db.Exec(ctx, saveGameCancellationReason, arg.GameID, arg.Reason)
+	return err
+}
+
+const saveUserOnboarding = `-- name: SaveUserOnboarding :one
+INSERT INTO user_settings (user_id, default_radius_meters, availability_windows, timezone, onboarded_at)
+VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET default_radius_meters = EXCLUDED.default_radius_meters,
+    availability_windows = EXCLUDED.availability_windows,
+    timezone = EXCLUDED.timezone,
+    onboarded_at = NOW(),
+    updated_at = NOW()
+RETURNING user_id, home_latitude, home_longitude, home_point, home_place_id, home_name, default_radius_meters, updated_at, availability_windows, timezone, onboarded_at
+`
+
+type SaveUserOnboardingParams struct {
+	UserID              pgtype.UUID   `json:"user_id"`
+	DefaultRadiusMeters pgtype.Float8 `json:"default_radius_meters"`
+	AvailabilityWindows []string      `json:"availability_windows"`
+	Timezone            pgtype.Text   `json:"timezone"`
+}
+
+func (q *Queries) SaveUserOnboarding(ctx context.Context, arg SaveUserOnboardingParams) (UserSetting, error) {
+	row := q.db.QueryRow(ctx, saveUserOnboarding,
+		arg.UserID,
+		arg.DefaultRadiusMeters,
+		arg.AvailabilityWindows,
+		arg.Timezone,
+	)
+	var i UserSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.HomeLatitude,
+		&i.HomeLongitude,
+		&i.HomePoint,
+		&i.HomePlaceID,
+		&i.HomeName,
+		&i.DefaultRadiusMeters,
+		&i.UpdatedAt,
+		&i.AvailabilityWindows,
+		&i.Timezone,
+		&i.OnboardedAt,
+	)
+	return i, err
+}
+
+const setSavedSearchAlerts = `-- name: SetSavedSearchAlerts :one
+UPDATE saved_searches
+SET alerts_enabled = $3
+WHERE id = $1 AND user_id = $2
+RETURNING id, user_id, name, filters, created_at, alerts_enabled, search_point
+`
+
+type SetSavedSearchAlertsParams struct {
+	ID            pgtype.UUID `json:"id"`
+	UserID        pgtype.UUID `json:"user_id"`
+	AlertsEnabled bool        `json:"alerts_enabled"`
+}
+
+func (q *Queries) SetSavedSearchAlerts(ctx context.Context, arg SetSavedSearchAlertsParams) (SavedSearch, error) {
+	row := q.db.QueryRow(ctx, setSavedSearchAlerts, arg.ID, arg.UserID, arg.AlertsEnabled)
+	var i SavedSearch
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Filters,
+		&i.CreatedAt,
+		&i.AlertsEnabled,
+		&i.SearchPoint,
+	)
+	return i, err
+}
+
+const setUserVerifiedPhone = `-- name: SetUserVerifiedPhone :one
+UPDATE users
+SET
+    phone_number = $2,
+    phone_verified_at = NOW()
+WHERE id = $1
+RETURNING id, email, first_name, last_name, password_hash, phone_number, phone_verified_at, birthdate, created_at, home_region, token_version
+`
+
+type SetUserVerifiedPhoneParams struct {
+	ID          pgtype.UUID `json:"id"`
+	PhoneNumber pgtype.Text `json:"phone_number"`
 }
 
-func (q *Queries) ListGamesInRadius(ctx context.Context, arg ListGamesInRadiusParams) ([]ListGamesInRadiusRow, error) {
-	rows, err := q.db.Query(ctx, listGamesInRadius,
-		arg.UserID,
-		arg.Longitude,
-		arg.Latitude,
-		arg.Radius,
-		arg.StartTime,
-		arg.EndTime,
-		arg.Status,
-		arg.Categories,
-		arg.Offset,
-		arg.Limit,
+func (q *Queries) SetUserVerifiedPhone(ctx context.Context, arg SetUserVerifiedPhoneParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserVerifiedPhone, arg.ID, arg.PhoneNumber)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.PasswordHash,
+		&i.PhoneNumber,
+		&i.PhoneVerifiedAt,
+		&i.Birthdate,
+		&i.CreatedAt,
+		&i.HomeRegion,
+		&i.TokenVersion,
 	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListGamesInRadiusRow{}
-	for rows.Next() {
-		var i ListGamesInRadiusRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.OwnerID,
-			&i.Category,
-			&i.Title,
-			&i.Description,
-			&i.LocationName,
-			&i.LocationAddress,
-			&i.Latitude,
-			&i.Longitude,
-			&i.LocationNotes,
-			&i.StartTime,
-			&i.DurationMinutes,
-			&i.MaxParticipants,
-			&i.SignupCount,
-			&i.PricingType,
-			&i.PricingAmountCents,
-			&i.PricingCurrency,
-			&i.SignupDeadline,
-			&i.DropDeadline,
-			&i.SkillLevel,
-			&i.Notes,
-			&i.Status,
-			&i.CancelledAt,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.UserParticipationStatus,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return i, err
 }
 
-const listParticipantsByGame = `-- name: ListParticipantsByGame :many
-SELECT
-    p.id,
-    p.game_id,
-    p.user_id,
-    p.team_id,
-    p.status,
-    p.paid,
-    p.payment_amount_cents,
-    p.notes,
-    p.joined_at,
-    p.updated_at,
-    u.email,
-    u.first_name,
-    u.last_name
-FROM participants p
-INNER JOIN users u ON p.user_id = u.id
-WHERE p.game_id = $1
-ORDER BY p.joined_at ASC
+const shadowBanUser = `-- name: ShadowBanUser :one
+INSERT INTO user_shadow_bans (
+    user_id,
+    banned_by,
+    reason
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    banned_by = EXCLUDED.banned_by,
+    reason = EXCLUDED.reason,
+    created_at = NOW()
+RETURNING user_id, banned_by, reason, created_at
 `
 
-type ListParticipantsByGameRow struct {
-	ID                 pgtype.UUID        `json:"id"`
-	GameID             pgtype.UUID        `json:"game_id"`
-	UserID             pgtype.UUID        `json:"user_id"`
-	TeamID             pgtype.UUID        `json:"team_id"`
-	Status             string             `json:"status"`
-	Paid               bool               `json:"paid"`
-	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
-	Notes              pgtype.Text        `json:"notes"`
-	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
-	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
-	Email              string             `json:"email"`
-	FirstName          string             `json:"first_name"`
-	LastName           string             `json:"last_name"`
+type ShadowBanUserParams struct {
+	UserID   pgtype.UUID `json:"user_id"`
+	BannedBy pgtype.UUID `json:"banned_by"`
+	Reason   string      `json:"reason"`
 }
 
-func (q *Queries) ListParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]ListParticipantsByGameRow, error) {
-	rows, err := q.db.Query(ctx, listParticipantsByGame, gameID)
+// Shadow-banning an already shadow-banned user replaces the reason
+func (q *Queries) ShadowBanUser(ctx context.Context, arg ShadowBanUserParams) (UserShadowBan, error) {
+	row := q.db.QueryRow(ctx, shadowBanUser, arg.UserID, arg.BannedBy, arg.Reason)
+	var i UserShadowBan
+	err := row.Scan(
+		&i.UserID,
+		&i.BannedBy,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const startGamesPastStartTime = `-- name: StartGamesPastStartTime :execrows
+UPDATE games
+SET
+    status = 'in_progress',
+    updated_at = NOW()
+WHERE status IN ('open', 'full', 'closed')
+AND start_time <= NOW()
+AND start_time + make_interval(mins => duration_minutes) > NOW()
+`
+
+func (q *Queries) StartGamesPastStartTime(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, startGamesPastStartTime)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListParticipantsByGameRow{}
-	for rows.Next() {
-		var i ListParticipantsByGameRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.GameID,
-			&i.UserID,
-			&i.TeamID,
-			&i.Status,
-			&i.Paid,
-			&i.PaymentAmountCents,
-			&i.Notes,
-			&i.JoinedAt,
-			&i.UpdatedAt,
-			&i.Email,
-			&i.FirstName,
-			&i.LastName,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const listParticipantsByGames = `-- name: ListParticipantsByGames :many
-SELECT
-    p.id,
-    p.game_id,
-    p.user_id,
-    p.team_id,
-    p.status,
-    p.paid,
-    p.payment_amount_cents,
-    p.notes,
-    p.joined_at,
-    p.updated_at,
-    u.email,
-    u.first_name,
-    u.last_name
-FROM participants p
-INNER JOIN users u ON p.user_id = u.id
-WHERE p.game_id = ANY($1::uuid[])
-ORDER BY p.game_id, p.joined_at ASC
+const suspendUser = `-- name: SuspendUser :one
+INSERT INTO user_suspensions (
+    user_id,
+    suspended_by,
+    reason
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    suspended_by = EXCLUDED.suspended_by,
+    reason = EXCLUDED.reason,
+    created_at = NOW()
+RETURNING user_id, suspended_by, reason, created_at
 `
 
-type ListParticipantsByGamesRow struct {
-	ID                 pgtype.UUID        `json:"id"`
-	GameID             pgtype.UUID        `json:"game_id"`
-	UserID             pgtype.UUID        `json:"user_id"`
-	TeamID             pgtype.UUID        `json:"team_id"`
-	Status             string             `json:"status"`
-	Paid               bool               `json:"paid"`
-	PaymentAmountCents pgtype.Int4        `json:"payment_amount_cents"`
-	Notes              pgtype.Text        `json:"notes"`
-	JoinedAt           pgtype.Timestamptz `json:"joined_at"`
-	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
-	Email              string             `json:"email"`
-	FirstName          string             `json:"first_name"`
-	LastName           string             `json:"last_name"`
+type SuspendUserParams struct {
+	UserID      pgtype.UUID `json:"user_id"`
+	SuspendedBy pgtype.UUID `json:"suspended_by"`
+	Reason      string      `json:"reason"`
 }
 
-func (q *Queries) ListParticipantsByGames(ctx context.Context, gameIds []pgtype.UUID) ([]ListParticipantsByGamesRow, error) {
-	rows, err := q.db.Query(ctx, listParticipantsByGames, gameIds)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListParticipantsByGamesRow{}
-	for rows.Next() {
-		var i ListParticipantsByGamesRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.GameID,
-			&i.UserID,
-			&i.TeamID,
-			&i.Status,
-			&i.Paid,
-			&i.PaymentAmountCents,
-			&i.Notes,
-			&i.JoinedAt,
-			&i.UpdatedAt,
-			&i.Email,
-			&i.FirstName,
-			&i.LastName,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+// Suspending an already suspended user replaces the reason
+func (q *Queries) SuspendUser(ctx context.Context, arg SuspendUserParams) (UserSuspension, error) {
+	row := q.db.QueryRow(ctx, suspendUser, arg.UserID, arg.SuspendedBy, arg.Reason)
+	var i UserSuspension
+	err := row.Scan(
+		&i.UserID,
+		&i.SuspendedBy,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
 }
 
-const listParticipantsByUser = `-- name: ListParticipantsByUser :many
-SELECT id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at FROM participants
-WHERE user_id = $1
-ORDER BY joined_at DESC
+const touchConversation = `-- name: TouchConversation :exec
+UPDATE conversations
+SET
+    last_message_at = $1,
+    host_read_at = CASE WHEN host_id = $2 THEN $1 ELSE host_read_at END,
+    player_read_at = CASE WHEN player_id = $2 THEN $1 ELSE player_read_at END
+WHERE id = $3
 `
 
-func (q *Queries) ListParticipantsByUser(ctx context.Context, userID pgtype.UUID) ([]Participant, error) {
-	rows, err := q.db.Query(ctx, listParticipantsByUser, userID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Participant{}
-	for rows.Next() {
-		var i Participant
-		if err := rows.Scan(
-			&i.ID,
-			&i.GameID,
-			&i.UserID,
-			&i.TeamID,
-			&i.Status,
-			&i.Paid,
-			&i.PaymentAmountCents,
-			&i.Notes,
-			&i.JoinedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+type TouchConversationParams struct {
+	SentAt   pgtype.Timestamptz `json:"sent_at"`
+	SenderID pgtype.UUID        `json:"sender_id"`
+	ID       pgtype.UUID        `json:"id"`
 }
 
-const listTeamsByGame = `-- name: ListTeamsByGame :many
-SELECT id, game_id, name, color, created_at FROM teams
-WHERE game_id = $1
-ORDER BY created_at ASC
+// Records a new message, which the sender has read
+func (q *Queries) TouchConversation(ctx context.Context, arg TouchConversationParams) error {
+	_, err := q.db.Exec(ctx, touchConversation, arg.SentAt, arg.SenderID, arg.ID)
+	return err
+}
+
+const unassignTeamParticipants = `-- name: UnassignTeamParticipants :execrows
+UPDATE participants
+SET
+    team_id = NULL,
+    updated_at = NOW()
+WHERE team_id = $1
 `
 
-func (q *Queries) ListTeamsByGame(ctx context.Context, gameID pgtype.UUID) ([]Team, error) {
-	rows, err := q.db.Query(ctx, listTeamsByGame, gameID)
+// Takes everyone off a team before it is deleted, so their update time reflects the change
+func (q *Queries) UnassignTeamParticipants(ctx context.Context, teamID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unassignTeamParticipants, teamID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []Team{}
-	for rows.Next() {
-		var i Team
-		if err := rows.Scan(
-			&i.ID,
-			&i.GameID,
-			&i.Name,
-			&i.Color,
-			&i.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const revokeAllUserRefreshTokens = `-- name: RevokeAllUserRefreshTokens :exec
-UPDATE refresh_tokens
-SET revoked_at = NOW()
-WHERE user_id = $1
-AND revoked_at IS NULL
+const unblockPlayer = `-- name: UnblockPlayer :execrows
+DELETE FROM host_blocked_players
+WHERE host_id = $1 AND player_id = $2
 `
 
-func (q *Queries) RevokeAllUserRefreshTokens(ctx context.Context, userID pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, revokeAllUserRefreshTokens, userID)
-	return err
+type UnblockPlayerParams struct {
+	HostID   pgtype.UUID `json:"host_id"`
+	PlayerID pgtype.UUID `json:"player_id"`
 }
 
-const revokeRefreshToken = `-- name: RevokeRefreshToken :exec
-UPDATE refresh_tokens
-SET revoked_at = NOW()
-WHERE token_hash = $1
+func (q *Queries) UnblockPlayer(ctx context.Context, arg UnblockPlayerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unblockPlayer, arg.HostID, arg.PlayerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unsuspendUser = `-- name: UnsuspendUser :execrows
+DELETE FROM user_suspensions
+WHERE user_id = $1
 `
 
-func (q *Queries) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
-	_, err := q.db.Exec(ctx, revokeRefreshToken, tokenHash)
-	return err
+func (q *Queries) UnsuspendUser(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unsuspendUser, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const updateGame = `-- name: UpdateGame :one
@@ -1168,7 +7285,7 @@ SET
     location_point = CASE
         WHEN $5::float8 IS NOT NULL
             AND $6::float8 IS NOT NULL
-        THEN ST_SetSRID(ST_MakePoint($5, $6), 4326)::geography
+        THEN geo_point($5, $6)
         ELSE location_point
     END,
     location_notes = COALESCE($7, location_notes),
@@ -1183,31 +7300,35 @@ SET
     skill_level = COALESCE($16, skill_level),
     notes = COALESCE($17, notes),
     status = COALESCE($18, status),
+    adult_only = COALESCE($19, adult_only),
+    late_drop_window_hours = COALESCE($20, late_drop_window_hours),
     updated_at = NOW()
-WHERE id = $19
+WHERE id = $21
 RETURNING id
 `
 
 type UpdateGameParams struct {
-	Title              pgtype.Text        `json:"title"`
-	Description        pgtype.Text        `json:"description"`
-	LocationName       pgtype.Text        `json:"location_name"`
-	LocationAddress    pgtype.Text        `json:"location_address"`
-	LocationLongitude  pgtype.Float8      `json:"location_longitude"`
-	LocationLatitude   pgtype.Float8      `json:"location_latitude"`
-	LocationNotes      pgtype.Text        `json:"location_notes"`
-	StartTime          pgtype.Timestamptz `json:"start_time"`
-	DurationMinutes    pgtype.Int4        `json:"duration_minutes"`
-	MaxParticipants    pgtype.Int4        `json:"max_participants"`
-	PricingType        pgtype.Text        `json:"pricing_type"`
-	PricingAmountCents pgtype.Int4        `json:"pricing_amount_cents"`
-	PricingCurrency    pgtype.Text        `json:"pricing_currency"`
-	SignupDeadline     pgtype.Timestamptz `json:"signup_deadline"`
-	DropDeadline       pgtype.Timestamptz `json:"drop_deadline"`
-	SkillLevel         pgtype.Text        `json:"skill_level"`
-	Notes              pgtype.Text        `json:"notes"`
-	Status             pgtype.Text        `json:"status"`
-	ID                 pgtype.UUID        `json:"id"`
+	Title               pgtype.Text        `json:"title"`
+	Description         pgtype.Text        `json:"description"`
+	LocationName        pgtype.Text        `json:"location_name"`
+	LocationAddress     pgtype.Text        `json:"location_address"`
+	LocationLongitude   pgtype.Float8      `json:"location_longitude"`
+	LocationLatitude    pgtype.Float8      `json:"location_latitude"`
+	LocationNotes       pgtype.Text        `json:"location_notes"`
+	StartTime           pgtype.Timestamptz `json:"start_time"`
+	DurationMinutes     pgtype.Int4        `json:"duration_minutes"`
+	MaxParticipants     pgtype.Int4        `json:"max_participants"`
+	PricingType         pgtype.Text        `json:"pricing_type"`
+	PricingAmountCents  pgtype.Int4        `json:"pricing_amount_cents"`
+	PricingCurrency     pgtype.Text        `json:"pricing_currency"`
+	SignupDeadline      pgtype.Timestamptz `json:"signup_deadline"`
+	DropDeadline        pgtype.Timestamptz `json:"drop_deadline"`
+	SkillLevel          pgtype.Text        `json:"skill_level"`
+	Notes               pgtype.Text        `json:"notes"`
+	Status              pgtype.Text        `json:"status"`
+	AdultOnly           pgtype.Bool        `json:"adult_only"`
+	LateDropWindowHours pgtype.Int4        `json:"late_drop_window_hours"`
+	ID                  pgtype.UUID        `json:"id"`
 }
 
 func (q *Queries) UpdateGame(ctx context.Context, arg UpdateGameParams) (pgtype.UUID, error) {
@@ -1230,6 +7351,8 @@ func (q *Queries) UpdateGame(ctx context.Context, arg UpdateGameParams) (pgtype.
 		arg.SkillLevel,
 		arg.Notes,
 		arg.Status,
+		arg.AdultOnly,
+		arg.LateDropWindowHours,
 		arg.ID,
 	)
 	var id pgtype.UUID
@@ -1237,14 +7360,62 @@ func (q *Queries) UpdateGame(ctx context.Context, arg UpdateGameParams) (pgtype.
 	return id, err
 }
 
+const updateGameCommentBody = `-- name: UpdateGameCommentBody :one
+UPDATE game_comments
+SET
+    body = $2,
+    edited_at = NOW()
+WHERE id = $1
+RETURNING id, game_id, parent_id, author_id, body, edited_at, deleted_at, deleted_by, created_at
+`
+
+type UpdateGameCommentBodyParams struct {
+	ID   pgtype.UUID `json:"id"`
+	Body string      `json:"body"`
+}
+
+func (q *Queries) UpdateGameCommentBody(ctx context.Context, arg UpdateGameCommentBodyParams) (GameComment, error) {
+	row := q.db.QueryRow(ctx, updateGameCommentBody, arg.ID, arg.Body)
+	var i GameComment
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.ParentID,
+		&i.AuthorID,
+		&i.Body,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateGameStatus = `-- name: UpdateGameStatus :exec
+UPDATE games
+SET
+    status = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateGameStatusParams struct {
+	ID     pgtype.UUID `json:"id"`
+	Status string      `json:"status"`
+}
+
+func (q *Queries) UpdateGameStatus(ctx context.Context, arg UpdateGameStatusParams) error {
+	_, err := q.db.Exec(ctx, updateGameStatus, arg.ID, arg.Status)
+	return err
+}
+
 const updateParticipantPayment = `-- name: UpdateParticipantPayment :one
 UPDATE participants
 SET
     paid = $2,
-    payment_amount_cents = $3,
-    updated_at = NOW()
+    payment_amount_cents = $3
 WHERE id = $1
-RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
 `
 
 type UpdateParticipantPaymentParams struct {
@@ -1253,6 +7424,7 @@ type UpdateParticipantPaymentParams struct {
 	PaymentAmountCents pgtype.Int4 `json:"payment_amount_cents"`
 }
 
+// Leaves updated_at alone: it records when the status last changed, which late drops are judged by
 func (q *Queries) UpdateParticipantPayment(ctx context.Context, arg UpdateParticipantPaymentParams) (Participant, error) {
 	row := q.db.QueryRow(ctx, updateParticipantPayment, arg.ID, arg.Paid, arg.PaymentAmountCents)
 	var i Participant
@@ -1267,6 +7439,12 @@ func (q *Queries) UpdateParticipantPayment(ctx context.Context, arg UpdatePartic
 		&i.Notes,
 		&i.JoinedAt,
 		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
 	)
 	return i, err
 }
@@ -1277,7 +7455,7 @@ SET
     status = $2,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
 `
 
 type UpdateParticipantStatusParams struct {
@@ -1299,6 +7477,12 @@ func (q *Queries) UpdateParticipantStatus(ctx context.Context, arg UpdatePartici
 		&i.Notes,
 		&i.JoinedAt,
 		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
 	)
 	return i, err
 }
@@ -1307,19 +7491,25 @@ const updateParticipantStatusResetJoinedAt = `-- name: UpdateParticipantStatusRe
 UPDATE participants
 SET
     status = $2,
+    position = $3,
+    drop_reason = NULL,
+    late_drop = FALSE,
+    checked_in_at = NULL,
     updated_at = NOW(),
-    joined_at = NOW()
+    joined_at = NOW(),
+    waitlist_rank = nextval('participants_waitlist_rank_seq')
 WHERE id = $1
-RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
 `
 
 type UpdateParticipantStatusResetJoinedAtParams struct {
-	ID     pgtype.UUID `json:"id"`
-	Status string      `json:"status"`
+	ID       pgtype.UUID `json:"id"`
+	Status   string      `json:"status"`
+	Position pgtype.Text `json:"position"`
 }
 
 func (q *Queries) UpdateParticipantStatusResetJoinedAt(ctx context.Context, arg UpdateParticipantStatusResetJoinedAtParams) (Participant, error) {
-	row := q.db.QueryRow(ctx, updateParticipantStatusResetJoinedAt, arg.ID, arg.Status)
+	row := q.db.QueryRow(ctx, updateParticipantStatusResetJoinedAt, arg.ID, arg.Status, arg.Position)
 	var i Participant
 	err := row.Scan(
 		&i.ID,
@@ -1332,6 +7522,12 @@ func (q *Queries) UpdateParticipantStatusResetJoinedAt(ctx context.Context, arg
 		&i.Notes,
 		&i.JoinedAt,
 		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
 	)
 	return i, err
 }
@@ -1342,7 +7538,7 @@ SET
     team_id = $2,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at
+RETURNING id, game_id, user_id, team_id, status, paid, payment_amount_cents, notes, joined_at, updated_at, placeholder_name, drop_reason, checked_in_at, position, late_drop, waitlist_rank
 `
 
 type UpdateParticipantTeamParams struct {
@@ -1364,6 +7560,74 @@ func (q *Queries) UpdateParticipantTeam(ctx context.Context, arg UpdateParticipa
 		&i.Notes,
 		&i.JoinedAt,
 		&i.UpdatedAt,
+		&i.PlaceholderName,
+		&i.DropReason,
+		&i.CheckedInAt,
+		&i.Position,
+		&i.LateDrop,
+		&i.WaitlistRank,
+	)
+	return i, err
+}
+
+const updateReportStatus = `-- name: UpdateReportStatus :one
+UPDATE reports
+SET
+    status = $2,
+    reviewed_by = $3,
+    reviewed_at = NOW()
+WHERE id = $1
+RETURNING id, reporter_id, target_type, target_id, reason, details, status, created_at, reviewed_by, reviewed_at
+`
+
+type UpdateReportStatusParams struct {
+	ID         pgtype.UUID `json:"id"`
+	Status     string      `json:"status"`
+	ReviewedBy pgtype.UUID `json:"reviewed_by"`
+}
+
+func (q *Queries) UpdateReportStatus(ctx context.Context, arg UpdateReportStatusParams) (Report, error) {
+	row := q.db.QueryRow(ctx, updateReportStatus, arg.ID, arg.Status, arg.ReviewedBy)
+	var i Report
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterID,
+		&i.TargetType,
+		&i.TargetID,
+		&i.Reason,
+		&i.Details,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+	)
+	return i, err
+}
+
+const updateTeam = `-- name: UpdateTeam :one
+UPDATE teams
+SET
+    name = COALESCE($1, name),
+    color = COALESCE($2, color)
+WHERE id = $3
+RETURNING id, game_id, name, color, created_at
+`
+
+type UpdateTeamParams struct {
+	Name  pgtype.Text `json:"name"`
+	Color pgtype.Text `json:"color"`
+	ID    pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateTeam(ctx context.Context, arg UpdateTeamParams) (Team, error) {
+	row := q.db.QueryRow(ctx, updateTeam, arg.Name, arg.Color, arg.ID)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.Name,
+		&i.Color,
+		&i.CreatedAt,
 	)
 	return i, err
 }
@@ -1375,7 +7639,7 @@ SET
     last_name = COALESCE($2, last_name),
     email = COALESCE($3, email)
 WHERE id = $4
-RETURNING id, email, first_name, last_name, password_hash, created_at
+RETURNING id, email, first_name, last_name, password_hash, phone_number, phone_verified_at, birthdate, created_at, home_region, token_version
 `
 
 type UpdateUserParams struct {
@@ -1399,7 +7663,339 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.FirstName,
 		&i.LastName,
 		&i.PasswordHash,
+		&i.PhoneNumber,
+		&i.PhoneVerifiedAt,
+		&i.Birthdate,
+		&i.CreatedAt,
+		&i.HomeRegion,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :one
+UPDATE users
+SET
+    password_hash = $2,
+    token_version = token_version + 1
+WHERE id = $1
+RETURNING id, email, first_name, last_name, password_hash, phone_number, phone_verified_at, birthdate, created_at, home_region, token_version
+`
+
+type UpdateUserPasswordParams struct {
+	ID           pgtype.UUID `json:"id"`
+	PasswordHash string      `json:"password_hash"`
+}
+
+// Changing the password also bumps token_version so previously issued access tokens stop working
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserPassword, arg.ID, arg.PasswordHash)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.PasswordHash,
+		&i.PhoneNumber,
+		&i.PhoneVerifiedAt,
+		&i.Birthdate,
+		&i.CreatedAt,
+		&i.HomeRegion,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const updateWebAuthnCredentialUsage = `-- name: UpdateWebAuthnCredentialUsage :exec
+UPDATE webauthn_credentials
+SET
+    sign_count = $2,
+    last_used_at = NOW()
+WHERE id = $1
+`
+
+type UpdateWebAuthnCredentialUsageParams struct {
+	ID        pgtype.UUID `json:"id"`
+	SignCount int64       `json:"sign_count"`
+}
+
+func (q *Queries) UpdateWebAuthnCredentialUsage(ctx context.Context, arg UpdateWebAuthnCredentialUsageParams) error {
+	_, err := q.db.Exec(ctx, updateWebAuthnCredentialUsage, arg.ID, arg.SignCount)
+	return err
+}
+
+const upsertAttendance = `-- name: UpsertAttendance :one
+INSERT INTO attendance (game_id, user_id, status, marked_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (game_id, user_id) DO UPDATE
+SET status = EXCLUDED.status,
+    marked_by = EXCLUDED.marked_by,
+    marked_at = NOW()
+RETURNING game_id, user_id, status, marked_by, marked_at
+`
+
+type UpsertAttendanceParams struct {
+	GameID   pgtype.UUID `json:"game_id"`
+	UserID   pgtype.UUID `json:"user_id"`
+	Status   string      `json:"status"`
+	MarkedBy pgtype.UUID `json:"marked_by"`
+}
+
+func (q *Queries) UpsertAttendance(ctx context.Context, arg UpsertAttendanceParams) (Attendance, error) {
+	row := q.db.QueryRow(ctx, upsertAttendance,
+		arg.GameID,
+		arg.UserID,
+		arg.Status,
+		arg.MarkedBy,
+	)
+	var i Attendance
+	err := row.Scan(
+		&i.GameID,
+		&i.UserID,
+		&i.Status,
+		&i.MarkedBy,
+		&i.MarkedAt,
+	)
+	return i, err
+}
+
+const upsertGameMVP = `-- name: UpsertGameMVP :one
+INSERT INTO game_mvps (game_id, user_id, named_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (game_id) DO UPDATE
+SET user_id = EXCLUDED.user_id,
+    named_by = EXCLUDED.named_by,
+    named_at = NOW()
+RETURNING game_id, user_id, named_by, named_at
+`
+
+type UpsertGameMVPParams struct {
+	GameID  pgtype.UUID `json:"game_id"`
+	UserID  pgtype.UUID `json:"user_id"`
+	NamedBy pgtype.UUID `json:"named_by"`
+}
+
+func (q *Queries) UpsertGameMVP(ctx context.Context, arg UpsertGameMVPParams) (GameMvp, error) {
+	row := q.db.QueryRow(ctx, upsertGameMVP, arg.GameID, arg.UserID, arg.NamedBy)
+	var i GameMvp
+	err := row.Scan(
+		&i.GameID,
+		&i.UserID,
+		&i.NamedBy,
+		&i.NamedAt,
+	)
+	return i, err
+}
+
+const upsertGameNotificationSettings = `-- name: UpsertGameNotificationSettings :one
+INSERT INTO game_notification_settings (game_id, user_id, reminders_muted, chat_muted)
+VALUES (
+    $1,
+    $2,
+    COALESCE($3::bool, FALSE),
+    COALESCE($4::bool, FALSE)
+)
+ON CONFLICT (game_id, user_id) DO UPDATE SET
+    reminders_muted = COALESCE($3::bool, game_notification_settings.reminders_muted),
+    chat_muted = COALESCE($4::bool, game_notification_settings.chat_muted),
+    updated_at = NOW()
+RETURNING game_id, user_id, reminders_muted, chat_muted, updated_at
+`
+
+type UpsertGameNotificationSettingsParams struct {
+	GameID         pgtype.UUID `json:"game_id"`
+	UserID         pgtype.UUID `json:"user_id"`
+	RemindersMuted pgtype.Bool `json:"reminders_muted"`
+	ChatMuted      pgtype.Bool `json:"chat_muted"`
+}
+
+// Sets the given mutes, leaving NULL ones unchanged
+func (q *Queries) UpsertGameNotificationSettings(ctx context.Context, arg UpsertGameNotificationSettingsParams) (GameNotificationSetting, error) {
+	row := q.db.QueryRow(ctx, upsertGameNotificationSettings,
+		arg.GameID,
+		arg.UserID,
+		arg.RemindersMuted,
+		arg.ChatMuted,
+	)
+	var i GameNotificationSetting
+	err := row.Scan(
+		&i.GameID,
+		&i.UserID,
+		&i.RemindersMuted,
+		&i.ChatMuted,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertGameReservation = `-- name: UpsertGameReservation :one
+INSERT INTO game_reservations (game_id, user_id, reserved_by, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (game_id, user_id) DO UPDATE SET
+    reserved_by = EXCLUDED.reserved_by,
+    expires_at = EXCLUDED.expires_at,
+    released_at = NULL
+RETURNING game_id, user_id, reserved_by, expires_at, released_at, created_at
+`
+
+type UpsertGameReservationParams struct {
+	GameID     pgtype.UUID        `json:"game_id"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	ReservedBy pgtype.UUID        `json:"reserved_by"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+}
+
+// Reserving again moves the expiry and holds the spot again if it had been released
+func (q *Queries) UpsertGameReservation(ctx context.Context, arg UpsertGameReservationParams) (GameReservation, error) {
+	row := q.db.QueryRow(ctx, upsertGameReservation,
+		arg.GameID,
+		arg.UserID,
+		arg.ReservedBy,
+		arg.ExpiresAt,
+	)
+	var i GameReservation
+	err := row.Scan(
+		&i.GameID,
+		&i.UserID,
+		&i.ReservedBy,
+		&i.ExpiresAt,
+		&i.ReleasedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertNotificationPreferences = `-- name: UpsertNotificationPreferences :one
+INSERT INTO user_notification_preferences (user_id, sms_enabled, email_enabled)
+VALUES (
+    $1,
+    COALESCE($2::bool, FALSE),
+    COALESCE($3::bool, TRUE)
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    sms_enabled = COALESCE($2::bool, user_notification_preferences.sms_enabled),
+    email_enabled = COALESCE($3::bool, user_notification_preferences.email_enabled),
+    updated_at = NOW()
+RETURNING user_id, sms_enabled, updated_at, email_enabled
+`
+
+type UpsertNotificationPreferencesParams struct {
+	UserID       pgtype.UUID `json:"user_id"`
+	SmsEnabled   pgtype.Bool `json:"sms_enabled"`
+	EmailEnabled pgtype.Bool `json:"email_enabled"`
+}
+
+// Sets the given preferences, leaving NULL ones unchanged
+func (q *Queries) UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) (UserNotificationPreference, error) {
+	row := q.db.QueryRow(ctx, upsertNotificationPreferences, arg.UserID, arg.SmsEnabled, arg.EmailEnabled)
+	var i UserNotificationPreference
+	err := row.Scan(
+		&i.UserID,
+		&i.SmsEnabled,
+		&i.UpdatedAt,
+		&i.EmailEnabled,
+	)
+	return i, err
+}
+
+const upsertSkillEndorsement = `-- name: UpsertSkillEndorsement :one
+INSERT INTO skill_endorsements (game_id, endorser_id, endorsee_id, skill_level)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (game_id, endorser_id, endorsee_id) DO UPDATE
+SET skill_level = EXCLUDED.skill_level,
+    updated_at = NOW()
+RETURNING game_id, endorser_id, endorsee_id, skill_level, created_at, updated_at
+`
+
+type UpsertSkillEndorsementParams struct {
+	GameID     pgtype.UUID `json:"game_id"`
+	EndorserID pgtype.UUID `json:"endorser_id"`
+	EndorseeID pgtype.UUID `json:"endorsee_id"`
+	SkillLevel string      `json:"skill_level"`
+}
+
+func (q *Queries) UpsertSkillEndorsement(ctx context.Context, arg UpsertSkillEndorsementParams) (SkillEndorsement, error) {
+	row := q.db.QueryRow(ctx, upsertSkillEndorsement,
+		arg.GameID,
+		arg.EndorserID,
+		arg.EndorseeID,
+		arg.SkillLevel,
+	)
+	var i SkillEndorsement
+	err := row.Scan(
+		&i.GameID,
+		&i.EndorserID,
+		&i.EndorseeID,
+		&i.SkillLevel,
 		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertUserSettings = `-- name: UpsertUserSettings :one
+INSERT INTO user_settings (user_id, home_latitude, home_longitude, home_place_id, home_name, default_radius_meters)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id) DO UPDATE
+SET home_latitude = EXCLUDED.home_latitude,
+    home_longitude = EXCLUDED.home_longitude,
+    home_place_id = EXCLUDED.home_place_id,
+    home_name = EXCLUDED.home_name,
+    default_radius_meters = EXCLUDED.default_radius_meters,
+    updated_at = NOW()
+RETURNING user_id, home_latitude, home_longitude, home_point, home_place_id, home_name, default_radius_meters, updated_at, availability_windows, timezone, onboarded_at
+`
+
+type UpsertUserSettingsParams struct {
+	UserID              pgtype.UUID   `json:"user_id"`
+	HomeLatitude        pgtype.Float8 `json:"home_latitude"`
+	HomeLongitude       pgtype.Float8 `json:"home_longitude"`
+	HomePlaceID         pgtype.Text   `json:"home_place_id"`
+	HomeName            pgtype.Text   `json:"home_name"`
+	DefaultRadiusMeters pgtype.Float8 `json:"default_radius_meters"`
+}
+
+func (q *Queries) UpsertUserSettings(ctx context.Context, arg UpsertUserSettingsParams) (UserSetting, error) {
+	row := q.db.QueryRow(ctx, upsertUserSettings,
+		arg.UserID,
+		arg.HomeLatitude,
+		arg.HomeLongitude,
+		arg.HomePlaceID,
+		arg.HomeName,
+		arg.DefaultRadiusMeters,
+	)
+	var i UserSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.HomeLatitude,
+		&i.HomeLongitude,
+		&i.HomePoint,
+		&i.HomePlaceID,
+		&i.HomeName,
+		&i.DefaultRadiusMeters,
+		&i.UpdatedAt,
+		&i.AvailabilityWindows,
+		&i.Timezone,
+		&i.OnboardedAt,
 	)
 	return i, err
 }
+
+const userHasRole = `-- name: UserHasRole :one
+SELECT EXISTS (
+    SELECT 1 FROM user_roles WHERE user_id = $1 AND role = $2
+) AS has_role
+`
+
+type UserHasRoleParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	Role   string      `json:"role"`
+}
+
+func (q *Queries) UserHasRole(ctx context.Context, arg UserHasRoleParams) (bool, error) {
+	row := q.db.QueryRow(ctx, userHasRole, arg.UserID, arg.Role)
+	var has_role bool
+	err := row.Scan(&has_role)
+	return has_role, err
+}
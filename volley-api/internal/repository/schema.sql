@@ -1,6 +1,23 @@
 -- Enable PostGIS extension
 CREATE EXTENSION IF NOT EXISTS postgis;
 
+-- Geography helpers so queries build and read points the same way everywhere.
+-- Points are always geography(Point, 4326): longitude first, distances in meters.
+CREATE OR REPLACE FUNCTION geo_point(longitude float8, latitude float8)
+RETURNS geography AS $$
+    SELECT ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography
+$$ LANGUAGE SQL IMMUTABLE PARALLEL SAFE;
+
+CREATE OR REPLACE FUNCTION geo_latitude(point geography)
+RETURNS float8 AS $$
+    SELECT ST_Y(point::geometry)
+$$ LANGUAGE SQL IMMUTABLE STRICT PARALLEL SAFE;
+
+CREATE OR REPLACE FUNCTION geo_longitude(point geography)
+RETURNS float8 AS $$
+    SELECT ST_X(point::geometry)
+$$ LANGUAGE SQL IMMUTABLE STRICT PARALLEL SAFE;
+
 -- Users table
 CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -8,9 +25,26 @@ CREATE TABLE IF NOT EXISTS users (
     first_name VARCHAR(100) NOT NULL,
     last_name VARCHAR(100) NOT NULL,
     password_hash VARCHAR(255) NOT NULL,
+    phone_number VARCHAR(20), -- E.164 format, only set once verified
+    phone_verified_at TIMESTAMPTZ,
+    birthdate DATE, -- NULL for accounts created before age gating
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
+-- Region the user's data is homed to; writes from other regions are proxied or rejected (see RegionMiddleware)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS home_region VARCHAR(32) NOT NULL DEFAULT 'primary';
+
+-- Bumped on password change and "log out everywhere"; access tokens carrying an older version are rejected
+ALTER TABLE users ADD COLUMN IF NOT EXISTS token_version INTEGER NOT NULL DEFAULT 0;
+
+-- Elevated roles granted to users (e.g. admin); regular users have no rows
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(50) NOT NULL, -- admin, verified_host
+    granted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role)
+);
+
 -- Refresh tokens table for managing long-lived authentication sessions
 CREATE TABLE IF NOT EXISTS refresh_tokens (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -27,6 +61,122 @@ CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id)
 CREATE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens(token_hash);
 CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens(expires_at);
 
+-- One-time codes sent by SMS to verify a user's phone number
+CREATE TABLE IF NOT EXISTS phone_verifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    phone_number VARCHAR(20) NOT NULL, -- E.164 format
+    code_hash VARCHAR(255) NOT NULL, -- SHA-256 hash of the code
+    attempts INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_phone_verifications_user_id ON phone_verifications(user_id, created_at);
+
+-- A verified number identifies one account, since it can be used to sign in
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_verified_phone ON users(phone_number) WHERE phone_verified_at IS NOT NULL;
+
+-- One-time codes sent by SMS to sign in with a verified phone number
+CREATE TABLE IF NOT EXISTS login_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(255) NOT NULL, -- SHA-256 hash of the code
+    attempts INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_codes_user_id ON login_codes(user_id, created_at);
+
+-- Failed password sign-ins, counted per email and per client IP to throttle brute-force attempts.
+-- Not tied to users so attempts against unknown emails count too.
+CREATE TABLE IF NOT EXISTS failed_logins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email VARCHAR(255) NOT NULL, -- Lowercased
+    ip_address VARCHAR(45) NOT NULL,
+    attempted_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_failed_logins_email ON failed_logins(email, attempted_at);
+CREATE INDEX IF NOT EXISTS idx_failed_logins_ip_address ON failed_logins(ip_address, attempted_at);
+
+-- Pending email address changes; the new address only replaces the old one once the link sent to it is opened
+CREATE TABLE IF NOT EXISTS email_change_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    new_email VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(255) NOT NULL UNIQUE, -- SHA-256 hash of the confirmation token
+    expires_at TIMESTAMPTZ NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_change_requests_user_id ON email_change_requests(user_id, created_at);
+
+-- Single-use passwordless sign-in links
+CREATE TABLE IF NOT EXISTS magic_link_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(255) NOT NULL UNIQUE, -- SHA-256 hash of the link token
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_magic_link_tokens_user_id ON magic_link_tokens(user_id, created_at);
+
+-- Passkeys registered by users
+CREATE TABLE IF NOT EXISTS webauthn_credentials (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    credential_id BYTEA NOT NULL UNIQUE,
+    public_key BYTEA NOT NULL, -- DER-encoded SubjectPublicKeyInfo
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    name VARCHAR(100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_webauthn_credentials_user_id ON webauthn_credentials(user_id);
+
+-- Challenges issued for passkey ceremonies; each is consumed by the response that answers it.
+-- user_id is only set for registration, since sign-in starts before the user is known.
+CREATE TABLE IF NOT EXISTS webauthn_challenges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    ceremony VARCHAR(20) NOT NULL, -- webauthn.create, webauthn.get
+    challenge VARCHAR(255) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webauthn_challenges_expires_at ON webauthn_challenges(expires_at);
+
+-- Versioned legal documents; the latest published version of each type is the current one
+CREATE TABLE IF NOT EXISTS legal_documents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    document_type VARCHAR(50) NOT NULL, -- terms_of_service, privacy_policy
+    version VARCHAR(50) NOT NULL,
+    url TEXT,
+    published_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (document_type, version)
+);
+
+-- Record of which document versions each user accepted, kept for compliance
+CREATE TABLE IF NOT EXISTS legal_acceptances (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    document_id UUID NOT NULL REFERENCES legal_documents(id),
+    ip_address VARCHAR(45),
+    user_agent TEXT,
+    accepted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, document_id)
+);
+
 -- Games table
 CREATE TABLE IF NOT EXISTS games (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -54,6 +204,7 @@ CREATE TABLE IF NOT EXISTS games (
     signup_deadline TIMESTAMPTZ NOT NULL,
     drop_deadline TIMESTAMPTZ, -- Optional deadline for dropping from game
     skill_level VARCHAR(50) NOT NULL DEFAULT 'all',
+    adult_only BOOLEAN NOT NULL DEFAULT FALSE, -- Minors cannot see or join
     notes TEXT,
     status VARCHAR(50) NOT NULL DEFAULT 'open',
     cancelled_at TIMESTAMPTZ, -- When the game was cancelled (NULL if not cancelled)
@@ -67,9 +218,106 @@ CREATE INDEX IF NOT EXISTS idx_games_start_time ON games(start_time) where statu
 CREATE INDEX IF NOT EXISTS idx_games_category_status ON games(category, status);
 CREATE INDEX IF NOT EXISTS idx_games_owner_id ON games(owner_id);
 
--- Spatial index for location-based queries
+-- Migrate databases created before location_point was geography(Point, 4326):
+-- geometry columns (in any SRID) are reprojected to WGS 84 and converted in place.
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'games' AND column_name = 'location_point' AND udt_name = 'geometry'
+    ) THEN
+        DROP INDEX IF EXISTS idx_games_location_point;
+        ALTER TABLE games ALTER COLUMN location_point TYPE geography(Point, 4326)
+            USING ST_Transform(ST_SetSRID(location_point, COALESCE(NULLIF(ST_SRID(location_point), 0), 4326)), 4326)::geography;
+    END IF;
+END $$;
+
+-- Spatial index for location-based queries (ST_DWithin on geography uses it directly)
 CREATE INDEX IF NOT EXISTS idx_games_location_point ON games USING GIST(location_point);
 
+-- Upcoming open/full games with precomputed signup counts, kept current by the triggers below.
+-- ListGames reads from this small hot table instead of aggregating participants over all of history.
+CREATE TABLE IF NOT EXISTS upcoming_games (
+    game_id UUID PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    location_point geography(Point, 4326),
+    start_time TIMESTAMPTZ NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    category VARCHAR(50) NOT NULL,
+    adult_only BOOLEAN NOT NULL,
+    signup_count INTEGER NOT NULL DEFAULT 0 -- confirmed + waitlist
+);
+
+ALTER TABLE upcoming_games ADD COLUMN IF NOT EXISTS confirmed_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE upcoming_games ADD COLUMN IF NOT EXISTS waitlist_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_upcoming_games_location_point ON upcoming_games USING GIST(location_point);
+CREATE INDEX IF NOT EXISTS idx_upcoming_games_start_time ON upcoming_games(start_time);
+
+-- Upserts or removes a game's upcoming_games row whenever the game changes
+CREATE OR REPLACE FUNCTION sync_upcoming_game() RETURNS trigger AS $$
+BEGIN
+    IF NEW.status IN ('open', 'full') AND NEW.start_time > NOW() THEN
+        INSERT INTO upcoming_games (game_id, location_point, start_time, status, category, adult_only, signup_count, confirmed_count, waitlist_count)
+        SELECT
+            NEW.id, NEW.location_point, NEW.start_time, NEW.status, NEW.category, NEW.adult_only,
+            COUNT(*) FILTER (WHERE status IN ('confirmed', 'waitlist')),
+            COUNT(*) FILTER (WHERE status = 'confirmed'),
+            COUNT(*) FILTER (WHERE status = 'waitlist')
+        FROM participants WHERE game_id = NEW.id
+        ON CONFLICT (game_id) DO UPDATE SET
+            location_point = EXCLUDED.location_point,
+            start_time = EXCLUDED.start_time,
+            status = EXCLUDED.status,
+            category = EXCLUDED.category,
+            adult_only = EXCLUDED.adult_only,
+            signup_count = EXCLUDED.signup_count,
+            confirmed_count = EXCLUDED.confirmed_count,
+            waitlist_count = EXCLUDED.waitlist_count;
+    ELSE
+        DELETE FROM upcoming_games WHERE game_id = NEW.id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER games_sync_upcoming
+    AFTER INSERT OR UPDATE ON games
+    FOR EACH ROW EXECUTE FUNCTION sync_upcoming_game();
+
+-- Recomputes the signup counts of a game's upcoming_games row when its roster changes
+CREATE OR REPLACE FUNCTION sync_upcoming_game_signups() RETURNS trigger AS $$
+DECLARE
+    target_game_id UUID := COALESCE(NEW.game_id, OLD.game_id);
+BEGIN
+    UPDATE upcoming_games ug
+    SET signup_count = counts.signup_count,
+        confirmed_count = counts.confirmed_count,
+        waitlist_count = counts.waitlist_count
+    FROM (
+        SELECT
+            COUNT(*) FILTER (WHERE status IN ('confirmed', 'waitlist')) AS signup_count,
+            COUNT(*) FILTER (WHERE status = 'confirmed') AS confirmed_count,
+            COUNT(*) FILTER (WHERE status = 'waitlist') AS waitlist_count
+        FROM participants WHERE game_id = target_game_id
+    ) counts
+    WHERE ug.game_id = target_game_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Game change history for material edits (time, location, price, capacity)
+CREATE TABLE IF NOT EXISTS game_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    field VARCHAR(50) NOT NULL, -- API field name, e.g. startTime, location.name, pricing.amountCents
+    old_value TEXT,
+    new_value TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_changes_game_id ON game_changes(game_id, created_at);
+
 -- Teams table for games that support team-based play
 CREATE TABLE IF NOT EXISTS teams (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -103,6 +351,42 @@ CREATE TABLE IF NOT EXISTS participants (
     UNIQUE(game_id, user_id)
 );
 
+-- Placeholder participants are friends of the host without an account: name only, no user, until linked
+ALTER TABLE participants ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE participants ADD COLUMN IF NOT EXISTS placeholder_name VARCHAR(100);
+
+-- Why a participant dropped (injury, schedule_conflict, weather, other), shown to the host; cleared on rejoin
+ALTER TABLE participants ADD COLUMN IF NOT EXISTS drop_reason VARCHAR(50);
+
+-- When a confirmed participant checked themselves in at the venue; cleared on rejoin
+ALTER TABLE participants ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMPTZ;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'participants_user_or_placeholder') THEN
+        ALTER TABLE participants ADD CONSTRAINT participants_user_or_placeholder
+            CHECK (user_id IS NOT NULL OR placeholder_name IS NOT NULL);
+    END IF;
+END $$;
+
+CREATE OR REPLACE TRIGGER participants_sync_upcoming
+    AFTER INSERT OR UPDATE OR DELETE ON participants
+    FOR EACH ROW EXECUTE FUNCTION sync_upcoming_game_signups();
+
+-- Backfill upcoming games that existed before upcoming_games did
+INSERT INTO upcoming_games (game_id, location_point, start_time, status, category, adult_only, signup_count)
+SELECT g.id, g.location_point, g.start_time, g.status, g.category, g.adult_only,
+    (SELECT COUNT(*) FROM participants p WHERE p.game_id = g.id AND p.status IN ('confirmed', 'waitlist'))
+FROM games g
+WHERE g.status IN ('open', 'full') AND g.start_time > NOW()
+ON CONFLICT (game_id) DO NOTHING;
+
+-- Backfill the confirmed/waitlist split of rows created before it was tracked
+UPDATE upcoming_games ug
+SET confirmed_count = (SELECT COUNT(*) FROM participants p WHERE p.game_id = ug.game_id AND p.status = 'confirmed'),
+    waitlist_count = (SELECT COUNT(*) FROM participants p WHERE p.game_id = ug.game_id AND p.status = 'waitlist')
+WHERE ug.confirmed_count + ug.waitlist_count <> ug.signup_count;
+
 -- Indexes for teams
 CREATE INDEX IF NOT EXISTS idx_teams_game_id ON teams(game_id);
 
@@ -110,3 +394,684 @@ CREATE INDEX IF NOT EXISTS idx_teams_game_id ON teams(game_id);
 CREATE INDEX IF NOT EXISTS idx_participants_game_id ON participants(game_id);
 CREATE INDEX IF NOT EXISTS idx_participants_user_id ON participants(user_id);
 CREATE INDEX IF NOT EXISTS idx_participants_status ON participants(game_id, status);
+
+-- Immutable roster snapshots taken when a game starts, so later drops and edits don't rewrite
+-- the record used for payments and attendance
+CREATE TABLE IF NOT EXISTS roster_snapshots (
+    game_id UUID PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    max_participants INTEGER NOT NULL,
+    taken_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS roster_snapshot_entries (
+    game_id UUID NOT NULL REFERENCES roster_snapshots(game_id) ON DELETE CASCADE,
+    -- IDs are not foreign keys: the snapshot outlives the participant and user rows and is never updated
+    participant_id UUID NOT NULL,
+    user_id UUID, -- NULL for placeholders
+    first_name VARCHAR(100) NOT NULL,
+    last_name VARCHAR(100) NOT NULL,
+    status VARCHAR(50) NOT NULL, -- confirmed, waitlist
+    waitlist_position INTEGER,
+    team_id UUID,
+    paid BOOLEAN NOT NULL,
+    payment_amount_cents INTEGER,
+    joined_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (game_id, participant_id)
+);
+
+-- Snapshots the active roster when a game leaves open/full/closed for in_progress or completed
+-- (a game can skip in_progress if the status job didn't run during it)
+CREATE OR REPLACE FUNCTION snapshot_roster_on_start() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO roster_snapshots (game_id, max_participants)
+    VALUES (NEW.id, NEW.max_participants)
+    ON CONFLICT (game_id) DO NOTHING;
+    IF NOT FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO roster_snapshot_entries (
+        game_id, participant_id, user_id, first_name, last_name, status,
+        waitlist_position, team_id, paid, payment_amount_cents, joined_at
+    )
+    SELECT
+        p.game_id, p.id, p.user_id, COALESCE(u.first_name, p.placeholder_name), COALESCE(u.last_name, ''), p.status,
+        CASE WHEN p.status = 'waitlist'
+            THEN ROW_NUMBER() OVER (PARTITION BY p.status ORDER BY p.waitlist_rank ASC)
+        END,
+        p.team_id, p.paid, p.payment_amount_cents, p.joined_at
+    FROM participants p
+    LEFT JOIN users u ON p.user_id = u.id
+    WHERE p.game_id = NEW.id
+    AND p.status IN ('confirmed', 'waitlist');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER games_snapshot_roster
+    AFTER UPDATE OF status ON games
+    FOR EACH ROW
+    WHEN (OLD.status IN ('open', 'full', 'closed') AND NEW.status IN ('in_progress', 'completed'))
+    EXECUTE FUNCTION snapshot_roster_on_start();
+
+CREATE OR REPLACE FUNCTION reject_snapshot_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'roster snapshots are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER roster_snapshots_immutable
+    BEFORE UPDATE ON roster_snapshots
+    FOR EACH ROW EXECUTE FUNCTION reject_snapshot_update();
+
+CREATE OR REPLACE TRIGGER roster_snapshot_entries_immutable
+    BEFORE UPDATE ON roster_snapshot_entries
+    FOR EACH ROW EXECUTE FUNCTION reject_snapshot_update();
+
+-- Opt-in journal of join/drop requests (VOLLEY_JOURNAL=true), replayed by cmd/replay to reproduce roster anomalies
+CREATE TABLE IF NOT EXISTS participation_journal (
+    id BIGSERIAL PRIMARY KEY,
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    action VARCHAR(10) NOT NULL, -- join, drop
+    requested_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ NOT NULL,
+    outcome VARCHAR(50) NOT NULL, -- resulting participant status, or error
+    error TEXT,
+    promoted_user_id UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_participation_journal_game_id ON participation_journal(game_id, requested_at);
+
+-- Attendance marked by the host after a game starts; one row per player per game, kept as attendance history
+CREATE TABLE IF NOT EXISTS attendance (
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('attended', 'no_show')),
+    marked_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    marked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_attendance_user_id ON attendance(user_id, marked_at);
+
+-- Per-player reliability, rebuilt from finished games by the refresh-reliability-scores job so
+-- roster and profile reads never aggregate participation history
+CREATE TABLE IF NOT EXISTS player_reliability (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    honored INTEGER NOT NULL,    -- Finished games they stayed confirmed for and showed up to
+    late_drops INTEGER NOT NULL, -- Drops within the late-drop window before start
+    no_shows INTEGER NOT NULL,   -- Confirmed but marked no_show by the host
+    score INTEGER NOT NULL CHECK (score BETWEEN 0 AND 100),
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Skill levels players vouch for in each other after playing a completed game together; one per
+-- endorser per player per game, updated if the endorser changes their mind
+CREATE TABLE IF NOT EXISTS skill_endorsements (
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    endorser_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    endorsee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    skill_level VARCHAR(20) NOT NULL CHECK (skill_level IN ('beginner', 'intermediate', 'advanced')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, endorser_id, endorsee_id),
+    CHECK (endorser_id <> endorsee_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_skill_endorsements_endorsee_id ON skill_endorsements(endorsee_id, endorser_id, updated_at);
+
+-- The player a host named most valuable in a completed game; naming another replaces them
+CREATE TABLE IF NOT EXISTS game_mvps (
+    game_id UUID PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    named_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    named_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Per-player totals of completed games by sport and map cell, rebuilt by the refresh-leaderboards job
+-- so leaderboards sum a few rows per player instead of scanning participation history. Cells are
+-- 0.1 degree squares (about 11 km north-south) keyed by floor(coordinate * 10); cell_point is the
+-- cell's center, which is what the leaderboard radius is measured to.
+CREATE TABLE IF NOT EXISTS leaderboard_cells (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category VARCHAR(50) NOT NULL,
+    cell_x INTEGER NOT NULL,
+    cell_y INTEGER NOT NULL,
+    cell_point geography(Point, 4326) NOT NULL,
+    games_played INTEGER NOT NULL,
+    games_hosted INTEGER NOT NULL,
+    mvps INTEGER NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, category, cell_x, cell_y)
+);
+
+CREATE INDEX IF NOT EXISTS idx_leaderboard_cells_cell_point ON leaderboard_cells USING GIST (cell_point);
+
+-- Follow-up work of a committed game change (waitlist promotion, participant notifications) that
+-- failed inline and is retried by the process-side-effects job until it succeeds
+CREATE TABLE IF NOT EXISTS side_effects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind VARCHAR(50) NOT NULL, -- reconcile_roster, notify_cancellation, refund_credits
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'done', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_side_effects_due ON side_effects(next_attempt_at) WHERE status = 'pending';
+ALTER TABLE side_effects ADD COLUMN IF NOT EXISTS attempt_history JSONB NOT NULL DEFAULT '[]'; -- [{attempt, error, at}] per failed attempt
+
+-- Players a host has blocked from joining any of their games; existing sign-ups are left alone
+CREATE TABLE IF NOT EXISTS host_blocked_players (
+    host_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (host_id, player_id),
+    CHECK (host_id <> player_id)
+);
+
+-- An organizer's request that confirmed players share their phone number for day-of coordination.
+-- Requests and consents are deleted once the game ends by the expire-contact-sharing job.
+CREATE TABLE IF NOT EXISTS contact_share_requests (
+    game_id UUID PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    requested_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A participant's consent to share their phone number with the organizer of one game
+CREATE TABLE IF NOT EXISTS contact_share_consents (
+    game_id UUID NOT NULL REFERENCES contact_share_requests(game_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    consented_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, user_id)
+);
+
+-- Positions a game takes sign-ups for (e.g. setter, goalkeeper), each capping its confirmed players
+-- on top of max_participants. Games without positions take sign-ups for any spot.
+CREATE TABLE IF NOT EXISTS game_positions (
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    PRIMARY KEY (game_id, name)
+);
+
+-- Position the participant signed up for; NULL in games without positions and for placeholders
+ALTER TABLE participants ADD COLUMN IF NOT EXISTS position VARCHAR(50);
+
+-- A participant's notification mutes for one game, on top of their global preferences. Game
+-- updates (cancellations, promotions) can't be muted.
+CREATE TABLE IF NOT EXISTS game_notification_settings (
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reminders_muted BOOLEAN NOT NULL DEFAULT FALSE,
+    chat_muted BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, user_id)
+);
+
+-- Audit log of organizer corrections to a participant's record after the fact (attendance, payment)
+CREATE TABLE IF NOT EXISTS participation_corrections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    corrected_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    field VARCHAR(50) NOT NULL, -- attendance, paid, paymentAmountCents
+    old_value TEXT,
+    new_value TEXT,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_participation_corrections_game_id ON participation_corrections(game_id, created_at);
+
+-- Spots a host holds for specific players. An unexpired reservation keeps a spot out of reach of
+-- other sign-ups until its player joins; released_at marks expired ones the roster was reconciled for.
+CREATE TABLE IF NOT EXISTS game_reservations (
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reserved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    released_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_reservations_unreleased ON game_reservations(expires_at) WHERE released_at IS NULL;
+
+-- How close to the start a drop counts as late; NULL uses the default of 24 hours, 0 disables it
+ALTER TABLE games ADD COLUMN IF NOT EXISTS late_drop_window_hours INTEGER CHECK (late_drop_window_hours BETWEEN 0 AND 168);
+
+-- Set when a confirmed player drops inside the game's late-drop window; feeds reliability scores
+ALTER TABLE participants ADD COLUMN IF NOT EXISTS late_drop BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Drops recorded before the flag existed were judged by the default window
+UPDATE participants p
+SET late_drop = TRUE
+FROM games g
+WHERE g.id = p.game_id
+AND p.status = 'dropped'
+AND NOT p.late_drop
+AND p.updated_at >= g.start_time - INTERVAL '24 hours';
+
+-- Explicit place in each game's line: lower ranks are confirmed first and promoted first off the
+-- waitlist. New sign-ups take the next value, so they join at the back; hosts can rearrange the
+-- waitlisted part of the line without touching joined_at.
+CREATE SEQUENCE IF NOT EXISTS participants_waitlist_rank_seq;
+
+ALTER TABLE participants ADD COLUMN IF NOT EXISTS waitlist_rank BIGINT;
+
+-- Existing lines keep the order they had by join time
+UPDATE participants p
+SET waitlist_rank = ranked.rank
+FROM (
+    SELECT id, ROW_NUMBER() OVER (ORDER BY joined_at ASC, id ASC) AS rank
+    FROM participants
+) ranked
+WHERE ranked.id = p.id
+AND p.waitlist_rank IS NULL;
+
+SELECT setval('participants_waitlist_rank_seq', GREATEST((SELECT MAX(waitlist_rank) FROM participants), 1));
+
+ALTER TABLE participants ALTER COLUMN waitlist_rank SET DEFAULT nextval('participants_waitlist_rank_seq');
+ALTER TABLE participants ALTER COLUMN waitlist_rank SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_participants_game_waitlist_rank ON participants(game_id, waitlist_rank);
+
+-- Reports of games and users filed by players, kept for admin review
+CREATE TABLE IF NOT EXISTS reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('game', 'user')),
+    target_id UUID NOT NULL, -- games.id or users.id, depending on target_type
+    reason VARCHAR(50) NOT NULL, -- spam, harassment, inappropriate, fraud, safety, other
+    details TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'open', -- open, resolved, dismissed
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reports_reporter_id ON reports(reporter_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports(status, created_at);
+-- One open report per reporter and target, so repeats don't flood the review queue
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_open_target ON reports(reporter_id, target_type, target_id) WHERE status = 'open';
+
+-- Who reviewed a report, and when
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMPTZ;
+
+-- Accounts suspended by an admin; suspended users are rejected with 403 on every authenticated request
+CREATE TABLE IF NOT EXISTS user_suspensions (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    suspended_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Shadow-banned accounts keep working, but their games are listed only to themselves and their
+-- join requests never leave the waitlist
+CREATE TABLE IF NOT EXISTS user_shadow_bans (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    banned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Every admin mutation (suspensions, forced cancellations, roster removals, report reviews), kept
+-- for accountability. No foreign keys, so entries outlive the accounts and games they mention.
+CREATE TABLE IF NOT EXISTS admin_audit (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_id UUID NOT NULL, -- admin who took the action
+    action VARCHAR(50) NOT NULL, -- suspend_user, unsuspend_user, shadow_ban_user, lift_shadow_ban, cancel_game, remove_participant, review_report, requeue_dead_letter
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('game', 'user', 'report', 'dead_letter')),
+    target_id UUID NOT NULL,
+    game_id UUID, -- game the action was taken in, when the target is a player
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_created_at ON admin_audit(created_at);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_actor_id ON admin_audit(actor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_target_id ON admin_audit(target_id, created_at);
+
+-- Databases created before dead letters were audited have the original target_type check
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM pg_constraint
+        WHERE conname = 'admin_audit_target_type_check'
+        AND pg_get_constraintdef(oid) NOT LIKE '%dead_letter%'
+    ) THEN
+        ALTER TABLE admin_audit DROP CONSTRAINT admin_audit_target_type_check;
+        ALTER TABLE admin_audit ADD CONSTRAINT admin_audit_target_type_check
+            CHECK (target_type IN ('game', 'user', 'report', 'dead_letter'));
+    END IF;
+END $$;
+
+CREATE OR REPLACE FUNCTION reject_admin_audit_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'admin audit entries are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER admin_audit_immutable
+    BEFORE UPDATE OR DELETE ON admin_audit
+    FOR EACH ROW EXECUTE FUNCTION reject_admin_audit_change();
+
+-- Games flagged by the content filter are queued for review as reports without a reporter, one
+-- open report per game
+ALTER TABLE reports ALTER COLUMN reporter_id DROP NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_open_flag ON reports(target_type, target_id) WHERE status = 'open' AND reporter_id IS NULL;
+
+-- Per-host game creation limits count recent creations
+CREATE INDEX IF NOT EXISTS idx_games_owner_created_at ON games(owner_id, created_at);
+
+-- Sports a user wants to play, with the level they consider themselves at. They default the game
+-- list's categories and steer recommendations when set.
+CREATE TABLE IF NOT EXISTS user_sport_preferences (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category VARCHAR(50) NOT NULL,
+    skill_level VARCHAR(20) CHECK (skill_level IN ('beginner', 'intermediate', 'advanced')), -- NULL when not given
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, category)
+);
+
+-- Per-user defaults for location-based searches, so clients don't resend coordinates on every request
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    home_latitude DOUBLE PRECISION,
+    home_longitude DOUBLE PRECISION,
+    home_point geography(Point, 4326) GENERATED ALWAYS AS (geo_point(home_longitude, home_latitude)) STORED,
+    home_place_id VARCHAR(255), -- Google Place ID when the home was picked from place search
+    home_name VARCHAR(255),     -- Place name shown back to the user
+    default_radius_meters DOUBLE PRECISION CHECK (default_radius_meters > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((home_latitude IS NULL) = (home_longitude IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_settings_home_point ON user_settings USING GIST (home_point);
+
+-- Onboarding answers: when the user can play, read in their timezone, and when they finished onboarding
+ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS availability_windows TEXT[] NOT NULL DEFAULT '{}'
+    CHECK (availability_windows <@ ARRAY['weekday_mornings', 'weekday_afternoons', 'weekday_evenings', 'weekend_mornings', 'weekend_afternoons', 'weekend_evenings']::TEXT[]);
+ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS timezone VARCHAR(64); -- IANA name, e.g. America/Chicago
+ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS onboarded_at TIMESTAMPTZ;
+
+-- Each user's shareable referral code; created at sign-up, or on first use for older accounts
+CREATE TABLE IF NOT EXISTS referral_codes (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    code VARCHAR(16) UNIQUE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Sign-ups that came through a referral code; a user is referred at most once
+CREATE TABLE IF NOT EXISTS referrals (
+    referred_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    referrer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code VARCHAR(16) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (referrer_id <> referred_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_referrals_referrer ON referrals(referrer_id, created_at DESC);
+
+-- Each user's credit balance, kept next to the ledger so spends can lock and check it
+CREATE TABLE IF NOT EXISTS credit_wallets (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    balance_cents INTEGER NOT NULL DEFAULT 0 CHECK (balance_cents >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Append-only ledger of credit earned (positive) and spent (negative)
+CREATE TABLE IF NOT EXISTS credit_transactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount_cents INTEGER NOT NULL CHECK (amount_cents <> 0),
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('referral', 'refund', 'game_join')),
+    game_id UUID REFERENCES games(id) ON DELETE SET NULL,
+    balance_after_cents INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_transactions_user ON credit_transactions(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_credit_transactions_game ON credit_transactions(game_id) WHERE game_id IS NOT NULL;
+
+-- Accounts their owners have switched off for now. Deactivated users can't sign in and their
+-- profile is hidden until they reactivate with their password.
+CREATE TABLE IF NOT EXISTS user_deactivations (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    deactivated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Why the host cancelled a game, when they said; included in the cancellation notifications
+CREATE TABLE IF NOT EXISTS game_cancellation_reasons (
+    game_id UUID PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A user's notification channel choices across all games. SMS is opt-in and is only sent to a
+-- verified phone number.
+CREATE TABLE IF NOT EXISTS user_notification_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    sms_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Game notification emails, turned off by the one-click unsubscribe link in their footer. Account
+-- emails such as sign-in links are always sent.
+ALTER TABLE user_notification_preferences ADD COLUMN IF NOT EXISTS email_enabled BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Endpoints a user has asked to receive events about the games they host. The secret signs every
+-- delivery so the receiver can check it came from Volley.
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    secret VARCHAR(100) NOT NULL,
+    event_types TEXT[] NOT NULL, -- game.created, participant.joined, participant.dropped, game.cancelled
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_owner ON webhook_subscriptions(owner_id);
+
+-- One event to POST to one subscription, retried with backoff by the deliver-webhooks job until
+-- the endpoint accepts it
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS attempt_history JSONB NOT NULL DEFAULT '[]'; -- [{attempt, error, at}] per failed attempt
+
+-- Every push, email and text handed to a provider, kept for 30 days so support can see what
+-- happened to a message a user says never arrived. Transient failures stay queued and are retried
+-- by the retry-notifications job; the payload is cleared once a delivery is finished with.
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    channel VARCHAR(10) NOT NULL CHECK (channel IN ('push', 'email', 'sms')),
+    recipient VARCHAR(255) NOT NULL, -- User ID for push, address for email, E.164 number for SMS
+    summary TEXT, -- Email subject or push title; SMS bodies can hold codes so aren't summarized
+    payload JSONB,
+    status VARCHAR(20) NOT NULL DEFAULT 'queued' CHECK (status IN ('queued', 'sent', 'failed', 'bounced')),
+    attempts INTEGER NOT NULL DEFAULT 1,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_due ON notification_deliveries(next_attempt_at) WHERE status = 'queued';
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_recipient ON notification_deliveries(channel, lower(recipient), created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_created_at ON notification_deliveries(created_at);
+ALTER TABLE notification_deliveries ADD COLUMN IF NOT EXISTS attempt_history JSONB NOT NULL DEFAULT '[]'; -- [{attempt, error, at}] per failed attempt
+
+-- Background job runs, shared by every API replica so each job runs on one replica per interval.
+-- A replica claims a run by taking the lease in locked_until; a crashed replica's lease just expires.
+CREATE TABLE IF NOT EXISTS job_runs (
+    name VARCHAR(100) PRIMARY KEY,
+    last_run_by VARCHAR(255) NOT NULL, -- Replica that claimed the latest run (hostname and process ID)
+    last_started_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ NOT NULL,
+    last_finished_at TIMESTAMPTZ,
+    last_error TEXT
+);
+
+-- Queued work that ran out of retries: side effects, webhook deliveries and email and SMS
+-- deliveries. Each keeps what it needs to run again, so an admin can re-enqueue it once the cause
+-- is fixed. The source rows stay behind as failed. Dead letters are deleted after 30 days.
+CREATE TABLE IF NOT EXISTS dead_letters (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source VARCHAR(30) NOT NULL CHECK (source IN ('side_effect', 'webhook_delivery', 'notification_delivery')),
+    source_id UUID NOT NULL, -- Row in the source's queue table; may since have been deleted
+    kind VARCHAR(50) NOT NULL, -- Side effect kind, webhook event type or notification channel
+    payload JSONB NOT NULL, -- What re-enqueueing needs: the game, the subscription and event, or the message
+    attempts INTEGER NOT NULL,
+    attempt_history JSONB NOT NULL,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    requeued_at TIMESTAMPTZ,
+    requeued_by UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letters_created_at ON dead_letters(created_at DESC);
+
+-- Domain events queued for the external event broker (VOLLEY_EVENT_BROKER), written in the
+-- documented JSON schema and sent by the publish-broker-events job with the side effect backoff.
+-- Delivery is at least once: consumers dedupe on the event ID. Finished rows are deleted after 7 days.
+CREATE TABLE IF NOT EXISTS broker_events (
+    id UUID PRIMARY KEY, -- Also the event's ID in the published document
+    event_type VARCHAR(50) NOT NULL,
+    partition_key VARCHAR(100) NOT NULL, -- Game ID, so one game's events stay in order on a Kafka partition
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'published', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_broker_events_due ON broker_events(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_broker_events_created_at ON broker_events(created_at);
+
+-- Messages an organizer sent to a game's roster, shown in the game's details and pushed to its
+-- confirmed and waitlisted players
+CREATE TABLE IF NOT EXISTS game_announcements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    author_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    message TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_announcements_game_id ON game_announcements(game_id, created_at DESC);
+
+-- Private threads between a game's host and one of its players, so players can ask the host
+-- questions without exchanging phone numbers. There is one per game and player.
+CREATE TABLE IF NOT EXISTS conversations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    host_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    host_read_at TIMESTAMPTZ, -- Messages sent after these are unread
+    player_read_at TIMESTAMPTZ,
+    last_message_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (game_id, player_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_conversations_host_id ON conversations(host_id);
+CREATE INDEX IF NOT EXISTS idx_conversations_player_id ON conversations(player_id);
+
+CREATE TABLE IF NOT EXISTS conversation_messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    conversation_id UUID NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    sender_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_conversation_messages_conversation_id ON conversation_messages(conversation_id, created_at DESC);
+
+-- A game's chat room, shared by the host and the confirmed and waitlisted players
+CREATE TABLE IF NOT EXISTS game_chat_messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    sender_id UUID REFERENCES users(id) ON DELETE SET NULL, -- NULL once the sender's account is deleted
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_chat_messages_game_id ON game_chat_messages(game_id, created_at DESC);
+
+-- Public comments on a game, visible to anyone who can view it. Replies point at the comment that
+-- starts their thread, so threads are one level deep. Deleted comments keep their row, without the
+-- text, so their replies still have a thread.
+CREATE TABLE IF NOT EXISTS game_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES game_comments(id) ON DELETE CASCADE,
+    author_id UUID REFERENCES users(id) ON DELETE SET NULL, -- NULL once the author's account is deleted
+    body TEXT NOT NULL,
+    edited_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    deleted_by UUID REFERENCES users(id) ON DELETE SET NULL, -- The author, or the host moderating
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_comments_game_id ON game_comments(game_id, created_at);
+
+-- Free-form labels hosts put on their games, such as "indoor" or "beginner-friendly", stored
+-- normalized (lowercase, hyphens for spaces) so filters match however they were typed
+CREATE TABLE IF NOT EXISTS game_tags (
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    tag VARCHAR(30) NOT NULL,
+    PRIMARY KEY (game_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_game_tags_tag ON game_tags(tag);
+
+-- Named game searches a user can re-run in one tap, such as "Tuesday volleyball near work".
+-- filters holds the listing filters as JSON (models.SavedSearchFilters) so new ones need no migration.
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    filters JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user_id ON saved_searches(user_id, created_at);
+
+-- Saved searches with alerts on are matched against each new game. search_point is the search's
+-- center, so matching can use the spatial index.
+ALTER TABLE saved_searches ADD COLUMN IF NOT EXISTS alerts_enabled BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE saved_searches ADD COLUMN IF NOT EXISTS search_point geography(Point, 4326)
+    GENERATED ALWAYS AS (geo_point((filters->>'longitude')::float8, (filters->>'latitude')::float8)) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_search_point ON saved_searches USING GIST (search_point) WHERE alerts_enabled;
+
+-- New-game alerts sent to users, one per user and game. A user's rows from the last day count
+-- toward their daily alert cap.
+CREATE TABLE IF NOT EXISTS new_game_alerts (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    game_id UUID NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    saved_search_id UUID REFERENCES saved_searches(id) ON DELETE SET NULL,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, game_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_new_game_alerts_user_sent_at ON new_game_alerts(user_id, sent_at);
@@ -0,0 +1,44 @@
+package repository
+
+import "context"
+
+// StreamExportGames runs the ExportGames query and hands each row to fn as it is read, so large
+// exports never hold the full result set in memory. The query is cancelled with ctx, and an error
+// returned by fn stops the stream and is returned as-is.
+func (q *Queries) StreamExportGames(ctx context.Context, arg ExportGamesParams, fn func(ExportGamesRow) error) error {
+	rows, err := q.db.Query(ctx, exportGames, arg.CreatedFrom, arg.CreatedTo)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i ExportGamesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Category,
+			&i.Title,
+			&i.Status,
+			&i.LocationName,
+			&i.Latitude,
+			&i.Longitude,
+			&i.StartTime,
+			&i.DurationMinutes,
+			&i.MaxParticipants,
+			&i.ConfirmedCount,
+			&i.WaitlistCount,
+			&i.PricingType,
+			&i.PricingAmountCents,
+			&i.PricingCurrency,
+			&i.AdultOnly,
+			&i.CancelledAt,
+			&i.CreatedAt,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
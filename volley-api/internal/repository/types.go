@@ -21,5 +21,9 @@ func ToParticipantDetail(p ListActiveParticipantsByGameRow) ParticipantDetail {
 		Email:              p.Email,
 		FirstName:          p.FirstName,
 		LastName:           p.LastName,
+		Birthdate:          p.Birthdate,
+		CheckedInAt:        p.CheckedInAt,
+		Position:           p.Position,
+		ShadowBanned:       p.ShadowBanned,
 	}
 }
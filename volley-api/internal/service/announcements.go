@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrTooManyAnnouncements is returned when a game already has maxAnnouncementsPerGame announcements
+var ErrTooManyAnnouncements = errors.New("announcement limit reached for this game")
+
+// maxAnnouncementsPerGame caps how many messages organizers can send one roster, since each one
+// reaches every player
+const maxAnnouncementsPerGame = 20
+
+// PostAnnouncement saves an organizer's message to the game's roster and publishes it, so the
+// confirmed and waitlisted players who haven't muted reminders for the game are notified. The message is screened like other game text:
+// in flag mode it is sent and the game is queued for review.
+func (s *GamesService) PostAnnouncement(ctx context.Context, gameID string, userID string, request models.CreateAnnouncementRequest) (*models.Announcement, error) {
+	logger := log.Ctx(ctx)
+
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	message := strings.TrimSpace(request.Message)
+	if message == "" {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "message",
+			Message:      "message must not be empty",
+		}
+	}
+	flagged, err := s.screenGameText([]gameTextField{{"announcement", &message}})
+	if err != nil {
+		return nil, err
+	}
+
+	var game repository.GetGameForUpdateRow
+	var announcement repository.GameAnnouncement
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		game, err = lockOwnedGame(ctx, q, gameUUID, userUUID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := q.ListGameAnnouncements(ctx, gameUUID)
+		if err != nil {
+			return fmt.Errorf("failed to list announcements: %w", err)
+		}
+		if len(existing) >= maxAnnouncementsPerGame {
+			return ErrTooManyAnnouncements
+		}
+
+		announcement, err = q.CreateGameAnnouncement(ctx, repository.CreateGameAnnouncementParams{
+			GameID:   gameUUID,
+			AuthorID: userUUID,
+			Message:  message,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create announcement: %w", err)
+		}
+
+		if len(flagged) > 0 {
+			return flagGameContent(ctx, q, gameUUID, flagged)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("announcementId", uuid.UUID(announcement.ID.Bytes).String()).Msg("Announcement posted")
+
+	// The announcement is already saved and shown in the game's details, so players who can't be
+	// listed now only miss the notification
+	recipients, err := s.announcementRecipients(ctx, gameUUID, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get participants for announcement")
+	}
+	s.publish(ctx, events.AnnouncementPosted{
+		Game:         gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime),
+		AuthorID:     userID,
+		Message:      message,
+		Participants: recipients,
+	})
+
+	result := convertAnnouncementToModel(announcement)
+	return &result, nil
+}
+
+// announcementRecipients is the game's roster other than the author, minus players who muted
+// reminders from the organizer for the game
+func (s *GamesService) announcementRecipients(ctx context.Context, gameUUID pgtype.UUID, authorID string) ([]models.User, error) {
+	participants, err := s.queries.ListParticipantsByGame(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+	roster := map[pgtype.UUID]models.User{}
+	var userUUIDs []pgtype.UUID
+	for _, recipient := range rosterRecipients(participants) {
+		var userUUID pgtype.UUID
+		if recipient.ID == authorID || userUUID.Scan(recipient.ID) != nil {
+			continue
+		}
+		roster[userUUID] = recipient
+		userUUIDs = append(userUUIDs, userUUID)
+	}
+
+	unmuted, err := s.notificationRecipients(ctx, gameUUID, NotificationTopicReminders, userUUIDs)
+	if err != nil {
+		return nil, err
+	}
+	recipients := make([]models.User, 0, len(unmuted))
+	for _, userUUID := range unmuted {
+		recipients = append(recipients, roster[userUUID])
+	}
+	return recipients, nil
+}
+
+// listAnnouncements returns the game's announcements, newest first
+func (s *GamesService) listAnnouncements(ctx context.Context, gameUUID pgtype.UUID) ([]models.Announcement, error) {
+	rows, err := s.queries.ListGameAnnouncements(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+	announcements := make([]models.Announcement, 0, len(rows))
+	for _, row := range rows {
+		announcements = append(announcements, convertAnnouncementToModel(row))
+	}
+	return announcements, nil
+}
+
+func convertAnnouncementToModel(announcement repository.GameAnnouncement) models.Announcement {
+	var authorID *string
+	if announcement.AuthorID.Valid {
+		id := uuid.UUID(announcement.AuthorID.Bytes).String()
+		authorID = &id
+	}
+	return models.Announcement{
+		ID:        uuid.UUID(announcement.ID.Bytes).String(),
+		AuthorID:  authorID,
+		Message:   announcement.Message,
+		CreatedAt: announcement.CreatedAt.Time.UTC(),
+	}
+}
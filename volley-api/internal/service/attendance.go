@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrAttendanceNotOpen = errors.New("attendance can only be marked once the game has started")
+
+// MarkAttendance records whether a confirmed participant attended the owner's game. Attendance can be marked
+// from the start time on, including after the game is completed, and marking again overwrites the status.
+func (s *GamesService) MarkAttendance(ctx context.Context, gameID string, ownerID string, userID string, status models.AttendanceStatus) (*models.Attendance, error) {
+	var gameUUID, ownerUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if status != models.AttendanceStatusAttended && status != models.AttendanceStatusNoShow {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "status",
+			Message:      "status must be attended or no_show",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.OwnerID != ownerUUID {
+		return nil, ErrNotOwner
+	}
+	if game.Status == string(models.GameStatusCancelled) || time.Now().Before(game.StartTime.Time) {
+		return nil, ErrAttendanceNotOpen
+	}
+
+	participant, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotParticipant
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.Status != string(models.ParticipantStatusConfirmed) {
+		return nil, ErrNotParticipant
+	}
+
+	attendance, err := s.queries.UpsertAttendance(ctx, repository.UpsertAttendanceParams{
+		GameID:   gameUUID,
+		UserID:   userUUID,
+		Status:   string(status),
+		MarkedBy: ownerUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark attendance: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("attendance", string(status)).Msg("Attendance marked")
+	return &models.Attendance{
+		GameID:   uuid.UUID(attendance.GameID.Bytes).String(),
+		UserID:   uuid.UUID(attendance.UserID.Bytes).String(),
+		Status:   models.AttendanceStatus(attendance.Status),
+		MarkedAt: attendance.MarkedAt.Time.UTC(),
+	}, nil
+}
+
+// attendanceWindow is how many of a player's most recent marked games count toward their no-show summary
+const attendanceWindow = 10
+
+// attendanceSummaries returns the no-show summary of each user with marked attendance, keyed by user ID
+func (s *GamesService) attendanceSummaries(ctx context.Context, userUUIDs []pgtype.UUID) (map[string]models.AttendanceSummary, error) {
+	summaries := map[string]models.AttendanceSummary{}
+	if len(userUUIDs) == 0 {
+		return summaries, nil
+	}
+	rows, err := s.queries.ListAttendanceSummaries(ctx, repository.ListAttendanceSummariesParams{
+		UserIds:    userUUIDs,
+		WindowSize: attendanceWindow,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attendance summaries: %w", err)
+	}
+	for _, row := range rows {
+		summaries[uuid.UUID(row.UserID.Bytes).String()] = models.AttendanceSummary{
+			NoShows: int(row.NoShows),
+			Games:   int(row.Games),
+		}
+	}
+	return summaries, nil
+}
+
+// attachAttendanceSummaries sets RecentAttendance on the participants that have marked attendance.
+// Placeholders have no account and are skipped.
+func (s *GamesService) attachAttendanceSummaries(ctx context.Context, participants ...[]models.Participant) error {
+	summaries, err := s.attendanceSummaries(ctx, participantUserUUIDs(participants...))
+	if err != nil {
+		return err
+	}
+	for _, list := range participants {
+		for i := range list {
+			if summary, ok := summaries[list[i].ID]; ok {
+				list[i].RecentAttendance = &summary
+			}
+		}
+	}
+	return nil
+}
+
+// participantUserUUIDs returns the user IDs of the participants that have an account
+func participantUserUUIDs(participants ...[]models.Participant) []pgtype.UUID {
+	var userUUIDs []pgtype.UUID
+	for _, list := range participants {
+		for _, p := range list {
+			if p.PlaceholderID != nil {
+				continue
+			}
+			var userUUID pgtype.UUID
+			if err := userUUID.Scan(p.ID); err == nil {
+				userUUIDs = append(userUUIDs, userUUID)
+			}
+		}
+	}
+	return userUUIDs
+}
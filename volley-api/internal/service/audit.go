@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// ListAdminAuditFilters filters and pages the admin audit trail
+type ListAdminAuditFilters struct {
+	ActorID  *string             // Only actions taken by this admin
+	TargetID *string             // Only actions against this game, user or report (or within this game)
+	Action   *models.AdminAction // Only this kind of action
+	Limit    int                 // Number of results to return (default 50, max 100)
+	Offset   int                 // Number of results to skip (default 0)
+}
+
+// ListAdminAudit returns one page of the admin audit trail, newest first
+func (u *UserService) ListAdminAudit(ctx context.Context, filters ListAdminAuditFilters) (*models.ListAuditResponse, error) {
+	if filters.Limit < 0 || filters.Offset < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "limit",
+			Message:      "limit and offset must be non-negative",
+		}
+	}
+	if filters.Limit == 0 {
+		filters.Limit = 50
+	}
+	if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	params := repository.ListAdminAuditParams{
+		// Fetch one extra row to learn whether another page follows
+		PageLimit:  int32(filters.Limit + 1),
+		PageOffset: int32(filters.Offset),
+	}
+	if filters.ActorID != nil {
+		if err := params.ActorID.Scan(*filters.ActorID); err != nil {
+			return nil, &InvalidArgumentError{ArgumentName: "actorId", Message: "invalid actor ID format"}
+		}
+	}
+	if filters.TargetID != nil {
+		if err := params.TargetID.Scan(*filters.TargetID); err != nil {
+			return nil, &InvalidArgumentError{ArgumentName: "targetId", Message: "invalid target ID format"}
+		}
+	}
+	if filters.Action != nil {
+		params.Action = pgtype.Text{String: string(*filters.Action), Valid: true}
+	}
+	rows, err := u.queries.ListAdminAudit(ctx, params)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list admin audit")
+		return nil, fmt.Errorf("failed to list admin audit: %w", err)
+	}
+
+	hasMore := len(rows) > filters.Limit
+	if hasMore {
+		rows = rows[:filters.Limit]
+	}
+
+	entries := make([]models.AuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, convertAuditEntryToModel(row))
+	}
+
+	response := &models.ListAuditResponse{
+		Entries: entries,
+		Limit:   filters.Limit,
+		Offset:  filters.Offset,
+		HasMore: hasMore,
+	}
+	if hasMore {
+		next := filters.Offset + filters.Limit
+		response.NextOffset = &next
+	}
+	return response, nil
+}
+
+func convertAuditEntryToModel(entry repository.AdminAudit) models.AuditEntry {
+	var gameID *string
+	if entry.GameID.Valid {
+		id := uuid.UUID(entry.GameID.Bytes).String()
+		gameID = &id
+	}
+	return models.AuditEntry{
+		ID:         uuid.UUID(entry.ID.Bytes).String(),
+		ActorID:    uuid.UUID(entry.ActorID.Bytes).String(),
+		Action:     models.AdminAction(entry.Action),
+		TargetType: models.AuditTargetType(entry.TargetType),
+		TargetID:   uuid.UUID(entry.TargetID.Bytes).String(),
+		GameID:     gameID,
+		Reason:     pgTextToStringPtr(entry.Reason),
+		CreatedAt:  entry.CreatedAt.Time.UTC(),
+	}
+}
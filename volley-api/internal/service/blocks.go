@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrBlockedByHost   = errors.New("the host of this game has blocked this user")
+	ErrCannotBlockSelf = errors.New("hosts cannot block themselves")
+)
+
+// BlockPlayer stops a player from joining any game the host owns and hides those games from their
+// game list. Games they have already joined are not affected. The host's contact details are also
+// withheld from contact sharing in games the player organizes. Blocking an already blocked player
+// is a no-op.
+func (u *UserService) BlockPlayer(ctx context.Context, hostID string, playerID string) error {
+	hostUUID, playerUUID, err := parseBlockIDs(hostID, playerID)
+	if err != nil {
+		return err
+	}
+	if hostUUID == playerUUID {
+		return ErrCannotBlockSelf
+	}
+
+	if _, err := u.queries.GetUserByID(ctx, playerUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.ErrNotFound
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := u.queries.BlockPlayer(ctx, repository.BlockPlayerParams{
+		HostID:   hostUUID,
+		PlayerID: playerUUID,
+	}); err != nil {
+		return fmt.Errorf("failed to block player: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("playerId", playerID).Msg("Player blocked")
+	return nil
+}
+
+// UnblockPlayer lets a blocked player join the host's games again
+func (u *UserService) UnblockPlayer(ctx context.Context, hostID string, playerID string) error {
+	hostUUID, playerUUID, err := parseBlockIDs(hostID, playerID)
+	if err != nil {
+		return err
+	}
+
+	removed, err := u.queries.UnblockPlayer(ctx, repository.UnblockPlayerParams{
+		HostID:   hostUUID,
+		PlayerID: playerUUID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unblock player: %w", err)
+	}
+	if removed == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	log.Ctx(ctx).Info().Str("playerId", playerID).Msg("Player unblocked")
+	return nil
+}
+
+// ListBlockedPlayers returns the players the host has blocked, most recent first
+func (u *UserService) ListBlockedPlayers(ctx context.Context, hostID string) ([]models.BlockedPlayer, error) {
+	var hostUUID pgtype.UUID
+	if err := hostUUID.Scan(hostID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	rows, err := u.queries.ListBlockedPlayers(ctx, hostUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blocked players: %w", err)
+	}
+
+	blocked := make([]models.BlockedPlayer, 0, len(rows))
+	for _, row := range rows {
+		blocked = append(blocked, models.BlockedPlayer{
+			UserID:    uuid.UUID(row.PlayerID.Bytes).String(),
+			FirstName: row.FirstName,
+			LastName:  row.LastName,
+			BlockedAt: row.CreatedAt.Time.UTC(),
+		})
+	}
+	return blocked, nil
+}
+
+func parseBlockIDs(hostID string, playerID string) (pgtype.UUID, pgtype.UUID, error) {
+	var hostUUID, playerUUID pgtype.UUID
+	if err := hostUUID.Scan(hostID); err != nil {
+		return hostUUID, playerUUID, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := playerUUID.Scan(playerID); err != nil {
+		return hostUUID, playerUUID, &InvalidArgumentError{
+			ArgumentName: "player_id",
+			Message:      "invalid player ID format",
+		}
+	}
+	return hostUUID, playerUUID, nil
+}
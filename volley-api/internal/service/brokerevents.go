@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/broker"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// brokerBatchSize is how many due events one run of the job claims
+	brokerBatchSize = 50
+	// brokerTimeout bounds each publish, so an unreachable broker can't outlast the claim's lease
+	brokerTimeout = 10 * time.Second
+	// maxBrokerAttempts is how many times an event is tried before it is marked failed. With the
+	// side effect backoff that spans about three and a half hours.
+	maxBrokerAttempts = 10
+)
+
+// BrokerPublisher queues domain events for the external event broker and publishes them in the
+// documented JSON schema, retrying failures with backoff. Queueing them first means a broker
+// outage delays events rather than losing them.
+type BrokerPublisher struct {
+	queries   ifaces.Querier
+	publisher broker.Publisher
+}
+
+func NewBrokerPublisher(queries ifaces.Querier, publisher broker.Publisher) *BrokerPublisher {
+	return &BrokerPublisher{
+		queries:   queries,
+		publisher: publisher,
+	}
+}
+
+// Subscribe queues every game event published on the bus for the broker
+func (b *BrokerPublisher) Subscribe(bus *events.Bus) {
+	bus.SubscribeAll(b.Enqueue)
+}
+
+// Enqueue queues the event for the broker. Events without a broker document are skipped.
+func (b *BrokerPublisher) Enqueue(ctx context.Context, event events.Event) error {
+	document, ok := brokerEvent(event)
+	if !ok {
+		return nil
+	}
+	payload, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("failed to encode broker event: %w", err)
+	}
+
+	var eventUUID pgtype.UUID
+	if err := eventUUID.Scan(document.ID); err != nil {
+		return fmt.Errorf("invalid broker event ID: %w", err)
+	}
+	if err := b.queries.EnqueueBrokerEvent(ctx, repository.EnqueueBrokerEventParams{
+		ID:           eventUUID,
+		EventType:    document.Type,
+		PartitionKey: document.Data.GameID,
+		Payload:      payload,
+	}); err != nil {
+		return fmt.Errorf("failed to queue broker event: %w", err)
+	}
+	return nil
+}
+
+// brokerEvent builds the broker document for a game event
+func brokerEvent(event events.Event) (models.BrokerEvent, bool) {
+	var game events.Game
+	var data models.BrokerEventData
+	switch event := event.(type) {
+	case events.GameCreated:
+		game = event.Game
+	case events.GameCancelled:
+		game = event.Game
+	case events.ParticipantJoined:
+		game = event.Game
+		data.UserID = &event.UserID
+		data.ParticipantStatus = &event.Status
+	case events.ParticipantDropped:
+		game = event.Game
+		data.UserID = &event.UserID
+		data.LateDrop = &event.LateDrop
+	case events.ParticipantPromoted:
+		game = event.Game
+		data.UserID = &event.Player.ID
+	default:
+		return models.BrokerEvent{}, false
+	}
+
+	data.GameID = game.ID
+	data.HostID = game.OwnerID
+	data.Category = game.Category
+	data.StartTime = game.StartTime.UTC()
+	return models.BrokerEvent{
+		SchemaVersion: models.BrokerEventSchemaVersion,
+		ID:            uuid.NewString(),
+		Type:          event.EventName(),
+		OccurredAt:    time.Now().UTC(),
+		Data:          data,
+	}, true
+}
+
+// PublishDue sends due events to the broker, oldest first. Events the broker doesn't accept are
+// retried with the side effect backoff until maxBrokerAttempts, after which they are marked failed.
+func (b *BrokerPublisher) PublishDue(ctx context.Context) error {
+	queued, err := b.queries.ClaimDueBrokerEvents(ctx, repository.ClaimDueBrokerEventsParams{
+		LeaseSeconds: int32(sideEffectLease / time.Second),
+		BatchSize:    brokerBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim broker events: %w", err)
+	}
+
+	for _, event := range queued {
+		eventID := uuid.UUID(event.ID.Bytes).String()
+		logger := log.Ctx(ctx).With().
+			Str("eventId", eventID).
+			Str("eventType", event.EventType).
+			Int32("attempt", event.Attempts).
+			Logger()
+
+		publishCtx, cancel := context.WithTimeout(ctx, brokerTimeout)
+		publishErr := b.publisher.Publish(publishCtx, broker.Message{
+			ID:   eventID,
+			Type: event.EventType,
+			Key:  event.PartitionKey,
+			Body: event.Payload,
+		})
+		cancel()
+		if publishErr == nil {
+			if err := b.queries.CompleteBrokerEvent(ctx, event.ID); err != nil {
+				return fmt.Errorf("failed to complete broker event: %w", err)
+			}
+			logger.Debug().Msg("Broker event published")
+			continue
+		}
+
+		lastError := pgtype.Text{String: publishErr.Error(), Valid: true}
+		if event.Attempts >= maxBrokerAttempts {
+			if err := b.queries.FailBrokerEvent(ctx, repository.FailBrokerEventParams{ID: event.ID, LastError: lastError}); err != nil {
+				return fmt.Errorf("failed to mark broker event failed: %w", err)
+			}
+			logger.Error().Err(publishErr).Msg("Broker event failed permanently")
+			continue
+		}
+
+		nextAttempt := time.Now().Add(sideEffectBackoff(event.Attempts))
+		if err := b.queries.RescheduleBrokerEvent(ctx, repository.RescheduleBrokerEventParams{
+			ID:            event.ID,
+			LastError:     lastError,
+			NextAttemptAt: pgtype.Timestamptz{Time: nextAttempt, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to reschedule broker event: %w", err)
+		}
+		logger.Warn().Err(publishErr).Time("nextAttemptAt", nextAttempt).Msg("Broker event failed - rescheduled")
+	}
+	return nil
+}
+
+// PruneBrokerEvents deletes published and failed events older than 7 days
+func (b *BrokerPublisher) PruneBrokerEvents(ctx context.Context) error {
+	deleted, err := b.queries.DeleteOldBrokerEvents(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prune broker events: %w", err)
+	}
+	if deleted > 0 {
+		log.Ctx(ctx).Info().Int64("deleted", deleted).Msg("Pruned old broker events")
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// BulkUpdateParticipants confirms or waitlists many participants of the owner's game at once and
+// returns the updated game.
+//
+// Statuses follow each participant's place in line, so a status alone would be undone by the next
+// reconciliation. Instead the participants move to the front of the line (confirm) or the back
+// (waitlist) in the given order, and the roster is recomputed under the game row lock. Players
+// sent to the back stay confirmed if nobody is waiting for their spot, and confirmations can't
+// exceed the game's capacity.
+func (s *GamesService) BulkUpdateParticipants(ctx context.Context, gameID string, ownerID string, request models.BulkUpdateParticipantsRequest) (*models.Game, error) {
+	var gameUUID, ownerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if request.Status != models.ParticipantStatusConfirmed && request.Status != models.ParticipantStatusWaitlist {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "status",
+			Message:      "status must be confirmed or waitlist",
+		}
+	}
+	if len(request.UserIDs) == 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_ids",
+			Message:      "at least one user ID is required",
+		}
+	}
+
+	var game repository.GetGameForUpdateRow
+	var toConfirm, toWaitlist []pgtype.UUID
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		var err error
+		game, err = lockOwnedGame(ctx, q, gameUUID, ownerUUID)
+		if err != nil {
+			return err
+		}
+		if request.Status == models.ParticipantStatusConfirmed {
+			spots, err := openSpots(ctx, q, gameUUID, game.MaxParticipants)
+			if err != nil {
+				return err
+			}
+			if len(request.UserIDs) > int(spots) {
+				return &InvalidArgumentError{
+					ArgumentName: "user_ids",
+					Message:      fmt.Sprintf("can't confirm more than the game's %d open spots", spots),
+				}
+			}
+		}
+
+		participants, err := q.ListParticipantsByGame(ctx, gameUUID)
+		if err != nil {
+			return fmt.Errorf("failed to list participants: %w", err)
+		}
+		active := make(map[pgtype.UUID]pgtype.UUID, len(participants)) // user ID -> participant ID
+		for _, p := range participants {
+			if p.UserID.Valid && !InactiveParticipantStates[p.Status] {
+				active[p.UserID] = p.ID
+			}
+		}
+
+		participantIDs := make([]pgtype.UUID, 0, len(request.UserIDs))
+		seen := make(map[pgtype.UUID]bool, len(request.UserIDs))
+		for _, userID := range request.UserIDs {
+			var userUUID pgtype.UUID
+			if err := userUUID.Scan(userID); err != nil {
+				return &InvalidArgumentError{
+					ArgumentName: "user_ids",
+					Message:      fmt.Sprintf("invalid user ID %q", userID),
+				}
+			}
+			participantID, ok := active[userUUID]
+			if !ok {
+				return &InvalidArgumentError{
+					ArgumentName: "user_ids",
+					Message:      fmt.Sprintf("user %s is not confirmed or waitlisted in this game", userID),
+				}
+			}
+			if !seen[userUUID] {
+				seen[userUUID] = true
+				participantIDs = append(participantIDs, participantID)
+			}
+		}
+
+		if request.Status == models.ParticipantStatusConfirmed {
+			err = q.MoveParticipantsToFrontOfLine(ctx, repository.MoveParticipantsToFrontOfLineParams{
+				ParticipantIds: participantIDs,
+				GameID:         gameUUID,
+			})
+		} else {
+			err = q.MoveParticipantsToBackOfLine(ctx, repository.MoveParticipantsToBackOfLineParams{
+				ParticipantIds: participantIDs,
+				GameID:         gameUUID,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to move participants in line: %w", err)
+		}
+
+		toConfirm, toWaitlist, err = applyRosterChanges(ctx, q, game)
+		if err != nil {
+			return err
+		}
+
+		return syncCapacityStatus(ctx, q, gameUUID, game.Status, game.MaxParticipants)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("status", string(request.Status)).
+		Int("requested", len(request.UserIDs)).
+		Int("confirmed", len(toConfirm)).
+		Int("waitlisted", len(toWaitlist)).
+		Msg("Participants updated in bulk")
+
+	// The roster is already updated, so a failed lookup only costs the players their news
+	promotedInto := gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime)
+	if _, err := s.announcePromotions(ctx, promotedInto, gameUUID, toConfirm); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to get players promoted in bulk")
+	}
+	return s.GetGame(ctx, gameID, ownerID)
+}
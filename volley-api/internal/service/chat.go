@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrNotChatMember is returned when someone other than the host or a confirmed or waitlisted
+// player uses a game's chat
+var ErrNotChatMember = errors.New("only the host and confirmed or waitlisted players can use the game's chat")
+
+const (
+	// maxChatMessageLength caps a chat message, in characters
+	maxChatMessageLength = 1000
+	// chatHistoryPageSize caps how many messages one page of a game's chat history returns
+	chatHistoryPageSize = 50
+)
+
+// AuthorizeChat checks that the user may use the game's chat: its host, or a confirmed or
+// waitlisted player
+func (s *GamesService) AuthorizeChat(ctx context.Context, gameID string, userID string) error {
+	gameUUID, userUUID, err := parseChatIDs(gameID, userID)
+	if err != nil {
+		return err
+	}
+	return s.chatMember(ctx, gameUUID, userUUID)
+}
+
+// ListChatMessages returns a page of the game's chat, newest first. Passing the oldest message's
+// time as before returns the page ahead of it.
+func (s *GamesService) ListChatMessages(ctx context.Context, gameID string, userID string, before *time.Time) ([]models.ChatMessage, error) {
+	gameUUID, userUUID, err := parseChatIDs(gameID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.chatMember(ctx, gameUUID, userUUID); err != nil {
+		return nil, err
+	}
+
+	params := repository.ListGameChatMessagesParams{
+		GameID:     gameUUID,
+		MaxResults: chatHistoryPageSize,
+	}
+	if before != nil {
+		params.Before = pgtype.Timestamptz{Time: *before, Valid: true}
+	}
+	rows, err := s.queries.ListGameChatMessages(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chat messages: %w", err)
+	}
+
+	messages := make([]models.ChatMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, models.ChatMessage{
+			ID:              uuid.UUID(row.ID.Bytes).String(),
+			GameID:          uuid.UUID(row.GameID.Bytes).String(),
+			SenderID:        chatSenderID(row.SenderID),
+			SenderFirstName: row.SenderFirstName.String,
+			SenderLastName:  row.SenderLastName.String,
+			Body:            row.Body,
+			CreatedAt:       row.CreatedAt.Time.UTC(),
+		})
+	}
+	return messages, nil
+}
+
+// PostChatMessage saves a message to the game's chat and publishes it to the room. Membership is
+// checked on every message, so a player who drops out stops being able to post even with a
+// connection still open. The message is screened like other game text: in flag mode it is sent
+// and the game is queued for review.
+func (s *GamesService) PostChatMessage(ctx context.Context, gameID string, userID string, body string) (*models.ChatMessage, error) {
+	gameUUID, userUUID, err := parseChatIDs(gameID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "body",
+			Message:      "body must not be empty",
+		}
+	}
+	if utf8.RuneCountInString(body) > maxChatMessageLength {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "body",
+			Message:      fmt.Sprintf("body must be at most %d characters", maxChatMessageLength),
+		}
+	}
+
+	if err := s.chatMember(ctx, gameUUID, userUUID); err != nil {
+		return nil, err
+	}
+	flagged, err := s.screenGameText([]gameTextField{{"chat", &body}})
+	if err != nil {
+		return nil, err
+	}
+	sender, err := s.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var saved repository.GameChatMessage
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		saved, err = q.CreateGameChatMessage(ctx, repository.CreateGameChatMessageParams{
+			GameID:   gameUUID,
+			SenderID: userUUID,
+			Body:     body,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create chat message: %w", err)
+		}
+		if len(flagged) > 0 {
+			return flagGameContent(ctx, q, gameUUID, flagged)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := models.ChatMessage{
+		ID:              uuid.UUID(saved.ID.Bytes).String(),
+		GameID:          uuid.UUID(saved.GameID.Bytes).String(),
+		SenderID:        chatSenderID(saved.SenderID),
+		SenderFirstName: sender.FirstName,
+		SenderLastName:  sender.LastName,
+		Body:            saved.Body,
+		CreatedAt:       saved.CreatedAt.Time.UTC(),
+	}
+	s.publish(ctx, events.ChatMessagePosted{Message: message})
+	return &message, nil
+}
+
+// chatMember checks that the user hosts the game or is confirmed or waitlisted in it
+func (s *GamesService) chatMember(ctx context.Context, gameUUID pgtype.UUID, userUUID pgtype.UUID) error {
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.ErrNotFound
+		}
+		return fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.OwnerID == userUUID {
+		return nil
+	}
+
+	participant, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotChatMember
+		}
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.Status != string(models.ParticipantStatusConfirmed) && participant.Status != string(models.ParticipantStatusWaitlist) {
+		return ErrNotChatMember
+	}
+	return nil
+}
+
+func parseChatIDs(gameID string, userID string) (pgtype.UUID, pgtype.UUID, error) {
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return gameUUID, userUUID, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return gameUUID, userUUID, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	return gameUUID, userUUID, nil
+}
+
+func chatSenderID(senderID pgtype.UUID) *string {
+	if !senderID.Valid {
+		return nil
+	}
+	id := uuid.UUID(senderID.Bytes).String()
+	return &id
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// checkInOpensBefore is how long before the start time players can check in; check-in closes when the game ends
+const checkInOpensBefore = time.Hour
+
+var ErrCheckInNotOpen = errors.New("check-in is not open for this game")
+
+// CheckIn marks a confirmed participant as arrived and returns the updated game. Check-in is open from
+// checkInOpensBefore the start time until the game ends. Checking in again keeps the first check-in time.
+func (s *GamesService) CheckIn(ctx context.Context, gameID string, userID string) (*models.Game, error) {
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.Status == string(models.GameStatusCancelled) {
+		return nil, ErrCheckInNotOpen
+	}
+	now := time.Now()
+	opensAt := game.StartTime.Time.Add(-checkInOpensBefore)
+	closesAt := game.StartTime.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
+	if now.Before(opensAt) || now.After(closesAt) {
+		return nil, ErrCheckInNotOpen
+	}
+
+	participant, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotParticipant
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	// Waitlisted players don't have a spot to check in to
+	if participant.Status != string(models.ParticipantStatusConfirmed) {
+		return nil, ErrNotParticipant
+	}
+
+	if !participant.CheckedInAt.Valid {
+		if _, err := s.queries.CheckInParticipant(ctx, participant.ID); err != nil {
+			return nil, fmt.Errorf("failed to check in participant: %w", err)
+		}
+		log.Ctx(ctx).Info().Msg("Participant checked in")
+	}
+
+	return s.GetGame(ctx, gameID, userID)
+}
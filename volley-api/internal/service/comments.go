@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrNotCommentAuthor is returned when someone other than a comment's author edits it
+	ErrNotCommentAuthor = errors.New("only the comment's author can edit it")
+	// ErrCannotDeleteComment is returned when someone other than a comment's author or the game's
+	// host deletes it
+	ErrCannotDeleteComment = errors.New("only the comment's author or the game's host can delete it")
+)
+
+// ListComments returns the game's comments, top-level comments oldest first with their replies
+// nested under them
+func (s *GamesService) ListComments(ctx context.Context, gameID string) ([]models.Comment, error) {
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if _, err := s.commentGame(ctx, gameUUID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListGameComments(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	comments := []models.Comment{}
+	threads := map[pgtype.UUID]int{}
+	for _, row := range rows {
+		comment := models.Comment{
+			ID:              uuid.UUID(row.ID.Bytes).String(),
+			ParentID:        optionalUUIDString(row.ParentID),
+			AuthorID:        optionalUUIDString(row.AuthorID),
+			AuthorFirstName: row.AuthorFirstName.String,
+			AuthorLastName:  row.AuthorLastName.String,
+			Body:            row.Body,
+			Deleted:         row.DeletedAt.Valid,
+			EditedAt:        pgTimestamptzToTimePtr(row.EditedAt),
+			CreatedAt:       row.CreatedAt.Time.UTC(),
+		}
+		if comment.Deleted {
+			comment.AuthorID = nil
+			comment.AuthorFirstName = ""
+			comment.AuthorLastName = ""
+			comment.EditedAt = nil
+		}
+
+		if !row.ParentID.Valid {
+			threads[row.ID] = len(comments)
+			comments = append(comments, comment)
+			continue
+		}
+		// Replies come after the comment they reply to, as they're listed oldest first
+		if i, ok := threads[row.ParentID]; ok {
+			comments[i].Replies = append(comments[i].Replies, comment)
+		}
+	}
+	return comments, nil
+}
+
+// PostComment adds the user's comment to the game, as a reply when a parent is given. Anyone who
+// can see the game can comment, except players its host has blocked. The text is screened like
+// other game text: in flag mode the comment is posted and the game is queued for review.
+func (s *GamesService) PostComment(ctx context.Context, gameID string, userID string, req models.CreateCommentRequest) (*models.Comment, error) {
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "body",
+			Message:      "body must not be empty",
+		}
+	}
+
+	game, err := s.commentGame(ctx, gameUUID)
+	if err != nil {
+		return nil, err
+	}
+	if game.OwnerID != userUUID {
+		blocked, err := s.queries.IsPlayerBlockedByHost(ctx, repository.IsPlayerBlockedByHostParams{
+			HostID:   game.OwnerID,
+			PlayerID: userUUID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check host block list: %w", err)
+		}
+		if blocked {
+			return nil, ErrBlockedByHost
+		}
+	}
+
+	var parentUUID pgtype.UUID
+	if req.ParentID != nil {
+		parentUUID, err = s.commentThread(ctx, gameUUID, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	flagged, err := s.screenGameText([]gameTextField{{"comment", &body}})
+	if err != nil {
+		return nil, err
+	}
+	author, err := s.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var saved repository.GameComment
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		saved, err = q.CreateGameComment(ctx, repository.CreateGameCommentParams{
+			GameID:   gameUUID,
+			ParentID: parentUUID,
+			AuthorID: userUUID,
+			Body:     body,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create comment: %w", err)
+		}
+		if len(flagged) > 0 {
+			return flagGameContent(ctx, q, gameUUID, flagged)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	comment := gameCommentToModel(saved, author)
+	return &comment, nil
+}
+
+// EditComment replaces the text of the user's own comment, screening it again
+func (s *GamesService) EditComment(ctx context.Context, gameID string, commentID string, userID string, req models.UpdateCommentRequest) (*models.Comment, error) {
+	comment, userUUID, err := s.getComment(ctx, gameID, commentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorID != userUUID {
+		return nil, ErrNotCommentAuthor
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "body",
+			Message:      "body must not be empty",
+		}
+	}
+	flagged, err := s.screenGameText([]gameTextField{{"comment", &body}})
+	if err != nil {
+		return nil, err
+	}
+	author, err := s.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var saved repository.GameComment
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		saved, err = q.UpdateGameCommentBody(ctx, repository.UpdateGameCommentBodyParams{
+			ID:   comment.ID,
+			Body: body,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update comment: %w", err)
+		}
+		if len(flagged) > 0 {
+			return flagGameContent(ctx, q, comment.GameID, flagged)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated := gameCommentToModel(saved, author)
+	return &updated, nil
+}
+
+// DeleteComment removes a comment. Its author can delete it, and the game's host can delete any
+// comment on their game. The comment's replies stay, under a deleted placeholder.
+func (s *GamesService) DeleteComment(ctx context.Context, gameID string, commentID string, userID string) error {
+	comment, userUUID, err := s.getComment(ctx, gameID, commentID, userID)
+	if err != nil {
+		return err
+	}
+
+	if comment.AuthorID != userUUID {
+		game, err := s.commentGame(ctx, comment.GameID)
+		if err != nil {
+			return err
+		}
+		if game.OwnerID != userUUID {
+			return ErrCannotDeleteComment
+		}
+		log.Ctx(ctx).Info().
+			Str("commentId", uuid.UUID(comment.ID.Bytes).String()).
+			Msg("Host deleted a comment on their game")
+	}
+
+	if err := s.queries.DeleteGameComment(ctx, repository.DeleteGameCommentParams{
+		ID:        comment.ID,
+		DeletedBy: userUUID,
+	}); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	return nil
+}
+
+// commentGame returns the game being commented on
+func (s *GamesService) commentGame(ctx context.Context, gameUUID pgtype.UUID) (repository.GetGameRow, error) {
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return game, apperrors.ErrNotFound
+		}
+		return game, fmt.Errorf("failed to get game: %w", err)
+	}
+	return game, nil
+}
+
+// commentThread returns the comment starting the thread a reply to parentID joins
+func (s *GamesService) commentThread(ctx context.Context, gameUUID pgtype.UUID, parentID string) (pgtype.UUID, error) {
+	var parentUUID pgtype.UUID
+	if err := parentUUID.Scan(parentID); err != nil {
+		return parentUUID, &InvalidArgumentError{
+			ArgumentName: "parentId",
+			Message:      "invalid parent comment ID format",
+		}
+	}
+	parent, err := s.queries.GetGameComment(ctx, repository.GetGameCommentParams{
+		ID:     parentUUID,
+		GameID: gameUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return parentUUID, &InvalidArgumentError{
+				ArgumentName: "parentId",
+				Message:      "parent comment not found on this game",
+			}
+		}
+		return parentUUID, fmt.Errorf("failed to get parent comment: %w", err)
+	}
+	if parent.ParentID.Valid {
+		return parent.ParentID, nil
+	}
+	return parent.ID, nil
+}
+
+// getComment returns a comment on the game that hasn't been deleted, along with the parsed user ID
+func (s *GamesService) getComment(ctx context.Context, gameID string, commentID string, userID string) (repository.GameComment, pgtype.UUID, error) {
+	var comment repository.GameComment
+	var gameUUID, commentUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return comment, userUUID, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := commentUUID.Scan(commentID); err != nil {
+		return comment, userUUID, &InvalidArgumentError{
+			ArgumentName: "comment_id",
+			Message:      "invalid comment ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return comment, userUUID, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	comment, err := s.queries.GetGameComment(ctx, repository.GetGameCommentParams{
+		ID:     commentUUID,
+		GameID: gameUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return comment, userUUID, apperrors.ErrNotFound
+		}
+		return comment, userUUID, fmt.Errorf("failed to get comment: %w", err)
+	}
+	if comment.DeletedAt.Valid {
+		return comment, userUUID, apperrors.ErrNotFound
+	}
+	return comment, userUUID, nil
+}
+
+func gameCommentToModel(comment repository.GameComment, author repository.User) models.Comment {
+	return models.Comment{
+		ID:              uuid.UUID(comment.ID.Bytes).String(),
+		ParentID:        optionalUUIDString(comment.ParentID),
+		AuthorID:        optionalUUIDString(comment.AuthorID),
+		AuthorFirstName: author.FirstName,
+		AuthorLastName:  author.LastName,
+		Body:            comment.Body,
+		EditedAt:        pgTimestamptzToTimePtr(comment.EditedAt),
+		CreatedAt:       comment.CreatedAt.Time.UTC(),
+	}
+}
+
+func optionalUUIDString(id pgtype.UUID) *string {
+	if !id.Valid {
+		return nil
+	}
+	s := uuid.UUID(id.Bytes).String()
+	return &s
+}
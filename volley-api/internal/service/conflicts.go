@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ScheduleConflictError is returned by JoinGame when the user asked to reject conflicts and is
+// already confirmed for another game that overlaps in time
+type ScheduleConflictError struct {
+	Conflicts []models.PlayerGame
+}
+
+func (e *ScheduleConflictError) Error() string {
+	return fmt.Sprintf("user is already confirmed for %d overlapping game(s)", len(e.Conflicts))
+}
+
+// ScheduleConflicts returns the other games the user is confirmed for that overlap the given game
+// in time, soonest first. Cancelled and completed games don't conflict.
+func (s *GamesService) ScheduleConflicts(ctx context.Context, gameID string, userID string) ([]models.PlayerGame, error) {
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	return s.scheduleConflicts(ctx, gameUUID, userUUID)
+}
+
+func (s *GamesService) scheduleConflicts(ctx context.Context, gameUUID, userUUID pgtype.UUID) ([]models.PlayerGame, error) {
+	rows, err := s.queries.ListUserOverlappingGames(ctx, repository.ListUserOverlappingGamesParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overlapping games: %w", err)
+	}
+
+	conflicts := make([]models.PlayerGame, 0, len(rows))
+	for _, row := range rows {
+		conflicts = append(conflicts, models.PlayerGame{
+			ID:           uuid.UUID(row.ID.Bytes).String(),
+			Title:        pgTextToStringPtr(row.Title),
+			Category:     models.GameCategory(row.Category),
+			LocationName: row.LocationName,
+			StartTime:    row.StartTime.Time.UTC(),
+			Status:       models.GameStatus(row.Status),
+		})
+	}
+	return conflicts, nil
+}
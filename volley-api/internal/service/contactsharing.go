@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrContactSharingExpired      = errors.New("contact sharing ends when the game ends")
+	ErrContactSharingNotRequested = errors.New("the organizer has not requested contact sharing for this game")
+)
+
+// RequestContactSharing asks the confirmed participants of the owner's game to share their phone
+// number for day-of coordination. Each participant has to consent; access ends when the game does.
+func (s *GamesService) RequestContactSharing(ctx context.Context, gameID string, ownerID string) (*models.ContactSharing, error) {
+	gameUUID, game, err := s.contactSharingGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	var ownerUUID pgtype.UUID
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if game.OwnerID != ownerUUID {
+		return nil, ErrNotOwner
+	}
+
+	request, err := s.queries.RequestContactSharing(ctx, repository.RequestContactSharingParams{
+		GameID:      gameUUID,
+		RequestedBy: ownerUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request contact sharing: %w", err)
+	}
+
+	participants, err := s.queries.ListActiveParticipantsByGame(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+	roster := map[pgtype.UUID]models.User{}
+	var confirmed []pgtype.UUID
+	for _, p := range participants {
+		if p.UserID.Valid && p.Status == string(models.ParticipantStatusConfirmed) {
+			roster[p.UserID] = models.User{
+				ID:        uuid.UUID(p.UserID.Bytes).String(),
+				Email:     p.Email,
+				FirstName: p.FirstName,
+				LastName:  p.LastName,
+			}
+			confirmed = append(confirmed, p.UserID)
+		}
+	}
+	unmuted, err := s.notificationRecipients(ctx, gameUUID, NotificationTopicReminders, confirmed)
+	if err != nil {
+		return nil, err
+	}
+	recipients := make([]models.User, 0, len(unmuted))
+	for _, userUUID := range unmuted {
+		recipients = append(recipients, roster[userUUID])
+	}
+	s.publish(ctx, events.ContactSharingRequested{
+		Game:         gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime),
+		Participants: recipients,
+	})
+
+	return s.sharedContacts(ctx, game, request)
+}
+
+// ListSharedContacts returns the phone numbers participants of the owner's game have agreed to share
+func (s *GamesService) ListSharedContacts(ctx context.Context, gameID string, ownerID string) (*models.ContactSharing, error) {
+	gameUUID, game, err := s.contactSharingGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	var ownerUUID pgtype.UUID
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if game.OwnerID != ownerUUID {
+		return nil, ErrNotOwner
+	}
+
+	request, err := s.queries.GetContactShareRequest(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrContactSharingNotRequested
+		}
+		return nil, fmt.Errorf("failed to get contact sharing request: %w", err)
+	}
+	return s.sharedContacts(ctx, game, request)
+}
+
+// ConsentToContactSharing shares the user's verified phone number with the organizer of a game they
+// are confirmed for. Consent only covers that game and consenting again is a no-op.
+func (s *GamesService) ConsentToContactSharing(ctx context.Context, gameID string, userID string) error {
+	gameUUID, _, err := s.contactSharingGame(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	if _, err := s.queries.GetContactShareRequest(ctx, gameUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrContactSharingNotRequested
+		}
+		return fmt.Errorf("failed to get contact sharing request: %w", err)
+	}
+
+	participant, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotParticipant
+		}
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.Status != string(models.ParticipantStatusConfirmed) {
+		return ErrNotParticipant
+	}
+
+	user, err := s.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if !user.PhoneNumber.Valid || !user.PhoneVerifiedAt.Valid {
+		return ErrPhoneNotVerified
+	}
+
+	if err := s.queries.GrantContactShareConsent(ctx, repository.GrantContactShareConsentParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	}); err != nil {
+		return fmt.Errorf("failed to grant contact sharing consent: %w", err)
+	}
+	log.Ctx(ctx).Info().Msg("Contact sharing consent granted")
+	return nil
+}
+
+// RevokeContactSharingConsent stops sharing the user's phone number with the organizer of a game.
+// Revoking without having consented is a no-op.
+func (s *GamesService) RevokeContactSharingConsent(ctx context.Context, gameID string, userID string) error {
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	revoked, err := s.queries.RevokeContactShareConsent(ctx, repository.RevokeContactShareConsentParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke contact sharing consent: %w", err)
+	}
+	if revoked > 0 {
+		log.Ctx(ctx).Info().Msg("Contact sharing consent revoked")
+	}
+	return nil
+}
+
+// ExpireContactSharing deletes the contact sharing requests and consents of games that have ended
+// or were cancelled, so organizers keep no access to participant contacts afterwards
+func (s *GamesService) ExpireContactSharing(ctx context.Context) error {
+	expired, err := s.queries.DeleteExpiredContactShareRequests(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire contact sharing: %w", err)
+	}
+	if expired > 0 {
+		log.Ctx(ctx).Info().Int64("games", expired).Msg("Expired contact sharing")
+	}
+	return nil
+}
+
+// contactSharingGame loads a game whose contacts can still be shared. Until the expiry job runs,
+// this check is what ends access once the game is over.
+func (s *GamesService) contactSharingGame(ctx context.Context, gameID string) (pgtype.UUID, repository.GetGameRow, error) {
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return gameUUID, repository.GetGameRow{}, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gameUUID, game, apperrors.ErrNotFound
+		}
+		return gameUUID, game, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.Status == string(models.GameStatusCancelled) || !time.Now().Before(contactSharingExpiry(game)) {
+		return gameUUID, game, ErrContactSharingExpired
+	}
+	return gameUUID, game, nil
+}
+
+// contactSharingExpiry is when the game ends
+func contactSharingExpiry(game repository.GetGameRow) time.Time {
+	return game.StartTime.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
+}
+
+func (s *GamesService) sharedContacts(ctx context.Context, game repository.GetGameRow, request repository.ContactShareRequest) (*models.ContactSharing, error) {
+	rows, err := s.queries.ListSharedContacts(ctx, game.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared contacts: %w", err)
+	}
+
+	contacts := make([]models.SharedContact, 0, len(rows))
+	for _, row := range rows {
+		contacts = append(contacts, models.SharedContact{
+			UserID:      uuid.UUID(row.UserID.Bytes).String(),
+			FirstName:   row.FirstName,
+			LastName:    row.LastName,
+			PhoneNumber: row.PhoneNumber.String,
+			ConsentedAt: row.ConsentedAt.Time.UTC(),
+		})
+	}
+	return &models.ContactSharing{
+		GameID:      uuid.UUID(game.ID.Bytes).String(),
+		RequestedAt: request.RequestedAt.Time.UTC(),
+		ExpiresAt:   contactSharingExpiry(game).UTC(),
+		Contacts:    contacts,
+	}, nil
+}
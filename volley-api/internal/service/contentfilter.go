@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// ContentFilterMode decides what happens to a game whose text matches the content filter
+type ContentFilterMode string
+
+const (
+	ContentFilterReject ContentFilterMode = "reject" // Refuse the create or update
+	ContentFilterFlag   ContentFilterMode = "flag"   // Save the game and queue it for admin review
+)
+
+// ContentFilter screens user-written game text against a list of blocked terms. Matching is
+// case-insensitive and on whole words, so "class" doesn't match "ass"; a term of several words
+// matches those words in a row, whatever punctuation or spacing separates them.
+type ContentFilter struct {
+	mode  ContentFilterMode
+	terms [][]string
+}
+
+// ContentRejectedError is returned by CreateGame and UpdateGame when the content filter rejects the
+// text of one or more fields
+type ContentRejectedError struct {
+	Fields []string // JSON names of the offending fields, e.g. "title" or "location.notes"
+}
+
+func (e *ContentRejectedError) Error() string {
+	return fmt.Sprintf("objectionable content in %s", strings.Join(e.Fields, ", "))
+}
+
+// NewContentFilter builds a filter from a comma-separated list of blocked terms, e.g. from
+// VOLLEY_CONTENT_FILTER_TERMS. Blank terms are ignored.
+func NewContentFilter(mode ContentFilterMode, rawTerms string) (*ContentFilter, error) {
+	if mode != ContentFilterReject && mode != ContentFilterFlag {
+		return nil, fmt.Errorf("invalid content filter mode %q (must be reject or flag)", mode)
+	}
+	filter := &ContentFilter{mode: mode}
+	for _, term := range strings.Split(rawTerms, ",") {
+		if words := contentWords(term); len(words) > 0 {
+			filter.terms = append(filter.terms, words)
+		}
+	}
+	return filter, nil
+}
+
+// SetContentFilter screens game titles, descriptions and notes on create and update (nil disables it)
+func (s *GamesService) SetContentFilter(filter *ContentFilter) {
+	s.contentFilter = filter
+}
+
+// Matches reports whether text contains a blocked term
+func (f *ContentFilter) Matches(text string) bool {
+	words := contentWords(text)
+	for _, term := range f.terms {
+		for i := 0; i+len(term) <= len(words); i++ {
+			if wordsEqual(words[i:i+len(term)], term) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// contentWords lowercases text and splits it into words of letters and digits
+func contentWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func wordsEqual(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// gameTextField is one user-written game field to screen; a nil value wasn't provided
+type gameTextField struct {
+	name  string
+	value *string
+}
+
+func createGameTextFields(request models.CreateGameRequest) []gameTextField {
+	return []gameTextField{
+		{"title", request.Title},
+		{"description", request.Description},
+		{"notes", request.Notes},
+		{"location.notes", request.Location.Notes},
+		{"tags", gameTagsText(request.Tags)},
+	}
+}
+
+func updateGameTextFields(request models.UpdateGameRequest) []gameTextField {
+	fields := []gameTextField{
+		{"title", request.Title},
+		{"description", request.Description},
+		{"notes", request.Notes},
+	}
+	if request.Location != nil {
+		fields = append(fields, gameTextField{"location.notes", request.Location.Notes})
+	}
+	if request.Tags != nil {
+		fields = append(fields, gameTextField{"tags", gameTagsText(*request.Tags)})
+	}
+	return fields
+}
+
+// screenGameText runs the fields through the content filter. In reject mode a match returns a
+// *ContentRejectedError; in flag mode it returns the names of the matching fields so the caller
+// can flag the game once it is saved. Without a filter nothing matches.
+func (s *GamesService) screenGameText(fields []gameTextField) ([]string, error) {
+	if s.contentFilter == nil {
+		return nil, nil
+	}
+	var matched []string
+	for _, field := range fields {
+		if field.value != nil && s.contentFilter.Matches(*field.value) {
+			matched = append(matched, field.name)
+		}
+	}
+	if len(matched) > 0 && s.contentFilter.mode == ContentFilterReject {
+		return nil, &ContentRejectedError{Fields: matched}
+	}
+	return matched, nil
+}
+
+// flagGameContent files a report against the game from the content filter, putting it in the admin
+// review queue (GET /v1/admin/reports). A game already waiting for review keeps a single report.
+func flagGameContent(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID, fields []string) error {
+	details := "Flagged by the content filter: " + strings.Join(fields, ", ")
+	if err := q.FlagGameContent(ctx, repository.FlagGameContentParams{
+		TargetID: gameUUID,
+		Details:  pgtype.Text{String: details, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to flag game for review: %w", err)
+	}
+	log.Ctx(ctx).Warn().Strs("fields", fields).Msg("Game flagged by content filter")
+	return nil
+}
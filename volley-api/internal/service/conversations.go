@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrNotSharingGame is returned when messaging a user who isn't confirmed or waitlisted in the
+// game, or who doesn't host it
+var ErrNotSharingGame = errors.New("users do not share this game")
+
+// conversationMessagesPageSize caps how many messages one page of a conversation returns
+const conversationMessagesPageSize = 50
+
+// StartConversation opens a conversation between a game's host and one of its confirmed or
+// waitlisted players, so they can talk without exchanging phone numbers. Players message the
+// host; the host names the player in request.UserID. Starting one that already exists returns it.
+// Players the host has blocked can't be messaged and can't message the host.
+func (s *GamesService) StartConversation(ctx context.Context, userID string, request models.StartConversationRequest) (*models.Conversation, error) {
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(request.GameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	playerUUID := userUUID
+	if game.OwnerID == userUUID {
+		if request.UserID == nil {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "user_id",
+				Message:      "user_id is required to message a player",
+			}
+		}
+		if err := playerUUID.Scan(*request.UserID); err != nil {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "user_id",
+				Message:      "invalid user ID format",
+			}
+		}
+		if playerUUID == userUUID {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "user_id",
+				Message:      "hosts cannot message themselves",
+			}
+		}
+	}
+
+	if err := canMessage(ctx, s.queries, gameUUID, game.OwnerID, playerUUID); err != nil {
+		return nil, err
+	}
+
+	conversation, err := s.queries.CreateConversation(ctx, repository.CreateConversationParams{
+		GameID:   gameUUID,
+		HostID:   game.OwnerID,
+		PlayerID: playerUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create conversation: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("conversationId", uuid.UUID(conversation.ID.Bytes).String()).Msg("Conversation started")
+	return s.getConversation(ctx, userUUID, conversation.ID)
+}
+
+// ListConversations returns the user's conversations, latest activity first
+func (s *GamesService) ListConversations(ctx context.Context, userID string) ([]models.Conversation, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	rows, err := s.queries.ListConversations(ctx, repository.ListConversationsParams{UserID: userUUID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", err)
+	}
+	conversations := make([]models.Conversation, 0, len(rows))
+	for _, row := range rows {
+		conversations = append(conversations, convertConversationToModel(row, userUUID))
+	}
+	return conversations, nil
+}
+
+// ListMessages returns a page of the conversation's messages, newest first. Passing the oldest
+// message's time as before returns the page ahead of it. Reading marks the conversation read for
+// the user. Users outside the conversation get apperrors.ErrNotFound.
+func (s *GamesService) ListMessages(ctx context.Context, userID string, conversationID string, before *time.Time) ([]models.ConversationMessage, error) {
+	userUUID, conversation, err := s.memberConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := repository.ListConversationMessagesParams{
+		ConversationID: conversation.ID,
+		MaxResults:     conversationMessagesPageSize,
+	}
+	if before != nil {
+		params.Before = pgtype.Timestamptz{Time: *before, Valid: true}
+	}
+	rows, err := s.queries.ListConversationMessages(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	// The messages are still returned when the read marker can't be moved; they just stay counted
+	// as unread
+	if err := s.queries.MarkConversationRead(ctx, repository.MarkConversationReadParams{
+		UserID: userUUID,
+		ID:     conversation.ID,
+	}); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to mark conversation read")
+	}
+
+	messages := make([]models.ConversationMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, convertConversationMessageToModel(row))
+	}
+	return messages, nil
+}
+
+// SendMessage adds a message to the conversation and publishes it, so the other user is notified.
+// The two users must still share the game: once the player drops out or is blocked by the host,
+// the conversation can be read but gets no new messages.
+func (s *GamesService) SendMessage(ctx context.Context, userID string, conversationID string, request models.SendMessageRequest) (*models.ConversationMessage, error) {
+	logger := log.Ctx(ctx)
+
+	body := strings.TrimSpace(request.Body)
+	if body == "" {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "body",
+			Message:      "body must not be empty",
+		}
+	}
+
+	userUUID, conversation, err := s.memberConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := s.queries.GetGame(ctx, conversation.GameID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if err := canMessage(ctx, s.queries, conversation.GameID, conversation.HostID, conversation.PlayerID); err != nil {
+		return nil, err
+	}
+
+	var message repository.ConversationMessage
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		message, err = q.CreateConversationMessage(ctx, repository.CreateConversationMessageParams{
+			ConversationID: conversation.ID,
+			SenderID:       userUUID,
+			Body:           body,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create message: %w", err)
+		}
+		if err := q.TouchConversation(ctx, repository.TouchConversationParams{
+			SentAt:   message.CreatedAt,
+			SenderID: userUUID,
+			ID:       conversation.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to update conversation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The message is already saved and shown in the conversation, so a recipient who can't be
+	// looked up now only misses the notification
+	recipientUUID := conversation.HostID
+	if userUUID == conversation.HostID {
+		recipientUUID = conversation.PlayerID
+	}
+	sender, err := s.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get message sender")
+	}
+	recipient, recipientErr := s.queries.GetUserByID(ctx, recipientUUID)
+	if recipientErr != nil {
+		logger.Error().Err(recipientErr).Msg("Failed to get message recipient")
+	}
+	if err == nil && recipientErr == nil {
+		s.publish(ctx, events.MessageSent{
+			Game:           gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime),
+			ConversationID: uuid.UUID(conversation.ID.Bytes).String(),
+			Sender:         messageUser(sender),
+			Recipient:      messageUser(recipient),
+			Body:           body,
+		})
+	}
+
+	result := convertConversationMessageToModel(message)
+	return &result, nil
+}
+
+// memberConversation loads the conversation if the user is its host or player, and otherwise
+// returns apperrors.ErrNotFound so conversations can't be discovered by ID
+func (s *GamesService) memberConversation(ctx context.Context, userID string, conversationID string) (pgtype.UUID, repository.Conversation, error) {
+	var userUUID, conversationUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return userUUID, repository.Conversation{}, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := conversationUUID.Scan(conversationID); err != nil {
+		return userUUID, repository.Conversation{}, &InvalidArgumentError{
+			ArgumentName: "conversation_id",
+			Message:      "invalid conversation ID format",
+		}
+	}
+
+	conversation, err := s.queries.GetConversation(ctx, conversationUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return userUUID, repository.Conversation{}, apperrors.ErrNotFound
+		}
+		return userUUID, repository.Conversation{}, fmt.Errorf("failed to get conversation: %w", err)
+	}
+	if conversation.HostID != userUUID && conversation.PlayerID != userUUID {
+		return userUUID, repository.Conversation{}, apperrors.ErrNotFound
+	}
+	return userUUID, conversation, nil
+}
+
+// getConversation returns one of the user's conversations as they see it
+func (s *GamesService) getConversation(ctx context.Context, userUUID pgtype.UUID, conversationUUID pgtype.UUID) (*models.Conversation, error) {
+	rows, err := s.queries.ListConversations(ctx, repository.ListConversationsParams{
+		UserID:         userUUID,
+		ConversationID: conversationUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, apperrors.ErrNotFound
+	}
+	conversation := convertConversationToModel(rows[0], userUUID)
+	return &conversation, nil
+}
+
+// canMessage checks that the player is confirmed or waitlisted in the host's game and that the host
+// hasn't blocked them
+func canMessage(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID, hostUUID pgtype.UUID, playerUUID pgtype.UUID) error {
+	participant, err := q.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+		GameID: gameUUID,
+		UserID: playerUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotSharingGame
+		}
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.Status != string(models.ParticipantStatusConfirmed) && participant.Status != string(models.ParticipantStatusWaitlist) {
+		return ErrNotSharingGame
+	}
+
+	blocked, err := q.IsPlayerBlockedByHost(ctx, repository.IsPlayerBlockedByHostParams{
+		HostID:   hostUUID,
+		PlayerID: playerUUID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check host block list: %w", err)
+	}
+	if blocked {
+		return ErrBlockedByHost
+	}
+	return nil
+}
+
+// messageUser is who a direct message notification is from or for
+func messageUser(user repository.User) models.User {
+	return models.User{
+		ID:        uuid.UUID(user.ID.Bytes).String(),
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}
+}
+
+func convertConversationToModel(row repository.ListConversationsRow, userUUID pgtype.UUID) models.Conversation {
+	var lastSenderID *string
+	if row.LastMessageSenderID.Valid {
+		id := uuid.UUID(row.LastMessageSenderID.Bytes).String()
+		lastSenderID = &id
+	}
+	return models.Conversation{
+		ID:                  uuid.UUID(row.ID.Bytes).String(),
+		GameID:              uuid.UUID(row.GameID.Bytes).String(),
+		GameTitle:           gameDisplayTitle(pgTextToStringPtr(row.GameTitle), row.GameCategory),
+		GameStartTime:       row.GameStartTime.Time.UTC(),
+		IsHost:              row.HostID == userUUID,
+		OtherUserID:         uuid.UUID(row.OtherUserID.Bytes).String(),
+		OtherFirstName:      row.OtherFirstName,
+		OtherLastName:       row.OtherLastName,
+		LastMessage:         pgTextToStringPtr(row.LastMessageBody),
+		LastMessageSenderID: lastSenderID,
+		LastMessageAt:       pgTimestamptzToTimePtr(row.LastMessageAt),
+		UnreadCount:         int(row.UnreadCount),
+		CreatedAt:           row.CreatedAt.Time.UTC(),
+	}
+}
+
+func convertConversationMessageToModel(message repository.ConversationMessage) models.ConversationMessage {
+	return models.ConversationMessage{
+		ID:        uuid.UUID(message.ID.Bytes).String(),
+		SenderID:  uuid.UUID(message.SenderID.Bytes).String(),
+		Body:      message.Body,
+		CreatedAt: message.CreatedAt.Time.UTC(),
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// CorrectParticipation fixes a participant's attendance or payment record in the owner's game. Each
+// changed field is written to the correction audit log with the reason, and attendance changes
+// recompute the player's reliability score. Fields that already hold the requested value are skipped.
+func (s *GamesService) CorrectParticipation(ctx context.Context, gameID string, ownerID string, userID string, request models.CorrectParticipationRequest) ([]models.Correction, error) {
+	var gameUUID, ownerUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	reason := strings.TrimSpace(request.Reason)
+	if reason == "" {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "reason",
+			Message:      "reason is required",
+		}
+	}
+	if request.Attendance == nil && request.Paid == nil && request.PaymentAmountCents == nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "correction",
+			Message:      "at least one of attendance, paid or paymentAmountCents is required",
+		}
+	}
+	if request.Attendance != nil && *request.Attendance != models.AttendanceStatusAttended && *request.Attendance != models.AttendanceStatusNoShow {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "attendance",
+			Message:      "attendance must be attended or no_show",
+		}
+	}
+	if request.PaymentAmountCents != nil && *request.PaymentAmountCents < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "paymentAmountCents",
+			Message:      "paymentAmountCents must be non-negative",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.OwnerID != ownerUUID {
+		return nil, ErrNotOwner
+	}
+
+	participant, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotParticipant
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	// Attendance follows the same rules as marking it the first time
+	var oldAttendance pgtype.Text
+	if request.Attendance != nil {
+		if game.Status == string(models.GameStatusCancelled) || time.Now().Before(game.StartTime.Time) {
+			return nil, ErrAttendanceNotOpen
+		}
+		if participant.Status != string(models.ParticipantStatusConfirmed) {
+			return nil, ErrNotParticipant
+		}
+		attendance, err := s.queries.GetAttendance(ctx, repository.GetAttendanceParams{GameID: gameUUID, UserID: userUUID})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get attendance: %w", err)
+		}
+		if err == nil {
+			oldAttendance = pgtype.Text{String: attendance.Status, Valid: true}
+		}
+	}
+
+	var corrections []repository.ParticipationCorrection
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		audit := func(field models.CorrectionField, oldValue, newValue pgtype.Text) error {
+			correction, err := q.CreateParticipationCorrection(ctx, repository.CreateParticipationCorrectionParams{
+				GameID:      gameUUID,
+				UserID:      userUUID,
+				CorrectedBy: ownerUUID,
+				Field:       string(field),
+				OldValue:    oldValue,
+				NewValue:    newValue,
+				Reason:      reason,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record correction: %w", err)
+			}
+			corrections = append(corrections, correction)
+			return nil
+		}
+
+		if request.Attendance != nil && oldAttendance.String != string(*request.Attendance) {
+			if _, err := q.UpsertAttendance(ctx, repository.UpsertAttendanceParams{
+				GameID:   gameUUID,
+				UserID:   userUUID,
+				Status:   string(*request.Attendance),
+				MarkedBy: ownerUUID,
+			}); err != nil {
+				return fmt.Errorf("failed to correct attendance: %w", err)
+			}
+			if err := audit(models.CorrectionFieldAttendance, oldAttendance, pgtype.Text{String: string(*request.Attendance), Valid: true}); err != nil {
+				return err
+			}
+		}
+
+		paid := participant.Paid
+		if request.Paid != nil {
+			paid = *request.Paid
+		}
+		amount := participant.PaymentAmountCents
+		if request.PaymentAmountCents != nil {
+			amount = pgtype.Int4{Int32: int32(*request.PaymentAmountCents), Valid: true}
+		}
+		if paid == participant.Paid && amount == participant.PaymentAmountCents {
+			return nil
+		}
+		if _, err := q.UpdateParticipantPayment(ctx, repository.UpdateParticipantPaymentParams{
+			ID:                 participant.ID,
+			Paid:               paid,
+			PaymentAmountCents: amount,
+		}); err != nil {
+			return fmt.Errorf("failed to correct payment: %w", err)
+		}
+		if paid != participant.Paid {
+			if err := audit(models.CorrectionFieldPaid, boolText(participant.Paid), boolText(paid)); err != nil {
+				return err
+			}
+		}
+		if amount != participant.PaymentAmountCents {
+			if err := audit(models.CorrectionFieldPaymentAmountCents, int4Text(participant.PaymentAmountCents), int4Text(amount)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger := log.Ctx(ctx)
+	for _, correction := range corrections {
+		if correction.Field == string(models.CorrectionFieldAttendance) && s.stats != nil {
+			// The hourly refresh catches up if this fails, so the committed correction stands
+			if err := s.stats.RefreshReliabilityScore(ctx, userUUID); err != nil {
+				logger.Warn().Err(err).Msg("Failed to refresh reliability score after correction")
+			}
+		}
+	}
+	logger.Info().Int("corrections", len(corrections)).Msg("Participation corrected")
+
+	result := make([]models.Correction, 0, len(corrections))
+	for _, correction := range corrections {
+		result = append(result, convertCorrection(correction))
+	}
+	return result, nil
+}
+
+// ListCorrections returns the correction audit log of the owner's game, newest first
+func (s *GamesService) ListCorrections(ctx context.Context, gameID string, ownerID string) (*models.ListCorrectionsResponse, error) {
+	var gameUUID, ownerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.OwnerID != ownerUUID {
+		return nil, ErrNotOwner
+	}
+
+	rows, err := s.queries.ListParticipationCorrections(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list corrections: %w", err)
+	}
+	corrections := make([]models.Correction, 0, len(rows))
+	for _, row := range rows {
+		corrections = append(corrections, convertCorrection(row))
+	}
+	return &models.ListCorrectionsResponse{Corrections: corrections}, nil
+}
+
+// convertCorrection converts a repository.ParticipationCorrection to a models.Correction
+func convertCorrection(c repository.ParticipationCorrection) models.Correction {
+	correction := models.Correction{
+		ID:          uuid.UUID(c.ID.Bytes).String(),
+		UserID:      uuid.UUID(c.UserID.Bytes).String(),
+		Field:       models.CorrectionField(c.Field),
+		OldValue:    pgTextToStringPtr(c.OldValue),
+		NewValue:    pgTextToStringPtr(c.NewValue),
+		Reason:      c.Reason,
+		CorrectedAt: c.CreatedAt.Time.UTC(),
+	}
+	if c.CorrectedBy.Valid {
+		correctedBy := uuid.UUID(c.CorrectedBy.Bytes).String()
+		correction.CorrectedBy = &correctedBy
+	}
+	return correction
+}
+
+func boolText(b bool) pgtype.Text {
+	return pgtype.Text{String: strconv.FormatBool(b), Valid: true}
+}
+
+// int4Text formats an optional integer for the audit log; NULL stays NULL
+func int4Text(i pgtype.Int4) pgtype.Text {
+	if !i.Valid {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: strconv.Itoa(int(i.Int32)), Valid: true}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// gameCreationWindow is how long a created game counts towards GameCreationLimits.MaxPerHour
+const gameCreationWindow = time.Hour
+
+// GameCreationLimits caps how many games a host can create, to slow down spam. Zero disables a
+// limit. Hosts with the verified_host role are exempt.
+type GameCreationLimits struct {
+	MaxOpenGames int // Games that haven't started and aren't cancelled
+	MaxPerHour   int // Games created in the last hour
+}
+
+// GameCreationLimitError is returned by CreateGame when the host has reached a creation limit.
+// It wraps apperrors.ErrRateLimited.
+type GameCreationLimitError struct {
+	MaxOpenGames int           // Set when the open games limit was reached
+	MaxPerHour   int           // Set when the hourly limit was reached
+	RetryAfter   time.Duration // Until the oldest creation that counts towards the hourly limit expires (zero for the open games limit)
+}
+
+func (e *GameCreationLimitError) Error() string {
+	if e.MaxOpenGames > 0 {
+		return fmt.Sprintf("host already has %d open games", e.MaxOpenGames)
+	}
+	return fmt.Sprintf("host created %d games in the last hour, retry in %s", e.MaxPerHour, e.RetryAfter.Round(time.Second))
+}
+
+func (e *GameCreationLimitError) Unwrap() error {
+	return apperrors.ErrRateLimited
+}
+
+// SetGameCreationLimits caps how many games each host can create
+func (s *GamesService) SetGameCreationLimits(limits GameCreationLimits) {
+	s.creationLimits = limits
+}
+
+// checkGameCreationLimits returns a *GameCreationLimitError if the host has reached a creation limit
+// and isn't a verified host
+func (s *GamesService) checkGameCreationLimits(ctx context.Context, ownerID pgtype.UUID) error {
+	limitErr, err := s.gameCreationLimitReached(ctx, ownerID)
+	if err != nil || limitErr == nil {
+		return err
+	}
+
+	// Only look up the exemption once a limit is hit, so most creations skip the query
+	verified, err := s.queries.UserHasRole(ctx, repository.UserHasRoleParams{
+		UserID: ownerID,
+		Role:   string(models.UserRoleVerifiedHost),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check verified host role: %w", err)
+	}
+	if verified {
+		return nil
+	}
+
+	log.Ctx(ctx).Warn().Err(limitErr).Msg("Game creation limit reached")
+	return limitErr
+}
+
+func (s *GamesService) gameCreationLimitReached(ctx context.Context, ownerID pgtype.UUID) (*GameCreationLimitError, error) {
+	limits := s.creationLimits
+
+	if limits.MaxPerHour > 0 {
+		recent, err := s.queries.ListRecentGameCreationsByOwner(ctx, repository.ListRecentGameCreationsByOwnerParams{
+			OwnerID:   ownerID,
+			CreatedAt: pgtype.Timestamptz{Time: time.Now().Add(-gameCreationWindow), Valid: true},
+			Limit:     int32(limits.MaxPerHour),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list recent games: %w", err)
+		}
+		if len(recent) >= limits.MaxPerHour {
+			return &GameCreationLimitError{
+				MaxPerHour: limits.MaxPerHour,
+				RetryAfter: time.Until(recent[len(recent)-1].Time.Add(gameCreationWindow)),
+			}, nil
+		}
+	}
+
+	if limits.MaxOpenGames > 0 {
+		open, err := s.queries.CountOpenGamesByOwner(ctx, ownerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count open games: %w", err)
+		}
+		if open >= int64(limits.MaxOpenGames) {
+			return &GameCreationLimitError{MaxOpenGames: limits.MaxOpenGames}, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// lowFillWindow is how close to start time a game must be before a low fill warning is raised
+	lowFillWindow = 48 * time.Hour
+	// lowFillRatio is the share of spots below which a game is considered low on players
+	lowFillRatio = 0.5
+)
+
+// OrganizerDashboard collects actionable items across the owner's upcoming games: confirmed players who
+// haven't paid for a paid game, and games starting within lowFillWindow with less than half their spots filled
+func (s *GamesService) OrganizerDashboard(ctx context.Context, ownerID string) (*models.OrganizerDashboard, error) {
+	var ownerUUID pgtype.UUID
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	games, err := s.queries.ListOwnerUpcomingGames(ctx, ownerUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upcoming games: %w", err)
+	}
+
+	dashboard := &models.OrganizerDashboard{
+		UpcomingGames: make([]models.OrganizerGame, 0, len(games)),
+		Actions:       []models.OrganizerAction{},
+	}
+	if len(games) == 0 {
+		return dashboard, nil
+	}
+
+	// Unpaid players only matter for paid games
+	var paidGameIDs []pgtype.UUID
+	for _, g := range games {
+		if g.PricingType != string(models.PricingTypeFree) {
+			paidGameIDs = append(paidGameIDs, g.ID)
+		}
+	}
+	unpaidByGame := map[pgtype.UUID][]models.User{}
+	if len(paidGameIDs) > 0 {
+		participants, err := s.queries.ListParticipantsByGames(ctx, paidGameIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list participants: %w", err)
+		}
+		for _, p := range participants {
+			// Placeholders have no account to remind
+			if p.Paid || p.Status != string(models.ParticipantStatusConfirmed) || !p.UserID.Valid {
+				continue
+			}
+			unpaidByGame[p.GameID] = append(unpaidByGame[p.GameID], models.User{
+				ID:        uuid.UUID(p.UserID.Bytes).String(),
+				FirstName: p.FirstName,
+				LastName:  p.LastName,
+			})
+		}
+	}
+
+	lowFillBefore := time.Now().Add(lowFillWindow)
+	for _, g := range games {
+		game := models.OrganizerGame{
+			ID:              uuid.UUID(g.ID.Bytes).String(),
+			Title:           pgTextToStringPtr(g.Title),
+			Category:        models.GameCategory(g.Category),
+			LocationName:    g.LocationName,
+			StartTime:       g.StartTime.Time.UTC(),
+			MaxParticipants: int(g.MaxParticipants),
+			ConfirmedCount:  int(g.ConfirmedCount),
+			Status:          models.GameStatus(g.Status),
+		}
+		dashboard.UpcomingGames = append(dashboard.UpcomingGames, game)
+
+		if unpaid := unpaidByGame[g.ID]; len(unpaid) > 0 {
+			dashboard.Actions = append(dashboard.Actions, models.OrganizerAction{
+				Type:    models.OrganizerActionUnpaidPlayers,
+				Game:    game,
+				Players: unpaid,
+			})
+		}
+		if g.StartTime.Time.Before(lowFillBefore) && float64(g.ConfirmedCount) < float64(g.MaxParticipants)*lowFillRatio {
+			dashboard.Actions = append(dashboard.Actions, models.OrganizerAction{
+				Type: models.OrganizerActionLowFill,
+				Game: game,
+			})
+		}
+	}
+
+	return dashboard, nil
+}
+
+// recommendedGamesLimit is how many recommended games the player dashboard shows
+const recommendedGamesLimit = 5
+
+// allGameCategories is used to recommend games to players who haven't played anything yet, and to
+// list the user's own games whatever the sport
+var allGameCategories = []string{
+	string(models.GameCategorySoccer),
+	string(models.GameCategoryBasketball),
+	string(models.GameCategoryPickleball),
+	string(models.GameCategoryFlagFootball),
+	string(models.GameCategoryVolleyball),
+	string(models.GameCategoryUltimateFrisbee),
+	string(models.GameCategoryTennis),
+	string(models.GameCategoryOther),
+}
+
+// PlayerDashboardLocation is where to look for recommended games
+type PlayerDashboardLocation struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// PlayerDashboard collects the player's next confirmed game, their waitlist positions, and open games nearby
+// they haven't joined. The upcoming games and the recommendations are loaded concurrently. Recommendations are
+// only included when a location is given.
+func (s *GamesService) PlayerDashboard(ctx context.Context, userID string, near *PlayerDashboardLocation) (*models.PlayerDashboard, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	var (
+		wg                 sync.WaitGroup
+		participations     []repository.ListUserUpcomingParticipationsRow
+		recommended        []models.GameSummary
+		participationsErr  error
+		recommendationsErr error
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		participations, participationsErr = s.queries.ListUserUpcomingParticipations(ctx, userUUID)
+	}()
+
+	if near != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			recommended, recommendationsErr = s.recommendGames(ctx, userID, userUUID, *near)
+		}()
+	}
+
+	wg.Wait()
+	if participationsErr != nil {
+		return nil, fmt.Errorf("failed to list upcoming participations: %w", participationsErr)
+	}
+	if recommendationsErr != nil {
+		return nil, fmt.Errorf("failed to recommend games: %w", recommendationsErr)
+	}
+
+	dashboard := &models.PlayerDashboard{
+		Waitlists:        []models.WaitlistSpot{},
+		RecommendedGames: []models.GameSummary{},
+	}
+	for _, p := range participations {
+		game := models.PlayerGame{
+			ID:           uuid.UUID(p.ID.Bytes).String(),
+			Title:        pgTextToStringPtr(p.Title),
+			Category:     models.GameCategory(p.Category),
+			LocationName: p.LocationName,
+			StartTime:    p.StartTime.Time.UTC(),
+			Status:       models.GameStatus(p.Status),
+		}
+		switch models.ParticipantStatus(p.ParticipantStatus) {
+		case models.ParticipantStatusConfirmed:
+			if dashboard.NextGame == nil {
+				dashboard.NextGame = &game
+			}
+		case models.ParticipantStatusWaitlist:
+			dashboard.Waitlists = append(dashboard.Waitlists, models.WaitlistSpot{
+				Game:     game,
+				Position: int(p.WaitlistPosition),
+			})
+		}
+	}
+	if recommended != nil {
+		dashboard.RecommendedGames = recommended
+	}
+
+	return dashboard, nil
+}
+
+// recommendGames returns open games near the given location that the user hasn't joined yet, in the
+// sports they said they want to play, or else the sports they have played
+func (s *GamesService) recommendGames(ctx context.Context, userID string, userUUID pgtype.UUID, near PlayerDashboardLocation) ([]models.GameSummary, error) {
+	categories, err := s.preferredCategories(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) == 0 {
+		categories, err = s.queries.ListUserPlayedCategories(ctx, userUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list played categories: %w", err)
+		}
+	}
+	if len(categories) == 0 {
+		categories = allGameCategories
+	}
+
+	defaults, err := s.userSearchDefaults(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Over-fetch so games the user is already in, or that fall outside their availability, can be skipped
+	status := string(models.GameStatusOpen)
+	limit := recommendedGamesLimit * 2
+	if defaults.availability != nil {
+		limit = recommendedGamesLimit * 4
+	}
+	page, err := s.ListGames(ctx, ListGamesFilters{
+		Categories: categories,
+		Latitude:   near.Latitude,
+		Longitude:  near.Longitude,
+		Radius:     defaults.radius,
+		Status:     &status,
+		Limit:      limit,
+	}, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	recommended := []models.GameSummary{}
+	for _, g := range page.Games {
+		if g.UserParticipationStatus != nil || !defaults.fits(g.StartTime) {
+			continue
+		}
+		recommended = append(recommended, g)
+		if len(recommended) == recommendedGamesLimit {
+			break
+		}
+	}
+	return recommended, nil
+}
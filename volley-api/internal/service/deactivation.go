@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrAccountDeactivated = errors.New("account has been deactivated")
+	ErrHostsUpcomingGames = errors.New("upcoming hosted games must be cancelled before deactivating")
+)
+
+// Deactivate switches the user's account off until they reactivate it: their profile is hidden,
+// they can't sign in and every existing session ends. Deactivating again keeps the original time.
+func (u *UserService) Deactivate(ctx context.Context, userID string) (time.Time, error) {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return time.Time{}, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	deactivation, err := u.queries.DeactivateUser(ctx, userUUID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to deactivate user")
+		return time.Time{}, fmt.Errorf("failed to deactivate user: %w", err)
+	}
+	if err := u.SignOutEverywhere(ctx, userID); err != nil {
+		return time.Time{}, err
+	}
+
+	logger.Info().Str("userID", userID).Msg("User deactivated")
+	return deactivation.DeactivatedAt.Time.UTC(), nil
+}
+
+// Reactivate checks an email and password like Login and switches the account back on before
+// signing the user in. Reactivating an account that isn't deactivated just signs in.
+func (u *UserService) Reactivate(ctx context.Context, email string, password string, ipAddress string) (*models.User, error) {
+	logger := log.Ctx(ctx)
+
+	dbUser, err := u.checkPassword(ctx, email, password, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	reactivated, err := u.queries.ReactivateUser(ctx, dbUser.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to reactivate user")
+		return nil, fmt.Errorf("failed to reactivate user: %w", err)
+	}
+	if reactivated > 0 {
+		logger.Info().Str("userID", dbUser.ID.String()).Msg("User reactivated")
+	}
+	return convertUserToModel(dbUser), nil
+}
+
+// rejectDeactivated returns ErrAccountDeactivated if the user has deactivated their account
+func (u *UserService) rejectDeactivated(ctx context.Context, userUUID pgtype.UUID) error {
+	deactivated, err := u.queries.IsUserDeactivated(ctx, userUUID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to check deactivation")
+		return fmt.Errorf("failed to check deactivation: %w", err)
+	}
+	if deactivated {
+		return ErrAccountDeactivated
+	}
+	return nil
+}
+
+// HostsUpcomingGames reports whether the user owns a game that hasn't started and isn't cancelled.
+// Those have to be cancelled before the account can be deactivated.
+func (s *GamesService) HostsUpcomingGames(ctx context.Context, userID string) (bool, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return false, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	games, err := s.queries.ListOwnerUpcomingGames(ctx, userUUID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list hosted games: %w", err)
+	}
+	return len(games) > 0, nil
+}
+
+// LeaveUpcomingGames drops the user from every upcoming game they are confirmed or waitlisted in,
+// following the same rules as dropping by hand. Games past their drop deadline are returned as kept.
+func (s *GamesService) LeaveUpcomingGames(ctx context.Context, userID string) (dropped []models.PlayerGame, kept []models.PlayerGame, err error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	participations, err := s.queries.ListUserUpcomingParticipations(ctx, userUUID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list upcoming participations: %w", err)
+	}
+
+	dropped, kept = []models.PlayerGame{}, []models.PlayerGame{}
+	for _, p := range participations {
+		game := models.PlayerGame{
+			ID:           uuid.UUID(p.ID.Bytes).String(),
+			Title:        pgTextToStringPtr(p.Title),
+			Category:     models.GameCategory(p.Category),
+			LocationName: p.LocationName,
+			StartTime:    p.StartTime.Time.UTC(),
+			Status:       models.GameStatus(p.Status),
+		}
+
+		_, err := s.DropParticipantFromGame(ctx, game.ID, userID, nil)
+		switch {
+		case errors.Is(err, ErrTooLate), errors.Is(err, ErrGameFinished):
+			kept = append(kept, game)
+			continue
+		case errors.Is(err, ErrNotParticipant):
+			// Dropped by another request since the list was read
+			continue
+		case err != nil:
+			return nil, nil, fmt.Errorf("failed to drop from game %s: %w", game.ID, err)
+		}
+
+		dropped = append(dropped, game)
+	}
+	return dropped, kept, nil
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrDeadLetterRequeued is returned when re-enqueueing a dead letter that already was
+	ErrDeadLetterRequeued = errors.New("dead letter already requeued")
+	// ErrDeadLetterTargetGone is returned when the game or webhook subscription the work was for
+	// has since been deleted, so there is nothing to run it against
+	ErrDeadLetterTargetGone = errors.New("dead letter's game or webhook subscription no longer exists")
+	// ErrDeadLetterNotRequeueable is returned for a notification whose message wasn't kept
+	ErrDeadLetterNotRequeueable = errors.New("dead letter's message was not kept")
+)
+
+// maxListedDeadLetters caps how many dead letters the admin view returns
+const maxListedDeadLetters = 100
+
+// ListDeadLettersFilters narrows the admin view of dead letters
+type ListDeadLettersFilters struct {
+	Source          *models.DeadLetterSource // Only dead letters from this queue
+	IncludeRequeued bool                     // Also list the ones already re-enqueued
+}
+
+// ListDeadLetters returns the most recent queued work that ran out of retries, newest first
+func (s *GamesService) ListDeadLetters(ctx context.Context, filters ListDeadLettersFilters) ([]models.DeadLetter, error) {
+	params := repository.ListDeadLettersParams{
+		IncludeRequeued: filters.IncludeRequeued,
+		MaxResults:      maxListedDeadLetters,
+	}
+	if filters.Source != nil {
+		params.Source = pgtype.Text{String: string(*filters.Source), Valid: true}
+	}
+
+	rows, err := s.queries.ListDeadLetters(ctx, params)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list dead letters")
+		return nil, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	letters := make([]models.DeadLetter, 0, len(rows))
+	for _, row := range rows {
+		letter, err := convertDeadLetterToModel(row)
+		if err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+	return letters, nil
+}
+
+// RequeueDeadLetter queues the work again as a fresh side effect, webhook delivery or notification
+// with no attempts made, for once the cause of its failures has been fixed. The dead letter is
+// kept, marked requeued, and the admin's action is recorded in the audit trail.
+func (s *GamesService) RequeueDeadLetter(ctx context.Context, adminID string, deadLetterID string) (*models.DeadLetter, error) {
+	var adminUUID, deadLetterUUID pgtype.UUID
+	if err := adminUUID.Scan(adminID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := deadLetterUUID.Scan(deadLetterID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "dead_letter_id",
+			Message:      "invalid dead letter ID format",
+		}
+	}
+
+	var requeued repository.DeadLetter
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		letter, err := q.GetDeadLetterForUpdate(ctx, deadLetterUUID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperrors.ErrNotFound
+			}
+			return fmt.Errorf("failed to get dead letter: %w", err)
+		}
+		if letter.RequeuedAt.Valid {
+			return ErrDeadLetterRequeued
+		}
+
+		if err := enqueueDeadLetter(ctx, q, letter); err != nil {
+			return err
+		}
+
+		requeued, err = q.MarkDeadLetterRequeued(ctx, repository.MarkDeadLetterRequeuedParams{
+			ID:         deadLetterUUID,
+			RequeuedBy: adminUUID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to mark dead letter requeued: %w", err)
+		}
+
+		if err := q.CreateAdminAuditEntry(ctx, repository.CreateAdminAuditEntryParams{
+			ActorID:    adminUUID,
+			Action:     string(models.AdminActionRequeueDeadLetter),
+			TargetType: string(models.AuditTargetDeadLetter),
+			TargetID:   deadLetterUUID,
+		}); err != nil {
+			return fmt.Errorf("failed to record admin action: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("adminId", adminID).
+		Str("source", requeued.Source).
+		Str("kind", requeued.Kind).
+		Msg("Dead letter requeued")
+
+	letter, err := convertDeadLetterToModel(requeued)
+	if err != nil {
+		return nil, err
+	}
+	return &letter, nil
+}
+
+// enqueueDeadLetter adds the dead letter's work back to its queue from the payload it kept
+func enqueueDeadLetter(ctx context.Context, q ifaces.Querier, letter repository.DeadLetter) error {
+	switch models.DeadLetterSource(letter.Source) {
+	case models.DeadLetterSideEffect:
+		var payload struct {
+			GameID string `json:"gameId"`
+		}
+		var gameUUID pgtype.UUID
+		if err := json.Unmarshal(letter.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode dead side effect: %w", err)
+		}
+		if err := gameUUID.Scan(payload.GameID); err != nil {
+			return fmt.Errorf("failed to decode dead side effect: %w", err)
+		}
+		queued, err := q.RequeueSideEffect(ctx, repository.RequeueSideEffectParams{
+			Kind:   letter.Kind,
+			GameID: gameUUID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to requeue side effect: %w", err)
+		}
+		if queued == 0 {
+			return ErrDeadLetterTargetGone
+		}
+		return nil
+
+	case models.DeadLetterWebhookDelivery:
+		var payload struct {
+			SubscriptionID string          `json:"subscriptionId"`
+			Event          json.RawMessage `json:"event"`
+		}
+		var subscriptionUUID pgtype.UUID
+		if err := json.Unmarshal(letter.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode dead webhook delivery: %w", err)
+		}
+		if err := subscriptionUUID.Scan(payload.SubscriptionID); err != nil {
+			return fmt.Errorf("failed to decode dead webhook delivery: %w", err)
+		}
+		queued, err := q.RequeueWebhookDelivery(ctx, repository.RequeueWebhookDeliveryParams{
+			EventType:      letter.Kind,
+			Payload:        payload.Event,
+			SubscriptionID: subscriptionUUID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to requeue webhook delivery: %w", err)
+		}
+		if queued == 0 {
+			return ErrDeadLetterTargetGone
+		}
+		return nil
+
+	case models.DeadLetterNotificationDelivery:
+		var payload struct {
+			Recipient string          `json:"recipient"`
+			Summary   *string         `json:"summary"`
+			Message   json.RawMessage `json:"message"`
+		}
+		if err := json.Unmarshal(letter.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode dead notification: %w", err)
+		}
+		// Account messages aren't kept, and their links and codes would have lapsed anyway
+		if len(payload.Message) == 0 || string(payload.Message) == "null" {
+			return ErrDeadLetterNotRequeueable
+		}
+		if err := q.RequeueNotificationDelivery(ctx, repository.RequeueNotificationDeliveryParams{
+			Channel:   letter.Kind,
+			Recipient: payload.Recipient,
+			Summary:   stringPtrToPgText(payload.Summary),
+			Payload:   payload.Message,
+		}); err != nil {
+			return fmt.Errorf("failed to requeue notification: %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown dead letter source %q", letter.Source)
+}
+
+// PruneDeadLetters deletes dead letters older than 30 days, with the messages they keep
+func (s *GamesService) PruneDeadLetters(ctx context.Context) error {
+	deleted, err := s.queries.DeleteOldDeadLetters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prune dead letters: %w", err)
+	}
+	if deleted > 0 {
+		log.Ctx(ctx).Info().Int64("deleted", deleted).Msg("Pruned old dead letters")
+	}
+	return nil
+}
+
+func convertDeadLetterToModel(letter repository.DeadLetter) (models.DeadLetter, error) {
+	history := []models.DeadLetterAttempt{}
+	if err := json.Unmarshal(letter.AttemptHistory, &history); err != nil {
+		return models.DeadLetter{}, fmt.Errorf("failed to decode dead letter attempt history: %w", err)
+	}
+
+	var requeuedBy *string
+	if letter.RequeuedBy.Valid {
+		id := uuid.UUID(letter.RequeuedBy.Bytes).String()
+		requeuedBy = &id
+	}
+
+	return models.DeadLetter{
+		ID:             uuid.UUID(letter.ID.Bytes).String(),
+		Source:         models.DeadLetterSource(letter.Source),
+		SourceID:       uuid.UUID(letter.SourceID.Bytes).String(),
+		Kind:           letter.Kind,
+		Payload:        json.RawMessage(letter.Payload),
+		Attempts:       int(letter.Attempts),
+		AttemptHistory: history,
+		LastError:      letter.LastError,
+		CreatedAt:      letter.CreatedAt.Time.UTC(),
+		RequeuedAt:     pgTimestamptzToTimePtr(letter.RequeuedAt),
+		RequeuedBy:     requeuedBy,
+	}, nil
+}
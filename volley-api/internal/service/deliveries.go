@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	channelPush  = "push"
+	channelEmail = "email"
+	channelSMS   = "sms"
+)
+
+const (
+	// deliveryBatchSize is how many due deliveries one run of the retry job claims
+	deliveryBatchSize = 50
+	// maxDeliveryAttempts is how many times a message is sent before it is marked failed. With the
+	// side effect backoff the last retry is about 8 minutes after the first attempt.
+	maxDeliveryAttempts = 5
+	// maxListedDeliveries caps how many deliveries the admin view returns
+	maxListedDeliveries = 100
+)
+
+// errMessageNotKept fails deliveries of sensitive messages that can't be resent
+var errMessageNotKept = errors.New("message not kept for retries")
+
+// deliveryPayload is what a queued delivery resends; exactly one field is set
+type deliveryPayload struct {
+	Push  *notifications.PushMessage `json:"push,omitempty"`
+	Email *notifications.Email       `json:"email,omitempty"`
+	SMS   string                     `json:"sms,omitempty"`
+}
+
+// DeliveryTracker records every push, email and text in notification_deliveries before handing it
+// to the provider's sender, which it wraps. Transient email and SMS failures stay queued and are
+// resent by RetryDue. Failed pushes aren't retried, since the Notifier falls back to email.
+// Account messages go through Sensitive instead, so their secrets are never stored.
+type DeliveryTracker struct {
+	queries     ifaces.Querier
+	pushSender  notifications.PushSender
+	emailSender notifications.EmailSender
+	smsSender   notifications.SMSSender
+}
+
+// NewDeliveryTracker wraps the senders; the tracker is then used as the push, email and SMS sender
+func NewDeliveryTracker(queries ifaces.Querier, pushSender notifications.PushSender, emailSender notifications.EmailSender, smsSender notifications.SMSSender) *DeliveryTracker {
+	return &DeliveryTracker{
+		queries:     queries,
+		pushSender:  pushSender,
+		emailSender: emailSender,
+		smsSender:   smsSender,
+	}
+}
+
+func (t *DeliveryTracker) SendPush(ctx context.Context, userID string, message notifications.PushMessage) error {
+	return t.send(ctx, channelPush, userID, message.Title, deliveryPayload{Push: &message}, true)
+}
+
+func (t *DeliveryTracker) SendEmail(ctx context.Context, to string, email notifications.Email) error {
+	return t.send(ctx, channelEmail, to, email.Subject, deliveryPayload{Email: &email}, true)
+}
+
+func (t *DeliveryTracker) SendSMS(ctx context.Context, to string, body string) error {
+	return t.send(ctx, channelSMS, to, "", deliveryPayload{SMS: body}, true)
+}
+
+// SensitiveDeliveries sends account messages, such as sign-in links, one-time codes and email
+// change links, through the tracker without keeping them. Their deliveries are recorded with the
+// summary only and aren't retried, since the user can ask for a new link or code.
+type SensitiveDeliveries struct {
+	tracker *DeliveryTracker
+}
+
+// Sensitive returns the email and SMS sender for messages that carry credentials
+func (t *DeliveryTracker) Sensitive() *SensitiveDeliveries {
+	return &SensitiveDeliveries{tracker: t}
+}
+
+func (s *SensitiveDeliveries) SendEmail(ctx context.Context, to string, email notifications.Email) error {
+	return s.tracker.send(ctx, channelEmail, to, email.Subject, deliveryPayload{Email: &email}, false)
+}
+
+func (s *SensitiveDeliveries) SendSMS(ctx context.Context, to string, body string) error {
+	return s.tracker.send(ctx, channelSMS, to, "", deliveryPayload{SMS: body}, false)
+}
+
+// send records a delivery, makes the first attempt and records how it went. The payload is only
+// stored, and so only retried, when keep is set. Tracking failures are logged and never stop the
+// message itself.
+func (t *DeliveryTracker) send(ctx context.Context, channel string, recipient string, summary string, payload deliveryPayload, keep bool) error {
+	logger := log.Ctx(ctx).With().Str("channel", channel).Logger()
+
+	var encoded []byte
+	if keep {
+		var err error
+		encoded, err = json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to encode notification: %w", err)
+		}
+	}
+	deliveryID, err := t.queries.CreateNotificationDelivery(ctx, repository.CreateNotificationDeliveryParams{
+		Channel:      channel,
+		Recipient:    recipient,
+		Summary:      pgtype.Text{String: summary, Valid: summary != ""},
+		Payload:      encoded,
+		LeaseSeconds: int32(sideEffectLease / time.Second),
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to record notification delivery - sending untracked")
+		return t.deliver(ctx, channel, recipient, payload)
+	}
+
+	sendErr := t.deliver(ctx, channel, recipient, payload)
+	if err := t.recordAttempt(ctx, deliveryID, channel, 1, keep, sendErr); err != nil {
+		logger.Error().Err(err).Str("deliveryId", uuid.UUID(deliveryID.Bytes).String()).Msg("Failed to record notification attempt")
+	}
+	return sendErr
+}
+
+// deliver hands the payload to the wrapped sender for its channel
+func (t *DeliveryTracker) deliver(ctx context.Context, channel string, recipient string, payload deliveryPayload) error {
+	switch {
+	case channel == channelPush && payload.Push != nil:
+		return t.pushSender.SendPush(ctx, recipient, *payload.Push)
+	case channel == channelEmail && payload.Email != nil:
+		return t.emailSender.SendEmail(ctx, recipient, *payload.Email)
+	case channel == channelSMS:
+		return t.smsSender.SendSMS(ctx, recipient, payload.SMS)
+	}
+	return fmt.Errorf("no %s payload to deliver", channel)
+}
+
+// recordAttempt stores the outcome of an attempt: sent, bounced, failed for good, or queued for a
+// retry with backoff when the message was kept. Messages that run out of retries are also copied
+// to the dead letters.
+func (t *DeliveryTracker) recordAttempt(ctx context.Context, deliveryID pgtype.UUID, channel string, attempts int32, kept bool, sendErr error) error {
+	if sendErr == nil {
+		return t.queries.MarkNotificationDeliverySent(ctx, deliveryID)
+	}
+
+	lastError := pgtype.Text{String: sendErr.Error(), Valid: true}
+	switch {
+	case errors.Is(sendErr, notifications.ErrBounced):
+		return t.queries.FailNotificationDelivery(ctx, repository.FailNotificationDeliveryParams{
+			ID:        deliveryID,
+			Status:    string(models.NotificationDeliveryBounced),
+			LastError: lastError,
+		})
+	case channel == channelPush || !kept:
+		return t.queries.FailNotificationDelivery(ctx, repository.FailNotificationDeliveryParams{
+			ID:        deliveryID,
+			Status:    string(models.NotificationDeliveryFailed),
+			LastError: lastError,
+		})
+	case attempts >= maxDeliveryAttempts:
+		return t.queries.DeadLetterNotificationDelivery(ctx, repository.DeadLetterNotificationDeliveryParams{
+			ID:        deliveryID,
+			LastError: lastError,
+		})
+	}
+	return t.queries.RescheduleNotificationDelivery(ctx, repository.RescheduleNotificationDeliveryParams{
+		ID:            deliveryID,
+		LastError:     lastError,
+		NextAttemptAt: pgtype.Timestamptz{Time: time.Now().Add(sideEffectBackoff(attempts)), Valid: true},
+	})
+}
+
+// RetryDue resends queued deliveries whose retry is due, including ones whose first attempt never
+// reported back
+func (t *DeliveryTracker) RetryDue(ctx context.Context) error {
+	deliveries, err := t.queries.ClaimDueNotificationDeliveries(ctx, repository.ClaimDueNotificationDeliveriesParams{
+		LeaseSeconds: int32(sideEffectLease / time.Second),
+		BatchSize:    deliveryBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim notification deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		logger := log.Ctx(ctx).With().
+			Str("deliveryId", uuid.UUID(delivery.ID.Bytes).String()).
+			Str("channel", delivery.Channel).
+			Int32("attempt", delivery.Attempts).
+			Logger()
+
+		// A sensitive message whose first attempt never reported back wasn't kept, so there is
+		// nothing to resend
+		if delivery.Payload == nil {
+			if err := t.queries.FailNotificationDelivery(ctx, repository.FailNotificationDeliveryParams{
+				ID:        delivery.ID,
+				Status:    string(models.NotificationDeliveryFailed),
+				LastError: pgtype.Text{String: errMessageNotKept.Error(), Valid: true},
+			}); err != nil {
+				return fmt.Errorf("failed to record notification attempt: %w", err)
+			}
+			logger.Warn().Msg("Notification wasn't kept for retries - marked failed")
+			continue
+		}
+
+		var payload deliveryPayload
+		sendErr := json.Unmarshal(delivery.Payload, &payload)
+		if sendErr == nil {
+			sendErr = t.deliver(ctx, delivery.Channel, delivery.Recipient, payload)
+		}
+		if err := t.recordAttempt(ctx, delivery.ID, delivery.Channel, delivery.Attempts, true, sendErr); err != nil {
+			return fmt.Errorf("failed to record notification attempt: %w", err)
+		}
+		if sendErr != nil {
+			logger.Warn().Err(sendErr).Msg("Notification retry failed")
+			continue
+		}
+		logger.Info().Msg("Notification retry sent")
+	}
+	return nil
+}
+
+// PruneDeliveries deletes delivery records older than 30 days
+func (t *DeliveryTracker) PruneDeliveries(ctx context.Context) error {
+	deleted, err := t.queries.DeleteOldNotificationDeliveries(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prune notification deliveries: %w", err)
+	}
+	if deleted > 0 {
+		log.Ctx(ctx).Info().Int64("deleted", deleted).Msg("Pruned old notification deliveries")
+	}
+	return nil
+}
+
+// ListNotificationDeliveries returns the most recent pushes, emails and texts sent to the user's
+// account, newest first, for an admin looking into a missing notification
+func (u *UserService) ListNotificationDeliveries(ctx context.Context, userID string) ([]models.NotificationDelivery, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	rows, err := u.queries.ListUserNotificationDeliveries(ctx, repository.ListUserNotificationDeliveriesParams{
+		UserID:     userUUID,
+		MaxResults: maxListedDeliveries,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list notification deliveries")
+		return nil, fmt.Errorf("failed to list notification deliveries: %w", err)
+	}
+
+	deliveries := make([]models.NotificationDelivery, 0, len(rows))
+	for _, row := range rows {
+		delivery := models.NotificationDelivery{
+			ID:        uuid.UUID(row.ID.Bytes).String(),
+			Channel:   row.Channel,
+			Recipient: row.Recipient,
+			Summary:   pgTextToStringPtr(row.Summary),
+			Status:    models.NotificationDeliveryStatus(row.Status),
+			Attempts:  int(row.Attempts),
+			LastError: pgTextToStringPtr(row.LastError),
+			CreatedAt: row.CreatedAt.Time.UTC(),
+			SentAt:    pgTimestamptzToTimePtr(row.SentAt),
+		}
+		if delivery.Status == models.NotificationDeliveryQueued {
+			delivery.NextAttemptAt = pgTimestamptzToTimePtr(row.NextAttemptAt)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/mocks"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryTracker(t *testing.T) {
+	ctx := context.Background()
+	deliveryUUID := createTestUUID(t, "550e8400-e29b-41d4-a716-446655440040")
+	message := notifications.Email{Subject: "Your Volley sign-in link", Text: "Sign in"}
+
+	t.Run("Sent emails are recorded with their subject", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		email := &recordingEmailSender{}
+		tracker := NewDeliveryTracker(mockQuerier, &recordingPushSender{}, email, &recordingSMSSender{})
+
+		mockQuerier.On("CreateNotificationDelivery", ctx, mock.MatchedBy(func(arg repository.CreateNotificationDeliveryParams) bool {
+			return arg.Channel == "email" && arg.Recipient == "player@test.com" && arg.Summary.String == message.Subject
+		})).Return(deliveryUUID, nil)
+		mockQuerier.On("MarkNotificationDeliverySent", ctx, deliveryUUID).Return(nil)
+
+		require.NoError(t, tracker.SendEmail(ctx, "player@test.com", message))
+		assert.Len(t, email.sent["player@test.com"], 1)
+	})
+
+	t.Run("Transient failures are queued for a retry", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		tracker := NewDeliveryTracker(mockQuerier, &recordingPushSender{}, &recordingEmailSender{err: errors.New("connection reset")}, &recordingSMSSender{})
+
+		mockQuerier.On("CreateNotificationDelivery", ctx, mock.Anything).Return(deliveryUUID, nil)
+		mockQuerier.On("RescheduleNotificationDelivery", ctx, mock.MatchedBy(func(arg repository.RescheduleNotificationDeliveryParams) bool {
+			delay := time.Until(arg.NextAttemptAt.Time)
+			return arg.ID == deliveryUUID && arg.LastError.String == "connection reset" && delay > 20*time.Second && delay <= 30*time.Second
+		})).Return(nil)
+
+		assert.Error(t, tracker.SendEmail(ctx, "player@test.com", message))
+	})
+
+	t.Run("Bounces and failed pushes are not retried", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		bounce := fmt.Errorf("%w: unsubscribed recipient", notifications.ErrBounced)
+		tracker := NewDeliveryTracker(mockQuerier, &recordingPushSender{err: notifications.ErrNoPushDevices}, &recordingEmailSender{}, &recordingSMSSender{err: bounce})
+
+		mockQuerier.On("CreateNotificationDelivery", ctx, mock.Anything).Return(deliveryUUID, nil)
+		mockQuerier.On("FailNotificationDelivery", ctx, mock.MatchedBy(func(arg repository.FailNotificationDeliveryParams) bool {
+			return arg.Status == string(models.NotificationDeliveryBounced)
+		})).Return(nil).Once()
+		mockQuerier.On("FailNotificationDelivery", ctx, mock.MatchedBy(func(arg repository.FailNotificationDeliveryParams) bool {
+			return arg.Status == string(models.NotificationDeliveryFailed)
+		})).Return(nil).Once()
+
+		assert.ErrorIs(t, tracker.SendSMS(ctx, "+15551234567", "Your code is 123456"), notifications.ErrBounced)
+		assert.ErrorIs(t, tracker.SendPush(ctx, "550e8400-e29b-41d4-a716-446655440010", notifications.PushMessage{Title: "You're in!"}), notifications.ErrNoPushDevices)
+	})
+
+	t.Run("Account messages are tracked without the message and not retried", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		tracker := NewDeliveryTracker(mockQuerier, &recordingPushSender{}, &recordingEmailSender{err: errors.New("connection reset")}, &recordingSMSSender{})
+
+		mockQuerier.On("CreateNotificationDelivery", ctx, mock.MatchedBy(func(arg repository.CreateNotificationDeliveryParams) bool {
+			return arg.Summary.String == message.Subject && arg.Payload == nil
+		})).Return(deliveryUUID, nil)
+		mockQuerier.On("FailNotificationDelivery", ctx, repository.FailNotificationDeliveryParams{
+			ID:        deliveryUUID,
+			Status:    string(models.NotificationDeliveryFailed),
+			LastError: pgtype.Text{String: "connection reset", Valid: true},
+		}).Return(nil)
+
+		assert.Error(t, tracker.Sensitive().SendEmail(ctx, "player@test.com", message))
+	})
+
+	t.Run("Account messages whose first attempt never reported back are failed", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		sms := &recordingSMSSender{}
+		tracker := NewDeliveryTracker(mockQuerier, &recordingPushSender{}, &recordingEmailSender{}, sms)
+
+		mockQuerier.On("ClaimDueNotificationDeliveries", ctx, mock.Anything).Return([]repository.NotificationDelivery{
+			{ID: deliveryUUID, Channel: "sms", Recipient: "+15551234567", Attempts: 2},
+		}, nil)
+		mockQuerier.On("FailNotificationDelivery", ctx, repository.FailNotificationDeliveryParams{
+			ID:        deliveryUUID,
+			Status:    string(models.NotificationDeliveryFailed),
+			LastError: pgtype.Text{String: errMessageNotKept.Error(), Valid: true},
+		}).Return(nil)
+
+		require.NoError(t, tracker.RetryDue(ctx))
+		assert.Empty(t, sms.sent)
+	})
+
+	t.Run("Queued deliveries are resent until the last attempt", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		sms := &recordingSMSSender{}
+		tracker := NewDeliveryTracker(mockQuerier, &recordingPushSender{}, &recordingEmailSender{err: errors.New("timeout")}, sms)
+
+		emailPayload, err := json.Marshal(deliveryPayload{Email: &message})
+		require.NoError(t, err)
+		smsPayload, err := json.Marshal(deliveryPayload{SMS: "Volley: game cancelled"})
+		require.NoError(t, err)
+		smsUUID := createTestUUID(t, "550e8400-e29b-41d4-a716-446655440041")
+
+		mockQuerier.On("ClaimDueNotificationDeliveries", ctx, repository.ClaimDueNotificationDeliveriesParams{
+			LeaseSeconds: int32(sideEffectLease / time.Second),
+			BatchSize:    deliveryBatchSize,
+		}).Return([]repository.NotificationDelivery{
+			{ID: deliveryUUID, Channel: "email", Recipient: "player@test.com", Payload: emailPayload, Attempts: maxDeliveryAttempts},
+			{ID: smsUUID, Channel: "sms", Recipient: "+15551234567", Payload: smsPayload, Attempts: 2},
+		}, nil)
+		mockQuerier.On("DeadLetterNotificationDelivery", ctx, repository.DeadLetterNotificationDeliveryParams{
+			ID:        deliveryUUID,
+			LastError: pgtype.Text{String: "timeout", Valid: true},
+		}).Return(nil)
+		mockQuerier.On("MarkNotificationDeliverySent", ctx, smsUUID).Return(nil)
+
+		require.NoError(t, tracker.RetryDue(ctx))
+		assert.Equal(t, []string{"Volley: game cancelled"}, sms.sent["+15551234567"])
+	})
+}
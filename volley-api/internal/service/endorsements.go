@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrEndorsementNotOpen = errors.New("players can only be endorsed once the game is completed")
+	ErrCannotEndorseSelf  = errors.New("players cannot endorse themselves")
+	ErrEndorseeNotPlayer  = errors.New("endorsed user did not play in this game")
+)
+
+// endorsableSkillLevels ranks the levels a player can be endorsed at, lowest first ("all" only
+// describes games)
+var endorsableSkillLevels = []models.SkillLevel{
+	models.SkillLevelBeginner,
+	models.SkillLevelIntermediate,
+	models.SkillLevelAdvanced,
+}
+
+// EndorseSkill records endorserID vouching for endorseeID's skill level after they both played the
+// completed game. Endorsing the same player for the same game again replaces the level.
+func (s *GamesService) EndorseSkill(ctx context.Context, gameID string, endorserID string, endorseeID string, level models.SkillLevel) (*models.SkillEndorsement, error) {
+	var gameUUID, endorserUUID, endorseeUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := endorserUUID.Scan(endorserID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "endorser_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := endorseeUUID.Scan(endorseeID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if skillRank(level) < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "skill_level",
+			Message:      "skill level must be beginner, intermediate or advanced",
+		}
+	}
+	if endorserUUID == endorseeUUID {
+		return nil, ErrCannotEndorseSelf
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.Status != string(models.GameStatusCompleted) {
+		return nil, ErrEndorsementNotOpen
+	}
+
+	played, err := s.queries.PlayedCompletedGame(ctx, repository.PlayedCompletedGameParams{GameID: gameUUID, UserID: endorserUUID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check endorser participation: %w", err)
+	}
+	if !played {
+		return nil, ErrNotParticipant
+	}
+	played, err = s.queries.PlayedCompletedGame(ctx, repository.PlayedCompletedGameParams{GameID: gameUUID, UserID: endorseeUUID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check endorsee participation: %w", err)
+	}
+	if !played {
+		return nil, ErrEndorseeNotPlayer
+	}
+
+	endorsement, err := s.queries.UpsertSkillEndorsement(ctx, repository.UpsertSkillEndorsementParams{
+		GameID:     gameUUID,
+		EndorserID: endorserUUID,
+		EndorseeID: endorseeUUID,
+		SkillLevel: string(level),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save endorsement: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("skillLevel", string(level)).Msg("Skill endorsed")
+	return &models.SkillEndorsement{
+		GameID:     uuid.UUID(endorsement.GameID.Bytes).String(),
+		EndorseeID: uuid.UUID(endorsement.EndorseeID.Bytes).String(),
+		SkillLevel: models.SkillLevel(endorsement.SkillLevel),
+		UpdatedAt:  endorsement.UpdatedAt.Time.UTC(),
+	}, nil
+}
+
+// SkillConfidences returns the endorsed skill of each user other players have endorsed, keyed by user ID
+func (s *StatsService) SkillConfidences(ctx context.Context, userUUIDs []pgtype.UUID) (map[string]models.SkillConfidence, error) {
+	confidences := map[string]models.SkillConfidence{}
+	if len(userUUIDs) == 0 {
+		return confidences, nil
+	}
+	rows, err := s.queries.ListSkillEndorsementCounts(ctx, userUUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list skill endorsements: %w", err)
+	}
+
+	counts := map[string][]int{}
+	for _, row := range rows {
+		rank := skillRank(models.SkillLevel(row.SkillLevel))
+		if rank < 0 {
+			continue
+		}
+		userID := uuid.UUID(row.EndorseeID.Bytes).String()
+		if counts[userID] == nil {
+			counts[userID] = make([]int, len(endorsableSkillLevels))
+		}
+		counts[userID][rank] += int(row.Endorsers)
+	}
+	for userID, levels := range counts {
+		confidences[userID] = skillConfidence(levels)
+	}
+	return confidences, nil
+}
+
+// skillConfidence picks the level with the most endorsers from counts ranked like endorsableSkillLevels.
+// Ties go to the lower level, so a split vote never overstates a player.
+func skillConfidence(counts []int) models.SkillConfidence {
+	best, total := 0, 0
+	for rank, count := range counts {
+		total += count
+		if count > counts[best] {
+			best = rank
+		}
+	}
+	return models.SkillConfidence{
+		Level:      endorsableSkillLevels[best],
+		Confidence: int(math.Round(100 * float64(counts[best]) / float64(total))),
+		Endorsers:  total,
+	}
+}
+
+// skillRank returns the index of level in endorsableSkillLevels, or -1 if it can't be endorsed
+func skillRank(level models.SkillLevel) int {
+	for rank, l := range endorsableSkillLevels {
+		if l == level {
+			return rank
+		}
+	}
+	return -1
+}
+
+// attachSkillConfidences sets Skill on the participants other players have endorsed
+func (s *GamesService) attachSkillConfidences(ctx context.Context, participants ...[]models.Participant) error {
+	if s.stats == nil {
+		return nil
+	}
+	confidences, err := s.stats.SkillConfidences(ctx, participantUserUUIDs(participants...))
+	if err != nil {
+		return err
+	}
+	for _, list := range participants {
+		for i := range list {
+			if confidence, ok := confidences[list[i].ID]; ok {
+				list[i].Skill = &confidence
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ExportGamesFilters bounds an admin games export by creation time
+type ExportGamesFilters struct {
+	CreatedFrom *time.Time // Inclusive lower bound (optional)
+	CreatedTo   *time.Time // Exclusive upper bound (optional)
+}
+
+// ExportGames streams every game matching filters to fn in creation order. Rows are read from the
+// database one at a time rather than loaded up front, so memory use is flat however large the
+// export is. Cancelling ctx (e.g. the client disconnecting) stops the query.
+func (s *GamesService) ExportGames(ctx context.Context, filters ExportGamesFilters, fn func(models.GameExport) error) error {
+	if s.pool == nil {
+		return errors.New("exports require a database pool")
+	}
+
+	params := repository.ExportGamesParams{}
+	if filters.CreatedFrom != nil {
+		params.CreatedFrom = pgtype.Timestamptz{Time: *filters.CreatedFrom, Valid: true}
+	}
+	if filters.CreatedTo != nil {
+		params.CreatedTo = pgtype.Timestamptz{Time: *filters.CreatedTo, Valid: true}
+	}
+
+	err := repository.New(s.pool).StreamExportGames(ctx, params, func(row repository.ExportGamesRow) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(convertExportGamesRowToModel(row))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export games: %w", err)
+	}
+	return nil
+}
+
+// convertExportGamesRowToModel converts a repository.ExportGamesRow to a models.GameExport
+func convertExportGamesRowToModel(row repository.ExportGamesRow) models.GameExport {
+	var lat, lng *float64
+	if v, ok := row.Latitude.(float64); ok {
+		lat = &v
+	}
+	if v, ok := row.Longitude.(float64); ok {
+		lng = &v
+	}
+
+	return models.GameExport{
+		ID:                 uuid.UUID(row.ID.Bytes).String(),
+		OwnerID:            uuid.UUID(row.OwnerID.Bytes).String(),
+		Category:           models.GameCategory(row.Category),
+		Title:              pgTextToStringPtr(row.Title),
+		Status:             models.GameStatus(row.Status),
+		LocationName:       row.LocationName,
+		Latitude:           lat,
+		Longitude:          lng,
+		StartTime:          row.StartTime.Time.UTC(),
+		DurationMinutes:    int(row.DurationMinutes),
+		MaxParticipants:    int(row.MaxParticipants),
+		ConfirmedCount:     int(row.ConfirmedCount),
+		WaitlistCount:      int(row.WaitlistCount),
+		PricingType:        models.PricingType(row.PricingType),
+		PricingAmountCents: int(row.PricingAmountCents),
+		PricingCurrency:    row.PricingCurrency,
+		AdultOnly:          row.AdultOnly,
+		CancelledAt:        pgTimestamptzToTimePtr(row.CancelledAt),
+		CreatedAt:          row.CreatedAt.Time.UTC(),
+	}
+}
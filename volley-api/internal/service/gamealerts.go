@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultNewGameAlertsPerDay is how many new-game alerts a user gets in a rolling day unless
+// VOLLEY_MAX_NEW_GAME_ALERTS_PER_DAY says otherwise
+const DefaultNewGameAlertsPerDay = 3
+
+// GameAlerts tells users about new games that match their saved searches. A user hears about a
+// game once, whichever of their searches it matches, and gets at most maxPerDay alerts in a
+// rolling day so a busy area doesn't flood them.
+type GameAlerts struct {
+	queries   ifaces.Querier
+	notifier  *Notifier
+	maxPerDay int
+}
+
+func NewGameAlerts(queries ifaces.Querier, notifier *Notifier) *GameAlerts {
+	return &GameAlerts{
+		queries:   queries,
+		notifier:  notifier,
+		maxPerDay: DefaultNewGameAlertsPerDay,
+	}
+}
+
+// SetMaxPerDay sets how many alerts one user can get in a rolling day
+func (a *GameAlerts) SetMaxPerDay(maxPerDay int) {
+	a.maxPerDay = maxPerDay
+}
+
+// Subscribe alerts users when a game they'd search for is created
+func (a *GameAlerts) Subscribe(bus *events.Bus) {
+	events.Subscribe(bus, func(ctx context.Context, event events.GameCreated) error {
+		return a.SendNewGameAlerts(ctx, event.Game)
+	})
+}
+
+// SendNewGameAlerts tells each user with a matching saved search about the game. Users at their
+// daily cap are skipped, and a user who can't be reached is logged and skipped so the others still
+// hear about it.
+func (a *GameAlerts) SendNewGameAlerts(ctx context.Context, game events.Game) error {
+	logger := log.Ctx(ctx)
+
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(game.ID); err != nil {
+		return fmt.Errorf("invalid game ID: %w", err)
+	}
+	recipients, err := a.queries.ListNewGameAlertRecipients(ctx, gameUUID)
+	if err != nil {
+		return fmt.Errorf("failed to list new game alert recipients: %w", err)
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	sent, capped := 0, 0
+	for _, recipient := range recipients {
+		recipientID := uuid.UUID(recipient.UserID.Bytes).String()
+		// Recording the alert first claims the user's slot for the day, so the cap holds when
+		// several games are created at once
+		recorded, err := a.queries.RecordNewGameAlert(ctx, repository.RecordNewGameAlertParams{
+			UserID:        recipient.UserID,
+			GameID:        gameUUID,
+			SavedSearchID: recipient.SavedSearchID,
+			MaxPerDay:     int32(a.maxPerDay),
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("recipientId", recipientID).Msg("Failed to record new game alert")
+			continue
+		}
+		if recorded == 0 {
+			capped++
+			continue
+		}
+
+		email := notificationGame(game)
+		email.SearchName = recipient.SavedSearchName
+		notification := GameNotification{
+			Push: notifications.PushMessage{
+				Title: "New game posted",
+				Body:  fmt.Sprintf("%s at %s matches your saved search \"%s\".", email.GameTitle, email.LocationName, recipient.SavedSearchName),
+				Link:  a.notifier.gameLink(game.ID),
+			},
+			Email: notifications.EmailNewGameAlert,
+			Game:  email,
+		}
+		user := models.User{
+			ID:        recipientID,
+			Email:     recipient.Email,
+			FirstName: recipient.FirstName,
+		}
+		if err := a.notifier.Notify(ctx, user, notification); err != nil {
+			logger.Error().Err(err).Str("recipientId", recipientID).Msg("Failed to send new game alert")
+			continue
+		}
+		sent++
+	}
+	logger.Info().Int("sent", sent).Int("capped", capped).Int("matchCount", len(recipients)).Msg("New game alerts sent")
+	return nil
+}
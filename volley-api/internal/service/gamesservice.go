@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gabe-dev-svc/volley/ifaces"
 	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/events"
 	"github.com/gabe-dev-svc/volley/internal/models"
 	"github.com/gabe-dev-svc/volley/internal/repository"
 	"github.com/google/uuid"
@@ -50,20 +54,32 @@ var (
 	ErrTooLate            = errors.New("too late to drop from game")
 	ErrGameFinished       = errors.New("game has already finished")
 	ErrNotParticipant     = errors.New("user is not a participant of this game")
-	ErrNotOwner           = errors.New("only the game owner can cancel the game")
+	ErrNotOwner           = errors.New("only the game owner can perform this action")
 	ErrAlreadyCancelled   = errors.New("game is already cancelled")
 	ErrGameAlreadyStarted = errors.New("cannot cancel a game that has already started")
+	ErrGameNotEditable    = errors.New("game can no longer be edited")
+	ErrAgeRestricted      = errors.New("game is restricted to adults")
+	ErrAlreadyParticipant = errors.New("user is already participating in this game")
 )
 
 type GamesService struct {
 	queries ifaces.Querier
 	pool    *pgxpool.Pool
+	journal bool           // Record join/drop requests in participation_journal
+	reads   ifaces.Querier // Nearby read replica for lag-tolerant reads (nil reads from the primary)
+	stats   *StatsService  // Player reliability shown on rosters (nil skips it)
+
+	contentFilter  *ContentFilter     // Screens game text on create and update (nil allows anything)
+	creationLimits GameCreationLimits // Per-host caps on new games (zero values disable them)
+	notifier       *Notifier          // Sends retried cancellation notices (nil only logs them)
+	events         *events.Bus        // Where game events are published (nil publishes none)
 }
 
 func NewGamesService(queries ifaces.Querier, pool *pgxpool.Pool) *GamesService {
 	return &GamesService{
 		queries: queries,
 		pool:    pool,
+		stats:   NewStatsService(queries),
 	}
 }
 
@@ -75,26 +91,55 @@ const (
 	TimeFilterAll      TimeFilter = "all"
 )
 
+// GameRelationship is how the signed-in user takes part in a game, for tabs like "Hosting"
+type GameRelationship string
+
+const (
+	GameRelationshipHosting    GameRelationship = "hosting"    // The user created the game
+	GameRelationshipJoined     GameRelationship = "joined"     // The user has a confirmed spot
+	GameRelationshipWaitlisted GameRelationship = "waitlisted" // The user is on the waitlist
+)
+
+// BoundingBox is a map viewport to search instead of a circle
+type BoundingBox struct {
+	MinLatitude  float64 // Southern edge
+	MinLongitude float64 // Western edge
+	MaxLatitude  float64 // Northern edge
+	MaxLongitude float64 // Eastern edge
+}
+
 type ListGamesFilters struct {
-	Categories []string   // Sport categories (required: soccer, basketball, volleyball, etc.)
-	Latitude   float64    // Latitude coordinate for location-based search (required)
-	Longitude  float64    // Longitude coordinate for location-based search (required)
-	Radius     float64    // Search radius in meters (default: 16093.4 meters = 10 miles)
-	TimeFilter TimeFilter // Filter by time: upcoming, past, all (default: upcoming)
-	Status     *string    // Filter by game status (open, full, closed, etc.)
-	Limit      int        // Number of results to return (default 20, max 100)
-	Offset     int        // Number of results to skip (default 0)
-}
-
-// ListGames retrieves a list of games based on filters
-func (s *GamesService) ListGames(ctx context.Context, filters ListGamesFilters, userID *string) ([]models.GameSummary, error) {
-	// Validate required fields
-	if len(filters.Categories) == 0 {
-		return nil, &InvalidArgumentError{
-			ArgumentName: "categories",
-			Message:      "at least one sport category is required",
+	Categories             []string            // Sport categories (soccer, basketball, volleyball, etc.); defaults to the user's sport preferences
+	Latitude               float64             // Latitude coordinate for location-based search (required)
+	Longitude              float64             // Longitude coordinate for location-based search (required)
+	Radius                 float64             // Search radius in meters (default: 16093.4 meters = 10 miles)
+	Bounds                 *BoundingBox        // Search this viewport instead; Latitude, Longitude and Radius are then ignored
+	TimeFilter             TimeFilter          // Filter by time: upcoming, past, all (default: upcoming)
+	Status                 *string             // Filter by game status (open, full, closed, etc.)
+	SkillLevels            []models.SkillLevel // Only games at these skill levels; empty for any
+	FreeOnly               bool                // Only free games
+	MaxPricePerPersonCents *int                // Only games costing a player at most this much, counting the user in the split of a total
+	DaysOfWeek             []time.Weekday      // Only games starting on these days; empty for any
+	StartsAfter            *time.Duration      // Only games starting at or after this time of day, as an offset from midnight
+	StartsBefore           *time.Duration      // Only games starting before this time of day, as an offset from midnight
+	Timezone               string              // IANA timezone days and times of day are read in (default: the user's timezone, otherwise UTC)
+	Tags                   []string            // Only games with these tags; empty for any
+	MatchAnyTag            bool                // Games with any of the tags rather than all of them
+	Relationship           *GameRelationship   // Only games the user hosts, joined or is waitlisted for; needs a user
+	Limit                  int                 // Number of results to return (default 20, max 100)
+	Offset                 int                 // Number of results to skip (default 0)
+}
+
+// ListGames retrieves a page of games based on filters, with the number of games matching them
+func (s *GamesService) ListGames(ctx context.Context, filters ListGamesFilters, userID *string) (*models.ListGamesResponse, error) {
+	if filters.Bounds != nil {
+		if err := validateBoundingBox(*filters.Bounds); err != nil {
+			return nil, err
 		}
+		filters.Latitude, filters.Longitude, filters.Radius = filters.Bounds.circle()
 	}
+
+	// Validate required fields
 	if filters.Latitude < -90 || filters.Latitude > 90 {
 		return nil, &ErrInvalidLatitude
 	}
@@ -105,6 +150,16 @@ func (s *GamesService) ListGames(ctx context.Context, filters ListGamesFilters,
 		return nil, &ErrInvalidRadius
 	}
 
+	if err := validateTimeOfDayFilters(filters); err != nil {
+		return nil, err
+	}
+	if filters.MaxPricePerPersonCents != nil && *filters.MaxPricePerPersonCents < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "maxPricePerPersonCents",
+			Message:      "maxPricePerPersonCents must not be negative",
+		}
+	}
+
 	// Set defaults
 	if filters.Radius == 0 {
 		filters.Radius = 16093.4 // 10 miles in meters
@@ -144,6 +199,7 @@ func (s *GamesService) ListGames(ctx context.Context, filters ListGamesFilters,
 
 	// Handle optional user ID for participation status
 	var userUUID pgtype.UUID
+	includeAdultOnly := true
 	if userID != nil {
 		if err := userUUID.Scan(*userID); err != nil {
 			return nil, &InvalidArgumentError{
@@ -151,33 +207,273 @@ func (s *GamesService) ListGames(ctx context.Context, filters ListGamesFilters,
 				Message:      "invalid user ID format",
 			}
 		}
+
+		// Adult-only games are hidden from minors
+		user, err := s.queries.GetUserByID(ctx, userUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		includeAdultOnly = !isMinor(user.Birthdate, now)
+
+		// Without categories, search the sports the user said they want to play. The user's own
+		// games are listed whatever the sport.
+		if len(filters.Categories) == 0 && filters.Relationship != nil {
+			filters.Categories = allGameCategories
+		}
+		if len(filters.Categories) == 0 {
+			filters.Categories, err = s.preferredCategories(ctx, userUUID)
+			if err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		userUUID = pgtype.UUID{Valid: false}
 	}
 
-	games, err := s.queries.ListGamesInRadius(ctx, repository.ListGamesInRadiusParams{
-		Longitude:  filters.Longitude,
-		Latitude:   filters.Latitude,
-		Radius:     filters.Radius,
-		StartTime:  pgtype.Timestamptz{Time: startTime, Valid: true},
-		EndTime:    endTime,
-		Status:     statusText,
-		Categories: filters.Categories,
-		UserID:     userUUID,
-		Limit:      int32(filters.Limit),
-		Offset:     int32(filters.Offset),
-	})
+	relationship := pgtype.Text{Valid: false}
+	if filters.Relationship != nil {
+		switch *filters.Relationship {
+		case GameRelationshipHosting, GameRelationshipJoined, GameRelationshipWaitlisted:
+		default:
+			return nil, &InvalidArgumentError{
+				ArgumentName: "relationship",
+				Message:      fmt.Sprintf("invalid relationship %q (must be: hosting, joined, or waitlisted)", *filters.Relationship),
+			}
+		}
+		if userID == nil {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "relationship",
+				Message:      "relationship requires a signed-in user",
+			}
+		}
+		relationship = pgtype.Text{String: string(*filters.Relationship), Valid: true}
+	}
+	if len(filters.Categories) == 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "categories",
+			Message:      "at least one sport category is required",
+		}
+	}
+
+	var skillLevels []string
+	for _, level := range filters.SkillLevels {
+		switch level {
+		case models.SkillLevelBeginner, models.SkillLevelIntermediate, models.SkillLevelAdvanced, models.SkillLevelAll:
+			skillLevels = append(skillLevels, string(level))
+		default:
+			return nil, &InvalidArgumentError{
+				ArgumentName: "skillLevels",
+				Message:      fmt.Sprintf("invalid skill level %q (must be: beginner, intermediate, advanced, or all)", level),
+			}
+		}
+	}
+
+	tags, err := canonicalTags(filters.Tags)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list games: %w", err)
+		return nil, err
+	}
+
+	params := repository.ListGamesInRadiusParams{
+		Longitude:        filters.Longitude,
+		Latitude:         filters.Latitude,
+		Radius:           filters.Radius,
+		StartTime:        pgtype.Timestamptz{Time: startTime, Valid: true},
+		EndTime:          endTime,
+		Status:           statusText,
+		Categories:       filters.Categories,
+		IncludeAdultOnly: includeAdultOnly,
+		SkillLevels:      skillLevels,
+		FreeOnly:         filters.FreeOnly,
+		MatchAllTags:     !filters.MatchAnyTag,
+		Relationship:     relationship,
+		UserID:           userUUID,
+		Limit:            int32(filters.Limit),
+		Offset:           int32(filters.Offset),
+	}
+
+	if len(tags) > 0 {
+		params.Tags = tags
+	}
+	if filters.MaxPricePerPersonCents != nil {
+		params.MaxPricePerPersonCents = pgtype.Int4{Int32: int32(*filters.MaxPricePerPersonCents), Valid: true}
+	}
+	if err := s.setTimeOfDayParams(ctx, &params, filters, userUUID); err != nil {
+		return nil, err
+	}
+	if filters.Bounds != nil {
+		params.MinLatitude = pgtype.Float8{Float64: filters.Bounds.MinLatitude, Valid: true}
+		params.MinLongitude = pgtype.Float8{Float64: filters.Bounds.MinLongitude, Valid: true}
+		params.MaxLatitude = pgtype.Float8{Float64: filters.Bounds.MaxLatitude, Valid: true}
+		params.MaxLongitude = pgtype.Float8{Float64: filters.Bounds.MaxLongitude, Valid: true}
+	}
+
+	var games []repository.ListGamesInRadiusRow
+	if usesUpcomingGames(filters) {
+		// Hot path: upcoming open/full games come from the trigger-maintained upcoming_games table
+		rows, err := s.readQueries().ListUpcomingGamesInRadius(ctx, repository.ListUpcomingGamesInRadiusParams(params))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list games: %w", err)
+		}
+		for _, row := range rows {
+			games = append(games, repository.ListGamesInRadiusRow(row))
+		}
+	} else {
+		var err error
+		games, err = s.readQueries().ListGamesInRadius(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list games: %w", err)
+		}
 	}
 
 	// Convert repository games to model game summaries
-	var allGames []models.GameSummary
+	response := &models.ListGamesResponse{Games: []models.GameSummary{}}
 	for _, game := range games {
-		allGames = append(allGames, convertGameRowToSummary(game))
+		response.Games = append(response.Games, convertGameRowToSummary(game))
+	}
+	// Every row carries the total, so a page past the end has nothing to count with
+	if len(games) > 0 {
+		response.TotalCount = int(games[0].TotalCount)
+		response.HasMore = filters.Offset+len(games) < response.TotalCount
+	}
+
+	return response, nil
+}
+
+// validateBoundingBox checks a viewport's edges. Viewports crossing the antimeridian aren't
+// supported, so the western edge must be west of the eastern one.
+func validateBoundingBox(box BoundingBox) error {
+	for _, latitude := range []float64{box.MinLatitude, box.MaxLatitude} {
+		if latitude < -90 || latitude > 90 {
+			return &ErrInvalidLatitude
+		}
+	}
+	for _, longitude := range []float64{box.MinLongitude, box.MaxLongitude} {
+		if longitude < -180 || longitude > 180 {
+			return &ErrInvalidLongitude
+		}
+	}
+	if box.MinLatitude >= box.MaxLatitude || box.MinLongitude >= box.MaxLongitude {
+		return &InvalidArgumentError{
+			ArgumentName: "bounds",
+			Message:      "bounds must have minLat below maxLat and minLng west of maxLng",
+		}
+	}
+	return nil
+}
+
+// circle returns a center and radius, in meters, whose circle covers the box. The farthest point
+// of a latitude/longitude box from its center is always a corner.
+func (b BoundingBox) circle() (latitude float64, longitude float64, radius float64) {
+	latitude = (b.MinLatitude + b.MaxLatitude) / 2
+	longitude = (b.MinLongitude + b.MaxLongitude) / 2
+	for _, corner := range [][2]float64{
+		{b.MinLatitude, b.MinLongitude},
+		{b.MinLatitude, b.MaxLongitude},
+		{b.MaxLatitude, b.MinLongitude},
+		{b.MaxLatitude, b.MaxLongitude},
+	} {
+		radius = math.Max(radius, greatCircleMeters(latitude, longitude, corner[0], corner[1]))
+	}
+	// PostGIS measures on the spheroid, which can come out slightly longer than the sphere
+	return latitude, longitude, radius*1.01 + 1
+}
+
+// greatCircleMeters is the haversine distance between two points on a spherical Earth
+func greatCircleMeters(lat1 float64, lng1 float64, lat2 float64, lng2 float64) float64 {
+	const earthRadiusMeters = 6371008.8
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// validateTimeOfDayFilters checks the day and time of day filters. A window can't wrap past
+// midnight, so startsAfter must come before startsBefore.
+func validateTimeOfDayFilters(filters ListGamesFilters) error {
+	for _, bound := range []struct {
+		name  string
+		value *time.Duration
+	}{{"startsAfter", filters.StartsAfter}, {"startsBefore", filters.StartsBefore}} {
+		if bound.value != nil && (*bound.value < 0 || *bound.value >= 24*time.Hour) {
+			return &InvalidArgumentError{
+				ArgumentName: bound.name,
+				Message:      fmt.Sprintf("%s must be a time of day between 00:00 and 23:59", bound.name),
+			}
+		}
+	}
+	if filters.StartsAfter != nil && filters.StartsBefore != nil && *filters.StartsAfter >= *filters.StartsBefore {
+		return &InvalidArgumentError{
+			ArgumentName: "startsBefore",
+			Message:      "startsBefore must be later than startsAfter",
+		}
+	}
+	for _, day := range filters.DaysOfWeek {
+		if day < time.Sunday || day > time.Saturday {
+			return &InvalidArgumentError{
+				ArgumentName: "daysOfWeek",
+				Message:      "invalid day of week",
+			}
+		}
+	}
+	if filters.Timezone != "" {
+		if _, err := time.LoadLocation(filters.Timezone); err != nil {
+			return &InvalidArgumentError{
+				ArgumentName: "timezone",
+				Message:      "timezone must be an IANA name such as America/Chicago",
+			}
+		}
+	}
+	return nil
+}
+
+// setTimeOfDayParams fills in the day and time of day filters. Without a timezone they are read in
+// the timezone the signed-in user set during onboarding, or UTC.
+func (s *GamesService) setTimeOfDayParams(ctx context.Context, params *repository.ListGamesInRadiusParams, filters ListGamesFilters, userUUID pgtype.UUID) error {
+	params.TimeZone = "UTC"
+	if len(filters.DaysOfWeek) == 0 && filters.StartsAfter == nil && filters.StartsBefore == nil {
+		return nil
+	}
+
+	switch {
+	case filters.Timezone != "":
+		params.TimeZone = filters.Timezone
+	case userUUID.Valid:
+		settings, err := s.queries.GetUserSettings(ctx, userUUID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to get user settings: %w", err)
+		}
+		if err == nil && settings.Timezone.Valid {
+			params.TimeZone = settings.Timezone.String
+		}
+	}
+
+	for _, day := range filters.DaysOfWeek {
+		isoDay := int32(day)
+		if day == time.Sunday {
+			isoDay = 7
+		}
+		params.DaysOfWeek = append(params.DaysOfWeek, isoDay)
+	}
+	if filters.StartsAfter != nil {
+		params.StartsAfter = pgtype.Time{Microseconds: filters.StartsAfter.Microseconds(), Valid: true}
+	}
+	if filters.StartsBefore != nil {
+		params.StartsBefore = pgtype.Time{Microseconds: filters.StartsBefore.Microseconds(), Valid: true}
 	}
+	return nil
+}
 
-	return allGames, nil
+// usesUpcomingGames reports whether a listing can be served entirely from upcoming_games, which
+// only holds open and full games that have not started yet.
+func usesUpcomingGames(filters ListGamesFilters) bool {
+	if filters.TimeFilter != TimeFilterUpcoming {
+		return false
+	}
+	return filters.Status == nil ||
+		*filters.Status == string(models.GameStatusOpen) ||
+		*filters.Status == string(models.GameStatusFull)
 }
 
 // convertGameRowToSummary converts a repository game row to a models.GameSummary
@@ -209,6 +505,8 @@ func convertGameRowToSummary(g repository.ListGamesInRadiusRow) models.GameSumma
 		DurationMinutes: int(g.DurationMinutes),
 		MaxParticipants: int(g.MaxParticipants),
 		SignupCount:     int(g.SignupCount),
+		ConfirmedCount:  int(g.ConfirmedCount),
+		WaitlistCount:   int(g.WaitlistCount),
 		Pricing: models.Pricing{
 			Type:        models.PricingType(g.PricingType),
 			AmountCents: int(g.PricingAmountCents),
@@ -216,8 +514,11 @@ func convertGameRowToSummary(g repository.ListGamesInRadiusRow) models.GameSumma
 		},
 		SignupDeadline:          g.SignupDeadline.Time,
 		SkillLevel:              models.SkillLevel(g.SkillLevel),
+		AdultOnly:               g.AdultOnly,
 		Status:                  models.GameStatus(g.Status),
 		UserParticipationStatus: userParticipationStatus,
+		DistanceMeters:          math.Round(g.DistanceMeters),
+		Tags:                    g.Tags,
 	}
 }
 
@@ -238,6 +539,62 @@ func (s *GamesService) CreateGame(ctx context.Context, userID string, request mo
 		}
 	}
 
+	createGameRequest, err := buildCreateGameParams(ownerID, request)
+	if err != nil {
+		return nil, err
+	}
+	flagged, err := s.screenGameText(createGameTextFields(request))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGameCreationLimits(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	var game repository.CreateGameRow
+	var positions []repository.GamePosition
+	var tags []string
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		var err error
+		game, err = q.CreateGame(ctx, createGameRequest)
+		if err != nil {
+			return fmt.Errorf("failed to create game: %w", err)
+		}
+		positions, err = createGamePositions(ctx, q, game.ID, request.Positions)
+		if err != nil {
+			return err
+		}
+		tags, err = addGameTags(ctx, q, game.ID, request.Tags)
+		if err != nil {
+			return err
+		}
+		if len(flagged) > 0 {
+			return flagGameContent(ctx, q, game.ID, flagged)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch owner details to include in the response
+	owner, err := s.queries.GetUserByID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game owner: %w", err)
+	}
+
+	created := convertCreateGameRowToModel(game, &owner)
+	created.Positions = convertGamePositions(positions, nil)
+	created.Tags = tags
+	s.publish(ctx, events.GameCreated{
+		Game: gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime),
+	})
+	return created, nil
+}
+
+// buildCreateGameParams validates a create request and applies defaults (signup deadline at start
+// time, skill level "all", status open), returning the parameters for the CreateGame query
+func buildCreateGameParams(ownerID pgtype.UUID, request models.CreateGameRequest) (repository.CreateGameParams, error) {
 	// Set defaults
 	signupDeadline := request.StartTime
 	if request.SignupDeadline != nil {
@@ -251,13 +608,19 @@ func (s *GamesService) CreateGame(ctx context.Context, userID string, request mo
 
 	// Validate location coordinates are provided
 	if request.Location.Latitude == nil || request.Location.Longitude == nil {
-		return nil, &InvalidArgumentError{
+		return repository.CreateGameParams{}, &InvalidArgumentError{
 			ArgumentName: "location",
 			Message:      "location latitude and longitude are required",
 		}
 	}
+	if err := validateGamePositions(request.Positions, request.MaxParticipants); err != nil {
+		return repository.CreateGameParams{}, err
+	}
+	if _, err := normalizeGameTags(request.Tags); err != nil {
+		return repository.CreateGameParams{}, err
+	}
 
-	createGameRequest := repository.CreateGameParams{
+	return repository.CreateGameParams{
 		OwnerID:  ownerID,
 		Category: string(request.Category),
 		Title: pgtype.Text{
@@ -301,26 +664,15 @@ func (s *GamesService) CreateGame(ctx context.Context, userID string, request mo
 			}(),
 			Valid: request.DropDeadline != nil,
 		},
-		SkillLevel: string(skillLevel),
+		LateDropWindowHours: intPtrToPgInt4(request.LateDropWindowHours),
+		SkillLevel:          string(skillLevel),
 		Notes: pgtype.Text{
 			String: stringPtrToString(request.Notes),
 			Valid:  request.Notes != nil,
 		},
-		Status: string(models.GameStatusOpen),
-	}
-
-	game, err := s.queries.CreateGame(ctx, createGameRequest)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create game: %w", err)
-	}
-
-	// Fetch owner details to include in the response
-	owner, err := s.queries.GetUserByID(ctx, ownerID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get game owner: %w", err)
-	}
-
-	return convertCreateGameRowToModel(game, &owner), nil
+		Status:    string(models.GameStatusOpen),
+		AdultOnly: request.AdultOnly,
+	}, nil
 }
 
 // convertCreateGameRowToModel converts a repository.CreateGameRow to a models.Game
@@ -365,12 +717,14 @@ func convertCreateGameRowToModel(game repository.CreateGameRow, owner *repositor
 			AmountCents: int(game.PricingAmountCents),
 			Currency:    game.PricingCurrency,
 		},
-		SignupDeadline: game.SignupDeadline.Time.UTC(),
-		SkillLevel:     models.SkillLevel(game.SkillLevel),
-		Notes:          pgTextToStringPtr(game.Notes),
-		Status:         models.GameStatus(game.Status),
-		CreatedAt:      game.CreatedAt.Time.UTC(),
-		UpdatedAt:      game.UpdatedAt.Time.UTC(),
+		SignupDeadline:      game.SignupDeadline.Time.UTC(),
+		LateDropWindowHours: int(lateDropWindow(game.LateDropWindowHours) / time.Hour),
+		SkillLevel:          models.SkillLevel(game.SkillLevel),
+		AdultOnly:           game.AdultOnly,
+		Notes:               pgTextToStringPtr(game.Notes),
+		Status:              models.GameStatus(game.Status),
+		CreatedAt:           game.CreatedAt.Time.UTC(),
+		UpdatedAt:           game.UpdatedAt.Time.UTC(),
 	}
 }
 
@@ -418,14 +772,16 @@ func convertGetGameRowToModel(game repository.GetGameRow, owner *repository.User
 			AmountCents: int(game.PricingAmountCents),
 			Currency:    game.PricingCurrency,
 		},
-		SignupDeadline: game.SignupDeadline.Time.UTC(),
-		DropDeadline:   pgTimestamptzToTimePtr(game.DropDeadline),
-		SkillLevel:     models.SkillLevel(game.SkillLevel),
-		Notes:          pgTextToStringPtr(game.Notes),
-		Status:         models.GameStatus(game.Status),
-		CancelledAt:    pgTimestamptzToTimePtr(game.CancelledAt),
-		CreatedAt:      game.CreatedAt.Time.UTC(),
-		UpdatedAt:      game.UpdatedAt.Time.UTC(),
+		SignupDeadline:      game.SignupDeadline.Time.UTC(),
+		DropDeadline:        pgTimestamptzToTimePtr(game.DropDeadline),
+		LateDropWindowHours: int(lateDropWindow(game.LateDropWindowHours) / time.Hour),
+		SkillLevel:          models.SkillLevel(game.SkillLevel),
+		AdultOnly:           game.AdultOnly,
+		Notes:               pgTextToStringPtr(game.Notes),
+		Status:              models.GameStatus(game.Status),
+		CancelledAt:         pgTimestamptzToTimePtr(game.CancelledAt),
+		CreatedAt:           game.CreatedAt.Time.UTC(),
+		UpdatedAt:           game.UpdatedAt.Time.UTC(),
 	}
 }
 
@@ -437,6 +793,22 @@ func pgTextToStringPtr(text pgtype.Text) *string {
 	return &text.String
 }
 
+// Helper function to convert *string to pgtype.Text
+func stringPtrToPgText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
+
+// Helper function to convert *int to pgtype.Int4
+func intPtrToPgInt4(i *int) pgtype.Int4 {
+	if i == nil {
+		return pgtype.Int4{Valid: false}
+	}
+	return pgtype.Int4{Int32: int32(*i), Valid: true}
+}
+
 // Helper function to safely dereference string pointers
 func stringPtrToString(s *string) string {
 	if s == nil {
@@ -445,8 +817,9 @@ func stringPtrToString(s *string) string {
 	return *s
 }
 
-// GetGame retrieves a single game by ID with full participant details
-func (s *GamesService) GetGame(ctx context.Context, gameID string) (*models.Game, error) {
+// GetGame retrieves a single game by ID with full participant details.
+// Minors are left out of the participant lists unless the viewer is the organizer or the minor.
+func (s *GamesService) GetGame(ctx context.Context, gameID string, viewerID string) (*models.Game, error) {
 	// Validate game UUID
 	var gameUUID pgtype.UUID
 	if err := gameUUID.Scan(gameID); err != nil {
@@ -471,16 +844,47 @@ func (s *GamesService) GetGame(ctx context.Context, gameID string) (*models.Game
 		return nil, fmt.Errorf("failed to get game owner: %w", err)
 	}
 
-	// Get all participants ordered by joined_at
+	var viewerUUID pgtype.UUID
+	if err := viewerUUID.Scan(viewerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	// Get all participants in line order
 	allParticipants, err := s.queries.ListActiveParticipantsByGame(ctx, gameUUID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list participants: %w", err)
 	}
 
+	positions, err := s.queries.ListGamePositions(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game positions: %w", err)
+	}
+
+	teams, err := s.queries.ListTeamsByGame(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	announcements, err := s.listAnnouncements(ctx, gameUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.queries.ListGameTags(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game tags: %w", err)
+	}
+
 	// Split confirmed participants into roster and waitlist based on their status
 	confirmedParticipants := []models.Participant{}
 	waitlist := []models.Participant{}
 	confirmedCount := 0
+	waitlistCount := 0
+	confirmedByPosition := map[string]int{}
+	now := time.Now()
 
 	for _, p := range allParticipants {
 		status := models.ParticipantStatus(p.Status)
@@ -490,9 +894,26 @@ func (s *GamesService) GetGame(ctx context.Context, gameID string) (*models.Game
 			continue
 		}
 
-		participant := convertParticipantDetailToModel(repository.ToParticipantDetail(p), nil)
+		if status == models.ParticipantStatusConfirmed {
+			confirmedCount++
+			if p.Position.Valid {
+				confirmedByPosition[p.Position.String]++
+			}
+		}
+
+		// Positions count hidden participants so everyone sees their real place in line
+		if status == models.ParticipantStatusWaitlist {
+			waitlistCount++
+		}
+
+		detail := repository.ToParticipantDetail(p)
+		if !participantVisibleTo(detail, gameRow.OwnerID, viewerUUID, now) {
+			continue
+		}
+
+		participant := convertParticipantDetailToModel(detail, nil)
 		if status == models.ParticipantStatusWaitlist {
-			position := len(waitlist) + 1
+			position := waitlistCount
 			participant.WaitlistPosition = &position
 			waitlist = append(waitlist, *participant)
 		}
@@ -500,34 +921,45 @@ func (s *GamesService) GetGame(ctx context.Context, gameID string) (*models.Game
 		if status == models.ParticipantStatusConfirmed {
 			confirmedParticipants = append(confirmedParticipants, *participant)
 		}
+	}
 
-		confirmedCount++
+	// Shares of a total price split among every confirmed player, hidden ones included
+	share := totalShareCents(gameRow.PricingType, gameRow.PricingAmountCents, int64(confirmedCount))
+	attachShares(share, confirmedParticipants)
+
+	// The host sees each player's recent no-shows when deciding whom to promote
+	if viewerUUID == gameRow.OwnerID {
+		if err := s.attachAttendanceSummaries(ctx, confirmedParticipants, waitlist); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.attachReliabilityScores(ctx, confirmedParticipants, waitlist); err != nil {
+		return nil, err
+	}
+	if err := s.attachSkillConfidences(ctx, confirmedParticipants, waitlist); err != nil {
+		return nil, err
 	}
 
 	// Convert to Game model
 	game := convertGetGameRowToModel(gameRow, &owner, confirmedParticipants, waitlist)
+	game.Pricing.ShareCents = share
+	game.Positions = convertGamePositions(positions, confirmedByPosition)
+	game.Teams = teamRosters(teams, confirmedParticipants, waitlist)
+	game.Announcements = announcements
+	game.Tags = tags
 	return game, nil
 }
 
-// UpdateGame updates an existing game
-func (s *GamesService) UpdateGame(ctx context.Context, gameID string, userID string, request interface{}) (interface{}, error) {
-	// TODO: Implement game update logic
-	return nil, nil
-}
-
-// DeleteGame deletes/cancels a game
-func (s *GamesService) DeleteGame(ctx context.Context, gameID string, userID string) error {
-	// TODO: Implement game deletion logic (hard delete)
-	return nil
-}
-
-// CancelGameResult contains the result of a cancel operation
-type CancelGameResult struct {
-	ParticipantsToNotify []models.User // List of participants to notify about cancellation
+// gameChange describes a single material change to a game, recorded in game_changes
+type gameChange struct {
+	field    string
+	oldValue *string
+	newValue *string
 }
 
-// CancelGame cancels a game and returns information about participants to notify
-func (s *GamesService) CancelGame(ctx context.Context, gameID string, userID string) (*CancelGameResult, error) {
+// UpdateGame updates an existing game and records material changes (time, location, price, capacity)
+// in the game's change history within the same transaction
+func (s *GamesService) UpdateGame(ctx context.Context, gameID string, userID string, request models.UpdateGameRequest) (*models.Game, error) {
 	logger := log.Ctx(ctx)
 
 	// Validate UUIDs
@@ -545,82 +977,470 @@ func (s *GamesService) CancelGame(ctx context.Context, gameID string, userID str
 		}
 	}
 
-	// Get game to validate ownership and current status
-	game, err := s.queries.GetGame(ctx, gameUUID)
+	// Coordinates can only be updated together
+	if request.Location != nil && (request.Location.Latitude == nil) != (request.Location.Longitude == nil) {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "location",
+			Message:      "location latitude and longitude must be provided together",
+		}
+	}
+	flagged, err := s.screenGameText(updateGameTextFields(request))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	txQueries := repository.New(tx).WithTx(tx)
+
+	// Lock the game so concurrent edits and roster changes see a consistent row
+	existing, err := txQueries.GetGameForUpdate(ctx, gameUUID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("game not found")
+			return nil, apperrors.ErrNotFound
 		}
 		return nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
-	// Verify user is the game owner
-	if uuid.UUID(game.OwnerID.Bytes).String() != userID {
+	if existing.OwnerID != userUUID {
 		return nil, ErrNotOwner
 	}
 
-	// Check if already cancelled (idempotent)
-	if game.Status == string(models.GameStatusCancelled) {
-		logger.Info().Msg("Game already cancelled (idempotent)")
-		return &CancelGameResult{ParticipantsToNotify: []models.User{}}, nil
+	if existing.Status == string(models.GameStatusCancelled) || existing.Status == string(models.GameStatusCompleted) {
+		return nil, ErrGameNotEditable
 	}
 
-	// Check if game has already completed
-	if game.Status == string(models.GameStatusCompleted) {
-		return nil, fmt.Errorf("cannot cancel completed game")
+	params := repository.UpdateGameParams{
+		ID:          gameUUID,
+		Title:       stringPtrToPgText(request.Title),
+		Description: stringPtrToPgText(request.Description),
+		Notes:       stringPtrToPgText(request.Notes),
 	}
-
-	// Check if game has already started or finished
-	now := time.Now()
-	if now.After(game.StartTime.Time) {
-		// Game has started
-		gameEndTime := game.StartTime.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
-		if now.After(gameEndTime) {
-			return nil, ErrGameFinished
+	if request.Location != nil {
+		params.LocationName = pgtype.Text{String: request.Location.Name, Valid: request.Location.Name != ""}
+		params.LocationAddress = stringPtrToPgText(request.Location.Address)
+		params.LocationNotes = stringPtrToPgText(request.Location.Notes)
+		if request.Location.Latitude != nil && request.Location.Longitude != nil {
+			params.LocationLatitude = pgtype.Float8{Float64: *request.Location.Latitude, Valid: true}
+			params.LocationLongitude = pgtype.Float8{Float64: *request.Location.Longitude, Valid: true}
 		}
-		return nil, ErrGameAlreadyStarted
 	}
-
-	// Get all participants to notify (before cancelling)
-	participants, err := s.queries.ListParticipantsByGame(ctx, gameUUID)
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to get participants for notification")
-		// Don't fail the cancel operation, just log the error
+	if request.StartTime != nil {
+		params.StartTime = pgtype.Timestamptz{Time: *request.StartTime, Valid: true}
 	}
-
-	// Cancel the game
-	_, err = s.queries.CancelGame(ctx, gameUUID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to cancel game: %w", err)
+	if request.DurationMinutes != nil {
+		params.DurationMinutes = pgtype.Int4{Int32: int32(*request.DurationMinutes), Valid: true}
 	}
-
-	logger.Info().Msg("Game cancelled successfully")
-
-	// Prepare notification list
-	result := &CancelGameResult{
-		ParticipantsToNotify: make([]models.User, 0, len(participants)),
+	if request.MaxParticipants != nil {
+		params.MaxParticipants = pgtype.Int4{Int32: int32(*request.MaxParticipants), Valid: true}
+	}
+	if request.Pricing != nil {
+		params.PricingType = pgtype.Text{String: string(request.Pricing.Type), Valid: request.Pricing.Type != ""}
+		params.PricingAmountCents = pgtype.Int4{Int32: int32(request.Pricing.AmountCents), Valid: true}
+		params.PricingCurrency = pgtype.Text{String: request.Pricing.Currency, Valid: request.Pricing.Currency != ""}
+	}
+	if request.SignupDeadline != nil {
+		params.SignupDeadline = pgtype.Timestamptz{Time: *request.SignupDeadline, Valid: true}
+	}
+	if request.SkillLevel != nil {
+		params.SkillLevel = pgtype.Text{String: string(*request.SkillLevel), Valid: true}
+	}
+	if request.LateDropWindowHours != nil {
+		// Only affects later drops; drops already recorded keep their flag
+		params.LateDropWindowHours = intPtrToPgInt4(request.LateDropWindowHours)
+	}
+	if request.Status != nil {
+		params.Status = pgtype.Text{String: string(*request.Status), Valid: true}
+	}
+	if request.AdultOnly != nil {
+		// Only affects new joins; minors already on the roster stay on it
+		params.AdultOnly = pgtype.Bool{Bool: *request.AdultOnly, Valid: true}
+	}
+
+	if _, err := txQueries.UpdateGame(ctx, params); err != nil {
+		return nil, fmt.Errorf("failed to update game: %w", err)
+	}
+	if request.Tags != nil {
+		if err := txQueries.DeleteGameTags(ctx, gameUUID); err != nil {
+			return nil, fmt.Errorf("failed to clear game tags: %w", err)
+		}
+		if _, err := addGameTags(ctx, txQueries, gameUUID, *request.Tags); err != nil {
+			return nil, err
+		}
+	}
+	if len(flagged) > 0 {
+		if err := flagGameContent(ctx, txQueries, gameUUID, flagged); err != nil {
+			return nil, err
+		}
+	}
+
+	// A capacity change can fill or open up the game; an explicit status from the owner wins
+	if request.Status == nil && request.MaxParticipants != nil {
+		if err := syncCapacityStatus(ctx, txQueries, gameUUID, existing.Status, int32(*request.MaxParticipants)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Record material changes so participants can see what changed and when
+	changes := diffGameChanges(existing, request)
+	for _, change := range changes {
+		_, err := txQueries.CreateGameChange(ctx, repository.CreateGameChangeParams{
+			GameID:    gameUUID,
+			ChangedBy: userUUID,
+			Field:     change.field,
+			OldValue:  stringPtrToPgText(change.oldValue),
+			NewValue:  stringPtrToPgText(change.newValue),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to record game change: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.Info().Int("changeCount", len(changes)).Msg("Game updated successfully")
+
+	// Capacity changes can move players between the roster and the waitlist
+	if request.MaxParticipants != nil && int32(*request.MaxParticipants) != existing.MaxParticipants {
+		if _, err := s.reconcileParticipantStatuses(ctx, gameUUID, int32(*request.MaxParticipants)); err != nil {
+			logger.Error().Err(err).Msg("Failed to reconcile participant statuses after capacity change")
+			s.enqueueSideEffect(ctx, SideEffectReconcileRoster, gameUUID, err)
+		}
+	}
+
+	return s.GetGame(ctx, gameID, userID)
+}
+
+// diffGameChanges compares the current game row with an update request and returns the material changes
+func diffGameChanges(existing repository.GetGameForUpdateRow, request models.UpdateGameRequest) []gameChange {
+	var changes []gameChange
+	add := func(field string, oldValue, newValue *string) {
+		if stringPtrToString(oldValue) == stringPtrToString(newValue) {
+			return
+		}
+		changes = append(changes, gameChange{field: field, oldValue: oldValue, newValue: newValue})
+	}
+
+	if request.StartTime != nil {
+		add("startTime", formatTimePtr(&existing.StartTime.Time), formatTimePtr(request.StartTime))
+	}
+	if request.DurationMinutes != nil {
+		add("durationMinutes", formatIntPtr(int(existing.DurationMinutes)), formatIntPtr(*request.DurationMinutes))
+	}
+	if request.MaxParticipants != nil {
+		add("maxParticipants", formatIntPtr(int(existing.MaxParticipants)), formatIntPtr(*request.MaxParticipants))
+	}
+	if request.Location != nil {
+		if request.Location.Name != "" {
+			add("location.name", &existing.LocationName, &request.Location.Name)
+		}
+		if request.Location.Address != nil {
+			add("location.address", pgTextToStringPtr(existing.LocationAddress), request.Location.Address)
+		}
+		if request.Location.Latitude != nil && request.Location.Longitude != nil {
+			var oldCoordinates *string
+			if lat, ok := existing.Latitude.(float64); ok {
+				if lng, ok := existing.Longitude.(float64); ok {
+					oldCoordinates = formatCoordinates(lat, lng)
+				}
+			}
+			add("location.coordinates", oldCoordinates, formatCoordinates(*request.Location.Latitude, *request.Location.Longitude))
+		}
+	}
+	if request.Pricing != nil {
+		if request.Pricing.Type != "" {
+			oldType, newType := existing.PricingType, string(request.Pricing.Type)
+			add("pricing.type", &oldType, &newType)
+		}
+		add("pricing.amountCents", formatIntPtr(int(existing.PricingAmountCents)), formatIntPtr(request.Pricing.AmountCents))
+		if request.Pricing.Currency != "" {
+			add("pricing.currency", &existing.PricingCurrency, &request.Pricing.Currency)
+		}
+	}
+
+	return changes
+}
+
+func formatTimePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+func formatIntPtr(n int) *string {
+	formatted := fmt.Sprintf("%d", n)
+	return &formatted
+}
+
+func formatCoordinates(lat, lng float64) *string {
+	formatted := fmt.Sprintf("%.6f,%.6f", lat, lng)
+	return &formatted
+}
+
+// ListGameChanges returns the change history for a game, visible to the owner and participants
+func (s *GamesService) ListGameChanges(ctx context.Context, gameID string, userID string) ([]models.GameChange, error) {
+	// Validate UUIDs
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	// Only the owner and users with a participant record can see the history
+	if game.OwnerID != userUUID {
+		_, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+			GameID: gameUUID,
+			UserID: userUUID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrNotParticipant
+			}
+			return nil, fmt.Errorf("failed to get participant: %w", err)
+		}
+	}
+
+	rows, err := s.queries.ListGameChangesByGame(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game changes: %w", err)
+	}
+
+	changes := make([]models.GameChange, 0, len(rows))
+	for _, row := range rows {
+		var changedBy *string
+		if row.ChangedBy.Valid {
+			id := uuid.UUID(row.ChangedBy.Bytes).String()
+			changedBy = &id
+		}
+		changes = append(changes, models.GameChange{
+			ID:        uuid.UUID(row.ID.Bytes).String(),
+			Field:     row.Field,
+			OldValue:  pgTextToStringPtr(row.OldValue),
+			NewValue:  pgTextToStringPtr(row.NewValue),
+			ChangedBy: changedBy,
+			ChangedAt: row.CreatedAt.Time.UTC(),
+		})
+	}
+
+	return changes, nil
+}
+
+// GameOwnerID returns the user ID of a game's owner
+func (s *GamesService) GameOwnerID(ctx context.Context, gameID string) (string, error) {
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return "", &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	ownerUUID, err := s.queries.GetGameOwner(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", apperrors.ErrNotFound
+		}
+		return "", fmt.Errorf("failed to get game owner: %w", err)
+	}
+	return uuid.UUID(ownerUUID.Bytes).String(), nil
+}
+
+// DeleteGame deletes/cancels a game
+func (s *GamesService) DeleteGame(ctx context.Context, gameID string, userID string) error {
+	// TODO: Implement game deletion logic (hard delete)
+	return nil
+}
+
+// CancelGameResult contains the result of a cancel operation
+type CancelGameResult struct {
+	ParticipantsToNotify []models.User // List of participants to notify about cancellation
+}
+
+// maxCancellationReasonLength caps the reason a host gives for cancelling, which is sent to every player
+const maxCancellationReasonLength = 500
+
+// CancelGame cancels a game and notifies its confirmed and waitlisted players, passing on the
+// host's reason when one is given
+func (s *GamesService) CancelGame(ctx context.Context, gameID string, userID string, reason *string) (*CancelGameResult, error) {
+	logger := log.Ctx(ctx)
+
+	// Validate UUIDs
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if reason != nil {
+		trimmed := strings.TrimSpace(*reason)
+		if len(trimmed) > maxCancellationReasonLength {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "reason",
+				Message:      fmt.Sprintf("reason must be at most %d characters", maxCancellationReasonLength),
+			}
+		}
+		reason = &trimmed
+		if trimmed == "" {
+			reason = nil
+		}
+	}
+
+	// Get game to validate ownership and current status
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("game not found")
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	// Verify user is the game owner
+	if uuid.UUID(game.OwnerID.Bytes).String() != userID {
+		return nil, ErrNotOwner
+	}
+
+	// Check if already cancelled (idempotent)
+	if game.Status == string(models.GameStatusCancelled) {
+		logger.Info().Msg("Game already cancelled (idempotent)")
+		return &CancelGameResult{ParticipantsToNotify: []models.User{}}, nil
+	}
+
+	// Check the status set by the scheduled transitions first, then fall back to the clock
+	// in case the job has not run yet
+	switch game.Status {
+	case string(models.GameStatusCompleted):
+		return nil, ErrGameFinished
+	case string(models.GameStatusInProgress):
+		return nil, ErrGameAlreadyStarted
+	}
+
+	// Check if game has already started or finished
+	now := time.Now()
+	if now.After(game.StartTime.Time) {
+		// Game has started
+		gameEndTime := game.StartTime.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
+		if now.After(gameEndTime) {
+			return nil, ErrGameFinished
+		}
+		return nil, ErrGameAlreadyStarted
+	}
+
+	// Cancel the game, keeping the reason with it so a retried notification can still include it
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		if _, err := q.CancelGame(ctx, gameUUID); err != nil {
+			return fmt.Errorf("failed to cancel game: %w", err)
+		}
+		if reason != nil {
+			if err := q.SaveGameCancellationReason(ctx, repository.SaveGameCancellationReasonParams{
+				GameID: gameUUID,
+				Reason: *reason,
+			}); err != nil {
+				return fmt.Errorf("failed to save cancellation reason: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Msg("Game cancelled successfully")
+
+	if err := s.refundCancelledGameCredits(ctx, gameUUID); err != nil {
+		s.enqueueSideEffect(ctx, SideEffectRefundCredits, gameUUID, err)
+	}
+
+	return s.publishCancellation(ctx, gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime), reason), nil
+}
+
+// publishCancellation publishes a game's cancellation with the players to tell and returns them.
+// If they can't be listed their notification is queued for retry, since the game is already
+// cancelled.
+func (s *GamesService) publishCancellation(ctx context.Context, game events.Game, reason *string) *CancelGameResult {
+	logger := log.Ctx(ctx)
+
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(game.ID); err != nil {
+		logger.Error().Err(err).Msg("Invalid cancelled game ID")
+		return &CancelGameResult{ParticipantsToNotify: []models.User{}}
+	}
+	participants, err := s.queries.ListParticipantsByGame(ctx, gameUUID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get participants for notification")
+		s.enqueueSideEffect(ctx, SideEffectNotifyCancellation, gameUUID, err)
 	}
 
+	result := &CancelGameResult{ParticipantsToNotify: rosterRecipients(participants)}
+	logger.Info().Int("participantCount", len(result.ParticipantsToNotify)).Msg("Participants to notify about cancellation")
+
+	s.publish(ctx, events.GameCancelled{
+		Game:         game,
+		Reason:       reason,
+		Participants: result.ParticipantsToNotify,
+	})
+	return result
+}
+
+// rosterRecipients picks the players notices to a whole game, such as cancellations and
+// announcements, are sent to: everyone confirmed or waitlisted with an account
+func rosterRecipients(participants []repository.ParticipantDetail) []models.User {
+	recipients := make([]models.User, 0, len(participants))
 	for _, p := range participants {
-		result.ParticipantsToNotify = append(result.ParticipantsToNotify, models.User{
+		// Placeholders have no account to notify
+		if !p.UserID.Valid {
+			continue
+		}
+		if p.Status != string(models.ParticipantStatusConfirmed) && p.Status != string(models.ParticipantStatusWaitlist) {
+			continue
+		}
+		recipients = append(recipients, models.User{
 			ID:        uuid.UUID(p.UserID.Bytes).String(),
 			Email:     p.Email,
 			FirstName: p.FirstName,
 			LastName:  p.LastName,
 		})
 	}
-
-	logger.Info().Int("participantCount", len(result.ParticipantsToNotify)).Msg("Participants to notify about cancellation")
-
-	return result, nil
+	return recipients
 }
 
-// addOrUpdateParticipant adds a new participant or reactivates an inactive one within a transaction
-func (s *GamesService) addOrUpdateParticipant(ctx context.Context, gameUUID, userUUID pgtype.UUID) error {
+// addOrUpdateParticipant adds a new participant or reactivates an inactive one within a transaction,
+// reconciling the roster in the same transaction. It reports whether the user joined, as opposed to
+// already being in the game, and the other participants the reconcile moved into confirmed spots.
+func (s *GamesService) addOrUpdateParticipant(ctx context.Context, gameUUID, userUUID pgtype.UUID, requestedPosition *string) (bool, []pgtype.UUID, error) {
 	// Start a transaction with row-level locking
 	tx, err := s.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return false, nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
@@ -632,100 +1452,284 @@ func (s *GamesService) addOrUpdateParticipant(ctx context.Context, gameUUID, use
 	game, err := txQueries.GetGameForUpdate(ctx, gameUUID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return apperrors.ErrNotFound
+			return false, nil, apperrors.ErrNotFound
 		}
 		if errors.Is(err, context.DeadlineExceeded) {
-			return fmt.Errorf("timed out waiting for game lock - please try again")
+			return false, nil, fmt.Errorf("timed out waiting for game lock - please try again")
 		}
-		return fmt.Errorf("failed to get game: %w", err)
+		return false, nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
 	// Validate game hasn't finished
 	gameEndTime := game.StartTime.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
 	if time.Now().After(gameEndTime) {
-		return fmt.Errorf("cannot join game: game has already finished")
+		return false, nil, fmt.Errorf("cannot join game: game has already finished")
+	}
+
+	if game.AdultOnly {
+		user, err := txQueries.GetUserByID(ctx, userUUID)
+		if err != nil {
+			return false, nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if isMinor(user.Birthdate, time.Now()) {
+			return false, nil, ErrAgeRestricted
+		}
+	}
+
+	blocked, err := txQueries.IsPlayerBlockedByHost(ctx, repository.IsPlayerBlockedByHostParams{
+		HostID:   game.OwnerID,
+		PlayerID: userUUID,
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to check host block list: %w", err)
+	}
+	if blocked {
+		return false, nil, ErrBlockedByHost
+	}
+
+	// Shadow-banned players are let in, but only ever to the waitlist
+	shadowBanned, err := txQueries.IsUserShadowBanned(ctx, userUUID)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to check shadow ban: %w", err)
+	}
+
+	capacities, err := positionCapacities(ctx, txQueries, gameUUID)
+	if err != nil {
+		return false, nil, err
+	}
+	position, err := resolvePosition(requestedPosition, capacities)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// A player with a spot reserved for them goes to the front of the line; everyone else competes
+	// for the spots that aren't held
+	reserved, err := txQueries.HasActiveReservation(ctx, repository.HasActiveReservationParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to check reservation: %w", err)
+	}
+	spots, err := openSpots(ctx, txQueries, gameUUID, game.MaxParticipants)
+	if err != nil {
+		return false, nil, err
 	}
 
 	// Get all participants to determine status
 	existingParticipants, err := txQueries.ListParticipantsByGame(ctx, gameUUID)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve existing participants: %w", err)
+		return false, nil, fmt.Errorf("failed to retrieve existing participants: %w", err)
 	}
 
-	// Find existing participant record and count active participants
+	// Find existing participant record and hand out spots to the ACTIVE participants ahead of the user
 	var existingParticipantRecord *repository.ParticipantDetail
-	activeParticipants := 0
+	allocation := newRosterAllocation(spots, capacities)
 	for _, participant := range existingParticipants {
-		if !InactiveParticipantStates[participant.Status] {
-			activeParticipants++
-		}
 		if participant.UserID == userUUID {
 			existingParticipantRecord = &participant
 			// Don't break - we need to count all active participants
+			continue
+		}
+		if !InactiveParticipantStates[participant.Status] && !onHoldingWaitlist(participant) {
+			allocation.allocate(participant.Position)
 		}
 	}
 
-	// Determine participant status based on count of ACTIVE participants
+	// The user joins at the back of the line, so they are confirmed only if spots are left
 	participantStatus := models.ParticipantStatusConfirmed
-	if activeParticipants >= int(game.MaxParticipants) {
+	if shadowBanned || (!reserved && !allocation.hasRoom(position)) {
 		participantStatus = models.ParticipantStatusWaitlist
 	}
 
+	var joinedID pgtype.UUID
 	if existingParticipantRecord == nil {
 		// Create new participant
-		_, err = txQueries.CreateParticipant(ctx, repository.CreateParticipantParams{
-			GameID: gameUUID,
-			UserID: userUUID,
-			Status: string(participantStatus),
+		participant, err := txQueries.CreateParticipant(ctx, repository.CreateParticipantParams{
+			GameID:   gameUUID,
+			UserID:   userUUID,
+			Status:   string(participantStatus),
+			Position: position,
 		})
 		if err != nil {
-			return fmt.Errorf("failed to create participant: %w", err)
+			return false, nil, fmt.Errorf("failed to create participant: %w", err)
 		}
+		joinedID = participant.ID
 	} else {
-		// Check if participant is in an inactive state
-		if InactiveParticipantStates[existingParticipantRecord.Status] {
-			// Re-joining: reset joined_at to put them at the back of the line
+		// Re-joining from an inactive state or switching positions: reset joined_at to put them at
+		// the back of the line, so a switch can't jump the queue of the new position
+		if InactiveParticipantStates[existingParticipantRecord.Status] || existingParticipantRecord.Position != position {
 			_, err = txQueries.UpdateParticipantStatusResetJoinedAt(ctx, repository.UpdateParticipantStatusResetJoinedAtParams{
-				ID:     existingParticipantRecord.ID,
-				Status: string(participantStatus),
+				ID:       existingParticipantRecord.ID,
+				Status:   string(participantStatus),
+				Position: position,
 			})
 			if err != nil {
-				return fmt.Errorf("failed to update participant for rejoin: %w", err)
+				return false, nil, fmt.Errorf("failed to update participant for rejoin: %w", err)
 			}
+			joinedID = existingParticipantRecord.ID
+		}
+		// else: already active in this position, nothing to do (idempotent)
+	}
+
+	// The reserved spot is theirs: reconciliation bumps whoever took the last open one
+	if reserved && joinedID.Valid {
+		if err := txQueries.MoveParticipantsToFrontOfLine(ctx, repository.MoveParticipantsToFrontOfLineParams{
+			ParticipantIds: []pgtype.UUID{joinedID},
+			GameID:         gameUUID,
+		}); err != nil {
+			return false, nil, fmt.Errorf("failed to move reserved player to the front of the line: %w", err)
+		}
+	}
+
+	// Reconcile before paying, so credit is spent against the spot the user actually ends up with.
+	// A reserved player's join can bump whoever took the last open spot.
+	toConfirm, toWaitlist, err := applyRosterChanges(ctx, txQueries, game)
+	if err != nil {
+		return false, nil, err
+	}
+	promoted := slices.DeleteFunc(slices.Clone(toConfirm), func(id pgtype.UUID) bool { return id == joinedID })
+
+	// Credit pays toward per-person games the player gets a spot in, unless they already paid.
+	// Joins the reconcile confirmed were settled with it.
+	alreadyPaid := existingParticipantRecord != nil && existingParticipantRecord.Paid
+	confirmed := participantStatus == models.ParticipantStatusConfirmed && !slices.Contains(toWaitlist, joinedID)
+	if joinedID.Valid && confirmed && !alreadyPaid && paysWithCredit(game) {
+		if err := spendCreditsOnJoin(ctx, txQueries, gameUUID, userUUID, joinedID, game.PricingAmountCents); err != nil {
+			return false, nil, err
 		}
-		// else: already active, nothing to do (idempotent)
+	}
+
+	if err := syncCapacityStatus(ctx, txQueries, gameUUID, game.Status, game.MaxParticipants); err != nil {
+		return false, nil, err
 	}
 
 	// Commit the transaction
 	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return false, nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return nil
+	joined := existingParticipantRecord == nil || InactiveParticipantStates[existingParticipantRecord.Status]
+	return joined, promoted, nil
 }
 
-// reconcileParticipantStatuses updates participant statuses in batch to match their actual positions
-// Only updates records where the status doesn't match (confirmed->waitlist or waitlist->confirmed)
-func (s *GamesService) reconcileParticipantStatuses(ctx context.Context, gameUUID pgtype.UUID, maxParticipants int32) error {
+// reconcileParticipantStatuses updates participant statuses in batch to match their actual place in line.
+// Spots go to the earliest active sign-ups, up to maxParticipants less the spots held by reservations
+// and each position's capacity.
+// Only updates records where the status doesn't match (confirmed->waitlist or waitlist->confirmed).
+// Once the changes commit, the players moved into confirmed spots are told and returned.
+func (s *GamesService) reconcileParticipantStatuses(ctx context.Context, gameUUID pgtype.UUID, maxParticipants int32) ([]models.User, error) {
 	// First, check if any updates are needed (without locking)
 	participants, err := s.queries.ListParticipantsByGame(ctx, gameUUID)
 	if err != nil {
-		return fmt.Errorf("failed to list participants: %w", err)
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+	capacities, err := positionCapacities(ctx, s.queries, gameUUID)
+	if err != nil {
+		return nil, err
+	}
+	spots, err := openSpots(ctx, s.queries, gameUUID, maxParticipants)
+	if err != nil {
+		return nil, err
+	}
+
+	toConfirm, toWaitlist := rosterChanges(participants, spots, capacities)
+
+	// If no updates needed, return early (no lock acquired)
+	if len(toConfirm) == 0 && len(toWaitlist) == 0 {
+		return nil, nil
+	}
+
+	// Updates needed - lock the game and work them out again, since the roster may have changed
+	// since the check
+	var game repository.GetGameForUpdateRow
+	var confirmed []pgtype.UUID
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		// Lock the game to prevent concurrent modifications during reconciliation
+		var err error
+		game, err = q.GetGameForUpdate(ctx, gameUUID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperrors.ErrNotFound
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				return fmt.Errorf("timed out waiting for game lock during reconciliation")
+			}
+			return fmt.Errorf("failed to lock game for reconciliation: %w", err)
+		}
+
+		confirmed, _, err = applyRosterChanges(ctx, q, game)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The roster is already reconciled, so a failed lookup only costs the players their news
+	promotedInto := gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime)
+	promoted, err := s.announcePromotions(ctx, promotedInto, gameUUID, confirmed)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to get players promoted by reconciliation")
+		return nil, nil
+	}
+	return promoted, nil
+}
+
+// promotedPlayers looks up the players behind participant IDs that reconciliation just confirmed.
+// Placeholders have no account to tell, so they are left out.
+func (s *GamesService) promotedPlayers(ctx context.Context, gameUUID pgtype.UUID, confirmed []pgtype.UUID) ([]models.User, error) {
+	if len(confirmed) == 0 {
+		return nil, nil
+	}
+	participants, err := s.queries.ListParticipantsByGame(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+	var players []models.User
+	for _, p := range participants {
+		if !p.UserID.Valid || !slices.Contains(confirmed, p.ID) {
+			continue
+		}
+		players = append(players, models.User{
+			ID:        uuid.UUID(p.UserID.Bytes).String(),
+			Email:     p.Email,
+			FirstName: p.FirstName,
+			LastName:  p.LastName,
+		})
 	}
+	return players, nil
+}
 
-	// Build lists of IDs that need updating
-	var toConfirm []pgtype.UUID  // Waitlisted participants who should be confirmed
-	var toWaitlist []pgtype.UUID // Confirmed participants who should be waitlisted
+// announcePromotions publishes ParticipantPromoted for the players a roster change just confirmed
+// and returns them. Every reconcile goes through it once its changes commit, either via
+// reconcileParticipantStatuses or, for applyRosterChanges inside a caller's transaction, directly.
+func (s *GamesService) announcePromotions(ctx context.Context, game events.Game, gameUUID pgtype.UUID, confirmed []pgtype.UUID) ([]models.User, error) {
+	promoted, err := s.promotedPlayers(ctx, gameUUID, confirmed)
+	if err != nil {
+		return nil, err
+	}
+	for _, player := range promoted {
+		s.publish(ctx, events.ParticipantPromoted{Game: game, Player: player})
+	}
+	return promoted, nil
+}
 
-	activeCount := 0
+// rosterChanges returns the waitlisted participants who should be confirmed and the confirmed ones
+// who should be waitlisted, judged by their place in line (participants come in waitlist_rank order).
+// Shadow-banned players are never promoted: they stay on the waitlist without holding up the line.
+func rosterChanges(participants []repository.ListParticipantsByGameRow, maxParticipants int32, capacities map[string]int32) (toConfirm, toWaitlist []pgtype.UUID) {
+	allocation := newRosterAllocation(maxParticipants, capacities)
 	for _, p := range participants {
 		// Skip inactive participants
 		if InactiveParticipantStates[p.Status] {
 			continue
 		}
+		if onHoldingWaitlist(p) {
+			continue
+		}
 
-		// Determine what status should be based on position
-		shouldBeConfirmed := activeCount < int(maxParticipants)
+		// Determine what status should be based on place in line
+		shouldBeConfirmed := allocation.allocate(p.Position)
 		isConfirmed := p.Status == string(models.ParticipantStatusConfirmed)
 
 		// Check if status needs updating
@@ -734,62 +1738,63 @@ func (s *GamesService) reconcileParticipantStatuses(ctx context.Context, gameUUI
 		} else if !shouldBeConfirmed && isConfirmed {
 			toWaitlist = append(toWaitlist, p.ID)
 		}
-
-		activeCount++
 	}
+	return toConfirm, toWaitlist
+}
 
-	// If no updates needed, return early (no lock acquired)
-	if len(toConfirm) == 0 && len(toWaitlist) == 0 {
-		return nil
-	}
+// onHoldingWaitlist reports whether p is a shadow-banned player who hasn't got a spot. They stay on
+// the waitlist for good and don't count towards anyone else's place in line.
+func onHoldingWaitlist(p repository.ParticipantDetail) bool {
+	return p.ShadowBanned && p.Status != string(models.ParticipantStatusConfirmed)
+}
 
-	// Updates needed - start transaction with lock
-	tx, err := s.pool.Begin(ctx)
+// applyRosterChanges brings participant statuses in line with their place in line, within the
+// game's open spots, and settles the credit of the players it moves. The caller must hold the game
+// row lock.
+func applyRosterChanges(ctx context.Context, q ifaces.Querier, game repository.GetGameForUpdateRow) (toConfirm, toWaitlist []pgtype.UUID, err error) {
+	gameUUID := game.ID
+	participants, err := q.ListParticipantsByGame(ctx, gameUUID)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, nil, fmt.Errorf("failed to list participants: %w", err)
 	}
-	defer tx.Rollback(ctx)
-
-	txQueries := repository.New(tx).WithTx(tx)
-
-	// Lock the game to prevent concurrent modifications during reconciliation
-	_, err = txQueries.GetGameForUpdate(ctx, gameUUID)
+	capacities, err := positionCapacities(ctx, q, gameUUID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return apperrors.ErrNotFound
-		}
-		if errors.Is(err, context.DeadlineExceeded) {
-			return fmt.Errorf("timed out waiting for game lock during reconciliation")
-		}
-		return fmt.Errorf("failed to lock game for reconciliation: %w", err)
+		return nil, nil, err
+	}
+	spots, err := openSpots(ctx, q, gameUUID, game.MaxParticipants)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Batch update to confirmed (only if there are records to update)
+	toConfirm, toWaitlist = rosterChanges(participants, spots, capacities)
 	if len(toConfirm) > 0 {
-		err = txQueries.BatchUpdateParticipantsToConfirmed(ctx, toConfirm)
-		if err != nil {
-			return fmt.Errorf("failed to batch update participants to confirmed: %w", err)
+		if err := q.BatchUpdateParticipantsToConfirmed(ctx, toConfirm); err != nil {
+			return nil, nil, fmt.Errorf("failed to batch update participants to confirmed: %w", err)
 		}
 	}
-
-	// Batch update to waitlist (only if there are records to update)
 	if len(toWaitlist) > 0 {
-		err = txQueries.BatchUpdateParticipantsToWaitlist(ctx, toWaitlist)
-		if err != nil {
-			return fmt.Errorf("failed to batch update participants to waitlist: %w", err)
+		if err := q.BatchUpdateParticipantsToWaitlist(ctx, toWaitlist); err != nil {
+			return nil, nil, fmt.Errorf("failed to batch update participants to waitlist: %w", err)
 		}
 	}
-
-	// Commit the transaction
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	if err := settleRosterCredits(ctx, q, game, participants, toConfirm, toWaitlist); err != nil {
+		return nil, nil, err
 	}
+	return toConfirm, toWaitlist, nil
+}
 
-	return nil
+// JoinGame adds a user as a participant to a game and returns all participants with computed status.
+// Games with positions require one; joining again with a different position moves the user to the
+// back of that position's line. With RejectConflicts, a user already confirmed for an overlapping
+// game gets a *ScheduleConflictError instead.
+func (s *GamesService) JoinGame(ctx context.Context, gameID string, userID string, request models.JoinGameRequest) ([]models.Participant, error) {
+	requestedAt := time.Now()
+	participants, err := s.joinGame(ctx, gameID, userID, request)
+	s.recordParticipation(ctx, models.JournalActionJoin, gameID, userID, requestedAt, joinOutcome(participants, userID), nil, err)
+	return participants, err
 }
 
-// JoinGame adds a user as a participant to a game and returns all participants with computed status
-func (s *GamesService) JoinGame(ctx context.Context, gameID string, userID string) ([]models.Participant, error) {
+func (s *GamesService) joinGame(ctx context.Context, gameID string, userID string, request models.JoinGameRequest) ([]models.Participant, error) {
 	// Validate game and user UUID
 	var gameUUID, userUUID pgtype.UUID
 	if err := gameUUID.Scan(gameID); err != nil {
@@ -805,20 +1810,32 @@ func (s *GamesService) JoinGame(ctx context.Context, gameID string, userID strin
 		}
 	}
 
-	// Step 1: Add or update the participant (in transaction with row lock)
-	if err := s.addOrUpdateParticipant(ctx, gameUUID, userUUID); err != nil {
+	if request.RejectConflicts {
+		conflicts, err := s.scheduleConflicts(ctx, gameUUID, userUUID)
+		if err != nil {
+			return nil, err
+		}
+		if len(conflicts) > 0 {
+			return nil, &ScheduleConflictError{Conflicts: conflicts}
+		}
+	}
+
+	// Step 1: Add or update the participant and reconcile the roster (in transaction with row lock)
+	joined, promoted, err := s.addOrUpdateParticipant(ctx, gameUUID, userUUID, request.Position)
+	if err != nil {
 		return nil, err
 	}
 
-	// Step 2: Get game info for max participants
+	// Step 2: Get game info for the roster and events
 	game, err := s.queries.GetGame(ctx, gameUUID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
-	// Step 3: Reconcile all participant statuses to ensure they're accurate
-	if err := s.reconcileParticipantStatuses(ctx, gameUUID, game.MaxParticipants); err != nil {
-		return nil, err
+	// Step 3: Tell anyone the join moved into a spot, e.g. by leaving it for another position
+	joinedGame := gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime)
+	if _, err := s.announcePromotions(ctx, joinedGame, gameUUID, promoted); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to get players promoted by join")
 	}
 
 	// Step 4: Get final participant list - statuses are now accurate from reconciliation
@@ -830,6 +1847,9 @@ func (s *GamesService) JoinGame(ctx context.Context, gameID string, userID strin
 	// Convert to models, using the status directly from the database (already reconciled)
 	result := make([]models.Participant, 0, len(participants))
 	waitlistCount := 0
+	var confirmedCount int64
+	var joinedStatus models.ParticipantStatus
+	now := time.Now()
 	for _, p := range participants {
 		// Skip inactive participants
 		if InactiveParticipantStates[p.Status] {
@@ -839,15 +1859,35 @@ func (s *GamesService) JoinGame(ctx context.Context, gameID string, userID strin
 		// Use the status from the database (already correct after reconciliation)
 		status := models.ParticipantStatus(p.Status)
 		var waitlistPosition *int
+		if status == models.ParticipantStatusConfirmed {
+			confirmedCount++
+		}
 
 		// Calculate waitlist position for waitlisted participants
 		if status == models.ParticipantStatusWaitlist {
 			waitlistCount++
-			waitlistPosition = &waitlistCount
+			position := waitlistCount
+			waitlistPosition = &position
+		}
+		if p.UserID == userUUID {
+			joinedStatus = status
+		}
+
+		if !participantVisibleTo(p, game.OwnerID, userUUID, now) {
+			continue
 		}
 
 		result = append(result, *convertParticipantDetailToModel(p, waitlistPosition))
 	}
+	attachShares(totalShareCents(game.PricingType, game.PricingAmountCents, confirmedCount), result)
+
+	if joined {
+		s.publish(ctx, events.ParticipantJoined{
+			Game:   joinedGame,
+			UserID: userID,
+			Status: joinedStatus,
+		})
+	}
 
 	return result, nil
 }
@@ -855,10 +1895,45 @@ func (s *GamesService) JoinGame(ctx context.Context, gameID string, userID strin
 // DropGameResult contains the result of a drop operation
 type DropGameResult struct {
 	PromotedUser *models.User // User promoted from waitlist (nil if no promotion)
+	LateDrop     bool         // The dropped spot was confirmed and inside the late-drop window
+}
+
+// defaultLateDropWindowHours is how close to the start a drop counts as late when the host hasn't set a window
+const defaultLateDropWindowHours = 24
+
+// lateDropWindow returns how close to the start a drop counts as late, falling back to the default
+// when the game doesn't set its own window
+func lateDropWindow(hours pgtype.Int4) time.Duration {
+	if !hours.Valid {
+		return defaultLateDropWindowHours * time.Hour
+	}
+	return time.Duration(hours.Int32) * time.Hour
+}
+
+// isLateDrop reports whether dropping a confirmed spot at now falls inside the game's late-drop window.
+// Waitlisted players never hold a spot, so their drops are never late.
+func isLateDrop(wasConfirmed bool, startTime time.Time, windowHours pgtype.Int4, now time.Time) bool {
+	window := lateDropWindow(windowHours)
+	if !wasConfirmed || window == 0 {
+		return false
+	}
+	return !now.Before(startTime.Add(-window))
+}
+
+// DropParticipantFromGame marks a user as dropped from a game and returns information about any waitlist promotions.
+// The optional reason is stored on the participant record for the host.
+func (s *GamesService) DropParticipantFromGame(ctx context.Context, gameID string, userID string, reason *models.DropReason) (*DropGameResult, error) {
+	requestedAt := time.Now()
+	result, err := s.dropParticipantFromGame(ctx, gameID, userID, reason)
+	var promoted *models.User
+	if result != nil {
+		promoted = result.PromotedUser
+	}
+	s.recordParticipation(ctx, models.JournalActionDrop, gameID, userID, requestedAt, string(models.ParticipantStatusDropped), promoted, err)
+	return result, err
 }
 
-// DropParticipantFromGame marks a user as dropped from a game and returns information about any waitlist promotions
-func (s *GamesService) DropParticipantFromGame(ctx context.Context, gameID string, userID string) (*DropGameResult, error) {
+func (s *GamesService) dropParticipantFromGame(ctx context.Context, gameID string, userID string, reason *models.DropReason) (*DropGameResult, error) {
 	logger := log.Ctx(ctx)
 
 	// Validate game and user UUID
@@ -876,115 +1951,233 @@ func (s *GamesService) DropParticipantFromGame(ctx context.Context, gameID strin
 		}
 	}
 
-	// Get game to validate
-	game, err := s.queries.GetGame(ctx, gameUUID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get game: %w", err)
-	}
+	var game repository.GetGameForUpdateRow
+	var alreadyDropped, wasConfirmed, lateDrop bool
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		// Lock the game so the roster and open/full status change together
+		var err error
+		game, err = q.GetGameForUpdate(ctx, gameUUID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperrors.ErrNotFound
+			}
+			return fmt.Errorf("failed to get game: %w", err)
+		}
 
-	now := time.Now()
+		now := time.Now()
 
-	// Validate game hasn't finished (start time + duration > now)
-	gameEndTime := game.StartTime.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
-	if now.After(gameEndTime) {
-		return nil, ErrGameFinished
-	}
+		// Validate game hasn't finished (start time + duration > now)
+		gameEndTime := game.StartTime.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
+		if now.After(gameEndTime) {
+			return ErrGameFinished
+		}
 
-	// Validate drop deadline if one is set
-	if game.DropDeadline.Valid && now.After(game.DropDeadline.Time) {
-		return nil, ErrTooLate
-	}
+		// Validate drop deadline if one is set
+		if game.DropDeadline.Valid && now.After(game.DropDeadline.Time) {
+			return ErrTooLate
+		}
 
-	// Get the participant record
-	participant, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
-		GameID: gameUUID,
-		UserID: userUUID,
+		// Get the participant record
+		participant, err := q.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+			GameID: gameUUID,
+			UserID: userUUID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrNotParticipant
+			}
+			return fmt.Errorf("failed to get participant: %w", err)
+		}
+
+		// Check if already dropped (idempotent)
+		if participant.Status == string(models.ParticipantStatusDropped) {
+			alreadyDropped = true
+			return nil
+		}
+
+		// Check if user was confirmed (for promotion detection)
+		wasConfirmed = participant.Status == string(models.ParticipantStatusConfirmed)
+		lateDrop = isLateDrop(wasConfirmed, game.StartTime.Time, game.LateDropWindowHours, now)
+
+		// Update participant status to dropped
+		var dropReason pgtype.Text
+		if reason != nil {
+			dropReason = pgtype.Text{String: string(*reason), Valid: true}
+		}
+		_, err = q.MarkParticipantDropped(ctx, repository.MarkParticipantDroppedParams{
+			ID:         participant.ID,
+			DropReason: dropReason,
+			LateDrop:   lateDrop,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update participant status: %w", err)
+		}
+
+		// Credit spent on the game comes back unless the drop is late
+		if !lateDrop {
+			if err := refundParticipantCredits(ctx, q, gameUUID, participant); err != nil {
+				return err
+			}
+		}
+
+		return syncCapacityStatus(ctx, q, gameUUID, game.Status, game.MaxParticipants)
 	})
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrNotParticipant
-		}
-		return nil, fmt.Errorf("failed to get participant: %w", err)
+		return nil, err
 	}
 
-	// Check if already dropped (idempotent)
-	if participant.Status == string(models.ParticipantStatusDropped) {
+	if alreadyDropped {
 		logger.Info().Msg("User already dropped from game (idempotent)")
 		return &DropGameResult{PromotedUser: nil}, nil
 	}
 
-	// Check if user was confirmed (for promotion detection)
-	wasConfirmed := participant.Status == string(models.ParticipantStatusConfirmed)
-
-	// Update participant status to dropped
-	_, err = s.queries.UpdateParticipantStatus(ctx, repository.UpdateParticipantStatusParams{
-		ID:     participant.ID,
-		Status: string(models.ParticipantStatusDropped),
+	logger.Info().Bool("lateDrop", lateDrop).Msg("User dropped from game successfully")
+	droppedFrom := gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime)
+	s.publish(ctx, events.ParticipantDropped{
+		Game:     droppedFrom,
+		UserID:   userID,
+		LateDrop: lateDrop,
 	})
+
+	result := &DropGameResult{PromotedUser: nil, LateDrop: lateDrop}
+
+	// Reconcile participant statuses to promote from waitlist if needed
+	// This only does work if a confirmed participant dropped and there's a waitlist
+	if !wasConfirmed {
+		return result, nil
+	}
+	// Only the players reconciliation actually confirmed were promoted. Held reservations and full
+	// positions can leave the next player in line on the waitlist.
+	promoted, err := s.reconcileParticipantStatuses(ctx, gameUUID, game.MaxParticipants)
+	if err != nil {
+		// Don't fail the drop operation; the promotion is retried in the background
+		logger.Error().Err(err).Msg("Failed to reconcile participant statuses after drop")
+		s.enqueueSideEffect(ctx, SideEffectReconcileRoster, gameUUID, err)
+		return result, nil
+	}
+	for _, player := range promoted {
+		logger.Info().
+			Str("promotedUserId", player.ID).
+			Str("promotedUserEmail", player.Email).
+			Msg("User promoted from waitlist")
+	}
+	if len(promoted) > 0 {
+		result.PromotedUser = &promoted[0]
+	}
+
+	return result, nil
+}
+
+// CloseExpiredSignups moves open and full games whose signup deadline has passed to closed.
+// It runs periodically so listings and status filters stay accurate without waiting for a user action.
+func (s *GamesService) CloseExpiredSignups(ctx context.Context) error {
+	closed, err := s.queries.CloseGamesPastSignupDeadline(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close games past signup deadline: %w", err)
+	}
+	if closed > 0 {
+		log.Ctx(ctx).Info().Int64("closedGames", closed).Msg("Closed signups for games past their deadline")
+	}
+	return nil
+}
+
+// AdvanceGameStatuses moves games to in_progress at their start time and to completed once
+// start time plus duration has passed. Cancelled games are never touched.
+func (s *GamesService) AdvanceGameStatuses(ctx context.Context) error {
+	completed, err := s.queries.CompleteFinishedGames(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to complete finished games: %w", err)
+	}
+	started, err := s.queries.StartGamesPastStartTime(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update participant status: %w", err)
+		return fmt.Errorf("failed to start games: %w", err)
+	}
+	if completed > 0 || started > 0 {
+		log.Ctx(ctx).Info().Int64("startedGames", started).Int64("completedGames", completed).Msg("Advanced game statuses")
 	}
+	return nil
+}
 
-	logger.Info().Msg("User dropped from game successfully")
+// SetEventBus sets where game events are published
+func (s *GamesService) SetEventBus(bus *events.Bus) {
+	s.events = bus
+}
 
-	result := &DropGameResult{PromotedUser: nil}
+// publish hands an event about a committed change to its subscribers
+func (s *GamesService) publish(ctx context.Context, event events.Event) {
+	if s.events == nil {
+		return
+	}
+	s.events.Publish(ctx, event)
+}
 
-	// Reconcile participant statuses to promote from waitlist if needed
-	// This only does work if a confirmed participant dropped and there's a waitlist
-	if wasConfirmed && s.pool != nil {
-		if err := s.reconcileParticipantStatuses(ctx, gameUUID, game.MaxParticipants); err != nil {
-			// Don't fail the drop operation, just log the error
-			logger.Error().Err(err).Msg("Failed to reconcile participant statuses after drop")
-			return result, nil
-		}
+// inTx runs fn with transaction-scoped queries and commits if fn succeeds. Services built
+// without a pool (unit tests with a mocked Querier) run fn directly against s.queries.
+func (s *GamesService) inTx(ctx context.Context, fn func(q ifaces.Querier) error) error {
+	if s.pool == nil {
+		return fn(s.queries)
 	}
 
-	// Get updated participant list to detect promotion
-	participantsAfter, err := s.queries.ListParticipantsByGame(ctx, gameUUID)
+	tx, err := s.pool.Begin(ctx)
 	if err != nil {
-		// Don't fail the drop operation, just log the error
-		logger.Error().Err(err).Msg("Failed to get participants after drop for promotion detection")
-		return result, nil
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	// Find who got promoted (if anyone)
-	// When a confirmed user drops, the person at position (maxParticipants) would be promoted
-	if wasConfirmed {
-		// Count active participants to find the last confirmed spot
-		activeCount := 0
-		var lastConfirmed *repository.ParticipantDetail
-		for _, p := range participantsAfter {
-			// Skip inactive participants (including the one we just dropped)
-			if InactiveParticipantStates[p.Status] {
-				continue
-			}
+	if err := fn(repository.New(tx).WithTx(tx)); err != nil {
+		return err
+	}
 
-			// Count all active participants (both confirmed and waitlist)
-			// The person at position maxParticipants is the promoted one
-			activeCount++
-			if activeCount == int(game.MaxParticipants) {
-				pCopy := p
-				lastConfirmed = &pCopy
-			}
-		}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
 
-		// If we have at least maxParticipants active participants after the drop,
-		// the person at position maxParticipants was promoted from waitlist
-		if lastConfirmed != nil && lastConfirmed.UserID != userUUID {
-			result.PromotedUser = &models.User{
-				ID:        uuid.UUID(lastConfirmed.UserID.Bytes).String(),
-				Email:     lastConfirmed.Email,
-				FirstName: lastConfirmed.FirstName,
-				LastName:  lastConfirmed.LastName,
-			}
-			logger.Info().
-				Str("promotedUserId", result.PromotedUser.ID).
-				Str("promotedUserEmail", result.PromotedUser.Email).
-				Msg("User promoted from waitlist")
-		}
+// syncCapacityStatus flips a game between open and full to match its active roster (confirmed plus
+// waitlisted) and the spots held by reservations. Call it inside the transaction that changed the
+// roster, after the change, so ListGames status filters never lag behind. Games in any other status
+// (closed, in_progress, ...) are left alone.
+func syncCapacityStatus(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID, status string, maxParticipants int32) error {
+	if status != string(models.GameStatusOpen) && status != string(models.GameStatusFull) {
+		return nil
 	}
 
-	return result, nil
+	confirmed, err := q.CountConfirmedParticipants(ctx, gameUUID)
+	if err != nil {
+		return fmt.Errorf("failed to count confirmed participants: %w", err)
+	}
+	waitlisted, err := q.CountWaitlistParticipants(ctx, gameUUID)
+	if err != nil {
+		return fmt.Errorf("failed to count waitlisted participants: %w", err)
+	}
+	held, err := q.CountHeldReservations(ctx, gameUUID)
+	if err != nil {
+		return fmt.Errorf("failed to count held reservations: %w", err)
+	}
+
+	desired := models.GameStatusOpen
+	if confirmed+waitlisted+held >= int64(maxParticipants) {
+		desired = models.GameStatusFull
+	}
+	if string(desired) == status {
+		return nil
+	}
+
+	if err := q.UpdateGameStatus(ctx, repository.UpdateGameStatusParams{ID: gameUUID, Status: string(desired)}); err != nil {
+		return fmt.Errorf("failed to update game status: %w", err)
+	}
+	log.Ctx(ctx).Info().Str("status", string(desired)).Msg("Game capacity status changed")
+	return nil
+}
+
+// participantVisibleTo reports whether a participant may be listed to viewer.
+// Minors only appear in rosters shown to the game's organizer and to themselves.
+func participantVisibleTo(p repository.ParticipantDetail, ownerID, viewerID pgtype.UUID, now time.Time) bool {
+	if !isMinor(p.Birthdate, now) {
+		return true
+	}
+	return viewerID == ownerID || viewerID == p.UserID
 }
 
 // convertParticipantDetailToModel converts a repository.ParticipantDetail to a models.Participant
@@ -1001,19 +2194,36 @@ func convertParticipantDetailToModel(p repository.ParticipantDetail, waitlistPos
 		paymentCents = &cents
 	}
 
+	user := models.User{
+		Email:     p.Email,
+		FirstName: p.FirstName,
+		LastName:  p.LastName,
+	}
+	var checkedInAt *time.Time
+	if p.CheckedInAt.Valid {
+		t := p.CheckedInAt.Time.UTC()
+		checkedInAt = &t
+	}
+
+	var placeholderID *string
+	if p.UserID.Valid {
+		user.ID = uuid.UUID(p.UserID.Bytes).String()
+	} else {
+		id := uuid.UUID(p.ID.Bytes).String()
+		placeholderID = &id
+	}
+
 	return &models.Participant{
-		User: models.User{
-			ID:        uuid.UUID(p.UserID.Bytes).String(),
-			Email:     p.Email,
-			FirstName: p.FirstName,
-			LastName:  p.LastName,
-		},
+		User:               user,
+		PlaceholderID:      placeholderID,
 		TeamID:             teamID,
+		Position:           pgTextToStringPtr(p.Position),
 		Status:             models.ParticipantStatus(p.Status),
 		WaitlistPosition:   waitlistPosition,
 		Paid:               p.Paid,
 		PaymentAmountCents: paymentCents,
 		Notes:              pgTextToStringPtr(p.Notes),
+		CheckedInAt:        checkedInAt,
 		JoinedAt:           p.JoinedAt.Time.UTC(),
 		UpdatedAt:          p.UpdatedAt.Time.UTC(),
 	}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ParticipationHistoryFilters pages a player's participation history
+type ParticipationHistoryFilters struct {
+	Limit  int // Number of results to return (default 50, max 100)
+	Offset int // Number of results to skip (default 0)
+}
+
+// ParticipationHistory returns one page of the games the user signed up for that have ended, most
+// recent first, with how each sign-up ended and whether the user paid
+func (s *GamesService) ParticipationHistory(ctx context.Context, userID string, filters ParticipationHistoryFilters) (*models.ParticipationHistoryResponse, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	if filters.Limit < 0 || filters.Offset < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "limit",
+			Message:      "limit and offset must be non-negative",
+		}
+	}
+	if filters.Limit == 0 {
+		filters.Limit = 50
+	}
+	if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	// Fetch one extra row to learn whether another page follows
+	rows, err := s.queries.ListUserParticipationHistory(ctx, repository.ListUserParticipationHistoryParams{
+		UserID:     userUUID,
+		PageLimit:  int32(filters.Limit + 1),
+		PageOffset: int32(filters.Offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participation history: %w", err)
+	}
+
+	hasMore := len(rows) > filters.Limit
+	if hasMore {
+		rows = rows[:filters.Limit]
+	}
+
+	entries := make([]models.ParticipationHistoryEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := models.ParticipationHistoryEntry{
+			Game: models.PlayerGame{
+				ID:           uuid.UUID(row.ID.Bytes).String(),
+				Title:        pgTextToStringPtr(row.Title),
+				Category:     models.GameCategory(row.Category),
+				LocationName: row.LocationName,
+				StartTime:    row.StartTime.Time.UTC(),
+				Status:       models.GameStatus(row.Status),
+			},
+			Outcome: participationOutcome(models.ParticipantStatus(row.ParticipantStatus), row.AttendanceStatus),
+			Paid:    row.Paid,
+			// History only lists games that have ended
+			ReceiptAvailable: row.Paid && row.Status != string(models.GameStatusCancelled),
+		}
+		if row.PaymentAmountCents.Valid {
+			cents := int(row.PaymentAmountCents.Int32)
+			entry.PaymentAmountCents = &cents
+		}
+		entries = append(entries, entry)
+	}
+
+	response := &models.ParticipationHistoryResponse{
+		Entries: entries,
+		Limit:   filters.Limit,
+		Offset:  filters.Offset,
+		HasMore: hasMore,
+	}
+	if hasMore {
+		next := filters.Offset + filters.Limit
+		response.NextOffset = &next
+	}
+	return response, nil
+}
+
+// participationOutcome combines a participant's final status with the attendance the host marked.
+// Attendance only counts for confirmed players; the host can't mark anyone else.
+func participationOutcome(status models.ParticipantStatus, attendance pgtype.Text) models.ParticipationOutcome {
+	switch status {
+	case models.ParticipantStatusConfirmed:
+		switch models.AttendanceStatus(attendance.String) {
+		case models.AttendanceStatusAttended:
+			return models.ParticipationOutcomeAttended
+		case models.AttendanceStatusNoShow:
+			return models.ParticipationOutcomeNoShow
+		}
+		return models.ParticipationOutcomeConfirmed
+	case models.ParticipantStatusWaitlist:
+		return models.ParticipationOutcomeWaitlisted
+	case models.ParticipantStatusDeclined:
+		return models.ParticipationOutcomeDeclined
+	case models.ParticipantStatusRemoved:
+		return models.ParticipationOutcomeRemoved
+	}
+	return models.ParticipationOutcomeDropped
+}
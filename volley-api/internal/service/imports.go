@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// MaxImportRows caps the number of games in one bulk import upload
+	MaxImportRows = 1000
+	// importBatchSize is how many games are created per transaction
+	importBatchSize = 100
+)
+
+// ImportGameRow is one decoded row of a bulk import. ParseErr is set when the row could not be
+// decoded or failed request validation before reaching the service.
+type ImportGameRow struct {
+	Line     int
+	Request  models.CreateGameRequest
+	ParseErr error
+}
+
+// ImportGames bulk-creates games owned by userID, e.g. from a league schedule upload.
+// Every row is validated (and screened by the content filter) first; if any row is invalid nothing is created and the valid rows are
+// reported as skipped. In dry-run mode the validation results are returned without writing.
+// Otherwise games are created in transactions of importBatchSize rows: a batch that fails is
+// rolled back and its rows are reported as failed, while earlier batches stay committed.
+func (s *GamesService) ImportGames(ctx context.Context, userID string, rows []ImportGameRow, dryRun bool) (*models.ImportGamesResponse, error) {
+	logger := log.Ctx(ctx)
+
+	var ownerID pgtype.UUID
+	if err := ownerID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if len(rows) == 0 {
+		return nil, &InvalidArgumentError{ArgumentName: "file", Message: "upload contains no games"}
+	}
+	if len(rows) > MaxImportRows {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "file",
+			Message:      fmt.Sprintf("upload contains %d games; the limit is %d", len(rows), MaxImportRows),
+		}
+	}
+
+	response := &models.ImportGamesResponse{
+		DryRun:  dryRun,
+		Total:   len(rows),
+		Results: make([]models.ImportGameResult, len(rows)),
+	}
+
+	params := make([]repository.CreateGameParams, len(rows))
+	flagged := make([][]string, len(rows))
+	for i, row := range rows {
+		response.Results[i] = models.ImportGameResult{Line: row.Line, Status: models.ImportRowStatusValid}
+
+		err := row.ParseErr
+		if err == nil {
+			params[i], err = buildCreateGameParams(ownerID, row.Request)
+		}
+		if err == nil {
+			flagged[i], err = s.screenGameText(createGameTextFields(row.Request))
+		}
+		if err != nil {
+			message := err.Error()
+			response.Results[i].Status = models.ImportRowStatusInvalid
+			response.Results[i].Error = &message
+			response.Invalid++
+		}
+	}
+
+	if dryRun {
+		return response, nil
+	}
+	if response.Invalid > 0 {
+		for i := range response.Results {
+			if response.Results[i].Status == models.ImportRowStatusValid {
+				response.Results[i].Status = models.ImportRowStatusSkipped
+			}
+		}
+		return response, nil
+	}
+
+	for start := 0; start < len(rows); start += importBatchSize {
+		end := min(start+importBatchSize, len(rows))
+
+		gameIDs := make([]string, 0, end-start)
+		err := s.inTx(ctx, func(q ifaces.Querier) error {
+			for i := start; i < end; i++ {
+				game, err := q.CreateGame(ctx, params[i])
+				if err != nil {
+					return fmt.Errorf("line %d: %w", rows[i].Line, err)
+				}
+				if _, err := createGamePositions(ctx, q, game.ID, rows[i].Request.Positions); err != nil {
+					return fmt.Errorf("line %d: %w", rows[i].Line, err)
+				}
+				if _, err := addGameTags(ctx, q, game.ID, rows[i].Request.Tags); err != nil {
+					return fmt.Errorf("line %d: %w", rows[i].Line, err)
+				}
+				if len(flagged[i]) > 0 {
+					if err := flagGameContent(ctx, q, game.ID, flagged[i]); err != nil {
+						return fmt.Errorf("line %d: %w", rows[i].Line, err)
+					}
+				}
+				gameIDs = append(gameIDs, uuid.UUID(game.ID.Bytes).String())
+			}
+			return nil
+		})
+		if err != nil {
+			logger.Error().Err(err).Int("batchStart", start).Msg("Failed to import game batch")
+			message := "failed to save this batch of games"
+			for i := start; i < end; i++ {
+				response.Results[i].Status = models.ImportRowStatusFailed
+				response.Results[i].Error = &message
+			}
+			continue
+		}
+
+		for i := start; i < end; i++ {
+			response.Results[i].Status = models.ImportRowStatusCreated
+			response.Results[i].GameID = &gameIDs[i-start]
+		}
+		response.Created += end - start
+	}
+
+	logger.Info().Int("total", response.Total).Int("created", response.Created).Msg("Games imported")
+	return response, nil
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/jobs"
+)
+
+// Jobs is the periodic work that keeps games in step with the clock and finishes follow-up work
+func (s *GamesService) Jobs() []jobs.Job {
+	return []jobs.Job{
+		{Name: "close-expired-signups", Interval: time.Minute, Run: s.CloseExpiredSignups},
+		{Name: "advance-game-statuses", Interval: time.Minute, Run: s.AdvanceGameStatuses},
+		{Name: "process-side-effects", Interval: 30 * time.Second, Run: s.ProcessSideEffects},
+		{Name: "expire-contact-sharing", Interval: 5 * time.Minute, Run: s.ExpireContactSharing},
+		{Name: "release-expired-reservations", Interval: time.Minute, Run: s.ReleaseExpiredReservations},
+		{Name: "prune-dead-letters", Interval: time.Hour, Run: s.PruneDeadLetters},
+	}
+}
+
+// Jobs is the periodic work that refreshes precomputed stats
+func (s *StatsService) Jobs() []jobs.Job {
+	return []jobs.Job{
+		{Name: "refresh-reliability-scores", Interval: time.Hour, Run: s.RefreshReliabilityScores},
+		{Name: "refresh-leaderboards", Interval: time.Hour, Run: s.RefreshLeaderboards},
+	}
+}
+
+// Jobs is the periodic cleanup of account data
+func (u *UserService) Jobs() []jobs.Job {
+	return []jobs.Job{
+		{Name: "prune-failed-logins", Interval: time.Hour, Run: u.PruneFailedLogins},
+	}
+}
+
+// Jobs delivers queued webhook events
+func (w *Webhooks) Jobs() []jobs.Job {
+	return []jobs.Job{
+		{Name: "deliver-webhooks", Interval: 15 * time.Second, Run: w.DeliverDue},
+	}
+}
+
+// Jobs retries failed notifications and prunes old delivery records
+func (t *DeliveryTracker) Jobs() []jobs.Job {
+	return []jobs.Job{
+		{Name: "retry-notifications", Interval: 15 * time.Second, Run: t.RetryDue},
+		{Name: "prune-notification-deliveries", Interval: time.Hour, Run: t.PruneDeliveries},
+	}
+}
+
+// Jobs publishes queued events to the external event broker and prunes finished ones
+func (b *BrokerPublisher) Jobs() []jobs.Job {
+	return []jobs.Job{
+		{Name: "publish-broker-events", Interval: 15 * time.Second, Run: b.PublishDue},
+		{Name: "prune-broker-events", Interval: time.Hour, Run: b.PruneBrokerEvents},
+	}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// EnableJournal records every join and drop request in participation_journal, with its timing and
+// outcome, so roster anomalies reported from production can be replayed with cmd/replay.
+// It costs an extra insert per request, so it is off unless VOLLEY_JOURNAL=true.
+func (s *GamesService) EnableJournal() {
+	s.journal = true
+}
+
+// recordParticipation writes a journal entry for a finished join or drop request.
+// Failures are logged and never affect the request itself.
+func (s *GamesService) recordParticipation(ctx context.Context, action models.JournalAction, gameID, userID string, requestedAt time.Time, outcome string, promoted *models.User, requestErr error) {
+	if !s.journal {
+		return
+	}
+
+	var gameUUID, userUUID pgtype.UUID
+	if gameUUID.Scan(gameID) != nil || userUUID.Scan(userID) != nil {
+		return
+	}
+
+	params := repository.CreateParticipationJournalEntryParams{
+		GameID:      gameUUID,
+		UserID:      userUUID,
+		Action:      string(action),
+		RequestedAt: pgtype.Timestamptz{Time: requestedAt, Valid: true},
+		CompletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		Outcome:     outcome,
+	}
+	if requestErr != nil {
+		params.Outcome = models.JournalOutcomeError
+		params.Error = pgtype.Text{String: requestErr.Error(), Valid: true}
+	}
+	if promoted != nil {
+		_ = params.PromotedUserID.Scan(promoted.ID)
+	}
+
+	if err := s.queries.CreateParticipationJournalEntry(ctx, params); err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("action", string(action)).Msg("Failed to record participation journal entry")
+	}
+}
+
+// joinOutcome is the joining user's status in the roster returned by JoinGame
+func joinOutcome(participants []models.Participant, userID string) string {
+	for _, p := range participants {
+		if p.ID == userID {
+			return string(p.Status)
+		}
+	}
+	return models.JournalOutcomeError
+}
+
+// ListParticipationJournal returns a game's journaled join and drop requests in request order
+func (s *GamesService) ListParticipationJournal(ctx context.Context, gameID string) ([]models.ParticipationJournalEntry, error) {
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+
+	if _, err := s.queries.GetGame(ctx, gameUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	rows, err := s.queries.ListParticipationJournalByGame(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participation journal: %w", err)
+	}
+
+	entries := make([]models.ParticipationJournalEntry, 0, len(rows))
+	for _, row := range rows {
+		var promotedUserID *string
+		if row.PromotedUserID.Valid {
+			id := uuid.UUID(row.PromotedUserID.Bytes).String()
+			promotedUserID = &id
+		}
+		entries = append(entries, models.ParticipationJournalEntry{
+			ID:             row.ID,
+			GameID:         uuid.UUID(row.GameID.Bytes).String(),
+			UserID:         uuid.UUID(row.UserID.Bytes).String(),
+			Action:         models.JournalAction(row.Action),
+			RequestedAt:    row.RequestedAt.Time.UTC(),
+			CompletedAt:    row.CompletedAt.Time.UTC(),
+			Outcome:        row.Outcome,
+			Error:          pgTextToStringPtr(row.Error),
+			PromotedUserID: promotedUserID,
+		})
+	}
+
+	return entries, nil
+}
+
+// ReplayParticipation re-runs a journaled request and returns its outcome in the same terms the
+// journal uses, so the replayed and original outcomes can be compared directly
+func (s *GamesService) ReplayParticipation(ctx context.Context, entry models.ParticipationJournalEntry) (string, error) {
+	switch entry.Action {
+	case models.JournalActionJoin:
+		// The journal doesn't record positions, so joins of games with positions can't be replayed
+		participants, err := s.joinGame(ctx, entry.GameID, entry.UserID, models.JoinGameRequest{})
+		if err != nil {
+			return models.JournalOutcomeError, err
+		}
+		return joinOutcome(participants, entry.UserID), nil
+	case models.JournalActionDrop:
+		if _, err := s.dropParticipantFromGame(ctx, entry.GameID, entry.UserID, nil); err != nil {
+			return models.JournalOutcomeError, err
+		}
+		return string(models.ParticipantStatusDropped), nil
+	default:
+		return models.JournalOutcomeError, fmt.Errorf("unknown journal action %q", entry.Action)
+	}
+}
+
+// ResetParticipants deletes every participant of a game and reopens it, so a journal can be
+// replayed from an empty roster. Only for cmd/replay against a test database.
+func (s *GamesService) ResetParticipants(ctx context.Context, gameID string) error {
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+
+	return s.inTx(ctx, func(q ifaces.Querier) error {
+		if err := q.DeleteParticipantsByGame(ctx, gameUUID); err != nil {
+			return fmt.Errorf("failed to delete participants: %w", err)
+		}
+		if err := q.UpdateGameStatus(ctx, repository.UpdateGameStatusParams{
+			ID:     gameUUID,
+			Status: string(models.GameStatusOpen),
+		}); err != nil {
+			return fmt.Errorf("failed to reopen game: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrMVPNotOpen = errors.New("an MVP can only be named once the game is completed")
+
+// LeaderboardFilters selects and pages a leaderboard
+type LeaderboardFilters struct {
+	Metric    models.LeaderboardMetric // What to rank by (default played)
+	Category  *models.GameCategory     // Only this sport; all sports when nil
+	Latitude  float64                  // Center of the area
+	Longitude float64                  // Center of the area
+	Radius    float64                  // Area radius in meters
+	Limit     int                      // Number of results to return (default 50, max 100)
+	Offset    int                      // Number of results to skip (default 0)
+}
+
+// RefreshLeaderboards rebuilds the leaderboard totals from completed games. Rows the refresh didn't
+// write are pruned afterwards, so a player whose last game in an area was corrected away drops off.
+func (s *StatsService) RefreshLeaderboards(ctx context.Context) error {
+	computedAt := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	refreshed, err := s.queries.RefreshLeaderboardCells(ctx, computedAt)
+	if err != nil {
+		return fmt.Errorf("failed to refresh leaderboards: %w", err)
+	}
+	pruned, err := s.queries.PruneLeaderboardCells(ctx, computedAt)
+	if err != nil {
+		return fmt.Errorf("failed to prune leaderboards: %w", err)
+	}
+	log.Ctx(ctx).Info().Int64("cells", refreshed).Int64("pruned", pruned).Msg("Refreshed leaderboards")
+	return nil
+}
+
+// Leaderboard ranks the players of completed games within the area. Totals come from the last
+// leaderboard refresh, so they can lag behind the latest games by up to an hour.
+func (s *StatsService) Leaderboard(ctx context.Context, filters LeaderboardFilters) (*models.LeaderboardResponse, error) {
+	if filters.Metric == "" {
+		filters.Metric = models.LeaderboardMetricPlayed
+	}
+	switch filters.Metric {
+	case models.LeaderboardMetricPlayed, models.LeaderboardMetricHosted, models.LeaderboardMetricMVPs:
+	default:
+		return nil, &InvalidArgumentError{
+			ArgumentName: "metric",
+			Message:      "metric must be played, hosted or mvps",
+		}
+	}
+	if filters.Radius <= 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "radius",
+			Message:      "radius must be positive",
+		}
+	}
+	if filters.Limit < 0 || filters.Offset < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "limit",
+			Message:      "limit and offset must be non-negative",
+		}
+	}
+	if filters.Limit == 0 {
+		filters.Limit = 50
+	}
+	if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	params := repository.ListLeaderboardParams{
+		Longitude: filters.Longitude,
+		Latitude:  filters.Latitude,
+		Radius:    filters.Radius,
+		Metric:    string(filters.Metric),
+		// Fetch one extra row to learn whether another page follows
+		PageLimit:  int32(filters.Limit + 1),
+		PageOffset: int32(filters.Offset),
+	}
+	if filters.Category != nil {
+		params.Category = pgtype.Text{String: string(*filters.Category), Valid: true}
+	}
+	rows, err := s.queries.ListLeaderboard(ctx, params)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list leaderboard")
+		return nil, fmt.Errorf("failed to list leaderboard: %w", err)
+	}
+
+	hasMore := len(rows) > filters.Limit
+	if hasMore {
+		rows = rows[:filters.Limit]
+	}
+
+	entries := make([]models.LeaderboardEntry, 0, len(rows))
+	for i, row := range rows {
+		entries = append(entries, models.LeaderboardEntry{
+			Rank:        filters.Offset + i + 1,
+			UserID:      uuid.UUID(row.UserID.Bytes).String(),
+			FirstName:   row.FirstName,
+			LastName:    row.LastName,
+			GamesPlayed: int(row.GamesPlayed),
+			GamesHosted: int(row.GamesHosted),
+			MVPs:        int(row.Mvps),
+		})
+	}
+
+	response := &models.LeaderboardResponse{
+		Metric:   filters.Metric,
+		Category: filters.Category,
+		Entries:  entries,
+		Limit:    filters.Limit,
+		Offset:   filters.Offset,
+		HasMore:  hasMore,
+	}
+	if hasMore {
+		next := filters.Offset + filters.Limit
+		response.NextOffset = &next
+	}
+	return response, nil
+}
+
+// NameMVP records the owner's pick for most valuable player of their completed game. The MVP must
+// have played it; naming someone else replaces the previous pick.
+func (s *GamesService) NameMVP(ctx context.Context, gameID string, ownerID string, userID string) (*models.GameMVP, error) {
+	var gameUUID, ownerUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.OwnerID != ownerUUID {
+		return nil, ErrNotOwner
+	}
+	if game.Status != string(models.GameStatusCompleted) {
+		return nil, ErrMVPNotOpen
+	}
+
+	played, err := s.queries.PlayedCompletedGame(ctx, repository.PlayedCompletedGameParams{GameID: gameUUID, UserID: userUUID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check participation: %w", err)
+	}
+	if !played {
+		return nil, ErrNotParticipant
+	}
+
+	mvp, err := s.queries.UpsertGameMVP(ctx, repository.UpsertGameMVPParams{
+		GameID:  gameUUID,
+		UserID:  userUUID,
+		NamedBy: ownerUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to name MVP: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("mvpUserId", userID).Msg("MVP named")
+	return &models.GameMVP{
+		GameID:  uuid.UUID(mvp.GameID.Bytes).String(),
+		UserID:  uuid.UUID(mvp.UserID.Bytes).String(),
+		NamedAt: mvp.NamedAt.Time.UTC(),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// CheckGameExists returns apperrors.ErrNotFound unless the game exists, for streams that watch a
+// game's changes without loading its details
+func (s *GamesService) CheckGameExists(ctx context.Context, gameID string) error {
+	var gameUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if _, err := s.queries.GetGame(ctx, gameUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.ErrNotFound
+		}
+		return fmt.Errorf("failed to get game: %w", err)
+	}
+	return nil
+}
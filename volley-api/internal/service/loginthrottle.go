@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// failedLoginWindow is how long a failed password sign-in counts towards the limits below
+	failedLoginWindow = 15 * time.Minute
+	// maxFailedLoginsPerAccount locks an email out of password sign-in once reached within the window
+	maxFailedLoginsPerAccount = 5
+	// maxFailedLoginsPerIP blocks a client IP once reached within the window. Higher than the
+	// per-account limit so users behind a shared NAT don't lock each other out.
+	maxFailedLoginsPerIP = 20
+	// failedLoginRetention is how long failed sign-ins are kept before PruneFailedLogins deletes them
+	failedLoginRetention = 24 * time.Hour
+)
+
+// LoginThrottledError is returned by Login while the account or the client IP has too many
+// recent failed sign-ins. It wraps apperrors.ErrRateLimited.
+type LoginThrottledError struct {
+	RetryAfter time.Duration // Until the oldest failure that counts towards the limit expires
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("too many failed sign-ins, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return apperrors.ErrRateLimited
+}
+
+// loginFailures is the number of recent failed sign-ins for an email and an IP, capped at their limits
+type loginFailures struct {
+	email int
+	ip    int
+}
+
+// checkLoginThrottle returns a *LoginThrottledError if the email or the IP has reached its limit
+func (u *UserService) checkLoginThrottle(ctx context.Context, email string, ipAddress string) (loginFailures, error) {
+	logger := log.Ctx(ctx)
+	since := pgtype.Timestamptz{Time: time.Now().Add(-failedLoginWindow), Valid: true}
+
+	byEmail, err := u.queries.ListRecentFailedLoginsByEmail(ctx, repository.ListRecentFailedLoginsByEmailParams{
+		Email:       email,
+		AttemptedAt: since,
+		Limit:       maxFailedLoginsPerAccount,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list failed logins by email")
+		return loginFailures{}, fmt.Errorf("failed to list failed logins: %w", err)
+	}
+	if len(byEmail) >= maxFailedLoginsPerAccount {
+		retryAfter := time.Until(byEmail[len(byEmail)-1].Time.Add(failedLoginWindow))
+		logger.Warn().Str("email", email).Str("ipAddress", ipAddress).Dur("retryAfter", retryAfter).Msg("Login blocked: account locked out")
+		return loginFailures{}, &LoginThrottledError{RetryAfter: retryAfter}
+	}
+
+	byIP, err := u.queries.ListRecentFailedLoginsByIP(ctx, repository.ListRecentFailedLoginsByIPParams{
+		IpAddress:   ipAddress,
+		AttemptedAt: since,
+		Limit:       maxFailedLoginsPerIP,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to list failed logins by IP")
+		return loginFailures{}, fmt.Errorf("failed to list failed logins: %w", err)
+	}
+	if len(byIP) >= maxFailedLoginsPerIP {
+		retryAfter := time.Until(byIP[len(byIP)-1].Time.Add(failedLoginWindow))
+		logger.Warn().Str("email", email).Str("ipAddress", ipAddress).Dur("retryAfter", retryAfter).Msg("Login blocked: IP address locked out")
+		return loginFailures{}, &LoginThrottledError{RetryAfter: retryAfter}
+	}
+
+	return loginFailures{email: len(byEmail), ip: len(byIP)}, nil
+}
+
+// recordFailedLogin counts a failed sign-in and logs when it starts a lockout. Errors are only
+// logged so the caller still gets its invalid credentials response.
+func (u *UserService) recordFailedLogin(ctx context.Context, email string, ipAddress string, previous loginFailures) {
+	logger := log.Ctx(ctx)
+
+	if err := u.queries.RecordFailedLogin(ctx, repository.RecordFailedLoginParams{
+		Email:     email,
+		IpAddress: ipAddress,
+	}); err != nil {
+		logger.Error().Err(err).Msg("Failed to record failed login")
+		return
+	}
+
+	if previous.email+1 == maxFailedLoginsPerAccount {
+		logger.Warn().Str("email", email).Str("ipAddress", ipAddress).Dur("lockout", failedLoginWindow).Msg("Account locked out after repeated failed sign-ins")
+	}
+	if previous.ip+1 == maxFailedLoginsPerIP {
+		logger.Warn().Str("ipAddress", ipAddress).Dur("lockout", failedLoginWindow).Msg("IP address locked out after repeated failed sign-ins")
+	}
+}
+
+// normalizeLoginEmail is the key failed sign-ins are counted under, so changing the case of an
+// email doesn't get around the limit
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// PruneFailedLogins deletes failed sign-ins that are long past counting towards a lockout
+func (u *UserService) PruneFailedLogins(ctx context.Context) error {
+	cutoff := pgtype.Timestamptz{Time: time.Now().Add(-failedLoginRetention), Valid: true}
+	deleted, err := u.queries.DeleteFailedLoginsBefore(ctx, cutoff)
+	if err != nil {
+		return fmt.Errorf("failed to prune failed logins: %w", err)
+	}
+	if deleted > 0 {
+		log.Ctx(ctx).Info().Int64("deleted", deleted).Msg("Pruned failed logins")
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+)
+
+// DefaultLocale is used when the client asks for a locale without translations
+const DefaultLocale = "en"
+
+// enumEntry is one enum value with its display name per locale
+type enumEntry struct {
+	value string
+	icon  string
+	names map[string]string
+}
+
+var (
+	categoryEntries = []enumEntry{
+		{string(models.GameCategorySoccer), "sports_soccer", map[string]string{"en": "Soccer", "es": "Fútbol"}},
+		{string(models.GameCategoryBasketball), "sports_basketball", map[string]string{"en": "Basketball", "es": "Baloncesto"}},
+		{string(models.GameCategoryVolleyball), "sports_volleyball", map[string]string{"en": "Volleyball", "es": "Voleibol"}},
+		{string(models.GameCategoryPickleball), "sports_tennis", map[string]string{"en": "Pickleball", "es": "Pickleball"}},
+		{string(models.GameCategoryTennis), "sports_tennis", map[string]string{"en": "Tennis", "es": "Tenis"}},
+		{string(models.GameCategoryFlagFootball), "sports_football", map[string]string{"en": "Flag Football", "es": "Fútbol de banderas"}},
+		{string(models.GameCategoryUltimateFrisbee), "sports_handball", map[string]string{"en": "Ultimate Frisbee", "es": "Ultimate Frisbee"}},
+		{string(models.GameCategoryOther), "sports", map[string]string{"en": "Other", "es": "Otro"}},
+	}
+	skillLevelEntries = []enumEntry{
+		{string(models.SkillLevelAll), "groups", map[string]string{"en": "All Levels", "es": "Todos los niveles"}},
+		{string(models.SkillLevelBeginner), "signal_cellular_1_bar", map[string]string{"en": "Beginner", "es": "Principiante"}},
+		{string(models.SkillLevelIntermediate), "signal_cellular_3_bar", map[string]string{"en": "Intermediate", "es": "Intermedio"}},
+		{string(models.SkillLevelAdvanced), "signal_cellular_4_bar", map[string]string{"en": "Advanced", "es": "Avanzado"}},
+	}
+	gameStatusEntries = []enumEntry{
+		{string(models.GameStatusOpen), "event_available", map[string]string{"en": "Open", "es": "Abierto"}},
+		{string(models.GameStatusFull), "event_busy", map[string]string{"en": "Full", "es": "Completo"}},
+		{string(models.GameStatusClosed), "lock", map[string]string{"en": "Sign-ups Closed", "es": "Inscripciones cerradas"}},
+		{string(models.GameStatusInProgress), "play_circle", map[string]string{"en": "In Progress", "es": "En curso"}},
+		{string(models.GameStatusCompleted), "check_circle", map[string]string{"en": "Completed", "es": "Finalizado"}},
+		{string(models.GameStatusCancelled), "cancel", map[string]string{"en": "Cancelled", "es": "Cancelado"}},
+	}
+	participantStatusEntries = []enumEntry{
+		{string(models.ParticipantStatusConfirmed), "check", map[string]string{"en": "Confirmed", "es": "Confirmado"}},
+		{string(models.ParticipantStatusWaitlist), "hourglass_empty", map[string]string{"en": "Waitlist", "es": "Lista de espera"}},
+		{string(models.ParticipantStatusDropped), "logout", map[string]string{"en": "Dropped", "es": "Se retiró"}},
+		{string(models.ParticipantStatusDeclined), "do_not_disturb_on", map[string]string{"en": "Declined", "es": "Rechazado"}},
+		{string(models.ParticipantStatusRemoved), "person_remove", map[string]string{"en": "Removed", "es": "Eliminado"}},
+	}
+	pricingTypeEntries = []enumEntry{
+		{string(models.PricingTypeFree), "money_off", map[string]string{"en": "Free", "es": "Gratis"}},
+		{string(models.PricingTypePerPerson), "person", map[string]string{"en": "Per Person", "es": "Por persona"}},
+		{string(models.PricingTypeTotal), "payments", map[string]string{"en": "Split Total", "es": "Total dividido"}},
+	}
+	reportReasonEntries = []enumEntry{
+		{string(models.ReportReasonSpam), "report", map[string]string{"en": "Spam", "es": "Spam"}},
+		{string(models.ReportReasonHarassment), "front_hand", map[string]string{"en": "Harassment", "es": "Acoso"}},
+		{string(models.ReportReasonInappropriate), "visibility_off", map[string]string{"en": "Inappropriate Content", "es": "Contenido inapropiado"}},
+		{string(models.ReportReasonFraud), "money_off", map[string]string{"en": "Fraud or Scam", "es": "Fraude o estafa"}},
+		{string(models.ReportReasonSafety), "health_and_safety", map[string]string{"en": "Safety Concern", "es": "Problema de seguridad"}},
+		{string(models.ReportReasonOther), "more_horiz", map[string]string{"en": "Other", "es": "Otro"}},
+	}
+)
+
+// supportedLocales lists the locales that have display names, in preference order for ties
+var supportedLocales = []string{"en", "es"}
+
+// NegotiateLocale picks the best supported locale for an Accept-Language header value, falling back to
+// DefaultLocale. Quality values are ignored; the header's order is used as the preference order.
+func NegotiateLocale(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		primary := strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+		for _, locale := range supportedLocales {
+			if primary == locale {
+				return locale
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+// Metadata returns the display names, icons, and ordering of the API's enums in the given locale.
+// Unsupported locales get DefaultLocale.
+func Metadata(locale string) *models.Metadata {
+	if NegotiateLocale(locale) != locale {
+		locale = DefaultLocale
+	}
+	return &models.Metadata{
+		Locale:              locale,
+		Categories:          enumOptions(categoryEntries, locale),
+		SkillLevels:         enumOptions(skillLevelEntries, locale),
+		GameStatuses:        enumOptions(gameStatusEntries, locale),
+		ParticipantStatuses: enumOptions(participantStatusEntries, locale),
+		PricingTypes:        enumOptions(pricingTypeEntries, locale),
+		ReportReasons:       enumOptions(reportReasonEntries, locale),
+	}
+}
+
+func enumOptions(entries []enumEntry, locale string) []models.EnumOption {
+	options := make([]models.EnumOption, len(entries))
+	for i, e := range entries {
+		options[i] = models.EnumOption{
+			Value:       e.value,
+			DisplayName: e.names[locale],
+			Icon:        e.icon,
+			Order:       i + 1,
+		}
+	}
+	return options
+}
@@ -0,0 +1,391 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrCannotSuspendSelf = errors.New("admins cannot suspend or shadow-ban themselves")
+
+// ForceCancelGame cancels any game on behalf of an admin, whoever owns it. Unlike an owner
+// cancellation it also stops games that are already under way; only finished games can't be
+// cancelled. The cancellation is recorded in the game's change history and the admin audit trail.
+func (s *GamesService) ForceCancelGame(ctx context.Context, gameID string, adminID string, reason string) (*CancelGameResult, error) {
+	logger := log.Ctx(ctx)
+
+	var gameUUID, adminUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := adminUUID.Scan(adminID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	var alreadyCancelled bool
+	var game repository.GetGameForUpdateRow
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		var err error
+		game, err = q.GetGameForUpdate(ctx, gameUUID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperrors.ErrNotFound
+			}
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		if game.Status == string(models.GameStatusCancelled) {
+			alreadyCancelled = true
+			return nil
+		}
+		gameEndTime := game.StartTime.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
+		if game.Status == string(models.GameStatusCompleted) || time.Now().After(gameEndTime) {
+			return ErrGameFinished
+		}
+
+		if _, err := q.CancelGame(ctx, gameUUID); err != nil {
+			return fmt.Errorf("failed to cancel game: %w", err)
+		}
+
+		cancelled := string(models.GameStatusCancelled)
+		if _, err := q.CreateGameChange(ctx, repository.CreateGameChangeParams{
+			GameID:    gameUUID,
+			ChangedBy: adminUUID,
+			Field:     "status",
+			OldValue:  pgtype.Text{String: game.Status, Valid: true},
+			NewValue:  stringPtrToPgText(&cancelled),
+		}); err != nil {
+			return fmt.Errorf("failed to record game change: %w", err)
+		}
+
+		if err := q.CreateAdminAuditEntry(ctx, repository.CreateAdminAuditEntryParams{
+			ActorID:    adminUUID,
+			Action:     string(models.AdminActionCancelGame),
+			TargetType: string(models.AuditTargetGame),
+			TargetID:   gameUUID,
+			Reason:     stringPtrToPgText(&reason),
+		}); err != nil {
+			return fmt.Errorf("failed to record admin action: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if alreadyCancelled {
+		logger.Info().Msg("Game already cancelled (idempotent)")
+		return &CancelGameResult{ParticipantsToNotify: []models.User{}}, nil
+	}
+
+	logger.Warn().Str("adminId", adminID).Str("reason", reason).Msg("Game force-cancelled by admin")
+	if err := s.refundCancelledGameCredits(ctx, gameUUID); err != nil {
+		s.enqueueSideEffect(ctx, SideEffectRefundCredits, gameUUID, err)
+	}
+	// The admin's reason is for the audit trail, so players and the host's webhooks aren't sent one
+	return s.publishCancellation(ctx, gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime), nil), nil
+}
+
+// RemoveParticipant takes a player off any game's roster on behalf of an admin and returns the
+// updated game. The participant is marked removed, so they show as removed by the host in their
+// history, and the waitlist is promoted if they held a spot. The removal is recorded in the admin
+// audit trail. Removing a removed player is a no-op.
+func (s *GamesService) RemoveParticipant(ctx context.Context, gameID string, adminID string, userID string, reason string) (*models.Game, error) {
+	logger := log.Ctx(ctx)
+
+	var gameUUID, adminUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := adminUUID.Scan(adminID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	var game repository.GetGameForUpdateRow
+	var alreadyRemoved, wasConfirmed bool
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		var err error
+		game, err = q.GetGameForUpdate(ctx, gameUUID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperrors.ErrNotFound
+			}
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+		if game.Status == string(models.GameStatusCancelled) || game.Status == string(models.GameStatusCompleted) {
+			return ErrGameNotEditable
+		}
+
+		participant, err := q.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+			GameID: gameUUID,
+			UserID: userUUID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrNotParticipant
+			}
+			return fmt.Errorf("failed to get participant: %w", err)
+		}
+		if participant.Status == string(models.ParticipantStatusRemoved) {
+			alreadyRemoved = true
+			return nil
+		}
+		wasConfirmed = participant.Status == string(models.ParticipantStatusConfirmed)
+
+		if _, err := q.UpdateParticipantStatus(ctx, repository.UpdateParticipantStatusParams{
+			ID:     participant.ID,
+			Status: string(models.ParticipantStatusRemoved),
+		}); err != nil {
+			return fmt.Errorf("failed to update participant status: %w", err)
+		}
+		if err := refundParticipantCredits(ctx, q, gameUUID, participant); err != nil {
+			return err
+		}
+
+		if err := q.CreateAdminAuditEntry(ctx, repository.CreateAdminAuditEntryParams{
+			ActorID:    adminUUID,
+			Action:     string(models.AdminActionRemoveParticipant),
+			TargetType: string(models.AuditTargetUser),
+			TargetID:   userUUID,
+			GameID:     gameUUID,
+			Reason:     stringPtrToPgText(&reason),
+		}); err != nil {
+			return fmt.Errorf("failed to record admin action: %w", err)
+		}
+
+		return syncCapacityStatus(ctx, q, gameUUID, game.Status, game.MaxParticipants)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if alreadyRemoved {
+		logger.Info().Msg("Participant already removed from game (idempotent)")
+	} else {
+		logger.Warn().Str("adminId", adminID).Str("playerId", userID).Str("reason", reason).Msg("Participant removed by admin")
+	}
+
+	if wasConfirmed {
+		if _, err := s.reconcileParticipantStatuses(ctx, gameUUID, game.MaxParticipants); err != nil {
+			// The player is already removed, so just log the error like a drop would
+			logger.Error().Err(err).Msg("Failed to reconcile participant statuses after removal")
+			s.enqueueSideEffect(ctx, SideEffectReconcileRoster, gameUUID, err)
+		}
+	}
+
+	return s.GetGame(ctx, gameID, adminID)
+}
+
+// SuspendUser suspends a user's account. Suspended users keep their data but every authenticated
+// request they make is refused (see CheckSession) until an admin lifts the suspension.
+// Suspending a suspended user replaces the reason. Both are recorded in the admin audit trail.
+func (u *UserService) SuspendUser(ctx context.Context, adminID string, userID string, reason string) (*models.Suspension, error) {
+	var adminUUID, userUUID pgtype.UUID
+	if err := adminUUID.Scan(adminID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{ArgumentName: "userId", Message: "invalid user ID format"}
+	}
+	if adminUUID == userUUID {
+		return nil, ErrCannotSuspendSelf
+	}
+
+	if _, err := u.queries.GetUserByID(ctx, userUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	suspension, err := u.queries.SuspendUser(ctx, repository.SuspendUserParams{
+		UserID:      userUUID,
+		SuspendedBy: adminUUID,
+		Reason:      reason,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to suspend user")
+		return nil, fmt.Errorf("failed to suspend user: %w", err)
+	}
+
+	if err := u.queries.CreateAdminAuditEntry(ctx, repository.CreateAdminAuditEntryParams{
+		ActorID:    adminUUID,
+		Action:     string(models.AdminActionSuspendUser),
+		TargetType: string(models.AuditTargetUser),
+		TargetID:   userUUID,
+		Reason:     stringPtrToPgText(&reason),
+	}); err != nil {
+		// Suspending again is harmless, so fail and let the admin retry rather than lose the entry
+		return nil, fmt.Errorf("failed to record admin action: %w", err)
+	}
+
+	log.Ctx(ctx).Warn().Str("suspendedUserId", userID).Str("reason", reason).Msg("User suspended")
+	return convertSuspensionToModel(suspension), nil
+}
+
+// UnsuspendUser lifts a user's suspension and records it in the admin audit trail. Returns
+// ErrNotFound if the user isn't suspended.
+func (u *UserService) UnsuspendUser(ctx context.Context, adminID string, userID string) error {
+	var adminUUID, userUUID pgtype.UUID
+	if err := adminUUID.Scan(adminID); err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return &InvalidArgumentError{ArgumentName: "userId", Message: "invalid user ID format"}
+	}
+
+	lifted, err := u.queries.UnsuspendUser(ctx, userUUID)
+	if err != nil {
+		return fmt.Errorf("failed to unsuspend user: %w", err)
+	}
+	if lifted == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	if err := u.queries.CreateAdminAuditEntry(ctx, repository.CreateAdminAuditEntryParams{
+		ActorID:    adminUUID,
+		Action:     string(models.AdminActionUnsuspendUser),
+		TargetType: string(models.AuditTargetUser),
+		TargetID:   userUUID,
+	}); err != nil {
+		return fmt.Errorf("failed to record admin action: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("adminId", adminID).Str("suspendedUserId", userID).Msg("User suspension lifted")
+	return nil
+}
+
+// ShadowBanUser shadow-bans a user's account. Nothing changes for them, but their games are listed
+// only to themselves and every game they join keeps them on the waitlist, out of everyone's way.
+// Shadow-banning a shadow-banned user replaces the reason. Both are recorded in the admin audit trail.
+func (u *UserService) ShadowBanUser(ctx context.Context, adminID string, userID string, reason string) (*models.ShadowBan, error) {
+	var adminUUID, userUUID pgtype.UUID
+	if err := adminUUID.Scan(adminID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{ArgumentName: "userId", Message: "invalid user ID format"}
+	}
+	if adminUUID == userUUID {
+		return nil, ErrCannotSuspendSelf
+	}
+
+	if _, err := u.queries.GetUserByID(ctx, userUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	ban, err := u.queries.ShadowBanUser(ctx, repository.ShadowBanUserParams{
+		UserID:   userUUID,
+		BannedBy: adminUUID,
+		Reason:   reason,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to shadow-ban user")
+		return nil, fmt.Errorf("failed to shadow-ban user: %w", err)
+	}
+
+	if err := u.queries.CreateAdminAuditEntry(ctx, repository.CreateAdminAuditEntryParams{
+		ActorID:    adminUUID,
+		Action:     string(models.AdminActionShadowBanUser),
+		TargetType: string(models.AuditTargetUser),
+		TargetID:   userUUID,
+		Reason:     stringPtrToPgText(&reason),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record admin action: %w", err)
+	}
+
+	log.Ctx(ctx).Warn().Str("bannedUserId", userID).Str("reason", reason).Msg("User shadow-banned")
+	return convertShadowBanToModel(ban), nil
+}
+
+// LiftShadowBan lifts a user's shadow ban and records it in the admin audit trail. Games they are
+// already waitlisted for promote them on the next roster change. Returns ErrNotFound if the user
+// isn't shadow-banned.
+func (u *UserService) LiftShadowBan(ctx context.Context, adminID string, userID string) error {
+	var adminUUID, userUUID pgtype.UUID
+	if err := adminUUID.Scan(adminID); err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return &InvalidArgumentError{ArgumentName: "userId", Message: "invalid user ID format"}
+	}
+
+	lifted, err := u.queries.LiftShadowBan(ctx, userUUID)
+	if err != nil {
+		return fmt.Errorf("failed to lift shadow ban: %w", err)
+	}
+	if lifted == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	if err := u.queries.CreateAdminAuditEntry(ctx, repository.CreateAdminAuditEntryParams{
+		ActorID:    adminUUID,
+		Action:     string(models.AdminActionLiftShadowBan),
+		TargetType: string(models.AuditTargetUser),
+		TargetID:   userUUID,
+	}); err != nil {
+		return fmt.Errorf("failed to record admin action: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("adminId", adminID).Str("bannedUserId", userID).Msg("User shadow ban lifted")
+	return nil
+}
+
+func convertSuspensionToModel(suspension repository.UserSuspension) *models.Suspension {
+	var suspendedBy *string
+	if suspension.SuspendedBy.Valid {
+		id := uuid.UUID(suspension.SuspendedBy.Bytes).String()
+		suspendedBy = &id
+	}
+	return &models.Suspension{
+		UserID:      uuid.UUID(suspension.UserID.Bytes).String(),
+		Reason:      suspension.Reason,
+		SuspendedBy: suspendedBy,
+		SuspendedAt: suspension.CreatedAt.Time.UTC(),
+	}
+}
+
+func convertShadowBanToModel(ban repository.UserShadowBan) *models.ShadowBan {
+	var bannedBy *string
+	if ban.BannedBy.Valid {
+		id := uuid.UUID(ban.BannedBy.Bytes).String()
+		bannedBy = &id
+	}
+	return &models.ShadowBan{
+		UserID:   uuid.UUID(ban.UserID.Bytes).String(),
+		Reason:   ban.Reason,
+		BannedBy: bannedBy,
+		BannedAt: ban.CreatedAt.Time.UTC(),
+	}
+}
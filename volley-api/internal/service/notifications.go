@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// NotificationTopic groups notifications a participant can mute per game
+type NotificationTopic string
+
+const (
+	// NotificationTopicUpdates covers changes to the game or the participant's spot, like
+	// cancellations and waitlist promotions. It can't be muted.
+	NotificationTopicUpdates NotificationTopic = "updates"
+	// NotificationTopicReminders covers reminders, requests and announcements from the organizer
+	NotificationTopicReminders NotificationTopic = "reminders"
+	// NotificationTopicChat covers game chat messages
+	NotificationTopicChat NotificationTopic = "chat"
+)
+
+// UpdateGameNotificationSettings mutes or unmutes notifications about one game for a participant
+func (s *GamesService) UpdateGameNotificationSettings(ctx context.Context, gameID string, userID string, request models.UpdateGameNotificationSettingsRequest) (*models.GameNotificationSettings, error) {
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	if _, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotParticipant
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	params := repository.UpsertGameNotificationSettingsParams{GameID: gameUUID, UserID: userUUID}
+	if request.RemindersMuted != nil {
+		params.RemindersMuted = pgtype.Bool{Bool: *request.RemindersMuted, Valid: true}
+	}
+	if request.ChatMuted != nil {
+		params.ChatMuted = pgtype.Bool{Bool: *request.ChatMuted, Valid: true}
+	}
+	settings, err := s.queries.UpsertGameNotificationSettings(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notification settings: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Bool("remindersMuted", settings.RemindersMuted).
+		Bool("chatMuted", settings.ChatMuted).
+		Msg("Game notification settings updated")
+	return &models.GameNotificationSettings{
+		GameID:         gameID,
+		RemindersMuted: settings.RemindersMuted,
+		ChatMuted:      settings.ChatMuted,
+	}, nil
+}
+
+// notificationRecipients drops the users who muted the topic for the game. Reminders and chat
+// messages must be addressed through it; updates pass through unfiltered.
+func (s *GamesService) notificationRecipients(ctx context.Context, gameUUID pgtype.UUID, topic NotificationTopic, userUUIDs []pgtype.UUID) ([]pgtype.UUID, error) {
+	if topic == NotificationTopicUpdates || len(userUUIDs) == 0 {
+		return userUUIDs, nil
+	}
+
+	settings, err := s.queries.ListGameNotificationSettings(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification settings: %w", err)
+	}
+	muted := map[pgtype.UUID]bool{}
+	for _, setting := range settings {
+		switch topic {
+		case NotificationTopicReminders:
+			muted[setting.UserID] = setting.RemindersMuted
+		case NotificationTopicChat:
+			muted[setting.UserID] = setting.ChatMuted
+		}
+	}
+
+	recipients := make([]pgtype.UUID, 0, len(userUUIDs))
+	for _, userUUID := range userUUIDs {
+		if muted[userUUID] {
+			log.Ctx(ctx).Debug().
+				Str("userId", uuid.UUID(userUUID.Bytes).String()).
+				Str("topic", string(topic)).
+				Msg("Skipping notification muted for this game")
+			continue
+		}
+		recipients = append(recipients, userUUID)
+	}
+	return recipients, nil
+}
@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/gabe-dev-svc/volley/internal/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// smsLeadTime is how close to the start a game update has to be to also go out by SMS. Further
+// out, a push or email is read in time.
+const smsLeadTime = 6 * time.Hour
+
+// Notifier tells players about changes to their games. Each notification goes out as a push,
+// falling back to a templated email when push can't reach the player. Time-critical ones are also
+// texted to players who opted in to SMS.
+type Notifier struct {
+	queries     ifaces.Querier
+	pushSender  notifications.PushSender
+	emailSender notifications.EmailSender
+	smsSender   notifications.SMSSender
+	appURL      string
+}
+
+func NewNotifier(queries ifaces.Querier, pushSender notifications.PushSender, emailSender notifications.EmailSender, smsSender notifications.SMSSender) *Notifier {
+	return &Notifier{
+		queries:     queries,
+		pushSender:  pushSender,
+		emailSender: emailSender,
+		smsSender:   smsSender,
+		appURL:      DefaultAppURL,
+	}
+}
+
+// SetAppURL sets the base URL of the web app that deep links point to
+func (n *Notifier) SetAppURL(appURL string) {
+	n.appURL = strings.TrimRight(appURL, "/")
+}
+
+// SetNotifier sets where retried cancellation notices are sent (nil only logs them). Other
+// notifications reach the Notifier through its subscription to the event bus.
+func (s *GamesService) SetNotifier(notifier *Notifier) {
+	s.notifier = notifier
+}
+
+// gameLink is the deep link to a game's details
+func (n *Notifier) gameLink(gameID string) string {
+	return fmt.Sprintf("%s/games/%s", n.appURL, gameID)
+}
+
+// conversationLink is the deep link to a direct message conversation
+func (n *Notifier) conversationLink(conversationID string) string {
+	return fmt.Sprintf("%s/conversations/%s", n.appURL, conversationID)
+}
+
+// GameNotification tells a player about one of their games: a push, and the email sent instead
+// when the push can't be delivered. SMS is texted as well when set.
+type GameNotification struct {
+	Push  notifications.PushMessage
+	Email notifications.EmailTemplate
+	Game  notifications.GameEmail
+	SMS   string
+}
+
+// Notify sends notification to recipient by push, or by email if the push fails. The email shows
+// the game's start time in the recipient's timezone. A failed SMS is only logged, since the push
+// or email still carries the news.
+func (n *Notifier) Notify(ctx context.Context, recipient models.User, notification GameNotification) error {
+	logger := log.Ctx(ctx).With().Str("recipientId", recipient.ID).Logger()
+
+	if notification.SMS != "" {
+		n.text(ctx, recipient.ID, notification.SMS)
+	}
+
+	pushErr := n.pushSender.SendPush(ctx, recipient.ID, notification.Push)
+	if pushErr == nil {
+		return nil
+	}
+	if recipient.Email == "" {
+		return fmt.Errorf("failed to send push notification: %w", pushErr)
+	}
+	logger.Warn().Err(pushErr).Msg("Push notification failed, falling back to email")
+
+	wantsEmail, err := n.wantsEmail(ctx, recipient.ID)
+	if err != nil {
+		return err
+	}
+	if !wantsEmail {
+		logger.Info().Msg("Recipient unsubscribed from game emails - skipping email fallback")
+		return nil
+	}
+
+	data := notification.Game
+	data.RecipientName = recipient.FirstName
+	data.StartTime = data.StartTime.In(n.recipientLocation(ctx, recipient.ID))
+	data.Link = notification.Push.Link
+	email, err := notifications.RenderEmail(notification.Email, data, n.unsubscribeLink(recipient.ID))
+	if err != nil {
+		return err
+	}
+	if err := n.emailSender.SendEmail(ctx, recipient.Email, email); err != nil {
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+	return nil
+}
+
+// wantsEmail reports whether the user still gets game emails, which is until they unsubscribe
+func (n *Notifier) wantsEmail(ctx context.Context, userID string) (bool, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return false, fmt.Errorf("invalid user ID: %w", err)
+	}
+	preferences, err := n.queries.GetNotificationPreferences(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	return preferences.EmailEnabled, nil
+}
+
+// unsubscribeLink is the one-click unsubscribe link in the footer of the user's game emails
+func (n *Notifier) unsubscribeLink(userID string) string {
+	token := util.SignUnsubscribeToken(userID, UnsubscribeChannelEmail)
+	return fmt.Sprintf("%s/unsubscribe?token=%s", n.appURL, url.QueryEscape(token))
+}
+
+// text sends body to the user by SMS if they opted in and have a verified phone number
+func (n *Notifier) text(ctx context.Context, userID string, body string) {
+	logger := log.Ctx(ctx).With().Str("recipientId", userID).Logger()
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return
+	}
+	number, err := n.queries.GetSMSNumber(ctx, userUUID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			logger.Error().Err(err).Msg("Failed to get SMS number")
+		}
+		return
+	}
+	if !number.Valid {
+		return
+	}
+	if err := n.smsSender.SendSMS(ctx, number.String, body); err != nil {
+		logger.Error().Err(err).Msg("Failed to send SMS notification")
+	}
+}
+
+// urgentSMS returns body when the game starts within smsLeadTime, so the update is also texted,
+// and "" otherwise
+func urgentSMS(startTime time.Time, body string) string {
+	if time.Until(startTime) > smsLeadTime {
+		return ""
+	}
+	return body
+}
+
+// startsIn says how long until a game starts, e.g. "starts in 3 hours"
+func startsIn(startTime time.Time) string {
+	until := time.Until(startTime)
+	switch {
+	case until <= 0:
+		return "has started"
+	case until < time.Hour:
+		return fmt.Sprintf("starts in %d minutes", max(1, int(until.Minutes())))
+	case until < 90*time.Minute:
+		return "starts in 1 hour"
+	default:
+		return fmt.Sprintf("starts in %d hours", int(until.Round(time.Hour).Hours()))
+	}
+}
+
+// recipientLocation is the timezone the user set during onboarding, or UTC if they haven't set one
+func (n *Notifier) recipientLocation(ctx context.Context, userID string) *time.Location {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return time.UTC
+	}
+
+	settings, err := n.queries.GetUserSettings(ctx, userUUID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Ctx(ctx).Warn().Err(err).Str("recipientId", userID).Msg("Failed to get recipient timezone, using UTC")
+		}
+		return time.UTC
+	}
+	if !settings.Timezone.Valid {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(settings.Timezone.String)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// Subscribe tells players about the game events that concern them
+func (n *Notifier) Subscribe(bus *events.Bus) {
+	events.Subscribe(bus, func(ctx context.Context, event events.ParticipantPromoted) error {
+		return n.notifyPromotion(ctx, event.Game, event.Player)
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.GameCancelled) error {
+		n.sendCancellationNotices(ctx, event.Game, event.Reason, event.Participants)
+		return nil
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.AnnouncementPosted) error {
+		n.sendAnnouncement(ctx, event.Game, event.Message, event.Participants)
+		return nil
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.ContactSharingRequested) error {
+		n.sendContactSharingRequests(ctx, event.Game, event.Participants)
+		return nil
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.MessageSent) error {
+		return n.notifyMessage(ctx, event)
+	})
+}
+
+// notifyPromotion tells a player they got a confirmed spot off the waitlist
+func (n *Notifier) notifyPromotion(ctx context.Context, game events.Game, player models.User) error {
+	email := notificationGame(game)
+	notification := GameNotification{
+		Push: notifications.PushMessage{
+			Title: "You're in!",
+			Body:  fmt.Sprintf("A spot opened up in %s at %s and it's yours. You're now confirmed.", email.GameTitle, email.LocationName),
+			Link:  n.gameLink(game.ID),
+		},
+		Email: notifications.EmailWaitlistPromotion,
+		Game:  email,
+		SMS: urgentSMS(game.StartTime, fmt.Sprintf("Volley: You're off the waitlist for %s at %s. The game %s.",
+			email.GameTitle, email.LocationName, startsIn(game.StartTime))),
+	}
+	if err := n.Notify(ctx, player, notification); err != nil {
+		return fmt.Errorf("failed to send waitlist promotion notification: %w", err)
+	}
+	log.Ctx(ctx).Info().Str("promotedUserId", player.ID).Msg("Waitlist promotion notification sent")
+	return nil
+}
+
+// sendCancellationNotices tells each recipient their game was cancelled. A player who can't be
+// reached is logged and skipped so the others still hear about it.
+func (n *Notifier) sendCancellationNotices(ctx context.Context, game events.Game, reason *string, recipients []models.User) {
+	logger := log.Ctx(ctx)
+	if len(recipients) == 0 {
+		return
+	}
+
+	email := notificationGame(game)
+	body := fmt.Sprintf("Sorry, %s at %s has been cancelled.", email.GameTitle, email.LocationName)
+	if reason != nil {
+		body += fmt.Sprintf(" The host said: \"%s\"", *reason)
+		email.Reason = *reason
+	}
+	notification := GameNotification{
+		Push: notifications.PushMessage{
+			Title: "Game cancelled",
+			Body:  body,
+			Link:  n.gameLink(game.ID),
+		},
+		Email: notifications.EmailGameCancelled,
+		Game:  email,
+		SMS: urgentSMS(game.StartTime, fmt.Sprintf("Volley: %s at %s has been cancelled. Don't head over.",
+			email.GameTitle, email.LocationName)),
+	}
+
+	sent := 0
+	for _, recipient := range recipients {
+		if err := n.Notify(ctx, recipient, notification); err != nil {
+			logger.Error().Err(err).Str("recipientId", recipient.ID).Msg("Failed to send cancellation notification")
+			continue
+		}
+		sent++
+	}
+	logger.Info().Int("sent", sent).Int("participantCount", len(recipients)).Msg("Cancellation notifications sent")
+}
+
+// sendAnnouncement passes an organizer's message on to each recipient. A player who can't be
+// reached is logged and skipped so the others still get it.
+func (n *Notifier) sendAnnouncement(ctx context.Context, game events.Game, message string, recipients []models.User) {
+	logger := log.Ctx(ctx)
+	if len(recipients) == 0 {
+		return
+	}
+
+	email := notificationGame(game)
+	email.Message = message
+	notification := GameNotification{
+		Push: notifications.PushMessage{
+			Title: fmt.Sprintf("Update for %s", email.GameTitle),
+			Body:  message,
+			Link:  n.gameLink(game.ID),
+		},
+		Email: notifications.EmailGameAnnouncement,
+		Game:  email,
+		SMS:   urgentSMS(game.StartTime, fmt.Sprintf("Volley: The host of %s says: \"%s\"", email.GameTitle, message)),
+	}
+
+	sent := 0
+	for _, recipient := range recipients {
+		if err := n.Notify(ctx, recipient, notification); err != nil {
+			logger.Error().Err(err).Str("recipientId", recipient.ID).Msg("Failed to send announcement")
+			continue
+		}
+		sent++
+	}
+	logger.Info().Int("sent", sent).Int("participantCount", len(recipients)).Msg("Announcement sent")
+}
+
+// sendContactSharingRequests asks each recipient to share their phone number with the organizer. A
+// player who can't be reached is logged and skipped so the others are still asked.
+func (n *Notifier) sendContactSharingRequests(ctx context.Context, game events.Game, recipients []models.User) {
+	logger := log.Ctx(ctx)
+	if len(recipients) == 0 {
+		return
+	}
+
+	email := notificationGame(game)
+	notification := GameNotification{
+		Push: notifications.PushMessage{
+			Title: "Share your number?",
+			Body:  fmt.Sprintf("The organizer of %s at %s asked players to share their phone number for day-of coordination.", email.GameTitle, email.LocationName),
+			Link:  n.gameLink(game.ID),
+		},
+		Email: notifications.EmailContactSharingRequest,
+		Game:  email,
+	}
+
+	sent := 0
+	for _, recipient := range recipients {
+		if err := n.Notify(ctx, recipient, notification); err != nil {
+			logger.Error().Err(err).Str("recipientId", recipient.ID).Msg("Failed to send contact sharing request")
+			continue
+		}
+		sent++
+	}
+	logger.Info().Int("sent", sent).Int("participantCount", len(recipients)).Msg("Contact sharing requests sent")
+}
+
+// notifyMessage tells the recipient of a direct message who sent it and what it says. There's no
+// text message, since a conversation could otherwise flood the recipient's phone.
+func (n *Notifier) notifyMessage(ctx context.Context, event events.MessageSent) error {
+	email := notificationGame(event.Game)
+	email.Message = event.Body
+	email.SenderName = event.Sender.FirstName
+	notification := GameNotification{
+		Push: notifications.PushMessage{
+			Title: fmt.Sprintf("Message from %s", event.Sender.FirstName),
+			Body:  event.Body,
+			Link:  n.conversationLink(event.ConversationID),
+		},
+		Email: notifications.EmailDirectMessage,
+		Game:  email,
+	}
+	if err := n.Notify(ctx, event.Recipient, notification); err != nil {
+		return fmt.Errorf("failed to send direct message notification: %w", err)
+	}
+	return nil
+}
+
+// gameEvent describes a game for the events published about it
+func gameEvent(gameUUID pgtype.UUID, ownerUUID pgtype.UUID, title pgtype.Text, category string, locationName string, startTime pgtype.Timestamptz) events.Game {
+	return events.Game{
+		ID:           uuid.UUID(gameUUID.Bytes).String(),
+		OwnerID:      uuid.UUID(ownerUUID.Bytes).String(),
+		Title:        pgTextToStringPtr(title),
+		Category:     category,
+		LocationName: locationName,
+		StartTime:    startTime.Time,
+	}
+}
+
+// notificationGame is what notifications say about a game
+func notificationGame(game events.Game) notifications.GameEmail {
+	return notifications.GameEmail{
+		GameTitle:    gameDisplayTitle(game.Title, game.Category),
+		LocationName: game.LocationName,
+		StartTime:    game.StartTime,
+	}
+}
+
+// gameDisplayTitle is how notifications name a game: its title, or its sport when it has none
+func gameDisplayTitle(title *string, category string) string {
+	if title != nil && *title != "" {
+		return *title
+	}
+	return fmt.Sprintf("the %s game", strings.ReplaceAll(category, "_", " "))
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// GetOnboarding returns the user's onboarding answers. OnboardedAt is nil until they have been saved.
+func (u *UserService) GetOnboarding(ctx context.Context, userID string) (*models.Onboarding, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	preferences, err := u.GetSportPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	onboarding := &models.Onboarding{
+		Sports:       preferences.Sports,
+		Availability: []models.AvailabilityWindow{},
+	}
+
+	row, err := u.queries.GetUserSettings(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return onboarding, nil
+		}
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+	applyOnboardingRow(onboarding, row)
+	return onboarding, nil
+}
+
+// SaveOnboarding replaces the user's sports, availability and travel radius in one call. Everything
+// is validated before anything is written; the writes themselves are not atomic, but each replaces
+// state wholesale, so retrying a failed call converges.
+func (u *UserService) SaveOnboarding(ctx context.Context, userID string, onboarding models.Onboarding) (*models.Onboarding, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	params := repository.SaveUserOnboardingParams{
+		UserID:              userUUID,
+		AvailabilityWindows: make([]string, 0, len(onboarding.Availability)),
+	}
+	seen := map[models.AvailabilityWindow]bool{}
+	for _, window := range onboarding.Availability {
+		if seen[window] {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "availability",
+				Message:      fmt.Sprintf("%s is listed more than once", window),
+			}
+		}
+		seen[window] = true
+		params.AvailabilityWindows = append(params.AvailabilityWindows, string(window))
+	}
+	if onboarding.Timezone != nil {
+		if _, err := time.LoadLocation(*onboarding.Timezone); err != nil || *onboarding.Timezone == "" {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "timezone",
+				Message:      "timezone must be an IANA name such as America/Chicago",
+			}
+		}
+		params.Timezone = pgtype.Text{String: *onboarding.Timezone, Valid: true}
+	} else if len(onboarding.Availability) > 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "timezone",
+			Message:      "timezone is required with availability",
+		}
+	}
+	if onboarding.TravelRadius != nil {
+		if *onboarding.TravelRadius <= 0 {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "travelRadius",
+				Message:      "travelRadius must be positive",
+			}
+		}
+		params.DefaultRadiusMeters = pgtype.Float8{Float64: *onboarding.TravelRadius, Valid: true}
+	}
+
+	preferences, err := u.SetSportPreferences(ctx, userID, models.SportPreferences{Sports: onboarding.Sports})
+	if err != nil {
+		return nil, err
+	}
+	row, err := u.queries.SaveUserOnboarding(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save onboarding: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Int("sports", len(preferences.Sports)).
+		Strs("availability", params.AvailabilityWindows).
+		Msg("Onboarding saved")
+
+	saved := &models.Onboarding{Sports: preferences.Sports}
+	applyOnboardingRow(saved, row)
+	return saved, nil
+}
+
+func applyOnboardingRow(onboarding *models.Onboarding, row repository.UserSetting) {
+	onboarding.Availability = make([]models.AvailabilityWindow, 0, len(row.AvailabilityWindows))
+	for _, window := range row.AvailabilityWindows {
+		onboarding.Availability = append(onboarding.Availability, models.AvailabilityWindow(window))
+	}
+	if row.Timezone.Valid {
+		onboarding.Timezone = &row.Timezone.String
+	}
+	if row.DefaultRadiusMeters.Valid {
+		onboarding.TravelRadius = &row.DefaultRadiusMeters.Float64
+	}
+	if row.OnboardedAt.Valid {
+		onboardedAt := row.OnboardedAt.Time.UTC()
+		onboarding.OnboardedAt = &onboardedAt
+	}
+}
+
+// availabilityWindowAt returns the window t falls in, read in loc
+func availabilityWindowAt(t time.Time, loc *time.Location) models.AvailabilityWindow {
+	local := t.In(loc)
+	weekend := local.Weekday() == time.Saturday || local.Weekday() == time.Sunday
+	switch hour := local.Hour(); {
+	case hour < 12 && weekend:
+		return models.AvailabilityWeekendMornings
+	case hour < 12:
+		return models.AvailabilityWeekdayMornings
+	case hour < 17 && weekend:
+		return models.AvailabilityWeekendAfternoons
+	case hour < 17:
+		return models.AvailabilityWeekdayAfternoons
+	case weekend:
+		return models.AvailabilityWeekendEvenings
+	default:
+		return models.AvailabilityWeekdayEvenings
+	}
+}
+
+// searchDefaults is what recommendations read from the user's saved settings
+type searchDefaults struct {
+	radius       float64                            // 0 when not set
+	availability map[models.AvailabilityWindow]bool // nil means any time
+	location     *time.Location                     // Timezone availability is read in
+}
+
+// userSearchDefaults loads the travel radius and availability the user saved during onboarding
+func (s *GamesService) userSearchDefaults(ctx context.Context, userUUID pgtype.UUID) (searchDefaults, error) {
+	var defaults searchDefaults
+	row, err := s.queries.GetUserSettings(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return defaults, nil
+		}
+		return defaults, fmt.Errorf("failed to get settings: %w", err)
+	}
+	if row.DefaultRadiusMeters.Valid {
+		defaults.radius = row.DefaultRadiusMeters.Float64
+	}
+	if len(row.AvailabilityWindows) > 0 && row.Timezone.Valid {
+		loc, err := time.LoadLocation(row.Timezone.String)
+		if err != nil {
+			// Saved names were validated, so this only happens if tzdata drops one; ignore availability
+			log.Ctx(ctx).Warn().Err(err).Str("timezone", row.Timezone.String).Msg("Unknown saved timezone")
+			return defaults, nil
+		}
+		defaults.location = loc
+		defaults.availability = map[models.AvailabilityWindow]bool{}
+		for _, window := range row.AvailabilityWindows {
+			defaults.availability[models.AvailabilityWindow(window)] = true
+		}
+	}
+	return defaults, nil
+}
+
+// fits reports whether a game starting at startTime falls in the user's availability
+func (d searchDefaults) fits(startTime time.Time) bool {
+	if d.availability == nil {
+		return true
+	}
+	return d.availability[availabilityWindowAt(startTime, d.location)]
+}
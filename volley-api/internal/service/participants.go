@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ParticipantSort is the ordering of a participant page
+type ParticipantSort string
+
+const (
+	ParticipantSortJoinedAt     ParticipantSort = "joinedAt"  // Place in line, earliest sign-ups first unless the host reordered the waitlist (default)
+	ParticipantSortJoinedAtDesc ParticipantSort = "-joinedAt" // Latest sign-ups first
+	ParticipantSortName         ParticipantSort = "name"      // Last name, then first name
+)
+
+// participantSortColumns maps each ParticipantSort to the sort key understood by ListParticipantsByGamePage
+var participantSortColumns = map[ParticipantSort]string{
+	ParticipantSortJoinedAt:     "joined_at",
+	ParticipantSortJoinedAtDesc: "joined_at_desc",
+	ParticipantSortName:         "name",
+}
+
+type ListParticipantsFilters struct {
+	Status *models.ParticipantStatus // Only return participants with this status
+	Sort   ParticipantSort           // Ordering (default joinedAt)
+	Limit  int                       // Number of results to return (default 50, max 100)
+	Offset int                       // Number of results to skip (default 0)
+}
+
+// ListParticipants returns one page of a game's participants, including dropped and removed ones.
+// The host also sees why participants dropped and their recent no-shows.
+// Waitlist positions are counted across the whole waitlist, so they stay correct on every page.
+// Minors are left out unless the viewer is the organizer or the minor, the same as GetGame.
+func (s *GamesService) ListParticipants(ctx context.Context, gameID string, viewerID string, filters ListParticipantsFilters) (*models.ListParticipantsResponse, error) {
+	var gameUUID, viewerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := viewerUUID.Scan(viewerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	if filters.Sort == "" {
+		filters.Sort = ParticipantSortJoinedAt
+	}
+	sortColumn, ok := participantSortColumns[filters.Sort]
+	if !ok {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "sort",
+			Message:      "sort must be one of: joinedAt, -joinedAt, name",
+		}
+	}
+
+	var status pgtype.Text
+	if filters.Status != nil {
+		switch *filters.Status {
+		case models.ParticipantStatusConfirmed, models.ParticipantStatusWaitlist, models.ParticipantStatusDropped,
+			models.ParticipantStatusDeclined, models.ParticipantStatusRemoved:
+			status = pgtype.Text{String: string(*filters.Status), Valid: true}
+		default:
+			return nil, &InvalidArgumentError{
+				ArgumentName: "status",
+				Message:      "status must be one of: confirmed, waitlist, dropped, declined, removed",
+			}
+		}
+	}
+
+	if filters.Limit < 0 || filters.Offset < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "limit",
+			Message:      "limit and offset must be non-negative",
+		}
+	}
+	if filters.Limit == 0 {
+		filters.Limit = 50
+	}
+	if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	// Fetch one extra row to learn whether another page follows
+	rows, err := s.queries.ListParticipantsByGamePage(ctx, repository.ListParticipantsByGamePageParams{
+		GameID:        gameUUID,
+		Status:        status,
+		IncludeMinors: game.OwnerID == viewerUUID,
+		ViewerID:      viewerUUID,
+		Sort:          sortColumn,
+		PageLimit:     int32(filters.Limit + 1),
+		PageOffset:    int32(filters.Offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+
+	hasMore := len(rows) > filters.Limit
+	if hasMore {
+		rows = rows[:filters.Limit]
+	}
+
+	participants := make([]models.Participant, 0, len(rows))
+	for _, row := range rows {
+		var position *int
+		if row.WaitlistPosition.Valid {
+			p := int(row.WaitlistPosition.Int64)
+			position = &p
+		}
+		participant := convertParticipantDetailToModel(repository.ParticipantDetail{
+			ID:                 row.ID,
+			GameID:             row.GameID,
+			UserID:             row.UserID,
+			TeamID:             row.TeamID,
+			Status:             row.Status,
+			Paid:               row.Paid,
+			PaymentAmountCents: row.PaymentAmountCents,
+			Notes:              row.Notes,
+			JoinedAt:           row.JoinedAt,
+			UpdatedAt:          row.UpdatedAt,
+			Email:              row.Email,
+			FirstName:          row.FirstName,
+			LastName:           row.LastName,
+			Birthdate:          row.Birthdate,
+			CheckedInAt:        row.CheckedInAt,
+			Position:           row.Position,
+		}, position)
+		// Drop reasons are for the host to understand churn, not for other players
+		if game.OwnerID == viewerUUID {
+			if row.DropReason.Valid {
+				reason := models.DropReason(row.DropReason.String)
+				participant.DropReason = &reason
+			}
+			participant.LateDrop = row.LateDrop
+		}
+		participants = append(participants, *participant)
+	}
+	if models.PricingType(game.PricingType) == models.PricingTypeTotal {
+		confirmed, err := s.queries.CountConfirmedParticipants(ctx, gameUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count confirmed participants: %w", err)
+		}
+		attachShares(totalShareCents(game.PricingType, game.PricingAmountCents, confirmed), participants)
+	}
+	if game.OwnerID == viewerUUID {
+		if err := s.attachAttendanceSummaries(ctx, participants); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.attachReliabilityScores(ctx, participants); err != nil {
+		return nil, err
+	}
+	if err := s.attachSkillConfidences(ctx, participants); err != nil {
+		return nil, err
+	}
+
+	response := &models.ListParticipantsResponse{
+		Participants: participants,
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		HasMore:      hasMore,
+	}
+	if hasMore {
+		next := filters.Offset + filters.Limit
+		response.NextOffset = &next
+	}
+	return response, nil
+}
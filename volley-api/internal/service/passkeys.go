@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/internal/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// passkeyChallengeTTL is how long a passkey ceremony can take before its challenge expires
+	passkeyChallengeTTL = 5 * time.Minute
+	// passkeyRPName is the service name authenticators show when creating a passkey
+	passkeyRPName = "Volley"
+)
+
+// COSE algorithm identifiers accepted for passkeys: ES256, EdDSA and RS256
+var passkeyAlgorithms = []int{-7, -8, -257}
+
+var ErrInvalidPasskey = errors.New("invalid or expired passkey response")
+
+// WebAuthnConfig identifies this deployment as a WebAuthn relying party
+type WebAuthnConfig struct {
+	RPID    string   // Domain passkeys are bound to, e.g. "volley.gg"
+	Origins []string // Web origins allowed to run ceremonies, e.g. "https://app.volley.gg"
+}
+
+// SetWebAuthnConfig sets the relying party passkeys are registered for. Without it the host and
+// origin of the app URL are used.
+func (u *UserService) SetWebAuthnConfig(config WebAuthnConfig) {
+	u.webAuthn = config
+}
+
+// webAuthnConfig returns the configured relying party, falling back to the app URL
+func (u *UserService) webAuthnConfig() WebAuthnConfig {
+	config := u.webAuthn
+	if config.RPID == "" {
+		if appURL, err := url.Parse(u.appURL); err == nil {
+			config.RPID = appURL.Hostname()
+		}
+	}
+	if len(config.Origins) == 0 {
+		config.Origins = []string{u.appURL}
+	}
+	return config
+}
+
+// BeginPasskeyRegistration issues the options a browser needs to create a passkey for the user
+func (u *UserService) BeginPasskeyRegistration(ctx context.Context, userID string) (*models.PasskeyCreationOptions, error) {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{ArgumentName: "userId", Message: "invalid user ID"}
+	}
+
+	dbUser, err := u.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	credentials, err := u.queries.ListWebAuthnCredentialsByUser(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list passkeys: %w", err)
+	}
+
+	challenge, err := u.createPasskeyChallenge(ctx, userUUID, util.WebAuthnCeremonyCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	config := u.webAuthnConfig()
+	options := &models.PasskeyCreationOptions{
+		Challenge: challenge,
+		RP:        models.PasskeyRelyingParty{ID: config.RPID, Name: passkeyRPName},
+		User: models.PasskeyUser{
+			ID:          base64.RawURLEncoding.EncodeToString(userUUID.Bytes[:]),
+			Name:        dbUser.Email,
+			DisplayName: strings.TrimSpace(dbUser.FirstName + " " + dbUser.LastName),
+		},
+		Timeout: passkeyChallengeTTL.Milliseconds(),
+		// One passkey per authenticator
+		ExcludeCredentials: make([]models.PasskeyCredentialDescriptor, 0, len(credentials)),
+		AuthenticatorSelection: models.PasskeyAuthenticatorSelection{
+			ResidentKey:      "required",
+			UserVerification: "preferred",
+		},
+		Attestation: "none",
+	}
+	for _, alg := range passkeyAlgorithms {
+		options.PubKeyCredParams = append(options.PubKeyCredParams, models.PasskeyCredentialParameter{Type: "public-key", Alg: alg})
+	}
+	for _, credential := range credentials {
+		options.ExcludeCredentials = append(options.ExcludeCredentials, models.PasskeyCredentialDescriptor{
+			Type: "public-key",
+			ID:   base64.RawURLEncoding.EncodeToString(credential.CredentialID),
+		})
+	}
+
+	logger.Info().Str("userID", userID).Msg("Passkey registration started")
+	return options, nil
+}
+
+// FinishPasskeyRegistration verifies the browser's response to BeginPasskeyRegistration and stores
+// the new passkey. Attestation is not requested, so the authenticator's make is not checked.
+func (u *UserService) FinishPasskeyRegistration(ctx context.Context, userID string, req models.PasskeyRegistrationRequest) (*models.Passkey, error) {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{ArgumentName: "userId", Message: "invalid user ID"}
+	}
+
+	credentialID, err := decodePasskeyField("rawId", req.RawID)
+	if err != nil {
+		return nil, err
+	}
+	clientDataJSON, err := decodePasskeyField("clientDataJSON", req.Response.ClientDataJSON)
+	if err != nil {
+		return nil, err
+	}
+	authenticatorData, err := decodePasskeyField("authenticatorData", req.Response.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := decodePasskeyField("publicKey", req.Response.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	config := u.webAuthnConfig()
+	clientData, err := util.ParseWebAuthnClientData(clientDataJSON, util.WebAuthnCeremonyCreate, config.Origins)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Rejected passkey registration")
+		return nil, ErrInvalidPasskey
+	}
+	challenge, err := u.consumePasskeyChallenge(ctx, clientData.Challenge, util.WebAuthnCeremonyCreate)
+	if err != nil {
+		return nil, err
+	}
+	if challenge.UserID != userUUID {
+		logger.Warn().Str("userID", userID).Msg("Passkey registration answered another user's challenge")
+		return nil, ErrInvalidPasskey
+	}
+
+	authData, err := util.ParseWebAuthnAuthenticatorData(authenticatorData, config.RPID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Rejected passkey registration")
+		return nil, ErrInvalidPasskey
+	}
+	if string(authData.CredentialID) != string(credentialID) {
+		logger.Warn().Msg("Passkey credential ID does not match authenticator data")
+		return nil, ErrInvalidPasskey
+	}
+	if err := util.ValidateWebAuthnPublicKey(publicKey); err != nil {
+		logger.Warn().Err(err).Msg("Rejected passkey registration")
+		return nil, ErrInvalidPasskey
+	}
+
+	if _, err := u.queries.GetWebAuthnCredential(ctx, credentialID); err == nil {
+		return nil, fmt.Errorf("passkey: %w", apperrors.ErrAlreadyExists)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get passkey: %w", err)
+	}
+
+	credential, err := u.queries.CreateWebAuthnCredential(ctx, repository.CreateWebAuthnCredentialParams{
+		UserID:       userUUID,
+		CredentialID: credentialID,
+		PublicKey:    publicKey,
+		SignCount:    int64(authData.SignCount),
+		Name:         stringPtrToPgText(req.Name),
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to store passkey")
+		return nil, fmt.Errorf("failed to store passkey: %w", err)
+	}
+
+	logger.Info().Str("userID", userID).Msg("Passkey registered")
+	return convertPasskeyToModel(credential), nil
+}
+
+// BeginPasskeyLogin issues the options a browser needs to sign in with any passkey for this site
+func (u *UserService) BeginPasskeyLogin(ctx context.Context) (*models.PasskeyRequestOptions, error) {
+	// Sign-in challenges are issued to anyone, so clear out abandoned ones as new ones are made
+	if err := u.queries.DeleteExpiredWebAuthnChallenges(ctx); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Failed to delete expired passkey challenges")
+	}
+
+	challenge, err := u.createPasskeyChallenge(ctx, pgtype.UUID{}, util.WebAuthnCeremonyGet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PasskeyRequestOptions{
+		Challenge:        challenge,
+		RPID:             u.webAuthnConfig().RPID,
+		Timeout:          passkeyChallengeTTL.Milliseconds(),
+		UserVerification: "preferred",
+		AllowCredentials: []models.PasskeyCredentialDescriptor{},
+	}, nil
+}
+
+// FinishPasskeyLogin verifies a passkey assertion and returns the user it belongs to
+func (u *UserService) FinishPasskeyLogin(ctx context.Context, req models.PasskeyLoginRequest) (*models.User, error) {
+	logger := log.Ctx(ctx)
+
+	credentialID, err := decodePasskeyField("rawId", req.RawID)
+	if err != nil {
+		return nil, err
+	}
+	clientDataJSON, err := decodePasskeyField("clientDataJSON", req.Response.ClientDataJSON)
+	if err != nil {
+		return nil, err
+	}
+	authenticatorData, err := decodePasskeyField("authenticatorData", req.Response.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := decodePasskeyField("signature", req.Response.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	config := u.webAuthnConfig()
+	clientData, err := util.ParseWebAuthnClientData(clientDataJSON, util.WebAuthnCeremonyGet, config.Origins)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Rejected passkey sign-in")
+		return nil, ErrInvalidPasskey
+	}
+	if _, err := u.consumePasskeyChallenge(ctx, clientData.Challenge, util.WebAuthnCeremonyGet); err != nil {
+		return nil, err
+	}
+
+	credential, err := u.queries.GetWebAuthnCredential(ctx, credentialID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidPasskey
+		}
+		return nil, fmt.Errorf("failed to get passkey: %w", err)
+	}
+	if req.Response.UserHandle != nil && *req.Response.UserHandle != "" {
+		userHandle, err := decodePasskeyField("userHandle", *req.Response.UserHandle)
+		if err != nil {
+			return nil, err
+		}
+		if string(userHandle) != string(credential.UserID.Bytes[:]) {
+			logger.Warn().Msg("Passkey user handle does not match the credential owner")
+			return nil, ErrInvalidPasskey
+		}
+	}
+
+	authData, err := util.ParseWebAuthnAuthenticatorData(authenticatorData, config.RPID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Rejected passkey sign-in")
+		return nil, ErrInvalidPasskey
+	}
+	if err := util.VerifyWebAuthnSignature(credential.PublicKey, authenticatorData, clientDataJSON, signature); err != nil {
+		logger.Warn().Err(err).Msg("Rejected passkey sign-in")
+		return nil, ErrInvalidPasskey
+	}
+
+	// A counter that doesn't move forward means the authenticator may have been cloned. Authenticators
+	// that don't keep a counter always report zero.
+	signCount := int64(authData.SignCount)
+	if (signCount != 0 || credential.SignCount != 0) && signCount <= credential.SignCount {
+		logger.Warn().Str("userID", credential.UserID.String()).Msg("Passkey signature counter went backwards")
+		return nil, ErrInvalidPasskey
+	}
+
+	if err := u.queries.UpdateWebAuthnCredentialUsage(ctx, repository.UpdateWebAuthnCredentialUsageParams{
+		ID:        credential.ID,
+		SignCount: signCount,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record passkey use: %w", err)
+	}
+
+	dbUser, err := u.queries.GetUserByID(ctx, credential.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := u.rejectDeactivated(ctx, dbUser.ID); err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("userID", dbUser.ID.String()).Msg("User signed in with passkey")
+	return convertUserToModel(dbUser), nil
+}
+
+// createPasskeyChallenge stores a fresh random challenge for a ceremony and returns it base64url encoded
+func (u *UserService) createPasskeyChallenge(ctx context.Context, userID pgtype.UUID, ceremony string) (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate passkey challenge: %w", err)
+	}
+	challenge := base64.RawURLEncoding.EncodeToString(bytes)
+
+	if err := u.queries.CreateWebAuthnChallenge(ctx, repository.CreateWebAuthnChallengeParams{
+		UserID:    userID,
+		Ceremony:  ceremony,
+		Challenge: challenge,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(passkeyChallengeTTL), Valid: true},
+	}); err != nil {
+		return "", fmt.Errorf("failed to store passkey challenge: %w", err)
+	}
+	return challenge, nil
+}
+
+// consumePasskeyChallenge deletes an unexpired challenge so it can't be answered twice
+func (u *UserService) consumePasskeyChallenge(ctx context.Context, challenge string, ceremony string) (repository.WebauthnChallenge, error) {
+	stored, err := u.queries.ConsumeWebAuthnChallenge(ctx, repository.ConsumeWebAuthnChallengeParams{
+		Challenge: challenge,
+		Ceremony:  ceremony,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.WebauthnChallenge{}, ErrInvalidPasskey
+		}
+		return repository.WebauthnChallenge{}, fmt.Errorf("failed to get passkey challenge: %w", err)
+	}
+	return stored, nil
+}
+
+// decodePasskeyField decodes a base64url field of a WebAuthn JSON response, with or without padding
+func decodePasskeyField(name string, value string) ([]byte, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil || len(decoded) == 0 {
+		return nil, &InvalidArgumentError{ArgumentName: name, Message: name + " must be base64url encoded"}
+	}
+	return decoded, nil
+}
+
+func convertPasskeyToModel(credential repository.WebauthnCredential) *models.Passkey {
+	return &models.Passkey{
+		ID:         base64.RawURLEncoding.EncodeToString(credential.CredentialID),
+		Name:       pgTextToStringPtr(credential.Name),
+		CreatedAt:  credential.CreatedAt.Time,
+		LastUsedAt: pgTimestamptzToTimePtr(credential.LastUsedAt),
+	}
+}
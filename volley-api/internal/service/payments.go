@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// MarkPayment records whether a participant of the owner's game paid, and how much, and returns the
+// updated game. An omitted amount keeps the one on record, and marking someone unpaid clears it.
+// Payments can be recorded for confirmed and waitlisted players, including after the game; changes
+// to a completed game that need an audit trail go through CorrectParticipation instead.
+func (s *GamesService) MarkPayment(ctx context.Context, gameID string, ownerID string, userID string, request models.MarkPaymentRequest) (*models.Game, error) {
+	var gameUUID, ownerUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if request.Paid == nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "paid",
+			Message:      "paid is required",
+		}
+	}
+	if request.PaymentAmountCents != nil && *request.PaymentAmountCents < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "paymentAmountCents",
+			Message:      "paymentAmountCents must be non-negative",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.OwnerID != ownerUUID {
+		return nil, ErrNotOwner
+	}
+	if game.Status == string(models.GameStatusCancelled) {
+		return nil, ErrGameNotEditable
+	}
+
+	participant, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotParticipant
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if InactiveParticipantStates[participant.Status] {
+		return nil, ErrNotParticipant
+	}
+
+	amount := participant.PaymentAmountCents
+	switch {
+	case !*request.Paid:
+		amount = pgtype.Int4{}
+	case request.PaymentAmountCents != nil:
+		amount = pgtype.Int4{Int32: int32(*request.PaymentAmountCents), Valid: true}
+	}
+
+	if _, err := s.queries.UpdateParticipantPayment(ctx, repository.UpdateParticipantPaymentParams{
+		ID:                 participant.ID,
+		Paid:               *request.Paid,
+		PaymentAmountCents: amount,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Bool("paid", *request.Paid).Msg("Payment recorded")
+	return s.GetGame(ctx, gameID, ownerID)
+}
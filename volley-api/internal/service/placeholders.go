@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// Placeholders are participants added by the host by name only, for friends who aren't on the app.
+// They take a spot (or a waitlist position) like anyone else until removed or linked to an account.
+
+// lockOwnedGame locks a game for a roster change by its owner. Cancelled and completed games are rejected.
+func lockOwnedGame(ctx context.Context, q ifaces.Querier, gameUUID, ownerUUID pgtype.UUID) (repository.GetGameForUpdateRow, error) {
+	game, err := q.GetGameForUpdate(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return game, apperrors.ErrNotFound
+		}
+		return game, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.OwnerID != ownerUUID {
+		return game, ErrNotOwner
+	}
+	if game.Status == string(models.GameStatusCancelled) || game.Status == string(models.GameStatusCompleted) {
+		return game, ErrGameNotEditable
+	}
+	return game, nil
+}
+
+// getPlaceholder returns a game's placeholder participant, or ErrNotFound if the ID is not an unlinked placeholder of that game
+func getPlaceholder(ctx context.Context, q ifaces.Querier, gameUUID, placeholderUUID pgtype.UUID) (repository.Participant, error) {
+	participant, err := q.GetParticipant(ctx, placeholderUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return participant, apperrors.ErrNotFound
+		}
+		return participant, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.GameID != gameUUID || participant.UserID.Valid {
+		return participant, apperrors.ErrNotFound
+	}
+	return participant, nil
+}
+
+// AddPlaceholder adds a name-only participant to the owner's game and returns the updated game.
+// The placeholder is confirmed if there is an open spot and waitlisted otherwise.
+func (s *GamesService) AddPlaceholder(ctx context.Context, gameID string, ownerID string, name string) (*models.Game, error) {
+	var gameUUID, ownerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		game, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID)
+		if err != nil {
+			return err
+		}
+
+		confirmed, err := q.CountConfirmedParticipants(ctx, gameUUID)
+		if err != nil {
+			return fmt.Errorf("failed to count confirmed participants: %w", err)
+		}
+		spots, err := openSpots(ctx, q, gameUUID, game.MaxParticipants)
+		if err != nil {
+			return err
+		}
+		status := models.ParticipantStatusConfirmed
+		if confirmed >= int64(spots) {
+			status = models.ParticipantStatusWaitlist
+		}
+
+		if _, err := q.CreatePlaceholderParticipant(ctx, repository.CreatePlaceholderParticipantParams{
+			GameID:          gameUUID,
+			PlaceholderName: pgtype.Text{String: name, Valid: true},
+			Status:          string(status),
+		}); err != nil {
+			return fmt.Errorf("failed to create placeholder: %w", err)
+		}
+
+		return syncCapacityStatus(ctx, q, gameUUID, game.Status, game.MaxParticipants)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Msg("Placeholder added to game")
+	return s.GetGame(ctx, gameID, ownerID)
+}
+
+// RemovePlaceholder deletes a placeholder from the owner's game, promotes from the waitlist if it
+// held a spot, and returns the updated game
+func (s *GamesService) RemovePlaceholder(ctx context.Context, gameID string, ownerID string, placeholderID string) (*models.Game, error) {
+	var gameUUID, ownerUUID, placeholderUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := placeholderUUID.Scan(placeholderID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "placeholder_id",
+			Message:      "invalid placeholder ID format",
+		}
+	}
+
+	var game repository.GetGameForUpdateRow
+	var wasConfirmed bool
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		var err error
+		game, err = lockOwnedGame(ctx, q, gameUUID, ownerUUID)
+		if err != nil {
+			return err
+		}
+
+		placeholder, err := getPlaceholder(ctx, q, gameUUID, placeholderUUID)
+		if err != nil {
+			return err
+		}
+		wasConfirmed = placeholder.Status == string(models.ParticipantStatusConfirmed)
+
+		if err := q.DeleteParticipant(ctx, placeholder.ID); err != nil {
+			return fmt.Errorf("failed to delete placeholder: %w", err)
+		}
+
+		return syncCapacityStatus(ctx, q, gameUUID, game.Status, game.MaxParticipants)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Msg("Placeholder removed from game")
+
+	if wasConfirmed {
+		if _, err := s.reconcileParticipantStatuses(ctx, gameUUID, game.MaxParticipants); err != nil {
+			// The placeholder is already gone, so just log the error like a drop would
+			log.Ctx(ctx).Error().Err(err).Msg("Failed to reconcile participant statuses after placeholder removal")
+			s.enqueueSideEffect(ctx, SideEffectReconcileRoster, gameUUID, err)
+		}
+	}
+
+	return s.GetGame(ctx, gameID, ownerID)
+}
+
+// LinkPlaceholder turns a placeholder into a real participant for the given account, keeping its
+// place in line, and returns the updated game. A previous dropped or removed record of that user
+// in the game is replaced; an active one is a conflict.
+func (s *GamesService) LinkPlaceholder(ctx context.Context, gameID string, ownerID string, placeholderID string, request models.LinkPlaceholderRequest) (*models.Game, error) {
+	var gameUUID, ownerUUID, placeholderUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := placeholderUUID.Scan(placeholderID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "placeholder_id",
+			Message:      "invalid placeholder ID format",
+		}
+	}
+	if (request.UserID == nil) == (request.Email == nil) {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "userId",
+			Message:      "exactly one of userId or email is required",
+		}
+	}
+
+	var user repository.User
+	var err error
+	if request.UserID != nil {
+		var userUUID pgtype.UUID
+		if err := userUUID.Scan(*request.UserID); err != nil {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "userId",
+				Message:      "invalid user ID format",
+			}
+		}
+		user, err = s.queries.GetUserByID(ctx, userUUID)
+	} else {
+		user, err = s.queries.GetUserByEmail(ctx, *request.Email)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("account to link: %w", apperrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		game, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID)
+		if err != nil {
+			return err
+		}
+
+		placeholder, err := getPlaceholder(ctx, q, gameUUID, placeholderUUID)
+		if err != nil {
+			return err
+		}
+
+		if game.AdultOnly && isMinor(user.Birthdate, time.Now()) {
+			return ErrAgeRestricted
+		}
+
+		existing, err := q.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+			GameID: gameUUID,
+			UserID: user.ID,
+		})
+		switch {
+		case err == nil && !InactiveParticipantStates[existing.Status]:
+			return ErrAlreadyParticipant
+		case err == nil:
+			if err := q.DeleteParticipant(ctx, existing.ID); err != nil {
+				return fmt.Errorf("failed to delete previous participation: %w", err)
+			}
+		case !errors.Is(err, pgx.ErrNoRows):
+			return fmt.Errorf("failed to get participant: %w", err)
+		}
+
+		if _, err := q.LinkPlaceholderParticipant(ctx, repository.LinkPlaceholderParticipantParams{
+			UserID: user.ID,
+			ID:     placeholder.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to link placeholder: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Msg("Placeholder linked to account")
+	return s.GetGame(ctx, gameID, ownerID)
+}
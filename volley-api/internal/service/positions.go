@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// validateGamePositions checks the positions of a create request: names are unique and no position
+// holds more players than the game
+func validateGamePositions(positions []models.GamePosition, maxParticipants int) error {
+	seen := make(map[string]bool, len(positions))
+	for _, position := range positions {
+		name := strings.TrimSpace(position.Name)
+		if name == "" {
+			return &InvalidArgumentError{
+				ArgumentName: "positions",
+				Message:      "position names must not be empty",
+			}
+		}
+		if seen[name] {
+			return &InvalidArgumentError{
+				ArgumentName: "positions",
+				Message:      fmt.Sprintf("position %q is listed more than once", name),
+			}
+		}
+		seen[name] = true
+		if position.Capacity < 1 || position.Capacity > maxParticipants {
+			return &InvalidArgumentError{
+				ArgumentName: "positions",
+				Message:      fmt.Sprintf("position %q capacity must be between 1 and maxParticipants", name),
+			}
+		}
+	}
+	return nil
+}
+
+// createGamePositions stores the positions of a new game. Call it in the transaction that creates the game.
+func createGamePositions(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID, positions []models.GamePosition) ([]repository.GamePosition, error) {
+	created := make([]repository.GamePosition, 0, len(positions))
+	for _, position := range positions {
+		params := repository.CreateGamePositionParams{
+			GameID:   gameUUID,
+			Name:     strings.TrimSpace(position.Name),
+			Capacity: int32(position.Capacity),
+		}
+		if err := q.CreateGamePosition(ctx, params); err != nil {
+			return nil, fmt.Errorf("failed to create game position: %w", err)
+		}
+		created = append(created, repository.GamePosition(params))
+	}
+	return created, nil
+}
+
+// positionCapacities returns the capacity of each of a game's positions, keyed by name. Games
+// without positions return an empty map.
+func positionCapacities(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID) (map[string]int32, error) {
+	positions, err := q.ListGamePositions(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game positions: %w", err)
+	}
+	capacities := make(map[string]int32, len(positions))
+	for _, position := range positions {
+		capacities[position.Name] = position.Capacity
+	}
+	return capacities, nil
+}
+
+// resolvePosition checks the position a player asked to join as against the game's positions.
+// Games with positions require one; games without reject it.
+func resolvePosition(position *string, capacities map[string]int32) (pgtype.Text, error) {
+	if len(capacities) == 0 {
+		if position != nil {
+			return pgtype.Text{}, &InvalidArgumentError{
+				ArgumentName: "position",
+				Message:      "this game does not take sign-ups by position",
+			}
+		}
+		return pgtype.Text{}, nil
+	}
+
+	if position == nil {
+		return pgtype.Text{}, &InvalidArgumentError{
+			ArgumentName: "position",
+			Message:      "a position is required to join this game",
+		}
+	}
+	name := strings.TrimSpace(*position)
+	if _, ok := capacities[name]; !ok {
+		return pgtype.Text{}, &InvalidArgumentError{
+			ArgumentName: "position",
+			Message:      fmt.Sprintf("this game has no position %q", name),
+		}
+	}
+	return pgtype.Text{String: name, Valid: true}, nil
+}
+
+// rosterAllocation hands out confirmed spots in sign-up order, up to the game's maximum and each
+// position's capacity. Players without a position (placeholders, sign-ups from before the game had
+// positions) only count against the maximum.
+type rosterAllocation struct {
+	maxParticipants int32
+	capacities      map[string]int32
+	confirmed       int32
+	byPosition      map[string]int32
+}
+
+func newRosterAllocation(maxParticipants int32, capacities map[string]int32) *rosterAllocation {
+	return &rosterAllocation{
+		maxParticipants: maxParticipants,
+		capacities:      capacities,
+		byPosition:      map[string]int32{},
+	}
+}
+
+// hasRoom reports whether a player in position would get a confirmed spot
+func (a *rosterAllocation) hasRoom(position pgtype.Text) bool {
+	if a.confirmed >= a.maxParticipants {
+		return false
+	}
+	if capacity, ok := a.capacities[position.String]; position.Valid && ok {
+		return a.byPosition[position.String] < capacity
+	}
+	return true
+}
+
+// allocate gives the next player in line a confirmed spot if there is room and reports whether it did
+func (a *rosterAllocation) allocate(position pgtype.Text) bool {
+	if !a.hasRoom(position) {
+		return false
+	}
+	a.confirmed++
+	if position.Valid {
+		a.byPosition[position.String]++
+	}
+	return true
+}
+
+// convertGamePositions lists a game's positions with how many confirmed participants each has
+func convertGamePositions(positions []repository.GamePosition, confirmedByPosition map[string]int) []models.GamePosition {
+	if len(positions) == 0 {
+		return nil
+	}
+	result := make([]models.GamePosition, 0, len(positions))
+	for _, position := range positions {
+		result = append(result, models.GamePosition{
+			Name:      position.Name,
+			Capacity:  int(position.Capacity),
+			Confirmed: confirmedByPosition[position.Name],
+		})
+	}
+	return result
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/internal/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// GetSportPreferences returns the sports the user wants to play, by category
+func (u *UserService) GetSportPreferences(ctx context.Context, userID string) (*models.SportPreferences, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	rows, err := u.queries.ListUserSportPreferences(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sport preferences: %w", err)
+	}
+
+	preferences := &models.SportPreferences{Sports: make([]models.SportPreference, 0, len(rows))}
+	for _, row := range rows {
+		preference := models.SportPreference{Category: models.GameCategory(row.Category)}
+		if row.SkillLevel.Valid {
+			level := models.SkillLevel(row.SkillLevel.String)
+			preference.SkillLevel = &level
+		}
+		preferences.Sports = append(preferences.Sports, preference)
+	}
+	return preferences, nil
+}
+
+// SetSportPreferences replaces the sports the user wants to play. Each sport may appear once; an empty
+// list clears them, which brings back the defaults they steer.
+func (u *UserService) SetSportPreferences(ctx context.Context, userID string, preferences models.SportPreferences) (*models.SportPreferences, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	params := repository.ReplaceUserSportPreferencesParams{
+		UserID:      userUUID,
+		Categories:  make([]string, 0, len(preferences.Sports)),
+		SkillLevels: make([]string, 0, len(preferences.Sports)),
+	}
+	seen := map[models.GameCategory]bool{}
+	for _, sport := range preferences.Sports {
+		if seen[sport.Category] {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "sports",
+				Message:      fmt.Sprintf("%s is listed more than once", sport.Category),
+			}
+		}
+		seen[sport.Category] = true
+		if sport.SkillLevel != nil && skillRank(*sport.SkillLevel) < 0 {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "skillLevel",
+				Message:      "skill level must be beginner, intermediate or advanced",
+			}
+		}
+
+		params.Categories = append(params.Categories, string(sport.Category))
+		level := ""
+		if sport.SkillLevel != nil {
+			level = string(*sport.SkillLevel)
+		}
+		params.SkillLevels = append(params.SkillLevels, level)
+	}
+
+	if err := u.queries.ReplaceUserSportPreferences(ctx, params); err != nil {
+		return nil, fmt.Errorf("failed to save sport preferences: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Strs("categories", params.Categories).Msg("Sport preferences updated")
+	return u.GetSportPreferences(ctx, userID)
+}
+
+// UnsubscribeChannelEmail is the channel in the unsubscribe links in game email footers
+const UnsubscribeChannelEmail = "email"
+
+// GetNotificationPreferences returns how the user wants to hear about their games. Users who never
+// set them get the defaults: email on and SMS off.
+func (u *UserService) GetNotificationPreferences(ctx context.Context, userID string) (*models.NotificationPreferences, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	preferences := &models.NotificationPreferences{EmailEnabled: true}
+	row, err := u.queries.GetNotificationPreferences(ctx, userUUID)
+	switch {
+	case err == nil:
+		preferences.EmailEnabled = row.EmailEnabled
+		preferences.SMSEnabled = row.SmsEnabled
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	switch _, err := u.RequireVerifiedPhone(ctx, userID); {
+	case err == nil:
+		preferences.PhoneVerified = true
+	case !errors.Is(err, ErrPhoneNotVerified):
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// UpdateNotificationPreferences changes how the user wants to hear about their games. SMS can only
+// be turned on with a verified phone number.
+func (u *UserService) UpdateNotificationPreferences(ctx context.Context, userID string, request models.UpdateNotificationPreferencesRequest) (*models.NotificationPreferences, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	params := repository.UpsertNotificationPreferencesParams{UserID: userUUID}
+	if request.SMSEnabled != nil {
+		if *request.SMSEnabled {
+			if _, err := u.RequireVerifiedPhone(ctx, userID); err != nil {
+				return nil, err
+			}
+		}
+		params.SmsEnabled = pgtype.Bool{Bool: *request.SMSEnabled, Valid: true}
+	}
+	if request.EmailEnabled != nil {
+		params.EmailEnabled = pgtype.Bool{Bool: *request.EmailEnabled, Valid: true}
+	}
+	row, err := u.queries.UpsertNotificationPreferences(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notification preferences: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Bool("emailEnabled", row.EmailEnabled).
+		Bool("smsEnabled", row.SmsEnabled).
+		Msg("Notification preferences updated")
+	return u.GetNotificationPreferences(ctx, userID)
+}
+
+// Unsubscribe turns off the channel an unsubscribe link was signed for, without the user signing
+// in. Links keep working after use, and for accounts that have since been deleted.
+func (u *UserService) Unsubscribe(ctx context.Context, token string) (*models.Unsubscribed, error) {
+	userID, channel, err := util.ParseUnsubscribeToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if channel != UnsubscribeChannelEmail {
+		return nil, util.ErrInvalidUnsubscribeToken
+	}
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, util.ErrInvalidUnsubscribeToken
+	}
+
+	if _, err := u.queries.GetUserByID(ctx, userUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &models.Unsubscribed{Channel: channel}, nil
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if _, err := u.queries.UpsertNotificationPreferences(ctx, repository.UpsertNotificationPreferencesParams{
+		UserID:       userUUID,
+		EmailEnabled: pgtype.Bool{Bool: false, Valid: true},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("userID", userID).Str("channel", channel).Msg("User unsubscribed")
+	return &models.Unsubscribed{Channel: channel}, nil
+}
+
+// preferredCategories returns the categories the user has saved sport preferences for, or nil
+func (s *GamesService) preferredCategories(ctx context.Context, userUUID pgtype.UUID) ([]string, error) {
+	rows, err := s.queries.ListUserSportPreferences(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sport preferences: %w", err)
+	}
+	var categories []string
+	for _, row := range rows {
+		categories = append(categories, row.Category)
+	}
+	return categories, nil
+}
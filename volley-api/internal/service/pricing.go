@@ -0,0 +1,31 @@
+package service
+
+import (
+	"github.com/gabe-dev-svc/volley/internal/models"
+)
+
+// totalShareCents returns each confirmed player's share of a game priced as a total, or nil for other
+// pricing and for games nobody is confirmed for yet. Shares are rounded up so together they cover the total.
+func totalShareCents(pricingType string, amountCents int32, confirmed int64) *int {
+	if models.PricingType(pricingType) != models.PricingTypeTotal || confirmed <= 0 {
+		return nil
+	}
+	share := int((int64(amountCents) + confirmed - 1) / confirmed)
+	return &share
+}
+
+// attachShares sets ShareCents on the confirmed participants. Waitlisted players owe nothing until
+// they get a spot.
+func attachShares(share *int, participants ...[]models.Participant) {
+	if share == nil {
+		return
+	}
+	for _, list := range participants {
+		for i := range list {
+			if list[i].Status == models.ParticipantStatusConfirmed {
+				cents := *share
+				list[i].ShareCents = &cents
+			}
+		}
+	}
+}
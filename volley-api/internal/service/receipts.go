@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrReceiptNotAvailable is returned for sign-ups that have no receipt: the user didn't pay, the
+// game was cancelled, or it hasn't ended yet
+var ErrReceiptNotAvailable = errors.New("receipt not available")
+
+// Receipt returns the user's receipt for a paid game that has ended
+func (s *GamesService) Receipt(ctx context.Context, gameID string, userID string) (*models.Receipt, error) {
+	var gameUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	row, err := s.queries.GetParticipationReceipt(ctx, repository.GetParticipationReceiptParams{
+		GameID: gameUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	endTime := row.StartTime.Time.Add(time.Duration(row.DurationMinutes) * time.Minute)
+	if !row.Paid || row.Status == string(models.GameStatusCancelled) || endTime.After(time.Now()) {
+		return nil, ErrReceiptNotAvailable
+	}
+
+	amountCents, estimated := receiptAmountCents(row)
+	return &models.Receipt{
+		Number:    uuid.UUID(row.ParticipantID.Bytes).String(),
+		GameID:    uuid.UUID(row.ID.Bytes).String(),
+		GameTitle: pgTextToStringPtr(row.Title),
+		Category:  models.GameCategory(row.Category),
+		Venue: models.ReceiptVenue{
+			Name:    row.LocationName,
+			Address: pgTextToStringPtr(row.LocationAddress),
+		},
+		StartTime:       row.StartTime.Time.UTC(),
+		DurationMinutes: int(row.DurationMinutes),
+		Organizer:       row.OrganizerFirstName + " " + row.OrganizerLastName,
+		AmountCents:     amountCents,
+		Currency:        row.PricingCurrency,
+		AmountEstimated: estimated,
+		IssuedAt:        time.Now().UTC(),
+	}, nil
+}
+
+// EmailReceipt sends the user's receipt for a game to their account email
+func (s *GamesService) EmailReceipt(ctx context.Context, gameID string, userID string) error {
+	receipt, err := s.Receipt(ctx, gameID, userID)
+	if err != nil {
+		return err
+	}
+	if s.notifier == nil {
+		return errors.New("no notifier configured to email receipts")
+	}
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+	user, err := s.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	recipient := models.User{ID: userID, Email: user.Email, FirstName: user.FirstName, LastName: user.LastName}
+	if err := s.notifier.SendReceipt(ctx, recipient, receipt); err != nil {
+		return err
+	}
+	log.Ctx(ctx).Info().Str("receiptNumber", receipt.Number).Msg("Receipt emailed")
+	return nil
+}
+
+// SendReceipt emails a receipt to recipient, with the game's start time in their timezone. It's
+// sent whatever their game email preferences, since they asked for it.
+func (n *Notifier) SendReceipt(ctx context.Context, recipient models.User, receipt *models.Receipt) error {
+	title := string(receipt.Category)
+	if receipt.GameTitle != nil {
+		title = *receipt.GameTitle
+	}
+	venue := receipt.Venue.Name
+	if receipt.Venue.Address != nil {
+		venue += ", " + *receipt.Venue.Address
+	}
+
+	email, err := notifications.RenderEmail(notifications.EmailReceipt, notifications.ReceiptEmail{
+		RecipientName:   recipient.FirstName,
+		Number:          receipt.Number,
+		GameTitle:       title,
+		Venue:           venue,
+		StartTime:       receipt.StartTime.In(n.recipientLocation(ctx, recipient.ID)),
+		DurationMinutes: receipt.DurationMinutes,
+		Organizer:       receipt.Organizer,
+		Amount:          fmt.Sprintf("%d.%02d %s", receipt.AmountCents/100, receipt.AmountCents%100, receipt.Currency),
+		AmountEstimated: receipt.AmountEstimated,
+	}, "")
+	if err != nil {
+		return err
+	}
+	if err := n.emailSender.SendEmail(ctx, recipient.Email, email); err != nil {
+		return fmt.Errorf("failed to send receipt email: %w", err)
+	}
+	return nil
+}
+
+// receiptAmountCents returns what the participant paid. Hosts don't always record the amount; the
+// game's pricing is used then, splitting a total evenly among the confirmed players.
+func receiptAmountCents(row repository.GetParticipationReceiptRow) (amountCents int, estimated bool) {
+	if row.PaymentAmountCents.Valid {
+		return int(row.PaymentAmountCents.Int32), false
+	}
+	switch models.PricingType(row.PricingType) {
+	case models.PricingTypePerPerson:
+		return int(row.PricingAmountCents), true
+	case models.PricingTypeTotal:
+		if share := totalShareCents(row.PricingType, row.PricingAmountCents, row.ConfirmedCount); share != nil {
+			return *share, true
+		}
+	}
+	return 0, true
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// referralCodeAlphabet leaves out 0/O and 1/I/L so codes survive being read aloud
+	referralCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+	referralCodeLength   = 8
+	// referralCodeAttempts bounds retries when a random code is already taken
+	referralCodeAttempts = 5
+	recentReferralsLimit = 20
+)
+
+// Referrals returns the user's referral code, creating it for accounts that predate referrals, and
+// how many friends signed up with it
+func (u *UserService) Referrals(ctx context.Context, userID string) (*models.ReferralSummary, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	code, err := u.ensureReferralCode(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	signups, err := u.queries.CountReferrals(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count referrals: %w", err)
+	}
+	rows, err := u.queries.ListRecentReferrals(ctx, repository.ListRecentReferralsParams{
+		ReferrerID: userUUID,
+		Limit:      recentReferralsLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list referrals: %w", err)
+	}
+
+	summary := &models.ReferralSummary{
+		Code:    code,
+		Signups: int(signups),
+		Recent:  make([]models.ReferredFriend, 0, len(rows)),
+	}
+	for _, row := range rows {
+		summary.Recent = append(summary.Recent, models.ReferredFriend{
+			FirstName:  row.FirstName,
+			SignedUpAt: row.CreatedAt.Time.UTC(),
+		})
+	}
+	return summary, nil
+}
+
+// ensureReferralCode returns the user's referral code, creating one if they don't have it yet
+func (u *UserService) ensureReferralCode(ctx context.Context, userUUID pgtype.UUID) (string, error) {
+	existing, err := u.queries.GetReferralCodeByUser(ctx, userUUID)
+	if err == nil {
+		return existing.Code, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("failed to get referral code: %w", err)
+	}
+
+	for range referralCodeAttempts {
+		code, err := newReferralCode()
+		if err != nil {
+			return "", err
+		}
+		created, err := u.queries.CreateReferralCode(ctx, repository.CreateReferralCodeParams{UserID: userUUID, Code: code})
+		if err == nil {
+			return created.Code, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("failed to create referral code: %w", err)
+		}
+
+		// Nothing was inserted: either a concurrent request gave the user a code, or this one is taken
+		existing, err := u.queries.GetReferralCodeByUser(ctx, userUUID)
+		if err == nil {
+			return existing.Code, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("failed to get referral code: %w", err)
+		}
+	}
+	return "", fmt.Errorf("failed to find a free referral code after %d attempts", referralCodeAttempts)
+}
+
+// lookupReferralCode finds the owner of a code entered at sign-up. Codes are case-insensitive.
+func (u *UserService) lookupReferralCode(ctx context.Context, code string) (*repository.ReferralCode, error) {
+	referrer, err := u.queries.GetReferralCodeByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "referralCode",
+				Message:      "referral code not found",
+			}
+		}
+		return nil, fmt.Errorf("failed to look up referral code: %w", err)
+	}
+	return &referrer, nil
+}
+
+func newReferralCode() (string, error) {
+	var code strings.Builder
+	alphabetSize := big.NewInt(int64(len(referralCodeAlphabet)))
+	for range referralCodeLength {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate referral code: %w", err)
+		}
+		code.WriteByte(referralCodeAlphabet[n.Int64()])
+	}
+	return code.String(), nil
+}
@@ -0,0 +1,17 @@
+package service
+
+import "github.com/gabe-dev-svc/volley/ifaces"
+
+// UseReadReplica serves lag-tolerant reads (game browsing) from queries, typically a replica in
+// the same region as this deployment, while writes and read-your-own-write paths stay on the primary.
+func (s *GamesService) UseReadReplica(queries ifaces.Querier) {
+	s.reads = queries
+}
+
+// readQueries returns the querier for reads that tolerate replication lag
+func (s *GamesService) readQueries() ifaces.Querier {
+	if s.reads != nil {
+		return s.reads
+	}
+	return s.queries
+}
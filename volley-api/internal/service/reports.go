@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// reportWindow is the period maxReportsPerWindow applies to
+	reportWindow = 24 * time.Hour
+	// maxReportsPerWindow caps how many reports a user can file in reportWindow
+	maxReportsPerWindow = 10
+)
+
+// CreateReport files a report about a game or user for admin review. Reporters can't report
+// themselves, can have one open report per target, and are limited to maxReportsPerWindow a day.
+func (u *UserService) CreateReport(ctx context.Context, reporterID string, req models.CreateReportRequest) (*models.Report, error) {
+	logger := log.Ctx(ctx)
+
+	var reporterUUID, targetUUID pgtype.UUID
+	if err := reporterUUID.Scan(reporterID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if err := targetUUID.Scan(req.TargetID); err != nil {
+		return nil, &InvalidArgumentError{ArgumentName: "targetId", Message: "invalid target ID format"}
+	}
+
+	switch req.TargetType {
+	case models.ReportTargetUser:
+		if targetUUID == reporterUUID {
+			return nil, &InvalidArgumentError{ArgumentName: "targetId", Message: "you can't report yourself"}
+		}
+		if _, err := u.queries.GetUserByID(ctx, targetUUID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, apperrors.ErrNotFound
+			}
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+	case models.ReportTargetGame:
+		if _, err := u.queries.GetGameOwner(ctx, targetUUID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, apperrors.ErrNotFound
+			}
+			return nil, fmt.Errorf("failed to get game: %w", err)
+		}
+	default:
+		return nil, &InvalidArgumentError{ArgumentName: "targetType", Message: "targetType must be game or user"}
+	}
+
+	filed, err := u.queries.CountReportsByReporterSince(ctx, repository.CountReportsByReporterSinceParams{
+		ReporterID: reporterUUID,
+		CreatedAt:  pgtype.Timestamptz{Time: time.Now().Add(-reportWindow), Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to count reports")
+		return nil, fmt.Errorf("failed to count reports: %w", err)
+	}
+	if filed >= maxReportsPerWindow {
+		logger.Warn().Int64("filed", filed).Msg("Daily report limit reached")
+		return nil, fmt.Errorf("daily report limit reached: %w", apperrors.ErrRateLimited)
+	}
+
+	report, err := u.queries.CreateReport(ctx, repository.CreateReportParams{
+		ReporterID: reporterUUID,
+		TargetType: string(req.TargetType),
+		TargetID:   targetUUID,
+		Reason:     string(req.Reason),
+		Details:    stringPtrToPgText(req.Details),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("open report on this %s: %w", req.TargetType, apperrors.ErrAlreadyExists)
+		}
+		logger.Error().Err(err).Msg("Failed to create report")
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	logger.Info().
+		Str("reportId", report.ID.String()).
+		Str("targetType", report.TargetType).
+		Str("targetId", req.TargetID).
+		Str("reason", report.Reason).
+		Msg("Report filed")
+	return convertReportToModel(report), nil
+}
+
+// ListReportsFilters filters and pages the admin report queue
+type ListReportsFilters struct {
+	Status     *models.ReportStatus     // Only reports in this status
+	TargetType *models.ReportTargetType // Only reports about games or only about users
+	Limit      int                      // Number of results to return (default 50, max 100)
+	Offset     int                      // Number of results to skip (default 0)
+}
+
+// ListReports returns one page of reports for admin review, oldest first so the queue is worked in order
+func (u *UserService) ListReports(ctx context.Context, filters ListReportsFilters) (*models.ListReportsResponse, error) {
+	if filters.Limit < 0 || filters.Offset < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "limit",
+			Message:      "limit and offset must be non-negative",
+		}
+	}
+	if filters.Limit == 0 {
+		filters.Limit = 50
+	}
+	if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	params := repository.ListReportsParams{
+		// Fetch one extra row to learn whether another page follows
+		PageLimit:  int32(filters.Limit + 1),
+		PageOffset: int32(filters.Offset),
+	}
+	if filters.Status != nil {
+		params.Status = pgtype.Text{String: string(*filters.Status), Valid: true}
+	}
+	if filters.TargetType != nil {
+		params.TargetType = pgtype.Text{String: string(*filters.TargetType), Valid: true}
+	}
+	rows, err := u.queries.ListReports(ctx, params)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list reports")
+		return nil, fmt.Errorf("failed to list reports: %w", err)
+	}
+
+	hasMore := len(rows) > filters.Limit
+	if hasMore {
+		rows = rows[:filters.Limit]
+	}
+
+	reports := make([]models.Report, 0, len(rows))
+	for _, row := range rows {
+		reports = append(reports, *convertReportToModel(row))
+	}
+
+	response := &models.ListReportsResponse{
+		Reports: reports,
+		Limit:   filters.Limit,
+		Offset:  filters.Offset,
+		HasMore: hasMore,
+	}
+	if hasMore {
+		next := filters.Offset + filters.Limit
+		response.NextOffset = &next
+	}
+	return response, nil
+}
+
+// ReviewReport closes a report as resolved or dismissed, recording which admin reviewed it.
+// Reviewing an already closed report changes its outcome. Every review is recorded in the admin
+// audit trail.
+func (u *UserService) ReviewReport(ctx context.Context, adminID string, reportID string, status models.ReportStatus) (*models.Report, error) {
+	var adminUUID, reportUUID pgtype.UUID
+	if err := adminUUID.Scan(adminID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if err := reportUUID.Scan(reportID); err != nil {
+		return nil, &InvalidArgumentError{ArgumentName: "reportId", Message: "invalid report ID format"}
+	}
+	if status != models.ReportStatusResolved && status != models.ReportStatusDismissed {
+		return nil, &InvalidArgumentError{ArgumentName: "status", Message: "status must be resolved or dismissed"}
+	}
+
+	report, err := u.queries.UpdateReportStatus(ctx, repository.UpdateReportStatusParams{
+		ID:         reportUUID,
+		Status:     string(status),
+		ReviewedBy: adminUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to update report status")
+		return nil, fmt.Errorf("failed to update report status: %w", err)
+	}
+
+	if err := u.queries.CreateAdminAuditEntry(ctx, repository.CreateAdminAuditEntryParams{
+		ActorID:    adminUUID,
+		Action:     string(models.AdminActionReviewReport),
+		TargetType: string(models.AuditTargetReport),
+		TargetID:   reportUUID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record admin action: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("reportId", reportID).Str("status", string(status)).Msg("Report reviewed")
+	return convertReportToModel(report), nil
+}
+
+func convertReportToModel(report repository.Report) *models.Report {
+	var reviewedAt *time.Time
+	if report.ReviewedAt.Valid {
+		t := report.ReviewedAt.Time.UTC()
+		reviewedAt = &t
+	}
+	var reporterID *string
+	if report.ReporterID.Valid {
+		id := uuid.UUID(report.ReporterID.Bytes).String()
+		reporterID = &id
+	}
+	return &models.Report{
+		ID:         uuid.UUID(report.ID.Bytes).String(),
+		ReporterID: reporterID,
+		TargetType: models.ReportTargetType(report.TargetType),
+		TargetID:   uuid.UUID(report.TargetID.Bytes).String(),
+		Reason:     models.ReportReason(report.Reason),
+		Details:    pgTextToStringPtr(report.Details),
+		Status:     models.ReportStatus(report.Status),
+		CreatedAt:  report.CreatedAt.Time.UTC(),
+		ReviewedAt: reviewedAt,
+	}
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// ReserveSpots holds spots in the owner's game for specific players until the given expiry, which
+// must fall before the game starts. Held spots are out of reach of other sign-ups; a reserved
+// player who joins goes to the front of the line. The owner can only reserve spots nobody has yet.
+func (s *GamesService) ReserveSpots(ctx context.Context, gameID string, ownerID string, request models.ReserveSpotsRequest) (*models.ListReservationsResponse, error) {
+	var gameUUID, ownerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if len(request.UserIDs) == 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_ids",
+			Message:      "at least one user ID is required",
+		}
+	}
+	if !request.ExpiresAt.After(time.Now()) {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "expires_at",
+			Message:      "expiresAt must be in the future",
+		}
+	}
+
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		game, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID)
+		if err != nil {
+			return err
+		}
+		if request.ExpiresAt.After(game.StartTime.Time) {
+			return &InvalidArgumentError{
+				ArgumentName: "expires_at",
+				Message:      "reservations must expire by the game's start time",
+			}
+		}
+
+		for _, userID := range request.UserIDs {
+			var userUUID pgtype.UUID
+			if err := userUUID.Scan(userID); err != nil {
+				return &InvalidArgumentError{
+					ArgumentName: "user_ids",
+					Message:      fmt.Sprintf("invalid user ID %q", userID),
+				}
+			}
+			if _, err := q.GetUserByID(ctx, userUUID); err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return &InvalidArgumentError{
+						ArgumentName: "user_ids",
+						Message:      fmt.Sprintf("user %s not found", userID),
+					}
+				}
+				return fmt.Errorf("failed to get user: %w", err)
+			}
+			if _, err := q.UpsertGameReservation(ctx, repository.UpsertGameReservationParams{
+				GameID:     gameUUID,
+				UserID:     userUUID,
+				ReservedBy: ownerUUID,
+				ExpiresAt:  pgtype.Timestamptz{Time: request.ExpiresAt, Valid: true},
+			}); err != nil {
+				return fmt.Errorf("failed to reserve spot: %w", err)
+			}
+		}
+
+		// Holds can't take spots that players already have
+		confirmed, err := q.CountConfirmedParticipants(ctx, gameUUID)
+		if err != nil {
+			return fmt.Errorf("failed to count confirmed participants: %w", err)
+		}
+		held, err := q.CountHeldReservations(ctx, gameUUID)
+		if err != nil {
+			return fmt.Errorf("failed to count held reservations: %w", err)
+		}
+		if confirmed+held > int64(game.MaxParticipants) {
+			return &InvalidArgumentError{
+				ArgumentName: "user_ids",
+				Message:      fmt.Sprintf("only %d of the game's spots are free to reserve", max(int64(game.MaxParticipants)-confirmed, 0)),
+			}
+		}
+
+		return syncCapacityStatus(ctx, q, gameUUID, game.Status, game.MaxParticipants)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Int("players", len(request.UserIDs)).Time("expiresAt", request.ExpiresAt).Msg("Spots reserved")
+	return s.listReservations(ctx, gameUUID)
+}
+
+// CancelReservation releases the spot held for a player in the owner's game. A player who already
+// joined keeps their place; otherwise the spot goes to the front of the waitlist.
+func (s *GamesService) CancelReservation(ctx context.Context, gameID string, ownerID string, userID string) (*models.ListReservationsResponse, error) {
+	var gameUUID, ownerUUID, userUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	var game repository.GetGameForUpdateRow
+	var confirmed []pgtype.UUID
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		var err error
+		game, err = lockOwnedGame(ctx, q, gameUUID, ownerUUID)
+		if err != nil {
+			return err
+		}
+
+		deleted, err := q.DeleteGameReservation(ctx, repository.DeleteGameReservationParams{
+			GameID: gameUUID,
+			UserID: userUUID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to cancel reservation: %w", err)
+		}
+		if deleted == 0 {
+			return apperrors.ErrNotFound
+		}
+
+		confirmed, _, err = applyRosterChanges(ctx, q, game)
+		if err != nil {
+			return err
+		}
+		return syncCapacityStatus(ctx, q, gameUUID, game.Status, game.MaxParticipants)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Str("reservedUserId", userID).Int("promoted", len(confirmed)).Msg("Reservation cancelled")
+
+	// The spot is already handed on, so a failed lookup only costs the players their news
+	promotedInto := gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime)
+	if _, err := s.announcePromotions(ctx, promotedInto, gameUUID, confirmed); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to get players promoted into the cancelled reservation's spot")
+	}
+	return s.listReservations(ctx, gameUUID)
+}
+
+// ListReservations returns the spots held in the owner's game, including lapsed ones
+func (s *GamesService) ListReservations(ctx context.Context, gameID string, ownerID string) (*models.ListReservationsResponse, error) {
+	var gameUUID, ownerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "owner_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+	if game.OwnerID != ownerUUID {
+		return nil, ErrNotOwner
+	}
+
+	return s.listReservations(ctx, gameUUID)
+}
+
+func (s *GamesService) listReservations(ctx context.Context, gameUUID pgtype.UUID) (*models.ListReservationsResponse, error) {
+	rows, err := s.queries.ListGameReservations(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservations: %w", err)
+	}
+	now := time.Now()
+	reservations := make([]models.Reservation, 0, len(rows))
+	for _, row := range rows {
+		reservations = append(reservations, models.Reservation{
+			UserID:    uuid.UUID(row.UserID.Bytes).String(),
+			FirstName: row.FirstName,
+			LastName:  row.LastName,
+			ExpiresAt: row.ExpiresAt.Time.UTC(),
+			Joined:    row.Joined,
+			Expired:   !row.ExpiresAt.Time.After(now),
+			CreatedAt: row.CreatedAt.Time.UTC(),
+		})
+	}
+	return &models.ListReservationsResponse{Reservations: reservations}, nil
+}
+
+// ReleaseExpiredReservations hands the spots of lapsed reservations back to everyone else, promoting
+// waitlisted players into them. Each game is released and reconciled under its row lock, so a
+// failed game is retried on the next run.
+func (s *GamesService) ReleaseExpiredReservations(ctx context.Context) error {
+	gameUUIDs, err := s.queries.ListGamesWithExpiredReservations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list games with expired reservations: %w", err)
+	}
+
+	var failed int
+	for _, gameUUID := range gameUUIDs {
+		var game repository.GetGameForUpdateRow
+		var confirmed []pgtype.UUID
+		err := s.inTx(ctx, func(q ifaces.Querier) error {
+			var err error
+			game, err = q.GetGameForUpdate(ctx, gameUUID)
+			if err != nil {
+				return fmt.Errorf("failed to lock game: %w", err)
+			}
+			if _, err := q.ReleaseExpiredReservations(ctx, gameUUID); err != nil {
+				return fmt.Errorf("failed to release reservations: %w", err)
+			}
+			if game.Status != string(models.GameStatusOpen) && game.Status != string(models.GameStatusFull) {
+				return nil
+			}
+
+			confirmed, _, err = applyRosterChanges(ctx, q, game)
+			if err != nil {
+				return err
+			}
+			return syncCapacityStatus(ctx, q, gameUUID, game.Status, game.MaxParticipants)
+		})
+		logger := log.Ctx(ctx).With().Str("gameId", uuid.UUID(gameUUID.Bytes).String()).Logger()
+		if err != nil {
+			failed++
+			logger.Error().Err(err).Msg("Failed to release expired reservations")
+			continue
+		}
+		logger.Info().Int("promoted", len(confirmed)).Msg("Expired reservations released")
+
+		// The spots are already released, so a failed lookup only costs the players their news
+		promotedInto := gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime)
+		if _, err := s.announcePromotions(ctx, promotedInto, gameUUID, confirmed); err != nil {
+			logger.Error().Err(err).Msg("Failed to get players promoted into released spots")
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to release expired reservations for %d games", failed)
+	}
+	return nil
+}
+
+// openSpots returns how many of the game's spots are open to sign-ups: the maximum less the spots
+// held for reserved players who haven't joined yet
+func openSpots(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID, maxParticipants int32) (int32, error) {
+	held, err := q.CountHeldReservations(ctx, gameUUID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count held reservations: %w", err)
+	}
+	return max(maxParticipants-int32(held), 0), nil
+}
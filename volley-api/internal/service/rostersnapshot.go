@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrSnapshotNotTaken is returned for games that haven't started, since the roster snapshot is taken at start
+var ErrSnapshotNotTaken = errors.New("roster snapshot is taken when the game starts")
+
+// GetRosterSnapshot returns the roster recorded when a game started. The snapshot is written by a
+// database trigger on the game's status change and never updated, so later drops, removals and
+// payment edits don't change it. Only the owner and users with a participant record can see it,
+// and minors are left out unless the viewer is the organizer or the minor, the same as GetGame.
+func (s *GamesService) GetRosterSnapshot(ctx context.Context, gameID string, viewerID string) (*models.RosterSnapshot, error) {
+	var gameUUID, viewerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := viewerUUID.Scan(viewerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	game, err := s.queries.GetGame(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	if game.OwnerID != viewerUUID {
+		_, err := s.queries.GetParticipantByGameAndUser(ctx, repository.GetParticipantByGameAndUserParams{
+			GameID: gameUUID,
+			UserID: viewerUUID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrNotParticipant
+			}
+			return nil, fmt.Errorf("failed to get participant: %w", err)
+		}
+	}
+
+	snapshot, err := s.queries.GetRosterSnapshot(ctx, gameUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSnapshotNotTaken
+		}
+		return nil, fmt.Errorf("failed to get roster snapshot: %w", err)
+	}
+
+	rows, err := s.queries.ListRosterSnapshotEntries(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roster snapshot entries: %w", err)
+	}
+
+	result := &models.RosterSnapshot{
+		GameID:                gameID,
+		TakenAt:               snapshot.TakenAt.Time.UTC(),
+		MaxParticipants:       int(snapshot.MaxParticipants),
+		ConfirmedParticipants: []models.RosterSnapshotEntry{},
+		Waitlist:              []models.RosterSnapshotEntry{},
+	}
+	now := time.Now()
+	for _, row := range rows {
+		if isMinor(row.Birthdate, now) && viewerUUID != game.OwnerID && viewerUUID != row.UserID {
+			continue
+		}
+
+		entry := convertRosterSnapshotEntry(row)
+		if entry.Status == models.ParticipantStatusWaitlist {
+			result.Waitlist = append(result.Waitlist, entry)
+		} else {
+			result.ConfirmedParticipants = append(result.ConfirmedParticipants, entry)
+		}
+	}
+
+	return result, nil
+}
+
+func convertRosterSnapshotEntry(row repository.ListRosterSnapshotEntriesRow) models.RosterSnapshotEntry {
+	entry := models.RosterSnapshotEntry{
+		FirstName: row.FirstName,
+		LastName:  row.LastName,
+		Status:    models.ParticipantStatus(row.Status),
+		Paid:      row.Paid,
+		JoinedAt:  row.JoinedAt.Time.UTC(),
+	}
+	if row.UserID.Valid {
+		id := uuid.UUID(row.UserID.Bytes).String()
+		entry.UserID = &id
+	} else {
+		id := uuid.UUID(row.ParticipantID.Bytes).String()
+		entry.PlaceholderID = &id
+	}
+	if row.WaitlistPosition.Valid {
+		position := int(row.WaitlistPosition.Int32)
+		entry.WaitlistPosition = &position
+	}
+	if row.TeamID.Valid {
+		id := uuid.UUID(row.TeamID.Bytes).String()
+		entry.TeamID = &id
+	}
+	if row.PaymentAmountCents.Valid {
+		cents := int(row.PaymentAmountCents.Int32)
+		entry.PaymentAmountCents = &cents
+	}
+	return entry
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrTooManySavedSearches = errors.New("saved search limit reached")
+
+// maxSavedSearches is how many searches one user can save
+const maxSavedSearches = 20
+
+// CreateSavedSearch saves a named set of game listing filters for the user. The radius defaults to
+// 10 miles like GET /v1/games; categories are left empty to keep following the user's sport
+// preferences. Alerts about new matching games are on unless the request turns them off.
+func (s *GamesService) CreateSavedSearch(ctx context.Context, userID string, request models.CreateSavedSearchRequest) (*models.SavedSearch, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "name",
+			Message:      "name must not be empty",
+		}
+	}
+	filters := request.Filters
+	if filters.Radius == 0 {
+		filters.Radius = 16093.4 // 10 miles in meters
+	}
+	alerts := true
+	if request.Alerts != nil {
+		alerts = *request.Alerts
+	}
+	encoded, err := json.Marshal(filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode saved search filters: %w", err)
+	}
+
+	existing, err := s.queries.ListSavedSearches(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	if len(existing) >= maxSavedSearches {
+		return nil, ErrTooManySavedSearches
+	}
+
+	saved, err := s.queries.CreateSavedSearch(ctx, repository.CreateSavedSearchParams{
+		UserID:        userUUID,
+		Name:          name,
+		Filters:       encoded,
+		AlertsEnabled: alerts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create saved search: %w", err)
+	}
+
+	created, err := convertSavedSearch(saved)
+	if err != nil {
+		return nil, err
+	}
+	log.Ctx(ctx).Info().Str("savedSearchId", created.ID).Msg("Search saved")
+	return created, nil
+}
+
+// ListSavedSearches returns the user's saved searches, oldest first
+func (s *GamesService) ListSavedSearches(ctx context.Context, userID string) ([]models.SavedSearch, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	rows, err := s.queries.ListSavedSearches(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	searches := make([]models.SavedSearch, 0, len(rows))
+	for _, row := range rows {
+		search, err := convertSavedSearch(row)
+		if err != nil {
+			return nil, err
+		}
+		searches = append(searches, *search)
+	}
+	return searches, nil
+}
+
+// SetSavedSearchAlerts turns new-game alerts for one of the user's saved searches on or off
+func (s *GamesService) SetSavedSearchAlerts(ctx context.Context, userID string, savedSearchID string, enabled bool) (*models.SavedSearch, error) {
+	var userUUID, searchUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := searchUUID.Scan(savedSearchID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "saved_search_id",
+			Message:      "invalid saved search ID format",
+		}
+	}
+
+	updated, err := s.queries.SetSavedSearchAlerts(ctx, repository.SetSavedSearchAlertsParams{
+		ID:            searchUUID,
+		UserID:        userUUID,
+		AlertsEnabled: enabled,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to update saved search alerts: %w", err)
+	}
+	log.Ctx(ctx).Info().Str("savedSearchId", savedSearchID).Bool("alerts", enabled).Msg("Saved search alerts updated")
+	return convertSavedSearch(updated)
+}
+
+// DeleteSavedSearch removes one of the user's saved searches
+func (s *GamesService) DeleteSavedSearch(ctx context.Context, userID string, savedSearchID string) error {
+	var userUUID, searchUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := searchUUID.Scan(savedSearchID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "saved_search_id",
+			Message:      "invalid saved search ID format",
+		}
+	}
+
+	deleted, err := s.queries.DeleteSavedSearch(ctx, repository.DeleteSavedSearchParams{
+		ID:     searchUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	if deleted == 0 {
+		return apperrors.ErrNotFound
+	}
+	log.Ctx(ctx).Info().Str("savedSearchId", savedSearchID).Msg("Saved search deleted")
+	return nil
+}
+
+func convertSavedSearch(search repository.SavedSearch) (*models.SavedSearch, error) {
+	var filters models.SavedSearchFilters
+	if err := json.Unmarshal(search.Filters, &filters); err != nil {
+		return nil, fmt.Errorf("failed to decode saved search filters: %w", err)
+	}
+	return &models.SavedSearch{
+		ID:        uuid.UUID(search.ID.Bytes).String(),
+		Name:      search.Name,
+		Filters:   filters,
+		Alerts:    search.AlertsEnabled,
+		CreatedAt: search.CreatedAt.Time.UTC(),
+	}, nil
+}
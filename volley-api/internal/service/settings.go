@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// SettingsUpdate changes a user's settings. Nil fields are left unchanged; Home is already resolved to
+// coordinates when it was given as a place.
+type SettingsUpdate struct {
+	Home          *models.HomeLocation // New home location
+	ClearHome     bool                 // Remove the saved home location
+	DefaultRadius *float64             // New default radius in meters
+}
+
+// GetSettings returns the user's search defaults. Users who never saved any get empty settings.
+func (u *UserService) GetSettings(ctx context.Context, userID string) (*models.UserSettings, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	row, err := u.queries.GetUserSettings(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &models.UserSettings{}, nil
+		}
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+	return userSettingsFromRow(row), nil
+}
+
+// UpdateSettings applies update on top of the user's saved settings
+func (u *UserService) UpdateSettings(ctx context.Context, userID string, update SettingsUpdate) (*models.UserSettings, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if update.Home != nil && update.ClearHome {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "homeLocation",
+			Message:      "homeLocation can't be set and cleared at once",
+		}
+	}
+	if update.Home != nil {
+		if update.Home.Latitude < -90 || update.Home.Latitude > 90 || update.Home.Longitude < -180 || update.Home.Longitude > 180 {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "homeLocation",
+				Message:      "latitude must be within ±90 and longitude within ±180",
+			}
+		}
+	}
+	if update.DefaultRadius != nil && *update.DefaultRadius <= 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "defaultRadius",
+			Message:      "defaultRadius must be positive",
+		}
+	}
+
+	current, err := u.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if update.Home != nil {
+		current.HomeLocation = update.Home
+	}
+	if update.ClearHome {
+		current.HomeLocation = nil
+	}
+	if update.DefaultRadius != nil {
+		current.DefaultRadius = update.DefaultRadius
+	}
+
+	params := repository.UpsertUserSettingsParams{UserID: userUUID}
+	if home := current.HomeLocation; home != nil {
+		params.HomeLatitude = pgtype.Float8{Float64: home.Latitude, Valid: true}
+		params.HomeLongitude = pgtype.Float8{Float64: home.Longitude, Valid: true}
+		if home.PlaceID != nil {
+			params.HomePlaceID = pgtype.Text{String: *home.PlaceID, Valid: true}
+		}
+		if home.Name != nil {
+			params.HomeName = pgtype.Text{String: *home.Name, Valid: true}
+		}
+	}
+	if current.DefaultRadius != nil {
+		params.DefaultRadiusMeters = pgtype.Float8{Float64: *current.DefaultRadius, Valid: true}
+	}
+
+	row, err := u.queries.UpsertUserSettings(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save settings: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Bool("homeLocation", params.HomeLatitude.Valid).Msg("Settings updated")
+	return userSettingsFromRow(row), nil
+}
+
+func userSettingsFromRow(row repository.UserSetting) *models.UserSettings {
+	settings := &models.UserSettings{}
+	if row.HomeLatitude.Valid && row.HomeLongitude.Valid {
+		settings.HomeLocation = &models.HomeLocation{
+			Latitude:  row.HomeLatitude.Float64,
+			Longitude: row.HomeLongitude.Float64,
+		}
+		if row.HomePlaceID.Valid {
+			settings.HomeLocation.PlaceID = &row.HomePlaceID.String
+		}
+		if row.HomeName.Valid {
+			settings.HomeLocation.Name = &row.HomeName.String
+		}
+	}
+	if row.DefaultRadiusMeters.Valid {
+		settings.DefaultRadius = &row.DefaultRadiusMeters.Float64
+	}
+	return settings
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// SideEffectKind identifies follow-up work of a committed game change
+type SideEffectKind string
+
+const (
+	// SideEffectReconcileRoster re-runs roster reconciliation so waitlisted players get promoted
+	SideEffectReconcileRoster SideEffectKind = "reconcile_roster"
+	// SideEffectNotifyCancellation tells the participants of a cancelled game
+	SideEffectNotifyCancellation SideEffectKind = "notify_cancellation"
+	// SideEffectRefundCredits returns the credit players spent on a cancelled game
+	SideEffectRefundCredits SideEffectKind = "refund_credits"
+)
+
+const (
+	// sideEffectBatchSize is how many due side effects one run of the job claims
+	sideEffectBatchSize = 20
+	// sideEffectLease is how long a claimed side effect is hidden from other workers
+	sideEffectLease = 5 * time.Minute
+	// sideEffectBaseBackoff is the delay before the first retry; it doubles with every attempt
+	sideEffectBaseBackoff = 30 * time.Second
+	// sideEffectMaxBackoff caps the delay between retries
+	sideEffectMaxBackoff = time.Hour
+	// maxSideEffectAttempts is how many times a side effect is tried before it is marked failed
+	maxSideEffectAttempts = 10
+)
+
+// enqueueSideEffect records follow-up work that failed inline so ProcessSideEffects retries it.
+// Callers have already committed the change itself, so a failure here is only logged.
+func (s *GamesService) enqueueSideEffect(ctx context.Context, kind SideEffectKind, gameUUID pgtype.UUID, cause error) {
+	logger := log.Ctx(ctx)
+	effect, err := s.queries.EnqueueSideEffect(ctx, repository.EnqueueSideEffectParams{
+		Kind:   string(kind),
+		GameID: gameUUID,
+	})
+	if err != nil {
+		logger.Error().Err(err).AnErr("cause", cause).Str("kind", string(kind)).Msg("Failed to enqueue side effect for retry - it is lost")
+		return
+	}
+	logger.Warn().Err(cause).
+		Str("kind", string(kind)).
+		Str("sideEffectId", uuid.UUID(effect.ID.Bytes).String()).
+		Msg("Side effect failed - queued for retry")
+}
+
+// ProcessSideEffects retries due side effects. Failures are rescheduled with exponential backoff
+// until maxSideEffectAttempts, after which the side effect is marked failed and left for an operator.
+func (s *GamesService) ProcessSideEffects(ctx context.Context) error {
+	effects, err := s.queries.ClaimDueSideEffects(ctx, repository.ClaimDueSideEffectsParams{
+		LeaseSeconds: int32(sideEffectLease / time.Second),
+		BatchSize:    sideEffectBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim side effects: %w", err)
+	}
+
+	for _, effect := range effects {
+		logger := log.Ctx(ctx).With().
+			Str("sideEffectId", uuid.UUID(effect.ID.Bytes).String()).
+			Str("kind", effect.Kind).
+			Str("gameId", uuid.UUID(effect.GameID.Bytes).String()).
+			Int32("attempt", effect.Attempts).
+			Logger()
+		effectCtx := logger.WithContext(ctx)
+
+		runErr := s.runSideEffect(effectCtx, effect)
+		if runErr == nil {
+			if err := s.queries.CompleteSideEffect(ctx, effect.ID); err != nil {
+				return fmt.Errorf("failed to complete side effect: %w", err)
+			}
+			logger.Info().Msg("Side effect completed")
+			continue
+		}
+
+		lastError := pgtype.Text{String: runErr.Error(), Valid: true}
+		if effect.Attempts >= maxSideEffectAttempts {
+			if err := s.queries.FailSideEffect(ctx, repository.FailSideEffectParams{ID: effect.ID, LastError: lastError}); err != nil {
+				return fmt.Errorf("failed to mark side effect failed: %w", err)
+			}
+			logger.Error().Err(runErr).Msg("Side effect failed permanently")
+			continue
+		}
+
+		nextAttempt := time.Now().Add(sideEffectBackoff(effect.Attempts))
+		if err := s.queries.RescheduleSideEffect(ctx, repository.RescheduleSideEffectParams{
+			ID:            effect.ID,
+			LastError:     lastError,
+			NextAttemptAt: pgtype.Timestamptz{Time: nextAttempt, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to reschedule side effect: %w", err)
+		}
+		logger.Warn().Err(runErr).Time("nextAttemptAt", nextAttempt).Msg("Side effect failed - rescheduled")
+	}
+	return nil
+}
+
+// sideEffectBackoff returns the delay after the given number of attempts
+func sideEffectBackoff(attempts int32) time.Duration {
+	backoff := sideEffectBaseBackoff
+	for i := int32(1); i < attempts && backoff < sideEffectMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, sideEffectMaxBackoff)
+}
+
+func (s *GamesService) runSideEffect(ctx context.Context, effect repository.SideEffect) error {
+	switch SideEffectKind(effect.Kind) {
+	case SideEffectReconcileRoster:
+		game, err := s.queries.GetGame(ctx, effect.GameID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				// Deleted games cascade their side effects, so this only races with a delete
+				return nil
+			}
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+		if game.Status == string(models.GameStatusCancelled) {
+			return nil
+		}
+		// The retried promotion is news to the players it confirmed, as it would have been inline
+		_, err = s.reconcileParticipantStatuses(ctx, effect.GameID, game.MaxParticipants)
+		return err
+
+	case SideEffectRefundCredits:
+		return s.refundCancelledGameCredits(ctx, effect.GameID)
+
+	case SideEffectNotifyCancellation:
+		participants, err := s.queries.ListParticipantsByGame(ctx, effect.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to list participants: %w", err)
+		}
+		game, err := s.queries.GetGame(ctx, effect.GameID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+		var reason *string
+		saved, err := s.queries.GetGameCancellationReason(ctx, effect.GameID)
+		switch {
+		case err == nil:
+			reason = &saved
+		case !errors.Is(err, pgx.ErrNoRows):
+			return fmt.Errorf("failed to get cancellation reason: %w", err)
+		}
+		// Only the notices are retried; the cancellation's other subscribers already ran
+		if s.notifier == nil {
+			log.Ctx(ctx).Info().Msg("Notifier not configured - skipping cancellation notifications")
+			return nil
+		}
+		s.notifier.sendCancellationNotices(ctx, gameEvent(game.ID, game.OwnerID, game.Title, game.Category, game.LocationName, game.StartTime), reason, rosterRecipients(participants))
+		return nil
+	}
+	return fmt.Errorf("unknown side effect kind %q", effect.Kind)
+}
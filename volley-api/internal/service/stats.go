@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// StatsService computes player statistics from participation history
+type StatsService struct {
+	queries ifaces.Querier
+}
+
+func NewStatsService(queries ifaces.Querier) *StatsService {
+	return &StatsService{queries: queries}
+}
+
+// RefreshReliabilityScores recomputes every player's reliability score from their finished games.
+// Scores are stored so rosters and profiles read them without aggregating history on each request.
+func (s *StatsService) RefreshReliabilityScores(ctx context.Context) error {
+	refreshed, err := s.queries.RefreshPlayerReliability(ctx, pgtype.UUID{})
+	if err != nil {
+		return fmt.Errorf("failed to refresh reliability scores: %w", err)
+	}
+	log.Ctx(ctx).Info().Int64("players", refreshed).Msg("Refreshed reliability scores")
+	return nil
+}
+
+// RefreshReliabilityScore recomputes one player's reliability score, so corrections to their history
+// show up without waiting for the next refresh of every player
+func (s *StatsService) RefreshReliabilityScore(ctx context.Context, userUUID pgtype.UUID) error {
+	if _, err := s.queries.RefreshPlayerReliability(ctx, userUUID); err != nil {
+		return fmt.Errorf("failed to refresh reliability score: %w", err)
+	}
+	return nil
+}
+
+// ReliabilityScores returns the reliability score of each user that has finished a game, keyed by user ID
+func (s *StatsService) ReliabilityScores(ctx context.Context, userUUIDs []pgtype.UUID) (map[string]models.ReliabilityScore, error) {
+	scores := map[string]models.ReliabilityScore{}
+	if len(userUUIDs) == 0 {
+		return scores, nil
+	}
+	rows, err := s.queries.ListPlayerReliability(ctx, userUUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reliability scores: %w", err)
+	}
+	for _, row := range rows {
+		scores[uuid.UUID(row.UserID.Bytes).String()] = models.ReliabilityScore{
+			Score:      int(row.Score),
+			Honored:    int(row.Honored),
+			LateDrops:  int(row.LateDrops),
+			NoShows:    int(row.NoShows),
+			ComputedAt: row.ComputedAt.Time.UTC(),
+		}
+	}
+	return scores, nil
+}
+
+// PlayerProfile returns the public profile of a player
+func (s *StatsService) PlayerProfile(ctx context.Context, userID string) (*models.PlayerProfile, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	user, err := s.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Deactivated profiles are hidden until their owner reactivates
+	deactivated, err := s.queries.IsUserDeactivated(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check deactivation: %w", err)
+	}
+	if deactivated {
+		return nil, apperrors.ErrNotFound
+	}
+
+	scores, err := s.ReliabilityScores(ctx, []pgtype.UUID{userUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	skills, err := s.SkillConfidences(ctx, []pgtype.UUID{userUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.playerStats(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &models.PlayerProfile{
+		ID:          userID,
+		FirstName:   user.FirstName,
+		LastName:    user.LastName,
+		MemberSince: user.CreatedAt.Time.UTC(),
+		Stats:       *stats,
+	}
+	if score, ok := scores[userID]; ok {
+		profile.Reliability = &score
+	}
+	if skill, ok := skills[userID]; ok {
+		profile.Skill = &skill
+	}
+	return profile, nil
+}
+
+// PlayerStats returns the play statistics of a player
+func (s *StatsService) PlayerStats(ctx context.Context, userID string) (*models.PlayerStats, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	return s.playerStats(ctx, userUUID)
+}
+
+// playerStats aggregates a player's completed games. Unlike reliability scores these are computed on
+// each request; the queries only touch the player's own participations and games.
+func (s *StatsService) playerStats(ctx context.Context, userUUID pgtype.UUID) (*models.PlayerStats, error) {
+	totals, err := s.queries.GetUserPlayStats(ctx, userUUID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to get play stats")
+		return nil, fmt.Errorf("failed to get play stats: %w", err)
+	}
+
+	stats := &models.PlayerStats{
+		GamesPlayed: int(totals.GamesPlayed),
+		GamesHosted: int(totals.GamesHosted),
+	}
+	if confirmed := totals.GamesPlayed + totals.NoShows; confirmed > 0 {
+		rate := int(math.Round(100 * float64(totals.GamesPlayed) / float64(confirmed)))
+		stats.AttendanceRate = &rate
+	}
+	if totals.GamesPlayed == 0 {
+		return stats, nil
+	}
+
+	category, err := s.queries.GetUserFavoriteCategory(ctx, userUUID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to get favorite category")
+		return nil, fmt.Errorf("failed to get favorite category: %w", err)
+	}
+	if err == nil {
+		favorite := models.GameCategory(category)
+		stats.FavoriteSport = &favorite
+	}
+	return stats, nil
+}
+
+// attachReliabilityScores sets Reliability on the participants that have finished a game
+func (s *GamesService) attachReliabilityScores(ctx context.Context, participants ...[]models.Participant) error {
+	if s.stats == nil {
+		return nil
+	}
+	scores, err := s.stats.ReliabilityScores(ctx, participantUserUUIDs(participants...))
+	if err != nil {
+		return err
+	}
+	for _, list := range participants {
+		for i := range list {
+			if score, ok := scores[list[i].ID]; ok {
+				list[i].Reliability = &score
+			}
+		}
+	}
+	return nil
+}
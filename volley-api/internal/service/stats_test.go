@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshReliabilityScores(t *testing.T) {
+	mockQuerier := mocks.NewQuerier(t)
+	mockQuerier.EXPECT().RefreshPlayerReliability(mock.Anything, pgtype.UUID{}).Return(int64(3), nil)
+
+	err := NewStatsService(mockQuerier).RefreshReliabilityScores(context.Background())
+	require.NoError(t, err)
+}
+
+func TestPlayerProfile(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	computedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("includes the reliability score", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{
+			ID:        userUUID,
+			FirstName: "Jamie",
+			LastName:  "Rivera",
+			CreatedAt: pgtype.Timestamptz{Time: computedAt.AddDate(-1, 0, 0), Valid: true},
+		}, nil)
+		mockQuerier.EXPECT().IsUserDeactivated(mock.Anything, userUUID).Return(false, nil)
+		mockQuerier.EXPECT().GetUserPlayStats(mock.Anything, userUUID).Return(repository.GetUserPlayStatsRow{GamesPlayed: 9, NoShows: 1, GamesHosted: 2}, nil)
+		mockQuerier.EXPECT().GetUserFavoriteCategory(mock.Anything, userUUID).Return("soccer", nil)
+		mockQuerier.EXPECT().ListPlayerReliability(mock.Anything, []pgtype.UUID{userUUID}).Return([]repository.PlayerReliability{{
+			UserID:     userUUID,
+			Honored:    8,
+			LateDrops:  1,
+			NoShows:    1,
+			Score:      80,
+			ComputedAt: pgtype.Timestamptz{Time: computedAt, Valid: true},
+		}}, nil)
+		mockQuerier.EXPECT().ListSkillEndorsementCounts(mock.Anything, []pgtype.UUID{userUUID}).Return([]repository.ListSkillEndorsementCountsRow{
+			{EndorseeID: userUUID, SkillLevel: "intermediate", Endorsers: 3},
+			{EndorseeID: userUUID, SkillLevel: "advanced", Endorsers: 1},
+		}, nil)
+
+		profile, err := NewStatsService(mockQuerier).PlayerProfile(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, "Jamie", profile.FirstName)
+		assert.Equal(t, &models.ReliabilityScore{Score: 80, Honored: 8, LateDrops: 1, NoShows: 1, ComputedAt: computedAt}, profile.Reliability)
+		assert.Equal(t, &models.SkillConfidence{Level: models.SkillLevelIntermediate, Confidence: 75, Endorsers: 4}, profile.Skill)
+		assert.Equal(t, 9, profile.Stats.GamesPlayed)
+	})
+
+	t.Run("omits the score until a game is finished", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID, FirstName: "Jamie"}, nil)
+		mockQuerier.EXPECT().IsUserDeactivated(mock.Anything, userUUID).Return(false, nil)
+		mockQuerier.EXPECT().ListPlayerReliability(mock.Anything, []pgtype.UUID{userUUID}).Return([]repository.PlayerReliability{}, nil)
+		mockQuerier.EXPECT().ListSkillEndorsementCounts(mock.Anything, []pgtype.UUID{userUUID}).Return([]repository.ListSkillEndorsementCountsRow{}, nil)
+		mockQuerier.EXPECT().GetUserPlayStats(mock.Anything, userUUID).Return(repository.GetUserPlayStatsRow{}, nil)
+
+		profile, err := NewStatsService(mockQuerier).PlayerProfile(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Nil(t, profile.Reliability)
+		assert.Nil(t, profile.Skill)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, mock.Anything).Return(repository.User{}, pgx.ErrNoRows)
+
+		_, err := NewStatsService(mockQuerier).PlayerProfile(context.Background(), userID)
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("deactivated user is hidden", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID, FirstName: "Jamie"}, nil)
+		mockQuerier.EXPECT().IsUserDeactivated(mock.Anything, userUUID).Return(true, nil)
+
+		_, err := NewStatsService(mockQuerier).PlayerProfile(context.Background(), userID)
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("invalid user ID", func(t *testing.T) {
+		_, err := NewStatsService(mocks.NewQuerier(t)).PlayerProfile(context.Background(), "not-a-uuid")
+		var invalidArgErr *InvalidArgumentError
+		assert.ErrorAs(t, err, &invalidArgErr)
+	})
+}
+
+func TestAttachReliabilityScores(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	placeholderID := "123e4567-e89b-12d3-a456-426614174009"
+	mockQuerier := mocks.NewQuerier(t)
+	userUUID := createTestUUID(t, userID)
+
+	// Placeholders have no account, so only the player is looked up
+	mockQuerier.EXPECT().ListPlayerReliability(mock.Anything, []pgtype.UUID{userUUID}).Return([]repository.PlayerReliability{{
+		UserID: userUUID,
+		Score:  100,
+	}}, nil)
+
+	service := &GamesService{queries: mockQuerier, stats: NewStatsService(mockQuerier)}
+	participants := []models.Participant{
+		{User: models.User{ID: userID}},
+		{User: models.User{ID: placeholderID}, PlaceholderID: &placeholderID},
+	}
+	require.NoError(t, service.attachReliabilityScores(context.Background(), participants))
+	require.NotNil(t, participants[0].Reliability)
+	assert.Equal(t, 100, participants[0].Reliability.Score)
+	assert.Nil(t, participants[1].Reliability)
+}
+
+func TestSkillConfidences(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	mockQuerier := mocks.NewQuerier(t)
+	userUUID := createTestUUID(t, userID)
+
+	// A split vote goes to the lower level
+	mockQuerier.EXPECT().ListSkillEndorsementCounts(mock.Anything, []pgtype.UUID{userUUID}).Return([]repository.ListSkillEndorsementCountsRow{
+		{EndorseeID: userUUID, SkillLevel: "advanced", Endorsers: 2},
+		{EndorseeID: userUUID, SkillLevel: "intermediate", Endorsers: 2},
+		{EndorseeID: userUUID, SkillLevel: "beginner", Endorsers: 1},
+	}, nil)
+
+	confidences, err := NewStatsService(mockQuerier).SkillConfidences(context.Background(), []pgtype.UUID{userUUID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]models.SkillConfidence{
+		userID: {Level: models.SkillLevelIntermediate, Confidence: 40, Endorsers: 5},
+	}, confidences)
+}
+
+func TestPlayerStats(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("aggregates completed games", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		mockQuerier.EXPECT().GetUserPlayStats(mock.Anything, userUUID).Return(repository.GetUserPlayStatsRow{GamesPlayed: 7, NoShows: 1, GamesHosted: 3}, nil)
+		mockQuerier.EXPECT().GetUserFavoriteCategory(mock.Anything, userUUID).Return("pickleball", nil)
+
+		stats, err := NewStatsService(mockQuerier).PlayerStats(context.Background(), userID)
+		require.NoError(t, err)
+
+		favorite := models.GameCategoryPickleball
+		rate := 88
+		assert.Equal(t, &models.PlayerStats{GamesPlayed: 7, GamesHosted: 3, FavoriteSport: &favorite, AttendanceRate: &rate}, stats)
+	})
+
+	t.Run("new player has no rate or favorite", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserPlayStats(mock.Anything, mock.Anything).Return(repository.GetUserPlayStatsRow{GamesHosted: 1}, nil)
+
+		stats, err := NewStatsService(mockQuerier).PlayerStats(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, &models.PlayerStats{GamesHosted: 1}, stats)
+	})
+}
+
+func TestRefreshLeaderboards(t *testing.T) {
+	mockQuerier := mocks.NewQuerier(t)
+	var refreshedAt pgtype.Timestamptz
+	mockQuerier.EXPECT().RefreshLeaderboardCells(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, computedAt pgtype.Timestamptz) { refreshedAt = computedAt }).
+		Return(int64(12), nil)
+	// Prunes exactly the rows the refresh didn't stamp
+	mockQuerier.EXPECT().PruneLeaderboardCells(mock.Anything, mock.MatchedBy(func(computedAt pgtype.Timestamptz) bool {
+		return computedAt == refreshedAt
+	})).Return(int64(2), nil)
+
+	require.NoError(t, NewStatsService(mockQuerier).RefreshLeaderboards(context.Background()))
+}
+
+func TestLeaderboard(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	soccer := models.GameCategorySoccer
+
+	t.Run("ranks a page and reports the next one", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		mockQuerier.EXPECT().ListLeaderboard(mock.Anything, repository.ListLeaderboardParams{
+			Longitude:  -122.42,
+			Latitude:   37.77,
+			Radius:     5000,
+			Category:   pgtype.Text{String: "soccer", Valid: true},
+			Metric:     "mvps",
+			PageLimit:  2,
+			PageOffset: 10,
+		}).Return([]repository.ListLeaderboardRow{
+			{UserID: userUUID, FirstName: "Jamie", GamesPlayed: 20, GamesHosted: 1, Mvps: 6},
+			{UserID: userUUID, FirstName: "Alex", GamesPlayed: 9, Mvps: 4},
+		}, nil)
+
+		leaderboard, err := NewStatsService(mockQuerier).Leaderboard(context.Background(), LeaderboardFilters{
+			Metric:    models.LeaderboardMetricMVPs,
+			Category:  &soccer,
+			Latitude:  37.77,
+			Longitude: -122.42,
+			Radius:    5000,
+			Limit:     1,
+			Offset:    10,
+		})
+		require.NoError(t, err)
+		require.Len(t, leaderboard.Entries, 1)
+		assert.Equal(t, models.LeaderboardEntry{
+			Rank:        11,
+			UserID:      userID,
+			FirstName:   "Jamie",
+			GamesPlayed: 20,
+			GamesHosted: 1,
+			MVPs:        6,
+		}, leaderboard.Entries[0])
+		assert.True(t, leaderboard.HasMore)
+		require.NotNil(t, leaderboard.NextOffset)
+		assert.Equal(t, 11, *leaderboard.NextOffset)
+	})
+
+	t.Run("defaults to games played", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().ListLeaderboard(mock.Anything, mock.MatchedBy(func(params repository.ListLeaderboardParams) bool {
+			return params.Metric == "played" && !params.Category.Valid && params.PageLimit == 51
+		})).Return([]repository.ListLeaderboardRow{}, nil)
+
+		leaderboard, err := NewStatsService(mockQuerier).Leaderboard(context.Background(), LeaderboardFilters{Radius: 1000})
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaderboardMetricPlayed, leaderboard.Metric)
+		assert.Empty(t, leaderboard.Entries)
+		assert.False(t, leaderboard.HasMore)
+	})
+
+	t.Run("rejects an unknown metric", func(t *testing.T) {
+		_, err := NewStatsService(mocks.NewQuerier(t)).Leaderboard(context.Background(), LeaderboardFilters{Metric: "wins", Radius: 1000})
+		var invalidArgErr *InvalidArgumentError
+		assert.ErrorAs(t, err, &invalidArgErr)
+	})
+}
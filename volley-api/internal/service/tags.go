@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// maxGameTags caps how many tags a game can have
+	maxGameTags = 10
+	// maxGameTagLength caps a tag, in characters; matches game_tags.tag
+	maxGameTagLength = 30
+)
+
+var gameTagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// normalizeGameTags canonicalizes a game's tags and checks there aren't too many of them
+func normalizeGameTags(tags []string) ([]string, error) {
+	normalized, err := canonicalTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(normalized) > maxGameTags {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "tags",
+			Message:      fmt.Sprintf("a game can have at most %d tags", maxGameTags),
+		}
+	}
+	return normalized, nil
+}
+
+// canonicalTags lowercases tags and joins words with hyphens, so "Beginner Friendly" and
+// "beginner-friendly" are the same tag, then drops duplicates and sorts them. Tags are letters,
+// digits and hyphens.
+func canonicalTags(tags []string) ([]string, error) {
+	canonical := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+		if tag == "" {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "tags",
+				Message:      "tags must not be empty",
+			}
+		}
+		if len(tag) > maxGameTagLength {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "tags",
+				Message:      fmt.Sprintf("tag %q is longer than %d characters", tag, maxGameTagLength),
+			}
+		}
+		if !gameTagPattern.MatchString(tag) {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "tags",
+				Message:      fmt.Sprintf("tag %q may only contain letters, digits and hyphens", tag),
+			}
+		}
+		canonical = append(canonical, tag)
+	}
+	slices.Sort(canonical)
+	return slices.Compact(canonical), nil
+}
+
+// addGameTags stores the game's tags, returning them normalized. Call it in the transaction that
+// creates the game, or after DeleteGameTags when replacing them.
+func addGameTags(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID, tags []string) ([]string, error) {
+	normalized, err := normalizeGameTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(normalized) == 0 {
+		return normalized, nil
+	}
+	if err := q.AddGameTags(ctx, repository.AddGameTagsParams{
+		GameID: gameUUID,
+		Tags:   normalized,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to add game tags: %w", err)
+	}
+	return normalized, nil
+}
+
+// gameTagsText is the game's tags as one string for the content filter, or nil without tags
+func gameTagsText(tags []string) *string {
+	if len(tags) == 0 {
+		return nil
+	}
+	text := strings.Join(tags, " ")
+	return &text
+}
@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// Teams split a game's players into sides. Hosts create, rename and delete them and put confirmed
+// or waitlisted participants on them; GetGame returns each team with its players.
+
+// teamColorPattern matches the hex colors a team can have, #RGB or #RRGGBB
+var teamColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// validateTeamColor rejects colors that aren't hex color codes
+func validateTeamColor(color *string) error {
+	if color != nil && !teamColorPattern.MatchString(*color) {
+		return &InvalidArgumentError{
+			ArgumentName: "color",
+			Message:      "color must be a hex code like #FF0000",
+		}
+	}
+	return nil
+}
+
+// ListTeams returns the game's teams with the players the viewer can see
+func (s *GamesService) ListTeams(ctx context.Context, gameID string, viewerID string) ([]models.Team, error) {
+	game, err := s.GetGame(ctx, gameID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if game.Teams == nil {
+		return []models.Team{}, nil
+	}
+	return game.Teams, nil
+}
+
+// CreateTeam adds a team to the owner's game
+func (s *GamesService) CreateTeam(ctx context.Context, gameID string, ownerID string, request models.CreateTeamRequest) (*models.Team, error) {
+	var gameUUID, ownerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := validateTeamColor(request.Color); err != nil {
+		return nil, err
+	}
+
+	var team repository.Team
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		if _, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID); err != nil {
+			return err
+		}
+		var err error
+		team, err = q.CreateTeam(ctx, repository.CreateTeamParams{
+			GameID: gameUUID,
+			Name:   request.Name,
+			Color:  stringPtrToPgText(request.Color),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create team: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Str("teamId", uuid.UUID(team.ID.Bytes).String()).Msg("Team created")
+	return convertTeamToModel(team), nil
+}
+
+// UpdateTeam renames or recolors a team of the owner's game. Omitted fields keep their value.
+func (s *GamesService) UpdateTeam(ctx context.Context, gameID string, ownerID string, teamID string, request models.UpdateTeamRequest) (*models.Team, error) {
+	gameUUID, ownerUUID, teamUUID, err := parseTeamIDs(gameID, ownerID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTeamColor(request.Color); err != nil {
+		return nil, err
+	}
+
+	var team repository.Team
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		if _, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID); err != nil {
+			return err
+		}
+		if _, err := getGameTeam(ctx, q, gameUUID, teamUUID); err != nil {
+			return err
+		}
+		var err error
+		team, err = q.UpdateTeam(ctx, repository.UpdateTeamParams{
+			Name:  stringPtrToPgText(request.Name),
+			Color: stringPtrToPgText(request.Color),
+			ID:    teamUUID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update team: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Str("teamId", teamID).Msg("Team updated")
+	return convertTeamToModel(team), nil
+}
+
+// DeleteTeam deletes a team of the owner's game. Its players stay on the roster without a team.
+func (s *GamesService) DeleteTeam(ctx context.Context, gameID string, ownerID string, teamID string) error {
+	gameUUID, ownerUUID, teamUUID, err := parseTeamIDs(gameID, ownerID, teamID)
+	if err != nil {
+		return err
+	}
+
+	var unassigned int64
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		if _, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID); err != nil {
+			return err
+		}
+		if _, err := getGameTeam(ctx, q, gameUUID, teamUUID); err != nil {
+			return err
+		}
+		var err error
+		unassigned, err = q.UnassignTeamParticipants(ctx, teamUUID)
+		if err != nil {
+			return fmt.Errorf("failed to unassign team players: %w", err)
+		}
+		if err := q.DeleteTeam(ctx, teamUUID); err != nil {
+			return fmt.Errorf("failed to delete team: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Info().Str("teamId", teamID).Int64("unassigned", unassigned).Msg("Team deleted")
+	return nil
+}
+
+// AssignTeamPlayers puts confirmed or waitlisted participants of the owner's game on one of its
+// teams and returns the updated game. Participants on another team move to this one.
+func (s *GamesService) AssignTeamPlayers(ctx context.Context, gameID string, ownerID string, teamID string, request models.AssignTeamPlayersRequest) (*models.Game, error) {
+	gameUUID, ownerUUID, teamUUID, err := parseTeamIDs(gameID, ownerID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.UserIDs) == 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_ids",
+			Message:      "at least one user ID is required",
+		}
+	}
+
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		if _, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID); err != nil {
+			return err
+		}
+		if _, err := getGameTeam(ctx, q, gameUUID, teamUUID); err != nil {
+			return err
+		}
+		active, err := activeParticipantsByID(ctx, q, gameUUID)
+		if err != nil {
+			return err
+		}
+
+		for _, userID := range request.UserIDs {
+			participant, err := lookupActiveParticipant(active, userID)
+			if err != nil {
+				return err
+			}
+			if _, err := q.UpdateParticipantTeam(ctx, repository.UpdateParticipantTeamParams{
+				ID:     participant.ID,
+				TeamID: teamUUID,
+			}); err != nil {
+				return fmt.Errorf("failed to assign team: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Str("teamId", teamID).Int("players", len(request.UserIDs)).Msg("Players assigned to team")
+	return s.GetGame(ctx, gameID, ownerID)
+}
+
+// UnassignTeamPlayer takes a participant of the owner's game off one of its teams and returns the
+// updated game. The participant keeps their place on the roster.
+func (s *GamesService) UnassignTeamPlayer(ctx context.Context, gameID string, ownerID string, teamID string, userID string) (*models.Game, error) {
+	gameUUID, ownerUUID, teamUUID, err := parseTeamIDs(gameID, ownerID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.inTx(ctx, func(q ifaces.Querier) error {
+		if _, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID); err != nil {
+			return err
+		}
+		if _, err := getGameTeam(ctx, q, gameUUID, teamUUID); err != nil {
+			return err
+		}
+		active, err := activeParticipantsByID(ctx, q, gameUUID)
+		if err != nil {
+			return err
+		}
+		participant, err := lookupActiveParticipant(active, userID)
+		if err != nil {
+			return err
+		}
+		if participant.TeamID != teamUUID {
+			return &InvalidArgumentError{
+				ArgumentName: "user_id",
+				Message:      fmt.Sprintf("%s is not on this team", userID),
+			}
+		}
+
+		if _, err := q.UpdateParticipantTeam(ctx, repository.UpdateParticipantTeamParams{
+			ID: participant.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to unassign team: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Str("teamId", teamID).Str("playerId", userID).Msg("Player removed from team")
+	return s.GetGame(ctx, gameID, ownerID)
+}
+
+// parseTeamIDs validates the IDs of a team request
+func parseTeamIDs(gameID, ownerID, teamID string) (gameUUID, ownerUUID, teamUUID pgtype.UUID, err error) {
+	if err := gameUUID.Scan(gameID); err != nil {
+		return gameUUID, ownerUUID, teamUUID, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return gameUUID, ownerUUID, teamUUID, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := teamUUID.Scan(teamID); err != nil {
+		return gameUUID, ownerUUID, teamUUID, &InvalidArgumentError{
+			ArgumentName: "team_id",
+			Message:      "invalid team ID format",
+		}
+	}
+	return gameUUID, ownerUUID, teamUUID, nil
+}
+
+// getGameTeam returns a team of the game, or ErrNotFound if the team belongs to another game
+func getGameTeam(ctx context.Context, q ifaces.Querier, gameUUID, teamUUID pgtype.UUID) (repository.Team, error) {
+	team, err := q.GetTeam(ctx, teamUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return team, apperrors.ErrNotFound
+		}
+		return team, fmt.Errorf("failed to get team: %w", err)
+	}
+	if team.GameID != gameUUID {
+		return team, apperrors.ErrNotFound
+	}
+	return team, nil
+}
+
+// activeParticipantsByID indexes a game's confirmed and waitlisted participants by user ID, or by
+// participant ID for placeholders, matching the IDs the roster shows
+func activeParticipantsByID(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID) (map[string]repository.ListActiveParticipantsByGameRow, error) {
+	participants, err := q.ListActiveParticipantsByGame(ctx, gameUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+	active := make(map[string]repository.ListActiveParticipantsByGameRow, len(participants))
+	for _, p := range participants {
+		key := p.ID
+		if p.UserID.Valid {
+			key = p.UserID
+		}
+		active[uuid.UUID(key.Bytes).String()] = p
+	}
+	return active, nil
+}
+
+// lookupActiveParticipant finds a participant indexed by activeParticipantsByID
+func lookupActiveParticipant(active map[string]repository.ListActiveParticipantsByGameRow, userID string) (repository.ListActiveParticipantsByGameRow, error) {
+	parsed, err := uuid.Parse(userID)
+	if err != nil {
+		return repository.ListActiveParticipantsByGameRow{}, &InvalidArgumentError{
+			ArgumentName: "user_ids",
+			Message:      fmt.Sprintf("invalid user ID %q", userID),
+		}
+	}
+	participant, ok := active[parsed.String()]
+	if !ok {
+		return participant, &InvalidArgumentError{
+			ArgumentName: "user_ids",
+			Message:      fmt.Sprintf("%s is not confirmed or waitlisted in this game", userID),
+		}
+	}
+	return participant, nil
+}
+
+// teamRosters returns the game's teams, each with the given participants assigned to it
+func teamRosters(teams []repository.Team, participants ...[]models.Participant) []models.Team {
+	result := make([]models.Team, 0, len(teams))
+	index := make(map[string]int, len(teams))
+	for _, t := range teams {
+		team := convertTeamToModel(t)
+		index[team.ID] = len(result)
+		result = append(result, *team)
+	}
+	for _, list := range participants {
+		for _, p := range list {
+			if p.TeamID == nil {
+				continue
+			}
+			if i, ok := index[*p.TeamID]; ok {
+				result[i].Players = append(result[i].Players, p)
+			}
+		}
+	}
+	return result
+}
+
+// convertTeamToModel converts a repository.Team to a models.Team
+func convertTeamToModel(team repository.Team) *models.Team {
+	return &models.Team{
+		ID:        uuid.UUID(team.ID.Bytes).String(),
+		GameID:    uuid.UUID(team.GameID.Bytes).String(),
+		Name:      team.Name,
+		Color:     pgTextToStringPtr(team.Color),
+		CreatedAt: team.CreatedAt.Time.UTC(),
+	}
+}
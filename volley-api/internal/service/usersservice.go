@@ -2,33 +2,124 @@ package service
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gabe-dev-svc/volley/ifaces"
-	"github.com/gabe-dev-svc/volley/internal/errors"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
 	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
 	"github.com/gabe-dev-svc/volley/internal/repository"
 	"github.com/gabe-dev-svc/volley/internal/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	// adultAge is the age at which users stop being treated as minors
+	adultAge = 18
+
+	// phoneVerificationTTL is how long an SMS verification code stays valid
+	phoneVerificationTTL = 10 * time.Minute
+	// phoneVerificationCooldown is the minimum time between two codes for the same user
+	phoneVerificationCooldown = time.Minute
+	// maxPhoneVerificationsPerHour caps how many codes a user can request in an hour
+	maxPhoneVerificationsPerHour = 5
+	// maxPhoneVerificationAttempts is the number of guesses allowed per code
+	maxPhoneVerificationAttempts = 5
+
+	// emailChangeTTL is how long an email change confirmation link stays valid
+	emailChangeTTL = 24 * time.Hour
+	// emailChangeCooldown is the minimum time between two confirmation links for the same user
+	emailChangeCooldown = time.Minute
+
+	// loginCodeTTL is how long an SMS sign-in code stays valid
+	loginCodeTTL = 5 * time.Minute
+
+	// magicLinkTTL is how long a passwordless sign-in link stays valid
+	magicLinkTTL = 15 * time.Minute
+	// magicLinkCooldown is the minimum time between two sign-in links for the same user
+	magicLinkCooldown = time.Minute
+
+	// DefaultAppURL is the web app base URL used in emailed links when a deployment does not configure one
+	DefaultAppURL = "https://app.volley.gg"
+
+	// DefaultRegion is the region used when a deployment does not configure one
+	DefaultRegion = "primary"
+)
+
+var (
+	ErrInvalidVerificationCode = errors.New("invalid or expired verification code")
+	ErrPhoneNotVerified        = errors.New("a verified phone number is required")
+	ErrLegalAcceptanceRequired = errors.New("the current legal documents must be accepted")
+	ErrInvalidPassword         = errors.New("invalid password")
+	ErrInvalidCredentials      = errors.New("invalid email or password")
+	ErrInvalidEmailChangeToken = errors.New("invalid or expired email change link")
+	ErrInvalidMagicLink        = errors.New("invalid or expired sign-in link")
+	ErrTokenRevoked            = errors.New("access token has been revoked")
+	ErrAccountSuspended        = errors.New("account has been suspended")
+)
+
 type UserService struct {
-	queries ifaces.Querier
+	queries     ifaces.Querier
+	smsSender   notifications.SMSSender
+	emailSender notifications.EmailSender
+	generateOTP func() (code string, codeHash string, err error)
+	region      string
+	appURL      string
+	webAuthn    WebAuthnConfig
 }
 
-func NewUserService(queries ifaces.Querier) *UserService {
+func NewUserService(queries ifaces.Querier, smsSender notifications.SMSSender, emailSender notifications.EmailSender) *UserService {
 	return &UserService{
-		queries: queries,
+		queries:     queries,
+		smsSender:   smsSender,
+		emailSender: emailSender,
+		generateOTP: util.GenerateOTP,
+		region:      DefaultRegion,
+		appURL:      DefaultAppURL,
+	}
+}
+
+// SetRegion sets the region this deployment serves. New accounts are homed to it.
+func (u *UserService) SetRegion(region string) {
+	u.region = region
+}
+
+// SetAppURL sets the web app base URL that emailed links point at
+func (u *UserService) SetAppURL(appURL string) {
+	u.appURL = strings.TrimRight(appURL, "/")
+}
+
+// UseFixedVerificationCode makes every phone verification code equal to code. Only for sandbox
+// mode, where QA needs a predictable code and no SMS is actually delivered.
+func (u *UserService) UseFixedVerificationCode(code string) {
+	u.generateOTP = func() (string, string, error) {
+		return code, util.HashOTP(code), nil
 	}
 }
 
 type CreateUserRequest struct {
-	FirstName string
-	LastName  string
-	Password  string
-	Email     string
+	FirstName              string
+	LastName               string
+	Password               string
+	Email                  string
+	Birthdate              time.Time
+	AcceptedLegalDocuments []models.LegalDocumentRef
+	ClientInfo             ClientInfo
+	ReferralCode           string // Optional code of the referring user
+}
+
+// ClientInfo describes the client making a request, recorded alongside legal acceptances
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
 }
 
 func (u *UserService) CreateUser(ctx context.Context, req CreateUserRequest) (*models.User, error) {
@@ -38,7 +129,26 @@ func (u *UserService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 	existingUser, err := u.queries.GetUserByEmail(ctx, req.Email)
 	if err == nil && existingUser.ID.Valid {
 		logger.Warn().Str("email", req.Email).Msg("User with this email already exists")
-		return nil, fmt.Errorf("user with email %s: %w", req.Email, errors.ErrAlreadyExists)
+		return nil, fmt.Errorf("user with email %s: %w", req.Email, apperrors.ErrAlreadyExists)
+	}
+
+	now := time.Now()
+	if req.Birthdate.After(now) || req.Birthdate.Before(now.AddDate(-120, 0, 0)) {
+		return nil, &InvalidArgumentError{ArgumentName: "birthdate", Message: "birthdate is not a valid date of birth"}
+	}
+
+	// Every current legal document must be accepted to sign up
+	acceptedDocuments, err := u.matchCurrentLegalDocuments(ctx, req.AcceptedLegalDocuments, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// An unknown referral code fails sign-up rather than silently losing the referral
+	var referrer *repository.ReferralCode
+	if req.ReferralCode != "" {
+		if referrer, err = u.lookupReferralCode(ctx, req.ReferralCode); err != nil {
+			return nil, err
+		}
 	}
 
 	// Create user object
@@ -60,6 +170,8 @@ func (u *UserService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 		FirstName:    req.FirstName,
 		LastName:     req.LastName,
 		PasswordHash: hashedPassword,
+		Birthdate:    pgtype.Date{Time: req.Birthdate, Valid: true},
+		HomeRegion:   u.region,
 	})
 	if err != nil {
 		logger.Error().Err(err).Msg("CreateUser failed")
@@ -67,6 +179,27 @@ func (u *UserService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 	}
 	user.ID = newUser.ID.String()
 	user.CreatedAt = newUser.CreatedAt.Time
+	user.HomeRegion = newUser.HomeRegion
+
+	if err := u.recordLegalAcceptances(ctx, newUser.ID, acceptedDocuments, req.ClientInfo); err != nil {
+		return nil, err
+	}
+
+	// The account exists now, so referral bookkeeping failures are logged rather than failing sign-up
+	if referrer != nil {
+		if err := u.queries.CreateReferral(ctx, repository.CreateReferralParams{
+			ReferredID: newUser.ID,
+			ReferrerID: referrer.UserID,
+			Code:       referrer.Code,
+		}); err != nil {
+			logger.Error().Err(err).Msg("Failed to record referral")
+		} else if err := recordCredit(ctx, u.queries, referrer.UserID, referralRewardCents, models.CreditKindReferral, pgtype.UUID{}); err != nil {
+			logger.Error().Err(err).Msg("Failed to credit referrer")
+		}
+	}
+	if _, err := u.ensureReferralCode(ctx, newUser.ID); err != nil {
+		logger.Error().Err(err).Msg("Failed to create referral code")
+	}
 
 	// Print for debugging (remove in production)
 	fmt.Printf("User registered: %s %s (%s)\n", req.FirstName, req.LastName, req.Email)
@@ -75,37 +208,149 @@ func (u *UserService) CreateUser(ctx context.Context, req CreateUserRequest) (*m
 	return user, nil
 }
 
-func (u *UserService) Login(ctx context.Context, email string, password string) (*models.User, error) {
+// Login checks an email and password. Failed attempts are counted per email and per client IP;
+// once either has too many recent failures, Login returns a *LoginThrottledError without checking
+// the password. A deactivated account gets ErrAccountDeactivated and must use Reactivate instead.
+func (u *UserService) Login(ctx context.Context, email string, password string, ipAddress string) (*models.User, error) {
+	dbUser, err := u.checkPassword(ctx, email, password, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.rejectDeactivated(ctx, dbUser.ID); err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Str("email", email).Msg("User logged in successfully")
+	return convertUserToModel(dbUser), nil
+}
+
+// checkPassword returns the user registered with email if password matches, counting failures
+// towards the login throttle
+func (u *UserService) checkPassword(ctx context.Context, email string, password string, ipAddress string) (repository.User, error) {
 	logger := log.Ctx(ctx)
 
+	throttleKey := normalizeLoginEmail(email)
+	failures, err := u.checkLoginThrottle(ctx, throttleKey, ipAddress)
+	if err != nil {
+		return repository.User{}, err
+	}
+
 	// Get user by email
 	dbUser, err := u.queries.GetUserByEmail(ctx, email)
 	if err != nil {
 		logger.Warn().Str("email", email).Msg("User not found")
-		return nil, fmt.Errorf("invalid email or password")
+		u.recordFailedLogin(ctx, throttleKey, ipAddress, failures)
+		return repository.User{}, ErrInvalidCredentials
 	}
 
 	// Verify password
 	valid, err := util.VerifyPassword(password, dbUser.PasswordHash)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to verify password")
-		return nil, fmt.Errorf("invalid email or password")
+		return repository.User{}, ErrInvalidCredentials
 	}
 	if !valid {
 		logger.Warn().Str("email", email).Msg("Invalid password")
-		return nil, fmt.Errorf("invalid email or password")
+		u.recordFailedLogin(ctx, throttleKey, ipAddress, failures)
+		return repository.User{}, ErrInvalidCredentials
 	}
 
-	user := &models.User{
-		ID:        dbUser.ID.String(),
-		Email:     dbUser.Email,
-		FirstName: dbUser.FirstName,
-		LastName:  dbUser.LastName,
-		CreatedAt: dbUser.CreatedAt.Time,
+	if failures.email > 0 {
+		if err := u.queries.ClearFailedLoginsByEmail(ctx, throttleKey); err != nil {
+			logger.Error().Err(err).Msg("Failed to clear failed logins")
+		}
 	}
 
-	logger.Info().Str("email", email).Msg("User logged in successfully")
-	return user, nil
+	return dbUser, nil
+}
+
+// RequestMagicLink emails a single-use sign-in link to the account registered with email.
+// Unknown addresses and repeated requests succeed silently so the endpoint can't be used to find accounts.
+func (u *UserService) RequestMagicLink(ctx context.Context, email string) error {
+	logger := log.Ctx(ctx)
+
+	dbUser, err := u.queries.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Info().Str("email", email).Msg("Magic link requested for unknown email")
+			return nil
+		}
+		logger.Error().Err(err).Msg("Failed to get user by email")
+		return fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	now := time.Now()
+	latest, err := u.queries.GetLatestMagicLinkToken(ctx, dbUser.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error().Err(err).Msg("Failed to get latest magic link")
+		return fmt.Errorf("failed to get latest magic link: %w", err)
+	}
+	if err == nil && now.Sub(latest.CreatedAt.Time) < magicLinkCooldown {
+		logger.Warn().Str("userID", dbUser.ID.String()).Msg("Magic link requested too recently")
+		return nil
+	}
+
+	token, tokenHash, err := util.GenerateLinkToken()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to generate magic link token")
+		return fmt.Errorf("failed to generate magic link token: %w", err)
+	}
+
+	if _, err := u.queries.CreateMagicLinkToken(ctx, repository.CreateMagicLinkTokenParams{
+		UserID:    dbUser.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamptz{Time: now.Add(magicLinkTTL), Valid: true},
+	}); err != nil {
+		logger.Error().Err(err).Msg("Failed to store magic link token")
+		return fmt.Errorf("failed to store magic link token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/magic-link?token=%s", u.appURL, url.QueryEscape(token))
+	message, err := notifications.RenderEmail(notifications.EmailMagicLink, notifications.MagicLinkEmail{
+		Link:             link,
+		ExpiresInMinutes: int(magicLinkTTL.Minutes()),
+	}, "")
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to render magic link email")
+		return err
+	}
+	if err := u.emailSender.SendEmail(ctx, dbUser.Email, message); err != nil {
+		logger.Error().Err(err).Msg("Failed to send magic link")
+		return fmt.Errorf("failed to send magic link: %w", err)
+	}
+
+	logger.Info().Str("userID", dbUser.ID.String()).Msg("Magic link sent")
+	return nil
+}
+
+// RedeemMagicLink signs in the user a sign-in link was sent to. Each link works once.
+func (u *UserService) RedeemMagicLink(ctx context.Context, token string) (*models.User, error) {
+	logger := log.Ctx(ctx)
+
+	magicLink, err := u.queries.ConsumeMagicLinkToken(ctx, util.HashLinkToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidMagicLink
+		}
+		logger.Error().Err(err).Msg("Failed to redeem magic link")
+		return nil, fmt.Errorf("failed to redeem magic link: %w", err)
+	}
+
+	dbUser, err := u.queries.GetUserByID(ctx, magicLink.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidMagicLink
+		}
+		logger.Error().Err(err).Msg("Failed to get user")
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := u.rejectDeactivated(ctx, dbUser.ID); err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("userID", dbUser.ID.String()).Msg("User signed in with magic link")
+	return convertUserToModel(dbUser), nil
 }
 
 // CreateRefreshTokenForUser creates a new refresh token for a user
@@ -133,7 +378,7 @@ func (u *UserService) CreateRefreshTokenForUser(ctx context.Context, userID stri
 	}
 
 	_, err = u.queries.CreateRefreshToken(ctx, repository.CreateRefreshTokenParams{
-		UserID: userUUID,
+		UserID:    userUUID,
 		TokenHash: tokenHash,
 		DeviceInfo: pgtype.Text{
 			String: deviceInfo,
@@ -171,15 +416,7 @@ func (u *UserService) ValidateRefreshToken(ctx context.Context, token string) (*
 		return nil, fmt.Errorf("failed to get user")
 	}
 
-	user := &models.User{
-		ID:        dbUser.ID.String(),
-		Email:     dbUser.Email,
-		FirstName: dbUser.FirstName,
-		LastName:  dbUser.LastName,
-		CreatedAt: dbUser.CreatedAt.Time,
-	}
-
-	return user, nil
+	return convertUserToModel(dbUser), nil
 }
 
 // RevokeRefreshToken revokes a specific refresh token
@@ -196,3 +433,772 @@ func (u *UserService) RevokeAllUserRefreshTokens(ctx context.Context, userID str
 	}
 	return u.queries.RevokeAllUserRefreshTokens(ctx, userUUID)
 }
+
+// ChangePassword replaces the user's password after re-checking the current one. Every existing
+// session is ended: refresh tokens are revoked and outstanding access tokens stop passing
+// CheckSession. The updated user is returned so the caller can start a fresh session.
+func (u *UserService) ChangePassword(ctx context.Context, userID string, currentPassword string, newPassword string) (*models.User, error) {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	dbUser, err := u.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		logger.Error().Err(err).Msg("Failed to get user")
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	valid, err := util.VerifyPassword(currentPassword, dbUser.PasswordHash)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to verify password")
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !valid {
+		return nil, ErrInvalidPassword
+	}
+	if newPassword == currentPassword {
+		return nil, &InvalidArgumentError{ArgumentName: "newPassword", Message: "newPassword must differ from the current password"}
+	}
+
+	hashedPassword, err := util.HashPassword(newPassword)
+	if err != nil {
+		logger.Error().Err(err).Msg("HashPassword failed")
+		return nil, fmt.Errorf("HashPassword failed: %w", err)
+	}
+
+	updated, err := u.queries.UpdateUserPassword(ctx, repository.UpdateUserPasswordParams{
+		ID:           userUUID,
+		PasswordHash: hashedPassword,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to update password")
+		return nil, fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if err := u.queries.RevokeAllUserRefreshTokens(ctx, userUUID); err != nil {
+		logger.Error().Err(err).Msg("Failed to revoke refresh tokens")
+		return nil, fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	logger.Info().Str("userID", userID).Msg("Password changed")
+	return convertUserToModel(updated), nil
+}
+
+// SignOutEverywhere ends every session the user has by revoking their refresh tokens and bumping
+// their token version, which invalidates access tokens that have already been issued
+func (u *UserService) SignOutEverywhere(ctx context.Context, userID string) error {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if err := u.queries.RevokeAllUserRefreshTokens(ctx, userUUID); err != nil {
+		logger.Error().Err(err).Msg("Failed to revoke refresh tokens")
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	if _, err := u.queries.IncrementUserTokenVersion(ctx, userUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.ErrNotFound
+		}
+		logger.Error().Err(err).Msg("Failed to increment token version")
+		return fmt.Errorf("failed to increment token version: %w", err)
+	}
+
+	logger.Info().Str("userID", userID).Msg("User signed out everywhere")
+	return nil
+}
+
+// CheckSession returns ErrTokenRevoked if an access token carrying tokenVersion was issued before
+// the user's last password change or sign-out everywhere, or the user no longer exists,
+// ErrAccountSuspended if an admin has suspended the account and ErrAccountDeactivated if its
+// owner has deactivated it
+func (u *UserService) CheckSession(ctx context.Context, userID string, tokenVersion int32) error {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	state, err := u.queries.GetUserSessionState(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTokenRevoked
+		}
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to get session state")
+		return fmt.Errorf("failed to get session state: %w", err)
+	}
+	if tokenVersion != state.TokenVersion {
+		return ErrTokenRevoked
+	}
+	if state.Suspended {
+		return ErrAccountSuspended
+	}
+	if state.Deactivated {
+		return ErrAccountDeactivated
+	}
+	return nil
+}
+
+// StartPhoneVerification normalizes a phone number to E.164 and sends it a one-time code by SMS.
+// The number is only stored on the user once the code is confirmed with VerifyPhone.
+func (u *UserService) StartPhoneVerification(ctx context.Context, userID string, phoneNumber string, callingCode string) (*models.PhoneVerification, error) {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	normalized, err := util.NormalizePhoneNumber(phoneNumber, callingCode)
+	if err != nil {
+		return nil, &InvalidArgumentError{ArgumentName: "phoneNumber", Message: err.Error()}
+	}
+
+	// A verified number can sign in, so it can only belong to one account
+	owner, err := u.queries.GetUserByVerifiedPhone(ctx, pgtype.Text{String: normalized, Valid: true})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error().Err(err).Msg("Failed to look up user by phone number")
+		return nil, fmt.Errorf("failed to look up user by phone number: %w", err)
+	}
+	if err == nil && owner.ID != userUUID {
+		return nil, fmt.Errorf("phone number %s: %w", normalized, apperrors.ErrAlreadyExists)
+	}
+
+	// Rate limit: one code per cooldown window and a fixed number per hour
+	now := time.Now()
+	latest, err := u.queries.GetLatestPendingPhoneVerification(ctx, userUUID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error().Err(err).Msg("Failed to get latest phone verification")
+		return nil, fmt.Errorf("failed to get latest phone verification: %w", err)
+	}
+	if err == nil && now.Sub(latest.CreatedAt.Time) < phoneVerificationCooldown {
+		return nil, fmt.Errorf("verification code requested too recently: %w", apperrors.ErrRateLimited)
+	}
+
+	sent, err := u.queries.CountPhoneVerificationsSince(ctx, repository.CountPhoneVerificationsSinceParams{
+		UserID:    userUUID,
+		CreatedAt: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to count phone verifications")
+		return nil, fmt.Errorf("failed to count phone verifications: %w", err)
+	}
+	if sent >= maxPhoneVerificationsPerHour {
+		return nil, fmt.Errorf("hourly verification code limit reached: %w", apperrors.ErrRateLimited)
+	}
+
+	code, codeHash, err := u.generateOTP()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to generate verification code")
+		return nil, fmt.Errorf("failed to generate verification code: %w", err)
+	}
+
+	verification, err := u.queries.CreatePhoneVerification(ctx, repository.CreatePhoneVerificationParams{
+		UserID:      userUUID,
+		PhoneNumber: normalized,
+		CodeHash:    codeHash,
+		ExpiresAt:   pgtype.Timestamptz{Time: now.Add(phoneVerificationTTL), Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to store phone verification")
+		return nil, fmt.Errorf("failed to store phone verification: %w", err)
+	}
+
+	body := fmt.Sprintf("Your Volley verification code is %s. It expires in %d minutes.", code, int(phoneVerificationTTL.Minutes()))
+	if err := u.smsSender.SendSMS(ctx, normalized, body); err != nil {
+		logger.Error().Err(err).Msg("Failed to send verification SMS")
+		return nil, fmt.Errorf("failed to send verification SMS: %w", err)
+	}
+
+	logger.Info().Str("userID", userID).Msg("Phone verification code sent")
+	return &models.PhoneVerification{
+		PhoneNumber: normalized,
+		ExpiresAt:   verification.ExpiresAt.Time,
+	}, nil
+}
+
+// VerifyPhone checks a code sent by StartPhoneVerification and, if it matches,
+// marks the phone number as verified on the user.
+func (u *UserService) VerifyPhone(ctx context.Context, userID string, code string) (*models.User, error) {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	verification, err := u.queries.GetLatestPendingPhoneVerification(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidVerificationCode
+		}
+		logger.Error().Err(err).Msg("Failed to get phone verification")
+		return nil, fmt.Errorf("failed to get phone verification: %w", err)
+	}
+	if time.Now().After(verification.ExpiresAt.Time) {
+		return nil, ErrInvalidVerificationCode
+	}
+
+	// Count the attempt before comparing so concurrent guesses can't exceed the limit
+	attempts, err := u.queries.IncrementPhoneVerificationAttempts(ctx, verification.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to record verification attempt")
+		return nil, fmt.Errorf("failed to record verification attempt: %w", err)
+	}
+	if attempts > maxPhoneVerificationAttempts {
+		logger.Warn().Str("userID", userID).Msg("Too many phone verification attempts")
+		return nil, ErrInvalidVerificationCode
+	}
+	if subtle.ConstantTimeCompare([]byte(util.HashOTP(code)), []byte(verification.CodeHash)) != 1 {
+		return nil, ErrInvalidVerificationCode
+	}
+
+	if err := u.queries.MarkPhoneVerificationVerified(ctx, verification.ID); err != nil {
+		logger.Error().Err(err).Msg("Failed to mark phone verification as verified")
+		return nil, fmt.Errorf("failed to mark phone verification as verified: %w", err)
+	}
+
+	dbUser, err := u.queries.SetUserVerifiedPhone(ctx, repository.SetUserVerifiedPhoneParams{
+		ID:          userUUID,
+		PhoneNumber: pgtype.Text{String: verification.PhoneNumber, Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to store verified phone number")
+		return nil, fmt.Errorf("failed to store verified phone number: %w", err)
+	}
+
+	logger.Info().Str("userID", userID).Msg("Phone number verified")
+	return convertUserToModel(dbUser), nil
+}
+
+// RequestEmailChange emails a confirmation link to newEmail. The user's email is only changed once
+// the link is opened with ConfirmEmailChange, so a typo can't lock anyone out of their account.
+func (u *UserService) RequestEmailChange(ctx context.Context, userID string, newEmail string, password string) (*models.EmailChange, error) {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	dbUser, err := u.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		logger.Error().Err(err).Msg("Failed to get user")
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Re-check the password so a stolen session alone can't take over the account
+	valid, err := util.VerifyPassword(password, dbUser.PasswordHash)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to verify password")
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !valid {
+		return nil, ErrInvalidPassword
+	}
+
+	if strings.EqualFold(newEmail, dbUser.Email) {
+		return nil, &InvalidArgumentError{ArgumentName: "newEmail", Message: "newEmail must differ from the current email"}
+	}
+	if err := u.checkEmailAvailable(ctx, newEmail); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	latest, err := u.queries.GetLatestPendingEmailChangeRequest(ctx, userUUID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error().Err(err).Msg("Failed to get latest email change request")
+		return nil, fmt.Errorf("failed to get latest email change request: %w", err)
+	}
+	if err == nil && now.Sub(latest.CreatedAt.Time) < emailChangeCooldown {
+		return nil, fmt.Errorf("email change requested too recently: %w", apperrors.ErrRateLimited)
+	}
+
+	// Only the most recent link is valid
+	if err := u.queries.CancelPendingEmailChangeRequests(ctx, userUUID); err != nil {
+		logger.Error().Err(err).Msg("Failed to cancel pending email change requests")
+		return nil, fmt.Errorf("failed to cancel pending email change requests: %w", err)
+	}
+
+	token, tokenHash, err := util.GenerateLinkToken()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to generate email change token")
+		return nil, fmt.Errorf("failed to generate email change token: %w", err)
+	}
+
+	request, err := u.queries.CreateEmailChangeRequest(ctx, repository.CreateEmailChangeRequestParams{
+		UserID:    userUUID,
+		NewEmail:  newEmail,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamptz{Time: now.Add(emailChangeTTL), Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to store email change request")
+		return nil, fmt.Errorf("failed to store email change request: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/confirm-email?token=%s", u.appURL, url.QueryEscape(token))
+	message, err := notifications.RenderEmail(notifications.EmailChangeConfirmation, notifications.EmailChangeEmail{
+		NewEmail:       newEmail,
+		Link:           link,
+		ExpiresInHours: int(emailChangeTTL.Hours()),
+	}, "")
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to render email change confirmation")
+		return nil, err
+	}
+	if err := u.emailSender.SendEmail(ctx, newEmail, message); err != nil {
+		logger.Error().Err(err).Msg("Failed to send email change confirmation")
+		return nil, fmt.Errorf("failed to send email change confirmation: %w", err)
+	}
+
+	logger.Info().Str("userID", userID).Msg("Email change confirmation sent")
+	return &models.EmailChange{
+		NewEmail:  request.NewEmail,
+		ExpiresAt: request.ExpiresAt.Time,
+	}, nil
+}
+
+// ConfirmEmailChange applies the email change a token was issued for and tells the old address about it
+func (u *UserService) ConfirmEmailChange(ctx context.Context, token string) (*models.User, error) {
+	logger := log.Ctx(ctx)
+
+	request, err := u.queries.GetPendingEmailChangeRequestByTokenHash(ctx, util.HashLinkToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidEmailChangeToken
+		}
+		logger.Error().Err(err).Msg("Failed to get email change request")
+		return nil, fmt.Errorf("failed to get email change request: %w", err)
+	}
+	if time.Now().After(request.ExpiresAt.Time) {
+		return nil, ErrInvalidEmailChangeToken
+	}
+
+	oldUser, err := u.queries.GetUserByID(ctx, request.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidEmailChangeToken
+		}
+		logger.Error().Err(err).Msg("Failed to get user")
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Someone may have signed up with the address since the link was sent
+	if err := u.checkEmailAvailable(ctx, request.NewEmail); err != nil {
+		return nil, err
+	}
+
+	dbUser, err := u.queries.UpdateUser(ctx, repository.UpdateUserParams{
+		ID:    request.UserID,
+		Email: pgtype.Text{String: request.NewEmail, Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to update user email")
+		return nil, fmt.Errorf("failed to update user email: %w", err)
+	}
+
+	if err := u.queries.MarkEmailChangeRequestConfirmed(ctx, request.ID); err != nil {
+		logger.Error().Err(err).Msg("Failed to mark email change request as confirmed")
+		return nil, fmt.Errorf("failed to mark email change request as confirmed: %w", err)
+	}
+	if err := u.queries.CancelPendingEmailChangeRequests(ctx, request.UserID); err != nil {
+		logger.Error().Err(err).Msg("Failed to cancel pending email change requests")
+		return nil, fmt.Errorf("failed to cancel pending email change requests: %w", err)
+	}
+
+	// The change has been applied, so a failed notice is only logged
+	message, err := notifications.RenderEmail(notifications.EmailChanged, notifications.EmailChangeEmail{NewEmail: request.NewEmail}, "")
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to render email change notice")
+	} else if err := u.emailSender.SendEmail(ctx, oldUser.Email, message); err != nil {
+		logger.Error().Err(err).Msg("Failed to send email change notice")
+	}
+
+	logger.Info().Str("userID", dbUser.ID.String()).Msg("Email address changed")
+	return convertUserToModel(dbUser), nil
+}
+
+// checkEmailAvailable returns ErrAlreadyExists if an account already uses email
+func (u *UserService) checkEmailAvailable(ctx context.Context, email string) error {
+	existingUser, err := u.queries.GetUserByEmail(ctx, email)
+	if err == nil && existingUser.ID.Valid {
+		return fmt.Errorf("user with email %s: %w", email, apperrors.ErrAlreadyExists)
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to look up user by email")
+		return fmt.Errorf("failed to look up user by email: %w", err)
+	}
+	return nil
+}
+
+// RequestLoginCode texts a one-time sign-in code to a verified phone number. Numbers that don't
+// belong to an account and requests over the rate limit succeed silently so the endpoint can't be
+// used to find accounts.
+func (u *UserService) RequestLoginCode(ctx context.Context, phoneNumber string, callingCode string) error {
+	logger := log.Ctx(ctx)
+
+	normalized, err := util.NormalizePhoneNumber(phoneNumber, callingCode)
+	if err != nil {
+		return &InvalidArgumentError{ArgumentName: "phoneNumber", Message: err.Error()}
+	}
+
+	dbUser, err := u.queries.GetUserByVerifiedPhone(ctx, pgtype.Text{String: normalized, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Info().Msg("Login code requested for unknown phone number")
+			return nil
+		}
+		logger.Error().Err(err).Msg("Failed to look up user by phone number")
+		return fmt.Errorf("failed to look up user by phone number: %w", err)
+	}
+
+	// Same limits as phone verification codes
+	now := time.Now()
+	latest, err := u.queries.GetLatestPendingLoginCode(ctx, dbUser.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error().Err(err).Msg("Failed to get latest login code")
+		return fmt.Errorf("failed to get latest login code: %w", err)
+	}
+	if err == nil && now.Sub(latest.CreatedAt.Time) < phoneVerificationCooldown {
+		logger.Warn().Str("userID", dbUser.ID.String()).Msg("Login code requested too recently")
+		return nil
+	}
+
+	sent, err := u.queries.CountLoginCodesSince(ctx, repository.CountLoginCodesSinceParams{
+		UserID:    dbUser.ID,
+		CreatedAt: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to count login codes")
+		return fmt.Errorf("failed to count login codes: %w", err)
+	}
+	if sent >= maxPhoneVerificationsPerHour {
+		logger.Warn().Str("userID", dbUser.ID.String()).Msg("Hourly login code limit reached")
+		return nil
+	}
+
+	code, codeHash, err := u.generateOTP()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to generate login code")
+		return fmt.Errorf("failed to generate login code: %w", err)
+	}
+
+	if _, err := u.queries.CreateLoginCode(ctx, repository.CreateLoginCodeParams{
+		UserID:    dbUser.ID,
+		CodeHash:  codeHash,
+		ExpiresAt: pgtype.Timestamptz{Time: now.Add(loginCodeTTL), Valid: true},
+	}); err != nil {
+		logger.Error().Err(err).Msg("Failed to store login code")
+		return fmt.Errorf("failed to store login code: %w", err)
+	}
+
+	body := fmt.Sprintf("Your Volley sign-in code is %s. It expires in %d minutes.", code, int(loginCodeTTL.Minutes()))
+	if err := u.smsSender.SendSMS(ctx, normalized, body); err != nil {
+		logger.Error().Err(err).Msg("Failed to send login code SMS")
+		return fmt.Errorf("failed to send login code SMS: %w", err)
+	}
+
+	logger.Info().Str("userID", dbUser.ID.String()).Msg("Login code sent")
+	return nil
+}
+
+// VerifyLoginCode signs in the user whose verified phone number a code from RequestLoginCode was sent to
+func (u *UserService) VerifyLoginCode(ctx context.Context, phoneNumber string, callingCode string, code string) (*models.User, error) {
+	logger := log.Ctx(ctx)
+
+	normalized, err := util.NormalizePhoneNumber(phoneNumber, callingCode)
+	if err != nil {
+		return nil, &InvalidArgumentError{ArgumentName: "phoneNumber", Message: err.Error()}
+	}
+
+	dbUser, err := u.queries.GetUserByVerifiedPhone(ctx, pgtype.Text{String: normalized, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidVerificationCode
+		}
+		logger.Error().Err(err).Msg("Failed to look up user by phone number")
+		return nil, fmt.Errorf("failed to look up user by phone number: %w", err)
+	}
+
+	loginCode, err := u.queries.GetLatestPendingLoginCode(ctx, dbUser.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidVerificationCode
+		}
+		logger.Error().Err(err).Msg("Failed to get login code")
+		return nil, fmt.Errorf("failed to get login code: %w", err)
+	}
+	if time.Now().After(loginCode.ExpiresAt.Time) {
+		return nil, ErrInvalidVerificationCode
+	}
+
+	// Count the attempt before comparing so concurrent guesses can't exceed the limit
+	attempts, err := u.queries.IncrementLoginCodeAttempts(ctx, loginCode.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to record login code attempt")
+		return nil, fmt.Errorf("failed to record login code attempt: %w", err)
+	}
+	if attempts > maxPhoneVerificationAttempts {
+		logger.Warn().Str("userID", dbUser.ID.String()).Msg("Too many login code attempts")
+		return nil, ErrInvalidVerificationCode
+	}
+	if subtle.ConstantTimeCompare([]byte(util.HashOTP(code)), []byte(loginCode.CodeHash)) != 1 {
+		return nil, ErrInvalidVerificationCode
+	}
+
+	if err := u.queries.MarkLoginCodeUsed(ctx, loginCode.ID); err != nil {
+		logger.Error().Err(err).Msg("Failed to mark login code as used")
+		return nil, fmt.Errorf("failed to mark login code as used: %w", err)
+	}
+
+	if err := u.rejectDeactivated(ctx, dbUser.ID); err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("userID", dbUser.ID.String()).Msg("User signed in with SMS code")
+	return convertUserToModel(dbUser), nil
+}
+
+// RequireVerifiedPhone returns the user's verified phone number, or ErrPhoneNotVerified.
+// Features that text the user or pay them out (SMS notifications, organizer payouts) must check this first.
+func (u *UserService) RequireVerifiedPhone(ctx context.Context, userID string) (string, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return "", fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	dbUser, err := u.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", apperrors.ErrNotFound
+		}
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if !dbUser.PhoneNumber.Valid || !dbUser.PhoneVerifiedAt.Valid {
+		return "", ErrPhoneNotVerified
+	}
+	return dbUser.PhoneNumber.String, nil
+}
+
+func convertUserToModel(dbUser repository.User) *models.User {
+	return &models.User{
+		ID:            dbUser.ID.String(),
+		Email:         dbUser.Email,
+		FirstName:     dbUser.FirstName,
+		LastName:      dbUser.LastName,
+		PhoneNumber:   pgTextToStringPtr(dbUser.PhoneNumber),
+		PhoneVerified: dbUser.PhoneVerifiedAt.Valid,
+		CreatedAt:     dbUser.CreatedAt.Time,
+		HomeRegion:    dbUser.HomeRegion,
+		TokenVersion:  dbUser.TokenVersion,
+	}
+}
+
+// ListCurrentLegalDocuments returns the latest published version of each legal document
+func (u *UserService) ListCurrentLegalDocuments(ctx context.Context) ([]models.LegalDocument, error) {
+	documents, err := u.queries.ListCurrentLegalDocuments(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list current legal documents")
+		return nil, fmt.Errorf("failed to list current legal documents: %w", err)
+	}
+	return convertLegalDocumentsToModel(documents), nil
+}
+
+// ListPendingLegalDocuments returns the current legal documents the user has not accepted yet
+func (u *UserService) ListPendingLegalDocuments(ctx context.Context, userID string) ([]models.LegalDocument, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	documents, err := u.queries.ListPendingLegalDocuments(ctx, userUUID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list pending legal documents")
+		return nil, fmt.Errorf("failed to list pending legal documents: %w", err)
+	}
+	return convertLegalDocumentsToModel(documents), nil
+}
+
+// AcceptLegalDocuments records the user's acceptance of current legal document versions.
+// Accepting a version that is no longer current is rejected so clients re-fetch the latest text.
+func (u *UserService) AcceptLegalDocuments(ctx context.Context, userID string, refs []models.LegalDocumentRef, client ClientInfo) ([]models.LegalDocument, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	documents, err := u.matchCurrentLegalDocuments(ctx, refs, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.recordLegalAcceptances(ctx, userUUID, documents, client); err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Str("userID", userID).Int("documentCount", len(documents)).Msg("Legal documents accepted")
+	return u.ListPendingLegalDocuments(ctx, userID)
+}
+
+// ListLegalAcceptances returns the user's full acceptance history, newest first
+func (u *UserService) ListLegalAcceptances(ctx context.Context, userID string) ([]models.LegalAcceptance, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	rows, err := u.queries.ListLegalAcceptancesByUser(ctx, userUUID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list legal acceptances")
+		return nil, fmt.Errorf("failed to list legal acceptances: %w", err)
+	}
+
+	acceptances := make([]models.LegalAcceptance, 0, len(rows))
+	for _, row := range rows {
+		acceptances = append(acceptances, models.LegalAcceptance{
+			ID:         uuid.UUID(row.ID.Bytes).String(),
+			Type:       models.LegalDocumentType(row.DocumentType),
+			Version:    row.Version,
+			IPAddress:  pgTextToStringPtr(row.IpAddress),
+			UserAgent:  pgTextToStringPtr(row.UserAgent),
+			AcceptedAt: row.AcceptedAt.Time,
+		})
+	}
+	return acceptances, nil
+}
+
+// matchCurrentLegalDocuments resolves refs against the current legal documents. Every ref must name
+// a current version; with requireAll, every current document must also be covered.
+func (u *UserService) matchCurrentLegalDocuments(ctx context.Context, refs []models.LegalDocumentRef, requireAll bool) ([]repository.LegalDocument, error) {
+	current, err := u.queries.ListCurrentLegalDocuments(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list current legal documents")
+		return nil, fmt.Errorf("failed to list current legal documents: %w", err)
+	}
+
+	currentByType := make(map[string]repository.LegalDocument, len(current))
+	for _, document := range current {
+		currentByType[document.DocumentType] = document
+	}
+
+	matched := make([]repository.LegalDocument, 0, len(refs))
+	accepted := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		document, ok := currentByType[string(ref.Type)]
+		if !ok || document.Version != ref.Version {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "documents",
+				Message:      fmt.Sprintf("version %s of %s is not the current version", ref.Version, ref.Type),
+			}
+		}
+		if !accepted[document.DocumentType] {
+			matched = append(matched, document)
+			accepted[document.DocumentType] = true
+		}
+	}
+
+	if requireAll {
+		for _, document := range current {
+			if !accepted[document.DocumentType] {
+				return nil, fmt.Errorf("%s version %s not accepted: %w", document.DocumentType, document.Version, ErrLegalAcceptanceRequired)
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+func (u *UserService) recordLegalAcceptances(ctx context.Context, userID pgtype.UUID, documents []repository.LegalDocument, client ClientInfo) error {
+	for _, document := range documents {
+		err := u.queries.CreateLegalAcceptance(ctx, repository.CreateLegalAcceptanceParams{
+			UserID:     userID,
+			DocumentID: document.ID,
+			IpAddress:  pgtype.Text{String: client.IPAddress, Valid: client.IPAddress != ""},
+			UserAgent:  pgtype.Text{String: client.UserAgent, Valid: client.UserAgent != ""},
+		})
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("documentType", document.DocumentType).Msg("Failed to record legal acceptance")
+			return fmt.Errorf("failed to record legal acceptance: %w", err)
+		}
+	}
+	return nil
+}
+
+func convertLegalDocumentsToModel(documents []repository.LegalDocument) []models.LegalDocument {
+	result := make([]models.LegalDocument, 0, len(documents))
+	for _, document := range documents {
+		result = append(result, models.LegalDocument{
+			Type:        models.LegalDocumentType(document.DocumentType),
+			Version:     document.Version,
+			URL:         pgTextToStringPtr(document.Url),
+			PublishedAt: document.PublishedAt.Time,
+		})
+	}
+	return result
+}
+
+// CanMessageUser reports whether sender may message recipient directly. Minors can only be
+// contacted by organizers of a game they are signed up for.
+func (u *UserService) CanMessageUser(ctx context.Context, senderID string, recipientID string) (bool, error) {
+	var senderUUID, recipientUUID pgtype.UUID
+	if err := senderUUID.Scan(senderID); err != nil {
+		return false, fmt.Errorf("invalid sender ID: %w", err)
+	}
+	if err := recipientUUID.Scan(recipientID); err != nil {
+		return false, fmt.Errorf("invalid recipient ID: %w", err)
+	}
+
+	recipient, err := u.queries.GetUserByID(ctx, recipientUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, apperrors.ErrNotFound
+		}
+		return false, fmt.Errorf("failed to get recipient: %w", err)
+	}
+	if !isMinor(recipient.Birthdate, time.Now()) {
+		return true, nil
+	}
+
+	isOrganizer, err := u.queries.IsOrganizerOfParticipant(ctx, repository.IsOrganizerOfParticipantParams{
+		OrganizerID:   senderUUID,
+		ParticipantID: recipientUUID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check organizer relationship: %w", err)
+	}
+	return isOrganizer, nil
+}
+
+// HasRole reports whether the user has been granted an elevated role such as admin
+func (u *UserService) HasRole(ctx context.Context, userID string, role models.UserRole) (bool, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return false, &InvalidArgumentError{ArgumentName: "userID", Message: "invalid user ID format"}
+	}
+
+	hasRole, err := u.queries.UserHasRole(ctx, repository.UserHasRoleParams{UserID: userUUID, Role: string(role)})
+	if err != nil {
+		return false, fmt.Errorf("failed to check user role: %w", err)
+	}
+	return hasRole, nil
+}
+
+// isMinor reports whether someone born on birthdate is younger than adultAge at now.
+// Accounts without a birthdate predate age gating and are treated as adults.
+func isMinor(birthdate pgtype.Date, now time.Time) bool {
+	if !birthdate.Valid {
+		return false
+	}
+	return now.Before(birthdate.Time.AddDate(adultAge, 0, 0))
+}
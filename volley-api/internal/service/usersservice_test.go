@@ -0,0 +1,1441 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/internal/util"
+	"github.com/gabe-dev-svc/volley/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartPhoneVerification(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("sends code to normalized number", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByVerifiedPhone(mock.Anything, pgtype.Text{String: "+14155552671", Valid: true}).
+			Return(repository.User{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().GetLatestPendingPhoneVerification(mock.Anything, userUUID).
+			Return(repository.PhoneVerification{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().CountPhoneVerificationsSince(mock.Anything, mock.Anything).Return(int64(0), nil)
+		mockQuerier.EXPECT().CreatePhoneVerification(mock.Anything, mock.MatchedBy(func(p repository.CreatePhoneVerificationParams) bool {
+			return p.PhoneNumber == "+14155552671" && p.CodeHash != ""
+		})).Return(repository.PhoneVerification{
+			PhoneNumber: "+14155552671",
+			ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(phoneVerificationTTL), Valid: true},
+		}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		result, err := service.StartPhoneVerification(context.Background(), userID, "(415) 555-2671", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "+14155552671", result.PhoneNumber)
+	})
+
+	t.Run("rejects invalid number", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.StartPhoneVerification(context.Background(), userID, "12345", "")
+
+		var invalidArgErr *InvalidArgumentError
+		require.ErrorAs(t, err, &invalidArgErr)
+		assert.Equal(t, "phoneNumber", invalidArgErr.ArgumentName)
+	})
+
+	t.Run("number verified on another account", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+
+		mockQuerier.EXPECT().GetUserByVerifiedPhone(mock.Anything, mock.Anything).
+			Return(repository.User{ID: createTestUUID(t, "123e4567-e89b-12d3-a456-426614174002")}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.StartPhoneVerification(context.Background(), userID, "4155552671", "")
+
+		assert.ErrorIs(t, err, apperrors.ErrAlreadyExists)
+	})
+
+	t.Run("rate limited within cooldown", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+
+		mockQuerier.EXPECT().GetUserByVerifiedPhone(mock.Anything, mock.Anything).Return(repository.User{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().GetLatestPendingPhoneVerification(mock.Anything, mock.Anything).
+			Return(repository.PhoneVerification{CreatedAt: pgtype.Timestamptz{Time: time.Now().Add(-10 * time.Second), Valid: true}}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.StartPhoneVerification(context.Background(), userID, "4155552671", "")
+
+		assert.ErrorIs(t, err, apperrors.ErrRateLimited)
+	})
+
+	t.Run("rate limited after hourly cap", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+
+		mockQuerier.EXPECT().GetUserByVerifiedPhone(mock.Anything, mock.Anything).Return(repository.User{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().GetLatestPendingPhoneVerification(mock.Anything, mock.Anything).
+			Return(repository.PhoneVerification{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().CountPhoneVerificationsSince(mock.Anything, mock.Anything).
+			Return(int64(maxPhoneVerificationsPerHour), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.StartPhoneVerification(context.Background(), userID, "4155552671", "")
+
+		assert.ErrorIs(t, err, apperrors.ErrRateLimited)
+	})
+}
+
+func TestVerifyPhone(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	verificationID := "123e4567-e89b-12d3-a456-426614174099"
+
+	tests := []struct {
+		name      string
+		code      string
+		expiresIn time.Duration
+		attempts  int32
+		wantErr   error
+	}{
+		{name: "correct code", code: "123456", expiresIn: time.Minute, attempts: 1},
+		{name: "wrong code", code: "654321", expiresIn: time.Minute, attempts: 1, wantErr: ErrInvalidVerificationCode},
+		{name: "too many attempts", code: "123456", expiresIn: time.Minute, attempts: maxPhoneVerificationAttempts + 1, wantErr: ErrInvalidVerificationCode},
+		{name: "expired", code: "123456", expiresIn: -time.Minute, wantErr: ErrInvalidVerificationCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQuerier := mocks.NewQuerier(t)
+			userUUID := createTestUUID(t, userID)
+			verificationUUID := createTestUUID(t, verificationID)
+
+			mockQuerier.EXPECT().GetLatestPendingPhoneVerification(mock.Anything, userUUID).Return(repository.PhoneVerification{
+				ID:          verificationUUID,
+				UserID:      userUUID,
+				PhoneNumber: "+14155552671",
+				CodeHash:    util.HashOTP("123456"),
+				ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(tt.expiresIn), Valid: true},
+			}, nil)
+			if tt.expiresIn > 0 {
+				mockQuerier.EXPECT().IncrementPhoneVerificationAttempts(mock.Anything, verificationUUID).Return(tt.attempts, nil)
+			}
+			if tt.wantErr == nil {
+				mockQuerier.EXPECT().MarkPhoneVerificationVerified(mock.Anything, verificationUUID).Return(nil)
+				mockQuerier.EXPECT().SetUserVerifiedPhone(mock.Anything, repository.SetUserVerifiedPhoneParams{
+					ID:          userUUID,
+					PhoneNumber: pgtype.Text{String: "+14155552671", Valid: true},
+				}).Return(repository.User{
+					ID:              userUUID,
+					PhoneNumber:     pgtype.Text{String: "+14155552671", Valid: true},
+					PhoneVerifiedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+				}, nil)
+			}
+
+			service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+			user, err := service.VerifyPhone(context.Background(), userID, tt.code)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, user.PhoneVerified)
+			assert.Equal(t, "+14155552671", *user.PhoneNumber)
+		})
+	}
+}
+
+func TestAcceptLegalDocuments(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	termsID := "123e4567-e89b-12d3-a456-426614174010"
+
+	current := []repository.LegalDocument{
+		{ID: createTestUUID(t, termsID), DocumentType: string(models.LegalDocumentTermsOfService), Version: "2025-06-01"},
+	}
+
+	t.Run("records acceptance of current version", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().ListCurrentLegalDocuments(mock.Anything).Return(current, nil)
+		mockQuerier.EXPECT().CreateLegalAcceptance(mock.Anything, repository.CreateLegalAcceptanceParams{
+			UserID:     userUUID,
+			DocumentID: createTestUUID(t, termsID),
+			IpAddress:  pgtype.Text{String: "203.0.113.7", Valid: true},
+		}).Return(nil)
+		mockQuerier.EXPECT().ListPendingLegalDocuments(mock.Anything, userUUID).Return([]repository.LegalDocument{}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		pending, err := service.AcceptLegalDocuments(context.Background(), userID, []models.LegalDocumentRef{
+			{Type: models.LegalDocumentTermsOfService, Version: "2025-06-01"},
+		}, ClientInfo{IPAddress: "203.0.113.7"})
+
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("rejects outdated version", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+
+		mockQuerier.EXPECT().ListCurrentLegalDocuments(mock.Anything).Return(current, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.AcceptLegalDocuments(context.Background(), userID, []models.LegalDocumentRef{
+			{Type: models.LegalDocumentTermsOfService, Version: "2024-01-01"},
+		}, ClientInfo{})
+
+		var invalidArgErr *InvalidArgumentError
+		assert.ErrorAs(t, err, &invalidArgErr)
+	})
+}
+
+func TestHasRole(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("checks the requested role", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().UserHasRole(mock.Anything, repository.UserHasRoleParams{
+			UserID: createTestUUID(t, userID),
+			Role:   string(models.UserRoleAdmin),
+		}).Return(true, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		isAdmin, err := service.HasRole(context.Background(), userID, models.UserRoleAdmin)
+
+		require.NoError(t, err)
+		assert.True(t, isAdmin)
+	})
+
+	t.Run("rejects malformed user IDs", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.HasRole(context.Background(), "not-a-uuid", models.UserRoleAdmin)
+
+		var invalidArgErr *InvalidArgumentError
+		assert.ErrorAs(t, err, &invalidArgErr)
+	})
+}
+
+func TestBlockPlayer(t *testing.T) {
+	hostID := "123e4567-e89b-12d3-a456-426614174001"
+	playerID := "123e4567-e89b-12d3-a456-426614174002"
+
+	t.Run("blocks an existing player", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		playerUUID := createTestUUID(t, playerID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, playerUUID).Return(repository.User{ID: playerUUID}, nil)
+		mockQuerier.EXPECT().BlockPlayer(mock.Anything, repository.BlockPlayerParams{
+			HostID:   createTestUUID(t, hostID),
+			PlayerID: playerUUID,
+		}).Return(nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		require.NoError(t, service.BlockPlayer(context.Background(), hostID, playerID))
+	})
+
+	t.Run("hosts cannot block themselves", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		assert.ErrorIs(t, service.BlockPlayer(context.Background(), hostID, hostID), ErrCannotBlockSelf)
+	})
+
+	t.Run("unknown player", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, mock.Anything).Return(repository.User{}, pgx.ErrNoRows)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		assert.ErrorIs(t, service.BlockPlayer(context.Background(), hostID, playerID), apperrors.ErrNotFound)
+	})
+
+	t.Run("unblocking a player who isn't blocked", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().UnblockPlayer(mock.Anything, mock.Anything).Return(int64(0), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		assert.ErrorIs(t, service.UnblockPlayer(context.Background(), hostID, playerID), apperrors.ErrNotFound)
+	})
+
+	t.Run("lists blocked players", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		blockedAt := time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC)
+		mockQuerier.EXPECT().ListBlockedPlayers(mock.Anything, createTestUUID(t, hostID)).Return([]repository.ListBlockedPlayersRow{{
+			PlayerID:  createTestUUID(t, playerID),
+			FirstName: "Sam",
+			LastName:  "Lee",
+			CreatedAt: pgtype.Timestamptz{Time: blockedAt, Valid: true},
+		}}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		blocked, err := service.ListBlockedPlayers(context.Background(), hostID)
+		require.NoError(t, err)
+		assert.Equal(t, []models.BlockedPlayer{{UserID: playerID, FirstName: "Sam", LastName: "Lee", BlockedAt: blockedAt}}, blocked)
+	})
+}
+
+func TestSportPreferences(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	intermediate := models.SkillLevelIntermediate
+
+	t.Run("replaces the preferences", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().ReplaceUserSportPreferences(mock.Anything, repository.ReplaceUserSportPreferencesParams{
+			UserID:      userUUID,
+			Categories:  []string{"soccer", "tennis"},
+			SkillLevels: []string{"intermediate", ""},
+		}).Return(nil)
+		mockQuerier.EXPECT().ListUserSportPreferences(mock.Anything, userUUID).Return([]repository.UserSportPreference{
+			{UserID: userUUID, Category: "soccer", SkillLevel: pgtype.Text{String: "intermediate", Valid: true}},
+			{UserID: userUUID, Category: "tennis"},
+		}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		preferences, err := service.SetSportPreferences(context.Background(), userID, models.SportPreferences{Sports: []models.SportPreference{
+			{Category: models.GameCategorySoccer, SkillLevel: &intermediate},
+			{Category: models.GameCategoryTennis},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, &models.SportPreferences{Sports: []models.SportPreference{
+			{Category: models.GameCategorySoccer, SkillLevel: &intermediate},
+			{Category: models.GameCategoryTennis},
+		}}, preferences)
+	})
+
+	t.Run("rejects a sport listed twice", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.SetSportPreferences(context.Background(), userID, models.SportPreferences{Sports: []models.SportPreference{
+			{Category: models.GameCategorySoccer},
+			{Category: models.GameCategorySoccer, SkillLevel: &intermediate},
+		}})
+		var invalidArgErr *InvalidArgumentError
+		assert.ErrorAs(t, err, &invalidArgErr)
+	})
+}
+
+func TestUpdateSettings(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	placeID, name := "ChIJ-park", "Riverside Park"
+	radius := 8000.0
+
+	t.Run("keeps the saved home when only the radius changes", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		saved := repository.UserSetting{
+			UserID:        userUUID,
+			HomeLatitude:  pgtype.Float8{Float64: 40.8, Valid: true},
+			HomeLongitude: pgtype.Float8{Float64: -73.97, Valid: true},
+			HomePlaceID:   pgtype.Text{String: placeID, Valid: true},
+			HomeName:      pgtype.Text{String: name, Valid: true},
+		}
+
+		mockQuerier.EXPECT().GetUserSettings(mock.Anything, userUUID).Return(saved, nil)
+		updated := saved
+		updated.DefaultRadiusMeters = pgtype.Float8{Float64: radius, Valid: true}
+		mockQuerier.EXPECT().UpsertUserSettings(mock.Anything, repository.UpsertUserSettingsParams{
+			UserID:              userUUID,
+			HomeLatitude:        saved.HomeLatitude,
+			HomeLongitude:       saved.HomeLongitude,
+			HomePlaceID:         saved.HomePlaceID,
+			HomeName:            saved.HomeName,
+			DefaultRadiusMeters: updated.DefaultRadiusMeters,
+		}).Return(updated, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		settings, err := service.UpdateSettings(context.Background(), userID, SettingsUpdate{DefaultRadius: &radius})
+		require.NoError(t, err)
+		assert.Equal(t, &models.UserSettings{
+			HomeLocation:  &models.HomeLocation{Latitude: 40.8, Longitude: -73.97, PlaceID: &placeID, Name: &name},
+			DefaultRadius: &radius,
+		}, settings)
+	})
+
+	t.Run("clears the home location", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserSettings(mock.Anything, userUUID).Return(repository.UserSetting{
+			UserID:        userUUID,
+			HomeLatitude:  pgtype.Float8{Float64: 40.8, Valid: true},
+			HomeLongitude: pgtype.Float8{Float64: -73.97, Valid: true},
+		}, nil)
+		mockQuerier.EXPECT().UpsertUserSettings(mock.Anything, repository.UpsertUserSettingsParams{UserID: userUUID}).
+			Return(repository.UserSetting{UserID: userUUID}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		settings, err := service.UpdateSettings(context.Background(), userID, SettingsUpdate{ClearHome: true})
+		require.NoError(t, err)
+		assert.Equal(t, &models.UserSettings{}, settings)
+	})
+
+	t.Run("rejects an out-of-range home", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.UpdateSettings(context.Background(), userID, SettingsUpdate{
+			Home: &models.HomeLocation{Latitude: 91, Longitude: 0},
+		})
+		var invalidArgErr *InvalidArgumentError
+		assert.ErrorAs(t, err, &invalidArgErr)
+	})
+}
+
+func TestSaveOnboarding(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	timezone := "America/Chicago"
+	radius := 12000.0
+
+	t.Run("saves sports, availability and travel radius", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		onboardedAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+		mockQuerier.EXPECT().ReplaceUserSportPreferences(mock.Anything, repository.ReplaceUserSportPreferencesParams{
+			UserID:      userUUID,
+			Categories:  []string{"volleyball"},
+			SkillLevels: []string{""},
+		}).Return(nil)
+		mockQuerier.EXPECT().ListUserSportPreferences(mock.Anything, userUUID).Return([]repository.UserSportPreference{
+			{UserID: userUUID, Category: "volleyball"},
+		}, nil)
+		mockQuerier.EXPECT().SaveUserOnboarding(mock.Anything, repository.SaveUserOnboardingParams{
+			UserID:              userUUID,
+			DefaultRadiusMeters: pgtype.Float8{Float64: radius, Valid: true},
+			AvailabilityWindows: []string{"weekday_evenings", "weekend_mornings"},
+			Timezone:            pgtype.Text{String: timezone, Valid: true},
+		}).Return(repository.UserSetting{
+			UserID:              userUUID,
+			DefaultRadiusMeters: pgtype.Float8{Float64: radius, Valid: true},
+			AvailabilityWindows: []string{"weekday_evenings", "weekend_mornings"},
+			Timezone:            pgtype.Text{String: timezone, Valid: true},
+			OnboardedAt:         pgtype.Timestamptz{Time: onboardedAt, Valid: true},
+		}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		onboarding, err := service.SaveOnboarding(context.Background(), userID, models.Onboarding{
+			Sports:       []models.SportPreference{{Category: models.GameCategoryVolleyball}},
+			Availability: []models.AvailabilityWindow{models.AvailabilityWeekdayEvenings, models.AvailabilityWeekendMornings},
+			Timezone:     &timezone,
+			TravelRadius: &radius,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &models.Onboarding{
+			Sports:       []models.SportPreference{{Category: models.GameCategoryVolleyball}},
+			Availability: []models.AvailabilityWindow{models.AvailabilityWeekdayEvenings, models.AvailabilityWeekendMornings},
+			Timezone:     &timezone,
+			TravelRadius: &radius,
+			OnboardedAt:  &onboardedAt,
+		}, onboarding)
+	})
+
+	t.Run("requires a timezone with availability before writing anything", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.SaveOnboarding(context.Background(), userID, models.Onboarding{
+			Sports:       []models.SportPreference{{Category: models.GameCategoryVolleyball}},
+			Availability: []models.AvailabilityWindow{models.AvailabilityWeekdayEvenings},
+		})
+		var invalidArgErr *InvalidArgumentError
+		require.ErrorAs(t, err, &invalidArgErr)
+		assert.Equal(t, "timezone", invalidArgErr.ArgumentName)
+	})
+}
+
+func TestReferrals(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	signedUpAt := time.Date(2026, 10, 1, 18, 0, 0, 0, time.UTC)
+
+	t.Run("creates a code for an older account, retrying a taken one", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetReferralCodeByUser(mock.Anything, userUUID).Return(repository.ReferralCode{}, pgx.ErrNoRows).Times(2)
+		mockQuerier.EXPECT().CreateReferralCode(mock.Anything, mock.Anything).Return(repository.ReferralCode{}, pgx.ErrNoRows).Once()
+		mockQuerier.EXPECT().CreateReferralCode(mock.Anything, mock.MatchedBy(func(p repository.CreateReferralCodeParams) bool {
+			return p.UserID == userUUID && len(p.Code) == referralCodeLength
+		})).Return(repository.ReferralCode{UserID: userUUID, Code: "K7QX2MPA"}, nil).Once()
+		mockQuerier.EXPECT().CountReferrals(mock.Anything, userUUID).Return(1, nil)
+		mockQuerier.EXPECT().ListRecentReferrals(mock.Anything, repository.ListRecentReferralsParams{ReferrerID: userUUID, Limit: recentReferralsLimit}).
+			Return([]repository.ListRecentReferralsRow{{FirstName: "Sam", CreatedAt: pgtype.Timestamptz{Time: signedUpAt, Valid: true}}}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		summary, err := service.Referrals(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, &models.ReferralSummary{
+			Code:    "K7QX2MPA",
+			Signups: 1,
+			Recent:  []models.ReferredFriend{{FirstName: "Sam", SignedUpAt: signedUpAt}},
+		}, summary)
+	})
+
+	t.Run("rejects an unknown code at sign-up", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetReferralCodeByCode(mock.Anything, "NOPE2345").Return(repository.ReferralCode{}, pgx.ErrNoRows)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.lookupReferralCode(context.Background(), " nope2345 ")
+		var invalidArgErr *InvalidArgumentError
+		require.ErrorAs(t, err, &invalidArgErr)
+		assert.Equal(t, "referralCode", invalidArgErr.ArgumentName)
+	})
+}
+
+func TestWallet(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	gameID := "550e8400-e29b-41d4-a716-446655440001"
+	earnedAt := time.Date(2026, 10, 1, 18, 0, 0, 0, time.UTC)
+
+	mockQuerier := mocks.NewQuerier(t)
+	userUUID := createTestUUID(t, userID)
+	mockQuerier.EXPECT().GetCreditBalance(mock.Anything, userUUID).Return(300, nil)
+	mockQuerier.EXPECT().ListCreditTransactions(mock.Anything, repository.ListCreditTransactionsParams{UserID: userUUID, Limit: 2, Offset: 0}).
+		Return([]repository.CreditTransaction{
+			{ID: createTestUUID(t, "123e4567-e89b-12d3-a456-426614174101"), AmountCents: -200, Kind: "game_join", GameID: createTestUUID(t, gameID), BalanceAfterCents: 300, CreatedAt: pgtype.Timestamptz{Time: earnedAt.Add(time.Hour), Valid: true}},
+			{ID: createTestUUID(t, "123e4567-e89b-12d3-a456-426614174102"), AmountCents: 500, Kind: "referral", BalanceAfterCents: 500, CreatedAt: pgtype.Timestamptz{Time: earnedAt, Valid: true}},
+		}, nil)
+
+	service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+	wallet, err := service.Wallet(context.Background(), userID, WalletFilters{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 300, wallet.BalanceCents)
+	assert.True(t, wallet.HasMore)
+	require.NotNil(t, wallet.NextOffset)
+	assert.Equal(t, 1, *wallet.NextOffset)
+	require.Len(t, wallet.Transactions, 1)
+	assert.Equal(t, models.CreditKindGameJoin, wallet.Transactions[0].Kind)
+	require.NotNil(t, wallet.Transactions[0].GameID)
+	assert.Equal(t, gameID, *wallet.Transactions[0].GameID)
+}
+
+func TestDeactivation(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	passwordHash, err := util.HashPassword("correct-horse")
+	require.NoError(t, err)
+
+	t.Run("deactivating ends every session", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		deactivatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+		mockQuerier.EXPECT().DeactivateUser(mock.Anything, userUUID).Return(repository.UserDeactivation{
+			UserID:        userUUID,
+			DeactivatedAt: pgtype.Timestamptz{Time: deactivatedAt, Valid: true},
+		}, nil)
+		mockQuerier.EXPECT().RevokeAllUserRefreshTokens(mock.Anything, userUUID).Return(nil)
+		mockQuerier.EXPECT().IncrementUserTokenVersion(mock.Anything, userUUID).Return(int32(3), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		at, err := service.Deactivate(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, deactivatedAt, at)
+	})
+
+	t.Run("deactivated account can't log in", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().ListRecentFailedLoginsByEmail(mock.Anything, mock.Anything).Return(nil, nil)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByIP(mock.Anything, mock.Anything).Return(nil, nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "sam@example.com").
+			Return(repository.User{ID: userUUID, Email: "sam@example.com", PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().IsUserDeactivated(mock.Anything, userUUID).Return(true, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.Login(context.Background(), "sam@example.com", "correct-horse", "203.0.113.7")
+
+		assert.ErrorIs(t, err, ErrAccountDeactivated)
+	})
+
+	t.Run("reactivating with the password signs in", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().ListRecentFailedLoginsByEmail(mock.Anything, mock.Anything).Return(nil, nil)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByIP(mock.Anything, mock.Anything).Return(nil, nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "sam@example.com").
+			Return(repository.User{ID: userUUID, Email: "sam@example.com", PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().ReactivateUser(mock.Anything, userUUID).Return(int64(1), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		user, err := service.Reactivate(context.Background(), "sam@example.com", "correct-horse", "203.0.113.7")
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, user.ID)
+	})
+
+	t.Run("reactivating needs the right password", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+
+		mockQuerier.EXPECT().ListRecentFailedLoginsByEmail(mock.Anything, mock.Anything).Return(nil, nil)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByIP(mock.Anything, mock.Anything).Return(nil, nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "sam@example.com").Return(repository.User{PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().RecordFailedLogin(mock.Anything, mock.Anything).Return(nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.Reactivate(context.Background(), "sam@example.com", "wrong", "203.0.113.7")
+
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+}
+
+func TestEmailChange(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	passwordHash, err := util.HashPassword("correct-horse")
+	require.NoError(t, err)
+
+	t.Run("sends a confirmation link to the new address", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).
+			Return(repository.User{ID: userUUID, Email: "old@example.com", PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "new@example.com").Return(repository.User{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().GetLatestPendingEmailChangeRequest(mock.Anything, userUUID).
+			Return(repository.EmailChangeRequest{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().CancelPendingEmailChangeRequests(mock.Anything, userUUID).Return(nil)
+		mockQuerier.EXPECT().CreateEmailChangeRequest(mock.Anything, mock.MatchedBy(func(p repository.CreateEmailChangeRequestParams) bool {
+			return p.NewEmail == "new@example.com" && p.TokenHash != ""
+		})).Return(repository.EmailChangeRequest{
+			NewEmail:  "new@example.com",
+			ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(emailChangeTTL), Valid: true},
+		}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		change, err := service.RequestEmailChange(context.Background(), userID, "new@example.com", "correct-horse")
+
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", change.NewEmail)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, mock.Anything).
+			Return(repository.User{Email: "old@example.com", PasswordHash: passwordHash}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.RequestEmailChange(context.Background(), userID, "new@example.com", "wrong")
+
+		assert.ErrorIs(t, err, ErrInvalidPassword)
+	})
+
+	t.Run("address already in use", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, mock.Anything).
+			Return(repository.User{Email: "old@example.com", PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "taken@example.com").
+			Return(repository.User{ID: createTestUUID(t, "123e4567-e89b-12d3-a456-426614174002")}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.RequestEmailChange(context.Background(), userID, "taken@example.com", "correct-horse")
+
+		assert.ErrorIs(t, err, apperrors.ErrAlreadyExists)
+	})
+
+	t.Run("rate limited within cooldown", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, mock.Anything).
+			Return(repository.User{Email: "old@example.com", PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, mock.Anything).Return(repository.User{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().GetLatestPendingEmailChangeRequest(mock.Anything, mock.Anything).
+			Return(repository.EmailChangeRequest{CreatedAt: pgtype.Timestamptz{Time: time.Now().Add(-10 * time.Second), Valid: true}}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.RequestEmailChange(context.Background(), userID, "new@example.com", "correct-horse")
+
+		assert.ErrorIs(t, err, apperrors.ErrRateLimited)
+	})
+
+	t.Run("confirming applies the new address", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		requestID := createTestUUID(t, "123e4567-e89b-12d3-a456-426614174009")
+
+		mockQuerier.EXPECT().GetPendingEmailChangeRequestByTokenHash(mock.Anything, util.HashLinkToken("link-token")).
+			Return(repository.EmailChangeRequest{
+				ID:        requestID,
+				UserID:    userUUID,
+				NewEmail:  "new@example.com",
+				ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+			}, nil)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID, Email: "old@example.com"}, nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "new@example.com").Return(repository.User{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().UpdateUser(mock.Anything, repository.UpdateUserParams{
+			ID:    userUUID,
+			Email: pgtype.Text{String: "new@example.com", Valid: true},
+		}).Return(repository.User{ID: userUUID, Email: "new@example.com"}, nil)
+		mockQuerier.EXPECT().MarkEmailChangeRequestConfirmed(mock.Anything, requestID).Return(nil)
+		mockQuerier.EXPECT().CancelPendingEmailChangeRequests(mock.Anything, userUUID).Return(nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		user, err := service.ConfirmEmailChange(context.Background(), "link-token")
+
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", user.Email)
+	})
+
+	t.Run("expired link", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetPendingEmailChangeRequestByTokenHash(mock.Anything, mock.Anything).
+			Return(repository.EmailChangeRequest{ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.ConfirmEmailChange(context.Background(), "link-token")
+
+		assert.ErrorIs(t, err, ErrInvalidEmailChangeToken)
+	})
+}
+
+func TestLoginThrottle(t *testing.T) {
+	passwordHash, err := util.HashPassword("correct-horse")
+	require.NoError(t, err)
+	recent := func(n int) []pgtype.Timestamptz {
+		attempts := make([]pgtype.Timestamptz, n)
+		for i := range attempts {
+			attempts[i] = pgtype.Timestamptz{Time: time.Now().Add(-time.Duration(i) * time.Minute), Valid: true}
+		}
+		return attempts
+	}
+
+	t.Run("wrong password is counted", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByEmail(mock.Anything, mock.MatchedBy(func(p repository.ListRecentFailedLoginsByEmailParams) bool {
+			return p.Email == "sam@example.com" && p.Limit == maxFailedLoginsPerAccount
+		})).Return(recent(4), nil)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByIP(mock.Anything, mock.Anything).Return(recent(4), nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "Sam@example.com").Return(repository.User{PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().RecordFailedLogin(mock.Anything, repository.RecordFailedLoginParams{
+			Email:     "sam@example.com",
+			IpAddress: "203.0.113.7",
+		}).Return(nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.Login(context.Background(), "Sam@example.com", "wrong", "203.0.113.7")
+
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("locked out account is rejected before the password is checked", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByEmail(mock.Anything, mock.Anything).Return(recent(maxFailedLoginsPerAccount), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.Login(context.Background(), "sam@example.com", "correct-horse", "203.0.113.7")
+
+		var throttledErr *LoginThrottledError
+		require.ErrorAs(t, err, &throttledErr)
+		assert.ErrorIs(t, err, apperrors.ErrRateLimited)
+		// The oldest counted failure was 4 minutes ago
+		assert.InDelta(t, (failedLoginWindow - 4*time.Minute).Seconds(), throttledErr.RetryAfter.Seconds(), 5)
+	})
+
+	t.Run("locked out IP is rejected", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByEmail(mock.Anything, mock.Anything).Return(nil, nil)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByIP(mock.Anything, mock.MatchedBy(func(p repository.ListRecentFailedLoginsByIPParams) bool {
+			return p.IpAddress == "203.0.113.7" && p.Limit == maxFailedLoginsPerIP
+		})).Return(recent(maxFailedLoginsPerIP), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.Login(context.Background(), "sam@example.com", "correct-horse", "203.0.113.7")
+
+		assert.ErrorIs(t, err, apperrors.ErrRateLimited)
+	})
+
+	t.Run("successful login clears the account's failures", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByEmail(mock.Anything, mock.Anything).Return(recent(2), nil)
+		mockQuerier.EXPECT().ListRecentFailedLoginsByIP(mock.Anything, mock.Anything).Return(recent(2), nil)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "sam@example.com").
+			Return(repository.User{Email: "sam@example.com", PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().ClearFailedLoginsByEmail(mock.Anything, "sam@example.com").Return(nil)
+		mockQuerier.EXPECT().IsUserDeactivated(mock.Anything, pgtype.UUID{}).Return(false, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		user, err := service.Login(context.Background(), "sam@example.com", "correct-horse", "203.0.113.7")
+
+		require.NoError(t, err)
+		assert.Equal(t, "sam@example.com", user.Email)
+	})
+}
+
+func TestNotificationPreferences(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	enabled := true
+
+	t.Run("SMS is off until the user opts in", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetNotificationPreferences(mock.Anything, userUUID).Return(repository.UserNotificationPreference{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		preferences, err := service.GetNotificationPreferences(context.Background(), userID)
+
+		require.NoError(t, err)
+		assert.False(t, preferences.SMSEnabled)
+		assert.False(t, preferences.PhoneVerified)
+	})
+
+	t.Run("turning on SMS needs a verified phone", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.UpdateNotificationPreferences(context.Background(), userID, models.UpdateNotificationPreferencesRequest{SMSEnabled: &enabled})
+
+		assert.ErrorIs(t, err, ErrPhoneNotVerified)
+	})
+
+	t.Run("users with a verified phone can opt in", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		verified := repository.User{
+			ID:              userUUID,
+			PhoneNumber:     pgtype.Text{String: "+15551234567", Valid: true},
+			PhoneVerifiedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		}
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(verified, nil)
+		mockQuerier.EXPECT().UpsertNotificationPreferences(mock.Anything, repository.UpsertNotificationPreferencesParams{
+			UserID:     userUUID,
+			SmsEnabled: pgtype.Bool{Bool: true, Valid: true},
+		}).Return(repository.UserNotificationPreference{UserID: userUUID, SmsEnabled: true}, nil)
+		mockQuerier.EXPECT().GetNotificationPreferences(mock.Anything, userUUID).Return(repository.UserNotificationPreference{UserID: userUUID, SmsEnabled: true}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		preferences, err := service.UpdateNotificationPreferences(context.Background(), userID, models.UpdateNotificationPreferencesRequest{SMSEnabled: &enabled})
+
+		require.NoError(t, err)
+		assert.True(t, preferences.SMSEnabled)
+		assert.True(t, preferences.PhoneVerified)
+	})
+}
+
+func TestUnsubscribe(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("a signed link turns off game emails", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID}, nil)
+		mockQuerier.EXPECT().UpsertNotificationPreferences(mock.Anything, repository.UpsertNotificationPreferencesParams{
+			UserID:       userUUID,
+			EmailEnabled: pgtype.Bool{Bool: false, Valid: true},
+		}).Return(repository.UserNotificationPreference{UserID: userUUID}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		unsubscribed, err := service.Unsubscribe(context.Background(), util.SignUnsubscribeToken(userID, UnsubscribeChannelEmail))
+
+		require.NoError(t, err)
+		assert.Equal(t, UnsubscribeChannelEmail, unsubscribed.Channel)
+	})
+
+	t.Run("links for deleted accounts still succeed", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, createTestUUID(t, userID)).Return(repository.User{}, pgx.ErrNoRows)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.Unsubscribe(context.Background(), util.SignUnsubscribeToken(userID, UnsubscribeChannelEmail))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("forged and unknown-channel links are rejected", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+
+		_, err := service.Unsubscribe(context.Background(), "bm9wZQ.bm9wZQ")
+		assert.ErrorIs(t, err, util.ErrInvalidUnsubscribeToken)
+		_, err = service.Unsubscribe(context.Background(), util.SignUnsubscribeToken(userID, "sms"))
+		assert.ErrorIs(t, err, util.ErrInvalidUnsubscribeToken)
+	})
+}
+
+func TestTokenRevocation(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	passwordHash, err := util.HashPassword("correct-horse")
+	require.NoError(t, err)
+
+	t.Run("changing the password ends existing sessions", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).
+			Return(repository.User{ID: userUUID, PasswordHash: passwordHash}, nil)
+		mockQuerier.EXPECT().UpdateUserPassword(mock.Anything, mock.MatchedBy(func(p repository.UpdateUserPasswordParams) bool {
+			valid, err := util.VerifyPassword("battery-staple", p.PasswordHash)
+			return p.ID == userUUID && err == nil && valid
+		})).Return(repository.User{ID: userUUID, TokenVersion: 1}, nil)
+		mockQuerier.EXPECT().RevokeAllUserRefreshTokens(mock.Anything, userUUID).Return(nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		user, err := service.ChangePassword(context.Background(), userID, "correct-horse", "battery-staple")
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), user.TokenVersion)
+	})
+
+	t.Run("wrong current password", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, mock.Anything).Return(repository.User{PasswordHash: passwordHash}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.ChangePassword(context.Background(), userID, "wrong", "battery-staple")
+
+		assert.ErrorIs(t, err, ErrInvalidPassword)
+	})
+
+	t.Run("signing out everywhere bumps the token version", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		mockQuerier.EXPECT().RevokeAllUserRefreshTokens(mock.Anything, userUUID).Return(nil)
+		mockQuerier.EXPECT().IncrementUserTokenVersion(mock.Anything, userUUID).Return(int32(3), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		assert.NoError(t, service.SignOutEverywhere(context.Background(), userID))
+	})
+
+	t.Run("tokens with an older version are revoked", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserSessionState(mock.Anything, createTestUUID(t, userID)).
+			Return(repository.GetUserSessionStateRow{TokenVersion: 2}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		assert.NoError(t, service.CheckSession(context.Background(), userID, 2))
+		assert.ErrorIs(t, service.CheckSession(context.Background(), userID, 1), ErrTokenRevoked)
+	})
+
+	t.Run("suspended accounts are rejected", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserSessionState(mock.Anything, createTestUUID(t, userID)).
+			Return(repository.GetUserSessionStateRow{TokenVersion: 2, Suspended: true}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		assert.ErrorIs(t, service.CheckSession(context.Background(), userID, 2), ErrAccountSuspended)
+	})
+}
+
+func TestMagicLink(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("emails a sign-in link", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, "sam@example.com").
+			Return(repository.User{ID: userUUID, Email: "sam@example.com"}, nil)
+		mockQuerier.EXPECT().GetLatestMagicLinkToken(mock.Anything, userUUID).Return(repository.MagicLinkToken{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().CreateMagicLinkToken(mock.Anything, mock.MatchedBy(func(p repository.CreateMagicLinkTokenParams) bool {
+			return p.UserID == userUUID && p.TokenHash != "" && time.Until(p.ExpiresAt.Time) <= magicLinkTTL
+		})).Return(repository.MagicLinkToken{}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		require.NoError(t, service.RequestMagicLink(context.Background(), "sam@example.com"))
+	})
+
+	t.Run("unknown email succeeds without sending", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, mock.Anything).Return(repository.User{}, pgx.ErrNoRows)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		require.NoError(t, service.RequestMagicLink(context.Background(), "nobody@example.com"))
+	})
+
+	t.Run("repeat request within cooldown is ignored", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByEmail(mock.Anything, mock.Anything).
+			Return(repository.User{ID: createTestUUID(t, userID)}, nil)
+		mockQuerier.EXPECT().GetLatestMagicLinkToken(mock.Anything, mock.Anything).
+			Return(repository.MagicLinkToken{CreatedAt: pgtype.Timestamptz{Time: time.Now().Add(-10 * time.Second), Valid: true}}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		require.NoError(t, service.RequestMagicLink(context.Background(), "sam@example.com"))
+	})
+
+	t.Run("redeeming returns the user", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().ConsumeMagicLinkToken(mock.Anything, util.HashLinkToken("link-token")).
+			Return(repository.MagicLinkToken{UserID: userUUID}, nil)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID, Email: "sam@example.com"}, nil)
+		mockQuerier.EXPECT().IsUserDeactivated(mock.Anything, userUUID).Return(false, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		user, err := service.RedeemMagicLink(context.Background(), "link-token")
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, user.ID)
+	})
+
+	t.Run("used or expired link", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().ConsumeMagicLinkToken(mock.Anything, mock.Anything).Return(repository.MagicLinkToken{}, pgx.ErrNoRows)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.RedeemMagicLink(context.Background(), "link-token")
+
+		assert.ErrorIs(t, err, ErrInvalidMagicLink)
+	})
+}
+
+func TestLoginCode(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	phone := pgtype.Text{String: "+14155552671", Valid: true}
+
+	t.Run("texts a code to the verified number", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByVerifiedPhone(mock.Anything, phone).Return(repository.User{ID: userUUID, PhoneNumber: phone}, nil)
+		mockQuerier.EXPECT().GetLatestPendingLoginCode(mock.Anything, userUUID).Return(repository.LoginCode{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().CountLoginCodesSince(mock.Anything, mock.Anything).Return(int64(0), nil)
+		mockQuerier.EXPECT().CreateLoginCode(mock.Anything, mock.MatchedBy(func(p repository.CreateLoginCodeParams) bool {
+			return p.UserID == userUUID && p.CodeHash == util.HashOTP("123456")
+		})).Return(repository.LoginCode{}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		service.UseFixedVerificationCode("123456")
+		require.NoError(t, service.RequestLoginCode(context.Background(), "(415) 555-2671", ""))
+	})
+
+	t.Run("unknown number succeeds without sending", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetUserByVerifiedPhone(mock.Anything, phone).Return(repository.User{}, pgx.ErrNoRows)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		require.NoError(t, service.RequestLoginCode(context.Background(), "4155552671", ""))
+	})
+
+	t.Run("correct code signs in", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		codeID := createTestUUID(t, "123e4567-e89b-12d3-a456-426614174009")
+
+		mockQuerier.EXPECT().GetUserByVerifiedPhone(mock.Anything, phone).Return(repository.User{ID: userUUID, PhoneNumber: phone}, nil)
+		mockQuerier.EXPECT().GetLatestPendingLoginCode(mock.Anything, userUUID).Return(repository.LoginCode{
+			ID:        codeID,
+			CodeHash:  util.HashOTP("123456"),
+			ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true},
+		}, nil)
+		mockQuerier.EXPECT().IncrementLoginCodeAttempts(mock.Anything, codeID).Return(int32(1), nil)
+		mockQuerier.EXPECT().MarkLoginCodeUsed(mock.Anything, codeID).Return(nil)
+		mockQuerier.EXPECT().IsUserDeactivated(mock.Anything, userUUID).Return(false, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		user, err := service.VerifyLoginCode(context.Background(), "4155552671", "", "123456")
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, user.ID)
+	})
+
+	t.Run("wrong code", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+
+		mockQuerier.EXPECT().GetUserByVerifiedPhone(mock.Anything, phone).Return(repository.User{ID: createTestUUID(t, userID)}, nil)
+		mockQuerier.EXPECT().GetLatestPendingLoginCode(mock.Anything, mock.Anything).Return(repository.LoginCode{
+			CodeHash:  util.HashOTP("123456"),
+			ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true},
+		}, nil)
+		mockQuerier.EXPECT().IncrementLoginCodeAttempts(mock.Anything, mock.Anything).Return(int32(1), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.VerifyLoginCode(context.Background(), "4155552671", "", "654321")
+
+		assert.ErrorIs(t, err, ErrInvalidVerificationCode)
+	})
+}
+
+func TestPasskeys(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+	origin := "https://app.volley.gg"
+	credentialID := []byte("credential-1")
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	b64 := base64.RawURLEncoding.EncodeToString
+	authenticatorData := func(flags byte, signCount uint32, attestedCredentialID []byte) []byte {
+		rpIDHash := sha256.Sum256([]byte("app.volley.gg"))
+		data := append([]byte{}, rpIDHash[:]...)
+		data = append(data, flags)
+		data = binary.BigEndian.AppendUint32(data, signCount)
+		if attestedCredentialID != nil {
+			data = append(data, make([]byte, 16)...)
+			data = binary.BigEndian.AppendUint16(data, uint16(len(attestedCredentialID)))
+			data = append(data, attestedCredentialID...)
+		}
+		return data
+	}
+	clientData := func(ceremony string, challenge string) []byte {
+		return []byte(`{"type":"` + ceremony + `","challenge":"` + challenge + `","origin":"` + origin + `"}`)
+	}
+	sign := func(authData []byte, clientDataJSON []byte) []byte {
+		clientDataHash := sha256.Sum256(clientDataJSON)
+		digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+		signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+		require.NoError(t, err)
+		return signature
+	}
+
+	t.Run("registers a passkey", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().ConsumeWebAuthnChallenge(mock.Anything, repository.ConsumeWebAuthnChallengeParams{
+			Challenge: "challenge-1",
+			Ceremony:  util.WebAuthnCeremonyCreate,
+		}).Return(repository.WebauthnChallenge{UserID: userUUID}, nil)
+		mockQuerier.EXPECT().GetWebAuthnCredential(mock.Anything, credentialID).Return(repository.WebauthnCredential{}, pgx.ErrNoRows)
+		mockQuerier.EXPECT().CreateWebAuthnCredential(mock.Anything, mock.MatchedBy(func(p repository.CreateWebAuthnCredentialParams) bool {
+			return p.UserID == userUUID && string(p.PublicKey) == string(publicKeyDER)
+		})).Return(repository.WebauthnCredential{CredentialID: credentialID}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		passkey, err := service.FinishPasskeyRegistration(context.Background(), userID, models.PasskeyRegistrationRequest{
+			RawID: b64(credentialID),
+			Response: models.PasskeyAttestationResponse{
+				ClientDataJSON:    b64(clientData(util.WebAuthnCeremonyCreate, "challenge-1")),
+				AuthenticatorData: b64(authenticatorData(util.WebAuthnFlagUserPresent|util.WebAuthnFlagAttestedData, 0, credentialID)),
+				PublicKey:         b64(publicKeyDER),
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, b64(credentialID), passkey.ID)
+	})
+
+	t.Run("registration must answer the user's own challenge", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().ConsumeWebAuthnChallenge(mock.Anything, mock.Anything).
+			Return(repository.WebauthnChallenge{UserID: createTestUUID(t, "123e4567-e89b-12d3-a456-426614174002")}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.FinishPasskeyRegistration(context.Background(), userID, models.PasskeyRegistrationRequest{
+			RawID: b64(credentialID),
+			Response: models.PasskeyAttestationResponse{
+				ClientDataJSON:    b64(clientData(util.WebAuthnCeremonyCreate, "challenge-1")),
+				AuthenticatorData: b64(authenticatorData(util.WebAuthnFlagUserPresent|util.WebAuthnFlagAttestedData, 0, credentialID)),
+				PublicKey:         b64(publicKeyDER),
+			},
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+	})
+
+	t.Run("signs in with a valid assertion", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		userUUID := createTestUUID(t, userID)
+		storedID := createTestUUID(t, "123e4567-e89b-12d3-a456-426614174009")
+
+		authData := authenticatorData(util.WebAuthnFlagUserPresent, 5, nil)
+		clientDataJSON := clientData(util.WebAuthnCeremonyGet, "challenge-2")
+
+		mockQuerier.EXPECT().ConsumeWebAuthnChallenge(mock.Anything, repository.ConsumeWebAuthnChallengeParams{
+			Challenge: "challenge-2",
+			Ceremony:  util.WebAuthnCeremonyGet,
+		}).Return(repository.WebauthnChallenge{}, nil)
+		mockQuerier.EXPECT().GetWebAuthnCredential(mock.Anything, credentialID).Return(repository.WebauthnCredential{
+			ID:        storedID,
+			UserID:    userUUID,
+			PublicKey: publicKeyDER,
+			SignCount: 4,
+		}, nil)
+		mockQuerier.EXPECT().UpdateWebAuthnCredentialUsage(mock.Anything, repository.UpdateWebAuthnCredentialUsageParams{
+			ID:        storedID,
+			SignCount: 5,
+		}).Return(nil)
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID}, nil)
+		mockQuerier.EXPECT().IsUserDeactivated(mock.Anything, userUUID).Return(false, nil)
+
+		userHandle := b64(userUUID.Bytes[:])
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		user, err := service.FinishPasskeyLogin(context.Background(), models.PasskeyLoginRequest{
+			RawID: b64(credentialID),
+			Response: models.PasskeyAssertionResponse{
+				ClientDataJSON:    b64(clientDataJSON),
+				AuthenticatorData: b64(authData),
+				Signature:         b64(sign(authData, clientDataJSON)),
+				UserHandle:        &userHandle,
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, user.ID)
+	})
+
+	t.Run("rejects a counter that went backwards", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+
+		authData := authenticatorData(util.WebAuthnFlagUserPresent, 3, nil)
+		clientDataJSON := clientData(util.WebAuthnCeremonyGet, "challenge-3")
+
+		mockQuerier.EXPECT().ConsumeWebAuthnChallenge(mock.Anything, mock.Anything).Return(repository.WebauthnChallenge{}, nil)
+		mockQuerier.EXPECT().GetWebAuthnCredential(mock.Anything, credentialID).Return(repository.WebauthnCredential{
+			UserID:    createTestUUID(t, userID),
+			PublicKey: publicKeyDER,
+			SignCount: 4,
+		}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.FinishPasskeyLogin(context.Background(), models.PasskeyLoginRequest{
+			RawID: b64(credentialID),
+			Response: models.PasskeyAssertionResponse{
+				ClientDataJSON:    b64(clientDataJSON),
+				AuthenticatorData: b64(authData),
+				Signature:         b64(sign(authData, clientDataJSON)),
+			},
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+	})
+
+	t.Run("unknown or used challenge", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().ConsumeWebAuthnChallenge(mock.Anything, mock.Anything).Return(repository.WebauthnChallenge{}, pgx.ErrNoRows)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.FinishPasskeyLogin(context.Background(), models.PasskeyLoginRequest{
+			RawID: b64(credentialID),
+			Response: models.PasskeyAssertionResponse{
+				ClientDataJSON:    b64(clientData(util.WebAuthnCeremonyGet, "stale")),
+				AuthenticatorData: b64(authenticatorData(util.WebAuthnFlagUserPresent, 1, nil)),
+				Signature:         b64([]byte("sig")),
+			},
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+	})
+}
+
+func TestCreateReport(t *testing.T) {
+	reporterID := "123e4567-e89b-12d3-a456-426614174001"
+	gameID := "123e4567-e89b-12d3-a456-426614174005"
+
+	t.Run("files a report on a game", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		reporterUUID := createTestUUID(t, reporterID)
+		gameUUID := createTestUUID(t, gameID)
+
+		mockQuerier.EXPECT().GetGameOwner(mock.Anything, gameUUID).Return(createTestUUID(t, "123e4567-e89b-12d3-a456-426614174002"), nil)
+		mockQuerier.EXPECT().CountReportsByReporterSince(mock.Anything, mock.Anything).Return(int64(0), nil)
+		mockQuerier.EXPECT().CreateReport(mock.Anything, repository.CreateReportParams{
+			ReporterID: reporterUUID,
+			TargetType: "game",
+			TargetID:   gameUUID,
+			Reason:     "fraud",
+			Details:    pgtype.Text{String: "Took payment, never showed", Valid: true},
+		}).Return(repository.Report{
+			ID:         createTestUUID(t, "123e4567-e89b-12d3-a456-426614174009"),
+			TargetType: "game",
+			TargetID:   gameUUID,
+			Reason:     "fraud",
+			Status:     "open",
+		}, nil)
+
+		details := "Took payment, never showed"
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		report, err := service.CreateReport(context.Background(), reporterID, models.CreateReportRequest{
+			TargetType: models.ReportTargetGame,
+			TargetID:   gameID,
+			Reason:     models.ReportReasonFraud,
+			Details:    &details,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.ReportStatusOpen, report.Status)
+		assert.Equal(t, gameID, report.TargetID)
+	})
+
+	t.Run("can't report yourself", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.CreateReport(context.Background(), reporterID, models.CreateReportRequest{
+			TargetType: models.ReportTargetUser,
+			TargetID:   reporterID,
+			Reason:     models.ReportReasonSpam,
+		})
+
+		var invalidArgErr *InvalidArgumentError
+		assert.ErrorAs(t, err, &invalidArgErr)
+	})
+
+	t.Run("daily limit", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetGameOwner(mock.Anything, mock.Anything).Return(pgtype.UUID{}, nil)
+		mockQuerier.EXPECT().CountReportsByReporterSince(mock.Anything, mock.Anything).Return(int64(maxReportsPerWindow), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.CreateReport(context.Background(), reporterID, models.CreateReportRequest{
+			TargetType: models.ReportTargetGame,
+			TargetID:   gameID,
+			Reason:     models.ReportReasonSpam,
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrRateLimited)
+	})
+
+	t.Run("already reported", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().GetGameOwner(mock.Anything, mock.Anything).Return(pgtype.UUID{}, nil)
+		mockQuerier.EXPECT().CountReportsByReporterSince(mock.Anything, mock.Anything).Return(int64(1), nil)
+		mockQuerier.EXPECT().CreateReport(mock.Anything, mock.Anything).Return(repository.Report{}, pgx.ErrNoRows)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.CreateReport(context.Background(), reporterID, models.CreateReportRequest{
+			TargetType: models.ReportTargetGame,
+			TargetID:   gameID,
+			Reason:     models.ReportReasonSpam,
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrAlreadyExists)
+	})
+}
+
+func TestSuspendUser(t *testing.T) {
+	adminID := "123e4567-e89b-12d3-a456-426614174009"
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("suspends an account", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		adminUUID := createTestUUID(t, adminID)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID}, nil)
+		mockQuerier.EXPECT().SuspendUser(mock.Anything, repository.SuspendUserParams{
+			UserID:      userUUID,
+			SuspendedBy: adminUUID,
+			Reason:      "Repeated harassment",
+		}).Return(repository.UserSuspension{UserID: userUUID, SuspendedBy: adminUUID, Reason: "Repeated harassment"}, nil)
+		mockQuerier.EXPECT().CreateAdminAuditEntry(mock.Anything, repository.CreateAdminAuditEntryParams{
+			ActorID:    adminUUID,
+			Action:     string(models.AdminActionSuspendUser),
+			TargetType: string(models.AuditTargetUser),
+			TargetID:   userUUID,
+			Reason:     pgtype.Text{String: "Repeated harassment", Valid: true},
+		}).Return(nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		suspension, err := service.SuspendUser(context.Background(), adminID, userID, "Repeated harassment")
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, suspension.UserID)
+		assert.Equal(t, &adminID, suspension.SuspendedBy)
+	})
+
+	t.Run("admins can't suspend themselves", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		_, err := service.SuspendUser(context.Background(), adminID, adminID, "Oops")
+		assert.ErrorIs(t, err, ErrCannotSuspendSelf)
+	})
+
+	t.Run("lifting a suspension that doesn't exist", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().UnsuspendUser(mock.Anything, createTestUUID(t, userID)).Return(int64(0), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		assert.ErrorIs(t, service.UnsuspendUser(context.Background(), adminID, userID), apperrors.ErrNotFound)
+	})
+}
+
+func TestShadowBanUser(t *testing.T) {
+	adminID := "123e4567-e89b-12d3-a456-426614174009"
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("shadow-bans an account", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		adminUUID := createTestUUID(t, adminID)
+		userUUID := createTestUUID(t, userID)
+
+		mockQuerier.EXPECT().GetUserByID(mock.Anything, userUUID).Return(repository.User{ID: userUUID}, nil)
+		mockQuerier.EXPECT().ShadowBanUser(mock.Anything, repository.ShadowBanUserParams{
+			UserID:   userUUID,
+			BannedBy: adminUUID,
+			Reason:   "Spam games",
+		}).Return(repository.UserShadowBan{UserID: userUUID, BannedBy: adminUUID, Reason: "Spam games"}, nil)
+		mockQuerier.EXPECT().CreateAdminAuditEntry(mock.Anything, repository.CreateAdminAuditEntryParams{
+			ActorID:    adminUUID,
+			Action:     string(models.AdminActionShadowBanUser),
+			TargetType: string(models.AuditTargetUser),
+			TargetID:   userUUID,
+			Reason:     pgtype.Text{String: "Spam games", Valid: true},
+		}).Return(nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		ban, err := service.ShadowBanUser(context.Background(), adminID, userID, "Spam games")
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, ban.UserID)
+		assert.Equal(t, &adminID, ban.BannedBy)
+	})
+
+	t.Run("lifting a shadow ban that doesn't exist", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		mockQuerier.EXPECT().LiftShadowBan(mock.Anything, createTestUUID(t, userID)).Return(int64(0), nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		assert.ErrorIs(t, service.LiftShadowBan(context.Background(), adminID, userID), apperrors.ErrNotFound)
+	})
+}
+
+func TestListAdminAudit(t *testing.T) {
+	adminID := "123e4567-e89b-12d3-a456-426614174009"
+	gameID := "123e4567-e89b-12d3-a456-426614174002"
+
+	t.Run("filters by target and pages", func(t *testing.T) {
+		mockQuerier := mocks.NewQuerier(t)
+		gameUUID := createTestUUID(t, gameID)
+		entry := repository.AdminAudit{
+			ID:         createTestUUID(t, "123e4567-e89b-12d3-a456-426614174010"),
+			ActorID:    createTestUUID(t, adminID),
+			Action:     string(models.AdminActionCancelGame),
+			TargetType: string(models.AuditTargetGame),
+			TargetID:   gameUUID,
+			Reason:     pgtype.Text{String: "Fraudulent listing", Valid: true},
+		}
+
+		mockQuerier.EXPECT().ListAdminAudit(mock.Anything, repository.ListAdminAuditParams{
+			TargetID:   gameUUID,
+			PageLimit:  2,
+			PageOffset: 0,
+		}).Return([]repository.AdminAudit{entry, entry}, nil)
+
+		service := NewUserService(mockQuerier, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		page, err := service.ListAdminAudit(context.Background(), ListAdminAuditFilters{TargetID: &gameID, Limit: 1})
+
+		require.NoError(t, err)
+		require.Len(t, page.Entries, 1)
+		assert.Equal(t, models.AdminActionCancelGame, page.Entries[0].Action)
+		assert.Equal(t, gameID, page.Entries[0].TargetID)
+		assert.True(t, page.HasMore)
+		require.NotNil(t, page.NextOffset)
+		assert.Equal(t, 1, *page.NextOffset)
+	})
+
+	t.Run("rejects malformed actor IDs", func(t *testing.T) {
+		service := NewUserService(mocks.NewQuerier(t), notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+		badID := "not-a-uuid"
+		_, err := service.ListAdminAudit(context.Background(), ListAdminAuditFilters{ActorID: &badID})
+
+		var invalidArgErr *InvalidArgumentError
+		assert.ErrorAs(t, err, &invalidArgErr)
+	})
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// ReorderWaitlist rearranges the waitlist of the owner's game into the given order and returns the
+// updated game. The request must list every waitlisted participant exactly once, by user ID or by
+// placeholder ID for placeholders, so a stale client can't silently drop someone to the back.
+//
+// Only waitlisted participants' ranks change, and they keep the ranks the waitlist already held, so
+// confirmed players stay confirmed and later sign-ups still join behind everyone listed.
+func (s *GamesService) ReorderWaitlist(ctx context.Context, gameID string, ownerID string, request models.ReorderWaitlistRequest) (*models.Game, error) {
+	var gameUUID, ownerUUID pgtype.UUID
+	if err := gameUUID.Scan(gameID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "game_id",
+			Message:      "invalid game ID format",
+		}
+	}
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	err := s.inTx(ctx, func(q ifaces.Querier) error {
+		if _, err := lockOwnedGame(ctx, q, gameUUID, ownerUUID); err != nil {
+			return err
+		}
+
+		participants, err := q.ListActiveParticipantsByGame(ctx, gameUUID)
+		if err != nil {
+			return fmt.Errorf("failed to list participants: %w", err)
+		}
+		waitlisted := map[string]pgtype.UUID{} // user ID (placeholder ID for placeholders) -> participant ID
+		for _, p := range participants {
+			if p.Status != string(models.ParticipantStatusWaitlist) {
+				continue
+			}
+			key := p.ID
+			if p.UserID.Valid {
+				key = p.UserID
+			}
+			waitlisted[uuid.UUID(key.Bytes).String()] = p.ID
+		}
+
+		participantIDs, err := waitlistOrder(request.UserIDs, waitlisted)
+		if err != nil {
+			return err
+		}
+		if len(participantIDs) == 0 {
+			return nil
+		}
+
+		if err := q.ReorderWaitlist(ctx, repository.ReorderWaitlistParams{
+			ParticipantIds: participantIDs,
+			GameID:         gameUUID,
+		}); err != nil {
+			return fmt.Errorf("failed to reorder waitlist: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().Int("waitlisted", len(request.UserIDs)).Msg("Waitlist reordered")
+	return s.GetGame(ctx, gameID, ownerID)
+}
+
+// waitlistOrder maps the requested order onto participant IDs, requiring exactly the current waitlist
+func waitlistOrder(userIDs []string, waitlisted map[string]pgtype.UUID) ([]pgtype.UUID, error) {
+	if len(userIDs) != len(waitlisted) {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_ids",
+			Message:      fmt.Sprintf("the waitlist has %d participants but %d were listed", len(waitlisted), len(userIDs)),
+		}
+	}
+	participantIDs := make([]pgtype.UUID, 0, len(userIDs))
+	seen := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		parsed, err := uuid.Parse(userID)
+		if err != nil {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "user_ids",
+				Message:      fmt.Sprintf("invalid user ID %q", userID),
+			}
+		}
+		key := parsed.String()
+		participantID, ok := waitlisted[key]
+		if !ok {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "user_ids",
+				Message:      fmt.Sprintf("%s is not on the waitlist", userID),
+			}
+		}
+		if seen[key] {
+			return nil, &InvalidArgumentError{
+				ArgumentName: "user_ids",
+				Message:      fmt.Sprintf("%s is listed more than once", userID),
+			}
+		}
+		seen[key] = true
+		participantIDs = append(participantIDs, participantID)
+	}
+	return participantIDs, nil
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// creditCurrency is the currency credit is held in; it only pays for games priced in it
+	creditCurrency = "USD"
+	// referralRewardCents is the credit a user earns for each friend who signs up with their code
+	referralRewardCents = 500
+)
+
+// WalletFilters pages a user's credit transactions
+type WalletFilters struct {
+	Limit  int // Number of results to return (default 50, max 100)
+	Offset int // Number of results to skip (default 0)
+}
+
+// Wallet returns the user's credit balance and one page of their credit transactions, most recent first
+func (u *UserService) Wallet(ctx context.Context, userID string, filters WalletFilters) (*models.Wallet, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if filters.Limit < 0 || filters.Offset < 0 {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "limit",
+			Message:      "limit and offset must be non-negative",
+		}
+	}
+	if filters.Limit == 0 {
+		filters.Limit = 50
+	}
+	if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	balance, err := u.queries.GetCreditBalance(ctx, userUUID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get credit balance: %w", err)
+	}
+	rows, err := u.queries.ListCreditTransactions(ctx, repository.ListCreditTransactionsParams{
+		UserID: userUUID,
+		// Fetch one extra row to learn whether another page follows
+		Limit:  int32(filters.Limit + 1),
+		Offset: int32(filters.Offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credit transactions: %w", err)
+	}
+
+	hasMore := len(rows) > filters.Limit
+	if hasMore {
+		rows = rows[:filters.Limit]
+	}
+
+	wallet := &models.Wallet{
+		BalanceCents: int(balance),
+		Currency:     creditCurrency,
+		Transactions: make([]models.CreditTransaction, 0, len(rows)),
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		HasMore:      hasMore,
+	}
+	for _, row := range rows {
+		transaction := models.CreditTransaction{
+			ID:                uuid.UUID(row.ID.Bytes).String(),
+			AmountCents:       int(row.AmountCents),
+			Kind:              models.CreditKind(row.Kind),
+			BalanceAfterCents: int(row.BalanceAfterCents),
+			CreatedAt:         row.CreatedAt.Time.UTC(),
+		}
+		if row.GameID.Valid {
+			gameID := uuid.UUID(row.GameID.Bytes).String()
+			transaction.GameID = &gameID
+		}
+		wallet.Transactions = append(wallet.Transactions, transaction)
+	}
+	if hasMore {
+		next := filters.Offset + filters.Limit
+		wallet.NextOffset = &next
+	}
+	return wallet, nil
+}
+
+// recordCredit moves the user's balance by amountCents (negative to spend) and appends it to their ledger
+func recordCredit(ctx context.Context, q ifaces.Querier, userUUID pgtype.UUID, amountCents int32, kind models.CreditKind, gameUUID pgtype.UUID) error {
+	if _, err := q.RecordCreditTransaction(ctx, repository.RecordCreditTransactionParams{
+		UserID:      userUUID,
+		AmountCents: amountCents,
+		Kind:        string(kind),
+		GameID:      gameUUID,
+	}); err != nil {
+		return fmt.Errorf("failed to record %s credit: %w", kind, err)
+	}
+	log.Ctx(ctx).Info().Int32("amountCents", amountCents).Str("kind", string(kind)).Msg("Credit recorded")
+	return nil
+}
+
+// paysWithCredit reports whether players' credit pays toward their spots in the game
+func paysWithCredit(game repository.GetGameForUpdateRow) bool {
+	return game.PricingType == string(models.PricingTypePerPerson) && game.PricingCurrency == creditCurrency && game.PricingAmountCents > 0
+}
+
+// settleRosterCredits keeps credit spending in line with a roster change: players moved into a
+// confirmed spot pay toward it from their credit, and players moved to the waitlist get theirs back.
+// participants is the roster as it was before the change. Call it in the transaction that made it.
+func settleRosterCredits(ctx context.Context, q ifaces.Querier, game repository.GetGameForUpdateRow, participants []repository.ParticipantDetail, toConfirm, toWaitlist []pgtype.UUID) error {
+	if !paysWithCredit(game) {
+		return nil
+	}
+	for _, p := range participants {
+		if !p.UserID.Valid {
+			continue
+		}
+		switch {
+		case slices.Contains(toConfirm, p.ID) && !p.Paid:
+			if err := spendCreditsOnJoin(ctx, q, game.ID, p.UserID, p.ID, game.PricingAmountCents); err != nil {
+				return err
+			}
+		case slices.Contains(toWaitlist, p.ID):
+			if err := refundParticipantCredits(ctx, q, game.ID, repository.Participant{
+				ID:                 p.ID,
+				UserID:             p.UserID,
+				Paid:               p.Paid,
+				PaymentAmountCents: p.PaymentAmountCents,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// spendCreditsOnJoin pays what it can of a confirmed player's price from their credit and records it
+// as their payment. Call it inside the transaction that confirms the spot; the wallet row is locked so
+// concurrent joins can't spend the same credit twice.
+func spendCreditsOnJoin(ctx context.Context, q ifaces.Querier, gameUUID, userUUID, participantID pgtype.UUID, priceCents int32) error {
+	balance, err := q.GetCreditBalanceForUpdate(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get credit balance: %w", err)
+	}
+	if balance == 0 {
+		return nil
+	}
+
+	// A player who left and rejoined may still have credit on the game from before
+	spent, err := q.GetGameCreditsSpent(ctx, repository.GetGameCreditsSpentParams{UserID: userUUID, GameID: gameUUID})
+	if err != nil {
+		return fmt.Errorf("failed to get credits spent on game: %w", err)
+	}
+	due := priceCents - spent
+	if due <= 0 {
+		return nil
+	}
+	applied := min(balance, due)
+
+	if err := recordCredit(ctx, q, userUUID, -applied, models.CreditKindGameJoin, gameUUID); err != nil {
+		return err
+	}
+	if _, err := q.UpdateParticipantPayment(ctx, repository.UpdateParticipantPaymentParams{
+		ID:                 participantID,
+		Paid:               spent+applied >= priceCents,
+		PaymentAmountCents: pgtype.Int4{Int32: spent + applied, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to record credit payment: %w", err)
+	}
+	return nil
+}
+
+// refundGameCredits returns the credit the user spent on a game to their wallet and reports how much
+// that was. Refunded credit nets out of the game's ledger entries, so calling it again refunds nothing.
+func refundGameCredits(ctx context.Context, q ifaces.Querier, gameUUID, userUUID pgtype.UUID) (int32, error) {
+	// Lock the wallet so two refunds of the same game can't both see the credit as unrefunded
+	if _, err := q.GetCreditBalanceForUpdate(ctx, userUUID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get credit balance: %w", err)
+	}
+	spent, err := q.GetGameCreditsSpent(ctx, repository.GetGameCreditsSpentParams{UserID: userUUID, GameID: gameUUID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get credits spent on game: %w", err)
+	}
+	if spent <= 0 {
+		return 0, nil
+	}
+	if err := recordCredit(ctx, q, userUUID, spent, models.CreditKindRefund, gameUUID); err != nil {
+		return 0, err
+	}
+	return spent, nil
+}
+
+// refundParticipantCredits refunds the credit a player leaving a game spent on it and takes the
+// refunded amount off their recorded payment. Call it in the transaction that takes them off the roster.
+func refundParticipantCredits(ctx context.Context, q ifaces.Querier, gameUUID pgtype.UUID, participant repository.Participant) error {
+	// Credit spends are always recorded as a payment, so players without one have nothing to refund
+	if !participant.PaymentAmountCents.Valid {
+		return nil
+	}
+	refunded, err := refundGameCredits(ctx, q, gameUUID, participant.UserID)
+	if err != nil || refunded == 0 {
+		return err
+	}
+
+	remaining := participant.PaymentAmountCents.Int32 - refunded
+	payment := repository.UpdateParticipantPaymentParams{ID: participant.ID, Paid: participant.Paid && remaining > 0}
+	if remaining > 0 {
+		payment.PaymentAmountCents = pgtype.Int4{Int32: remaining, Valid: true}
+	}
+	if _, err := q.UpdateParticipantPayment(ctx, payment); err != nil {
+		return fmt.Errorf("failed to clear refunded payment: %w", err)
+	}
+	return nil
+}
+
+// refundCancelledGameCredits refunds every player who spent credit on a cancelled game. Each refund
+// commits on its own; already-refunded players are skipped, so a retry picks up where a failure left off.
+func (s *GamesService) refundCancelledGameCredits(ctx context.Context, gameUUID pgtype.UUID) error {
+	spenders, err := s.queries.ListGameCreditSpenders(ctx, gameUUID)
+	if err != nil {
+		return fmt.Errorf("failed to list credit spenders: %w", err)
+	}
+	var errs []error
+	for _, spender := range spenders {
+		if err := s.inTx(ctx, func(q ifaces.Querier) error {
+			_, err := refundGameCredits(ctx, q, gameUUID, spender.UserID)
+			return err
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,384 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gabe-dev-svc/volley/ifaces"
+	apperrors "github.com/gabe-dev-svc/volley/internal/errors"
+	"github.com/gabe-dev-svc/volley/internal/events"
+	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/gabe-dev-svc/volley/internal/util"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrTooManyWebhooks = errors.New("webhook subscription limit reached")
+
+const (
+	// maxWebhookSubscriptions is how many endpoints one user can subscribe
+	maxWebhookSubscriptions = 10
+	// webhookBatchSize is how many due deliveries one run of the job claims
+	webhookBatchSize = 20
+	// webhookTimeout bounds each delivery, so one slow endpoint can't outlast the claim's lease
+	webhookTimeout = 10 * time.Second
+	// maxWebhookAttempts is how many times a delivery is tried before it is marked failed. With the
+	// side effect backoff that spans about three and a half hours.
+	maxWebhookAttempts = 10
+)
+
+// errPrivateWebhookAddress stops deliveries to hosts that resolve inside our own network
+var errPrivateWebhookAddress = errors.New("webhook URL resolves to a private address")
+
+// Webhooks publishes game events to the endpoints hosts subscribe and delivers them, retrying
+// failed deliveries with backoff
+type Webhooks struct {
+	queries    ifaces.Querier
+	httpClient *http.Client
+}
+
+func NewWebhooks(queries ifaces.Querier) *Webhooks {
+	return &Webhooks{
+		queries:    queries,
+		httpClient: webhookHTTPClient(),
+	}
+}
+
+// Subscribe publishes game events on the bus to the webhook subscriptions of the game's host
+func (w *Webhooks) Subscribe(bus *events.Bus) {
+	events.Subscribe(bus, func(ctx context.Context, event events.GameCreated) error {
+		return w.Publish(ctx, event.Game.OwnerID, models.WebhookEventGameCreated, models.WebhookEventData{GameID: event.Game.ID})
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.ParticipantJoined) error {
+		return w.Publish(ctx, event.Game.OwnerID, models.WebhookEventParticipantJoined, models.WebhookEventData{
+			GameID:            event.Game.ID,
+			UserID:            &event.UserID,
+			ParticipantStatus: &event.Status,
+		})
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.ParticipantDropped) error {
+		return w.Publish(ctx, event.Game.OwnerID, models.WebhookEventParticipantDropped, models.WebhookEventData{
+			GameID: event.Game.ID,
+			UserID: &event.UserID,
+		})
+	})
+	events.Subscribe(bus, func(ctx context.Context, event events.GameCancelled) error {
+		return w.Publish(ctx, event.Game.OwnerID, models.WebhookEventGameCancelled, models.WebhookEventData{
+			GameID: event.Game.ID,
+			Reason: event.Reason,
+		})
+	})
+}
+
+// webhookHTTPClient only connects to public addresses, checked after DNS resolution so a hostname
+// can't point deliveries at internal services. Redirects aren't followed for the same reason.
+func webhookHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return errPrivateWebhookAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout:   webhookTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which net.IP.IsPrivate doesn't
+// cover but which is no more reachable from the internet than the private ranges
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsUnspecified() && !ip.IsMulticast() && !sharedAddressSpace.Contains(ip)
+}
+
+// CreateWebhook subscribes an HTTPS endpoint to events about the games the user hosts. The
+// returned subscription holds the signing secret, which is not shown again.
+func (s *GamesService) CreateWebhook(ctx context.Context, userID string, request models.CreateWebhookRequest) (*models.WebhookSubscription, error) {
+	logger := log.Ctx(ctx)
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := validateWebhookURL(request.URL); err != nil {
+		return nil, err
+	}
+
+	eventTypes := make([]string, 0, len(request.EventTypes))
+	for _, eventType := range request.EventTypes {
+		if !slices.Contains(eventTypes, string(eventType)) {
+			eventTypes = append(eventTypes, string(eventType))
+		}
+	}
+
+	existing, err := s.queries.ListWebhookSubscriptions(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	if len(existing) >= maxWebhookSubscriptions {
+		return nil, ErrTooManyWebhooks
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	subscription, err := s.queries.CreateWebhookSubscription(ctx, repository.CreateWebhookSubscriptionParams{
+		OwnerID:    userUUID,
+		Url:        request.URL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	created := convertWebhookSubscription(subscription)
+	created.Secret = subscription.Secret
+	logger.Info().Str("webhookId", created.ID).Strs("eventTypes", eventTypes).Msg("Webhook subscription created")
+	return &created, nil
+}
+
+// ListWebhooks returns the user's webhook subscriptions, oldest first and without their secrets
+func (s *GamesService) ListWebhooks(ctx context.Context, userID string) ([]models.WebhookSubscription, error) {
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+
+	subscriptions, err := s.queries.ListWebhookSubscriptions(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	result := make([]models.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, convertWebhookSubscription(subscription))
+	}
+	return result, nil
+}
+
+// DeleteWebhook unsubscribes one of the user's endpoints. Deliveries still pending for it are dropped.
+func (s *GamesService) DeleteWebhook(ctx context.Context, userID string, webhookID string) error {
+	var userUUID, webhookUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "user_id",
+			Message:      "invalid user ID format",
+		}
+	}
+	if err := webhookUUID.Scan(webhookID); err != nil {
+		return &InvalidArgumentError{
+			ArgumentName: "webhook_id",
+			Message:      "invalid webhook ID format",
+		}
+	}
+
+	deleted, err := s.queries.DeleteWebhookSubscription(ctx, repository.DeleteWebhookSubscriptionParams{
+		ID:      webhookUUID,
+		OwnerID: userUUID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	if deleted == 0 {
+		return apperrors.ErrNotFound
+	}
+	log.Ctx(ctx).Info().Str("webhookId", webhookID).Msg("Webhook subscription deleted")
+	return nil
+}
+
+// validateWebhookURL accepts absolute HTTPS URLs on a public host. Hostnames are checked again
+// against the address they resolve to when events are delivered.
+func validateWebhookURL(raw string) error {
+	invalid := func(message string) error {
+		return &InvalidArgumentError{ArgumentName: "url", Message: message}
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return invalid("url must be an absolute URL")
+	}
+	if parsed.Scheme != "https" {
+		return invalid("url must use https")
+	}
+	if parsed.User != nil {
+		return invalid("url must not contain credentials")
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return invalid("url must point to a public host")
+	}
+	if ip := net.ParseIP(host); ip != nil && !isPublicIP(ip) {
+		return invalid("url must point to a public host")
+	}
+	return nil
+}
+
+// generateWebhookSecret returns a random signing secret, prefixed so it is recognizable in a
+// receiver's config
+func generateWebhookSecret() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+func convertWebhookSubscription(subscription repository.WebhookSubscription) models.WebhookSubscription {
+	eventTypes := make([]models.WebhookEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, models.WebhookEventType(eventType))
+	}
+	return models.WebhookSubscription{
+		ID:         uuid.UUID(subscription.ID.Bytes).String(),
+		URL:        subscription.Url,
+		EventTypes: eventTypes,
+		CreatedAt:  subscription.CreatedAt.Time.UTC(),
+	}
+}
+
+// Publish queues an event for delivery to each of the owner's subscriptions to its type
+func (w *Webhooks) Publish(ctx context.Context, ownerID string, eventType models.WebhookEventType, data models.WebhookEventData) error {
+	var ownerUUID pgtype.UUID
+	if err := ownerUUID.Scan(ownerID); err != nil {
+		return fmt.Errorf("invalid owner ID: %w", err)
+	}
+
+	event := models.WebhookEvent{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	queued, err := w.queries.EnqueueWebhookDeliveries(ctx, repository.EnqueueWebhookDeliveriesParams{
+		EventType: string(eventType),
+		Payload:   payload,
+		OwnerID:   ownerUUID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue webhook deliveries: %w", err)
+	}
+	if queued > 0 {
+		log.Ctx(ctx).Info().
+			Str("eventType", string(eventType)).
+			Str("eventId", event.ID).
+			Int64("deliveries", queued).
+			Msg("Webhook event queued")
+	}
+	return nil
+}
+
+// DeliverDue POSTs due webhook deliveries to their endpoints. Deliveries the endpoint doesn't
+// accept with a 2xx are retried with the side effect backoff until maxWebhookAttempts, after which
+// they are marked failed.
+func (w *Webhooks) DeliverDue(ctx context.Context) error {
+	deliveries, err := w.queries.ClaimDueWebhookDeliveries(ctx, repository.ClaimDueWebhookDeliveriesParams{
+		LeaseSeconds: int32(sideEffectLease / time.Second),
+		BatchSize:    webhookBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		logger := log.Ctx(ctx).With().
+			Str("deliveryId", uuid.UUID(delivery.ID.Bytes).String()).
+			Str("webhookId", uuid.UUID(delivery.SubscriptionID.Bytes).String()).
+			Str("eventType", delivery.EventType).
+			Int32("attempt", delivery.Attempts).
+			Logger()
+
+		deliverErr := w.deliver(ctx, delivery)
+		if deliverErr == nil {
+			if err := w.queries.CompleteWebhookDelivery(ctx, delivery.ID); err != nil {
+				return fmt.Errorf("failed to complete webhook delivery: %w", err)
+			}
+			logger.Info().Msg("Webhook delivered")
+			continue
+		}
+
+		lastError := pgtype.Text{String: deliverErr.Error(), Valid: true}
+		if delivery.Attempts >= maxWebhookAttempts {
+			if err := w.queries.FailWebhookDelivery(ctx, repository.FailWebhookDeliveryParams{ID: delivery.ID, LastError: lastError}); err != nil {
+				return fmt.Errorf("failed to mark webhook delivery failed: %w", err)
+			}
+			logger.Error().Err(deliverErr).Msg("Webhook delivery failed permanently")
+			continue
+		}
+
+		nextAttempt := time.Now().Add(sideEffectBackoff(delivery.Attempts))
+		if err := w.queries.RescheduleWebhookDelivery(ctx, repository.RescheduleWebhookDeliveryParams{
+			ID:            delivery.ID,
+			LastError:     lastError,
+			NextAttemptAt: pgtype.Timestamptz{Time: nextAttempt, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to reschedule webhook delivery: %w", err)
+		}
+		logger.Warn().Err(deliverErr).Time("nextAttemptAt", nextAttempt).Msg("Webhook delivery failed - rescheduled")
+	}
+	return nil
+}
+
+// deliver POSTs one delivery's event, signed with its subscription's secret
+func (w *Webhooks) deliver(ctx context.Context, delivery repository.ClaimDueWebhookDeliveriesRow) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "Volley-Webhooks/1.0")
+	httpReq.Header.Set("X-Volley-Event", delivery.EventType)
+	httpReq.Header.Set("X-Volley-Delivery", uuid.UUID(delivery.ID.Bytes).String())
+	httpReq.Header.Set(util.WebhookSignatureHeader, util.SignWebhook(delivery.Secret, time.Now(), delivery.Payload))
+
+	resp, err := w.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -2,7 +2,18 @@ package util
 
 import (
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,6 +23,19 @@ import (
 var (
 	ErrInvalidToken = errors.New("invalid token")
 	ErrExpiredToken = errors.New("token has expired")
+	ErrNoJWTKeys    = errors.New("no JWT signing keys configured")
+)
+
+const (
+	// DevelopmentJWTKeyID identifies the built-in key used when no keys are configured outside release mode
+	DevelopmentJWTKeyID = "dev"
+	// developmentJWTSecret is the secret tokens were signed with before keys were configurable.
+	// Keeping it as the development key means local sessions survive the upgrade.
+	developmentJWTSecret = "your-secret-key-change-this-in-production"
+	// minJWTSecretLength is the shortest secret accepted from configuration (256 bits for HS256)
+	minJWTSecretLength = 32
+	// defaultJWTExpirationHours is how long access tokens stay valid
+	defaultJWTExpirationHours = 24 * 7 // 7 days
 )
 
 // JWTClaims represents the claims stored in the JWT
@@ -20,34 +44,182 @@ type JWTClaims struct {
 	Email     string `json:"email"`
 	FirstName string `json:"firstName"`
 	LastName  string `json:"lastName"`
+	// HomeRegion is the region the user's data is homed to; empty for tokens issued before regions existed
+	HomeRegion string `json:"homeRegion,omitempty"`
+	// TokenVersion must match the user's current token version; it is bumped on password change
+	// and "log out everywhere" to revoke outstanding access tokens
+	TokenVersion int32 `json:"tokenVersion,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// JWTConfig holds JWT configuration
+// JWTConfig holds JWT configuration. Tokens are signed with SigningKeyID and carry it in their kid
+// header; any key in Keys is accepted when validating, so a new key can be rolled out while tokens
+// signed with the previous one are still in circulation.
 type JWTConfig struct {
-	SecretKey       string
+	Keys            map[string]JWTKey // Keys by key ID
+	SigningKeyID    string
 	ExpirationHours int
 }
 
-// DefaultJWTConfig returns default JWT configuration
-func DefaultJWTConfig() *JWTConfig {
+// JWTKey is a key tokens can be signed and validated with: an HMAC secret (HS256), or an RSA (RS256)
+// or Ed25519 (EdDSA) private key whose public half other services can fetch from the JWKS endpoint
+type JWTKey struct {
+	Method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+}
+
+// NewHMACJWTKey returns an HS256 key for a shared secret
+func NewHMACJWTKey(secret string) JWTKey {
+	return JWTKey{Method: jwt.SigningMethodHS256, signKey: []byte(secret), verifyKey: []byte(secret)}
+}
+
+// ParsePrivateJWTKey returns an RS256 or EdDSA key for a PEM-encoded RSA (PKCS#1 or PKCS#8) or
+// Ed25519 (PKCS#8) private key
+func ParsePrivateJWTKey(pemData []byte) (JWTKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return JWTKey{}, fmt.Errorf("private key is not PEM encoded")
+	}
+
+	var privateKey crypto.PrivateKey
+	privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		rsaKey, rsaErr := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if rsaErr != nil {
+			return JWTKey{}, fmt.Errorf("unreadable private key: %w", err)
+		}
+		privateKey = rsaKey
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < 2048 {
+			return JWTKey{}, fmt.Errorf("RSA keys must be at least 2048 bits")
+		}
+		return JWTKey{Method: jwt.SigningMethodRS256, signKey: key, verifyKey: &key.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return JWTKey{Method: jwt.SigningMethodEdDSA, signKey: key, verifyKey: key.Public()}, nil
+	default:
+		return JWTKey{}, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+}
+
+// PublicKey returns the key's public half, or nil for HMAC keys which have none
+func (k JWTKey) PublicKey() crypto.PublicKey {
+	switch key := k.verifyKey.(type) {
+	case *rsa.PublicKey, ed25519.PublicKey:
+		return key
+	default:
+		return nil
+	}
+}
+
+var (
+	jwtConfigMu     sync.RWMutex
+	activeJWTConfig = DevelopmentJWTConfig()
+)
+
+// DevelopmentJWTConfig returns a configuration with the built-in development key. It must not be
+// used in release mode.
+func DevelopmentJWTConfig() *JWTConfig {
 	return &JWTConfig{
-		SecretKey:       "your-secret-key-change-this-in-production", // TODO: Load from env
-		ExpirationHours: 24 * 7,                                      // 7 days
+		Keys:            map[string]JWTKey{DevelopmentJWTKeyID: NewHMACJWTKey(developmentJWTSecret)},
+		SigningKeyID:    DevelopmentJWTKeyID,
+		ExpirationHours: defaultJWTExpirationHours,
+	}
+}
+
+// DefaultJWTConfig returns the JWT configuration set at startup with SetJWTConfig
+func DefaultJWTConfig() *JWTConfig {
+	jwtConfigMu.RLock()
+	defer jwtConfigMu.RUnlock()
+	return activeJWTConfig
+}
+
+// SetJWTConfig replaces the configuration used when no config is passed to GenerateToken and by ValidateToken
+func SetJWTConfig(config *JWTConfig) {
+	jwtConfigMu.Lock()
+	defer jwtConfigMu.Unlock()
+	activeJWTConfig = config
+}
+
+// ParseJWTConfig builds a configuration from JSON objects keyed by key ID: rawSecrets holds HMAC
+// secrets, e.g. {"2026-10":"..."}, and rawPrivateKeys holds PEM-encoded RSA or Ed25519 private
+// keys. Either may be empty. signingKeyID picks the key new tokens are signed with and may be empty
+// when there is only one key. Returns ErrNoJWTKeys if no keys are given.
+func ParseJWTConfig(rawSecrets string, rawPrivateKeys string, signingKeyID string) (*JWTConfig, error) {
+	keys := make(map[string]JWTKey)
+
+	if rawSecrets != "" {
+		var secrets map[string]string
+		if err := json.Unmarshal([]byte(rawSecrets), &secrets); err != nil {
+			return nil, fmt.Errorf("invalid JWT keys: %w", err)
+		}
+		for kid, secret := range secrets {
+			if len(secret) < minJWTSecretLength {
+				return nil, fmt.Errorf("JWT key %q: secret must be at least %d characters", kid, minJWTSecretLength)
+			}
+			keys[kid] = NewHMACJWTKey(secret)
+		}
+	}
+
+	if rawPrivateKeys != "" {
+		var privateKeys map[string]string
+		if err := json.Unmarshal([]byte(rawPrivateKeys), &privateKeys); err != nil {
+			return nil, fmt.Errorf("invalid JWT private keys: %w", err)
+		}
+		for kid, pemData := range privateKeys {
+			if _, ok := keys[kid]; ok {
+				return nil, fmt.Errorf("JWT key ID %q is used twice", kid)
+			}
+			key, err := ParsePrivateJWTKey([]byte(pemData))
+			if err != nil {
+				return nil, fmt.Errorf("JWT key %q: %w", kid, err)
+			}
+			keys[kid] = key
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrNoJWTKeys
 	}
+	if _, ok := keys[""]; ok {
+		return nil, fmt.Errorf("invalid JWT keys: key IDs must not be empty")
+	}
+
+	if signingKeyID == "" {
+		if len(keys) > 1 {
+			return nil, fmt.Errorf("a signing key ID is required when more than one JWT key is configured")
+		}
+		for kid := range keys {
+			signingKeyID = kid
+		}
+	}
+	if _, ok := keys[signingKeyID]; !ok {
+		return nil, fmt.Errorf("signing key %q is not one of the configured JWT keys", signingKeyID)
+	}
+
+	return &JWTConfig{
+		Keys:            keys,
+		SigningKeyID:    signingKeyID,
+		ExpirationHours: defaultJWTExpirationHours,
+	}, nil
 }
 
 // GenerateToken creates a new JWT token for a user
-func GenerateToken(userID, email, firstName, lastName string, config *JWTConfig) (string, error) {
+func GenerateToken(userID, email, firstName, lastName, homeRegion string, tokenVersion int32, config *JWTConfig) (string, error) {
 	if config == nil {
 		config = DefaultJWTConfig()
 	}
 
 	claims := JWTClaims{
-		UserID:    userID,
-		Email:     email,
-		FirstName: firstName,
-		LastName:  lastName,
+		UserID:       userID,
+		Email:        email,
+		FirstName:    firstName,
+		LastName:     lastName,
+		HomeRegion:   homeRegion,
+		TokenVersion: tokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * time.Duration(config.ExpirationHours))),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -56,8 +228,14 @@ func GenerateToken(userID, email, firstName, lastName string, config *JWTConfig)
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(config.SecretKey))
+	key, ok := config.Keys[config.SigningKeyID]
+	if !ok {
+		return "", fmt.Errorf("signing key %q is not configured", config.SigningKeyID)
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = config.SigningKeyID
+	return token.SignedString(key.signKey)
 }
 
 // ValidateToken validates a JWT token and returns the claims
@@ -67,11 +245,21 @@ func ValidateToken(c context.Context, tokenString string) (*JWTClaims, error) {
 	config := DefaultJWTConfig()
 
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Verify the signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		// Tokens issued before key IDs existed were signed with what is now the signing key
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			kid = config.SigningKeyID
+		}
+		key, ok := config.Keys[kid]
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		// The token must use the key's own algorithm, so a public key can't be passed off as an HMAC secret
+		if token.Method.Alg() != key.Method.Alg() {
 			return nil, ErrInvalidToken
 		}
-		return []byte(config.SecretKey), nil
+		return key.verifyKey, nil
 	})
 
 	if err != nil {
@@ -89,3 +277,56 @@ func ValidateToken(c context.Context, tokenString string) (*JWTClaims, error) {
 
 	return claims, nil
 }
+
+// JSONWebKey is a public key in JWK format (RFC 7517)
+type JSONWebKey struct {
+	Kty string `json:"kty"`           // RSA or OKP
+	Kid string `json:"kid"`           // Matches the kid header of tokens signed with the key
+	Use string `json:"use"`           // Always "sig"
+	Alg string `json:"alg"`           // RS256 or EdDSA
+	N   string `json:"n,omitempty"`   // RSA modulus
+	E   string `json:"e,omitempty"`   // RSA public exponent
+	Crv string `json:"crv,omitempty"` // Ed25519
+	X   string `json:"x,omitempty"`   // Ed25519 public key
+}
+
+// JSONWebKeySet is the body of a JWKS endpoint
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// PublicJWKS returns the public halves of the configured asymmetric keys, sorted by key ID.
+// HMAC secrets are never included.
+func (c *JWTConfig) PublicJWKS() JSONWebKeySet {
+	kids := make([]string, 0, len(c.Keys))
+	for kid := range c.Keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	set := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, kid := range kids {
+		key := c.Keys[kid]
+		switch publicKey := key.PublicKey().(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, JSONWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				Alg: key.Method.Alg(),
+				N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			set.Keys = append(set.Keys, JSONWebKey{
+				Kty: "OKP",
+				Kid: kid,
+				Use: "sig",
+				Alg: key.Method.Alg(),
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(publicKey),
+			})
+		}
+	}
+	return set
+}
@@ -0,0 +1,148 @@
+package util
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testOldSecret = "old-secret-old-secret-old-secret-0"
+	testNewSecret = "new-secret-new-secret-new-secret-0"
+)
+
+func TestParseJWTConfig(t *testing.T) {
+	t.Run("single key signs by default", func(t *testing.T) {
+		config, err := ParseJWTConfig(`{"2026-10":"`+testNewSecret+`"}`, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "2026-10", config.SigningKeyID)
+	})
+
+	t.Run("several keys need a signing key ID", func(t *testing.T) {
+		_, err := ParseJWTConfig(`{"2026-04":"`+testOldSecret+`","2026-10":"`+testNewSecret+`"}`, "", "")
+		assert.Error(t, err)
+
+		_, err = ParseJWTConfig(`{"2026-04":"`+testOldSecret+`","2026-10":"`+testNewSecret+`"}`, "", "2027-01")
+		assert.Error(t, err)
+	})
+
+	t.Run("short secrets are rejected", func(t *testing.T) {
+		_, err := ParseJWTConfig(`{"2026-10":"too-short"}`, "", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("missing keys", func(t *testing.T) {
+		_, err := ParseJWTConfig("", "", "")
+		assert.ErrorIs(t, err, ErrNoJWTKeys)
+	})
+}
+
+func TestValidateTokenKeyRotation(t *testing.T) {
+	previous := DefaultJWTConfig()
+	t.Cleanup(func() { SetJWTConfig(previous) })
+
+	oldConfig, err := ParseJWTConfig(`{"2026-04":"`+testOldSecret+`"}`, "", "")
+	require.NoError(t, err)
+	oldToken, err := GenerateToken("user-1", "sam@example.com", "Sam", "Lee", "primary", 0, oldConfig)
+	require.NoError(t, err)
+
+	// Rotated: new tokens use the new key, the old key is still accepted
+	rotated, err := ParseJWTConfig(`{"2026-04":"`+testOldSecret+`","2026-10":"`+testNewSecret+`"}`, "", "2026-10")
+	require.NoError(t, err)
+	SetJWTConfig(rotated)
+
+	claims, err := ValidateToken(context.Background(), oldToken)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+
+	newToken, err := GenerateToken("user-1", "sam@example.com", "Sam", "Lee", "primary", 0, nil)
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &JWTClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", parsed.Header["kid"])
+
+	// Old key retired
+	retired, err := ParseJWTConfig(`{"2026-10":"`+testNewSecret+`"}`, "", "")
+	require.NoError(t, err)
+	SetJWTConfig(retired)
+
+	_, err = ValidateToken(context.Background(), oldToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = ValidateToken(context.Background(), newToken)
+	assert.NoError(t, err)
+}
+
+func testPrivateKeyPEM(t *testing.T, key any) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestAsymmetricJWTKeys(t *testing.T) {
+	previous := DefaultJWTConfig()
+	t.Cleanup(func() { SetJWTConfig(previous) })
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	rawPrivateKeys, err := json.Marshal(map[string]string{
+		"rsa-1": testPrivateKeyPEM(t, rsaKey),
+		"ed-1":  testPrivateKeyPEM(t, edKey),
+	})
+	require.NoError(t, err)
+
+	for _, kid := range []string{"rsa-1", "ed-1"} {
+		t.Run("signs and validates with "+kid, func(t *testing.T) {
+			config, err := ParseJWTConfig(`{"hmac-1":"`+testNewSecret+`"}`, string(rawPrivateKeys), kid)
+			require.NoError(t, err)
+			SetJWTConfig(config)
+
+			token, err := GenerateToken("user-1", "sam@example.com", "Sam", "Lee", "primary", 0, nil)
+			require.NoError(t, err)
+			claims, err := ValidateToken(context.Background(), token)
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", claims.UserID)
+		})
+	}
+
+	t.Run("JWKS lists only public keys", func(t *testing.T) {
+		config, err := ParseJWTConfig(`{"hmac-1":"`+testNewSecret+`"}`, string(rawPrivateKeys), "rsa-1")
+		require.NoError(t, err)
+
+		jwks := config.PublicJWKS()
+		require.Len(t, jwks.Keys, 2)
+		assert.Equal(t, "ed-1", jwks.Keys[0].Kid)
+		assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+		assert.Equal(t, "rsa-1", jwks.Keys[1].Kid)
+		assert.Equal(t, "RS256", jwks.Keys[1].Alg)
+		assert.Equal(t, "AQAB", jwks.Keys[1].E)
+	})
+
+	t.Run("rejects a token whose algorithm doesn't match the key", func(t *testing.T) {
+		config, err := ParseJWTConfig("", string(rawPrivateKeys), "rsa-1")
+		require.NoError(t, err)
+		SetJWTConfig(config)
+
+		// HS256 signed with the RSA public key as the secret, claiming the RSA key ID
+		publicDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+		require.NoError(t, err)
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, JWTClaims{UserID: "attacker"})
+		forged.Header["kid"] = "rsa-1"
+		forgedString, err := forged.SignedString(publicDER)
+		require.NoError(t, err)
+
+		_, err = ValidateToken(context.Background(), forgedString)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+)
+
+const (
+	// OTPDigits is the number of digits in a one-time verification code
+	OTPDigits = 6
+)
+
+// GenerateOTP generates a random numeric one-time code
+// Returns the code (to send to the user) and its SHA-256 hash (to store in database)
+func GenerateOTP() (code string, codeHash string, err error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(OTPDigits), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate random code: %w", err)
+	}
+
+	code = fmt.Sprintf("%0*d", OTPDigits, n.Int64())
+	return code, HashOTP(code), nil
+}
+
+// HashOTP hashes a one-time code using SHA-256
+func HashOTP(code string) string {
+	hash := sha256.Sum256([]byte(code))
+	return base64.URLEncoding.EncodeToString(hash[:])
+}
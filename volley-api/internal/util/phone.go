@@ -0,0 +1,51 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultCallingCode is the country calling code assumed for numbers entered without one
+const DefaultCallingCode = "1"
+
+var (
+	e164Pattern        = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+	callingCodePattern = regexp.MustCompile(`^[1-9]\d{0,2}$`)
+	phoneSeparators    = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "\t", "")
+)
+
+// NormalizePhoneNumber converts a user-entered phone number to E.164 format (e.g. +14155552671).
+// Numbers with a "+" or "00" international prefix keep their own country code; anything else is
+// treated as a national number for callingCode, with a leading trunk "0" removed.
+func NormalizePhoneNumber(raw string, callingCode string) (string, error) {
+	callingCode = strings.TrimPrefix(strings.TrimSpace(callingCode), "+")
+	if callingCode == "" {
+		callingCode = DefaultCallingCode
+	}
+	if !callingCodePattern.MatchString(callingCode) {
+		return "", fmt.Errorf("invalid country calling code %q", callingCode)
+	}
+
+	number := phoneSeparators.Replace(strings.TrimSpace(raw))
+	switch {
+	case strings.HasPrefix(number, "+"):
+		// Already international
+	case strings.HasPrefix(number, "00"):
+		number = "+" + number[2:]
+	case callingCode == "1" && len(number) == 11 && strings.HasPrefix(number, "1"):
+		// North American numbers are often written with the leading 1 but no "+"
+		number = "+" + number
+	default:
+		number = "+" + callingCode + strings.TrimPrefix(number, "0")
+	}
+
+	if !e164Pattern.MatchString(number) {
+		return "", fmt.Errorf("%q is not a valid phone number", raw)
+	}
+	// North American numbers always have a 10 digit national number
+	if strings.HasPrefix(number, "+1") && len(number) != 12 {
+		return "", fmt.Errorf("%q is not a valid phone number", raw)
+	}
+	return number, nil
+}
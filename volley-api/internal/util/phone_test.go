@@ -0,0 +1,40 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		callingCode string
+		want        string
+		wantErr     bool
+	}{
+		{name: "US national number", raw: "(415) 555-2671", want: "+14155552671"},
+		{name: "US number with leading 1", raw: "1-415-555-2671", want: "+14155552671"},
+		{name: "already E.164", raw: "+44 20 7946 0958", callingCode: "1", want: "+442079460958"},
+		{name: "00 international prefix", raw: "0044 20 7946 0958", want: "+442079460958"},
+		{name: "UK national number drops trunk zero", raw: "020 7946 0958", callingCode: "+44", want: "+442079460958"},
+		{name: "too short", raw: "555-2671", wantErr: true},
+		{name: "letters", raw: "415-CALL-NOW", wantErr: true},
+		{name: "too long", raw: "+1234567890123456", wantErr: true},
+		{name: "invalid calling code", raw: "4155552671", callingCode: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizePhoneNumber(tt.raw, tt.callingCode)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// LinkTokenLength is the number of random bytes in a token sent inside an emailed link
+const LinkTokenLength = 32
+
+// GenerateLinkToken generates a single-use token for an emailed link (email confirmation, sign-in links)
+// Returns the token (to put in the link) and its SHA-256 hash (to store in database)
+func GenerateLinkToken() (token string, tokenHash string, err error) {
+	bytes := make([]byte, LinkTokenLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	// RawURLEncoding keeps the token free of padding so it can go in a query string unescaped
+	token = base64.RawURLEncoding.EncodeToString(bytes)
+	return token, HashLinkToken(token), nil
+}
+
+// HashLinkToken hashes a link token using SHA-256 for database lookup
+func HashLinkToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return base64.URLEncoding.EncodeToString(hash[:])
+}
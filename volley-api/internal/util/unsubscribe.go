@@ -0,0 +1,71 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe token")
+
+// developmentUnsubscribeSecret signs unsubscribe links when no secret is configured outside release mode
+const developmentUnsubscribeSecret = "volley-development-unsubscribe-secret"
+
+var (
+	unsubscribeSecretMu     sync.RWMutex
+	activeUnsubscribeSecret = []byte(developmentUnsubscribeSecret)
+)
+
+// SetUnsubscribeSecret replaces the secret unsubscribe tokens are signed with. Changing it breaks
+// every link already sent, so it should only be rotated when it leaks.
+func SetUnsubscribeSecret(secret string) error {
+	if len(secret) < minJWTSecretLength {
+		return fmt.Errorf("unsubscribe secret must be at least %d characters", minJWTSecretLength)
+	}
+	unsubscribeSecretMu.Lock()
+	defer unsubscribeSecretMu.Unlock()
+	activeUnsubscribeSecret = []byte(secret)
+	return nil
+}
+
+// SignUnsubscribeToken returns a token for an unsubscribe link that turns off the channel (e.g.
+// "email") for the user. Tokens don't expire, since a link has to keep working for as long as the
+// email sits in an inbox.
+func SignUnsubscribeToken(userID string, channel string) string {
+	payload := userID + ":" + channel
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(unsubscribeSignature(payload))
+}
+
+// ParseUnsubscribeToken checks a token's signature and returns the user and channel it was signed for
+func ParseUnsubscribeToken(token string) (userID string, channel string, err error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", "", ErrInvalidUnsubscribeToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return "", "", ErrInvalidUnsubscribeToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, unsubscribeSignature(string(payload))) {
+		return "", "", ErrInvalidUnsubscribeToken
+	}
+
+	userID, channel, ok = strings.Cut(string(payload), ":")
+	if !ok || userID == "" || channel == "" {
+		return "", "", ErrInvalidUnsubscribeToken
+	}
+	return userID, channel, nil
+}
+
+func unsubscribeSignature(payload string) []byte {
+	unsubscribeSecretMu.RLock()
+	defer unsubscribeSecretMu.RUnlock()
+	mac := hmac.New(sha256.New, activeUnsubscribeSecret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
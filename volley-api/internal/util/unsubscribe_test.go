@@ -0,0 +1,45 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsubscribeToken(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174001"
+
+	t.Run("signed tokens parse back to the user and channel", func(t *testing.T) {
+		gotUserID, channel, err := ParseUnsubscribeToken(SignUnsubscribeToken(userID, "email"))
+		require.NoError(t, err)
+		assert.Equal(t, userID, gotUserID)
+		assert.Equal(t, "email", channel)
+	})
+
+	t.Run("tampered tokens are rejected", func(t *testing.T) {
+		token := SignUnsubscribeToken(userID, "email")
+		other := SignUnsubscribeToken("123e4567-e89b-12d3-a456-426614174002", "email")
+		payload, _, _ := strings.Cut(token, ".")
+		_, signature, _ := strings.Cut(other, ".")
+
+		for _, bad := range []string{"", "garbage", payload + "." + signature, token + "x"} {
+			_, _, err := ParseUnsubscribeToken(bad)
+			assert.ErrorIs(t, err, ErrInvalidUnsubscribeToken, bad)
+		}
+	})
+
+	t.Run("tokens signed with another secret are rejected", func(t *testing.T) {
+		token := SignUnsubscribeToken(userID, "email")
+		require.NoError(t, SetUnsubscribeSecret(testNewSecret))
+		defer func() { activeUnsubscribeSecret = []byte(developmentUnsubscribeSecret) }()
+
+		_, _, err := ParseUnsubscribeToken(token)
+		assert.ErrorIs(t, err, ErrInvalidUnsubscribeToken)
+	})
+
+	t.Run("short secrets are rejected", func(t *testing.T) {
+		assert.Error(t, SetUnsubscribeSecret("too-short"))
+	})
+}
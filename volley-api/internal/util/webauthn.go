@@ -0,0 +1,143 @@
+package util
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Authenticator data flags (WebAuthn §6.1)
+const (
+	WebAuthnFlagUserPresent  = 0x01
+	WebAuthnFlagUserVerified = 0x04
+	WebAuthnFlagAttestedData = 0x40
+)
+
+// WebAuthn ceremony types as reported in clientDataJSON
+const (
+	WebAuthnCeremonyCreate = "webauthn.create"
+	WebAuthnCeremonyGet    = "webauthn.get"
+)
+
+var ErrInvalidWebAuthnResponse = errors.New("invalid WebAuthn response")
+
+// WebAuthnClientData is the subset of clientDataJSON the server checks
+type WebAuthnClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"` // base64url without padding
+	Origin    string `json:"origin"`
+}
+
+// ParseWebAuthnClientData decodes clientDataJSON and checks its ceremony type and origin.
+// The challenge is returned for the caller to match against one it issued.
+func ParseWebAuthnClientData(raw []byte, ceremony string, origins []string) (*WebAuthnClientData, error) {
+	var clientData WebAuthnClientData
+	if err := json.Unmarshal(raw, &clientData); err != nil {
+		return nil, fmt.Errorf("%w: malformed clientDataJSON", ErrInvalidWebAuthnResponse)
+	}
+	if clientData.Type != ceremony {
+		return nil, fmt.Errorf("%w: expected %s ceremony", ErrInvalidWebAuthnResponse, ceremony)
+	}
+	if !slices.Contains(origins, clientData.Origin) {
+		return nil, fmt.Errorf("%w: origin %q is not allowed", ErrInvalidWebAuthnResponse, clientData.Origin)
+	}
+	return &clientData, nil
+}
+
+// WebAuthnAuthenticatorData is the parsed authenticatorData structure
+type WebAuthnAuthenticatorData struct {
+	RPIDHash     []byte
+	Flags        byte
+	SignCount    uint32
+	CredentialID []byte // Only present during registration (attested credential data)
+}
+
+// ParseWebAuthnAuthenticatorData parses authenticatorData and checks that it was produced for rpID
+// with the user present. The credential public key that follows the credential ID is not decoded;
+// browsers hand it to us already converted to DER by getPublicKey().
+func ParseWebAuthnAuthenticatorData(raw []byte, rpID string) (*WebAuthnAuthenticatorData, error) {
+	// rpIdHash (32) + flags (1) + signCount (4)
+	if len(raw) < 37 {
+		return nil, fmt.Errorf("%w: authenticator data too short", ErrInvalidWebAuthnResponse)
+	}
+	authData := &WebAuthnAuthenticatorData{
+		RPIDHash:  raw[:32],
+		Flags:     raw[32],
+		SignCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	if subtle.ConstantTimeCompare(authData.RPIDHash, rpIDHash[:]) != 1 {
+		return nil, fmt.Errorf("%w: relying party ID mismatch", ErrInvalidWebAuthnResponse)
+	}
+	if authData.Flags&WebAuthnFlagUserPresent == 0 {
+		return nil, fmt.Errorf("%w: user not present", ErrInvalidWebAuthnResponse)
+	}
+
+	if authData.Flags&WebAuthnFlagAttestedData != 0 {
+		// aaguid (16) + credentialIdLength (2) + credentialId
+		rest := raw[37:]
+		if len(rest) < 18 {
+			return nil, fmt.Errorf("%w: attested credential data too short", ErrInvalidWebAuthnResponse)
+		}
+		idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+		if len(rest) < 18+idLength {
+			return nil, fmt.Errorf("%w: credential ID truncated", ErrInvalidWebAuthnResponse)
+		}
+		authData.CredentialID = rest[18 : 18+idLength]
+	}
+
+	return authData, nil
+}
+
+// VerifyWebAuthnSignature checks an assertion signature over authenticatorData || SHA-256(clientDataJSON)
+// using a DER-encoded (SubjectPublicKeyInfo) credential public key. ES256, RS256 and EdDSA keys are supported.
+func VerifyWebAuthnSignature(publicKeyDER []byte, authenticatorData []byte, clientDataJSON []byte, signature []byte) error {
+	publicKey, err := x509.ParsePKIXPublicKey(publicKeyDER)
+	if err != nil {
+		return fmt.Errorf("%w: unreadable public key", ErrInvalidWebAuthnResponse)
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(slices.Clone(authenticatorData), clientDataHash[:]...)
+	digest := sha256.Sum256(signed)
+
+	var valid bool
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, digest[:], signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, signed, signature)
+	default:
+		return fmt.Errorf("%w: unsupported key type", ErrInvalidWebAuthnResponse)
+	}
+	if !valid {
+		return fmt.Errorf("%w: signature mismatch", ErrInvalidWebAuthnResponse)
+	}
+	return nil
+}
+
+// ValidateWebAuthnPublicKey checks that a DER-encoded public key is a type VerifyWebAuthnSignature can use
+func ValidateWebAuthnPublicKey(publicKeyDER []byte) error {
+	publicKey, err := x509.ParsePKIXPublicKey(publicKeyDER)
+	if err != nil {
+		return fmt.Errorf("%w: unreadable public key", ErrInvalidWebAuthnResponse)
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported key type", ErrInvalidWebAuthnResponse)
+	}
+}
@@ -0,0 +1,84 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAuthenticatorData(rpID string, flags byte, signCount uint32, credentialID []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, signCount)
+	if credentialID != nil {
+		data = append(data, make([]byte, 16)...) // aaguid
+		data = binary.BigEndian.AppendUint16(data, uint16(len(credentialID)))
+		data = append(data, credentialID...)
+	}
+	return data
+}
+
+func TestParseWebAuthnClientData(t *testing.T) {
+	raw := []byte(`{"type":"webauthn.get","challenge":"abc","origin":"https://app.volley.gg"}`)
+
+	clientData, err := ParseWebAuthnClientData(raw, WebAuthnCeremonyGet, []string{"https://app.volley.gg"})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", clientData.Challenge)
+
+	_, err = ParseWebAuthnClientData(raw, WebAuthnCeremonyCreate, []string{"https://app.volley.gg"})
+	assert.ErrorIs(t, err, ErrInvalidWebAuthnResponse)
+
+	_, err = ParseWebAuthnClientData(raw, WebAuthnCeremonyGet, []string{"https://evil.example"})
+	assert.ErrorIs(t, err, ErrInvalidWebAuthnResponse)
+}
+
+func TestParseWebAuthnAuthenticatorData(t *testing.T) {
+	t.Run("registration includes the credential ID", func(t *testing.T) {
+		raw := testAuthenticatorData("volley.gg", WebAuthnFlagUserPresent|WebAuthnFlagAttestedData, 0, []byte{1, 2, 3})
+
+		authData, err := ParseWebAuthnAuthenticatorData(raw, "volley.gg")
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, authData.CredentialID)
+	})
+
+	t.Run("wrong relying party", func(t *testing.T) {
+		raw := testAuthenticatorData("evil.example", WebAuthnFlagUserPresent, 1, nil)
+
+		_, err := ParseWebAuthnAuthenticatorData(raw, "volley.gg")
+		assert.ErrorIs(t, err, ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("user not present", func(t *testing.T) {
+		raw := testAuthenticatorData("volley.gg", 0, 1, nil)
+
+		_, err := ParseWebAuthnAuthenticatorData(raw, "volley.gg")
+		assert.ErrorIs(t, err, ErrInvalidWebAuthnResponse)
+	})
+}
+
+func TestVerifyWebAuthnSignature(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	authData := testAuthenticatorData("volley.gg", WebAuthnFlagUserPresent, 7, nil)
+	clientDataJSON := []byte(`{"type":"webauthn.get","challenge":"abc","origin":"https://app.volley.gg"}`)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+	require.NoError(t, err)
+
+	assert.NoError(t, VerifyWebAuthnSignature(publicKeyDER, authData, clientDataJSON, signature))
+
+	tampered := []byte(`{"type":"webauthn.get","challenge":"xyz","origin":"https://app.volley.gg"}`)
+	assert.ErrorIs(t, VerifyWebAuthnSignature(publicKeyDER, authData, tampered, signature), ErrInvalidWebAuthnResponse)
+}
@@ -0,0 +1,49 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookSignatureHeader carries the signature of a webhook delivery
+const WebhookSignatureHeader = "X-Volley-Signature"
+
+// SignWebhook signs a webhook body for the X-Volley-Signature header as "t=<unix seconds>,v1=<hex>",
+// where v1 is the HMAC-SHA256 of "<unix seconds>.<body>" keyed with the subscription's secret.
+// Signing the timestamp lets receivers reject old deliveries that are replayed.
+func SignWebhook(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", unix, webhookMAC(secret, unix, body))
+}
+
+// VerifyWebhook checks a X-Volley-Signature header against the body, the way receivers are
+// documented to
+func VerifyWebhook(secret string, header string, body []byte) bool {
+	var unix, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			unix = value
+		case "v1":
+			signature = value
+		}
+	}
+	if unix == "" || signature == "" {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(webhookMAC(secret, unix, body)))
+}
+
+func webhookMAC(secret string, unix string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
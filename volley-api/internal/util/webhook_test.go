@@ -0,0 +1,27 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSignature(t *testing.T) {
+	body := []byte(`{"type":"game.created"}`)
+	header := SignWebhook("whsec_test", time.Unix(1700000000, 0), body)
+
+	t.Run("signatures verify with the same secret and body", func(t *testing.T) {
+		assert.Regexp(t, `^t=1700000000,v1=[0-9a-f]{64}$`, header)
+		assert.True(t, VerifyWebhook("whsec_test", header, body))
+	})
+
+	t.Run("another secret, body or timestamp fails verification", func(t *testing.T) {
+		assert.False(t, VerifyWebhook("whsec_other", header, body))
+		assert.False(t, VerifyWebhook("whsec_test", header, []byte(`{"type":"game.cancelled"}`)))
+
+		replayed := "t=1700000001" + header[len("t=1700000000"):]
+		assert.False(t, VerifyWebhook("whsec_test", replayed, body))
+		assert.False(t, VerifyWebhook("whsec_test", "", body))
+	})
+}
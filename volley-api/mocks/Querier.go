@@ -39,6 +39,63 @@ func (_m *Querier) EXPECT() *Querier_Expecter {
 	return &Querier_Expecter{mock: &_m.Mock}
 }
 
+// AddGameTags provides a mock function for the type Querier
+func (_mock *Querier) AddGameTags(ctx context.Context, arg repository.AddGameTagsParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGameTags")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.AddGameTagsParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_AddGameTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGameTags'
+type Querier_AddGameTags_Call struct {
+	*mock.Call
+}
+
+// AddGameTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.AddGameTagsParams
+func (_e *Querier_Expecter) AddGameTags(ctx interface{}, arg interface{}) *Querier_AddGameTags_Call {
+	return &Querier_AddGameTags_Call{Call: _e.mock.On("AddGameTags", ctx, arg)}
+}
+
+func (_c *Querier_AddGameTags_Call) Run(run func(ctx context.Context, arg repository.AddGameTagsParams)) *Querier_AddGameTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.AddGameTagsParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.AddGameTagsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_AddGameTags_Call) Return(err error) *Querier_AddGameTags_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_AddGameTags_Call) RunAndReturn(run func(ctx context.Context, arg repository.AddGameTagsParams) error) *Querier_AddGameTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BatchUpdateParticipantsToConfirmed provides a mock function for the type Querier
 func (_mock *Querier) BatchUpdateParticipantsToConfirmed(ctx context.Context, participantIds []pgtype.UUID) error {
 	ret := _mock.Called(ctx, participantIds)
@@ -153,6 +210,63 @@ func (_c *Querier_BatchUpdateParticipantsToWaitlist_Call) RunAndReturn(run func(
 	return _c
 }
 
+// BlockPlayer provides a mock function for the type Querier
+func (_mock *Querier) BlockPlayer(ctx context.Context, arg repository.BlockPlayerParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockPlayer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.BlockPlayerParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_BlockPlayer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockPlayer'
+type Querier_BlockPlayer_Call struct {
+	*mock.Call
+}
+
+// BlockPlayer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.BlockPlayerParams
+func (_e *Querier_Expecter) BlockPlayer(ctx interface{}, arg interface{}) *Querier_BlockPlayer_Call {
+	return &Querier_BlockPlayer_Call{Call: _e.mock.On("BlockPlayer", ctx, arg)}
+}
+
+func (_c *Querier_BlockPlayer_Call) Run(run func(ctx context.Context, arg repository.BlockPlayerParams)) *Querier_BlockPlayer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.BlockPlayerParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.BlockPlayerParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_BlockPlayer_Call) Return(err error) *Querier_BlockPlayer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_BlockPlayer_Call) RunAndReturn(run func(ctx context.Context, arg repository.BlockPlayerParams) error) *Querier_BlockPlayer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelGame provides a mock function for the type Querier
 func (_mock *Querier) CancelGame(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error) {
 	ret := _mock.Called(ctx, id)
@@ -219,45 +333,36 @@ func (_c *Querier_CancelGame_Call) RunAndReturn(run func(ctx context.Context, id
 	return _c
 }
 
-// CountConfirmedParticipants provides a mock function for the type Querier
-func (_mock *Querier) CountConfirmedParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error) {
-	ret := _mock.Called(ctx, gameID)
+// CancelPendingEmailChangeRequests provides a mock function for the type Querier
+func (_mock *Querier) CancelPendingEmailChangeRequests(ctx context.Context, userID pgtype.UUID) error {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountConfirmedParticipants")
+		panic("no return value specified for CancelPendingEmailChangeRequests")
 	}
 
-	var r0 int64
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
-		return returnFunc(ctx, gameID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
-		r0 = returnFunc(ctx, gameID)
-	} else {
-		r0 = ret.Get(0).(int64)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, gameID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_CountConfirmedParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountConfirmedParticipants'
-type Querier_CountConfirmedParticipants_Call struct {
+// Querier_CancelPendingEmailChangeRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelPendingEmailChangeRequests'
+type Querier_CancelPendingEmailChangeRequests_Call struct {
 	*mock.Call
 }
 
-// CountConfirmedParticipants is a helper method to define mock.On call
+// CancelPendingEmailChangeRequests is a helper method to define mock.On call
 //   - ctx context.Context
-//   - gameID pgtype.UUID
-func (_e *Querier_Expecter) CountConfirmedParticipants(ctx interface{}, gameID interface{}) *Querier_CountConfirmedParticipants_Call {
-	return &Querier_CountConfirmedParticipants_Call{Call: _e.mock.On("CountConfirmedParticipants", ctx, gameID)}
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) CancelPendingEmailChangeRequests(ctx interface{}, userID interface{}) *Querier_CancelPendingEmailChangeRequests_Call {
+	return &Querier_CancelPendingEmailChangeRequests_Call{Call: _e.mock.On("CancelPendingEmailChangeRequests", ctx, userID)}
 }
 
-func (_c *Querier_CountConfirmedParticipants_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_CountConfirmedParticipants_Call {
+func (_c *Querier_CancelPendingEmailChangeRequests_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_CancelPendingEmailChangeRequests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -275,55 +380,55 @@ func (_c *Querier_CountConfirmedParticipants_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *Querier_CountConfirmedParticipants_Call) Return(n int64, err error) *Querier_CountConfirmedParticipants_Call {
-	_c.Call.Return(n, err)
+func (_c *Querier_CancelPendingEmailChangeRequests_Call) Return(err error) *Querier_CancelPendingEmailChangeRequests_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_CountConfirmedParticipants_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (int64, error)) *Querier_CountConfirmedParticipants_Call {
+func (_c *Querier_CancelPendingEmailChangeRequests_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) error) *Querier_CancelPendingEmailChangeRequests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountWaitlistParticipants provides a mock function for the type Querier
-func (_mock *Querier) CountWaitlistParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error) {
-	ret := _mock.Called(ctx, gameID)
+// CheckInParticipant provides a mock function for the type Querier
+func (_mock *Querier) CheckInParticipant(ctx context.Context, id pgtype.UUID) (repository.Participant, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountWaitlistParticipants")
+		panic("no return value specified for CheckInParticipant")
 	}
 
-	var r0 int64
+	var r0 repository.Participant
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
-		return returnFunc(ctx, gameID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.Participant, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
-		r0 = returnFunc(ctx, gameID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.Participant); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(repository.Participant)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, gameID)
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_CountWaitlistParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountWaitlistParticipants'
-type Querier_CountWaitlistParticipants_Call struct {
+// Querier_CheckInParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckInParticipant'
+type Querier_CheckInParticipant_Call struct {
 	*mock.Call
 }
 
-// CountWaitlistParticipants is a helper method to define mock.On call
+// CheckInParticipant is a helper method to define mock.On call
 //   - ctx context.Context
-//   - gameID pgtype.UUID
-func (_e *Querier_Expecter) CountWaitlistParticipants(ctx interface{}, gameID interface{}) *Querier_CountWaitlistParticipants_Call {
-	return &Querier_CountWaitlistParticipants_Call{Call: _e.mock.On("CountWaitlistParticipants", ctx, gameID)}
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) CheckInParticipant(ctx interface{}, id interface{}) *Querier_CheckInParticipant_Call {
+	return &Querier_CheckInParticipant_Call{Call: _e.mock.On("CheckInParticipant", ctx, id)}
 }
 
-func (_c *Querier_CountWaitlistParticipants_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_CountWaitlistParticipants_Call {
+func (_c *Querier_CheckInParticipant_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_CheckInParticipant_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -341,35 +446,37 @@ func (_c *Querier_CountWaitlistParticipants_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *Querier_CountWaitlistParticipants_Call) Return(n int64, err error) *Querier_CountWaitlistParticipants_Call {
-	_c.Call.Return(n, err)
+func (_c *Querier_CheckInParticipant_Call) Return(participant repository.Participant, err error) *Querier_CheckInParticipant_Call {
+	_c.Call.Return(participant, err)
 	return _c
 }
 
-func (_c *Querier_CountWaitlistParticipants_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (int64, error)) *Querier_CountWaitlistParticipants_Call {
+func (_c *Querier_CheckInParticipant_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.Participant, error)) *Querier_CheckInParticipant_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateGame provides a mock function for the type Querier
-func (_mock *Querier) CreateGame(ctx context.Context, arg repository.CreateGameParams) (repository.CreateGameRow, error) {
+// ClaimDueBrokerEvents provides a mock function for the type Querier
+func (_mock *Querier) ClaimDueBrokerEvents(ctx context.Context, arg repository.ClaimDueBrokerEventsParams) ([]repository.BrokerEvent, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateGame")
+		panic("no return value specified for ClaimDueBrokerEvents")
 	}
 
-	var r0 repository.CreateGameRow
+	var r0 []repository.BrokerEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameParams) (repository.CreateGameRow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimDueBrokerEventsParams) ([]repository.BrokerEvent, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameParams) repository.CreateGameRow); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimDueBrokerEventsParams) []repository.BrokerEvent); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.CreateGameRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.BrokerEvent)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateGameParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ClaimDueBrokerEventsParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -377,27 +484,27 @@ func (_mock *Querier) CreateGame(ctx context.Context, arg repository.CreateGameP
 	return r0, r1
 }
 
-// Querier_CreateGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGame'
-type Querier_CreateGame_Call struct {
+// Querier_ClaimDueBrokerEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueBrokerEvents'
+type Querier_ClaimDueBrokerEvents_Call struct {
 	*mock.Call
 }
 
-// CreateGame is a helper method to define mock.On call
+// ClaimDueBrokerEvents is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateGameParams
-func (_e *Querier_Expecter) CreateGame(ctx interface{}, arg interface{}) *Querier_CreateGame_Call {
-	return &Querier_CreateGame_Call{Call: _e.mock.On("CreateGame", ctx, arg)}
+//   - arg repository.ClaimDueBrokerEventsParams
+func (_e *Querier_Expecter) ClaimDueBrokerEvents(ctx interface{}, arg interface{}) *Querier_ClaimDueBrokerEvents_Call {
+	return &Querier_ClaimDueBrokerEvents_Call{Call: _e.mock.On("ClaimDueBrokerEvents", ctx, arg)}
 }
 
-func (_c *Querier_CreateGame_Call) Run(run func(ctx context.Context, arg repository.CreateGameParams)) *Querier_CreateGame_Call {
+func (_c *Querier_ClaimDueBrokerEvents_Call) Run(run func(ctx context.Context, arg repository.ClaimDueBrokerEventsParams)) *Querier_ClaimDueBrokerEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.CreateGameParams
+		var arg1 repository.ClaimDueBrokerEventsParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.CreateGameParams)
+			arg1 = args[1].(repository.ClaimDueBrokerEventsParams)
 		}
 		run(
 			arg0,
@@ -407,35 +514,37 @@ func (_c *Querier_CreateGame_Call) Run(run func(ctx context.Context, arg reposit
 	return _c
 }
 
-func (_c *Querier_CreateGame_Call) Return(createGameRow repository.CreateGameRow, err error) *Querier_CreateGame_Call {
-	_c.Call.Return(createGameRow, err)
+func (_c *Querier_ClaimDueBrokerEvents_Call) Return(brokerEvents []repository.BrokerEvent, err error) *Querier_ClaimDueBrokerEvents_Call {
+	_c.Call.Return(brokerEvents, err)
 	return _c
 }
 
-func (_c *Querier_CreateGame_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateGameParams) (repository.CreateGameRow, error)) *Querier_CreateGame_Call {
+func (_c *Querier_ClaimDueBrokerEvents_Call) RunAndReturn(run func(ctx context.Context, arg repository.ClaimDueBrokerEventsParams) ([]repository.BrokerEvent, error)) *Querier_ClaimDueBrokerEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateParticipant provides a mock function for the type Querier
-func (_mock *Querier) CreateParticipant(ctx context.Context, arg repository.CreateParticipantParams) (repository.Participant, error) {
+// ClaimDueNotificationDeliveries provides a mock function for the type Querier
+func (_mock *Querier) ClaimDueNotificationDeliveries(ctx context.Context, arg repository.ClaimDueNotificationDeliveriesParams) ([]repository.NotificationDelivery, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateParticipant")
+		panic("no return value specified for ClaimDueNotificationDeliveries")
 	}
 
-	var r0 repository.Participant
+	var r0 []repository.NotificationDelivery
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateParticipantParams) (repository.Participant, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimDueNotificationDeliveriesParams) ([]repository.NotificationDelivery, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateParticipantParams) repository.Participant); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimDueNotificationDeliveriesParams) []repository.NotificationDelivery); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Participant)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.NotificationDelivery)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateParticipantParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ClaimDueNotificationDeliveriesParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -443,27 +552,27 @@ func (_mock *Querier) CreateParticipant(ctx context.Context, arg repository.Crea
 	return r0, r1
 }
 
-// Querier_CreateParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateParticipant'
-type Querier_CreateParticipant_Call struct {
+// Querier_ClaimDueNotificationDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueNotificationDeliveries'
+type Querier_ClaimDueNotificationDeliveries_Call struct {
 	*mock.Call
 }
 
-// CreateParticipant is a helper method to define mock.On call
+// ClaimDueNotificationDeliveries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateParticipantParams
-func (_e *Querier_Expecter) CreateParticipant(ctx interface{}, arg interface{}) *Querier_CreateParticipant_Call {
-	return &Querier_CreateParticipant_Call{Call: _e.mock.On("CreateParticipant", ctx, arg)}
+//   - arg repository.ClaimDueNotificationDeliveriesParams
+func (_e *Querier_Expecter) ClaimDueNotificationDeliveries(ctx interface{}, arg interface{}) *Querier_ClaimDueNotificationDeliveries_Call {
+	return &Querier_ClaimDueNotificationDeliveries_Call{Call: _e.mock.On("ClaimDueNotificationDeliveries", ctx, arg)}
 }
 
-func (_c *Querier_CreateParticipant_Call) Run(run func(ctx context.Context, arg repository.CreateParticipantParams)) *Querier_CreateParticipant_Call {
+func (_c *Querier_ClaimDueNotificationDeliveries_Call) Run(run func(ctx context.Context, arg repository.ClaimDueNotificationDeliveriesParams)) *Querier_ClaimDueNotificationDeliveries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.CreateParticipantParams
+		var arg1 repository.ClaimDueNotificationDeliveriesParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.CreateParticipantParams)
+			arg1 = args[1].(repository.ClaimDueNotificationDeliveriesParams)
 		}
 		run(
 			arg0,
@@ -473,35 +582,37 @@ func (_c *Querier_CreateParticipant_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *Querier_CreateParticipant_Call) Return(participant repository.Participant, err error) *Querier_CreateParticipant_Call {
-	_c.Call.Return(participant, err)
+func (_c *Querier_ClaimDueNotificationDeliveries_Call) Return(notificationDeliverys []repository.NotificationDelivery, err error) *Querier_ClaimDueNotificationDeliveries_Call {
+	_c.Call.Return(notificationDeliverys, err)
 	return _c
 }
 
-func (_c *Querier_CreateParticipant_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateParticipantParams) (repository.Participant, error)) *Querier_CreateParticipant_Call {
+func (_c *Querier_ClaimDueNotificationDeliveries_Call) RunAndReturn(run func(ctx context.Context, arg repository.ClaimDueNotificationDeliveriesParams) ([]repository.NotificationDelivery, error)) *Querier_ClaimDueNotificationDeliveries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTeam provides a mock function for the type Querier
-func (_mock *Querier) CreateTeam(ctx context.Context, arg repository.CreateTeamParams) (repository.Team, error) {
+// ClaimDueSideEffects provides a mock function for the type Querier
+func (_mock *Querier) ClaimDueSideEffects(ctx context.Context, arg repository.ClaimDueSideEffectsParams) ([]repository.SideEffect, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTeam")
+		panic("no return value specified for ClaimDueSideEffects")
 	}
 
-	var r0 repository.Team
+	var r0 []repository.SideEffect
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateTeamParams) (repository.Team, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimDueSideEffectsParams) ([]repository.SideEffect, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateTeamParams) repository.Team); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimDueSideEffectsParams) []repository.SideEffect); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Team)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SideEffect)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateTeamParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ClaimDueSideEffectsParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -509,27 +620,27 @@ func (_mock *Querier) CreateTeam(ctx context.Context, arg repository.CreateTeamP
 	return r0, r1
 }
 
-// Querier_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
-type Querier_CreateTeam_Call struct {
+// Querier_ClaimDueSideEffects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueSideEffects'
+type Querier_ClaimDueSideEffects_Call struct {
 	*mock.Call
 }
 
-// CreateTeam is a helper method to define mock.On call
+// ClaimDueSideEffects is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateTeamParams
-func (_e *Querier_Expecter) CreateTeam(ctx interface{}, arg interface{}) *Querier_CreateTeam_Call {
-	return &Querier_CreateTeam_Call{Call: _e.mock.On("CreateTeam", ctx, arg)}
+//   - arg repository.ClaimDueSideEffectsParams
+func (_e *Querier_Expecter) ClaimDueSideEffects(ctx interface{}, arg interface{}) *Querier_ClaimDueSideEffects_Call {
+	return &Querier_ClaimDueSideEffects_Call{Call: _e.mock.On("ClaimDueSideEffects", ctx, arg)}
 }
 
-func (_c *Querier_CreateTeam_Call) Run(run func(ctx context.Context, arg repository.CreateTeamParams)) *Querier_CreateTeam_Call {
+func (_c *Querier_ClaimDueSideEffects_Call) Run(run func(ctx context.Context, arg repository.ClaimDueSideEffectsParams)) *Querier_ClaimDueSideEffects_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.CreateTeamParams
+		var arg1 repository.ClaimDueSideEffectsParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.CreateTeamParams)
+			arg1 = args[1].(repository.ClaimDueSideEffectsParams)
 		}
 		run(
 			arg0,
@@ -539,35 +650,37 @@ func (_c *Querier_CreateTeam_Call) Run(run func(ctx context.Context, arg reposit
 	return _c
 }
 
-func (_c *Querier_CreateTeam_Call) Return(team repository.Team, err error) *Querier_CreateTeam_Call {
-	_c.Call.Return(team, err)
+func (_c *Querier_ClaimDueSideEffects_Call) Return(sideEffects []repository.SideEffect, err error) *Querier_ClaimDueSideEffects_Call {
+	_c.Call.Return(sideEffects, err)
 	return _c
 }
 
-func (_c *Querier_CreateTeam_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateTeamParams) (repository.Team, error)) *Querier_CreateTeam_Call {
+func (_c *Querier_ClaimDueSideEffects_Call) RunAndReturn(run func(ctx context.Context, arg repository.ClaimDueSideEffectsParams) ([]repository.SideEffect, error)) *Querier_ClaimDueSideEffects_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function for the type Querier
-func (_mock *Querier) CreateUser(ctx context.Context, arg repository.CreateUserParams) (repository.User, error) {
+// ClaimDueWebhookDeliveries provides a mock function for the type Querier
+func (_mock *Querier) ClaimDueWebhookDeliveries(ctx context.Context, arg repository.ClaimDueWebhookDeliveriesParams) ([]repository.ClaimDueWebhookDeliveriesRow, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for ClaimDueWebhookDeliveries")
 	}
 
-	var r0 repository.User
+	var r0 []repository.ClaimDueWebhookDeliveriesRow
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateUserParams) (repository.User, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimDueWebhookDeliveriesParams) ([]repository.ClaimDueWebhookDeliveriesRow, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateUserParams) repository.User); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimDueWebhookDeliveriesParams) []repository.ClaimDueWebhookDeliveriesRow); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ClaimDueWebhookDeliveriesRow)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateUserParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ClaimDueWebhookDeliveriesParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -575,27 +688,27 @@ func (_mock *Querier) CreateUser(ctx context.Context, arg repository.CreateUserP
 	return r0, r1
 }
 
-// Querier_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type Querier_CreateUser_Call struct {
+// Querier_ClaimDueWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueWebhookDeliveries'
+type Querier_ClaimDueWebhookDeliveries_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// ClaimDueWebhookDeliveries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.CreateUserParams
-func (_e *Querier_Expecter) CreateUser(ctx interface{}, arg interface{}) *Querier_CreateUser_Call {
-	return &Querier_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//   - arg repository.ClaimDueWebhookDeliveriesParams
+func (_e *Querier_Expecter) ClaimDueWebhookDeliveries(ctx interface{}, arg interface{}) *Querier_ClaimDueWebhookDeliveries_Call {
+	return &Querier_ClaimDueWebhookDeliveries_Call{Call: _e.mock.On("ClaimDueWebhookDeliveries", ctx, arg)}
 }
 
-func (_c *Querier_CreateUser_Call) Run(run func(ctx context.Context, arg repository.CreateUserParams)) *Querier_CreateUser_Call {
+func (_c *Querier_ClaimDueWebhookDeliveries_Call) Run(run func(ctx context.Context, arg repository.ClaimDueWebhookDeliveriesParams)) *Querier_ClaimDueWebhookDeliveries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.CreateUserParams
+		var arg1 repository.ClaimDueWebhookDeliveriesParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.CreateUserParams)
+			arg1 = args[1].(repository.ClaimDueWebhookDeliveriesParams)
 		}
 		run(
 			arg0,
@@ -605,54 +718,63 @@ func (_c *Querier_CreateUser_Call) Run(run func(ctx context.Context, arg reposit
 	return _c
 }
 
-func (_c *Querier_CreateUser_Call) Return(user repository.User, err error) *Querier_CreateUser_Call {
-	_c.Call.Return(user, err)
+func (_c *Querier_ClaimDueWebhookDeliveries_Call) Return(claimDueWebhookDeliveriesRows []repository.ClaimDueWebhookDeliveriesRow, err error) *Querier_ClaimDueWebhookDeliveries_Call {
+	_c.Call.Return(claimDueWebhookDeliveriesRows, err)
 	return _c
 }
 
-func (_c *Querier_CreateUser_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateUserParams) (repository.User, error)) *Querier_CreateUser_Call {
+func (_c *Querier_ClaimDueWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, arg repository.ClaimDueWebhookDeliveriesParams) ([]repository.ClaimDueWebhookDeliveriesRow, error)) *Querier_ClaimDueWebhookDeliveries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteGame provides a mock function for the type Querier
-func (_mock *Querier) DeleteGame(ctx context.Context, id pgtype.UUID) error {
-	ret := _mock.Called(ctx, id)
+// ClaimJobRun provides a mock function for the type Querier
+func (_mock *Querier) ClaimJobRun(ctx context.Context, arg repository.ClaimJobRunParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteGame")
+		panic("no return value specified for ClaimJobRun")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimJobRunParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ClaimJobRunParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
-	return r0
-}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ClaimJobRunParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
 
-// Querier_DeleteGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGame'
-type Querier_DeleteGame_Call struct {
+// Querier_ClaimJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimJobRun'
+type Querier_ClaimJobRun_Call struct {
 	*mock.Call
 }
 
-// DeleteGame is a helper method to define mock.On call
+// ClaimJobRun is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id pgtype.UUID
-func (_e *Querier_Expecter) DeleteGame(ctx interface{}, id interface{}) *Querier_DeleteGame_Call {
-	return &Querier_DeleteGame_Call{Call: _e.mock.On("DeleteGame", ctx, id)}
+//   - arg repository.ClaimJobRunParams
+func (_e *Querier_Expecter) ClaimJobRun(ctx interface{}, arg interface{}) *Querier_ClaimJobRun_Call {
+	return &Querier_ClaimJobRun_Call{Call: _e.mock.On("ClaimJobRun", ctx, arg)}
 }
 
-func (_c *Querier_DeleteGame_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_DeleteGame_Call {
+func (_c *Querier_ClaimJobRun_Call) Run(run func(ctx context.Context, arg repository.ClaimJobRunParams)) *Querier_ClaimJobRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
+		var arg1 repository.ClaimJobRunParams
 		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
+			arg1 = args[1].(repository.ClaimJobRunParams)
 		}
 		run(
 			arg0,
@@ -662,54 +784,54 @@ func (_c *Querier_DeleteGame_Call) Run(run func(ctx context.Context, id pgtype.U
 	return _c
 }
 
-func (_c *Querier_DeleteGame_Call) Return(err error) *Querier_DeleteGame_Call {
-	_c.Call.Return(err)
+func (_c *Querier_ClaimJobRun_Call) Return(n int64, err error) *Querier_ClaimJobRun_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_DeleteGame_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_DeleteGame_Call {
+func (_c *Querier_ClaimJobRun_Call) RunAndReturn(run func(ctx context.Context, arg repository.ClaimJobRunParams) (int64, error)) *Querier_ClaimJobRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteParticipant provides a mock function for the type Querier
-func (_mock *Querier) DeleteParticipant(ctx context.Context, id pgtype.UUID) error {
-	ret := _mock.Called(ctx, id)
+// ClearFailedLoginsByEmail provides a mock function for the type Querier
+func (_mock *Querier) ClearFailedLoginsByEmail(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteParticipant")
+		panic("no return value specified for ClearFailedLoginsByEmail")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Querier_DeleteParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteParticipant'
-type Querier_DeleteParticipant_Call struct {
+// Querier_ClearFailedLoginsByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearFailedLoginsByEmail'
+type Querier_ClearFailedLoginsByEmail_Call struct {
 	*mock.Call
 }
 
-// DeleteParticipant is a helper method to define mock.On call
+// ClearFailedLoginsByEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id pgtype.UUID
-func (_e *Querier_Expecter) DeleteParticipant(ctx interface{}, id interface{}) *Querier_DeleteParticipant_Call {
-	return &Querier_DeleteParticipant_Call{Call: _e.mock.On("DeleteParticipant", ctx, id)}
+//   - email string
+func (_e *Querier_Expecter) ClearFailedLoginsByEmail(ctx interface{}, email interface{}) *Querier_ClearFailedLoginsByEmail_Call {
+	return &Querier_ClearFailedLoginsByEmail_Call{Call: _e.mock.On("ClearFailedLoginsByEmail", ctx, email)}
 }
 
-func (_c *Querier_DeleteParticipant_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_DeleteParticipant_Call {
+func (_c *Querier_ClearFailedLoginsByEmail_Call) Run(run func(ctx context.Context, email string)) *Querier_ClearFailedLoginsByEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -719,79 +841,82 @@ func (_c *Querier_DeleteParticipant_Call) Run(run func(ctx context.Context, id p
 	return _c
 }
 
-func (_c *Querier_DeleteParticipant_Call) Return(err error) *Querier_DeleteParticipant_Call {
+func (_c *Querier_ClearFailedLoginsByEmail_Call) Return(err error) *Querier_ClearFailedLoginsByEmail_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_DeleteParticipant_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_DeleteParticipant_Call {
+func (_c *Querier_ClearFailedLoginsByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) error) *Querier_ClearFailedLoginsByEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTeam provides a mock function for the type Querier
-func (_mock *Querier) DeleteTeam(ctx context.Context, id pgtype.UUID) error {
-	ret := _mock.Called(ctx, id)
+// CloseGamesPastSignupDeadline provides a mock function for the type Querier
+func (_mock *Querier) CloseGamesPastSignupDeadline(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTeam")
+		panic("no return value specified for CloseGamesPastSignupDeadline")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Querier_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
-type Querier_DeleteTeam_Call struct {
+// Querier_CloseGamesPastSignupDeadline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseGamesPastSignupDeadline'
+type Querier_CloseGamesPastSignupDeadline_Call struct {
 	*mock.Call
 }
 
-// DeleteTeam is a helper method to define mock.On call
+// CloseGamesPastSignupDeadline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id pgtype.UUID
-func (_e *Querier_Expecter) DeleteTeam(ctx interface{}, id interface{}) *Querier_DeleteTeam_Call {
-	return &Querier_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, id)}
+func (_e *Querier_Expecter) CloseGamesPastSignupDeadline(ctx interface{}) *Querier_CloseGamesPastSignupDeadline_Call {
+	return &Querier_CloseGamesPastSignupDeadline_Call{Call: _e.mock.On("CloseGamesPastSignupDeadline", ctx)}
 }
 
-func (_c *Querier_DeleteTeam_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_DeleteTeam_Call {
+func (_c *Querier_CloseGamesPastSignupDeadline_Call) Run(run func(ctx context.Context)) *Querier_CloseGamesPastSignupDeadline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
-		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *Querier_DeleteTeam_Call) Return(err error) *Querier_DeleteTeam_Call {
-	_c.Call.Return(err)
+func (_c *Querier_CloseGamesPastSignupDeadline_Call) Return(n int64, err error) *Querier_CloseGamesPastSignupDeadline_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_DeleteTeam_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_DeleteTeam_Call {
+func (_c *Querier_CloseGamesPastSignupDeadline_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Querier_CloseGamesPastSignupDeadline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function for the type Querier
-func (_mock *Querier) DeleteUser(ctx context.Context, id pgtype.UUID) error {
+// CompleteBrokerEvent provides a mock function for the type Querier
+func (_mock *Querier) CompleteBrokerEvent(ctx context.Context, id pgtype.UUID) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for CompleteBrokerEvent")
 	}
 
 	var r0 error
@@ -803,19 +928,19 @@ func (_mock *Querier) DeleteUser(ctx context.Context, id pgtype.UUID) error {
 	return r0
 }
 
-// Querier_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type Querier_DeleteUser_Call struct {
+// Querier_CompleteBrokerEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteBrokerEvent'
+type Querier_CompleteBrokerEvent_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// CompleteBrokerEvent is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id pgtype.UUID
-func (_e *Querier_Expecter) DeleteUser(ctx interface{}, id interface{}) *Querier_DeleteUser_Call {
-	return &Querier_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+func (_e *Querier_Expecter) CompleteBrokerEvent(ctx interface{}, id interface{}) *Querier_CompleteBrokerEvent_Call {
+	return &Querier_CompleteBrokerEvent_Call{Call: _e.mock.On("CompleteBrokerEvent", ctx, id)}
 }
 
-func (_c *Querier_DeleteUser_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_DeleteUser_Call {
+func (_c *Querier_CompleteBrokerEvent_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_CompleteBrokerEvent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -833,121 +958,106 @@ func (_c *Querier_DeleteUser_Call) Run(run func(ctx context.Context, id pgtype.U
 	return _c
 }
 
-func (_c *Querier_DeleteUser_Call) Return(err error) *Querier_DeleteUser_Call {
+func (_c *Querier_CompleteBrokerEvent_Call) Return(err error) *Querier_CompleteBrokerEvent_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_DeleteUser_Call {
+func (_c *Querier_CompleteBrokerEvent_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_CompleteBrokerEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGame provides a mock function for the type Querier
-func (_mock *Querier) GetGame(ctx context.Context, id pgtype.UUID) (repository.GetGameRow, error) {
-	ret := _mock.Called(ctx, id)
+// CompleteFinishedGames provides a mock function for the type Querier
+func (_mock *Querier) CompleteFinishedGames(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGame")
+		panic("no return value specified for CompleteFinishedGames")
 	}
 
-	var r0 repository.GetGameRow
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.GetGameRow, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.GetGameRow); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Get(0).(repository.GetGameRow)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_GetGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGame'
-type Querier_GetGame_Call struct {
+// Querier_CompleteFinishedGames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteFinishedGames'
+type Querier_CompleteFinishedGames_Call struct {
 	*mock.Call
 }
 
-// GetGame is a helper method to define mock.On call
+// CompleteFinishedGames is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id pgtype.UUID
-func (_e *Querier_Expecter) GetGame(ctx interface{}, id interface{}) *Querier_GetGame_Call {
-	return &Querier_GetGame_Call{Call: _e.mock.On("GetGame", ctx, id)}
+func (_e *Querier_Expecter) CompleteFinishedGames(ctx interface{}) *Querier_CompleteFinishedGames_Call {
+	return &Querier_CompleteFinishedGames_Call{Call: _e.mock.On("CompleteFinishedGames", ctx)}
 }
 
-func (_c *Querier_GetGame_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetGame_Call {
+func (_c *Querier_CompleteFinishedGames_Call) Run(run func(ctx context.Context)) *Querier_CompleteFinishedGames_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
-		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *Querier_GetGame_Call) Return(getGameRow repository.GetGameRow, err error) *Querier_GetGame_Call {
-	_c.Call.Return(getGameRow, err)
+func (_c *Querier_CompleteFinishedGames_Call) Return(n int64, err error) *Querier_CompleteFinishedGames_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_GetGame_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.GetGameRow, error)) *Querier_GetGame_Call {
+func (_c *Querier_CompleteFinishedGames_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Querier_CompleteFinishedGames_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGameForUpdate provides a mock function for the type Querier
-func (_mock *Querier) GetGameForUpdate(ctx context.Context, id pgtype.UUID) (repository.GetGameForUpdateRow, error) {
+// CompleteSideEffect provides a mock function for the type Querier
+func (_mock *Querier) CompleteSideEffect(ctx context.Context, id pgtype.UUID) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGameForUpdate")
+		panic("no return value specified for CompleteSideEffect")
 	}
 
-	var r0 repository.GetGameForUpdateRow
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.GetGameForUpdateRow, error)); ok {
-		return returnFunc(ctx, id)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.GetGameForUpdateRow); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(repository.GetGameForUpdateRow)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_GetGameForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGameForUpdate'
-type Querier_GetGameForUpdate_Call struct {
+// Querier_CompleteSideEffect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteSideEffect'
+type Querier_CompleteSideEffect_Call struct {
 	*mock.Call
 }
 
-// GetGameForUpdate is a helper method to define mock.On call
+// CompleteSideEffect is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id pgtype.UUID
-func (_e *Querier_Expecter) GetGameForUpdate(ctx interface{}, id interface{}) *Querier_GetGameForUpdate_Call {
-	return &Querier_GetGameForUpdate_Call{Call: _e.mock.On("GetGameForUpdate", ctx, id)}
+func (_e *Querier_Expecter) CompleteSideEffect(ctx interface{}, id interface{}) *Querier_CompleteSideEffect_Call {
+	return &Querier_CompleteSideEffect_Call{Call: _e.mock.On("CompleteSideEffect", ctx, id)}
 }
 
-func (_c *Querier_GetGameForUpdate_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetGameForUpdate_Call {
+func (_c *Querier_CompleteSideEffect_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_CompleteSideEffect_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -965,55 +1075,46 @@ func (_c *Querier_GetGameForUpdate_Call) Run(run func(ctx context.Context, id pg
 	return _c
 }
 
-func (_c *Querier_GetGameForUpdate_Call) Return(getGameForUpdateRow repository.GetGameForUpdateRow, err error) *Querier_GetGameForUpdate_Call {
-	_c.Call.Return(getGameForUpdateRow, err)
+func (_c *Querier_CompleteSideEffect_Call) Return(err error) *Querier_CompleteSideEffect_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_GetGameForUpdate_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.GetGameForUpdateRow, error)) *Querier_GetGameForUpdate_Call {
+func (_c *Querier_CompleteSideEffect_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_CompleteSideEffect_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetParticipant provides a mock function for the type Querier
-func (_mock *Querier) GetParticipant(ctx context.Context, id pgtype.UUID) (repository.Participant, error) {
+// CompleteWebhookDelivery provides a mock function for the type Querier
+func (_mock *Querier) CompleteWebhookDelivery(ctx context.Context, id pgtype.UUID) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetParticipant")
+		panic("no return value specified for CompleteWebhookDelivery")
 	}
 
-	var r0 repository.Participant
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.Participant, error)); ok {
-		return returnFunc(ctx, id)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.Participant); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(repository.Participant)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_GetParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParticipant'
-type Querier_GetParticipant_Call struct {
+// Querier_CompleteWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteWebhookDelivery'
+type Querier_CompleteWebhookDelivery_Call struct {
 	*mock.Call
 }
 
-// GetParticipant is a helper method to define mock.On call
+// CompleteWebhookDelivery is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id pgtype.UUID
-func (_e *Querier_Expecter) GetParticipant(ctx interface{}, id interface{}) *Querier_GetParticipant_Call {
-	return &Querier_GetParticipant_Call{Call: _e.mock.On("GetParticipant", ctx, id)}
+func (_e *Querier_Expecter) CompleteWebhookDelivery(ctx interface{}, id interface{}) *Querier_CompleteWebhookDelivery_Call {
+	return &Querier_CompleteWebhookDelivery_Call{Call: _e.mock.On("CompleteWebhookDelivery", ctx, id)}
 }
 
-func (_c *Querier_GetParticipant_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetParticipant_Call {
+func (_c *Querier_CompleteWebhookDelivery_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_CompleteWebhookDelivery_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1031,63 +1132,63 @@ func (_c *Querier_GetParticipant_Call) Run(run func(ctx context.Context, id pgty
 	return _c
 }
 
-func (_c *Querier_GetParticipant_Call) Return(participant repository.Participant, err error) *Querier_GetParticipant_Call {
-	_c.Call.Return(participant, err)
+func (_c *Querier_CompleteWebhookDelivery_Call) Return(err error) *Querier_CompleteWebhookDelivery_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_GetParticipant_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.Participant, error)) *Querier_GetParticipant_Call {
+func (_c *Querier_CompleteWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_CompleteWebhookDelivery_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetParticipantByGameAndUser provides a mock function for the type Querier
-func (_mock *Querier) GetParticipantByGameAndUser(ctx context.Context, arg repository.GetParticipantByGameAndUserParams) (repository.Participant, error) {
-	ret := _mock.Called(ctx, arg)
+// ConsumeMagicLinkToken provides a mock function for the type Querier
+func (_mock *Querier) ConsumeMagicLinkToken(ctx context.Context, tokenHash string) (repository.MagicLinkToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetParticipantByGameAndUser")
+		panic("no return value specified for ConsumeMagicLinkToken")
 	}
 
-	var r0 repository.Participant
+	var r0 repository.MagicLinkToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetParticipantByGameAndUserParams) (repository.Participant, error)); ok {
-		return returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (repository.MagicLinkToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetParticipantByGameAndUserParams) repository.Participant); ok {
-		r0 = returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) repository.MagicLinkToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
 	} else {
-		r0 = ret.Get(0).(repository.Participant)
+		r0 = ret.Get(0).(repository.MagicLinkToken)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.GetParticipantByGameAndUserParams) error); ok {
-		r1 = returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_GetParticipantByGameAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParticipantByGameAndUser'
-type Querier_GetParticipantByGameAndUser_Call struct {
+// Querier_ConsumeMagicLinkToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeMagicLinkToken'
+type Querier_ConsumeMagicLinkToken_Call struct {
 	*mock.Call
 }
 
-// GetParticipantByGameAndUser is a helper method to define mock.On call
+// ConsumeMagicLinkToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.GetParticipantByGameAndUserParams
-func (_e *Querier_Expecter) GetParticipantByGameAndUser(ctx interface{}, arg interface{}) *Querier_GetParticipantByGameAndUser_Call {
-	return &Querier_GetParticipantByGameAndUser_Call{Call: _e.mock.On("GetParticipantByGameAndUser", ctx, arg)}
+//   - tokenHash string
+func (_e *Querier_Expecter) ConsumeMagicLinkToken(ctx interface{}, tokenHash interface{}) *Querier_ConsumeMagicLinkToken_Call {
+	return &Querier_ConsumeMagicLinkToken_Call{Call: _e.mock.On("ConsumeMagicLinkToken", ctx, tokenHash)}
 }
 
-func (_c *Querier_GetParticipantByGameAndUser_Call) Run(run func(ctx context.Context, arg repository.GetParticipantByGameAndUserParams)) *Querier_GetParticipantByGameAndUser_Call {
+func (_c *Querier_ConsumeMagicLinkToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_ConsumeMagicLinkToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.GetParticipantByGameAndUserParams
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(repository.GetParticipantByGameAndUserParams)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -1097,63 +1198,63 @@ func (_c *Querier_GetParticipantByGameAndUser_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *Querier_GetParticipantByGameAndUser_Call) Return(participant repository.Participant, err error) *Querier_GetParticipantByGameAndUser_Call {
-	_c.Call.Return(participant, err)
+func (_c *Querier_ConsumeMagicLinkToken_Call) Return(magicLinkToken repository.MagicLinkToken, err error) *Querier_ConsumeMagicLinkToken_Call {
+	_c.Call.Return(magicLinkToken, err)
 	return _c
 }
 
-func (_c *Querier_GetParticipantByGameAndUser_Call) RunAndReturn(run func(ctx context.Context, arg repository.GetParticipantByGameAndUserParams) (repository.Participant, error)) *Querier_GetParticipantByGameAndUser_Call {
+func (_c *Querier_ConsumeMagicLinkToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (repository.MagicLinkToken, error)) *Querier_ConsumeMagicLinkToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeam provides a mock function for the type Querier
-func (_mock *Querier) GetTeam(ctx context.Context, id pgtype.UUID) (repository.Team, error) {
-	ret := _mock.Called(ctx, id)
+// ConsumeWebAuthnChallenge provides a mock function for the type Querier
+func (_mock *Querier) ConsumeWebAuthnChallenge(ctx context.Context, arg repository.ConsumeWebAuthnChallengeParams) (repository.WebauthnChallenge, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeam")
+		panic("no return value specified for ConsumeWebAuthnChallenge")
 	}
 
-	var r0 repository.Team
+	var r0 repository.WebauthnChallenge
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.Team, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ConsumeWebAuthnChallengeParams) (repository.WebauthnChallenge, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.Team); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ConsumeWebAuthnChallengeParams) repository.WebauthnChallenge); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Team)
+		r0 = ret.Get(0).(repository.WebauthnChallenge)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ConsumeWebAuthnChallengeParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_GetTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeam'
-type Querier_GetTeam_Call struct {
+// Querier_ConsumeWebAuthnChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeWebAuthnChallenge'
+type Querier_ConsumeWebAuthnChallenge_Call struct {
 	*mock.Call
 }
 
-// GetTeam is a helper method to define mock.On call
+// ConsumeWebAuthnChallenge is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id pgtype.UUID
-func (_e *Querier_Expecter) GetTeam(ctx interface{}, id interface{}) *Querier_GetTeam_Call {
-	return &Querier_GetTeam_Call{Call: _e.mock.On("GetTeam", ctx, id)}
+//   - arg repository.ConsumeWebAuthnChallengeParams
+func (_e *Querier_Expecter) ConsumeWebAuthnChallenge(ctx interface{}, arg interface{}) *Querier_ConsumeWebAuthnChallenge_Call {
+	return &Querier_ConsumeWebAuthnChallenge_Call{Call: _e.mock.On("ConsumeWebAuthnChallenge", ctx, arg)}
 }
 
-func (_c *Querier_GetTeam_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetTeam_Call {
+func (_c *Querier_ConsumeWebAuthnChallenge_Call) Run(run func(ctx context.Context, arg repository.ConsumeWebAuthnChallengeParams)) *Querier_ConsumeWebAuthnChallenge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
+		var arg1 repository.ConsumeWebAuthnChallengeParams
 		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
+			arg1 = args[1].(repository.ConsumeWebAuthnChallengeParams)
 		}
 		run(
 			arg0,
@@ -1163,63 +1264,63 @@ func (_c *Querier_GetTeam_Call) Run(run func(ctx context.Context, id pgtype.UUID
 	return _c
 }
 
-func (_c *Querier_GetTeam_Call) Return(team repository.Team, err error) *Querier_GetTeam_Call {
-	_c.Call.Return(team, err)
+func (_c *Querier_ConsumeWebAuthnChallenge_Call) Return(webauthnChallenge repository.WebauthnChallenge, err error) *Querier_ConsumeWebAuthnChallenge_Call {
+	_c.Call.Return(webauthnChallenge, err)
 	return _c
 }
 
-func (_c *Querier_GetTeam_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.Team, error)) *Querier_GetTeam_Call {
+func (_c *Querier_ConsumeWebAuthnChallenge_Call) RunAndReturn(run func(ctx context.Context, arg repository.ConsumeWebAuthnChallengeParams) (repository.WebauthnChallenge, error)) *Querier_ConsumeWebAuthnChallenge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByEmail provides a mock function for the type Querier
-func (_mock *Querier) GetUserByEmail(ctx context.Context, email string) (repository.User, error) {
-	ret := _mock.Called(ctx, email)
+// CountConfirmedParticipants provides a mock function for the type Querier
+func (_mock *Querier) CountConfirmedParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, gameID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByEmail")
+		panic("no return value specified for CountConfirmedParticipants")
 	}
 
-	var r0 repository.User
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (repository.User, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, gameID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) repository.User); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, gameID)
 	} else {
-		r0 = ret.Get(0).(repository.User)
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
-type Querier_GetUserByEmail_Call struct {
+// Querier_CountConfirmedParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountConfirmedParticipants'
+type Querier_CountConfirmedParticipants_Call struct {
 	*mock.Call
 }
 
-// GetUserByEmail is a helper method to define mock.On call
+// CountConfirmedParticipants is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *Querier_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *Querier_GetUserByEmail_Call {
-	return &Querier_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) CountConfirmedParticipants(ctx interface{}, gameID interface{}) *Querier_CountConfirmedParticipants_Call {
+	return &Querier_CountConfirmedParticipants_Call{Call: _e.mock.On("CountConfirmedParticipants", ctx, gameID)}
 }
 
-func (_c *Querier_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *Querier_GetUserByEmail_Call {
+func (_c *Querier_CountConfirmedParticipants_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_CountConfirmedParticipants_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 pgtype.UUID
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(pgtype.UUID)
 		}
 		run(
 			arg0,
@@ -1229,55 +1330,55 @@ func (_c *Querier_GetUserByEmail_Call) Run(run func(ctx context.Context, email s
 	return _c
 }
 
-func (_c *Querier_GetUserByEmail_Call) Return(user repository.User, err error) *Querier_GetUserByEmail_Call {
-	_c.Call.Return(user, err)
+func (_c *Querier_CountConfirmedParticipants_Call) Return(n int64, err error) *Querier_CountConfirmedParticipants_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_GetUserByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (repository.User, error)) *Querier_GetUserByEmail_Call {
+func (_c *Querier_CountConfirmedParticipants_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (int64, error)) *Querier_CountConfirmedParticipants_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByID provides a mock function for the type Querier
-func (_mock *Querier) GetUserByID(ctx context.Context, id pgtype.UUID) (repository.User, error) {
-	ret := _mock.Called(ctx, id)
+// CountHeldReservations provides a mock function for the type Querier
+func (_mock *Querier) CountHeldReservations(ctx context.Context, gameID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, gameID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByID")
+		panic("no return value specified for CountHeldReservations")
 	}
 
-	var r0 repository.User
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.User, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, gameID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.User); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, gameID)
 	} else {
-		r0 = ret.Get(0).(repository.User)
+		r0 = ret.Get(0).(int64)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, id)
+		r1 = returnFunc(ctx, gameID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
-type Querier_GetUserByID_Call struct {
+// Querier_CountHeldReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountHeldReservations'
+type Querier_CountHeldReservations_Call struct {
 	*mock.Call
 }
 
-// GetUserByID is a helper method to define mock.On call
+// CountHeldReservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id pgtype.UUID
-func (_e *Querier_Expecter) GetUserByID(ctx interface{}, id interface{}) *Querier_GetUserByID_Call {
-	return &Querier_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) CountHeldReservations(ctx interface{}, gameID interface{}) *Querier_CountHeldReservations_Call {
+	return &Querier_CountHeldReservations_Call{Call: _e.mock.On("CountHeldReservations", ctx, gameID)}
 }
 
-func (_c *Querier_GetUserByID_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetUserByID_Call {
+func (_c *Querier_CountHeldReservations_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_CountHeldReservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1295,65 +1396,13590 @@ func (_c *Querier_GetUserByID_Call) Run(run func(ctx context.Context, id pgtype.
 	return _c
 }
 
-func (_c *Querier_GetUserByID_Call) Return(user repository.User, err error) *Querier_GetUserByID_Call {
-	_c.Call.Return(user, err)
+func (_c *Querier_CountHeldReservations_Call) Return(n int64, err error) *Querier_CountHeldReservations_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Querier_GetUserByID_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.User, error)) *Querier_GetUserByID_Call {
+func (_c *Querier_CountHeldReservations_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (int64, error)) *Querier_CountHeldReservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListActiveParticipantsByGame provides a mock function for the type Querier
-func (_mock *Querier) ListActiveParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.ListActiveParticipantsByGameRow, error) {
-	ret := _mock.Called(ctx, gameID)
+// CountLoginCodesSince provides a mock function for the type Querier
+func (_mock *Querier) CountLoginCodesSince(ctx context.Context, arg repository.CountLoginCodesSinceParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListActiveParticipantsByGame")
+		panic("no return value specified for CountLoginCodesSince")
 	}
 
-	var r0 []repository.ListActiveParticipantsByGameRow
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListActiveParticipantsByGameRow, error)); ok {
-		return returnFunc(ctx, gameID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CountLoginCodesSinceParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListActiveParticipantsByGameRow); ok {
-		r0 = returnFunc(ctx, gameID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CountLoginCodesSinceParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListActiveParticipantsByGameRow)
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CountLoginCodesSinceParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountLoginCodesSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLoginCodesSince'
+type Querier_CountLoginCodesSince_Call struct {
+	*mock.Call
+}
+
+// CountLoginCodesSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CountLoginCodesSinceParams
+func (_e *Querier_Expecter) CountLoginCodesSince(ctx interface{}, arg interface{}) *Querier_CountLoginCodesSince_Call {
+	return &Querier_CountLoginCodesSince_Call{Call: _e.mock.On("CountLoginCodesSince", ctx, arg)}
+}
+
+func (_c *Querier_CountLoginCodesSince_Call) Run(run func(ctx context.Context, arg repository.CountLoginCodesSinceParams)) *Querier_CountLoginCodesSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CountLoginCodesSinceParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CountLoginCodesSinceParams)
 		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountLoginCodesSince_Call) Return(n int64, err error) *Querier_CountLoginCodesSince_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountLoginCodesSince_Call) RunAndReturn(run func(ctx context.Context, arg repository.CountLoginCodesSinceParams) (int64, error)) *Querier_CountLoginCodesSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOpenGamesByOwner provides a mock function for the type Querier
+func (_mock *Querier) CountOpenGamesByOwner(ctx context.Context, ownerID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpenGamesByOwner")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, ownerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, ownerID)
+	} else {
+		r0 = ret.Get(0).(int64)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, gameID)
+		r1 = returnFunc(ctx, ownerID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_ListActiveParticipantsByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveParticipantsByGame'
-type Querier_ListActiveParticipantsByGame_Call struct {
+// Querier_CountOpenGamesByOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenGamesByOwner'
+type Querier_CountOpenGamesByOwner_Call struct {
 	*mock.Call
 }
 
-// ListActiveParticipantsByGame is a helper method to define mock.On call
+// CountOpenGamesByOwner is a helper method to define mock.On call
 //   - ctx context.Context
-//   - gameID pgtype.UUID
-func (_e *Querier_Expecter) ListActiveParticipantsByGame(ctx interface{}, gameID interface{}) *Querier_ListActiveParticipantsByGame_Call {
-	return &Querier_ListActiveParticipantsByGame_Call{Call: _e.mock.On("ListActiveParticipantsByGame", ctx, gameID)}
+//   - ownerID pgtype.UUID
+func (_e *Querier_Expecter) CountOpenGamesByOwner(ctx interface{}, ownerID interface{}) *Querier_CountOpenGamesByOwner_Call {
+	return &Querier_CountOpenGamesByOwner_Call{Call: _e.mock.On("CountOpenGamesByOwner", ctx, ownerID)}
+}
+
+func (_c *Querier_CountOpenGamesByOwner_Call) Run(run func(ctx context.Context, ownerID pgtype.UUID)) *Querier_CountOpenGamesByOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountOpenGamesByOwner_Call) Return(n int64, err error) *Querier_CountOpenGamesByOwner_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountOpenGamesByOwner_Call) RunAndReturn(run func(ctx context.Context, ownerID pgtype.UUID) (int64, error)) *Querier_CountOpenGamesByOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountPhoneVerificationsSince provides a mock function for the type Querier
+func (_mock *Querier) CountPhoneVerificationsSince(ctx context.Context, arg repository.CountPhoneVerificationsSinceParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPhoneVerificationsSince")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CountPhoneVerificationsSinceParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CountPhoneVerificationsSinceParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CountPhoneVerificationsSinceParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountPhoneVerificationsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPhoneVerificationsSince'
+type Querier_CountPhoneVerificationsSince_Call struct {
+	*mock.Call
+}
+
+// CountPhoneVerificationsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CountPhoneVerificationsSinceParams
+func (_e *Querier_Expecter) CountPhoneVerificationsSince(ctx interface{}, arg interface{}) *Querier_CountPhoneVerificationsSince_Call {
+	return &Querier_CountPhoneVerificationsSince_Call{Call: _e.mock.On("CountPhoneVerificationsSince", ctx, arg)}
+}
+
+func (_c *Querier_CountPhoneVerificationsSince_Call) Run(run func(ctx context.Context, arg repository.CountPhoneVerificationsSinceParams)) *Querier_CountPhoneVerificationsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CountPhoneVerificationsSinceParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CountPhoneVerificationsSinceParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountPhoneVerificationsSince_Call) Return(n int64, err error) *Querier_CountPhoneVerificationsSince_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountPhoneVerificationsSince_Call) RunAndReturn(run func(ctx context.Context, arg repository.CountPhoneVerificationsSinceParams) (int64, error)) *Querier_CountPhoneVerificationsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReferrals provides a mock function for the type Querier
+func (_mock *Querier) CountReferrals(ctx context.Context, referrerID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, referrerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReferrals")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, referrerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, referrerID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, referrerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountReferrals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReferrals'
+type Querier_CountReferrals_Call struct {
+	*mock.Call
+}
+
+// CountReferrals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - referrerID pgtype.UUID
+func (_e *Querier_Expecter) CountReferrals(ctx interface{}, referrerID interface{}) *Querier_CountReferrals_Call {
+	return &Querier_CountReferrals_Call{Call: _e.mock.On("CountReferrals", ctx, referrerID)}
+}
+
+func (_c *Querier_CountReferrals_Call) Run(run func(ctx context.Context, referrerID pgtype.UUID)) *Querier_CountReferrals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountReferrals_Call) Return(n int64, err error) *Querier_CountReferrals_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountReferrals_Call) RunAndReturn(run func(ctx context.Context, referrerID pgtype.UUID) (int64, error)) *Querier_CountReferrals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReportsByReporterSince provides a mock function for the type Querier
+func (_mock *Querier) CountReportsByReporterSince(ctx context.Context, arg repository.CountReportsByReporterSinceParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReportsByReporterSince")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CountReportsByReporterSinceParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CountReportsByReporterSinceParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CountReportsByReporterSinceParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountReportsByReporterSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReportsByReporterSince'
+type Querier_CountReportsByReporterSince_Call struct {
+	*mock.Call
+}
+
+// CountReportsByReporterSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CountReportsByReporterSinceParams
+func (_e *Querier_Expecter) CountReportsByReporterSince(ctx interface{}, arg interface{}) *Querier_CountReportsByReporterSince_Call {
+	return &Querier_CountReportsByReporterSince_Call{Call: _e.mock.On("CountReportsByReporterSince", ctx, arg)}
+}
+
+func (_c *Querier_CountReportsByReporterSince_Call) Run(run func(ctx context.Context, arg repository.CountReportsByReporterSinceParams)) *Querier_CountReportsByReporterSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CountReportsByReporterSinceParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CountReportsByReporterSinceParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountReportsByReporterSince_Call) Return(n int64, err error) *Querier_CountReportsByReporterSince_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountReportsByReporterSince_Call) RunAndReturn(run func(ctx context.Context, arg repository.CountReportsByReporterSinceParams) (int64, error)) *Querier_CountReportsByReporterSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountWaitlistParticipants provides a mock function for the type Querier
+func (_mock *Querier) CountWaitlistParticipants(ctx context.Context, gameID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountWaitlistParticipants")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CountWaitlistParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountWaitlistParticipants'
+type Querier_CountWaitlistParticipants_Call struct {
+	*mock.Call
+}
+
+// CountWaitlistParticipants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) CountWaitlistParticipants(ctx interface{}, gameID interface{}) *Querier_CountWaitlistParticipants_Call {
+	return &Querier_CountWaitlistParticipants_Call{Call: _e.mock.On("CountWaitlistParticipants", ctx, gameID)}
+}
+
+func (_c *Querier_CountWaitlistParticipants_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_CountWaitlistParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CountWaitlistParticipants_Call) Return(n int64, err error) *Querier_CountWaitlistParticipants_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_CountWaitlistParticipants_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (int64, error)) *Querier_CountWaitlistParticipants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAdminAuditEntry provides a mock function for the type Querier
+func (_mock *Querier) CreateAdminAuditEntry(ctx context.Context, arg repository.CreateAdminAuditEntryParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAdminAuditEntry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateAdminAuditEntryParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_CreateAdminAuditEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAdminAuditEntry'
+type Querier_CreateAdminAuditEntry_Call struct {
+	*mock.Call
+}
+
+// CreateAdminAuditEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateAdminAuditEntryParams
+func (_e *Querier_Expecter) CreateAdminAuditEntry(ctx interface{}, arg interface{}) *Querier_CreateAdminAuditEntry_Call {
+	return &Querier_CreateAdminAuditEntry_Call{Call: _e.mock.On("CreateAdminAuditEntry", ctx, arg)}
+}
+
+func (_c *Querier_CreateAdminAuditEntry_Call) Run(run func(ctx context.Context, arg repository.CreateAdminAuditEntryParams)) *Querier_CreateAdminAuditEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateAdminAuditEntryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateAdminAuditEntryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateAdminAuditEntry_Call) Return(err error) *Querier_CreateAdminAuditEntry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_CreateAdminAuditEntry_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateAdminAuditEntryParams) error) *Querier_CreateAdminAuditEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateConversation provides a mock function for the type Querier
+func (_mock *Querier) CreateConversation(ctx context.Context, arg repository.CreateConversationParams) (repository.Conversation, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConversation")
+	}
+
+	var r0 repository.Conversation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateConversationParams) (repository.Conversation, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateConversationParams) repository.Conversation); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Conversation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateConversationParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConversation'
+type Querier_CreateConversation_Call struct {
+	*mock.Call
+}
+
+// CreateConversation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateConversationParams
+func (_e *Querier_Expecter) CreateConversation(ctx interface{}, arg interface{}) *Querier_CreateConversation_Call {
+	return &Querier_CreateConversation_Call{Call: _e.mock.On("CreateConversation", ctx, arg)}
+}
+
+func (_c *Querier_CreateConversation_Call) Run(run func(ctx context.Context, arg repository.CreateConversationParams)) *Querier_CreateConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateConversationParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateConversationParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateConversation_Call) Return(conversation repository.Conversation, err error) *Querier_CreateConversation_Call {
+	_c.Call.Return(conversation, err)
+	return _c
+}
+
+func (_c *Querier_CreateConversation_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateConversationParams) (repository.Conversation, error)) *Querier_CreateConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateConversationMessage provides a mock function for the type Querier
+func (_mock *Querier) CreateConversationMessage(ctx context.Context, arg repository.CreateConversationMessageParams) (repository.ConversationMessage, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConversationMessage")
+	}
+
+	var r0 repository.ConversationMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateConversationMessageParams) (repository.ConversationMessage, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateConversationMessageParams) repository.ConversationMessage); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.ConversationMessage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateConversationMessageParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateConversationMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConversationMessage'
+type Querier_CreateConversationMessage_Call struct {
+	*mock.Call
+}
+
+// CreateConversationMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateConversationMessageParams
+func (_e *Querier_Expecter) CreateConversationMessage(ctx interface{}, arg interface{}) *Querier_CreateConversationMessage_Call {
+	return &Querier_CreateConversationMessage_Call{Call: _e.mock.On("CreateConversationMessage", ctx, arg)}
+}
+
+func (_c *Querier_CreateConversationMessage_Call) Run(run func(ctx context.Context, arg repository.CreateConversationMessageParams)) *Querier_CreateConversationMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateConversationMessageParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateConversationMessageParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateConversationMessage_Call) Return(conversationMessage repository.ConversationMessage, err error) *Querier_CreateConversationMessage_Call {
+	_c.Call.Return(conversationMessage, err)
+	return _c
+}
+
+func (_c *Querier_CreateConversationMessage_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateConversationMessageParams) (repository.ConversationMessage, error)) *Querier_CreateConversationMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEmailChangeRequest provides a mock function for the type Querier
+func (_mock *Querier) CreateEmailChangeRequest(ctx context.Context, arg repository.CreateEmailChangeRequestParams) (repository.EmailChangeRequest, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmailChangeRequest")
+	}
+
+	var r0 repository.EmailChangeRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateEmailChangeRequestParams) (repository.EmailChangeRequest, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateEmailChangeRequestParams) repository.EmailChangeRequest); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.EmailChangeRequest)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateEmailChangeRequestParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateEmailChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmailChangeRequest'
+type Querier_CreateEmailChangeRequest_Call struct {
+	*mock.Call
+}
+
+// CreateEmailChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateEmailChangeRequestParams
+func (_e *Querier_Expecter) CreateEmailChangeRequest(ctx interface{}, arg interface{}) *Querier_CreateEmailChangeRequest_Call {
+	return &Querier_CreateEmailChangeRequest_Call{Call: _e.mock.On("CreateEmailChangeRequest", ctx, arg)}
+}
+
+func (_c *Querier_CreateEmailChangeRequest_Call) Run(run func(ctx context.Context, arg repository.CreateEmailChangeRequestParams)) *Querier_CreateEmailChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateEmailChangeRequestParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateEmailChangeRequestParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateEmailChangeRequest_Call) Return(emailChangeRequest repository.EmailChangeRequest, err error) *Querier_CreateEmailChangeRequest_Call {
+	_c.Call.Return(emailChangeRequest, err)
+	return _c
+}
+
+func (_c *Querier_CreateEmailChangeRequest_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateEmailChangeRequestParams) (repository.EmailChangeRequest, error)) *Querier_CreateEmailChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGame provides a mock function for the type Querier
+func (_mock *Querier) CreateGame(ctx context.Context, arg repository.CreateGameParams) (repository.CreateGameRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGame")
+	}
+
+	var r0 repository.CreateGameRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameParams) (repository.CreateGameRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameParams) repository.CreateGameRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.CreateGameRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateGameParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGame'
+type Querier_CreateGame_Call struct {
+	*mock.Call
+}
+
+// CreateGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateGameParams
+func (_e *Querier_Expecter) CreateGame(ctx interface{}, arg interface{}) *Querier_CreateGame_Call {
+	return &Querier_CreateGame_Call{Call: _e.mock.On("CreateGame", ctx, arg)}
+}
+
+func (_c *Querier_CreateGame_Call) Run(run func(ctx context.Context, arg repository.CreateGameParams)) *Querier_CreateGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateGameParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateGameParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateGame_Call) Return(createGameRow repository.CreateGameRow, err error) *Querier_CreateGame_Call {
+	_c.Call.Return(createGameRow, err)
+	return _c
+}
+
+func (_c *Querier_CreateGame_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateGameParams) (repository.CreateGameRow, error)) *Querier_CreateGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGameAnnouncement provides a mock function for the type Querier
+func (_mock *Querier) CreateGameAnnouncement(ctx context.Context, arg repository.CreateGameAnnouncementParams) (repository.GameAnnouncement, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGameAnnouncement")
+	}
+
+	var r0 repository.GameAnnouncement
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameAnnouncementParams) (repository.GameAnnouncement, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameAnnouncementParams) repository.GameAnnouncement); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.GameAnnouncement)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateGameAnnouncementParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateGameAnnouncement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGameAnnouncement'
+type Querier_CreateGameAnnouncement_Call struct {
+	*mock.Call
+}
+
+// CreateGameAnnouncement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateGameAnnouncementParams
+func (_e *Querier_Expecter) CreateGameAnnouncement(ctx interface{}, arg interface{}) *Querier_CreateGameAnnouncement_Call {
+	return &Querier_CreateGameAnnouncement_Call{Call: _e.mock.On("CreateGameAnnouncement", ctx, arg)}
+}
+
+func (_c *Querier_CreateGameAnnouncement_Call) Run(run func(ctx context.Context, arg repository.CreateGameAnnouncementParams)) *Querier_CreateGameAnnouncement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateGameAnnouncementParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateGameAnnouncementParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateGameAnnouncement_Call) Return(gameAnnouncement repository.GameAnnouncement, err error) *Querier_CreateGameAnnouncement_Call {
+	_c.Call.Return(gameAnnouncement, err)
+	return _c
+}
+
+func (_c *Querier_CreateGameAnnouncement_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateGameAnnouncementParams) (repository.GameAnnouncement, error)) *Querier_CreateGameAnnouncement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGameChange provides a mock function for the type Querier
+func (_mock *Querier) CreateGameChange(ctx context.Context, arg repository.CreateGameChangeParams) (repository.GameChange, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGameChange")
+	}
+
+	var r0 repository.GameChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameChangeParams) (repository.GameChange, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameChangeParams) repository.GameChange); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.GameChange)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateGameChangeParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateGameChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGameChange'
+type Querier_CreateGameChange_Call struct {
+	*mock.Call
+}
+
+// CreateGameChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateGameChangeParams
+func (_e *Querier_Expecter) CreateGameChange(ctx interface{}, arg interface{}) *Querier_CreateGameChange_Call {
+	return &Querier_CreateGameChange_Call{Call: _e.mock.On("CreateGameChange", ctx, arg)}
+}
+
+func (_c *Querier_CreateGameChange_Call) Run(run func(ctx context.Context, arg repository.CreateGameChangeParams)) *Querier_CreateGameChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateGameChangeParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateGameChangeParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateGameChange_Call) Return(gameChange repository.GameChange, err error) *Querier_CreateGameChange_Call {
+	_c.Call.Return(gameChange, err)
+	return _c
+}
+
+func (_c *Querier_CreateGameChange_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateGameChangeParams) (repository.GameChange, error)) *Querier_CreateGameChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGameChatMessage provides a mock function for the type Querier
+func (_mock *Querier) CreateGameChatMessage(ctx context.Context, arg repository.CreateGameChatMessageParams) (repository.GameChatMessage, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGameChatMessage")
+	}
+
+	var r0 repository.GameChatMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameChatMessageParams) (repository.GameChatMessage, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameChatMessageParams) repository.GameChatMessage); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.GameChatMessage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateGameChatMessageParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateGameChatMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGameChatMessage'
+type Querier_CreateGameChatMessage_Call struct {
+	*mock.Call
+}
+
+// CreateGameChatMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateGameChatMessageParams
+func (_e *Querier_Expecter) CreateGameChatMessage(ctx interface{}, arg interface{}) *Querier_CreateGameChatMessage_Call {
+	return &Querier_CreateGameChatMessage_Call{Call: _e.mock.On("CreateGameChatMessage", ctx, arg)}
+}
+
+func (_c *Querier_CreateGameChatMessage_Call) Run(run func(ctx context.Context, arg repository.CreateGameChatMessageParams)) *Querier_CreateGameChatMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateGameChatMessageParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateGameChatMessageParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateGameChatMessage_Call) Return(gameChatMessage repository.GameChatMessage, err error) *Querier_CreateGameChatMessage_Call {
+	_c.Call.Return(gameChatMessage, err)
+	return _c
+}
+
+func (_c *Querier_CreateGameChatMessage_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateGameChatMessageParams) (repository.GameChatMessage, error)) *Querier_CreateGameChatMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGameComment provides a mock function for the type Querier
+func (_mock *Querier) CreateGameComment(ctx context.Context, arg repository.CreateGameCommentParams) (repository.GameComment, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGameComment")
+	}
+
+	var r0 repository.GameComment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameCommentParams) (repository.GameComment, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGameCommentParams) repository.GameComment); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.GameComment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateGameCommentParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateGameComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGameComment'
+type Querier_CreateGameComment_Call struct {
+	*mock.Call
+}
+
+// CreateGameComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateGameCommentParams
+func (_e *Querier_Expecter) CreateGameComment(ctx interface{}, arg interface{}) *Querier_CreateGameComment_Call {
+	return &Querier_CreateGameComment_Call{Call: _e.mock.On("CreateGameComment", ctx, arg)}
+}
+
+func (_c *Querier_CreateGameComment_Call) Run(run func(ctx context.Context, arg repository.CreateGameCommentParams)) *Querier_CreateGameComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateGameCommentParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateGameCommentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateGameComment_Call) Return(gameComment repository.GameComment, err error) *Querier_CreateGameComment_Call {
+	_c.Call.Return(gameComment, err)
+	return _c
+}
+
+func (_c *Querier_CreateGameComment_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateGameCommentParams) (repository.GameComment, error)) *Querier_CreateGameComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGamePosition provides a mock function for the type Querier
+func (_mock *Querier) CreateGamePosition(ctx context.Context, arg repository.CreateGamePositionParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGamePosition")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateGamePositionParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_CreateGamePosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGamePosition'
+type Querier_CreateGamePosition_Call struct {
+	*mock.Call
+}
+
+// CreateGamePosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateGamePositionParams
+func (_e *Querier_Expecter) CreateGamePosition(ctx interface{}, arg interface{}) *Querier_CreateGamePosition_Call {
+	return &Querier_CreateGamePosition_Call{Call: _e.mock.On("CreateGamePosition", ctx, arg)}
+}
+
+func (_c *Querier_CreateGamePosition_Call) Run(run func(ctx context.Context, arg repository.CreateGamePositionParams)) *Querier_CreateGamePosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateGamePositionParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateGamePositionParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateGamePosition_Call) Return(err error) *Querier_CreateGamePosition_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_CreateGamePosition_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateGamePositionParams) error) *Querier_CreateGamePosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLegalAcceptance provides a mock function for the type Querier
+func (_mock *Querier) CreateLegalAcceptance(ctx context.Context, arg repository.CreateLegalAcceptanceParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLegalAcceptance")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateLegalAcceptanceParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_CreateLegalAcceptance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLegalAcceptance'
+type Querier_CreateLegalAcceptance_Call struct {
+	*mock.Call
+}
+
+// CreateLegalAcceptance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateLegalAcceptanceParams
+func (_e *Querier_Expecter) CreateLegalAcceptance(ctx interface{}, arg interface{}) *Querier_CreateLegalAcceptance_Call {
+	return &Querier_CreateLegalAcceptance_Call{Call: _e.mock.On("CreateLegalAcceptance", ctx, arg)}
+}
+
+func (_c *Querier_CreateLegalAcceptance_Call) Run(run func(ctx context.Context, arg repository.CreateLegalAcceptanceParams)) *Querier_CreateLegalAcceptance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateLegalAcceptanceParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateLegalAcceptanceParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateLegalAcceptance_Call) Return(err error) *Querier_CreateLegalAcceptance_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_CreateLegalAcceptance_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateLegalAcceptanceParams) error) *Querier_CreateLegalAcceptance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLoginCode provides a mock function for the type Querier
+func (_mock *Querier) CreateLoginCode(ctx context.Context, arg repository.CreateLoginCodeParams) (repository.LoginCode, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoginCode")
+	}
+
+	var r0 repository.LoginCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateLoginCodeParams) (repository.LoginCode, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateLoginCodeParams) repository.LoginCode); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.LoginCode)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateLoginCodeParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateLoginCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoginCode'
+type Querier_CreateLoginCode_Call struct {
+	*mock.Call
+}
+
+// CreateLoginCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateLoginCodeParams
+func (_e *Querier_Expecter) CreateLoginCode(ctx interface{}, arg interface{}) *Querier_CreateLoginCode_Call {
+	return &Querier_CreateLoginCode_Call{Call: _e.mock.On("CreateLoginCode", ctx, arg)}
+}
+
+func (_c *Querier_CreateLoginCode_Call) Run(run func(ctx context.Context, arg repository.CreateLoginCodeParams)) *Querier_CreateLoginCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateLoginCodeParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateLoginCodeParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateLoginCode_Call) Return(loginCode repository.LoginCode, err error) *Querier_CreateLoginCode_Call {
+	_c.Call.Return(loginCode, err)
+	return _c
+}
+
+func (_c *Querier_CreateLoginCode_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateLoginCodeParams) (repository.LoginCode, error)) *Querier_CreateLoginCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMagicLinkToken provides a mock function for the type Querier
+func (_mock *Querier) CreateMagicLinkToken(ctx context.Context, arg repository.CreateMagicLinkTokenParams) (repository.MagicLinkToken, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMagicLinkToken")
+	}
+
+	var r0 repository.MagicLinkToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateMagicLinkTokenParams) (repository.MagicLinkToken, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateMagicLinkTokenParams) repository.MagicLinkToken); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.MagicLinkToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateMagicLinkTokenParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateMagicLinkToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMagicLinkToken'
+type Querier_CreateMagicLinkToken_Call struct {
+	*mock.Call
+}
+
+// CreateMagicLinkToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateMagicLinkTokenParams
+func (_e *Querier_Expecter) CreateMagicLinkToken(ctx interface{}, arg interface{}) *Querier_CreateMagicLinkToken_Call {
+	return &Querier_CreateMagicLinkToken_Call{Call: _e.mock.On("CreateMagicLinkToken", ctx, arg)}
+}
+
+func (_c *Querier_CreateMagicLinkToken_Call) Run(run func(ctx context.Context, arg repository.CreateMagicLinkTokenParams)) *Querier_CreateMagicLinkToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateMagicLinkTokenParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateMagicLinkTokenParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateMagicLinkToken_Call) Return(magicLinkToken repository.MagicLinkToken, err error) *Querier_CreateMagicLinkToken_Call {
+	_c.Call.Return(magicLinkToken, err)
+	return _c
+}
+
+func (_c *Querier_CreateMagicLinkToken_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateMagicLinkTokenParams) (repository.MagicLinkToken, error)) *Querier_CreateMagicLinkToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotificationDelivery provides a mock function for the type Querier
+func (_mock *Querier) CreateNotificationDelivery(ctx context.Context, arg repository.CreateNotificationDeliveryParams) (pgtype.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotificationDelivery")
+	}
+
+	var r0 pgtype.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateNotificationDeliveryParams) (pgtype.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateNotificationDeliveryParams) pgtype.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(pgtype.UUID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateNotificationDeliveryParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateNotificationDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationDelivery'
+type Querier_CreateNotificationDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateNotificationDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateNotificationDeliveryParams
+func (_e *Querier_Expecter) CreateNotificationDelivery(ctx interface{}, arg interface{}) *Querier_CreateNotificationDelivery_Call {
+	return &Querier_CreateNotificationDelivery_Call{Call: _e.mock.On("CreateNotificationDelivery", ctx, arg)}
+}
+
+func (_c *Querier_CreateNotificationDelivery_Call) Run(run func(ctx context.Context, arg repository.CreateNotificationDeliveryParams)) *Querier_CreateNotificationDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateNotificationDeliveryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateNotificationDeliveryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateNotificationDelivery_Call) Return(uUID pgtype.UUID, err error) *Querier_CreateNotificationDelivery_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_CreateNotificationDelivery_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateNotificationDeliveryParams) (pgtype.UUID, error)) *Querier_CreateNotificationDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateParticipant provides a mock function for the type Querier
+func (_mock *Querier) CreateParticipant(ctx context.Context, arg repository.CreateParticipantParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateParticipant")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateParticipantParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateParticipantParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateParticipantParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateParticipant'
+type Querier_CreateParticipant_Call struct {
+	*mock.Call
+}
+
+// CreateParticipant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateParticipantParams
+func (_e *Querier_Expecter) CreateParticipant(ctx interface{}, arg interface{}) *Querier_CreateParticipant_Call {
+	return &Querier_CreateParticipant_Call{Call: _e.mock.On("CreateParticipant", ctx, arg)}
+}
+
+func (_c *Querier_CreateParticipant_Call) Run(run func(ctx context.Context, arg repository.CreateParticipantParams)) *Querier_CreateParticipant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateParticipantParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateParticipantParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateParticipant_Call) Return(participant repository.Participant, err error) *Querier_CreateParticipant_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_CreateParticipant_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateParticipantParams) (repository.Participant, error)) *Querier_CreateParticipant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateParticipationCorrection provides a mock function for the type Querier
+func (_mock *Querier) CreateParticipationCorrection(ctx context.Context, arg repository.CreateParticipationCorrectionParams) (repository.ParticipationCorrection, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateParticipationCorrection")
+	}
+
+	var r0 repository.ParticipationCorrection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateParticipationCorrectionParams) (repository.ParticipationCorrection, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateParticipationCorrectionParams) repository.ParticipationCorrection); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.ParticipationCorrection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateParticipationCorrectionParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateParticipationCorrection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateParticipationCorrection'
+type Querier_CreateParticipationCorrection_Call struct {
+	*mock.Call
+}
+
+// CreateParticipationCorrection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateParticipationCorrectionParams
+func (_e *Querier_Expecter) CreateParticipationCorrection(ctx interface{}, arg interface{}) *Querier_CreateParticipationCorrection_Call {
+	return &Querier_CreateParticipationCorrection_Call{Call: _e.mock.On("CreateParticipationCorrection", ctx, arg)}
+}
+
+func (_c *Querier_CreateParticipationCorrection_Call) Run(run func(ctx context.Context, arg repository.CreateParticipationCorrectionParams)) *Querier_CreateParticipationCorrection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateParticipationCorrectionParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateParticipationCorrectionParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateParticipationCorrection_Call) Return(participationCorrection repository.ParticipationCorrection, err error) *Querier_CreateParticipationCorrection_Call {
+	_c.Call.Return(participationCorrection, err)
+	return _c
+}
+
+func (_c *Querier_CreateParticipationCorrection_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateParticipationCorrectionParams) (repository.ParticipationCorrection, error)) *Querier_CreateParticipationCorrection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateParticipationJournalEntry provides a mock function for the type Querier
+func (_mock *Querier) CreateParticipationJournalEntry(ctx context.Context, arg repository.CreateParticipationJournalEntryParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateParticipationJournalEntry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateParticipationJournalEntryParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_CreateParticipationJournalEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateParticipationJournalEntry'
+type Querier_CreateParticipationJournalEntry_Call struct {
+	*mock.Call
+}
+
+// CreateParticipationJournalEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateParticipationJournalEntryParams
+func (_e *Querier_Expecter) CreateParticipationJournalEntry(ctx interface{}, arg interface{}) *Querier_CreateParticipationJournalEntry_Call {
+	return &Querier_CreateParticipationJournalEntry_Call{Call: _e.mock.On("CreateParticipationJournalEntry", ctx, arg)}
+}
+
+func (_c *Querier_CreateParticipationJournalEntry_Call) Run(run func(ctx context.Context, arg repository.CreateParticipationJournalEntryParams)) *Querier_CreateParticipationJournalEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateParticipationJournalEntryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateParticipationJournalEntryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateParticipationJournalEntry_Call) Return(err error) *Querier_CreateParticipationJournalEntry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_CreateParticipationJournalEntry_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateParticipationJournalEntryParams) error) *Querier_CreateParticipationJournalEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePhoneVerification provides a mock function for the type Querier
+func (_mock *Querier) CreatePhoneVerification(ctx context.Context, arg repository.CreatePhoneVerificationParams) (repository.PhoneVerification, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePhoneVerification")
+	}
+
+	var r0 repository.PhoneVerification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreatePhoneVerificationParams) (repository.PhoneVerification, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreatePhoneVerificationParams) repository.PhoneVerification); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.PhoneVerification)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreatePhoneVerificationParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreatePhoneVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePhoneVerification'
+type Querier_CreatePhoneVerification_Call struct {
+	*mock.Call
+}
+
+// CreatePhoneVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreatePhoneVerificationParams
+func (_e *Querier_Expecter) CreatePhoneVerification(ctx interface{}, arg interface{}) *Querier_CreatePhoneVerification_Call {
+	return &Querier_CreatePhoneVerification_Call{Call: _e.mock.On("CreatePhoneVerification", ctx, arg)}
+}
+
+func (_c *Querier_CreatePhoneVerification_Call) Run(run func(ctx context.Context, arg repository.CreatePhoneVerificationParams)) *Querier_CreatePhoneVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreatePhoneVerificationParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreatePhoneVerificationParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreatePhoneVerification_Call) Return(phoneVerification repository.PhoneVerification, err error) *Querier_CreatePhoneVerification_Call {
+	_c.Call.Return(phoneVerification, err)
+	return _c
+}
+
+func (_c *Querier_CreatePhoneVerification_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreatePhoneVerificationParams) (repository.PhoneVerification, error)) *Querier_CreatePhoneVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePlaceholderParticipant provides a mock function for the type Querier
+func (_mock *Querier) CreatePlaceholderParticipant(ctx context.Context, arg repository.CreatePlaceholderParticipantParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePlaceholderParticipant")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreatePlaceholderParticipantParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreatePlaceholderParticipantParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreatePlaceholderParticipantParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreatePlaceholderParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePlaceholderParticipant'
+type Querier_CreatePlaceholderParticipant_Call struct {
+	*mock.Call
+}
+
+// CreatePlaceholderParticipant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreatePlaceholderParticipantParams
+func (_e *Querier_Expecter) CreatePlaceholderParticipant(ctx interface{}, arg interface{}) *Querier_CreatePlaceholderParticipant_Call {
+	return &Querier_CreatePlaceholderParticipant_Call{Call: _e.mock.On("CreatePlaceholderParticipant", ctx, arg)}
+}
+
+func (_c *Querier_CreatePlaceholderParticipant_Call) Run(run func(ctx context.Context, arg repository.CreatePlaceholderParticipantParams)) *Querier_CreatePlaceholderParticipant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreatePlaceholderParticipantParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreatePlaceholderParticipantParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreatePlaceholderParticipant_Call) Return(participant repository.Participant, err error) *Querier_CreatePlaceholderParticipant_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_CreatePlaceholderParticipant_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreatePlaceholderParticipantParams) (repository.Participant, error)) *Querier_CreatePlaceholderParticipant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReferral provides a mock function for the type Querier
+func (_mock *Querier) CreateReferral(ctx context.Context, arg repository.CreateReferralParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReferral")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateReferralParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_CreateReferral_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReferral'
+type Querier_CreateReferral_Call struct {
+	*mock.Call
+}
+
+// CreateReferral is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateReferralParams
+func (_e *Querier_Expecter) CreateReferral(ctx interface{}, arg interface{}) *Querier_CreateReferral_Call {
+	return &Querier_CreateReferral_Call{Call: _e.mock.On("CreateReferral", ctx, arg)}
+}
+
+func (_c *Querier_CreateReferral_Call) Run(run func(ctx context.Context, arg repository.CreateReferralParams)) *Querier_CreateReferral_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateReferralParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateReferralParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateReferral_Call) Return(err error) *Querier_CreateReferral_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_CreateReferral_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateReferralParams) error) *Querier_CreateReferral_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReferralCode provides a mock function for the type Querier
+func (_mock *Querier) CreateReferralCode(ctx context.Context, arg repository.CreateReferralCodeParams) (repository.ReferralCode, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReferralCode")
+	}
+
+	var r0 repository.ReferralCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateReferralCodeParams) (repository.ReferralCode, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateReferralCodeParams) repository.ReferralCode); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.ReferralCode)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateReferralCodeParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateReferralCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReferralCode'
+type Querier_CreateReferralCode_Call struct {
+	*mock.Call
+}
+
+// CreateReferralCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateReferralCodeParams
+func (_e *Querier_Expecter) CreateReferralCode(ctx interface{}, arg interface{}) *Querier_CreateReferralCode_Call {
+	return &Querier_CreateReferralCode_Call{Call: _e.mock.On("CreateReferralCode", ctx, arg)}
+}
+
+func (_c *Querier_CreateReferralCode_Call) Run(run func(ctx context.Context, arg repository.CreateReferralCodeParams)) *Querier_CreateReferralCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateReferralCodeParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateReferralCodeParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateReferralCode_Call) Return(referralCode repository.ReferralCode, err error) *Querier_CreateReferralCode_Call {
+	_c.Call.Return(referralCode, err)
+	return _c
+}
+
+func (_c *Querier_CreateReferralCode_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateReferralCodeParams) (repository.ReferralCode, error)) *Querier_CreateReferralCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRefreshToken provides a mock function for the type Querier
+func (_mock *Querier) CreateRefreshToken(ctx context.Context, arg repository.CreateRefreshTokenParams) (repository.RefreshToken, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefreshToken")
+	}
+
+	var r0 repository.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateRefreshTokenParams) (repository.RefreshToken, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateRefreshTokenParams) repository.RefreshToken); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.RefreshToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateRefreshTokenParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
+type Querier_CreateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// CreateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateRefreshTokenParams
+func (_e *Querier_Expecter) CreateRefreshToken(ctx interface{}, arg interface{}) *Querier_CreateRefreshToken_Call {
+	return &Querier_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, arg)}
+}
+
+func (_c *Querier_CreateRefreshToken_Call) Run(run func(ctx context.Context, arg repository.CreateRefreshTokenParams)) *Querier_CreateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateRefreshTokenParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateRefreshTokenParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateRefreshToken_Call) Return(refreshToken repository.RefreshToken, err error) *Querier_CreateRefreshToken_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *Querier_CreateRefreshToken_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateRefreshTokenParams) (repository.RefreshToken, error)) *Querier_CreateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReport provides a mock function for the type Querier
+func (_mock *Querier) CreateReport(ctx context.Context, arg repository.CreateReportParams) (repository.Report, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReport")
+	}
+
+	var r0 repository.Report
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateReportParams) (repository.Report, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateReportParams) repository.Report); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Report)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateReportParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReport'
+type Querier_CreateReport_Call struct {
+	*mock.Call
+}
+
+// CreateReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateReportParams
+func (_e *Querier_Expecter) CreateReport(ctx interface{}, arg interface{}) *Querier_CreateReport_Call {
+	return &Querier_CreateReport_Call{Call: _e.mock.On("CreateReport", ctx, arg)}
+}
+
+func (_c *Querier_CreateReport_Call) Run(run func(ctx context.Context, arg repository.CreateReportParams)) *Querier_CreateReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateReportParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateReportParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateReport_Call) Return(report repository.Report, err error) *Querier_CreateReport_Call {
+	_c.Call.Return(report, err)
+	return _c
+}
+
+func (_c *Querier_CreateReport_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateReportParams) (repository.Report, error)) *Querier_CreateReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSavedSearch provides a mock function for the type Querier
+func (_mock *Querier) CreateSavedSearch(ctx context.Context, arg repository.CreateSavedSearchParams) (repository.SavedSearch, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSavedSearch")
+	}
+
+	var r0 repository.SavedSearch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateSavedSearchParams) (repository.SavedSearch, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateSavedSearchParams) repository.SavedSearch); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SavedSearch)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateSavedSearchParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSavedSearch'
+type Querier_CreateSavedSearch_Call struct {
+	*mock.Call
+}
+
+// CreateSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateSavedSearchParams
+func (_e *Querier_Expecter) CreateSavedSearch(ctx interface{}, arg interface{}) *Querier_CreateSavedSearch_Call {
+	return &Querier_CreateSavedSearch_Call{Call: _e.mock.On("CreateSavedSearch", ctx, arg)}
+}
+
+func (_c *Querier_CreateSavedSearch_Call) Run(run func(ctx context.Context, arg repository.CreateSavedSearchParams)) *Querier_CreateSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateSavedSearchParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateSavedSearchParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateSavedSearch_Call) Return(savedSearch repository.SavedSearch, err error) *Querier_CreateSavedSearch_Call {
+	_c.Call.Return(savedSearch, err)
+	return _c
+}
+
+func (_c *Querier_CreateSavedSearch_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateSavedSearchParams) (repository.SavedSearch, error)) *Querier_CreateSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTeam provides a mock function for the type Querier
+func (_mock *Querier) CreateTeam(ctx context.Context, arg repository.CreateTeamParams) (repository.Team, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeam")
+	}
+
+	var r0 repository.Team
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateTeamParams) (repository.Team, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateTeamParams) repository.Team); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Team)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateTeamParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
+type Querier_CreateTeam_Call struct {
+	*mock.Call
+}
+
+// CreateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateTeamParams
+func (_e *Querier_Expecter) CreateTeam(ctx interface{}, arg interface{}) *Querier_CreateTeam_Call {
+	return &Querier_CreateTeam_Call{Call: _e.mock.On("CreateTeam", ctx, arg)}
+}
+
+func (_c *Querier_CreateTeam_Call) Run(run func(ctx context.Context, arg repository.CreateTeamParams)) *Querier_CreateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateTeamParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateTeamParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateTeam_Call) Return(team repository.Team, err error) *Querier_CreateTeam_Call {
+	_c.Call.Return(team, err)
+	return _c
+}
+
+func (_c *Querier_CreateTeam_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateTeamParams) (repository.Team, error)) *Querier_CreateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function for the type Querier
+func (_mock *Querier) CreateUser(ctx context.Context, arg repository.CreateUserParams) (repository.User, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 repository.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateUserParams) (repository.User, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateUserParams) repository.User); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type Querier_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateUserParams
+func (_e *Querier_Expecter) CreateUser(ctx interface{}, arg interface{}) *Querier_CreateUser_Call {
+	return &Querier_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *Querier_CreateUser_Call) Run(run func(ctx context.Context, arg repository.CreateUserParams)) *Querier_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateUserParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateUser_Call) Return(user repository.User, err error) *Querier_CreateUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Querier_CreateUser_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateUserParams) (repository.User, error)) *Querier_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebAuthnChallenge provides a mock function for the type Querier
+func (_mock *Querier) CreateWebAuthnChallenge(ctx context.Context, arg repository.CreateWebAuthnChallengeParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebAuthnChallenge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateWebAuthnChallengeParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_CreateWebAuthnChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebAuthnChallenge'
+type Querier_CreateWebAuthnChallenge_Call struct {
+	*mock.Call
+}
+
+// CreateWebAuthnChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateWebAuthnChallengeParams
+func (_e *Querier_Expecter) CreateWebAuthnChallenge(ctx interface{}, arg interface{}) *Querier_CreateWebAuthnChallenge_Call {
+	return &Querier_CreateWebAuthnChallenge_Call{Call: _e.mock.On("CreateWebAuthnChallenge", ctx, arg)}
+}
+
+func (_c *Querier_CreateWebAuthnChallenge_Call) Run(run func(ctx context.Context, arg repository.CreateWebAuthnChallengeParams)) *Querier_CreateWebAuthnChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateWebAuthnChallengeParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateWebAuthnChallengeParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateWebAuthnChallenge_Call) Return(err error) *Querier_CreateWebAuthnChallenge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_CreateWebAuthnChallenge_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateWebAuthnChallengeParams) error) *Querier_CreateWebAuthnChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebAuthnCredential provides a mock function for the type Querier
+func (_mock *Querier) CreateWebAuthnCredential(ctx context.Context, arg repository.CreateWebAuthnCredentialParams) (repository.WebauthnCredential, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebAuthnCredential")
+	}
+
+	var r0 repository.WebauthnCredential
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateWebAuthnCredentialParams) (repository.WebauthnCredential, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateWebAuthnCredentialParams) repository.WebauthnCredential); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.WebauthnCredential)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateWebAuthnCredentialParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateWebAuthnCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebAuthnCredential'
+type Querier_CreateWebAuthnCredential_Call struct {
+	*mock.Call
+}
+
+// CreateWebAuthnCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateWebAuthnCredentialParams
+func (_e *Querier_Expecter) CreateWebAuthnCredential(ctx interface{}, arg interface{}) *Querier_CreateWebAuthnCredential_Call {
+	return &Querier_CreateWebAuthnCredential_Call{Call: _e.mock.On("CreateWebAuthnCredential", ctx, arg)}
+}
+
+func (_c *Querier_CreateWebAuthnCredential_Call) Run(run func(ctx context.Context, arg repository.CreateWebAuthnCredentialParams)) *Querier_CreateWebAuthnCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateWebAuthnCredentialParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateWebAuthnCredentialParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateWebAuthnCredential_Call) Return(webauthnCredential repository.WebauthnCredential, err error) *Querier_CreateWebAuthnCredential_Call {
+	_c.Call.Return(webauthnCredential, err)
+	return _c
+}
+
+func (_c *Querier_CreateWebAuthnCredential_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateWebAuthnCredentialParams) (repository.WebauthnCredential, error)) *Querier_CreateWebAuthnCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookSubscription provides a mock function for the type Querier
+func (_mock *Querier) CreateWebhookSubscription(ctx context.Context, arg repository.CreateWebhookSubscriptionParams) (repository.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookSubscription")
+	}
+
+	var r0 repository.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateWebhookSubscriptionParams) (repository.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.CreateWebhookSubscriptionParams) repository.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.WebhookSubscription)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.CreateWebhookSubscriptionParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_CreateWebhookSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookSubscription'
+type Querier_CreateWebhookSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.CreateWebhookSubscriptionParams
+func (_e *Querier_Expecter) CreateWebhookSubscription(ctx interface{}, arg interface{}) *Querier_CreateWebhookSubscription_Call {
+	return &Querier_CreateWebhookSubscription_Call{Call: _e.mock.On("CreateWebhookSubscription", ctx, arg)}
+}
+
+func (_c *Querier_CreateWebhookSubscription_Call) Run(run func(ctx context.Context, arg repository.CreateWebhookSubscriptionParams)) *Querier_CreateWebhookSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.CreateWebhookSubscriptionParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.CreateWebhookSubscriptionParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_CreateWebhookSubscription_Call) Return(webhookSubscription repository.WebhookSubscription, err error) *Querier_CreateWebhookSubscription_Call {
+	_c.Call.Return(webhookSubscription, err)
+	return _c
+}
+
+func (_c *Querier_CreateWebhookSubscription_Call) RunAndReturn(run func(ctx context.Context, arg repository.CreateWebhookSubscriptionParams) (repository.WebhookSubscription, error)) *Querier_CreateWebhookSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateUser provides a mock function for the type Querier
+func (_mock *Querier) DeactivateUser(ctx context.Context, userID pgtype.UUID) (repository.UserDeactivation, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 repository.UserDeactivation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.UserDeactivation, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.UserDeactivation); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.UserDeactivation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateUser'
+type Querier_DeactivateUser_Call struct {
+	*mock.Call
+}
+
+// DeactivateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) DeactivateUser(ctx interface{}, userID interface{}) *Querier_DeactivateUser_Call {
+	return &Querier_DeactivateUser_Call{Call: _e.mock.On("DeactivateUser", ctx, userID)}
+}
+
+func (_c *Querier_DeactivateUser_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_DeactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeactivateUser_Call) Return(userDeactivation repository.UserDeactivation, err error) *Querier_DeactivateUser_Call {
+	_c.Call.Return(userDeactivation, err)
+	return _c
+}
+
+func (_c *Querier_DeactivateUser_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.UserDeactivation, error)) *Querier_DeactivateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeadLetterNotificationDelivery provides a mock function for the type Querier
+func (_mock *Querier) DeadLetterNotificationDelivery(ctx context.Context, arg repository.DeadLetterNotificationDeliveryParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterNotificationDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.DeadLetterNotificationDeliveryParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeadLetterNotificationDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeadLetterNotificationDelivery'
+type Querier_DeadLetterNotificationDelivery_Call struct {
+	*mock.Call
+}
+
+// DeadLetterNotificationDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.DeadLetterNotificationDeliveryParams
+func (_e *Querier_Expecter) DeadLetterNotificationDelivery(ctx interface{}, arg interface{}) *Querier_DeadLetterNotificationDelivery_Call {
+	return &Querier_DeadLetterNotificationDelivery_Call{Call: _e.mock.On("DeadLetterNotificationDelivery", ctx, arg)}
+}
+
+func (_c *Querier_DeadLetterNotificationDelivery_Call) Run(run func(ctx context.Context, arg repository.DeadLetterNotificationDeliveryParams)) *Querier_DeadLetterNotificationDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.DeadLetterNotificationDeliveryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.DeadLetterNotificationDeliveryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeadLetterNotificationDelivery_Call) Return(err error) *Querier_DeadLetterNotificationDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeadLetterNotificationDelivery_Call) RunAndReturn(run func(ctx context.Context, arg repository.DeadLetterNotificationDeliveryParams) error) *Querier_DeadLetterNotificationDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredContactShareRequests provides a mock function for the type Querier
+func (_mock *Querier) DeleteExpiredContactShareRequests(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredContactShareRequests")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteExpiredContactShareRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredContactShareRequests'
+type Querier_DeleteExpiredContactShareRequests_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredContactShareRequests is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Querier_Expecter) DeleteExpiredContactShareRequests(ctx interface{}) *Querier_DeleteExpiredContactShareRequests_Call {
+	return &Querier_DeleteExpiredContactShareRequests_Call{Call: _e.mock.On("DeleteExpiredContactShareRequests", ctx)}
+}
+
+func (_c *Querier_DeleteExpiredContactShareRequests_Call) Run(run func(ctx context.Context)) *Querier_DeleteExpiredContactShareRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteExpiredContactShareRequests_Call) Return(n int64, err error) *Querier_DeleteExpiredContactShareRequests_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteExpiredContactShareRequests_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Querier_DeleteExpiredContactShareRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredWebAuthnChallenges provides a mock function for the type Querier
+func (_mock *Querier) DeleteExpiredWebAuthnChallenges(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredWebAuthnChallenges")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteExpiredWebAuthnChallenges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredWebAuthnChallenges'
+type Querier_DeleteExpiredWebAuthnChallenges_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredWebAuthnChallenges is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Querier_Expecter) DeleteExpiredWebAuthnChallenges(ctx interface{}) *Querier_DeleteExpiredWebAuthnChallenges_Call {
+	return &Querier_DeleteExpiredWebAuthnChallenges_Call{Call: _e.mock.On("DeleteExpiredWebAuthnChallenges", ctx)}
+}
+
+func (_c *Querier_DeleteExpiredWebAuthnChallenges_Call) Run(run func(ctx context.Context)) *Querier_DeleteExpiredWebAuthnChallenges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteExpiredWebAuthnChallenges_Call) Return(err error) *Querier_DeleteExpiredWebAuthnChallenges_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteExpiredWebAuthnChallenges_Call) RunAndReturn(run func(ctx context.Context) error) *Querier_DeleteExpiredWebAuthnChallenges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFailedLoginsBefore provides a mock function for the type Querier
+func (_mock *Querier) DeleteFailedLoginsBefore(ctx context.Context, attemptedAt pgtype.Timestamptz) (int64, error) {
+	ret := _mock.Called(ctx, attemptedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFailedLoginsBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) (int64, error)); ok {
+		return returnFunc(ctx, attemptedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) int64); ok {
+		r0 = returnFunc(ctx, attemptedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = returnFunc(ctx, attemptedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteFailedLoginsBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFailedLoginsBefore'
+type Querier_DeleteFailedLoginsBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteFailedLoginsBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attemptedAt pgtype.Timestamptz
+func (_e *Querier_Expecter) DeleteFailedLoginsBefore(ctx interface{}, attemptedAt interface{}) *Querier_DeleteFailedLoginsBefore_Call {
+	return &Querier_DeleteFailedLoginsBefore_Call{Call: _e.mock.On("DeleteFailedLoginsBefore", ctx, attemptedAt)}
+}
+
+func (_c *Querier_DeleteFailedLoginsBefore_Call) Run(run func(ctx context.Context, attemptedAt pgtype.Timestamptz)) *Querier_DeleteFailedLoginsBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.Timestamptz
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.Timestamptz)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteFailedLoginsBefore_Call) Return(n int64, err error) *Querier_DeleteFailedLoginsBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteFailedLoginsBefore_Call) RunAndReturn(run func(ctx context.Context, attemptedAt pgtype.Timestamptz) (int64, error)) *Querier_DeleteFailedLoginsBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGame provides a mock function for the type Querier
+func (_mock *Querier) DeleteGame(ctx context.Context, id pgtype.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGame")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGame'
+type Querier_DeleteGame_Call struct {
+	*mock.Call
+}
+
+// DeleteGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) DeleteGame(ctx interface{}, id interface{}) *Querier_DeleteGame_Call {
+	return &Querier_DeleteGame_Call{Call: _e.mock.On("DeleteGame", ctx, id)}
+}
+
+func (_c *Querier_DeleteGame_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_DeleteGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteGame_Call) Return(err error) *Querier_DeleteGame_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteGame_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_DeleteGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGameComment provides a mock function for the type Querier
+func (_mock *Querier) DeleteGameComment(ctx context.Context, arg repository.DeleteGameCommentParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGameComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.DeleteGameCommentParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteGameComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGameComment'
+type Querier_DeleteGameComment_Call struct {
+	*mock.Call
+}
+
+// DeleteGameComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.DeleteGameCommentParams
+func (_e *Querier_Expecter) DeleteGameComment(ctx interface{}, arg interface{}) *Querier_DeleteGameComment_Call {
+	return &Querier_DeleteGameComment_Call{Call: _e.mock.On("DeleteGameComment", ctx, arg)}
+}
+
+func (_c *Querier_DeleteGameComment_Call) Run(run func(ctx context.Context, arg repository.DeleteGameCommentParams)) *Querier_DeleteGameComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.DeleteGameCommentParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.DeleteGameCommentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteGameComment_Call) Return(err error) *Querier_DeleteGameComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteGameComment_Call) RunAndReturn(run func(ctx context.Context, arg repository.DeleteGameCommentParams) error) *Querier_DeleteGameComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGameReservation provides a mock function for the type Querier
+func (_mock *Querier) DeleteGameReservation(ctx context.Context, arg repository.DeleteGameReservationParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGameReservation")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.DeleteGameReservationParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.DeleteGameReservationParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.DeleteGameReservationParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteGameReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGameReservation'
+type Querier_DeleteGameReservation_Call struct {
+	*mock.Call
+}
+
+// DeleteGameReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.DeleteGameReservationParams
+func (_e *Querier_Expecter) DeleteGameReservation(ctx interface{}, arg interface{}) *Querier_DeleteGameReservation_Call {
+	return &Querier_DeleteGameReservation_Call{Call: _e.mock.On("DeleteGameReservation", ctx, arg)}
+}
+
+func (_c *Querier_DeleteGameReservation_Call) Run(run func(ctx context.Context, arg repository.DeleteGameReservationParams)) *Querier_DeleteGameReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.DeleteGameReservationParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.DeleteGameReservationParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteGameReservation_Call) Return(n int64, err error) *Querier_DeleteGameReservation_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteGameReservation_Call) RunAndReturn(run func(ctx context.Context, arg repository.DeleteGameReservationParams) (int64, error)) *Querier_DeleteGameReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGameTags provides a mock function for the type Querier
+func (_mock *Querier) DeleteGameTags(ctx context.Context, gameID pgtype.UUID) error {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGameTags")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteGameTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGameTags'
+type Querier_DeleteGameTags_Call struct {
+	*mock.Call
+}
+
+// DeleteGameTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) DeleteGameTags(ctx interface{}, gameID interface{}) *Querier_DeleteGameTags_Call {
+	return &Querier_DeleteGameTags_Call{Call: _e.mock.On("DeleteGameTags", ctx, gameID)}
+}
+
+func (_c *Querier_DeleteGameTags_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_DeleteGameTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteGameTags_Call) Return(err error) *Querier_DeleteGameTags_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteGameTags_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) error) *Querier_DeleteGameTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOldBrokerEvents provides a mock function for the type Querier
+func (_mock *Querier) DeleteOldBrokerEvents(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOldBrokerEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteOldBrokerEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOldBrokerEvents'
+type Querier_DeleteOldBrokerEvents_Call struct {
+	*mock.Call
+}
+
+// DeleteOldBrokerEvents is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Querier_Expecter) DeleteOldBrokerEvents(ctx interface{}) *Querier_DeleteOldBrokerEvents_Call {
+	return &Querier_DeleteOldBrokerEvents_Call{Call: _e.mock.On("DeleteOldBrokerEvents", ctx)}
+}
+
+func (_c *Querier_DeleteOldBrokerEvents_Call) Run(run func(ctx context.Context)) *Querier_DeleteOldBrokerEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteOldBrokerEvents_Call) Return(n int64, err error) *Querier_DeleteOldBrokerEvents_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteOldBrokerEvents_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Querier_DeleteOldBrokerEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOldDeadLetters provides a mock function for the type Querier
+func (_mock *Querier) DeleteOldDeadLetters(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOldDeadLetters")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteOldDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOldDeadLetters'
+type Querier_DeleteOldDeadLetters_Call struct {
+	*mock.Call
+}
+
+// DeleteOldDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Querier_Expecter) DeleteOldDeadLetters(ctx interface{}) *Querier_DeleteOldDeadLetters_Call {
+	return &Querier_DeleteOldDeadLetters_Call{Call: _e.mock.On("DeleteOldDeadLetters", ctx)}
+}
+
+func (_c *Querier_DeleteOldDeadLetters_Call) Run(run func(ctx context.Context)) *Querier_DeleteOldDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteOldDeadLetters_Call) Return(n int64, err error) *Querier_DeleteOldDeadLetters_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteOldDeadLetters_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Querier_DeleteOldDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOldNotificationDeliveries provides a mock function for the type Querier
+func (_mock *Querier) DeleteOldNotificationDeliveries(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOldNotificationDeliveries")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteOldNotificationDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOldNotificationDeliveries'
+type Querier_DeleteOldNotificationDeliveries_Call struct {
+	*mock.Call
+}
+
+// DeleteOldNotificationDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Querier_Expecter) DeleteOldNotificationDeliveries(ctx interface{}) *Querier_DeleteOldNotificationDeliveries_Call {
+	return &Querier_DeleteOldNotificationDeliveries_Call{Call: _e.mock.On("DeleteOldNotificationDeliveries", ctx)}
+}
+
+func (_c *Querier_DeleteOldNotificationDeliveries_Call) Run(run func(ctx context.Context)) *Querier_DeleteOldNotificationDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteOldNotificationDeliveries_Call) Return(n int64, err error) *Querier_DeleteOldNotificationDeliveries_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteOldNotificationDeliveries_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Querier_DeleteOldNotificationDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteParticipant provides a mock function for the type Querier
+func (_mock *Querier) DeleteParticipant(ctx context.Context, id pgtype.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteParticipant")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteParticipant'
+type Querier_DeleteParticipant_Call struct {
+	*mock.Call
+}
+
+// DeleteParticipant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) DeleteParticipant(ctx interface{}, id interface{}) *Querier_DeleteParticipant_Call {
+	return &Querier_DeleteParticipant_Call{Call: _e.mock.On("DeleteParticipant", ctx, id)}
+}
+
+func (_c *Querier_DeleteParticipant_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_DeleteParticipant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteParticipant_Call) Return(err error) *Querier_DeleteParticipant_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteParticipant_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_DeleteParticipant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteParticipantsByGame provides a mock function for the type Querier
+func (_mock *Querier) DeleteParticipantsByGame(ctx context.Context, gameID pgtype.UUID) error {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteParticipantsByGame")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteParticipantsByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteParticipantsByGame'
+type Querier_DeleteParticipantsByGame_Call struct {
+	*mock.Call
+}
+
+// DeleteParticipantsByGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) DeleteParticipantsByGame(ctx interface{}, gameID interface{}) *Querier_DeleteParticipantsByGame_Call {
+	return &Querier_DeleteParticipantsByGame_Call{Call: _e.mock.On("DeleteParticipantsByGame", ctx, gameID)}
+}
+
+func (_c *Querier_DeleteParticipantsByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_DeleteParticipantsByGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteParticipantsByGame_Call) Return(err error) *Querier_DeleteParticipantsByGame_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteParticipantsByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) error) *Querier_DeleteParticipantsByGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSavedSearch provides a mock function for the type Querier
+func (_mock *Querier) DeleteSavedSearch(ctx context.Context, arg repository.DeleteSavedSearchParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSavedSearch")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.DeleteSavedSearchParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.DeleteSavedSearchParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.DeleteSavedSearchParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSavedSearch'
+type Querier_DeleteSavedSearch_Call struct {
+	*mock.Call
+}
+
+// DeleteSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.DeleteSavedSearchParams
+func (_e *Querier_Expecter) DeleteSavedSearch(ctx interface{}, arg interface{}) *Querier_DeleteSavedSearch_Call {
+	return &Querier_DeleteSavedSearch_Call{Call: _e.mock.On("DeleteSavedSearch", ctx, arg)}
+}
+
+func (_c *Querier_DeleteSavedSearch_Call) Run(run func(ctx context.Context, arg repository.DeleteSavedSearchParams)) *Querier_DeleteSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.DeleteSavedSearchParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.DeleteSavedSearchParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteSavedSearch_Call) Return(n int64, err error) *Querier_DeleteSavedSearch_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteSavedSearch_Call) RunAndReturn(run func(ctx context.Context, arg repository.DeleteSavedSearchParams) (int64, error)) *Querier_DeleteSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function for the type Querier
+func (_mock *Querier) DeleteTeam(ctx context.Context, id pgtype.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type Querier_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) DeleteTeam(ctx interface{}, id interface{}) *Querier_DeleteTeam_Call {
+	return &Querier_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, id)}
+}
+
+func (_c *Querier_DeleteTeam_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteTeam_Call) Return(err error) *Querier_DeleteTeam_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteTeam_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type Querier
+func (_mock *Querier) DeleteUser(ctx context.Context, id pgtype.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type Querier_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) DeleteUser(ctx interface{}, id interface{}) *Querier_DeleteUser_Call {
+	return &Querier_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *Querier_DeleteUser_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteUser_Call) Return(err error) *Querier_DeleteUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhookSubscription provides a mock function for the type Querier
+func (_mock *Querier) DeleteWebhookSubscription(ctx context.Context, arg repository.DeleteWebhookSubscriptionParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookSubscription")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.DeleteWebhookSubscriptionParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.DeleteWebhookSubscriptionParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.DeleteWebhookSubscriptionParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_DeleteWebhookSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookSubscription'
+type Querier_DeleteWebhookSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.DeleteWebhookSubscriptionParams
+func (_e *Querier_Expecter) DeleteWebhookSubscription(ctx interface{}, arg interface{}) *Querier_DeleteWebhookSubscription_Call {
+	return &Querier_DeleteWebhookSubscription_Call{Call: _e.mock.On("DeleteWebhookSubscription", ctx, arg)}
+}
+
+func (_c *Querier_DeleteWebhookSubscription_Call) Run(run func(ctx context.Context, arg repository.DeleteWebhookSubscriptionParams)) *Querier_DeleteWebhookSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.DeleteWebhookSubscriptionParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.DeleteWebhookSubscriptionParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_DeleteWebhookSubscription_Call) Return(n int64, err error) *Querier_DeleteWebhookSubscription_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_DeleteWebhookSubscription_Call) RunAndReturn(run func(ctx context.Context, arg repository.DeleteWebhookSubscriptionParams) (int64, error)) *Querier_DeleteWebhookSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueBrokerEvent provides a mock function for the type Querier
+func (_mock *Querier) EnqueueBrokerEvent(ctx context.Context, arg repository.EnqueueBrokerEventParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueBrokerEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.EnqueueBrokerEventParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_EnqueueBrokerEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueBrokerEvent'
+type Querier_EnqueueBrokerEvent_Call struct {
+	*mock.Call
+}
+
+// EnqueueBrokerEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.EnqueueBrokerEventParams
+func (_e *Querier_Expecter) EnqueueBrokerEvent(ctx interface{}, arg interface{}) *Querier_EnqueueBrokerEvent_Call {
+	return &Querier_EnqueueBrokerEvent_Call{Call: _e.mock.On("EnqueueBrokerEvent", ctx, arg)}
+}
+
+func (_c *Querier_EnqueueBrokerEvent_Call) Run(run func(ctx context.Context, arg repository.EnqueueBrokerEventParams)) *Querier_EnqueueBrokerEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.EnqueueBrokerEventParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.EnqueueBrokerEventParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_EnqueueBrokerEvent_Call) Return(err error) *Querier_EnqueueBrokerEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_EnqueueBrokerEvent_Call) RunAndReturn(run func(ctx context.Context, arg repository.EnqueueBrokerEventParams) error) *Querier_EnqueueBrokerEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueSideEffect provides a mock function for the type Querier
+func (_mock *Querier) EnqueueSideEffect(ctx context.Context, arg repository.EnqueueSideEffectParams) (repository.SideEffect, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueSideEffect")
+	}
+
+	var r0 repository.SideEffect
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.EnqueueSideEffectParams) (repository.SideEffect, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.EnqueueSideEffectParams) repository.SideEffect); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SideEffect)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.EnqueueSideEffectParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_EnqueueSideEffect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueSideEffect'
+type Querier_EnqueueSideEffect_Call struct {
+	*mock.Call
+}
+
+// EnqueueSideEffect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.EnqueueSideEffectParams
+func (_e *Querier_Expecter) EnqueueSideEffect(ctx interface{}, arg interface{}) *Querier_EnqueueSideEffect_Call {
+	return &Querier_EnqueueSideEffect_Call{Call: _e.mock.On("EnqueueSideEffect", ctx, arg)}
+}
+
+func (_c *Querier_EnqueueSideEffect_Call) Run(run func(ctx context.Context, arg repository.EnqueueSideEffectParams)) *Querier_EnqueueSideEffect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.EnqueueSideEffectParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.EnqueueSideEffectParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_EnqueueSideEffect_Call) Return(sideEffect repository.SideEffect, err error) *Querier_EnqueueSideEffect_Call {
+	_c.Call.Return(sideEffect, err)
+	return _c
+}
+
+func (_c *Querier_EnqueueSideEffect_Call) RunAndReturn(run func(ctx context.Context, arg repository.EnqueueSideEffectParams) (repository.SideEffect, error)) *Querier_EnqueueSideEffect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueWebhookDeliveries provides a mock function for the type Querier
+func (_mock *Querier) EnqueueWebhookDeliveries(ctx context.Context, arg repository.EnqueueWebhookDeliveriesParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueWebhookDeliveries")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.EnqueueWebhookDeliveriesParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.EnqueueWebhookDeliveriesParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.EnqueueWebhookDeliveriesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_EnqueueWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueWebhookDeliveries'
+type Querier_EnqueueWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// EnqueueWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.EnqueueWebhookDeliveriesParams
+func (_e *Querier_Expecter) EnqueueWebhookDeliveries(ctx interface{}, arg interface{}) *Querier_EnqueueWebhookDeliveries_Call {
+	return &Querier_EnqueueWebhookDeliveries_Call{Call: _e.mock.On("EnqueueWebhookDeliveries", ctx, arg)}
+}
+
+func (_c *Querier_EnqueueWebhookDeliveries_Call) Run(run func(ctx context.Context, arg repository.EnqueueWebhookDeliveriesParams)) *Querier_EnqueueWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.EnqueueWebhookDeliveriesParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.EnqueueWebhookDeliveriesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_EnqueueWebhookDeliveries_Call) Return(n int64, err error) *Querier_EnqueueWebhookDeliveries_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_EnqueueWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, arg repository.EnqueueWebhookDeliveriesParams) (int64, error)) *Querier_EnqueueWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailBrokerEvent provides a mock function for the type Querier
+func (_mock *Querier) FailBrokerEvent(ctx context.Context, arg repository.FailBrokerEventParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailBrokerEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.FailBrokerEventParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_FailBrokerEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailBrokerEvent'
+type Querier_FailBrokerEvent_Call struct {
+	*mock.Call
+}
+
+// FailBrokerEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.FailBrokerEventParams
+func (_e *Querier_Expecter) FailBrokerEvent(ctx interface{}, arg interface{}) *Querier_FailBrokerEvent_Call {
+	return &Querier_FailBrokerEvent_Call{Call: _e.mock.On("FailBrokerEvent", ctx, arg)}
+}
+
+func (_c *Querier_FailBrokerEvent_Call) Run(run func(ctx context.Context, arg repository.FailBrokerEventParams)) *Querier_FailBrokerEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.FailBrokerEventParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.FailBrokerEventParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_FailBrokerEvent_Call) Return(err error) *Querier_FailBrokerEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_FailBrokerEvent_Call) RunAndReturn(run func(ctx context.Context, arg repository.FailBrokerEventParams) error) *Querier_FailBrokerEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailNotificationDelivery provides a mock function for the type Querier
+func (_mock *Querier) FailNotificationDelivery(ctx context.Context, arg repository.FailNotificationDeliveryParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailNotificationDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.FailNotificationDeliveryParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_FailNotificationDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailNotificationDelivery'
+type Querier_FailNotificationDelivery_Call struct {
+	*mock.Call
+}
+
+// FailNotificationDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.FailNotificationDeliveryParams
+func (_e *Querier_Expecter) FailNotificationDelivery(ctx interface{}, arg interface{}) *Querier_FailNotificationDelivery_Call {
+	return &Querier_FailNotificationDelivery_Call{Call: _e.mock.On("FailNotificationDelivery", ctx, arg)}
+}
+
+func (_c *Querier_FailNotificationDelivery_Call) Run(run func(ctx context.Context, arg repository.FailNotificationDeliveryParams)) *Querier_FailNotificationDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.FailNotificationDeliveryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.FailNotificationDeliveryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_FailNotificationDelivery_Call) Return(err error) *Querier_FailNotificationDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_FailNotificationDelivery_Call) RunAndReturn(run func(ctx context.Context, arg repository.FailNotificationDeliveryParams) error) *Querier_FailNotificationDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailSideEffect provides a mock function for the type Querier
+func (_mock *Querier) FailSideEffect(ctx context.Context, arg repository.FailSideEffectParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailSideEffect")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.FailSideEffectParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_FailSideEffect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailSideEffect'
+type Querier_FailSideEffect_Call struct {
+	*mock.Call
+}
+
+// FailSideEffect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.FailSideEffectParams
+func (_e *Querier_Expecter) FailSideEffect(ctx interface{}, arg interface{}) *Querier_FailSideEffect_Call {
+	return &Querier_FailSideEffect_Call{Call: _e.mock.On("FailSideEffect", ctx, arg)}
+}
+
+func (_c *Querier_FailSideEffect_Call) Run(run func(ctx context.Context, arg repository.FailSideEffectParams)) *Querier_FailSideEffect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.FailSideEffectParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.FailSideEffectParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_FailSideEffect_Call) Return(err error) *Querier_FailSideEffect_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_FailSideEffect_Call) RunAndReturn(run func(ctx context.Context, arg repository.FailSideEffectParams) error) *Querier_FailSideEffect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailWebhookDelivery provides a mock function for the type Querier
+func (_mock *Querier) FailWebhookDelivery(ctx context.Context, arg repository.FailWebhookDeliveryParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailWebhookDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.FailWebhookDeliveryParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_FailWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailWebhookDelivery'
+type Querier_FailWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// FailWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.FailWebhookDeliveryParams
+func (_e *Querier_Expecter) FailWebhookDelivery(ctx interface{}, arg interface{}) *Querier_FailWebhookDelivery_Call {
+	return &Querier_FailWebhookDelivery_Call{Call: _e.mock.On("FailWebhookDelivery", ctx, arg)}
+}
+
+func (_c *Querier_FailWebhookDelivery_Call) Run(run func(ctx context.Context, arg repository.FailWebhookDeliveryParams)) *Querier_FailWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.FailWebhookDeliveryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.FailWebhookDeliveryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_FailWebhookDelivery_Call) Return(err error) *Querier_FailWebhookDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_FailWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, arg repository.FailWebhookDeliveryParams) error) *Querier_FailWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishJobRun provides a mock function for the type Querier
+func (_mock *Querier) FinishJobRun(ctx context.Context, arg repository.FinishJobRunParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishJobRun")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.FinishJobRunParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_FinishJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishJobRun'
+type Querier_FinishJobRun_Call struct {
+	*mock.Call
+}
+
+// FinishJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.FinishJobRunParams
+func (_e *Querier_Expecter) FinishJobRun(ctx interface{}, arg interface{}) *Querier_FinishJobRun_Call {
+	return &Querier_FinishJobRun_Call{Call: _e.mock.On("FinishJobRun", ctx, arg)}
+}
+
+func (_c *Querier_FinishJobRun_Call) Run(run func(ctx context.Context, arg repository.FinishJobRunParams)) *Querier_FinishJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.FinishJobRunParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.FinishJobRunParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_FinishJobRun_Call) Return(err error) *Querier_FinishJobRun_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_FinishJobRun_Call) RunAndReturn(run func(ctx context.Context, arg repository.FinishJobRunParams) error) *Querier_FinishJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlagGameContent provides a mock function for the type Querier
+func (_mock *Querier) FlagGameContent(ctx context.Context, arg repository.FlagGameContentParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlagGameContent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.FlagGameContentParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_FlagGameContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlagGameContent'
+type Querier_FlagGameContent_Call struct {
+	*mock.Call
+}
+
+// FlagGameContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.FlagGameContentParams
+func (_e *Querier_Expecter) FlagGameContent(ctx interface{}, arg interface{}) *Querier_FlagGameContent_Call {
+	return &Querier_FlagGameContent_Call{Call: _e.mock.On("FlagGameContent", ctx, arg)}
+}
+
+func (_c *Querier_FlagGameContent_Call) Run(run func(ctx context.Context, arg repository.FlagGameContentParams)) *Querier_FlagGameContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.FlagGameContentParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.FlagGameContentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_FlagGameContent_Call) Return(err error) *Querier_FlagGameContent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_FlagGameContent_Call) RunAndReturn(run func(ctx context.Context, arg repository.FlagGameContentParams) error) *Querier_FlagGameContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttendance provides a mock function for the type Querier
+func (_mock *Querier) GetAttendance(ctx context.Context, arg repository.GetAttendanceParams) (repository.Attendance, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttendance")
+	}
+
+	var r0 repository.Attendance
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetAttendanceParams) (repository.Attendance, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetAttendanceParams) repository.Attendance); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Attendance)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.GetAttendanceParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetAttendance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttendance'
+type Querier_GetAttendance_Call struct {
+	*mock.Call
+}
+
+// GetAttendance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GetAttendanceParams
+func (_e *Querier_Expecter) GetAttendance(ctx interface{}, arg interface{}) *Querier_GetAttendance_Call {
+	return &Querier_GetAttendance_Call{Call: _e.mock.On("GetAttendance", ctx, arg)}
+}
+
+func (_c *Querier_GetAttendance_Call) Run(run func(ctx context.Context, arg repository.GetAttendanceParams)) *Querier_GetAttendance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.GetAttendanceParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.GetAttendanceParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetAttendance_Call) Return(attendance repository.Attendance, err error) *Querier_GetAttendance_Call {
+	_c.Call.Return(attendance, err)
+	return _c
+}
+
+func (_c *Querier_GetAttendance_Call) RunAndReturn(run func(ctx context.Context, arg repository.GetAttendanceParams) (repository.Attendance, error)) *Querier_GetAttendance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContactShareRequest provides a mock function for the type Querier
+func (_mock *Querier) GetContactShareRequest(ctx context.Context, gameID pgtype.UUID) (repository.ContactShareRequest, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContactShareRequest")
+	}
+
+	var r0 repository.ContactShareRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.ContactShareRequest, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.ContactShareRequest); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		r0 = ret.Get(0).(repository.ContactShareRequest)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetContactShareRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContactShareRequest'
+type Querier_GetContactShareRequest_Call struct {
+	*mock.Call
+}
+
+// GetContactShareRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) GetContactShareRequest(ctx interface{}, gameID interface{}) *Querier_GetContactShareRequest_Call {
+	return &Querier_GetContactShareRequest_Call{Call: _e.mock.On("GetContactShareRequest", ctx, gameID)}
+}
+
+func (_c *Querier_GetContactShareRequest_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_GetContactShareRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetContactShareRequest_Call) Return(contactShareRequest repository.ContactShareRequest, err error) *Querier_GetContactShareRequest_Call {
+	_c.Call.Return(contactShareRequest, err)
+	return _c
+}
+
+func (_c *Querier_GetContactShareRequest_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (repository.ContactShareRequest, error)) *Querier_GetContactShareRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConversation provides a mock function for the type Querier
+func (_mock *Querier) GetConversation(ctx context.Context, id pgtype.UUID) (repository.Conversation, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConversation")
+	}
+
+	var r0 repository.Conversation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.Conversation, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.Conversation); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Conversation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversation'
+type Querier_GetConversation_Call struct {
+	*mock.Call
+}
+
+// GetConversation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetConversation(ctx interface{}, id interface{}) *Querier_GetConversation_Call {
+	return &Querier_GetConversation_Call{Call: _e.mock.On("GetConversation", ctx, id)}
+}
+
+func (_c *Querier_GetConversation_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetConversation_Call) Return(conversation repository.Conversation, err error) *Querier_GetConversation_Call {
+	_c.Call.Return(conversation, err)
+	return _c
+}
+
+func (_c *Querier_GetConversation_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.Conversation, error)) *Querier_GetConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditBalance provides a mock function for the type Querier
+func (_mock *Querier) GetCreditBalance(ctx context.Context, userID pgtype.UUID) (int32, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditBalance")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int32, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int32); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetCreditBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditBalance'
+type Querier_GetCreditBalance_Call struct {
+	*mock.Call
+}
+
+// GetCreditBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetCreditBalance(ctx interface{}, userID interface{}) *Querier_GetCreditBalance_Call {
+	return &Querier_GetCreditBalance_Call{Call: _e.mock.On("GetCreditBalance", ctx, userID)}
+}
+
+func (_c *Querier_GetCreditBalance_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetCreditBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetCreditBalance_Call) Return(n int32, err error) *Querier_GetCreditBalance_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_GetCreditBalance_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (int32, error)) *Querier_GetCreditBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCreditBalanceForUpdate provides a mock function for the type Querier
+func (_mock *Querier) GetCreditBalanceForUpdate(ctx context.Context, userID pgtype.UUID) (int32, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditBalanceForUpdate")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int32, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int32); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetCreditBalanceForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreditBalanceForUpdate'
+type Querier_GetCreditBalanceForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetCreditBalanceForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetCreditBalanceForUpdate(ctx interface{}, userID interface{}) *Querier_GetCreditBalanceForUpdate_Call {
+	return &Querier_GetCreditBalanceForUpdate_Call{Call: _e.mock.On("GetCreditBalanceForUpdate", ctx, userID)}
+}
+
+func (_c *Querier_GetCreditBalanceForUpdate_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetCreditBalanceForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetCreditBalanceForUpdate_Call) Return(n int32, err error) *Querier_GetCreditBalanceForUpdate_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_GetCreditBalanceForUpdate_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (int32, error)) *Querier_GetCreditBalanceForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeadLetterForUpdate provides a mock function for the type Querier
+func (_mock *Querier) GetDeadLetterForUpdate(ctx context.Context, id pgtype.UUID) (repository.DeadLetter, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeadLetterForUpdate")
+	}
+
+	var r0 repository.DeadLetter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.DeadLetter, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.DeadLetter); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.DeadLetter)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetDeadLetterForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeadLetterForUpdate'
+type Querier_GetDeadLetterForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetDeadLetterForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetDeadLetterForUpdate(ctx interface{}, id interface{}) *Querier_GetDeadLetterForUpdate_Call {
+	return &Querier_GetDeadLetterForUpdate_Call{Call: _e.mock.On("GetDeadLetterForUpdate", ctx, id)}
+}
+
+func (_c *Querier_GetDeadLetterForUpdate_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetDeadLetterForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetDeadLetterForUpdate_Call) Return(deadLetter repository.DeadLetter, err error) *Querier_GetDeadLetterForUpdate_Call {
+	_c.Call.Return(deadLetter, err)
+	return _c
+}
+
+func (_c *Querier_GetDeadLetterForUpdate_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.DeadLetter, error)) *Querier_GetDeadLetterForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGame provides a mock function for the type Querier
+func (_mock *Querier) GetGame(ctx context.Context, id pgtype.UUID) (repository.GetGameRow, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGame")
+	}
+
+	var r0 repository.GetGameRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.GetGameRow, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.GetGameRow); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetGameRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGame'
+type Querier_GetGame_Call struct {
+	*mock.Call
+}
+
+// GetGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetGame(ctx interface{}, id interface{}) *Querier_GetGame_Call {
+	return &Querier_GetGame_Call{Call: _e.mock.On("GetGame", ctx, id)}
+}
+
+func (_c *Querier_GetGame_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetGame_Call) Return(getGameRow repository.GetGameRow, err error) *Querier_GetGame_Call {
+	_c.Call.Return(getGameRow, err)
+	return _c
+}
+
+func (_c *Querier_GetGame_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.GetGameRow, error)) *Querier_GetGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGameCancellationReason provides a mock function for the type Querier
+func (_mock *Querier) GetGameCancellationReason(ctx context.Context, gameID pgtype.UUID) (string, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGameCancellationReason")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (string, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) string); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetGameCancellationReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGameCancellationReason'
+type Querier_GetGameCancellationReason_Call struct {
+	*mock.Call
+}
+
+// GetGameCancellationReason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) GetGameCancellationReason(ctx interface{}, gameID interface{}) *Querier_GetGameCancellationReason_Call {
+	return &Querier_GetGameCancellationReason_Call{Call: _e.mock.On("GetGameCancellationReason", ctx, gameID)}
+}
+
+func (_c *Querier_GetGameCancellationReason_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_GetGameCancellationReason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetGameCancellationReason_Call) Return(s string, err error) *Querier_GetGameCancellationReason_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Querier_GetGameCancellationReason_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (string, error)) *Querier_GetGameCancellationReason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGameComment provides a mock function for the type Querier
+func (_mock *Querier) GetGameComment(ctx context.Context, arg repository.GetGameCommentParams) (repository.GameComment, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGameComment")
+	}
+
+	var r0 repository.GameComment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetGameCommentParams) (repository.GameComment, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetGameCommentParams) repository.GameComment); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.GameComment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.GetGameCommentParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetGameComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGameComment'
+type Querier_GetGameComment_Call struct {
+	*mock.Call
+}
+
+// GetGameComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GetGameCommentParams
+func (_e *Querier_Expecter) GetGameComment(ctx interface{}, arg interface{}) *Querier_GetGameComment_Call {
+	return &Querier_GetGameComment_Call{Call: _e.mock.On("GetGameComment", ctx, arg)}
+}
+
+func (_c *Querier_GetGameComment_Call) Run(run func(ctx context.Context, arg repository.GetGameCommentParams)) *Querier_GetGameComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.GetGameCommentParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.GetGameCommentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetGameComment_Call) Return(gameComment repository.GameComment, err error) *Querier_GetGameComment_Call {
+	_c.Call.Return(gameComment, err)
+	return _c
+}
+
+func (_c *Querier_GetGameComment_Call) RunAndReturn(run func(ctx context.Context, arg repository.GetGameCommentParams) (repository.GameComment, error)) *Querier_GetGameComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGameCreditsSpent provides a mock function for the type Querier
+func (_mock *Querier) GetGameCreditsSpent(ctx context.Context, arg repository.GetGameCreditsSpentParams) (int32, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGameCreditsSpent")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetGameCreditsSpentParams) (int32, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetGameCreditsSpentParams) int32); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.GetGameCreditsSpentParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetGameCreditsSpent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGameCreditsSpent'
+type Querier_GetGameCreditsSpent_Call struct {
+	*mock.Call
+}
+
+// GetGameCreditsSpent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GetGameCreditsSpentParams
+func (_e *Querier_Expecter) GetGameCreditsSpent(ctx interface{}, arg interface{}) *Querier_GetGameCreditsSpent_Call {
+	return &Querier_GetGameCreditsSpent_Call{Call: _e.mock.On("GetGameCreditsSpent", ctx, arg)}
+}
+
+func (_c *Querier_GetGameCreditsSpent_Call) Run(run func(ctx context.Context, arg repository.GetGameCreditsSpentParams)) *Querier_GetGameCreditsSpent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.GetGameCreditsSpentParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.GetGameCreditsSpentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetGameCreditsSpent_Call) Return(n int32, err error) *Querier_GetGameCreditsSpent_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_GetGameCreditsSpent_Call) RunAndReturn(run func(ctx context.Context, arg repository.GetGameCreditsSpentParams) (int32, error)) *Querier_GetGameCreditsSpent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGameForUpdate provides a mock function for the type Querier
+func (_mock *Querier) GetGameForUpdate(ctx context.Context, id pgtype.UUID) (repository.GetGameForUpdateRow, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGameForUpdate")
+	}
+
+	var r0 repository.GetGameForUpdateRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.GetGameForUpdateRow, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.GetGameForUpdateRow); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetGameForUpdateRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetGameForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGameForUpdate'
+type Querier_GetGameForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetGameForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetGameForUpdate(ctx interface{}, id interface{}) *Querier_GetGameForUpdate_Call {
+	return &Querier_GetGameForUpdate_Call{Call: _e.mock.On("GetGameForUpdate", ctx, id)}
+}
+
+func (_c *Querier_GetGameForUpdate_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetGameForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetGameForUpdate_Call) Return(getGameForUpdateRow repository.GetGameForUpdateRow, err error) *Querier_GetGameForUpdate_Call {
+	_c.Call.Return(getGameForUpdateRow, err)
+	return _c
+}
+
+func (_c *Querier_GetGameForUpdate_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.GetGameForUpdateRow, error)) *Querier_GetGameForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGameOwner provides a mock function for the type Querier
+func (_mock *Querier) GetGameOwner(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGameOwner")
+	}
+
+	var r0 pgtype.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (pgtype.UUID, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) pgtype.UUID); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(pgtype.UUID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetGameOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGameOwner'
+type Querier_GetGameOwner_Call struct {
+	*mock.Call
+}
+
+// GetGameOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetGameOwner(ctx interface{}, id interface{}) *Querier_GetGameOwner_Call {
+	return &Querier_GetGameOwner_Call{Call: _e.mock.On("GetGameOwner", ctx, id)}
+}
+
+func (_c *Querier_GetGameOwner_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetGameOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetGameOwner_Call) Return(uUID pgtype.UUID, err error) *Querier_GetGameOwner_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_GetGameOwner_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (pgtype.UUID, error)) *Querier_GetGameOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestMagicLinkToken provides a mock function for the type Querier
+func (_mock *Querier) GetLatestMagicLinkToken(ctx context.Context, userID pgtype.UUID) (repository.MagicLinkToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestMagicLinkToken")
+	}
+
+	var r0 repository.MagicLinkToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.MagicLinkToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.MagicLinkToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.MagicLinkToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetLatestMagicLinkToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestMagicLinkToken'
+type Querier_GetLatestMagicLinkToken_Call struct {
+	*mock.Call
+}
+
+// GetLatestMagicLinkToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetLatestMagicLinkToken(ctx interface{}, userID interface{}) *Querier_GetLatestMagicLinkToken_Call {
+	return &Querier_GetLatestMagicLinkToken_Call{Call: _e.mock.On("GetLatestMagicLinkToken", ctx, userID)}
+}
+
+func (_c *Querier_GetLatestMagicLinkToken_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetLatestMagicLinkToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetLatestMagicLinkToken_Call) Return(magicLinkToken repository.MagicLinkToken, err error) *Querier_GetLatestMagicLinkToken_Call {
+	_c.Call.Return(magicLinkToken, err)
+	return _c
+}
+
+func (_c *Querier_GetLatestMagicLinkToken_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.MagicLinkToken, error)) *Querier_GetLatestMagicLinkToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestPendingEmailChangeRequest provides a mock function for the type Querier
+func (_mock *Querier) GetLatestPendingEmailChangeRequest(ctx context.Context, userID pgtype.UUID) (repository.EmailChangeRequest, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestPendingEmailChangeRequest")
+	}
+
+	var r0 repository.EmailChangeRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.EmailChangeRequest, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.EmailChangeRequest); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.EmailChangeRequest)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetLatestPendingEmailChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestPendingEmailChangeRequest'
+type Querier_GetLatestPendingEmailChangeRequest_Call struct {
+	*mock.Call
+}
+
+// GetLatestPendingEmailChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetLatestPendingEmailChangeRequest(ctx interface{}, userID interface{}) *Querier_GetLatestPendingEmailChangeRequest_Call {
+	return &Querier_GetLatestPendingEmailChangeRequest_Call{Call: _e.mock.On("GetLatestPendingEmailChangeRequest", ctx, userID)}
+}
+
+func (_c *Querier_GetLatestPendingEmailChangeRequest_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetLatestPendingEmailChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetLatestPendingEmailChangeRequest_Call) Return(emailChangeRequest repository.EmailChangeRequest, err error) *Querier_GetLatestPendingEmailChangeRequest_Call {
+	_c.Call.Return(emailChangeRequest, err)
+	return _c
+}
+
+func (_c *Querier_GetLatestPendingEmailChangeRequest_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.EmailChangeRequest, error)) *Querier_GetLatestPendingEmailChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestPendingLoginCode provides a mock function for the type Querier
+func (_mock *Querier) GetLatestPendingLoginCode(ctx context.Context, userID pgtype.UUID) (repository.LoginCode, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestPendingLoginCode")
+	}
+
+	var r0 repository.LoginCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.LoginCode, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.LoginCode); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.LoginCode)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetLatestPendingLoginCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestPendingLoginCode'
+type Querier_GetLatestPendingLoginCode_Call struct {
+	*mock.Call
+}
+
+// GetLatestPendingLoginCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetLatestPendingLoginCode(ctx interface{}, userID interface{}) *Querier_GetLatestPendingLoginCode_Call {
+	return &Querier_GetLatestPendingLoginCode_Call{Call: _e.mock.On("GetLatestPendingLoginCode", ctx, userID)}
+}
+
+func (_c *Querier_GetLatestPendingLoginCode_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetLatestPendingLoginCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetLatestPendingLoginCode_Call) Return(loginCode repository.LoginCode, err error) *Querier_GetLatestPendingLoginCode_Call {
+	_c.Call.Return(loginCode, err)
+	return _c
+}
+
+func (_c *Querier_GetLatestPendingLoginCode_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.LoginCode, error)) *Querier_GetLatestPendingLoginCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestPendingPhoneVerification provides a mock function for the type Querier
+func (_mock *Querier) GetLatestPendingPhoneVerification(ctx context.Context, userID pgtype.UUID) (repository.PhoneVerification, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestPendingPhoneVerification")
+	}
+
+	var r0 repository.PhoneVerification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.PhoneVerification, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.PhoneVerification); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.PhoneVerification)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetLatestPendingPhoneVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestPendingPhoneVerification'
+type Querier_GetLatestPendingPhoneVerification_Call struct {
+	*mock.Call
+}
+
+// GetLatestPendingPhoneVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetLatestPendingPhoneVerification(ctx interface{}, userID interface{}) *Querier_GetLatestPendingPhoneVerification_Call {
+	return &Querier_GetLatestPendingPhoneVerification_Call{Call: _e.mock.On("GetLatestPendingPhoneVerification", ctx, userID)}
+}
+
+func (_c *Querier_GetLatestPendingPhoneVerification_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetLatestPendingPhoneVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetLatestPendingPhoneVerification_Call) Return(phoneVerification repository.PhoneVerification, err error) *Querier_GetLatestPendingPhoneVerification_Call {
+	_c.Call.Return(phoneVerification, err)
+	return _c
+}
+
+func (_c *Querier_GetLatestPendingPhoneVerification_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.PhoneVerification, error)) *Querier_GetLatestPendingPhoneVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationPreferences provides a mock function for the type Querier
+func (_mock *Querier) GetNotificationPreferences(ctx context.Context, userID pgtype.UUID) (repository.UserNotificationPreference, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreferences")
+	}
+
+	var r0 repository.UserNotificationPreference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.UserNotificationPreference, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.UserNotificationPreference); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.UserNotificationPreference)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreferences'
+type Querier_GetNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetNotificationPreferences(ctx interface{}, userID interface{}) *Querier_GetNotificationPreferences_Call {
+	return &Querier_GetNotificationPreferences_Call{Call: _e.mock.On("GetNotificationPreferences", ctx, userID)}
+}
+
+func (_c *Querier_GetNotificationPreferences_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetNotificationPreferences_Call) Return(userNotificationPreference repository.UserNotificationPreference, err error) *Querier_GetNotificationPreferences_Call {
+	_c.Call.Return(userNotificationPreference, err)
+	return _c
+}
+
+func (_c *Querier_GetNotificationPreferences_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.UserNotificationPreference, error)) *Querier_GetNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParticipant provides a mock function for the type Querier
+func (_mock *Querier) GetParticipant(ctx context.Context, id pgtype.UUID) (repository.Participant, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParticipant")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.Participant, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.Participant); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParticipant'
+type Querier_GetParticipant_Call struct {
+	*mock.Call
+}
+
+// GetParticipant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetParticipant(ctx interface{}, id interface{}) *Querier_GetParticipant_Call {
+	return &Querier_GetParticipant_Call{Call: _e.mock.On("GetParticipant", ctx, id)}
+}
+
+func (_c *Querier_GetParticipant_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetParticipant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetParticipant_Call) Return(participant repository.Participant, err error) *Querier_GetParticipant_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_GetParticipant_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.Participant, error)) *Querier_GetParticipant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParticipantByGameAndUser provides a mock function for the type Querier
+func (_mock *Querier) GetParticipantByGameAndUser(ctx context.Context, arg repository.GetParticipantByGameAndUserParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParticipantByGameAndUser")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetParticipantByGameAndUserParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetParticipantByGameAndUserParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.GetParticipantByGameAndUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetParticipantByGameAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParticipantByGameAndUser'
+type Querier_GetParticipantByGameAndUser_Call struct {
+	*mock.Call
+}
+
+// GetParticipantByGameAndUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GetParticipantByGameAndUserParams
+func (_e *Querier_Expecter) GetParticipantByGameAndUser(ctx interface{}, arg interface{}) *Querier_GetParticipantByGameAndUser_Call {
+	return &Querier_GetParticipantByGameAndUser_Call{Call: _e.mock.On("GetParticipantByGameAndUser", ctx, arg)}
+}
+
+func (_c *Querier_GetParticipantByGameAndUser_Call) Run(run func(ctx context.Context, arg repository.GetParticipantByGameAndUserParams)) *Querier_GetParticipantByGameAndUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.GetParticipantByGameAndUserParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.GetParticipantByGameAndUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetParticipantByGameAndUser_Call) Return(participant repository.Participant, err error) *Querier_GetParticipantByGameAndUser_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_GetParticipantByGameAndUser_Call) RunAndReturn(run func(ctx context.Context, arg repository.GetParticipantByGameAndUserParams) (repository.Participant, error)) *Querier_GetParticipantByGameAndUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParticipationReceipt provides a mock function for the type Querier
+func (_mock *Querier) GetParticipationReceipt(ctx context.Context, arg repository.GetParticipationReceiptParams) (repository.GetParticipationReceiptRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParticipationReceipt")
+	}
+
+	var r0 repository.GetParticipationReceiptRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetParticipationReceiptParams) (repository.GetParticipationReceiptRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GetParticipationReceiptParams) repository.GetParticipationReceiptRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.GetParticipationReceiptRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.GetParticipationReceiptParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetParticipationReceipt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParticipationReceipt'
+type Querier_GetParticipationReceipt_Call struct {
+	*mock.Call
+}
+
+// GetParticipationReceipt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GetParticipationReceiptParams
+func (_e *Querier_Expecter) GetParticipationReceipt(ctx interface{}, arg interface{}) *Querier_GetParticipationReceipt_Call {
+	return &Querier_GetParticipationReceipt_Call{Call: _e.mock.On("GetParticipationReceipt", ctx, arg)}
+}
+
+func (_c *Querier_GetParticipationReceipt_Call) Run(run func(ctx context.Context, arg repository.GetParticipationReceiptParams)) *Querier_GetParticipationReceipt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.GetParticipationReceiptParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.GetParticipationReceiptParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetParticipationReceipt_Call) Return(getParticipationReceiptRow repository.GetParticipationReceiptRow, err error) *Querier_GetParticipationReceipt_Call {
+	_c.Call.Return(getParticipationReceiptRow, err)
+	return _c
+}
+
+func (_c *Querier_GetParticipationReceipt_Call) RunAndReturn(run func(ctx context.Context, arg repository.GetParticipationReceiptParams) (repository.GetParticipationReceiptRow, error)) *Querier_GetParticipationReceipt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingEmailChangeRequestByTokenHash provides a mock function for the type Querier
+func (_mock *Querier) GetPendingEmailChangeRequestByTokenHash(ctx context.Context, tokenHash string) (repository.EmailChangeRequest, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingEmailChangeRequestByTokenHash")
+	}
+
+	var r0 repository.EmailChangeRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (repository.EmailChangeRequest, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) repository.EmailChangeRequest); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(repository.EmailChangeRequest)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetPendingEmailChangeRequestByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingEmailChangeRequestByTokenHash'
+type Querier_GetPendingEmailChangeRequestByTokenHash_Call struct {
+	*mock.Call
+}
+
+// GetPendingEmailChangeRequestByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Querier_Expecter) GetPendingEmailChangeRequestByTokenHash(ctx interface{}, tokenHash interface{}) *Querier_GetPendingEmailChangeRequestByTokenHash_Call {
+	return &Querier_GetPendingEmailChangeRequestByTokenHash_Call{Call: _e.mock.On("GetPendingEmailChangeRequestByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *Querier_GetPendingEmailChangeRequestByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_GetPendingEmailChangeRequestByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetPendingEmailChangeRequestByTokenHash_Call) Return(emailChangeRequest repository.EmailChangeRequest, err error) *Querier_GetPendingEmailChangeRequestByTokenHash_Call {
+	_c.Call.Return(emailChangeRequest, err)
+	return _c
+}
+
+func (_c *Querier_GetPendingEmailChangeRequestByTokenHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (repository.EmailChangeRequest, error)) *Querier_GetPendingEmailChangeRequestByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferralCodeByCode provides a mock function for the type Querier
+func (_mock *Querier) GetReferralCodeByCode(ctx context.Context, code string) (repository.ReferralCode, error) {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferralCodeByCode")
+	}
+
+	var r0 repository.ReferralCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (repository.ReferralCode, error)); ok {
+		return returnFunc(ctx, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) repository.ReferralCode); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		r0 = ret.Get(0).(repository.ReferralCode)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetReferralCodeByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferralCodeByCode'
+type Querier_GetReferralCodeByCode_Call struct {
+	*mock.Call
+}
+
+// GetReferralCodeByCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *Querier_Expecter) GetReferralCodeByCode(ctx interface{}, code interface{}) *Querier_GetReferralCodeByCode_Call {
+	return &Querier_GetReferralCodeByCode_Call{Call: _e.mock.On("GetReferralCodeByCode", ctx, code)}
+}
+
+func (_c *Querier_GetReferralCodeByCode_Call) Run(run func(ctx context.Context, code string)) *Querier_GetReferralCodeByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetReferralCodeByCode_Call) Return(referralCode repository.ReferralCode, err error) *Querier_GetReferralCodeByCode_Call {
+	_c.Call.Return(referralCode, err)
+	return _c
+}
+
+func (_c *Querier_GetReferralCodeByCode_Call) RunAndReturn(run func(ctx context.Context, code string) (repository.ReferralCode, error)) *Querier_GetReferralCodeByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferralCodeByUser provides a mock function for the type Querier
+func (_mock *Querier) GetReferralCodeByUser(ctx context.Context, userID pgtype.UUID) (repository.ReferralCode, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferralCodeByUser")
+	}
+
+	var r0 repository.ReferralCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.ReferralCode, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.ReferralCode); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.ReferralCode)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetReferralCodeByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferralCodeByUser'
+type Querier_GetReferralCodeByUser_Call struct {
+	*mock.Call
+}
+
+// GetReferralCodeByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetReferralCodeByUser(ctx interface{}, userID interface{}) *Querier_GetReferralCodeByUser_Call {
+	return &Querier_GetReferralCodeByUser_Call{Call: _e.mock.On("GetReferralCodeByUser", ctx, userID)}
+}
+
+func (_c *Querier_GetReferralCodeByUser_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetReferralCodeByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetReferralCodeByUser_Call) Return(referralCode repository.ReferralCode, err error) *Querier_GetReferralCodeByUser_Call {
+	_c.Call.Return(referralCode, err)
+	return _c
+}
+
+func (_c *Querier_GetReferralCodeByUser_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.ReferralCode, error)) *Querier_GetReferralCodeByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshTokenByHash provides a mock function for the type Querier
+func (_mock *Querier) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (repository.RefreshToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshTokenByHash")
+	}
+
+	var r0 repository.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (repository.RefreshToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) repository.RefreshToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(repository.RefreshToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetRefreshTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshTokenByHash'
+type Querier_GetRefreshTokenByHash_Call struct {
+	*mock.Call
+}
+
+// GetRefreshTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Querier_Expecter) GetRefreshTokenByHash(ctx interface{}, tokenHash interface{}) *Querier_GetRefreshTokenByHash_Call {
+	return &Querier_GetRefreshTokenByHash_Call{Call: _e.mock.On("GetRefreshTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *Querier_GetRefreshTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_GetRefreshTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetRefreshTokenByHash_Call) Return(refreshToken repository.RefreshToken, err error) *Querier_GetRefreshTokenByHash_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *Querier_GetRefreshTokenByHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (repository.RefreshToken, error)) *Querier_GetRefreshTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRosterSnapshot provides a mock function for the type Querier
+func (_mock *Querier) GetRosterSnapshot(ctx context.Context, gameID pgtype.UUID) (repository.RosterSnapshot, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRosterSnapshot")
+	}
+
+	var r0 repository.RosterSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.RosterSnapshot, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.RosterSnapshot); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		r0 = ret.Get(0).(repository.RosterSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetRosterSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRosterSnapshot'
+type Querier_GetRosterSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetRosterSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) GetRosterSnapshot(ctx interface{}, gameID interface{}) *Querier_GetRosterSnapshot_Call {
+	return &Querier_GetRosterSnapshot_Call{Call: _e.mock.On("GetRosterSnapshot", ctx, gameID)}
+}
+
+func (_c *Querier_GetRosterSnapshot_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_GetRosterSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetRosterSnapshot_Call) Return(rosterSnapshot repository.RosterSnapshot, err error) *Querier_GetRosterSnapshot_Call {
+	_c.Call.Return(rosterSnapshot, err)
+	return _c
+}
+
+func (_c *Querier_GetRosterSnapshot_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (repository.RosterSnapshot, error)) *Querier_GetRosterSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSMSNumber provides a mock function for the type Querier
+func (_mock *Querier) GetSMSNumber(ctx context.Context, id pgtype.UUID) (pgtype.Text, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSMSNumber")
+	}
+
+	var r0 pgtype.Text
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (pgtype.Text, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) pgtype.Text); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(pgtype.Text)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetSMSNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSMSNumber'
+type Querier_GetSMSNumber_Call struct {
+	*mock.Call
+}
+
+// GetSMSNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetSMSNumber(ctx interface{}, id interface{}) *Querier_GetSMSNumber_Call {
+	return &Querier_GetSMSNumber_Call{Call: _e.mock.On("GetSMSNumber", ctx, id)}
+}
+
+func (_c *Querier_GetSMSNumber_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetSMSNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetSMSNumber_Call) Return(text pgtype.Text, err error) *Querier_GetSMSNumber_Call {
+	_c.Call.Return(text, err)
+	return _c
+}
+
+func (_c *Querier_GetSMSNumber_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (pgtype.Text, error)) *Querier_GetSMSNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeam provides a mock function for the type Querier
+func (_mock *Querier) GetTeam(ctx context.Context, id pgtype.UUID) (repository.Team, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeam")
+	}
+
+	var r0 repository.Team
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.Team, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.Team); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.Team)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeam'
+type Querier_GetTeam_Call struct {
+	*mock.Call
+}
+
+// GetTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetTeam(ctx interface{}, id interface{}) *Querier_GetTeam_Call {
+	return &Querier_GetTeam_Call{Call: _e.mock.On("GetTeam", ctx, id)}
+}
+
+func (_c *Querier_GetTeam_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetTeam_Call) Return(team repository.Team, err error) *Querier_GetTeam_Call {
+	_c.Call.Return(team, err)
+	return _c
+}
+
+func (_c *Querier_GetTeam_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.Team, error)) *Querier_GetTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function for the type Querier
+func (_mock *Querier) GetUserByEmail(ctx context.Context, email string) (repository.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 repository.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (repository.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) repository.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Get(0).(repository.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type Querier_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *Querier_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *Querier_GetUserByEmail_Call {
+	return &Querier_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *Querier_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *Querier_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserByEmail_Call) Return(user repository.User, err error) *Querier_GetUserByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Querier_GetUserByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (repository.User, error)) *Querier_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function for the type Querier
+func (_mock *Querier) GetUserByID(ctx context.Context, id pgtype.UUID) (repository.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 repository.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type Querier_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetUserByID(ctx interface{}, id interface{}) *Querier_GetUserByID_Call {
+	return &Querier_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+}
+
+func (_c *Querier_GetUserByID_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserByID_Call) Return(user repository.User, err error) *Querier_GetUserByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Querier_GetUserByID_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.User, error)) *Querier_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByVerifiedPhone provides a mock function for the type Querier
+func (_mock *Querier) GetUserByVerifiedPhone(ctx context.Context, phoneNumber pgtype.Text) (repository.User, error) {
+	ret := _mock.Called(ctx, phoneNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByVerifiedPhone")
+	}
+
+	var r0 repository.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.Text) (repository.User, error)); ok {
+		return returnFunc(ctx, phoneNumber)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.Text) repository.User); ok {
+		r0 = returnFunc(ctx, phoneNumber)
+	} else {
+		r0 = ret.Get(0).(repository.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = returnFunc(ctx, phoneNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserByVerifiedPhone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByVerifiedPhone'
+type Querier_GetUserByVerifiedPhone_Call struct {
+	*mock.Call
+}
+
+// GetUserByVerifiedPhone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - phoneNumber pgtype.Text
+func (_e *Querier_Expecter) GetUserByVerifiedPhone(ctx interface{}, phoneNumber interface{}) *Querier_GetUserByVerifiedPhone_Call {
+	return &Querier_GetUserByVerifiedPhone_Call{Call: _e.mock.On("GetUserByVerifiedPhone", ctx, phoneNumber)}
+}
+
+func (_c *Querier_GetUserByVerifiedPhone_Call) Run(run func(ctx context.Context, phoneNumber pgtype.Text)) *Querier_GetUserByVerifiedPhone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.Text
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.Text)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserByVerifiedPhone_Call) Return(user repository.User, err error) *Querier_GetUserByVerifiedPhone_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Querier_GetUserByVerifiedPhone_Call) RunAndReturn(run func(ctx context.Context, phoneNumber pgtype.Text) (repository.User, error)) *Querier_GetUserByVerifiedPhone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserFavoriteCategory provides a mock function for the type Querier
+func (_mock *Querier) GetUserFavoriteCategory(ctx context.Context, userID pgtype.UUID) (string, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserFavoriteCategory")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (string, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) string); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserFavoriteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserFavoriteCategory'
+type Querier_GetUserFavoriteCategory_Call struct {
+	*mock.Call
+}
+
+// GetUserFavoriteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetUserFavoriteCategory(ctx interface{}, userID interface{}) *Querier_GetUserFavoriteCategory_Call {
+	return &Querier_GetUserFavoriteCategory_Call{Call: _e.mock.On("GetUserFavoriteCategory", ctx, userID)}
+}
+
+func (_c *Querier_GetUserFavoriteCategory_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetUserFavoriteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserFavoriteCategory_Call) Return(s string, err error) *Querier_GetUserFavoriteCategory_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Querier_GetUserFavoriteCategory_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (string, error)) *Querier_GetUserFavoriteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPlayStats provides a mock function for the type Querier
+func (_mock *Querier) GetUserPlayStats(ctx context.Context, userID pgtype.UUID) (repository.GetUserPlayStatsRow, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPlayStats")
+	}
+
+	var r0 repository.GetUserPlayStatsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.GetUserPlayStatsRow, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.GetUserPlayStatsRow); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.GetUserPlayStatsRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserPlayStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserPlayStats'
+type Querier_GetUserPlayStats_Call struct {
+	*mock.Call
+}
+
+// GetUserPlayStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetUserPlayStats(ctx interface{}, userID interface{}) *Querier_GetUserPlayStats_Call {
+	return &Querier_GetUserPlayStats_Call{Call: _e.mock.On("GetUserPlayStats", ctx, userID)}
+}
+
+func (_c *Querier_GetUserPlayStats_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetUserPlayStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserPlayStats_Call) Return(getUserPlayStatsRow repository.GetUserPlayStatsRow, err error) *Querier_GetUserPlayStats_Call {
+	_c.Call.Return(getUserPlayStatsRow, err)
+	return _c
+}
+
+func (_c *Querier_GetUserPlayStats_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.GetUserPlayStatsRow, error)) *Querier_GetUserPlayStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserSessionState provides a mock function for the type Querier
+func (_mock *Querier) GetUserSessionState(ctx context.Context, id pgtype.UUID) (repository.GetUserSessionStateRow, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSessionState")
+	}
+
+	var r0 repository.GetUserSessionStateRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.GetUserSessionStateRow, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.GetUserSessionStateRow); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(repository.GetUserSessionStateRow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserSessionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSessionState'
+type Querier_GetUserSessionState_Call struct {
+	*mock.Call
+}
+
+// GetUserSessionState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) GetUserSessionState(ctx interface{}, id interface{}) *Querier_GetUserSessionState_Call {
+	return &Querier_GetUserSessionState_Call{Call: _e.mock.On("GetUserSessionState", ctx, id)}
+}
+
+func (_c *Querier_GetUserSessionState_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_GetUserSessionState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserSessionState_Call) Return(getUserSessionStateRow repository.GetUserSessionStateRow, err error) *Querier_GetUserSessionState_Call {
+	_c.Call.Return(getUserSessionStateRow, err)
+	return _c
+}
+
+func (_c *Querier_GetUserSessionState_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (repository.GetUserSessionStateRow, error)) *Querier_GetUserSessionState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserSettings provides a mock function for the type Querier
+func (_mock *Querier) GetUserSettings(ctx context.Context, userID pgtype.UUID) (repository.UserSetting, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSettings")
+	}
+
+	var r0 repository.UserSetting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (repository.UserSetting, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) repository.UserSetting); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(repository.UserSetting)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetUserSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSettings'
+type Querier_GetUserSettings_Call struct {
+	*mock.Call
+}
+
+// GetUserSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) GetUserSettings(ctx interface{}, userID interface{}) *Querier_GetUserSettings_Call {
+	return &Querier_GetUserSettings_Call{Call: _e.mock.On("GetUserSettings", ctx, userID)}
+}
+
+func (_c *Querier_GetUserSettings_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_GetUserSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetUserSettings_Call) Return(userSetting repository.UserSetting, err error) *Querier_GetUserSettings_Call {
+	_c.Call.Return(userSetting, err)
+	return _c
+}
+
+func (_c *Querier_GetUserSettings_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (repository.UserSetting, error)) *Querier_GetUserSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebAuthnCredential provides a mock function for the type Querier
+func (_mock *Querier) GetWebAuthnCredential(ctx context.Context, credentialID []byte) (repository.WebauthnCredential, error) {
+	ret := _mock.Called(ctx, credentialID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebAuthnCredential")
+	}
+
+	var r0 repository.WebauthnCredential
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) (repository.WebauthnCredential, error)); ok {
+		return returnFunc(ctx, credentialID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) repository.WebauthnCredential); ok {
+		r0 = returnFunc(ctx, credentialID)
+	} else {
+		r0 = ret.Get(0).(repository.WebauthnCredential)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = returnFunc(ctx, credentialID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_GetWebAuthnCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebAuthnCredential'
+type Querier_GetWebAuthnCredential_Call struct {
+	*mock.Call
+}
+
+// GetWebAuthnCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - credentialID []byte
+func (_e *Querier_Expecter) GetWebAuthnCredential(ctx interface{}, credentialID interface{}) *Querier_GetWebAuthnCredential_Call {
+	return &Querier_GetWebAuthnCredential_Call{Call: _e.mock.On("GetWebAuthnCredential", ctx, credentialID)}
+}
+
+func (_c *Querier_GetWebAuthnCredential_Call) Run(run func(ctx context.Context, credentialID []byte)) *Querier_GetWebAuthnCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GetWebAuthnCredential_Call) Return(webauthnCredential repository.WebauthnCredential, err error) *Querier_GetWebAuthnCredential_Call {
+	_c.Call.Return(webauthnCredential, err)
+	return _c
+}
+
+func (_c *Querier_GetWebAuthnCredential_Call) RunAndReturn(run func(ctx context.Context, credentialID []byte) (repository.WebauthnCredential, error)) *Querier_GetWebAuthnCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GrantContactShareConsent provides a mock function for the type Querier
+func (_mock *Querier) GrantContactShareConsent(ctx context.Context, arg repository.GrantContactShareConsentParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantContactShareConsent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.GrantContactShareConsentParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_GrantContactShareConsent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantContactShareConsent'
+type Querier_GrantContactShareConsent_Call struct {
+	*mock.Call
+}
+
+// GrantContactShareConsent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.GrantContactShareConsentParams
+func (_e *Querier_Expecter) GrantContactShareConsent(ctx interface{}, arg interface{}) *Querier_GrantContactShareConsent_Call {
+	return &Querier_GrantContactShareConsent_Call{Call: _e.mock.On("GrantContactShareConsent", ctx, arg)}
+}
+
+func (_c *Querier_GrantContactShareConsent_Call) Run(run func(ctx context.Context, arg repository.GrantContactShareConsentParams)) *Querier_GrantContactShareConsent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.GrantContactShareConsentParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.GrantContactShareConsentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_GrantContactShareConsent_Call) Return(err error) *Querier_GrantContactShareConsent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_GrantContactShareConsent_Call) RunAndReturn(run func(ctx context.Context, arg repository.GrantContactShareConsentParams) error) *Querier_GrantContactShareConsent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasActiveReservation provides a mock function for the type Querier
+func (_mock *Querier) HasActiveReservation(ctx context.Context, arg repository.HasActiveReservationParams) (bool, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasActiveReservation")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.HasActiveReservationParams) (bool, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.HasActiveReservationParams) bool); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.HasActiveReservationParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_HasActiveReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasActiveReservation'
+type Querier_HasActiveReservation_Call struct {
+	*mock.Call
+}
+
+// HasActiveReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.HasActiveReservationParams
+func (_e *Querier_Expecter) HasActiveReservation(ctx interface{}, arg interface{}) *Querier_HasActiveReservation_Call {
+	return &Querier_HasActiveReservation_Call{Call: _e.mock.On("HasActiveReservation", ctx, arg)}
+}
+
+func (_c *Querier_HasActiveReservation_Call) Run(run func(ctx context.Context, arg repository.HasActiveReservationParams)) *Querier_HasActiveReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.HasActiveReservationParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.HasActiveReservationParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_HasActiveReservation_Call) Return(b bool, err error) *Querier_HasActiveReservation_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Querier_HasActiveReservation_Call) RunAndReturn(run func(ctx context.Context, arg repository.HasActiveReservationParams) (bool, error)) *Querier_HasActiveReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementLoginCodeAttempts provides a mock function for the type Querier
+func (_mock *Querier) IncrementLoginCodeAttempts(ctx context.Context, id pgtype.UUID) (int32, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementLoginCodeAttempts")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int32, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int32); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_IncrementLoginCodeAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementLoginCodeAttempts'
+type Querier_IncrementLoginCodeAttempts_Call struct {
+	*mock.Call
+}
+
+// IncrementLoginCodeAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) IncrementLoginCodeAttempts(ctx interface{}, id interface{}) *Querier_IncrementLoginCodeAttempts_Call {
+	return &Querier_IncrementLoginCodeAttempts_Call{Call: _e.mock.On("IncrementLoginCodeAttempts", ctx, id)}
+}
+
+func (_c *Querier_IncrementLoginCodeAttempts_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_IncrementLoginCodeAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_IncrementLoginCodeAttempts_Call) Return(n int32, err error) *Querier_IncrementLoginCodeAttempts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_IncrementLoginCodeAttempts_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (int32, error)) *Querier_IncrementLoginCodeAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementPhoneVerificationAttempts provides a mock function for the type Querier
+func (_mock *Querier) IncrementPhoneVerificationAttempts(ctx context.Context, id pgtype.UUID) (int32, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementPhoneVerificationAttempts")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int32, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int32); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_IncrementPhoneVerificationAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementPhoneVerificationAttempts'
+type Querier_IncrementPhoneVerificationAttempts_Call struct {
+	*mock.Call
+}
+
+// IncrementPhoneVerificationAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) IncrementPhoneVerificationAttempts(ctx interface{}, id interface{}) *Querier_IncrementPhoneVerificationAttempts_Call {
+	return &Querier_IncrementPhoneVerificationAttempts_Call{Call: _e.mock.On("IncrementPhoneVerificationAttempts", ctx, id)}
+}
+
+func (_c *Querier_IncrementPhoneVerificationAttempts_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_IncrementPhoneVerificationAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_IncrementPhoneVerificationAttempts_Call) Return(n int32, err error) *Querier_IncrementPhoneVerificationAttempts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_IncrementPhoneVerificationAttempts_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (int32, error)) *Querier_IncrementPhoneVerificationAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementUserTokenVersion provides a mock function for the type Querier
+func (_mock *Querier) IncrementUserTokenVersion(ctx context.Context, id pgtype.UUID) (int32, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementUserTokenVersion")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int32, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int32); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_IncrementUserTokenVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementUserTokenVersion'
+type Querier_IncrementUserTokenVersion_Call struct {
+	*mock.Call
+}
+
+// IncrementUserTokenVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) IncrementUserTokenVersion(ctx interface{}, id interface{}) *Querier_IncrementUserTokenVersion_Call {
+	return &Querier_IncrementUserTokenVersion_Call{Call: _e.mock.On("IncrementUserTokenVersion", ctx, id)}
+}
+
+func (_c *Querier_IncrementUserTokenVersion_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_IncrementUserTokenVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_IncrementUserTokenVersion_Call) Return(n int32, err error) *Querier_IncrementUserTokenVersion_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_IncrementUserTokenVersion_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) (int32, error)) *Querier_IncrementUserTokenVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsOrganizerOfParticipant provides a mock function for the type Querier
+func (_mock *Querier) IsOrganizerOfParticipant(ctx context.Context, arg repository.IsOrganizerOfParticipantParams) (bool, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsOrganizerOfParticipant")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.IsOrganizerOfParticipantParams) (bool, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.IsOrganizerOfParticipantParams) bool); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.IsOrganizerOfParticipantParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_IsOrganizerOfParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsOrganizerOfParticipant'
+type Querier_IsOrganizerOfParticipant_Call struct {
+	*mock.Call
+}
+
+// IsOrganizerOfParticipant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.IsOrganizerOfParticipantParams
+func (_e *Querier_Expecter) IsOrganizerOfParticipant(ctx interface{}, arg interface{}) *Querier_IsOrganizerOfParticipant_Call {
+	return &Querier_IsOrganizerOfParticipant_Call{Call: _e.mock.On("IsOrganizerOfParticipant", ctx, arg)}
+}
+
+func (_c *Querier_IsOrganizerOfParticipant_Call) Run(run func(ctx context.Context, arg repository.IsOrganizerOfParticipantParams)) *Querier_IsOrganizerOfParticipant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.IsOrganizerOfParticipantParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.IsOrganizerOfParticipantParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_IsOrganizerOfParticipant_Call) Return(b bool, err error) *Querier_IsOrganizerOfParticipant_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Querier_IsOrganizerOfParticipant_Call) RunAndReturn(run func(ctx context.Context, arg repository.IsOrganizerOfParticipantParams) (bool, error)) *Querier_IsOrganizerOfParticipant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsPlayerBlockedByHost provides a mock function for the type Querier
+func (_mock *Querier) IsPlayerBlockedByHost(ctx context.Context, arg repository.IsPlayerBlockedByHostParams) (bool, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsPlayerBlockedByHost")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.IsPlayerBlockedByHostParams) (bool, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.IsPlayerBlockedByHostParams) bool); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.IsPlayerBlockedByHostParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_IsPlayerBlockedByHost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPlayerBlockedByHost'
+type Querier_IsPlayerBlockedByHost_Call struct {
+	*mock.Call
+}
+
+// IsPlayerBlockedByHost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.IsPlayerBlockedByHostParams
+func (_e *Querier_Expecter) IsPlayerBlockedByHost(ctx interface{}, arg interface{}) *Querier_IsPlayerBlockedByHost_Call {
+	return &Querier_IsPlayerBlockedByHost_Call{Call: _e.mock.On("IsPlayerBlockedByHost", ctx, arg)}
+}
+
+func (_c *Querier_IsPlayerBlockedByHost_Call) Run(run func(ctx context.Context, arg repository.IsPlayerBlockedByHostParams)) *Querier_IsPlayerBlockedByHost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.IsPlayerBlockedByHostParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.IsPlayerBlockedByHostParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_IsPlayerBlockedByHost_Call) Return(b bool, err error) *Querier_IsPlayerBlockedByHost_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Querier_IsPlayerBlockedByHost_Call) RunAndReturn(run func(ctx context.Context, arg repository.IsPlayerBlockedByHostParams) (bool, error)) *Querier_IsPlayerBlockedByHost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserDeactivated provides a mock function for the type Querier
+func (_mock *Querier) IsUserDeactivated(ctx context.Context, userID pgtype.UUID) (bool, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserDeactivated")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (bool, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) bool); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_IsUserDeactivated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserDeactivated'
+type Querier_IsUserDeactivated_Call struct {
+	*mock.Call
+}
+
+// IsUserDeactivated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) IsUserDeactivated(ctx interface{}, userID interface{}) *Querier_IsUserDeactivated_Call {
+	return &Querier_IsUserDeactivated_Call{Call: _e.mock.On("IsUserDeactivated", ctx, userID)}
+}
+
+func (_c *Querier_IsUserDeactivated_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_IsUserDeactivated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_IsUserDeactivated_Call) Return(b bool, err error) *Querier_IsUserDeactivated_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Querier_IsUserDeactivated_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (bool, error)) *Querier_IsUserDeactivated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserShadowBanned provides a mock function for the type Querier
+func (_mock *Querier) IsUserShadowBanned(ctx context.Context, userID pgtype.UUID) (bool, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserShadowBanned")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (bool, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) bool); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_IsUserShadowBanned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserShadowBanned'
+type Querier_IsUserShadowBanned_Call struct {
+	*mock.Call
+}
+
+// IsUserShadowBanned is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) IsUserShadowBanned(ctx interface{}, userID interface{}) *Querier_IsUserShadowBanned_Call {
+	return &Querier_IsUserShadowBanned_Call{Call: _e.mock.On("IsUserShadowBanned", ctx, userID)}
+}
+
+func (_c *Querier_IsUserShadowBanned_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_IsUserShadowBanned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_IsUserShadowBanned_Call) Return(b bool, err error) *Querier_IsUserShadowBanned_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Querier_IsUserShadowBanned_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (bool, error)) *Querier_IsUserShadowBanned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LiftShadowBan provides a mock function for the type Querier
+func (_mock *Querier) LiftShadowBan(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LiftShadowBan")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_LiftShadowBan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LiftShadowBan'
+type Querier_LiftShadowBan_Call struct {
+	*mock.Call
+}
+
+// LiftShadowBan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) LiftShadowBan(ctx interface{}, userID interface{}) *Querier_LiftShadowBan_Call {
+	return &Querier_LiftShadowBan_Call{Call: _e.mock.On("LiftShadowBan", ctx, userID)}
+}
+
+func (_c *Querier_LiftShadowBan_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_LiftShadowBan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_LiftShadowBan_Call) Return(n int64, err error) *Querier_LiftShadowBan_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_LiftShadowBan_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (int64, error)) *Querier_LiftShadowBan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkPlaceholderParticipant provides a mock function for the type Querier
+func (_mock *Querier) LinkPlaceholderParticipant(ctx context.Context, arg repository.LinkPlaceholderParticipantParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkPlaceholderParticipant")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.LinkPlaceholderParticipantParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.LinkPlaceholderParticipantParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.LinkPlaceholderParticipantParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_LinkPlaceholderParticipant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkPlaceholderParticipant'
+type Querier_LinkPlaceholderParticipant_Call struct {
+	*mock.Call
+}
+
+// LinkPlaceholderParticipant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.LinkPlaceholderParticipantParams
+func (_e *Querier_Expecter) LinkPlaceholderParticipant(ctx interface{}, arg interface{}) *Querier_LinkPlaceholderParticipant_Call {
+	return &Querier_LinkPlaceholderParticipant_Call{Call: _e.mock.On("LinkPlaceholderParticipant", ctx, arg)}
+}
+
+func (_c *Querier_LinkPlaceholderParticipant_Call) Run(run func(ctx context.Context, arg repository.LinkPlaceholderParticipantParams)) *Querier_LinkPlaceholderParticipant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.LinkPlaceholderParticipantParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.LinkPlaceholderParticipantParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_LinkPlaceholderParticipant_Call) Return(participant repository.Participant, err error) *Querier_LinkPlaceholderParticipant_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_LinkPlaceholderParticipant_Call) RunAndReturn(run func(ctx context.Context, arg repository.LinkPlaceholderParticipantParams) (repository.Participant, error)) *Querier_LinkPlaceholderParticipant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveParticipantsByGame provides a mock function for the type Querier
+func (_mock *Querier) ListActiveParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.ListActiveParticipantsByGameRow, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveParticipantsByGame")
+	}
+
+	var r0 []repository.ListActiveParticipantsByGameRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListActiveParticipantsByGameRow, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListActiveParticipantsByGameRow); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListActiveParticipantsByGameRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListActiveParticipantsByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveParticipantsByGame'
+type Querier_ListActiveParticipantsByGame_Call struct {
+	*mock.Call
+}
+
+// ListActiveParticipantsByGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListActiveParticipantsByGame(ctx interface{}, gameID interface{}) *Querier_ListActiveParticipantsByGame_Call {
+	return &Querier_ListActiveParticipantsByGame_Call{Call: _e.mock.On("ListActiveParticipantsByGame", ctx, gameID)}
+}
+
+func (_c *Querier_ListActiveParticipantsByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListActiveParticipantsByGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListActiveParticipantsByGame_Call) Return(listActiveParticipantsByGameRows []repository.ListActiveParticipantsByGameRow, err error) *Querier_ListActiveParticipantsByGame_Call {
+	_c.Call.Return(listActiveParticipantsByGameRows, err)
+	return _c
+}
+
+func (_c *Querier_ListActiveParticipantsByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListActiveParticipantsByGameRow, error)) *Querier_ListActiveParticipantsByGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAdminAudit provides a mock function for the type Querier
+func (_mock *Querier) ListAdminAudit(ctx context.Context, arg repository.ListAdminAuditParams) ([]repository.AdminAudit, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAdminAudit")
+	}
+
+	var r0 []repository.AdminAudit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListAdminAuditParams) ([]repository.AdminAudit, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListAdminAuditParams) []repository.AdminAudit); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.AdminAudit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListAdminAuditParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListAdminAudit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAdminAudit'
+type Querier_ListAdminAudit_Call struct {
+	*mock.Call
+}
+
+// ListAdminAudit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListAdminAuditParams
+func (_e *Querier_Expecter) ListAdminAudit(ctx interface{}, arg interface{}) *Querier_ListAdminAudit_Call {
+	return &Querier_ListAdminAudit_Call{Call: _e.mock.On("ListAdminAudit", ctx, arg)}
+}
+
+func (_c *Querier_ListAdminAudit_Call) Run(run func(ctx context.Context, arg repository.ListAdminAuditParams)) *Querier_ListAdminAudit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListAdminAuditParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListAdminAuditParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListAdminAudit_Call) Return(adminAudits []repository.AdminAudit, err error) *Querier_ListAdminAudit_Call {
+	_c.Call.Return(adminAudits, err)
+	return _c
+}
+
+func (_c *Querier_ListAdminAudit_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListAdminAuditParams) ([]repository.AdminAudit, error)) *Querier_ListAdminAudit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAttendanceSummaries provides a mock function for the type Querier
+func (_mock *Querier) ListAttendanceSummaries(ctx context.Context, arg repository.ListAttendanceSummariesParams) ([]repository.ListAttendanceSummariesRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAttendanceSummaries")
+	}
+
+	var r0 []repository.ListAttendanceSummariesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListAttendanceSummariesParams) ([]repository.ListAttendanceSummariesRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListAttendanceSummariesParams) []repository.ListAttendanceSummariesRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListAttendanceSummariesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListAttendanceSummariesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListAttendanceSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAttendanceSummaries'
+type Querier_ListAttendanceSummaries_Call struct {
+	*mock.Call
+}
+
+// ListAttendanceSummaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListAttendanceSummariesParams
+func (_e *Querier_Expecter) ListAttendanceSummaries(ctx interface{}, arg interface{}) *Querier_ListAttendanceSummaries_Call {
+	return &Querier_ListAttendanceSummaries_Call{Call: _e.mock.On("ListAttendanceSummaries", ctx, arg)}
+}
+
+func (_c *Querier_ListAttendanceSummaries_Call) Run(run func(ctx context.Context, arg repository.ListAttendanceSummariesParams)) *Querier_ListAttendanceSummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListAttendanceSummariesParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListAttendanceSummariesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListAttendanceSummaries_Call) Return(listAttendanceSummariesRows []repository.ListAttendanceSummariesRow, err error) *Querier_ListAttendanceSummaries_Call {
+	_c.Call.Return(listAttendanceSummariesRows, err)
+	return _c
+}
+
+func (_c *Querier_ListAttendanceSummaries_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListAttendanceSummariesParams) ([]repository.ListAttendanceSummariesRow, error)) *Querier_ListAttendanceSummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBlockedPlayers provides a mock function for the type Querier
+func (_mock *Querier) ListBlockedPlayers(ctx context.Context, hostID pgtype.UUID) ([]repository.ListBlockedPlayersRow, error) {
+	ret := _mock.Called(ctx, hostID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlockedPlayers")
+	}
+
+	var r0 []repository.ListBlockedPlayersRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListBlockedPlayersRow, error)); ok {
+		return returnFunc(ctx, hostID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListBlockedPlayersRow); ok {
+		r0 = returnFunc(ctx, hostID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListBlockedPlayersRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, hostID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListBlockedPlayers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlockedPlayers'
+type Querier_ListBlockedPlayers_Call struct {
+	*mock.Call
+}
+
+// ListBlockedPlayers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hostID pgtype.UUID
+func (_e *Querier_Expecter) ListBlockedPlayers(ctx interface{}, hostID interface{}) *Querier_ListBlockedPlayers_Call {
+	return &Querier_ListBlockedPlayers_Call{Call: _e.mock.On("ListBlockedPlayers", ctx, hostID)}
+}
+
+func (_c *Querier_ListBlockedPlayers_Call) Run(run func(ctx context.Context, hostID pgtype.UUID)) *Querier_ListBlockedPlayers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListBlockedPlayers_Call) Return(listBlockedPlayersRows []repository.ListBlockedPlayersRow, err error) *Querier_ListBlockedPlayers_Call {
+	_c.Call.Return(listBlockedPlayersRows, err)
+	return _c
+}
+
+func (_c *Querier_ListBlockedPlayers_Call) RunAndReturn(run func(ctx context.Context, hostID pgtype.UUID) ([]repository.ListBlockedPlayersRow, error)) *Querier_ListBlockedPlayers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConversationMessages provides a mock function for the type Querier
+func (_mock *Querier) ListConversationMessages(ctx context.Context, arg repository.ListConversationMessagesParams) ([]repository.ConversationMessage, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConversationMessages")
+	}
+
+	var r0 []repository.ConversationMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListConversationMessagesParams) ([]repository.ConversationMessage, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListConversationMessagesParams) []repository.ConversationMessage); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ConversationMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListConversationMessagesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListConversationMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConversationMessages'
+type Querier_ListConversationMessages_Call struct {
+	*mock.Call
+}
+
+// ListConversationMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListConversationMessagesParams
+func (_e *Querier_Expecter) ListConversationMessages(ctx interface{}, arg interface{}) *Querier_ListConversationMessages_Call {
+	return &Querier_ListConversationMessages_Call{Call: _e.mock.On("ListConversationMessages", ctx, arg)}
+}
+
+func (_c *Querier_ListConversationMessages_Call) Run(run func(ctx context.Context, arg repository.ListConversationMessagesParams)) *Querier_ListConversationMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListConversationMessagesParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListConversationMessagesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListConversationMessages_Call) Return(conversationMessages []repository.ConversationMessage, err error) *Querier_ListConversationMessages_Call {
+	_c.Call.Return(conversationMessages, err)
+	return _c
+}
+
+func (_c *Querier_ListConversationMessages_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListConversationMessagesParams) ([]repository.ConversationMessage, error)) *Querier_ListConversationMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConversations provides a mock function for the type Querier
+func (_mock *Querier) ListConversations(ctx context.Context, arg repository.ListConversationsParams) ([]repository.ListConversationsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConversations")
+	}
+
+	var r0 []repository.ListConversationsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListConversationsParams) ([]repository.ListConversationsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListConversationsParams) []repository.ListConversationsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListConversationsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListConversationsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListConversations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConversations'
+type Querier_ListConversations_Call struct {
+	*mock.Call
+}
+
+// ListConversations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListConversationsParams
+func (_e *Querier_Expecter) ListConversations(ctx interface{}, arg interface{}) *Querier_ListConversations_Call {
+	return &Querier_ListConversations_Call{Call: _e.mock.On("ListConversations", ctx, arg)}
+}
+
+func (_c *Querier_ListConversations_Call) Run(run func(ctx context.Context, arg repository.ListConversationsParams)) *Querier_ListConversations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListConversationsParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListConversationsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListConversations_Call) Return(listConversationsRows []repository.ListConversationsRow, err error) *Querier_ListConversations_Call {
+	_c.Call.Return(listConversationsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListConversations_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListConversationsParams) ([]repository.ListConversationsRow, error)) *Querier_ListConversations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCreditTransactions provides a mock function for the type Querier
+func (_mock *Querier) ListCreditTransactions(ctx context.Context, arg repository.ListCreditTransactionsParams) ([]repository.CreditTransaction, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCreditTransactions")
+	}
+
+	var r0 []repository.CreditTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListCreditTransactionsParams) ([]repository.CreditTransaction, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListCreditTransactionsParams) []repository.CreditTransaction); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.CreditTransaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListCreditTransactionsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListCreditTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCreditTransactions'
+type Querier_ListCreditTransactions_Call struct {
+	*mock.Call
+}
+
+// ListCreditTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListCreditTransactionsParams
+func (_e *Querier_Expecter) ListCreditTransactions(ctx interface{}, arg interface{}) *Querier_ListCreditTransactions_Call {
+	return &Querier_ListCreditTransactions_Call{Call: _e.mock.On("ListCreditTransactions", ctx, arg)}
+}
+
+func (_c *Querier_ListCreditTransactions_Call) Run(run func(ctx context.Context, arg repository.ListCreditTransactionsParams)) *Querier_ListCreditTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListCreditTransactionsParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListCreditTransactionsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListCreditTransactions_Call) Return(creditTransactions []repository.CreditTransaction, err error) *Querier_ListCreditTransactions_Call {
+	_c.Call.Return(creditTransactions, err)
+	return _c
+}
+
+func (_c *Querier_ListCreditTransactions_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListCreditTransactionsParams) ([]repository.CreditTransaction, error)) *Querier_ListCreditTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCurrentLegalDocuments provides a mock function for the type Querier
+func (_mock *Querier) ListCurrentLegalDocuments(ctx context.Context) ([]repository.LegalDocument, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCurrentLegalDocuments")
+	}
+
+	var r0 []repository.LegalDocument
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]repository.LegalDocument, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []repository.LegalDocument); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.LegalDocument)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListCurrentLegalDocuments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCurrentLegalDocuments'
+type Querier_ListCurrentLegalDocuments_Call struct {
+	*mock.Call
+}
+
+// ListCurrentLegalDocuments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Querier_Expecter) ListCurrentLegalDocuments(ctx interface{}) *Querier_ListCurrentLegalDocuments_Call {
+	return &Querier_ListCurrentLegalDocuments_Call{Call: _e.mock.On("ListCurrentLegalDocuments", ctx)}
+}
+
+func (_c *Querier_ListCurrentLegalDocuments_Call) Run(run func(ctx context.Context)) *Querier_ListCurrentLegalDocuments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListCurrentLegalDocuments_Call) Return(legalDocuments []repository.LegalDocument, err error) *Querier_ListCurrentLegalDocuments_Call {
+	_c.Call.Return(legalDocuments, err)
+	return _c
+}
+
+func (_c *Querier_ListCurrentLegalDocuments_Call) RunAndReturn(run func(ctx context.Context) ([]repository.LegalDocument, error)) *Querier_ListCurrentLegalDocuments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeadLetters provides a mock function for the type Querier
+func (_mock *Querier) ListDeadLetters(ctx context.Context, arg repository.ListDeadLettersParams) ([]repository.DeadLetter, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeadLetters")
+	}
+
+	var r0 []repository.DeadLetter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListDeadLettersParams) ([]repository.DeadLetter, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListDeadLettersParams) []repository.DeadLetter); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.DeadLetter)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListDeadLettersParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeadLetters'
+type Querier_ListDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ListDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListDeadLettersParams
+func (_e *Querier_Expecter) ListDeadLetters(ctx interface{}, arg interface{}) *Querier_ListDeadLetters_Call {
+	return &Querier_ListDeadLetters_Call{Call: _e.mock.On("ListDeadLetters", ctx, arg)}
+}
+
+func (_c *Querier_ListDeadLetters_Call) Run(run func(ctx context.Context, arg repository.ListDeadLettersParams)) *Querier_ListDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListDeadLettersParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListDeadLettersParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListDeadLetters_Call) Return(deadLetters []repository.DeadLetter, err error) *Querier_ListDeadLetters_Call {
+	_c.Call.Return(deadLetters, err)
+	return _c
+}
+
+func (_c *Querier_ListDeadLetters_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListDeadLettersParams) ([]repository.DeadLetter, error)) *Querier_ListDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGameAnnouncements provides a mock function for the type Querier
+func (_mock *Querier) ListGameAnnouncements(ctx context.Context, gameID pgtype.UUID) ([]repository.GameAnnouncement, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGameAnnouncements")
+	}
+
+	var r0 []repository.GameAnnouncement
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.GameAnnouncement, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.GameAnnouncement); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.GameAnnouncement)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGameAnnouncements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGameAnnouncements'
+type Querier_ListGameAnnouncements_Call struct {
+	*mock.Call
+}
+
+// ListGameAnnouncements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListGameAnnouncements(ctx interface{}, gameID interface{}) *Querier_ListGameAnnouncements_Call {
+	return &Querier_ListGameAnnouncements_Call{Call: _e.mock.On("ListGameAnnouncements", ctx, gameID)}
+}
+
+func (_c *Querier_ListGameAnnouncements_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListGameAnnouncements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGameAnnouncements_Call) Return(gameAnnouncements []repository.GameAnnouncement, err error) *Querier_ListGameAnnouncements_Call {
+	_c.Call.Return(gameAnnouncements, err)
+	return _c
+}
+
+func (_c *Querier_ListGameAnnouncements_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.GameAnnouncement, error)) *Querier_ListGameAnnouncements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGameChangesByGame provides a mock function for the type Querier
+func (_mock *Querier) ListGameChangesByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.GameChange, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGameChangesByGame")
+	}
+
+	var r0 []repository.GameChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.GameChange, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.GameChange); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.GameChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGameChangesByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGameChangesByGame'
+type Querier_ListGameChangesByGame_Call struct {
+	*mock.Call
+}
+
+// ListGameChangesByGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListGameChangesByGame(ctx interface{}, gameID interface{}) *Querier_ListGameChangesByGame_Call {
+	return &Querier_ListGameChangesByGame_Call{Call: _e.mock.On("ListGameChangesByGame", ctx, gameID)}
+}
+
+func (_c *Querier_ListGameChangesByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListGameChangesByGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGameChangesByGame_Call) Return(gameChanges []repository.GameChange, err error) *Querier_ListGameChangesByGame_Call {
+	_c.Call.Return(gameChanges, err)
+	return _c
+}
+
+func (_c *Querier_ListGameChangesByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.GameChange, error)) *Querier_ListGameChangesByGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGameChatMessages provides a mock function for the type Querier
+func (_mock *Querier) ListGameChatMessages(ctx context.Context, arg repository.ListGameChatMessagesParams) ([]repository.ListGameChatMessagesRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGameChatMessages")
+	}
+
+	var r0 []repository.ListGameChatMessagesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListGameChatMessagesParams) ([]repository.ListGameChatMessagesRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListGameChatMessagesParams) []repository.ListGameChatMessagesRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListGameChatMessagesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListGameChatMessagesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGameChatMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGameChatMessages'
+type Querier_ListGameChatMessages_Call struct {
+	*mock.Call
+}
+
+// ListGameChatMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListGameChatMessagesParams
+func (_e *Querier_Expecter) ListGameChatMessages(ctx interface{}, arg interface{}) *Querier_ListGameChatMessages_Call {
+	return &Querier_ListGameChatMessages_Call{Call: _e.mock.On("ListGameChatMessages", ctx, arg)}
+}
+
+func (_c *Querier_ListGameChatMessages_Call) Run(run func(ctx context.Context, arg repository.ListGameChatMessagesParams)) *Querier_ListGameChatMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListGameChatMessagesParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListGameChatMessagesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGameChatMessages_Call) Return(listGameChatMessagesRows []repository.ListGameChatMessagesRow, err error) *Querier_ListGameChatMessages_Call {
+	_c.Call.Return(listGameChatMessagesRows, err)
+	return _c
+}
+
+func (_c *Querier_ListGameChatMessages_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListGameChatMessagesParams) ([]repository.ListGameChatMessagesRow, error)) *Querier_ListGameChatMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGameComments provides a mock function for the type Querier
+func (_mock *Querier) ListGameComments(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameCommentsRow, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGameComments")
+	}
+
+	var r0 []repository.ListGameCommentsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListGameCommentsRow, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListGameCommentsRow); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListGameCommentsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGameComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGameComments'
+type Querier_ListGameComments_Call struct {
+	*mock.Call
+}
+
+// ListGameComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListGameComments(ctx interface{}, gameID interface{}) *Querier_ListGameComments_Call {
+	return &Querier_ListGameComments_Call{Call: _e.mock.On("ListGameComments", ctx, gameID)}
+}
+
+func (_c *Querier_ListGameComments_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListGameComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGameComments_Call) Return(listGameCommentsRows []repository.ListGameCommentsRow, err error) *Querier_ListGameComments_Call {
+	_c.Call.Return(listGameCommentsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListGameComments_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameCommentsRow, error)) *Querier_ListGameComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGameCreditSpenders provides a mock function for the type Querier
+func (_mock *Querier) ListGameCreditSpenders(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameCreditSpendersRow, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGameCreditSpenders")
+	}
+
+	var r0 []repository.ListGameCreditSpendersRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListGameCreditSpendersRow, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListGameCreditSpendersRow); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListGameCreditSpendersRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGameCreditSpenders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGameCreditSpenders'
+type Querier_ListGameCreditSpenders_Call struct {
+	*mock.Call
+}
+
+// ListGameCreditSpenders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListGameCreditSpenders(ctx interface{}, gameID interface{}) *Querier_ListGameCreditSpenders_Call {
+	return &Querier_ListGameCreditSpenders_Call{Call: _e.mock.On("ListGameCreditSpenders", ctx, gameID)}
+}
+
+func (_c *Querier_ListGameCreditSpenders_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListGameCreditSpenders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGameCreditSpenders_Call) Return(listGameCreditSpendersRows []repository.ListGameCreditSpendersRow, err error) *Querier_ListGameCreditSpenders_Call {
+	_c.Call.Return(listGameCreditSpendersRows, err)
+	return _c
+}
+
+func (_c *Querier_ListGameCreditSpenders_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameCreditSpendersRow, error)) *Querier_ListGameCreditSpenders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGameNotificationSettings provides a mock function for the type Querier
+func (_mock *Querier) ListGameNotificationSettings(ctx context.Context, gameID pgtype.UUID) ([]repository.GameNotificationSetting, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGameNotificationSettings")
+	}
+
+	var r0 []repository.GameNotificationSetting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.GameNotificationSetting, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.GameNotificationSetting); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.GameNotificationSetting)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGameNotificationSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGameNotificationSettings'
+type Querier_ListGameNotificationSettings_Call struct {
+	*mock.Call
+}
+
+// ListGameNotificationSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListGameNotificationSettings(ctx interface{}, gameID interface{}) *Querier_ListGameNotificationSettings_Call {
+	return &Querier_ListGameNotificationSettings_Call{Call: _e.mock.On("ListGameNotificationSettings", ctx, gameID)}
+}
+
+func (_c *Querier_ListGameNotificationSettings_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListGameNotificationSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGameNotificationSettings_Call) Return(gameNotificationSettings []repository.GameNotificationSetting, err error) *Querier_ListGameNotificationSettings_Call {
+	_c.Call.Return(gameNotificationSettings, err)
+	return _c
+}
+
+func (_c *Querier_ListGameNotificationSettings_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.GameNotificationSetting, error)) *Querier_ListGameNotificationSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGamePositions provides a mock function for the type Querier
+func (_mock *Querier) ListGamePositions(ctx context.Context, gameID pgtype.UUID) ([]repository.GamePosition, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGamePositions")
+	}
+
+	var r0 []repository.GamePosition
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.GamePosition, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.GamePosition); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.GamePosition)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGamePositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGamePositions'
+type Querier_ListGamePositions_Call struct {
+	*mock.Call
+}
+
+// ListGamePositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListGamePositions(ctx interface{}, gameID interface{}) *Querier_ListGamePositions_Call {
+	return &Querier_ListGamePositions_Call{Call: _e.mock.On("ListGamePositions", ctx, gameID)}
+}
+
+func (_c *Querier_ListGamePositions_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListGamePositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGamePositions_Call) Return(gamePositions []repository.GamePosition, err error) *Querier_ListGamePositions_Call {
+	_c.Call.Return(gamePositions, err)
+	return _c
+}
+
+func (_c *Querier_ListGamePositions_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.GamePosition, error)) *Querier_ListGamePositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGameReservations provides a mock function for the type Querier
+func (_mock *Querier) ListGameReservations(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameReservationsRow, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGameReservations")
+	}
+
+	var r0 []repository.ListGameReservationsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListGameReservationsRow, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListGameReservationsRow); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListGameReservationsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGameReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGameReservations'
+type Querier_ListGameReservations_Call struct {
+	*mock.Call
+}
+
+// ListGameReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListGameReservations(ctx interface{}, gameID interface{}) *Querier_ListGameReservations_Call {
+	return &Querier_ListGameReservations_Call{Call: _e.mock.On("ListGameReservations", ctx, gameID)}
+}
+
+func (_c *Querier_ListGameReservations_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListGameReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGameReservations_Call) Return(listGameReservationsRows []repository.ListGameReservationsRow, err error) *Querier_ListGameReservations_Call {
+	_c.Call.Return(listGameReservationsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListGameReservations_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListGameReservationsRow, error)) *Querier_ListGameReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGameTags provides a mock function for the type Querier
+func (_mock *Querier) ListGameTags(ctx context.Context, gameID pgtype.UUID) ([]string, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGameTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]string, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []string); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGameTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGameTags'
+type Querier_ListGameTags_Call struct {
+	*mock.Call
+}
+
+// ListGameTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListGameTags(ctx interface{}, gameID interface{}) *Querier_ListGameTags_Call {
+	return &Querier_ListGameTags_Call{Call: _e.mock.On("ListGameTags", ctx, gameID)}
+}
+
+func (_c *Querier_ListGameTags_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListGameTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGameTags_Call) Return(strings []string, err error) *Querier_ListGameTags_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Querier_ListGameTags_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]string, error)) *Querier_ListGameTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGamesInRadius provides a mock function for the type Querier
+func (_mock *Querier) ListGamesInRadius(ctx context.Context, arg repository.ListGamesInRadiusParams) ([]repository.ListGamesInRadiusRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGamesInRadius")
+	}
+
+	var r0 []repository.ListGamesInRadiusRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListGamesInRadiusParams) ([]repository.ListGamesInRadiusRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListGamesInRadiusParams) []repository.ListGamesInRadiusRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListGamesInRadiusRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListGamesInRadiusParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGamesInRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGamesInRadius'
+type Querier_ListGamesInRadius_Call struct {
+	*mock.Call
+}
+
+// ListGamesInRadius is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListGamesInRadiusParams
+func (_e *Querier_Expecter) ListGamesInRadius(ctx interface{}, arg interface{}) *Querier_ListGamesInRadius_Call {
+	return &Querier_ListGamesInRadius_Call{Call: _e.mock.On("ListGamesInRadius", ctx, arg)}
+}
+
+func (_c *Querier_ListGamesInRadius_Call) Run(run func(ctx context.Context, arg repository.ListGamesInRadiusParams)) *Querier_ListGamesInRadius_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListGamesInRadiusParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListGamesInRadiusParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGamesInRadius_Call) Return(listGamesInRadiusRows []repository.ListGamesInRadiusRow, err error) *Querier_ListGamesInRadius_Call {
+	_c.Call.Return(listGamesInRadiusRows, err)
+	return _c
+}
+
+func (_c *Querier_ListGamesInRadius_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListGamesInRadiusParams) ([]repository.ListGamesInRadiusRow, error)) *Querier_ListGamesInRadius_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGamesWithExpiredReservations provides a mock function for the type Querier
+func (_mock *Querier) ListGamesWithExpiredReservations(ctx context.Context) ([]pgtype.UUID, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGamesWithExpiredReservations")
+	}
+
+	var r0 []pgtype.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]pgtype.UUID, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []pgtype.UUID); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pgtype.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListGamesWithExpiredReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGamesWithExpiredReservations'
+type Querier_ListGamesWithExpiredReservations_Call struct {
+	*mock.Call
+}
+
+// ListGamesWithExpiredReservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Querier_Expecter) ListGamesWithExpiredReservations(ctx interface{}) *Querier_ListGamesWithExpiredReservations_Call {
+	return &Querier_ListGamesWithExpiredReservations_Call{Call: _e.mock.On("ListGamesWithExpiredReservations", ctx)}
+}
+
+func (_c *Querier_ListGamesWithExpiredReservations_Call) Run(run func(ctx context.Context)) *Querier_ListGamesWithExpiredReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListGamesWithExpiredReservations_Call) Return(uUIDs []pgtype.UUID, err error) *Querier_ListGamesWithExpiredReservations_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *Querier_ListGamesWithExpiredReservations_Call) RunAndReturn(run func(ctx context.Context) ([]pgtype.UUID, error)) *Querier_ListGamesWithExpiredReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLeaderboard provides a mock function for the type Querier
+func (_mock *Querier) ListLeaderboard(ctx context.Context, arg repository.ListLeaderboardParams) ([]repository.ListLeaderboardRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLeaderboard")
+	}
+
+	var r0 []repository.ListLeaderboardRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListLeaderboardParams) ([]repository.ListLeaderboardRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListLeaderboardParams) []repository.ListLeaderboardRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListLeaderboardRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListLeaderboardParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLeaderboard'
+type Querier_ListLeaderboard_Call struct {
+	*mock.Call
+}
+
+// ListLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListLeaderboardParams
+func (_e *Querier_Expecter) ListLeaderboard(ctx interface{}, arg interface{}) *Querier_ListLeaderboard_Call {
+	return &Querier_ListLeaderboard_Call{Call: _e.mock.On("ListLeaderboard", ctx, arg)}
+}
+
+func (_c *Querier_ListLeaderboard_Call) Run(run func(ctx context.Context, arg repository.ListLeaderboardParams)) *Querier_ListLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListLeaderboardParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListLeaderboardParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListLeaderboard_Call) Return(listLeaderboardRows []repository.ListLeaderboardRow, err error) *Querier_ListLeaderboard_Call {
+	_c.Call.Return(listLeaderboardRows, err)
+	return _c
+}
+
+func (_c *Querier_ListLeaderboard_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListLeaderboardParams) ([]repository.ListLeaderboardRow, error)) *Querier_ListLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLegalAcceptancesByUser provides a mock function for the type Querier
+func (_mock *Querier) ListLegalAcceptancesByUser(ctx context.Context, userID pgtype.UUID) ([]repository.ListLegalAcceptancesByUserRow, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLegalAcceptancesByUser")
+	}
+
+	var r0 []repository.ListLegalAcceptancesByUserRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListLegalAcceptancesByUserRow, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListLegalAcceptancesByUserRow); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListLegalAcceptancesByUserRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListLegalAcceptancesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLegalAcceptancesByUser'
+type Querier_ListLegalAcceptancesByUser_Call struct {
+	*mock.Call
+}
+
+// ListLegalAcceptancesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ListLegalAcceptancesByUser(ctx interface{}, userID interface{}) *Querier_ListLegalAcceptancesByUser_Call {
+	return &Querier_ListLegalAcceptancesByUser_Call{Call: _e.mock.On("ListLegalAcceptancesByUser", ctx, userID)}
+}
+
+func (_c *Querier_ListLegalAcceptancesByUser_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListLegalAcceptancesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListLegalAcceptancesByUser_Call) Return(listLegalAcceptancesByUserRows []repository.ListLegalAcceptancesByUserRow, err error) *Querier_ListLegalAcceptancesByUser_Call {
+	_c.Call.Return(listLegalAcceptancesByUserRows, err)
+	return _c
+}
+
+func (_c *Querier_ListLegalAcceptancesByUser_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]repository.ListLegalAcceptancesByUserRow, error)) *Querier_ListLegalAcceptancesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNewGameAlertRecipients provides a mock function for the type Querier
+func (_mock *Querier) ListNewGameAlertRecipients(ctx context.Context, gameID pgtype.UUID) ([]repository.ListNewGameAlertRecipientsRow, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNewGameAlertRecipients")
+	}
+
+	var r0 []repository.ListNewGameAlertRecipientsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListNewGameAlertRecipientsRow, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListNewGameAlertRecipientsRow); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListNewGameAlertRecipientsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListNewGameAlertRecipients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewGameAlertRecipients'
+type Querier_ListNewGameAlertRecipients_Call struct {
+	*mock.Call
+}
+
+// ListNewGameAlertRecipients is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListNewGameAlertRecipients(ctx interface{}, gameID interface{}) *Querier_ListNewGameAlertRecipients_Call {
+	return &Querier_ListNewGameAlertRecipients_Call{Call: _e.mock.On("ListNewGameAlertRecipients", ctx, gameID)}
+}
+
+func (_c *Querier_ListNewGameAlertRecipients_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListNewGameAlertRecipients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListNewGameAlertRecipients_Call) Return(listNewGameAlertRecipientsRows []repository.ListNewGameAlertRecipientsRow, err error) *Querier_ListNewGameAlertRecipients_Call {
+	_c.Call.Return(listNewGameAlertRecipientsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListNewGameAlertRecipients_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListNewGameAlertRecipientsRow, error)) *Querier_ListNewGameAlertRecipients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOwnerUpcomingGames provides a mock function for the type Querier
+func (_mock *Querier) ListOwnerUpcomingGames(ctx context.Context, ownerID pgtype.UUID) ([]repository.ListOwnerUpcomingGamesRow, error) {
+	ret := _mock.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOwnerUpcomingGames")
+	}
+
+	var r0 []repository.ListOwnerUpcomingGamesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListOwnerUpcomingGamesRow, error)); ok {
+		return returnFunc(ctx, ownerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListOwnerUpcomingGamesRow); ok {
+		r0 = returnFunc(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListOwnerUpcomingGamesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListOwnerUpcomingGames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOwnerUpcomingGames'
+type Querier_ListOwnerUpcomingGames_Call struct {
+	*mock.Call
+}
+
+// ListOwnerUpcomingGames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID pgtype.UUID
+func (_e *Querier_Expecter) ListOwnerUpcomingGames(ctx interface{}, ownerID interface{}) *Querier_ListOwnerUpcomingGames_Call {
+	return &Querier_ListOwnerUpcomingGames_Call{Call: _e.mock.On("ListOwnerUpcomingGames", ctx, ownerID)}
+}
+
+func (_c *Querier_ListOwnerUpcomingGames_Call) Run(run func(ctx context.Context, ownerID pgtype.UUID)) *Querier_ListOwnerUpcomingGames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListOwnerUpcomingGames_Call) Return(listOwnerUpcomingGamesRows []repository.ListOwnerUpcomingGamesRow, err error) *Querier_ListOwnerUpcomingGames_Call {
+	_c.Call.Return(listOwnerUpcomingGamesRows, err)
+	return _c
+}
+
+func (_c *Querier_ListOwnerUpcomingGames_Call) RunAndReturn(run func(ctx context.Context, ownerID pgtype.UUID) ([]repository.ListOwnerUpcomingGamesRow, error)) *Querier_ListOwnerUpcomingGames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParticipantsByGame provides a mock function for the type Querier
+func (_mock *Querier) ListParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.ListParticipantsByGameRow, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParticipantsByGame")
+	}
+
+	var r0 []repository.ListParticipantsByGameRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListParticipantsByGameRow, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListParticipantsByGameRow); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListParticipantsByGameRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListParticipantsByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParticipantsByGame'
+type Querier_ListParticipantsByGame_Call struct {
+	*mock.Call
+}
+
+// ListParticipantsByGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListParticipantsByGame(ctx interface{}, gameID interface{}) *Querier_ListParticipantsByGame_Call {
+	return &Querier_ListParticipantsByGame_Call{Call: _e.mock.On("ListParticipantsByGame", ctx, gameID)}
+}
+
+func (_c *Querier_ListParticipantsByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListParticipantsByGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListParticipantsByGame_Call) Return(listParticipantsByGameRows []repository.ListParticipantsByGameRow, err error) *Querier_ListParticipantsByGame_Call {
+	_c.Call.Return(listParticipantsByGameRows, err)
+	return _c
+}
+
+func (_c *Querier_ListParticipantsByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListParticipantsByGameRow, error)) *Querier_ListParticipantsByGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParticipantsByGamePage provides a mock function for the type Querier
+func (_mock *Querier) ListParticipantsByGamePage(ctx context.Context, arg repository.ListParticipantsByGamePageParams) ([]repository.ListParticipantsByGamePageRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParticipantsByGamePage")
+	}
+
+	var r0 []repository.ListParticipantsByGamePageRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListParticipantsByGamePageParams) ([]repository.ListParticipantsByGamePageRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListParticipantsByGamePageParams) []repository.ListParticipantsByGamePageRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListParticipantsByGamePageRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListParticipantsByGamePageParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListParticipantsByGamePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParticipantsByGamePage'
+type Querier_ListParticipantsByGamePage_Call struct {
+	*mock.Call
+}
+
+// ListParticipantsByGamePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListParticipantsByGamePageParams
+func (_e *Querier_Expecter) ListParticipantsByGamePage(ctx interface{}, arg interface{}) *Querier_ListParticipantsByGamePage_Call {
+	return &Querier_ListParticipantsByGamePage_Call{Call: _e.mock.On("ListParticipantsByGamePage", ctx, arg)}
+}
+
+func (_c *Querier_ListParticipantsByGamePage_Call) Run(run func(ctx context.Context, arg repository.ListParticipantsByGamePageParams)) *Querier_ListParticipantsByGamePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListParticipantsByGamePageParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListParticipantsByGamePageParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListParticipantsByGamePage_Call) Return(listParticipantsByGamePageRows []repository.ListParticipantsByGamePageRow, err error) *Querier_ListParticipantsByGamePage_Call {
+	_c.Call.Return(listParticipantsByGamePageRows, err)
+	return _c
+}
+
+func (_c *Querier_ListParticipantsByGamePage_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListParticipantsByGamePageParams) ([]repository.ListParticipantsByGamePageRow, error)) *Querier_ListParticipantsByGamePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParticipantsByGames provides a mock function for the type Querier
+func (_mock *Querier) ListParticipantsByGames(ctx context.Context, gameIds []pgtype.UUID) ([]repository.ListParticipantsByGamesRow, error) {
+	ret := _mock.Called(ctx, gameIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParticipantsByGames")
+	}
+
+	var r0 []repository.ListParticipantsByGamesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []pgtype.UUID) ([]repository.ListParticipantsByGamesRow, error)); ok {
+		return returnFunc(ctx, gameIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []pgtype.UUID) []repository.ListParticipantsByGamesRow); ok {
+		r0 = returnFunc(ctx, gameIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListParticipantsByGamesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListParticipantsByGames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParticipantsByGames'
+type Querier_ListParticipantsByGames_Call struct {
+	*mock.Call
+}
+
+// ListParticipantsByGames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameIds []pgtype.UUID
+func (_e *Querier_Expecter) ListParticipantsByGames(ctx interface{}, gameIds interface{}) *Querier_ListParticipantsByGames_Call {
+	return &Querier_ListParticipantsByGames_Call{Call: _e.mock.On("ListParticipantsByGames", ctx, gameIds)}
+}
+
+func (_c *Querier_ListParticipantsByGames_Call) Run(run func(ctx context.Context, gameIds []pgtype.UUID)) *Querier_ListParticipantsByGames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListParticipantsByGames_Call) Return(listParticipantsByGamesRows []repository.ListParticipantsByGamesRow, err error) *Querier_ListParticipantsByGames_Call {
+	_c.Call.Return(listParticipantsByGamesRows, err)
+	return _c
+}
+
+func (_c *Querier_ListParticipantsByGames_Call) RunAndReturn(run func(ctx context.Context, gameIds []pgtype.UUID) ([]repository.ListParticipantsByGamesRow, error)) *Querier_ListParticipantsByGames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParticipantsByUser provides a mock function for the type Querier
+func (_mock *Querier) ListParticipantsByUser(ctx context.Context, userID pgtype.UUID) ([]repository.Participant, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParticipantsByUser")
+	}
+
+	var r0 []repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.Participant, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.Participant); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.Participant)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListParticipantsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParticipantsByUser'
+type Querier_ListParticipantsByUser_Call struct {
+	*mock.Call
+}
+
+// ListParticipantsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ListParticipantsByUser(ctx interface{}, userID interface{}) *Querier_ListParticipantsByUser_Call {
+	return &Querier_ListParticipantsByUser_Call{Call: _e.mock.On("ListParticipantsByUser", ctx, userID)}
+}
+
+func (_c *Querier_ListParticipantsByUser_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListParticipantsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListParticipantsByUser_Call) Return(participants []repository.Participant, err error) *Querier_ListParticipantsByUser_Call {
+	_c.Call.Return(participants, err)
+	return _c
+}
+
+func (_c *Querier_ListParticipantsByUser_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]repository.Participant, error)) *Querier_ListParticipantsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParticipationCorrections provides a mock function for the type Querier
+func (_mock *Querier) ListParticipationCorrections(ctx context.Context, gameID pgtype.UUID) ([]repository.ParticipationCorrection, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParticipationCorrections")
+	}
+
+	var r0 []repository.ParticipationCorrection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ParticipationCorrection, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ParticipationCorrection); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ParticipationCorrection)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListParticipationCorrections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParticipationCorrections'
+type Querier_ListParticipationCorrections_Call struct {
+	*mock.Call
+}
+
+// ListParticipationCorrections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListParticipationCorrections(ctx interface{}, gameID interface{}) *Querier_ListParticipationCorrections_Call {
+	return &Querier_ListParticipationCorrections_Call{Call: _e.mock.On("ListParticipationCorrections", ctx, gameID)}
+}
+
+func (_c *Querier_ListParticipationCorrections_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListParticipationCorrections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListParticipationCorrections_Call) Return(participationCorrections []repository.ParticipationCorrection, err error) *Querier_ListParticipationCorrections_Call {
+	_c.Call.Return(participationCorrections, err)
+	return _c
+}
+
+func (_c *Querier_ListParticipationCorrections_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ParticipationCorrection, error)) *Querier_ListParticipationCorrections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParticipationJournalByGame provides a mock function for the type Querier
+func (_mock *Querier) ListParticipationJournalByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.ParticipationJournal, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParticipationJournalByGame")
+	}
+
+	var r0 []repository.ParticipationJournal
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ParticipationJournal, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ParticipationJournal); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ParticipationJournal)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListParticipationJournalByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParticipationJournalByGame'
+type Querier_ListParticipationJournalByGame_Call struct {
+	*mock.Call
+}
+
+// ListParticipationJournalByGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListParticipationJournalByGame(ctx interface{}, gameID interface{}) *Querier_ListParticipationJournalByGame_Call {
+	return &Querier_ListParticipationJournalByGame_Call{Call: _e.mock.On("ListParticipationJournalByGame", ctx, gameID)}
+}
+
+func (_c *Querier_ListParticipationJournalByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListParticipationJournalByGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListParticipationJournalByGame_Call) Return(participationJournals []repository.ParticipationJournal, err error) *Querier_ListParticipationJournalByGame_Call {
+	_c.Call.Return(participationJournals, err)
+	return _c
+}
+
+func (_c *Querier_ListParticipationJournalByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ParticipationJournal, error)) *Querier_ListParticipationJournalByGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingLegalDocuments provides a mock function for the type Querier
+func (_mock *Querier) ListPendingLegalDocuments(ctx context.Context, userID pgtype.UUID) ([]repository.LegalDocument, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingLegalDocuments")
+	}
+
+	var r0 []repository.LegalDocument
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.LegalDocument, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.LegalDocument); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.LegalDocument)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListPendingLegalDocuments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingLegalDocuments'
+type Querier_ListPendingLegalDocuments_Call struct {
+	*mock.Call
+}
+
+// ListPendingLegalDocuments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ListPendingLegalDocuments(ctx interface{}, userID interface{}) *Querier_ListPendingLegalDocuments_Call {
+	return &Querier_ListPendingLegalDocuments_Call{Call: _e.mock.On("ListPendingLegalDocuments", ctx, userID)}
+}
+
+func (_c *Querier_ListPendingLegalDocuments_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListPendingLegalDocuments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListPendingLegalDocuments_Call) Return(legalDocuments []repository.LegalDocument, err error) *Querier_ListPendingLegalDocuments_Call {
+	_c.Call.Return(legalDocuments, err)
+	return _c
+}
+
+func (_c *Querier_ListPendingLegalDocuments_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]repository.LegalDocument, error)) *Querier_ListPendingLegalDocuments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPlayerReliability provides a mock function for the type Querier
+func (_mock *Querier) ListPlayerReliability(ctx context.Context, userIds []pgtype.UUID) ([]repository.PlayerReliability, error) {
+	ret := _mock.Called(ctx, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPlayerReliability")
+	}
+
+	var r0 []repository.PlayerReliability
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []pgtype.UUID) ([]repository.PlayerReliability, error)); ok {
+		return returnFunc(ctx, userIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []pgtype.UUID) []repository.PlayerReliability); ok {
+		r0 = returnFunc(ctx, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.PlayerReliability)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListPlayerReliability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPlayerReliability'
+type Querier_ListPlayerReliability_Call struct {
+	*mock.Call
+}
+
+// ListPlayerReliability is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIds []pgtype.UUID
+func (_e *Querier_Expecter) ListPlayerReliability(ctx interface{}, userIds interface{}) *Querier_ListPlayerReliability_Call {
+	return &Querier_ListPlayerReliability_Call{Call: _e.mock.On("ListPlayerReliability", ctx, userIds)}
+}
+
+func (_c *Querier_ListPlayerReliability_Call) Run(run func(ctx context.Context, userIds []pgtype.UUID)) *Querier_ListPlayerReliability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListPlayerReliability_Call) Return(playerReliabilitys []repository.PlayerReliability, err error) *Querier_ListPlayerReliability_Call {
+	_c.Call.Return(playerReliabilitys, err)
+	return _c
+}
+
+func (_c *Querier_ListPlayerReliability_Call) RunAndReturn(run func(ctx context.Context, userIds []pgtype.UUID) ([]repository.PlayerReliability, error)) *Querier_ListPlayerReliability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentFailedLoginsByEmail provides a mock function for the type Querier
+func (_mock *Querier) ListRecentFailedLoginsByEmail(ctx context.Context, arg repository.ListRecentFailedLoginsByEmailParams) ([]pgtype.Timestamptz, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentFailedLoginsByEmail")
+	}
+
+	var r0 []pgtype.Timestamptz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListRecentFailedLoginsByEmailParams) ([]pgtype.Timestamptz, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListRecentFailedLoginsByEmailParams) []pgtype.Timestamptz); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pgtype.Timestamptz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListRecentFailedLoginsByEmailParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListRecentFailedLoginsByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecentFailedLoginsByEmail'
+type Querier_ListRecentFailedLoginsByEmail_Call struct {
+	*mock.Call
+}
+
+// ListRecentFailedLoginsByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListRecentFailedLoginsByEmailParams
+func (_e *Querier_Expecter) ListRecentFailedLoginsByEmail(ctx interface{}, arg interface{}) *Querier_ListRecentFailedLoginsByEmail_Call {
+	return &Querier_ListRecentFailedLoginsByEmail_Call{Call: _e.mock.On("ListRecentFailedLoginsByEmail", ctx, arg)}
+}
+
+func (_c *Querier_ListRecentFailedLoginsByEmail_Call) Run(run func(ctx context.Context, arg repository.ListRecentFailedLoginsByEmailParams)) *Querier_ListRecentFailedLoginsByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListRecentFailedLoginsByEmailParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListRecentFailedLoginsByEmailParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListRecentFailedLoginsByEmail_Call) Return(timestamptzs []pgtype.Timestamptz, err error) *Querier_ListRecentFailedLoginsByEmail_Call {
+	_c.Call.Return(timestamptzs, err)
+	return _c
+}
+
+func (_c *Querier_ListRecentFailedLoginsByEmail_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListRecentFailedLoginsByEmailParams) ([]pgtype.Timestamptz, error)) *Querier_ListRecentFailedLoginsByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentFailedLoginsByIP provides a mock function for the type Querier
+func (_mock *Querier) ListRecentFailedLoginsByIP(ctx context.Context, arg repository.ListRecentFailedLoginsByIPParams) ([]pgtype.Timestamptz, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentFailedLoginsByIP")
+	}
+
+	var r0 []pgtype.Timestamptz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListRecentFailedLoginsByIPParams) ([]pgtype.Timestamptz, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListRecentFailedLoginsByIPParams) []pgtype.Timestamptz); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pgtype.Timestamptz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListRecentFailedLoginsByIPParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListRecentFailedLoginsByIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecentFailedLoginsByIP'
+type Querier_ListRecentFailedLoginsByIP_Call struct {
+	*mock.Call
+}
+
+// ListRecentFailedLoginsByIP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListRecentFailedLoginsByIPParams
+func (_e *Querier_Expecter) ListRecentFailedLoginsByIP(ctx interface{}, arg interface{}) *Querier_ListRecentFailedLoginsByIP_Call {
+	return &Querier_ListRecentFailedLoginsByIP_Call{Call: _e.mock.On("ListRecentFailedLoginsByIP", ctx, arg)}
+}
+
+func (_c *Querier_ListRecentFailedLoginsByIP_Call) Run(run func(ctx context.Context, arg repository.ListRecentFailedLoginsByIPParams)) *Querier_ListRecentFailedLoginsByIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListRecentFailedLoginsByIPParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListRecentFailedLoginsByIPParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListRecentFailedLoginsByIP_Call) Return(timestamptzs []pgtype.Timestamptz, err error) *Querier_ListRecentFailedLoginsByIP_Call {
+	_c.Call.Return(timestamptzs, err)
+	return _c
+}
+
+func (_c *Querier_ListRecentFailedLoginsByIP_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListRecentFailedLoginsByIPParams) ([]pgtype.Timestamptz, error)) *Querier_ListRecentFailedLoginsByIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentGameCreationsByOwner provides a mock function for the type Querier
+func (_mock *Querier) ListRecentGameCreationsByOwner(ctx context.Context, arg repository.ListRecentGameCreationsByOwnerParams) ([]pgtype.Timestamptz, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentGameCreationsByOwner")
+	}
+
+	var r0 []pgtype.Timestamptz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListRecentGameCreationsByOwnerParams) ([]pgtype.Timestamptz, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListRecentGameCreationsByOwnerParams) []pgtype.Timestamptz); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pgtype.Timestamptz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListRecentGameCreationsByOwnerParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListRecentGameCreationsByOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecentGameCreationsByOwner'
+type Querier_ListRecentGameCreationsByOwner_Call struct {
+	*mock.Call
+}
+
+// ListRecentGameCreationsByOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListRecentGameCreationsByOwnerParams
+func (_e *Querier_Expecter) ListRecentGameCreationsByOwner(ctx interface{}, arg interface{}) *Querier_ListRecentGameCreationsByOwner_Call {
+	return &Querier_ListRecentGameCreationsByOwner_Call{Call: _e.mock.On("ListRecentGameCreationsByOwner", ctx, arg)}
+}
+
+func (_c *Querier_ListRecentGameCreationsByOwner_Call) Run(run func(ctx context.Context, arg repository.ListRecentGameCreationsByOwnerParams)) *Querier_ListRecentGameCreationsByOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListRecentGameCreationsByOwnerParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListRecentGameCreationsByOwnerParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListRecentGameCreationsByOwner_Call) Return(timestamptzs []pgtype.Timestamptz, err error) *Querier_ListRecentGameCreationsByOwner_Call {
+	_c.Call.Return(timestamptzs, err)
+	return _c
+}
+
+func (_c *Querier_ListRecentGameCreationsByOwner_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListRecentGameCreationsByOwnerParams) ([]pgtype.Timestamptz, error)) *Querier_ListRecentGameCreationsByOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentReferrals provides a mock function for the type Querier
+func (_mock *Querier) ListRecentReferrals(ctx context.Context, arg repository.ListRecentReferralsParams) ([]repository.ListRecentReferralsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentReferrals")
+	}
+
+	var r0 []repository.ListRecentReferralsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListRecentReferralsParams) ([]repository.ListRecentReferralsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListRecentReferralsParams) []repository.ListRecentReferralsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListRecentReferralsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListRecentReferralsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListRecentReferrals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecentReferrals'
+type Querier_ListRecentReferrals_Call struct {
+	*mock.Call
+}
+
+// ListRecentReferrals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListRecentReferralsParams
+func (_e *Querier_Expecter) ListRecentReferrals(ctx interface{}, arg interface{}) *Querier_ListRecentReferrals_Call {
+	return &Querier_ListRecentReferrals_Call{Call: _e.mock.On("ListRecentReferrals", ctx, arg)}
+}
+
+func (_c *Querier_ListRecentReferrals_Call) Run(run func(ctx context.Context, arg repository.ListRecentReferralsParams)) *Querier_ListRecentReferrals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListRecentReferralsParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListRecentReferralsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListRecentReferrals_Call) Return(listRecentReferralsRows []repository.ListRecentReferralsRow, err error) *Querier_ListRecentReferrals_Call {
+	_c.Call.Return(listRecentReferralsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListRecentReferrals_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListRecentReferralsParams) ([]repository.ListRecentReferralsRow, error)) *Querier_ListRecentReferrals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReports provides a mock function for the type Querier
+func (_mock *Querier) ListReports(ctx context.Context, arg repository.ListReportsParams) ([]repository.Report, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReports")
+	}
+
+	var r0 []repository.Report
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListReportsParams) ([]repository.Report, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListReportsParams) []repository.Report); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.Report)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListReportsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReports'
+type Querier_ListReports_Call struct {
+	*mock.Call
+}
+
+// ListReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListReportsParams
+func (_e *Querier_Expecter) ListReports(ctx interface{}, arg interface{}) *Querier_ListReports_Call {
+	return &Querier_ListReports_Call{Call: _e.mock.On("ListReports", ctx, arg)}
+}
+
+func (_c *Querier_ListReports_Call) Run(run func(ctx context.Context, arg repository.ListReportsParams)) *Querier_ListReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListReportsParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListReportsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListReports_Call) Return(reports []repository.Report, err error) *Querier_ListReports_Call {
+	_c.Call.Return(reports, err)
+	return _c
+}
+
+func (_c *Querier_ListReports_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListReportsParams) ([]repository.Report, error)) *Querier_ListReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRosterSnapshotEntries provides a mock function for the type Querier
+func (_mock *Querier) ListRosterSnapshotEntries(ctx context.Context, gameID pgtype.UUID) ([]repository.ListRosterSnapshotEntriesRow, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRosterSnapshotEntries")
+	}
+
+	var r0 []repository.ListRosterSnapshotEntriesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListRosterSnapshotEntriesRow, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListRosterSnapshotEntriesRow); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListRosterSnapshotEntriesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListRosterSnapshotEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRosterSnapshotEntries'
+type Querier_ListRosterSnapshotEntries_Call struct {
+	*mock.Call
+}
+
+// ListRosterSnapshotEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListRosterSnapshotEntries(ctx interface{}, gameID interface{}) *Querier_ListRosterSnapshotEntries_Call {
+	return &Querier_ListRosterSnapshotEntries_Call{Call: _e.mock.On("ListRosterSnapshotEntries", ctx, gameID)}
+}
+
+func (_c *Querier_ListRosterSnapshotEntries_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListRosterSnapshotEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListRosterSnapshotEntries_Call) Return(listRosterSnapshotEntriesRows []repository.ListRosterSnapshotEntriesRow, err error) *Querier_ListRosterSnapshotEntries_Call {
+	_c.Call.Return(listRosterSnapshotEntriesRows, err)
+	return _c
+}
+
+func (_c *Querier_ListRosterSnapshotEntries_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListRosterSnapshotEntriesRow, error)) *Querier_ListRosterSnapshotEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSavedSearches provides a mock function for the type Querier
+func (_mock *Querier) ListSavedSearches(ctx context.Context, userID pgtype.UUID) ([]repository.SavedSearch, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSavedSearches")
+	}
+
+	var r0 []repository.SavedSearch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.SavedSearch, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.SavedSearch); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SavedSearch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListSavedSearches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSavedSearches'
+type Querier_ListSavedSearches_Call struct {
+	*mock.Call
+}
+
+// ListSavedSearches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ListSavedSearches(ctx interface{}, userID interface{}) *Querier_ListSavedSearches_Call {
+	return &Querier_ListSavedSearches_Call{Call: _e.mock.On("ListSavedSearches", ctx, userID)}
+}
+
+func (_c *Querier_ListSavedSearches_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListSavedSearches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListSavedSearches_Call) Return(savedSearchs []repository.SavedSearch, err error) *Querier_ListSavedSearches_Call {
+	_c.Call.Return(savedSearchs, err)
+	return _c
+}
+
+func (_c *Querier_ListSavedSearches_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]repository.SavedSearch, error)) *Querier_ListSavedSearches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSharedContacts provides a mock function for the type Querier
+func (_mock *Querier) ListSharedContacts(ctx context.Context, gameID pgtype.UUID) ([]repository.ListSharedContactsRow, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSharedContacts")
+	}
+
+	var r0 []repository.ListSharedContactsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListSharedContactsRow, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListSharedContactsRow); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSharedContactsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListSharedContacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSharedContacts'
+type Querier_ListSharedContacts_Call struct {
+	*mock.Call
+}
+
+// ListSharedContacts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListSharedContacts(ctx interface{}, gameID interface{}) *Querier_ListSharedContacts_Call {
+	return &Querier_ListSharedContacts_Call{Call: _e.mock.On("ListSharedContacts", ctx, gameID)}
+}
+
+func (_c *Querier_ListSharedContacts_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListSharedContacts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListSharedContacts_Call) Return(listSharedContactsRows []repository.ListSharedContactsRow, err error) *Querier_ListSharedContacts_Call {
+	_c.Call.Return(listSharedContactsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListSharedContacts_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListSharedContactsRow, error)) *Querier_ListSharedContacts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSkillEndorsementCounts provides a mock function for the type Querier
+func (_mock *Querier) ListSkillEndorsementCounts(ctx context.Context, userIds []pgtype.UUID) ([]repository.ListSkillEndorsementCountsRow, error) {
+	ret := _mock.Called(ctx, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkillEndorsementCounts")
+	}
+
+	var r0 []repository.ListSkillEndorsementCountsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []pgtype.UUID) ([]repository.ListSkillEndorsementCountsRow, error)); ok {
+		return returnFunc(ctx, userIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []pgtype.UUID) []repository.ListSkillEndorsementCountsRow); ok {
+		r0 = returnFunc(ctx, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListSkillEndorsementCountsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListSkillEndorsementCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSkillEndorsementCounts'
+type Querier_ListSkillEndorsementCounts_Call struct {
+	*mock.Call
+}
+
+// ListSkillEndorsementCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIds []pgtype.UUID
+func (_e *Querier_Expecter) ListSkillEndorsementCounts(ctx interface{}, userIds interface{}) *Querier_ListSkillEndorsementCounts_Call {
+	return &Querier_ListSkillEndorsementCounts_Call{Call: _e.mock.On("ListSkillEndorsementCounts", ctx, userIds)}
+}
+
+func (_c *Querier_ListSkillEndorsementCounts_Call) Run(run func(ctx context.Context, userIds []pgtype.UUID)) *Querier_ListSkillEndorsementCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListSkillEndorsementCounts_Call) Return(listSkillEndorsementCountsRows []repository.ListSkillEndorsementCountsRow, err error) *Querier_ListSkillEndorsementCounts_Call {
+	_c.Call.Return(listSkillEndorsementCountsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListSkillEndorsementCounts_Call) RunAndReturn(run func(ctx context.Context, userIds []pgtype.UUID) ([]repository.ListSkillEndorsementCountsRow, error)) *Querier_ListSkillEndorsementCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTeamsByGame provides a mock function for the type Querier
+func (_mock *Querier) ListTeamsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.Team, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeamsByGame")
+	}
+
+	var r0 []repository.Team
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.Team, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.Team); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.Team)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListTeamsByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeamsByGame'
+type Querier_ListTeamsByGame_Call struct {
+	*mock.Call
+}
+
+// ListTeamsByGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ListTeamsByGame(ctx interface{}, gameID interface{}) *Querier_ListTeamsByGame_Call {
+	return &Querier_ListTeamsByGame_Call{Call: _e.mock.On("ListTeamsByGame", ctx, gameID)}
+}
+
+func (_c *Querier_ListTeamsByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListTeamsByGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListTeamsByGame_Call) Return(teams []repository.Team, err error) *Querier_ListTeamsByGame_Call {
+	_c.Call.Return(teams, err)
+	return _c
+}
+
+func (_c *Querier_ListTeamsByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.Team, error)) *Querier_ListTeamsByGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUpcomingGamesInRadius provides a mock function for the type Querier
+func (_mock *Querier) ListUpcomingGamesInRadius(ctx context.Context, arg repository.ListUpcomingGamesInRadiusParams) ([]repository.ListUpcomingGamesInRadiusRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUpcomingGamesInRadius")
+	}
+
+	var r0 []repository.ListUpcomingGamesInRadiusRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListUpcomingGamesInRadiusParams) ([]repository.ListUpcomingGamesInRadiusRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListUpcomingGamesInRadiusParams) []repository.ListUpcomingGamesInRadiusRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListUpcomingGamesInRadiusRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListUpcomingGamesInRadiusParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUpcomingGamesInRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUpcomingGamesInRadius'
+type Querier_ListUpcomingGamesInRadius_Call struct {
+	*mock.Call
+}
+
+// ListUpcomingGamesInRadius is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListUpcomingGamesInRadiusParams
+func (_e *Querier_Expecter) ListUpcomingGamesInRadius(ctx interface{}, arg interface{}) *Querier_ListUpcomingGamesInRadius_Call {
+	return &Querier_ListUpcomingGamesInRadius_Call{Call: _e.mock.On("ListUpcomingGamesInRadius", ctx, arg)}
+}
+
+func (_c *Querier_ListUpcomingGamesInRadius_Call) Run(run func(ctx context.Context, arg repository.ListUpcomingGamesInRadiusParams)) *Querier_ListUpcomingGamesInRadius_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListUpcomingGamesInRadiusParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListUpcomingGamesInRadiusParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUpcomingGamesInRadius_Call) Return(listUpcomingGamesInRadiusRows []repository.ListUpcomingGamesInRadiusRow, err error) *Querier_ListUpcomingGamesInRadius_Call {
+	_c.Call.Return(listUpcomingGamesInRadiusRows, err)
+	return _c
+}
+
+func (_c *Querier_ListUpcomingGamesInRadius_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListUpcomingGamesInRadiusParams) ([]repository.ListUpcomingGamesInRadiusRow, error)) *Querier_ListUpcomingGamesInRadius_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserNotificationDeliveries provides a mock function for the type Querier
+func (_mock *Querier) ListUserNotificationDeliveries(ctx context.Context, arg repository.ListUserNotificationDeliveriesParams) ([]repository.ListUserNotificationDeliveriesRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserNotificationDeliveries")
+	}
+
+	var r0 []repository.ListUserNotificationDeliveriesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListUserNotificationDeliveriesParams) ([]repository.ListUserNotificationDeliveriesRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListUserNotificationDeliveriesParams) []repository.ListUserNotificationDeliveriesRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListUserNotificationDeliveriesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListUserNotificationDeliveriesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserNotificationDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserNotificationDeliveries'
+type Querier_ListUserNotificationDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListUserNotificationDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListUserNotificationDeliveriesParams
+func (_e *Querier_Expecter) ListUserNotificationDeliveries(ctx interface{}, arg interface{}) *Querier_ListUserNotificationDeliveries_Call {
+	return &Querier_ListUserNotificationDeliveries_Call{Call: _e.mock.On("ListUserNotificationDeliveries", ctx, arg)}
+}
+
+func (_c *Querier_ListUserNotificationDeliveries_Call) Run(run func(ctx context.Context, arg repository.ListUserNotificationDeliveriesParams)) *Querier_ListUserNotificationDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListUserNotificationDeliveriesParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListUserNotificationDeliveriesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserNotificationDeliveries_Call) Return(listUserNotificationDeliveriesRows []repository.ListUserNotificationDeliveriesRow, err error) *Querier_ListUserNotificationDeliveries_Call {
+	_c.Call.Return(listUserNotificationDeliveriesRows, err)
+	return _c
+}
+
+func (_c *Querier_ListUserNotificationDeliveries_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListUserNotificationDeliveriesParams) ([]repository.ListUserNotificationDeliveriesRow, error)) *Querier_ListUserNotificationDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserOverlappingGames provides a mock function for the type Querier
+func (_mock *Querier) ListUserOverlappingGames(ctx context.Context, arg repository.ListUserOverlappingGamesParams) ([]repository.ListUserOverlappingGamesRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserOverlappingGames")
+	}
+
+	var r0 []repository.ListUserOverlappingGamesRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListUserOverlappingGamesParams) ([]repository.ListUserOverlappingGamesRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListUserOverlappingGamesParams) []repository.ListUserOverlappingGamesRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListUserOverlappingGamesRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListUserOverlappingGamesParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserOverlappingGames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserOverlappingGames'
+type Querier_ListUserOverlappingGames_Call struct {
+	*mock.Call
+}
+
+// ListUserOverlappingGames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListUserOverlappingGamesParams
+func (_e *Querier_Expecter) ListUserOverlappingGames(ctx interface{}, arg interface{}) *Querier_ListUserOverlappingGames_Call {
+	return &Querier_ListUserOverlappingGames_Call{Call: _e.mock.On("ListUserOverlappingGames", ctx, arg)}
+}
+
+func (_c *Querier_ListUserOverlappingGames_Call) Run(run func(ctx context.Context, arg repository.ListUserOverlappingGamesParams)) *Querier_ListUserOverlappingGames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListUserOverlappingGamesParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListUserOverlappingGamesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserOverlappingGames_Call) Return(listUserOverlappingGamesRows []repository.ListUserOverlappingGamesRow, err error) *Querier_ListUserOverlappingGames_Call {
+	_c.Call.Return(listUserOverlappingGamesRows, err)
+	return _c
+}
+
+func (_c *Querier_ListUserOverlappingGames_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListUserOverlappingGamesParams) ([]repository.ListUserOverlappingGamesRow, error)) *Querier_ListUserOverlappingGames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserParticipationHistory provides a mock function for the type Querier
+func (_mock *Querier) ListUserParticipationHistory(ctx context.Context, arg repository.ListUserParticipationHistoryParams) ([]repository.ListUserParticipationHistoryRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserParticipationHistory")
+	}
+
+	var r0 []repository.ListUserParticipationHistoryRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListUserParticipationHistoryParams) ([]repository.ListUserParticipationHistoryRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListUserParticipationHistoryParams) []repository.ListUserParticipationHistoryRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListUserParticipationHistoryRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListUserParticipationHistoryParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserParticipationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserParticipationHistory'
+type Querier_ListUserParticipationHistory_Call struct {
+	*mock.Call
+}
+
+// ListUserParticipationHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ListUserParticipationHistoryParams
+func (_e *Querier_Expecter) ListUserParticipationHistory(ctx interface{}, arg interface{}) *Querier_ListUserParticipationHistory_Call {
+	return &Querier_ListUserParticipationHistory_Call{Call: _e.mock.On("ListUserParticipationHistory", ctx, arg)}
+}
+
+func (_c *Querier_ListUserParticipationHistory_Call) Run(run func(ctx context.Context, arg repository.ListUserParticipationHistoryParams)) *Querier_ListUserParticipationHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ListUserParticipationHistoryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ListUserParticipationHistoryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserParticipationHistory_Call) Return(listUserParticipationHistoryRows []repository.ListUserParticipationHistoryRow, err error) *Querier_ListUserParticipationHistory_Call {
+	_c.Call.Return(listUserParticipationHistoryRows, err)
+	return _c
+}
+
+func (_c *Querier_ListUserParticipationHistory_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListUserParticipationHistoryParams) ([]repository.ListUserParticipationHistoryRow, error)) *Querier_ListUserParticipationHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserPlayedCategories provides a mock function for the type Querier
+func (_mock *Querier) ListUserPlayedCategories(ctx context.Context, userID pgtype.UUID) ([]string, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserPlayedCategories")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]string, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []string); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserPlayedCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserPlayedCategories'
+type Querier_ListUserPlayedCategories_Call struct {
+	*mock.Call
+}
+
+// ListUserPlayedCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ListUserPlayedCategories(ctx interface{}, userID interface{}) *Querier_ListUserPlayedCategories_Call {
+	return &Querier_ListUserPlayedCategories_Call{Call: _e.mock.On("ListUserPlayedCategories", ctx, userID)}
+}
+
+func (_c *Querier_ListUserPlayedCategories_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListUserPlayedCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserPlayedCategories_Call) Return(strings []string, err error) *Querier_ListUserPlayedCategories_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Querier_ListUserPlayedCategories_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]string, error)) *Querier_ListUserPlayedCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserSportPreferences provides a mock function for the type Querier
+func (_mock *Querier) ListUserSportPreferences(ctx context.Context, userID pgtype.UUID) ([]repository.UserSportPreference, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserSportPreferences")
+	}
+
+	var r0 []repository.UserSportPreference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.UserSportPreference, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.UserSportPreference); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.UserSportPreference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserSportPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserSportPreferences'
+type Querier_ListUserSportPreferences_Call struct {
+	*mock.Call
+}
+
+// ListUserSportPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ListUserSportPreferences(ctx interface{}, userID interface{}) *Querier_ListUserSportPreferences_Call {
+	return &Querier_ListUserSportPreferences_Call{Call: _e.mock.On("ListUserSportPreferences", ctx, userID)}
+}
+
+func (_c *Querier_ListUserSportPreferences_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListUserSportPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserSportPreferences_Call) Return(userSportPreferences []repository.UserSportPreference, err error) *Querier_ListUserSportPreferences_Call {
+	_c.Call.Return(userSportPreferences, err)
+	return _c
+}
+
+func (_c *Querier_ListUserSportPreferences_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]repository.UserSportPreference, error)) *Querier_ListUserSportPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserUpcomingParticipations provides a mock function for the type Querier
+func (_mock *Querier) ListUserUpcomingParticipations(ctx context.Context, userID pgtype.UUID) ([]repository.ListUserUpcomingParticipationsRow, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserUpcomingParticipations")
+	}
+
+	var r0 []repository.ListUserUpcomingParticipationsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListUserUpcomingParticipationsRow, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListUserUpcomingParticipationsRow); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.ListUserUpcomingParticipationsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListUserUpcomingParticipations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserUpcomingParticipations'
+type Querier_ListUserUpcomingParticipations_Call struct {
+	*mock.Call
+}
+
+// ListUserUpcomingParticipations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ListUserUpcomingParticipations(ctx interface{}, userID interface{}) *Querier_ListUserUpcomingParticipations_Call {
+	return &Querier_ListUserUpcomingParticipations_Call{Call: _e.mock.On("ListUserUpcomingParticipations", ctx, userID)}
+}
+
+func (_c *Querier_ListUserUpcomingParticipations_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListUserUpcomingParticipations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListUserUpcomingParticipations_Call) Return(listUserUpcomingParticipationsRows []repository.ListUserUpcomingParticipationsRow, err error) *Querier_ListUserUpcomingParticipations_Call {
+	_c.Call.Return(listUserUpcomingParticipationsRows, err)
+	return _c
+}
+
+func (_c *Querier_ListUserUpcomingParticipations_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]repository.ListUserUpcomingParticipationsRow, error)) *Querier_ListUserUpcomingParticipations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebAuthnCredentialsByUser provides a mock function for the type Querier
+func (_mock *Querier) ListWebAuthnCredentialsByUser(ctx context.Context, userID pgtype.UUID) ([]repository.WebauthnCredential, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebAuthnCredentialsByUser")
+	}
+
+	var r0 []repository.WebauthnCredential
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.WebauthnCredential, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.WebauthnCredential); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.WebauthnCredential)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListWebAuthnCredentialsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebAuthnCredentialsByUser'
+type Querier_ListWebAuthnCredentialsByUser_Call struct {
+	*mock.Call
+}
+
+// ListWebAuthnCredentialsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ListWebAuthnCredentialsByUser(ctx interface{}, userID interface{}) *Querier_ListWebAuthnCredentialsByUser_Call {
+	return &Querier_ListWebAuthnCredentialsByUser_Call{Call: _e.mock.On("ListWebAuthnCredentialsByUser", ctx, userID)}
+}
+
+func (_c *Querier_ListWebAuthnCredentialsByUser_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListWebAuthnCredentialsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListWebAuthnCredentialsByUser_Call) Return(webauthnCredentials []repository.WebauthnCredential, err error) *Querier_ListWebAuthnCredentialsByUser_Call {
+	_c.Call.Return(webauthnCredentials, err)
+	return _c
+}
+
+func (_c *Querier_ListWebAuthnCredentialsByUser_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]repository.WebauthnCredential, error)) *Querier_ListWebAuthnCredentialsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookSubscriptions provides a mock function for the type Querier
+func (_mock *Querier) ListWebhookSubscriptions(ctx context.Context, ownerID pgtype.UUID) ([]repository.WebhookSubscription, error) {
+	ret := _mock.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookSubscriptions")
+	}
+
+	var r0 []repository.WebhookSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.WebhookSubscription, error)); ok {
+		return returnFunc(ctx, ownerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.WebhookSubscription); ok {
+		r0 = returnFunc(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.WebhookSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ListWebhookSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookSubscriptions'
+type Querier_ListWebhookSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListWebhookSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID pgtype.UUID
+func (_e *Querier_Expecter) ListWebhookSubscriptions(ctx interface{}, ownerID interface{}) *Querier_ListWebhookSubscriptions_Call {
+	return &Querier_ListWebhookSubscriptions_Call{Call: _e.mock.On("ListWebhookSubscriptions", ctx, ownerID)}
+}
+
+func (_c *Querier_ListWebhookSubscriptions_Call) Run(run func(ctx context.Context, ownerID pgtype.UUID)) *Querier_ListWebhookSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ListWebhookSubscriptions_Call) Return(webhookSubscriptions []repository.WebhookSubscription, err error) *Querier_ListWebhookSubscriptions_Call {
+	_c.Call.Return(webhookSubscriptions, err)
+	return _c
+}
+
+func (_c *Querier_ListWebhookSubscriptions_Call) RunAndReturn(run func(ctx context.Context, ownerID pgtype.UUID) ([]repository.WebhookSubscription, error)) *Querier_ListWebhookSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkConversationRead provides a mock function for the type Querier
+func (_mock *Querier) MarkConversationRead(ctx context.Context, arg repository.MarkConversationReadParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkConversationRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.MarkConversationReadParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_MarkConversationRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkConversationRead'
+type Querier_MarkConversationRead_Call struct {
+	*mock.Call
+}
+
+// MarkConversationRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.MarkConversationReadParams
+func (_e *Querier_Expecter) MarkConversationRead(ctx interface{}, arg interface{}) *Querier_MarkConversationRead_Call {
+	return &Querier_MarkConversationRead_Call{Call: _e.mock.On("MarkConversationRead", ctx, arg)}
+}
+
+func (_c *Querier_MarkConversationRead_Call) Run(run func(ctx context.Context, arg repository.MarkConversationReadParams)) *Querier_MarkConversationRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.MarkConversationReadParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.MarkConversationReadParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MarkConversationRead_Call) Return(err error) *Querier_MarkConversationRead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_MarkConversationRead_Call) RunAndReturn(run func(ctx context.Context, arg repository.MarkConversationReadParams) error) *Querier_MarkConversationRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDeadLetterRequeued provides a mock function for the type Querier
+func (_mock *Querier) MarkDeadLetterRequeued(ctx context.Context, arg repository.MarkDeadLetterRequeuedParams) (repository.DeadLetter, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDeadLetterRequeued")
+	}
+
+	var r0 repository.DeadLetter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.MarkDeadLetterRequeuedParams) (repository.DeadLetter, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.MarkDeadLetterRequeuedParams) repository.DeadLetter); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.DeadLetter)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.MarkDeadLetterRequeuedParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_MarkDeadLetterRequeued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDeadLetterRequeued'
+type Querier_MarkDeadLetterRequeued_Call struct {
+	*mock.Call
+}
+
+// MarkDeadLetterRequeued is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.MarkDeadLetterRequeuedParams
+func (_e *Querier_Expecter) MarkDeadLetterRequeued(ctx interface{}, arg interface{}) *Querier_MarkDeadLetterRequeued_Call {
+	return &Querier_MarkDeadLetterRequeued_Call{Call: _e.mock.On("MarkDeadLetterRequeued", ctx, arg)}
+}
+
+func (_c *Querier_MarkDeadLetterRequeued_Call) Run(run func(ctx context.Context, arg repository.MarkDeadLetterRequeuedParams)) *Querier_MarkDeadLetterRequeued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.MarkDeadLetterRequeuedParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.MarkDeadLetterRequeuedParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MarkDeadLetterRequeued_Call) Return(deadLetter repository.DeadLetter, err error) *Querier_MarkDeadLetterRequeued_Call {
+	_c.Call.Return(deadLetter, err)
+	return _c
+}
+
+func (_c *Querier_MarkDeadLetterRequeued_Call) RunAndReturn(run func(ctx context.Context, arg repository.MarkDeadLetterRequeuedParams) (repository.DeadLetter, error)) *Querier_MarkDeadLetterRequeued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailChangeRequestConfirmed provides a mock function for the type Querier
+func (_mock *Querier) MarkEmailChangeRequestConfirmed(ctx context.Context, id pgtype.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailChangeRequestConfirmed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_MarkEmailChangeRequestConfirmed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailChangeRequestConfirmed'
+type Querier_MarkEmailChangeRequestConfirmed_Call struct {
+	*mock.Call
+}
+
+// MarkEmailChangeRequestConfirmed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) MarkEmailChangeRequestConfirmed(ctx interface{}, id interface{}) *Querier_MarkEmailChangeRequestConfirmed_Call {
+	return &Querier_MarkEmailChangeRequestConfirmed_Call{Call: _e.mock.On("MarkEmailChangeRequestConfirmed", ctx, id)}
+}
+
+func (_c *Querier_MarkEmailChangeRequestConfirmed_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_MarkEmailChangeRequestConfirmed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MarkEmailChangeRequestConfirmed_Call) Return(err error) *Querier_MarkEmailChangeRequestConfirmed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_MarkEmailChangeRequestConfirmed_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_MarkEmailChangeRequestConfirmed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkLoginCodeUsed provides a mock function for the type Querier
+func (_mock *Querier) MarkLoginCodeUsed(ctx context.Context, id pgtype.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkLoginCodeUsed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_MarkLoginCodeUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkLoginCodeUsed'
+type Querier_MarkLoginCodeUsed_Call struct {
+	*mock.Call
+}
+
+// MarkLoginCodeUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) MarkLoginCodeUsed(ctx interface{}, id interface{}) *Querier_MarkLoginCodeUsed_Call {
+	return &Querier_MarkLoginCodeUsed_Call{Call: _e.mock.On("MarkLoginCodeUsed", ctx, id)}
+}
+
+func (_c *Querier_MarkLoginCodeUsed_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_MarkLoginCodeUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MarkLoginCodeUsed_Call) Return(err error) *Querier_MarkLoginCodeUsed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_MarkLoginCodeUsed_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_MarkLoginCodeUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNotificationDeliverySent provides a mock function for the type Querier
+func (_mock *Querier) MarkNotificationDeliverySent(ctx context.Context, id pgtype.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationDeliverySent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_MarkNotificationDeliverySent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNotificationDeliverySent'
+type Querier_MarkNotificationDeliverySent_Call struct {
+	*mock.Call
+}
+
+// MarkNotificationDeliverySent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) MarkNotificationDeliverySent(ctx interface{}, id interface{}) *Querier_MarkNotificationDeliverySent_Call {
+	return &Querier_MarkNotificationDeliverySent_Call{Call: _e.mock.On("MarkNotificationDeliverySent", ctx, id)}
+}
+
+func (_c *Querier_MarkNotificationDeliverySent_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_MarkNotificationDeliverySent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MarkNotificationDeliverySent_Call) Return(err error) *Querier_MarkNotificationDeliverySent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_MarkNotificationDeliverySent_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_MarkNotificationDeliverySent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkParticipantDropped provides a mock function for the type Querier
+func (_mock *Querier) MarkParticipantDropped(ctx context.Context, arg repository.MarkParticipantDroppedParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkParticipantDropped")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.MarkParticipantDroppedParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.MarkParticipantDroppedParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.MarkParticipantDroppedParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_MarkParticipantDropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkParticipantDropped'
+type Querier_MarkParticipantDropped_Call struct {
+	*mock.Call
+}
+
+// MarkParticipantDropped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.MarkParticipantDroppedParams
+func (_e *Querier_Expecter) MarkParticipantDropped(ctx interface{}, arg interface{}) *Querier_MarkParticipantDropped_Call {
+	return &Querier_MarkParticipantDropped_Call{Call: _e.mock.On("MarkParticipantDropped", ctx, arg)}
+}
+
+func (_c *Querier_MarkParticipantDropped_Call) Run(run func(ctx context.Context, arg repository.MarkParticipantDroppedParams)) *Querier_MarkParticipantDropped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.MarkParticipantDroppedParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.MarkParticipantDroppedParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MarkParticipantDropped_Call) Return(participant repository.Participant, err error) *Querier_MarkParticipantDropped_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_MarkParticipantDropped_Call) RunAndReturn(run func(ctx context.Context, arg repository.MarkParticipantDroppedParams) (repository.Participant, error)) *Querier_MarkParticipantDropped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPhoneVerificationVerified provides a mock function for the type Querier
+func (_mock *Querier) MarkPhoneVerificationVerified(ctx context.Context, id pgtype.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPhoneVerificationVerified")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_MarkPhoneVerificationVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPhoneVerificationVerified'
+type Querier_MarkPhoneVerificationVerified_Call struct {
+	*mock.Call
+}
+
+// MarkPhoneVerificationVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id pgtype.UUID
+func (_e *Querier_Expecter) MarkPhoneVerificationVerified(ctx interface{}, id interface{}) *Querier_MarkPhoneVerificationVerified_Call {
+	return &Querier_MarkPhoneVerificationVerified_Call{Call: _e.mock.On("MarkPhoneVerificationVerified", ctx, id)}
+}
+
+func (_c *Querier_MarkPhoneVerificationVerified_Call) Run(run func(ctx context.Context, id pgtype.UUID)) *Querier_MarkPhoneVerificationVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MarkPhoneVerificationVerified_Call) Return(err error) *Querier_MarkPhoneVerificationVerified_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_MarkPhoneVerificationVerified_Call) RunAndReturn(run func(ctx context.Context, id pgtype.UUID) error) *Querier_MarkPhoneVerificationVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveParticipantsToBackOfLine provides a mock function for the type Querier
+func (_mock *Querier) MoveParticipantsToBackOfLine(ctx context.Context, arg repository.MoveParticipantsToBackOfLineParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveParticipantsToBackOfLine")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.MoveParticipantsToBackOfLineParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_MoveParticipantsToBackOfLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveParticipantsToBackOfLine'
+type Querier_MoveParticipantsToBackOfLine_Call struct {
+	*mock.Call
+}
+
+// MoveParticipantsToBackOfLine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.MoveParticipantsToBackOfLineParams
+func (_e *Querier_Expecter) MoveParticipantsToBackOfLine(ctx interface{}, arg interface{}) *Querier_MoveParticipantsToBackOfLine_Call {
+	return &Querier_MoveParticipantsToBackOfLine_Call{Call: _e.mock.On("MoveParticipantsToBackOfLine", ctx, arg)}
+}
+
+func (_c *Querier_MoveParticipantsToBackOfLine_Call) Run(run func(ctx context.Context, arg repository.MoveParticipantsToBackOfLineParams)) *Querier_MoveParticipantsToBackOfLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.MoveParticipantsToBackOfLineParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.MoveParticipantsToBackOfLineParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MoveParticipantsToBackOfLine_Call) Return(err error) *Querier_MoveParticipantsToBackOfLine_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_MoveParticipantsToBackOfLine_Call) RunAndReturn(run func(ctx context.Context, arg repository.MoveParticipantsToBackOfLineParams) error) *Querier_MoveParticipantsToBackOfLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveParticipantsToFrontOfLine provides a mock function for the type Querier
+func (_mock *Querier) MoveParticipantsToFrontOfLine(ctx context.Context, arg repository.MoveParticipantsToFrontOfLineParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveParticipantsToFrontOfLine")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.MoveParticipantsToFrontOfLineParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_MoveParticipantsToFrontOfLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveParticipantsToFrontOfLine'
+type Querier_MoveParticipantsToFrontOfLine_Call struct {
+	*mock.Call
+}
+
+// MoveParticipantsToFrontOfLine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.MoveParticipantsToFrontOfLineParams
+func (_e *Querier_Expecter) MoveParticipantsToFrontOfLine(ctx interface{}, arg interface{}) *Querier_MoveParticipantsToFrontOfLine_Call {
+	return &Querier_MoveParticipantsToFrontOfLine_Call{Call: _e.mock.On("MoveParticipantsToFrontOfLine", ctx, arg)}
+}
+
+func (_c *Querier_MoveParticipantsToFrontOfLine_Call) Run(run func(ctx context.Context, arg repository.MoveParticipantsToFrontOfLineParams)) *Querier_MoveParticipantsToFrontOfLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.MoveParticipantsToFrontOfLineParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.MoveParticipantsToFrontOfLineParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_MoveParticipantsToFrontOfLine_Call) Return(err error) *Querier_MoveParticipantsToFrontOfLine_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_MoveParticipantsToFrontOfLine_Call) RunAndReturn(run func(ctx context.Context, arg repository.MoveParticipantsToFrontOfLineParams) error) *Querier_MoveParticipantsToFrontOfLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlayedCompletedGame provides a mock function for the type Querier
+func (_mock *Querier) PlayedCompletedGame(ctx context.Context, arg repository.PlayedCompletedGameParams) (bool, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlayedCompletedGame")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PlayedCompletedGameParams) (bool, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.PlayedCompletedGameParams) bool); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.PlayedCompletedGameParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_PlayedCompletedGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlayedCompletedGame'
+type Querier_PlayedCompletedGame_Call struct {
+	*mock.Call
+}
+
+// PlayedCompletedGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.PlayedCompletedGameParams
+func (_e *Querier_Expecter) PlayedCompletedGame(ctx interface{}, arg interface{}) *Querier_PlayedCompletedGame_Call {
+	return &Querier_PlayedCompletedGame_Call{Call: _e.mock.On("PlayedCompletedGame", ctx, arg)}
+}
+
+func (_c *Querier_PlayedCompletedGame_Call) Run(run func(ctx context.Context, arg repository.PlayedCompletedGameParams)) *Querier_PlayedCompletedGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.PlayedCompletedGameParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.PlayedCompletedGameParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_PlayedCompletedGame_Call) Return(b bool, err error) *Querier_PlayedCompletedGame_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Querier_PlayedCompletedGame_Call) RunAndReturn(run func(ctx context.Context, arg repository.PlayedCompletedGameParams) (bool, error)) *Querier_PlayedCompletedGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PruneLeaderboardCells provides a mock function for the type Querier
+func (_mock *Querier) PruneLeaderboardCells(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error) {
+	ret := _mock.Called(ctx, computedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneLeaderboardCells")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) (int64, error)); ok {
+		return returnFunc(ctx, computedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) int64); ok {
+		r0 = returnFunc(ctx, computedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = returnFunc(ctx, computedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_PruneLeaderboardCells_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneLeaderboardCells'
+type Querier_PruneLeaderboardCells_Call struct {
+	*mock.Call
+}
+
+// PruneLeaderboardCells is a helper method to define mock.On call
+//   - ctx context.Context
+//   - computedAt pgtype.Timestamptz
+func (_e *Querier_Expecter) PruneLeaderboardCells(ctx interface{}, computedAt interface{}) *Querier_PruneLeaderboardCells_Call {
+	return &Querier_PruneLeaderboardCells_Call{Call: _e.mock.On("PruneLeaderboardCells", ctx, computedAt)}
+}
+
+func (_c *Querier_PruneLeaderboardCells_Call) Run(run func(ctx context.Context, computedAt pgtype.Timestamptz)) *Querier_PruneLeaderboardCells_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.Timestamptz
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.Timestamptz)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_PruneLeaderboardCells_Call) Return(n int64, err error) *Querier_PruneLeaderboardCells_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_PruneLeaderboardCells_Call) RunAndReturn(run func(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error)) *Querier_PruneLeaderboardCells_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReactivateUser provides a mock function for the type Querier
+func (_mock *Querier) ReactivateUser(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReactivateUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ReactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReactivateUser'
+type Querier_ReactivateUser_Call struct {
+	*mock.Call
+}
+
+// ReactivateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) ReactivateUser(ctx interface{}, userID interface{}) *Querier_ReactivateUser_Call {
+	return &Querier_ReactivateUser_Call{Call: _e.mock.On("ReactivateUser", ctx, userID)}
+}
+
+func (_c *Querier_ReactivateUser_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ReactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ReactivateUser_Call) Return(n int64, err error) *Querier_ReactivateUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_ReactivateUser_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (int64, error)) *Querier_ReactivateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordCreditTransaction provides a mock function for the type Querier
+func (_mock *Querier) RecordCreditTransaction(ctx context.Context, arg repository.RecordCreditTransactionParams) (repository.CreditTransaction, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCreditTransaction")
+	}
+
+	var r0 repository.CreditTransaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RecordCreditTransactionParams) (repository.CreditTransaction, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RecordCreditTransactionParams) repository.CreditTransaction); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.CreditTransaction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.RecordCreditTransactionParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_RecordCreditTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordCreditTransaction'
+type Querier_RecordCreditTransaction_Call struct {
+	*mock.Call
+}
+
+// RecordCreditTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RecordCreditTransactionParams
+func (_e *Querier_Expecter) RecordCreditTransaction(ctx interface{}, arg interface{}) *Querier_RecordCreditTransaction_Call {
+	return &Querier_RecordCreditTransaction_Call{Call: _e.mock.On("RecordCreditTransaction", ctx, arg)}
+}
+
+func (_c *Querier_RecordCreditTransaction_Call) Run(run func(ctx context.Context, arg repository.RecordCreditTransactionParams)) *Querier_RecordCreditTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RecordCreditTransactionParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RecordCreditTransactionParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RecordCreditTransaction_Call) Return(creditTransaction repository.CreditTransaction, err error) *Querier_RecordCreditTransaction_Call {
+	_c.Call.Return(creditTransaction, err)
+	return _c
+}
+
+func (_c *Querier_RecordCreditTransaction_Call) RunAndReturn(run func(ctx context.Context, arg repository.RecordCreditTransactionParams) (repository.CreditTransaction, error)) *Querier_RecordCreditTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailedLogin provides a mock function for the type Querier
+func (_mock *Querier) RecordFailedLogin(ctx context.Context, arg repository.RecordFailedLoginParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailedLogin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RecordFailedLoginParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RecordFailedLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailedLogin'
+type Querier_RecordFailedLogin_Call struct {
+	*mock.Call
+}
+
+// RecordFailedLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RecordFailedLoginParams
+func (_e *Querier_Expecter) RecordFailedLogin(ctx interface{}, arg interface{}) *Querier_RecordFailedLogin_Call {
+	return &Querier_RecordFailedLogin_Call{Call: _e.mock.On("RecordFailedLogin", ctx, arg)}
+}
+
+func (_c *Querier_RecordFailedLogin_Call) Run(run func(ctx context.Context, arg repository.RecordFailedLoginParams)) *Querier_RecordFailedLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RecordFailedLoginParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RecordFailedLoginParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RecordFailedLogin_Call) Return(err error) *Querier_RecordFailedLogin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RecordFailedLogin_Call) RunAndReturn(run func(ctx context.Context, arg repository.RecordFailedLoginParams) error) *Querier_RecordFailedLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordNewGameAlert provides a mock function for the type Querier
+func (_mock *Querier) RecordNewGameAlert(ctx context.Context, arg repository.RecordNewGameAlertParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordNewGameAlert")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RecordNewGameAlertParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RecordNewGameAlertParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.RecordNewGameAlertParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_RecordNewGameAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordNewGameAlert'
+type Querier_RecordNewGameAlert_Call struct {
+	*mock.Call
+}
+
+// RecordNewGameAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RecordNewGameAlertParams
+func (_e *Querier_Expecter) RecordNewGameAlert(ctx interface{}, arg interface{}) *Querier_RecordNewGameAlert_Call {
+	return &Querier_RecordNewGameAlert_Call{Call: _e.mock.On("RecordNewGameAlert", ctx, arg)}
+}
+
+func (_c *Querier_RecordNewGameAlert_Call) Run(run func(ctx context.Context, arg repository.RecordNewGameAlertParams)) *Querier_RecordNewGameAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RecordNewGameAlertParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RecordNewGameAlertParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RecordNewGameAlert_Call) Return(n int64, err error) *Querier_RecordNewGameAlert_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_RecordNewGameAlert_Call) RunAndReturn(run func(ctx context.Context, arg repository.RecordNewGameAlertParams) (int64, error)) *Querier_RecordNewGameAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshLeaderboardCells provides a mock function for the type Querier
+func (_mock *Querier) RefreshLeaderboardCells(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error) {
+	ret := _mock.Called(ctx, computedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshLeaderboardCells")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) (int64, error)); ok {
+		return returnFunc(ctx, computedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) int64); ok {
+		r0 = returnFunc(ctx, computedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = returnFunc(ctx, computedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_RefreshLeaderboardCells_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshLeaderboardCells'
+type Querier_RefreshLeaderboardCells_Call struct {
+	*mock.Call
+}
+
+// RefreshLeaderboardCells is a helper method to define mock.On call
+//   - ctx context.Context
+//   - computedAt pgtype.Timestamptz
+func (_e *Querier_Expecter) RefreshLeaderboardCells(ctx interface{}, computedAt interface{}) *Querier_RefreshLeaderboardCells_Call {
+	return &Querier_RefreshLeaderboardCells_Call{Call: _e.mock.On("RefreshLeaderboardCells", ctx, computedAt)}
+}
+
+func (_c *Querier_RefreshLeaderboardCells_Call) Run(run func(ctx context.Context, computedAt pgtype.Timestamptz)) *Querier_RefreshLeaderboardCells_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.Timestamptz
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.Timestamptz)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RefreshLeaderboardCells_Call) Return(n int64, err error) *Querier_RefreshLeaderboardCells_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_RefreshLeaderboardCells_Call) RunAndReturn(run func(ctx context.Context, computedAt pgtype.Timestamptz) (int64, error)) *Querier_RefreshLeaderboardCells_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshPlayerReliability provides a mock function for the type Querier
+func (_mock *Querier) RefreshPlayerReliability(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshPlayerReliability")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_RefreshPlayerReliability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshPlayerReliability'
+type Querier_RefreshPlayerReliability_Call struct {
+	*mock.Call
+}
+
+// RefreshPlayerReliability is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) RefreshPlayerReliability(ctx interface{}, userID interface{}) *Querier_RefreshPlayerReliability_Call {
+	return &Querier_RefreshPlayerReliability_Call{Call: _e.mock.On("RefreshPlayerReliability", ctx, userID)}
+}
+
+func (_c *Querier_RefreshPlayerReliability_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_RefreshPlayerReliability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RefreshPlayerReliability_Call) Return(n int64, err error) *Querier_RefreshPlayerReliability_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_RefreshPlayerReliability_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (int64, error)) *Querier_RefreshPlayerReliability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseExpiredReservations provides a mock function for the type Querier
+func (_mock *Querier) ReleaseExpiredReservations(ctx context.Context, gameID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseExpiredReservations")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, gameID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, gameID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ReleaseExpiredReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseExpiredReservations'
+type Querier_ReleaseExpiredReservations_Call struct {
+	*mock.Call
+}
+
+// ReleaseExpiredReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID pgtype.UUID
+func (_e *Querier_Expecter) ReleaseExpiredReservations(ctx interface{}, gameID interface{}) *Querier_ReleaseExpiredReservations_Call {
+	return &Querier_ReleaseExpiredReservations_Call{Call: _e.mock.On("ReleaseExpiredReservations", ctx, gameID)}
+}
+
+func (_c *Querier_ReleaseExpiredReservations_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ReleaseExpiredReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ReleaseExpiredReservations_Call) Return(n int64, err error) *Querier_ReleaseExpiredReservations_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_ReleaseExpiredReservations_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) (int64, error)) *Querier_ReleaseExpiredReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderWaitlist provides a mock function for the type Querier
+func (_mock *Querier) ReorderWaitlist(ctx context.Context, arg repository.ReorderWaitlistParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderWaitlist")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ReorderWaitlistParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_ReorderWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderWaitlist'
+type Querier_ReorderWaitlist_Call struct {
+	*mock.Call
+}
+
+// ReorderWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ReorderWaitlistParams
+func (_e *Querier_Expecter) ReorderWaitlist(ctx interface{}, arg interface{}) *Querier_ReorderWaitlist_Call {
+	return &Querier_ReorderWaitlist_Call{Call: _e.mock.On("ReorderWaitlist", ctx, arg)}
+}
+
+func (_c *Querier_ReorderWaitlist_Call) Run(run func(ctx context.Context, arg repository.ReorderWaitlistParams)) *Querier_ReorderWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ReorderWaitlistParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ReorderWaitlistParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ReorderWaitlist_Call) Return(err error) *Querier_ReorderWaitlist_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_ReorderWaitlist_Call) RunAndReturn(run func(ctx context.Context, arg repository.ReorderWaitlistParams) error) *Querier_ReorderWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceUserSportPreferences provides a mock function for the type Querier
+func (_mock *Querier) ReplaceUserSportPreferences(ctx context.Context, arg repository.ReplaceUserSportPreferencesParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceUserSportPreferences")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ReplaceUserSportPreferencesParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_ReplaceUserSportPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceUserSportPreferences'
+type Querier_ReplaceUserSportPreferences_Call struct {
+	*mock.Call
+}
+
+// ReplaceUserSportPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ReplaceUserSportPreferencesParams
+func (_e *Querier_Expecter) ReplaceUserSportPreferences(ctx interface{}, arg interface{}) *Querier_ReplaceUserSportPreferences_Call {
+	return &Querier_ReplaceUserSportPreferences_Call{Call: _e.mock.On("ReplaceUserSportPreferences", ctx, arg)}
+}
+
+func (_c *Querier_ReplaceUserSportPreferences_Call) Run(run func(ctx context.Context, arg repository.ReplaceUserSportPreferencesParams)) *Querier_ReplaceUserSportPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ReplaceUserSportPreferencesParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ReplaceUserSportPreferencesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ReplaceUserSportPreferences_Call) Return(err error) *Querier_ReplaceUserSportPreferences_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_ReplaceUserSportPreferences_Call) RunAndReturn(run func(ctx context.Context, arg repository.ReplaceUserSportPreferencesParams) error) *Querier_ReplaceUserSportPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestContactSharing provides a mock function for the type Querier
+func (_mock *Querier) RequestContactSharing(ctx context.Context, arg repository.RequestContactSharingParams) (repository.ContactShareRequest, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestContactSharing")
+	}
+
+	var r0 repository.ContactShareRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RequestContactSharingParams) (repository.ContactShareRequest, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RequestContactSharingParams) repository.ContactShareRequest); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.ContactShareRequest)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.RequestContactSharingParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_RequestContactSharing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestContactSharing'
+type Querier_RequestContactSharing_Call struct {
+	*mock.Call
+}
+
+// RequestContactSharing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RequestContactSharingParams
+func (_e *Querier_Expecter) RequestContactSharing(ctx interface{}, arg interface{}) *Querier_RequestContactSharing_Call {
+	return &Querier_RequestContactSharing_Call{Call: _e.mock.On("RequestContactSharing", ctx, arg)}
+}
+
+func (_c *Querier_RequestContactSharing_Call) Run(run func(ctx context.Context, arg repository.RequestContactSharingParams)) *Querier_RequestContactSharing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RequestContactSharingParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RequestContactSharingParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RequestContactSharing_Call) Return(contactShareRequest repository.ContactShareRequest, err error) *Querier_RequestContactSharing_Call {
+	_c.Call.Return(contactShareRequest, err)
+	return _c
+}
+
+func (_c *Querier_RequestContactSharing_Call) RunAndReturn(run func(ctx context.Context, arg repository.RequestContactSharingParams) (repository.ContactShareRequest, error)) *Querier_RequestContactSharing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequeueNotificationDelivery provides a mock function for the type Querier
+func (_mock *Querier) RequeueNotificationDelivery(ctx context.Context, arg repository.RequeueNotificationDeliveryParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueNotificationDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RequeueNotificationDeliveryParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RequeueNotificationDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueNotificationDelivery'
+type Querier_RequeueNotificationDelivery_Call struct {
+	*mock.Call
+}
+
+// RequeueNotificationDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RequeueNotificationDeliveryParams
+func (_e *Querier_Expecter) RequeueNotificationDelivery(ctx interface{}, arg interface{}) *Querier_RequeueNotificationDelivery_Call {
+	return &Querier_RequeueNotificationDelivery_Call{Call: _e.mock.On("RequeueNotificationDelivery", ctx, arg)}
+}
+
+func (_c *Querier_RequeueNotificationDelivery_Call) Run(run func(ctx context.Context, arg repository.RequeueNotificationDeliveryParams)) *Querier_RequeueNotificationDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RequeueNotificationDeliveryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RequeueNotificationDeliveryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RequeueNotificationDelivery_Call) Return(err error) *Querier_RequeueNotificationDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RequeueNotificationDelivery_Call) RunAndReturn(run func(ctx context.Context, arg repository.RequeueNotificationDeliveryParams) error) *Querier_RequeueNotificationDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequeueSideEffect provides a mock function for the type Querier
+func (_mock *Querier) RequeueSideEffect(ctx context.Context, arg repository.RequeueSideEffectParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueSideEffect")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RequeueSideEffectParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RequeueSideEffectParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.RequeueSideEffectParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_RequeueSideEffect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueSideEffect'
+type Querier_RequeueSideEffect_Call struct {
+	*mock.Call
+}
+
+// RequeueSideEffect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RequeueSideEffectParams
+func (_e *Querier_Expecter) RequeueSideEffect(ctx interface{}, arg interface{}) *Querier_RequeueSideEffect_Call {
+	return &Querier_RequeueSideEffect_Call{Call: _e.mock.On("RequeueSideEffect", ctx, arg)}
+}
+
+func (_c *Querier_RequeueSideEffect_Call) Run(run func(ctx context.Context, arg repository.RequeueSideEffectParams)) *Querier_RequeueSideEffect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RequeueSideEffectParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RequeueSideEffectParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RequeueSideEffect_Call) Return(n int64, err error) *Querier_RequeueSideEffect_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_RequeueSideEffect_Call) RunAndReturn(run func(ctx context.Context, arg repository.RequeueSideEffectParams) (int64, error)) *Querier_RequeueSideEffect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequeueWebhookDelivery provides a mock function for the type Querier
+func (_mock *Querier) RequeueWebhookDelivery(ctx context.Context, arg repository.RequeueWebhookDeliveryParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueWebhookDelivery")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RequeueWebhookDeliveryParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RequeueWebhookDeliveryParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.RequeueWebhookDeliveryParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_RequeueWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueWebhookDelivery'
+type Querier_RequeueWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// RequeueWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RequeueWebhookDeliveryParams
+func (_e *Querier_Expecter) RequeueWebhookDelivery(ctx interface{}, arg interface{}) *Querier_RequeueWebhookDelivery_Call {
+	return &Querier_RequeueWebhookDelivery_Call{Call: _e.mock.On("RequeueWebhookDelivery", ctx, arg)}
+}
+
+func (_c *Querier_RequeueWebhookDelivery_Call) Run(run func(ctx context.Context, arg repository.RequeueWebhookDeliveryParams)) *Querier_RequeueWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RequeueWebhookDeliveryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RequeueWebhookDeliveryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RequeueWebhookDelivery_Call) Return(n int64, err error) *Querier_RequeueWebhookDelivery_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_RequeueWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, arg repository.RequeueWebhookDeliveryParams) (int64, error)) *Querier_RequeueWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RescheduleBrokerEvent provides a mock function for the type Querier
+func (_mock *Querier) RescheduleBrokerEvent(ctx context.Context, arg repository.RescheduleBrokerEventParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RescheduleBrokerEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RescheduleBrokerEventParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RescheduleBrokerEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RescheduleBrokerEvent'
+type Querier_RescheduleBrokerEvent_Call struct {
+	*mock.Call
+}
+
+// RescheduleBrokerEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RescheduleBrokerEventParams
+func (_e *Querier_Expecter) RescheduleBrokerEvent(ctx interface{}, arg interface{}) *Querier_RescheduleBrokerEvent_Call {
+	return &Querier_RescheduleBrokerEvent_Call{Call: _e.mock.On("RescheduleBrokerEvent", ctx, arg)}
+}
+
+func (_c *Querier_RescheduleBrokerEvent_Call) Run(run func(ctx context.Context, arg repository.RescheduleBrokerEventParams)) *Querier_RescheduleBrokerEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RescheduleBrokerEventParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RescheduleBrokerEventParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RescheduleBrokerEvent_Call) Return(err error) *Querier_RescheduleBrokerEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RescheduleBrokerEvent_Call) RunAndReturn(run func(ctx context.Context, arg repository.RescheduleBrokerEventParams) error) *Querier_RescheduleBrokerEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RescheduleNotificationDelivery provides a mock function for the type Querier
+func (_mock *Querier) RescheduleNotificationDelivery(ctx context.Context, arg repository.RescheduleNotificationDeliveryParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RescheduleNotificationDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RescheduleNotificationDeliveryParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RescheduleNotificationDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RescheduleNotificationDelivery'
+type Querier_RescheduleNotificationDelivery_Call struct {
+	*mock.Call
+}
+
+// RescheduleNotificationDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RescheduleNotificationDeliveryParams
+func (_e *Querier_Expecter) RescheduleNotificationDelivery(ctx interface{}, arg interface{}) *Querier_RescheduleNotificationDelivery_Call {
+	return &Querier_RescheduleNotificationDelivery_Call{Call: _e.mock.On("RescheduleNotificationDelivery", ctx, arg)}
+}
+
+func (_c *Querier_RescheduleNotificationDelivery_Call) Run(run func(ctx context.Context, arg repository.RescheduleNotificationDeliveryParams)) *Querier_RescheduleNotificationDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RescheduleNotificationDeliveryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RescheduleNotificationDeliveryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RescheduleNotificationDelivery_Call) Return(err error) *Querier_RescheduleNotificationDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RescheduleNotificationDelivery_Call) RunAndReturn(run func(ctx context.Context, arg repository.RescheduleNotificationDeliveryParams) error) *Querier_RescheduleNotificationDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RescheduleSideEffect provides a mock function for the type Querier
+func (_mock *Querier) RescheduleSideEffect(ctx context.Context, arg repository.RescheduleSideEffectParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RescheduleSideEffect")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RescheduleSideEffectParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RescheduleSideEffect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RescheduleSideEffect'
+type Querier_RescheduleSideEffect_Call struct {
+	*mock.Call
+}
+
+// RescheduleSideEffect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RescheduleSideEffectParams
+func (_e *Querier_Expecter) RescheduleSideEffect(ctx interface{}, arg interface{}) *Querier_RescheduleSideEffect_Call {
+	return &Querier_RescheduleSideEffect_Call{Call: _e.mock.On("RescheduleSideEffect", ctx, arg)}
+}
+
+func (_c *Querier_RescheduleSideEffect_Call) Run(run func(ctx context.Context, arg repository.RescheduleSideEffectParams)) *Querier_RescheduleSideEffect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RescheduleSideEffectParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RescheduleSideEffectParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RescheduleSideEffect_Call) Return(err error) *Querier_RescheduleSideEffect_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RescheduleSideEffect_Call) RunAndReturn(run func(ctx context.Context, arg repository.RescheduleSideEffectParams) error) *Querier_RescheduleSideEffect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RescheduleWebhookDelivery provides a mock function for the type Querier
+func (_mock *Querier) RescheduleWebhookDelivery(ctx context.Context, arg repository.RescheduleWebhookDeliveryParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RescheduleWebhookDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RescheduleWebhookDeliveryParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RescheduleWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RescheduleWebhookDelivery'
+type Querier_RescheduleWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// RescheduleWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RescheduleWebhookDeliveryParams
+func (_e *Querier_Expecter) RescheduleWebhookDelivery(ctx interface{}, arg interface{}) *Querier_RescheduleWebhookDelivery_Call {
+	return &Querier_RescheduleWebhookDelivery_Call{Call: _e.mock.On("RescheduleWebhookDelivery", ctx, arg)}
+}
+
+func (_c *Querier_RescheduleWebhookDelivery_Call) Run(run func(ctx context.Context, arg repository.RescheduleWebhookDeliveryParams)) *Querier_RescheduleWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RescheduleWebhookDeliveryParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RescheduleWebhookDeliveryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RescheduleWebhookDelivery_Call) Return(err error) *Querier_RescheduleWebhookDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RescheduleWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, arg repository.RescheduleWebhookDeliveryParams) error) *Querier_RescheduleWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllUserRefreshTokens provides a mock function for the type Querier
+func (_mock *Querier) RevokeAllUserRefreshTokens(ctx context.Context, userID pgtype.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllUserRefreshTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RevokeAllUserRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllUserRefreshTokens'
+type Querier_RevokeAllUserRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeAllUserRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) RevokeAllUserRefreshTokens(ctx interface{}, userID interface{}) *Querier_RevokeAllUserRefreshTokens_Call {
+	return &Querier_RevokeAllUserRefreshTokens_Call{Call: _e.mock.On("RevokeAllUserRefreshTokens", ctx, userID)}
+}
+
+func (_c *Querier_RevokeAllUserRefreshTokens_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_RevokeAllUserRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RevokeAllUserRefreshTokens_Call) Return(err error) *Querier_RevokeAllUserRefreshTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RevokeAllUserRefreshTokens_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) error) *Querier_RevokeAllUserRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeContactShareConsent provides a mock function for the type Querier
+func (_mock *Querier) RevokeContactShareConsent(ctx context.Context, arg repository.RevokeContactShareConsentParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeContactShareConsent")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RevokeContactShareConsentParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.RevokeContactShareConsentParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.RevokeContactShareConsentParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_RevokeContactShareConsent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeContactShareConsent'
+type Querier_RevokeContactShareConsent_Call struct {
+	*mock.Call
+}
+
+// RevokeContactShareConsent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.RevokeContactShareConsentParams
+func (_e *Querier_Expecter) RevokeContactShareConsent(ctx interface{}, arg interface{}) *Querier_RevokeContactShareConsent_Call {
+	return &Querier_RevokeContactShareConsent_Call{Call: _e.mock.On("RevokeContactShareConsent", ctx, arg)}
+}
+
+func (_c *Querier_RevokeContactShareConsent_Call) Run(run func(ctx context.Context, arg repository.RevokeContactShareConsentParams)) *Querier_RevokeContactShareConsent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.RevokeContactShareConsentParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.RevokeContactShareConsentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RevokeContactShareConsent_Call) Return(n int64, err error) *Querier_RevokeContactShareConsent_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_RevokeContactShareConsent_Call) RunAndReturn(run func(ctx context.Context, arg repository.RevokeContactShareConsentParams) (int64, error)) *Querier_RevokeContactShareConsent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRefreshToken provides a mock function for the type Querier
+func (_mock *Querier) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_RevokeRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRefreshToken'
+type Querier_RevokeRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RevokeRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *Querier_Expecter) RevokeRefreshToken(ctx interface{}, tokenHash interface{}) *Querier_RevokeRefreshToken_Call {
+	return &Querier_RevokeRefreshToken_Call{Call: _e.mock.On("RevokeRefreshToken", ctx, tokenHash)}
+}
+
+func (_c *Querier_RevokeRefreshToken_Call) Run(run func(ctx context.Context, tokenHash string)) *Querier_RevokeRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_RevokeRefreshToken_Call) Return(err error) *Querier_RevokeRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_RevokeRefreshToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) error) *Querier_RevokeRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveGameCancellationReason provides a mock function for the type Querier
+func (_mock *Querier) SaveGameCancellationReason(ctx context.Context, arg repository.SaveGameCancellationReasonParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveGameCancellationReason")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SaveGameCancellationReasonParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_SaveGameCancellationReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveGameCancellationReason'
+type Querier_SaveGameCancellationReason_Call struct {
+	*mock.Call
+}
+
+// SaveGameCancellationReason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.SaveGameCancellationReasonParams
+func (_e *Querier_Expecter) SaveGameCancellationReason(ctx interface{}, arg interface{}) *Querier_SaveGameCancellationReason_Call {
+	return &Querier_SaveGameCancellationReason_Call{Call: _e.mock.On("SaveGameCancellationReason", ctx, arg)}
+}
+
+func (_c *Querier_SaveGameCancellationReason_Call) Run(run func(ctx context.Context, arg repository.SaveGameCancellationReasonParams)) *Querier_SaveGameCancellationReason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.SaveGameCancellationReasonParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.SaveGameCancellationReasonParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_SaveGameCancellationReason_Call) Return(err error) *Querier_SaveGameCancellationReason_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_SaveGameCancellationReason_Call) RunAndReturn(run func(ctx context.Context, arg repository.SaveGameCancellationReasonParams) error) *Querier_SaveGameCancellationReason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveUserOnboarding provides a mock function for the type Querier
+func (_mock *Querier) SaveUserOnboarding(ctx context.Context, arg repository.SaveUserOnboardingParams) (repository.UserSetting, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUserOnboarding")
+	}
+
+	var r0 repository.UserSetting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SaveUserOnboardingParams) (repository.UserSetting, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SaveUserOnboardingParams) repository.UserSetting); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.UserSetting)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.SaveUserOnboardingParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_SaveUserOnboarding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUserOnboarding'
+type Querier_SaveUserOnboarding_Call struct {
+	*mock.Call
+}
+
+// SaveUserOnboarding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.SaveUserOnboardingParams
+func (_e *Querier_Expecter) SaveUserOnboarding(ctx interface{}, arg interface{}) *Querier_SaveUserOnboarding_Call {
+	return &Querier_SaveUserOnboarding_Call{Call: _e.mock.On("SaveUserOnboarding", ctx, arg)}
+}
+
+func (_c *Querier_SaveUserOnboarding_Call) Run(run func(ctx context.Context, arg repository.SaveUserOnboardingParams)) *Querier_SaveUserOnboarding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.SaveUserOnboardingParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.SaveUserOnboardingParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_SaveUserOnboarding_Call) Return(userSetting repository.UserSetting, err error) *Querier_SaveUserOnboarding_Call {
+	_c.Call.Return(userSetting, err)
+	return _c
+}
+
+func (_c *Querier_SaveUserOnboarding_Call) RunAndReturn(run func(ctx context.Context, arg repository.SaveUserOnboardingParams) (repository.UserSetting, error)) *Querier_SaveUserOnboarding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSavedSearchAlerts provides a mock function for the type Querier
+func (_mock *Querier) SetSavedSearchAlerts(ctx context.Context, arg repository.SetSavedSearchAlertsParams) (repository.SavedSearch, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSavedSearchAlerts")
+	}
+
+	var r0 repository.SavedSearch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SetSavedSearchAlertsParams) (repository.SavedSearch, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SetSavedSearchAlertsParams) repository.SavedSearch); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.SavedSearch)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.SetSavedSearchAlertsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_SetSavedSearchAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSavedSearchAlerts'
+type Querier_SetSavedSearchAlerts_Call struct {
+	*mock.Call
+}
+
+// SetSavedSearchAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.SetSavedSearchAlertsParams
+func (_e *Querier_Expecter) SetSavedSearchAlerts(ctx interface{}, arg interface{}) *Querier_SetSavedSearchAlerts_Call {
+	return &Querier_SetSavedSearchAlerts_Call{Call: _e.mock.On("SetSavedSearchAlerts", ctx, arg)}
+}
+
+func (_c *Querier_SetSavedSearchAlerts_Call) Run(run func(ctx context.Context, arg repository.SetSavedSearchAlertsParams)) *Querier_SetSavedSearchAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.SetSavedSearchAlertsParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.SetSavedSearchAlertsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_SetSavedSearchAlerts_Call) Return(savedSearch repository.SavedSearch, err error) *Querier_SetSavedSearchAlerts_Call {
+	_c.Call.Return(savedSearch, err)
+	return _c
+}
+
+func (_c *Querier_SetSavedSearchAlerts_Call) RunAndReturn(run func(ctx context.Context, arg repository.SetSavedSearchAlertsParams) (repository.SavedSearch, error)) *Querier_SetSavedSearchAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserVerifiedPhone provides a mock function for the type Querier
+func (_mock *Querier) SetUserVerifiedPhone(ctx context.Context, arg repository.SetUserVerifiedPhoneParams) (repository.User, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserVerifiedPhone")
+	}
+
+	var r0 repository.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SetUserVerifiedPhoneParams) (repository.User, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SetUserVerifiedPhoneParams) repository.User); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.SetUserVerifiedPhoneParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_SetUserVerifiedPhone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserVerifiedPhone'
+type Querier_SetUserVerifiedPhone_Call struct {
+	*mock.Call
+}
+
+// SetUserVerifiedPhone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.SetUserVerifiedPhoneParams
+func (_e *Querier_Expecter) SetUserVerifiedPhone(ctx interface{}, arg interface{}) *Querier_SetUserVerifiedPhone_Call {
+	return &Querier_SetUserVerifiedPhone_Call{Call: _e.mock.On("SetUserVerifiedPhone", ctx, arg)}
+}
+
+func (_c *Querier_SetUserVerifiedPhone_Call) Run(run func(ctx context.Context, arg repository.SetUserVerifiedPhoneParams)) *Querier_SetUserVerifiedPhone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.SetUserVerifiedPhoneParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.SetUserVerifiedPhoneParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_SetUserVerifiedPhone_Call) Return(user repository.User, err error) *Querier_SetUserVerifiedPhone_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *Querier_SetUserVerifiedPhone_Call) RunAndReturn(run func(ctx context.Context, arg repository.SetUserVerifiedPhoneParams) (repository.User, error)) *Querier_SetUserVerifiedPhone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShadowBanUser provides a mock function for the type Querier
+func (_mock *Querier) ShadowBanUser(ctx context.Context, arg repository.ShadowBanUserParams) (repository.UserShadowBan, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShadowBanUser")
+	}
+
+	var r0 repository.UserShadowBan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ShadowBanUserParams) (repository.UserShadowBan, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ShadowBanUserParams) repository.UserShadowBan); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.UserShadowBan)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ShadowBanUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_ShadowBanUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShadowBanUser'
+type Querier_ShadowBanUser_Call struct {
+	*mock.Call
+}
+
+// ShadowBanUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.ShadowBanUserParams
+func (_e *Querier_Expecter) ShadowBanUser(ctx interface{}, arg interface{}) *Querier_ShadowBanUser_Call {
+	return &Querier_ShadowBanUser_Call{Call: _e.mock.On("ShadowBanUser", ctx, arg)}
+}
+
+func (_c *Querier_ShadowBanUser_Call) Run(run func(ctx context.Context, arg repository.ShadowBanUserParams)) *Querier_ShadowBanUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.ShadowBanUserParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.ShadowBanUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_ShadowBanUser_Call) Return(userShadowBan repository.UserShadowBan, err error) *Querier_ShadowBanUser_Call {
+	_c.Call.Return(userShadowBan, err)
+	return _c
+}
+
+func (_c *Querier_ShadowBanUser_Call) RunAndReturn(run func(ctx context.Context, arg repository.ShadowBanUserParams) (repository.UserShadowBan, error)) *Querier_ShadowBanUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartGamesPastStartTime provides a mock function for the type Querier
+func (_mock *Querier) StartGamesPastStartTime(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartGamesPastStartTime")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_StartGamesPastStartTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartGamesPastStartTime'
+type Querier_StartGamesPastStartTime_Call struct {
+	*mock.Call
+}
+
+// StartGamesPastStartTime is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Querier_Expecter) StartGamesPastStartTime(ctx interface{}) *Querier_StartGamesPastStartTime_Call {
+	return &Querier_StartGamesPastStartTime_Call{Call: _e.mock.On("StartGamesPastStartTime", ctx)}
+}
+
+func (_c *Querier_StartGamesPastStartTime_Call) Run(run func(ctx context.Context)) *Querier_StartGamesPastStartTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_StartGamesPastStartTime_Call) Return(n int64, err error) *Querier_StartGamesPastStartTime_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_StartGamesPastStartTime_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Querier_StartGamesPastStartTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuspendUser provides a mock function for the type Querier
+func (_mock *Querier) SuspendUser(ctx context.Context, arg repository.SuspendUserParams) (repository.UserSuspension, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuspendUser")
+	}
+
+	var r0 repository.UserSuspension
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SuspendUserParams) (repository.UserSuspension, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.SuspendUserParams) repository.UserSuspension); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.UserSuspension)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.SuspendUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_SuspendUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendUser'
+type Querier_SuspendUser_Call struct {
+	*mock.Call
+}
+
+// SuspendUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.SuspendUserParams
+func (_e *Querier_Expecter) SuspendUser(ctx interface{}, arg interface{}) *Querier_SuspendUser_Call {
+	return &Querier_SuspendUser_Call{Call: _e.mock.On("SuspendUser", ctx, arg)}
+}
+
+func (_c *Querier_SuspendUser_Call) Run(run func(ctx context.Context, arg repository.SuspendUserParams)) *Querier_SuspendUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.SuspendUserParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.SuspendUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_SuspendUser_Call) Return(userSuspension repository.UserSuspension, err error) *Querier_SuspendUser_Call {
+	_c.Call.Return(userSuspension, err)
+	return _c
+}
+
+func (_c *Querier_SuspendUser_Call) RunAndReturn(run func(ctx context.Context, arg repository.SuspendUserParams) (repository.UserSuspension, error)) *Querier_SuspendUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchConversation provides a mock function for the type Querier
+func (_mock *Querier) TouchConversation(ctx context.Context, arg repository.TouchConversationParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchConversation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.TouchConversationParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_TouchConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchConversation'
+type Querier_TouchConversation_Call struct {
+	*mock.Call
+}
+
+// TouchConversation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.TouchConversationParams
+func (_e *Querier_Expecter) TouchConversation(ctx interface{}, arg interface{}) *Querier_TouchConversation_Call {
+	return &Querier_TouchConversation_Call{Call: _e.mock.On("TouchConversation", ctx, arg)}
+}
+
+func (_c *Querier_TouchConversation_Call) Run(run func(ctx context.Context, arg repository.TouchConversationParams)) *Querier_TouchConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.TouchConversationParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.TouchConversationParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_TouchConversation_Call) Return(err error) *Querier_TouchConversation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_TouchConversation_Call) RunAndReturn(run func(ctx context.Context, arg repository.TouchConversationParams) error) *Querier_TouchConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignTeamParticipants provides a mock function for the type Querier
+func (_mock *Querier) UnassignTeamParticipants(ctx context.Context, teamID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignTeamParticipants")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, teamID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, teamID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UnassignTeamParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignTeamParticipants'
+type Querier_UnassignTeamParticipants_Call struct {
+	*mock.Call
+}
+
+// UnassignTeamParticipants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID pgtype.UUID
+func (_e *Querier_Expecter) UnassignTeamParticipants(ctx interface{}, teamID interface{}) *Querier_UnassignTeamParticipants_Call {
+	return &Querier_UnassignTeamParticipants_Call{Call: _e.mock.On("UnassignTeamParticipants", ctx, teamID)}
+}
+
+func (_c *Querier_UnassignTeamParticipants_Call) Run(run func(ctx context.Context, teamID pgtype.UUID)) *Querier_UnassignTeamParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UnassignTeamParticipants_Call) Return(n int64, err error) *Querier_UnassignTeamParticipants_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_UnassignTeamParticipants_Call) RunAndReturn(run func(ctx context.Context, teamID pgtype.UUID) (int64, error)) *Querier_UnassignTeamParticipants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnblockPlayer provides a mock function for the type Querier
+func (_mock *Querier) UnblockPlayer(ctx context.Context, arg repository.UnblockPlayerParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnblockPlayer")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UnblockPlayerParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UnblockPlayerParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UnblockPlayerParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UnblockPlayer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnblockPlayer'
+type Querier_UnblockPlayer_Call struct {
+	*mock.Call
+}
+
+// UnblockPlayer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UnblockPlayerParams
+func (_e *Querier_Expecter) UnblockPlayer(ctx interface{}, arg interface{}) *Querier_UnblockPlayer_Call {
+	return &Querier_UnblockPlayer_Call{Call: _e.mock.On("UnblockPlayer", ctx, arg)}
+}
+
+func (_c *Querier_UnblockPlayer_Call) Run(run func(ctx context.Context, arg repository.UnblockPlayerParams)) *Querier_UnblockPlayer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UnblockPlayerParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UnblockPlayerParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UnblockPlayer_Call) Return(n int64, err error) *Querier_UnblockPlayer_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_UnblockPlayer_Call) RunAndReturn(run func(ctx context.Context, arg repository.UnblockPlayerParams) (int64, error)) *Querier_UnblockPlayer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsuspendUser provides a mock function for the type Querier
+func (_mock *Querier) UnsuspendUser(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsuspendUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UnsuspendUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsuspendUser'
+type Querier_UnsuspendUser_Call struct {
+	*mock.Call
+}
+
+// UnsuspendUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID pgtype.UUID
+func (_e *Querier_Expecter) UnsuspendUser(ctx interface{}, userID interface{}) *Querier_UnsuspendUser_Call {
+	return &Querier_UnsuspendUser_Call{Call: _e.mock.On("UnsuspendUser", ctx, userID)}
+}
+
+func (_c *Querier_UnsuspendUser_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_UnsuspendUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgtype.UUID
+		if args[1] != nil {
+			arg1 = args[1].(pgtype.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UnsuspendUser_Call) Return(n int64, err error) *Querier_UnsuspendUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Querier_UnsuspendUser_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) (int64, error)) *Querier_UnsuspendUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGame provides a mock function for the type Querier
+func (_mock *Querier) UpdateGame(ctx context.Context, arg repository.UpdateGameParams) (pgtype.UUID, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGame")
+	}
+
+	var r0 pgtype.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateGameParams) (pgtype.UUID, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateGameParams) pgtype.UUID); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(pgtype.UUID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateGameParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGame'
+type Querier_UpdateGame_Call struct {
+	*mock.Call
+}
+
+// UpdateGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateGameParams
+func (_e *Querier_Expecter) UpdateGame(ctx interface{}, arg interface{}) *Querier_UpdateGame_Call {
+	return &Querier_UpdateGame_Call{Call: _e.mock.On("UpdateGame", ctx, arg)}
+}
+
+func (_c *Querier_UpdateGame_Call) Run(run func(ctx context.Context, arg repository.UpdateGameParams)) *Querier_UpdateGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateGameParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateGameParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateGame_Call) Return(uUID pgtype.UUID, err error) *Querier_UpdateGame_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *Querier_UpdateGame_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateGameParams) (pgtype.UUID, error)) *Querier_UpdateGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGameCommentBody provides a mock function for the type Querier
+func (_mock *Querier) UpdateGameCommentBody(ctx context.Context, arg repository.UpdateGameCommentBodyParams) (repository.GameComment, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGameCommentBody")
+	}
+
+	var r0 repository.GameComment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateGameCommentBodyParams) (repository.GameComment, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateGameCommentBodyParams) repository.GameComment); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.GameComment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateGameCommentBodyParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateGameCommentBody_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGameCommentBody'
+type Querier_UpdateGameCommentBody_Call struct {
+	*mock.Call
+}
+
+// UpdateGameCommentBody is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateGameCommentBodyParams
+func (_e *Querier_Expecter) UpdateGameCommentBody(ctx interface{}, arg interface{}) *Querier_UpdateGameCommentBody_Call {
+	return &Querier_UpdateGameCommentBody_Call{Call: _e.mock.On("UpdateGameCommentBody", ctx, arg)}
+}
+
+func (_c *Querier_UpdateGameCommentBody_Call) Run(run func(ctx context.Context, arg repository.UpdateGameCommentBodyParams)) *Querier_UpdateGameCommentBody_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateGameCommentBodyParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateGameCommentBodyParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateGameCommentBody_Call) Return(gameComment repository.GameComment, err error) *Querier_UpdateGameCommentBody_Call {
+	_c.Call.Return(gameComment, err)
+	return _c
+}
+
+func (_c *Querier_UpdateGameCommentBody_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateGameCommentBodyParams) (repository.GameComment, error)) *Querier_UpdateGameCommentBody_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGameStatus provides a mock function for the type Querier
+func (_mock *Querier) UpdateGameStatus(ctx context.Context, arg repository.UpdateGameStatusParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGameStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateGameStatusParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Querier_UpdateGameStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGameStatus'
+type Querier_UpdateGameStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateGameStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateGameStatusParams
+func (_e *Querier_Expecter) UpdateGameStatus(ctx interface{}, arg interface{}) *Querier_UpdateGameStatus_Call {
+	return &Querier_UpdateGameStatus_Call{Call: _e.mock.On("UpdateGameStatus", ctx, arg)}
+}
+
+func (_c *Querier_UpdateGameStatus_Call) Run(run func(ctx context.Context, arg repository.UpdateGameStatusParams)) *Querier_UpdateGameStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateGameStatusParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateGameStatusParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateGameStatus_Call) Return(err error) *Querier_UpdateGameStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Querier_UpdateGameStatus_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateGameStatusParams) error) *Querier_UpdateGameStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateParticipantPayment provides a mock function for the type Querier
+func (_mock *Querier) UpdateParticipantPayment(ctx context.Context, arg repository.UpdateParticipantPaymentParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateParticipantPayment")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantPaymentParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantPaymentParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateParticipantPaymentParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateParticipantPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParticipantPayment'
+type Querier_UpdateParticipantPayment_Call struct {
+	*mock.Call
+}
+
+// UpdateParticipantPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateParticipantPaymentParams
+func (_e *Querier_Expecter) UpdateParticipantPayment(ctx interface{}, arg interface{}) *Querier_UpdateParticipantPayment_Call {
+	return &Querier_UpdateParticipantPayment_Call{Call: _e.mock.On("UpdateParticipantPayment", ctx, arg)}
+}
+
+func (_c *Querier_UpdateParticipantPayment_Call) Run(run func(ctx context.Context, arg repository.UpdateParticipantPaymentParams)) *Querier_UpdateParticipantPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateParticipantPaymentParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateParticipantPaymentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateParticipantPayment_Call) Return(participant repository.Participant, err error) *Querier_UpdateParticipantPayment_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_UpdateParticipantPayment_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateParticipantPaymentParams) (repository.Participant, error)) *Querier_UpdateParticipantPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateParticipantStatus provides a mock function for the type Querier
+func (_mock *Querier) UpdateParticipantStatus(ctx context.Context, arg repository.UpdateParticipantStatusParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateParticipantStatus")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantStatusParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantStatusParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateParticipantStatusParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateParticipantStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParticipantStatus'
+type Querier_UpdateParticipantStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateParticipantStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateParticipantStatusParams
+func (_e *Querier_Expecter) UpdateParticipantStatus(ctx interface{}, arg interface{}) *Querier_UpdateParticipantStatus_Call {
+	return &Querier_UpdateParticipantStatus_Call{Call: _e.mock.On("UpdateParticipantStatus", ctx, arg)}
+}
+
+func (_c *Querier_UpdateParticipantStatus_Call) Run(run func(ctx context.Context, arg repository.UpdateParticipantStatusParams)) *Querier_UpdateParticipantStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateParticipantStatusParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateParticipantStatusParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateParticipantStatus_Call) Return(participant repository.Participant, err error) *Querier_UpdateParticipantStatus_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_UpdateParticipantStatus_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateParticipantStatusParams) (repository.Participant, error)) *Querier_UpdateParticipantStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateParticipantStatusResetJoinedAt provides a mock function for the type Querier
+func (_mock *Querier) UpdateParticipantStatusResetJoinedAt(ctx context.Context, arg repository.UpdateParticipantStatusResetJoinedAtParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateParticipantStatusResetJoinedAt")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantStatusResetJoinedAtParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantStatusResetJoinedAtParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateParticipantStatusResetJoinedAtParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateParticipantStatusResetJoinedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParticipantStatusResetJoinedAt'
+type Querier_UpdateParticipantStatusResetJoinedAt_Call struct {
+	*mock.Call
+}
+
+// UpdateParticipantStatusResetJoinedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateParticipantStatusResetJoinedAtParams
+func (_e *Querier_Expecter) UpdateParticipantStatusResetJoinedAt(ctx interface{}, arg interface{}) *Querier_UpdateParticipantStatusResetJoinedAt_Call {
+	return &Querier_UpdateParticipantStatusResetJoinedAt_Call{Call: _e.mock.On("UpdateParticipantStatusResetJoinedAt", ctx, arg)}
+}
+
+func (_c *Querier_UpdateParticipantStatusResetJoinedAt_Call) Run(run func(ctx context.Context, arg repository.UpdateParticipantStatusResetJoinedAtParams)) *Querier_UpdateParticipantStatusResetJoinedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateParticipantStatusResetJoinedAtParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateParticipantStatusResetJoinedAtParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateParticipantStatusResetJoinedAt_Call) Return(participant repository.Participant, err error) *Querier_UpdateParticipantStatusResetJoinedAt_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_UpdateParticipantStatusResetJoinedAt_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateParticipantStatusResetJoinedAtParams) (repository.Participant, error)) *Querier_UpdateParticipantStatusResetJoinedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateParticipantTeam provides a mock function for the type Querier
+func (_mock *Querier) UpdateParticipantTeam(ctx context.Context, arg repository.UpdateParticipantTeamParams) (repository.Participant, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateParticipantTeam")
+	}
+
+	var r0 repository.Participant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantTeamParams) (repository.Participant, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantTeamParams) repository.Participant); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Participant)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateParticipantTeamParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateParticipantTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParticipantTeam'
+type Querier_UpdateParticipantTeam_Call struct {
+	*mock.Call
+}
+
+// UpdateParticipantTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateParticipantTeamParams
+func (_e *Querier_Expecter) UpdateParticipantTeam(ctx interface{}, arg interface{}) *Querier_UpdateParticipantTeam_Call {
+	return &Querier_UpdateParticipantTeam_Call{Call: _e.mock.On("UpdateParticipantTeam", ctx, arg)}
+}
+
+func (_c *Querier_UpdateParticipantTeam_Call) Run(run func(ctx context.Context, arg repository.UpdateParticipantTeamParams)) *Querier_UpdateParticipantTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateParticipantTeamParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateParticipantTeamParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateParticipantTeam_Call) Return(participant repository.Participant, err error) *Querier_UpdateParticipantTeam_Call {
+	_c.Call.Return(participant, err)
+	return _c
+}
+
+func (_c *Querier_UpdateParticipantTeam_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateParticipantTeamParams) (repository.Participant, error)) *Querier_UpdateParticipantTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReportStatus provides a mock function for the type Querier
+func (_mock *Querier) UpdateReportStatus(ctx context.Context, arg repository.UpdateReportStatusParams) (repository.Report, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReportStatus")
+	}
+
+	var r0 repository.Report
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateReportStatusParams) (repository.Report, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateReportStatusParams) repository.Report); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Report)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateReportStatusParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateReportStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReportStatus'
+type Querier_UpdateReportStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateReportStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateReportStatusParams
+func (_e *Querier_Expecter) UpdateReportStatus(ctx interface{}, arg interface{}) *Querier_UpdateReportStatus_Call {
+	return &Querier_UpdateReportStatus_Call{Call: _e.mock.On("UpdateReportStatus", ctx, arg)}
+}
+
+func (_c *Querier_UpdateReportStatus_Call) Run(run func(ctx context.Context, arg repository.UpdateReportStatusParams)) *Querier_UpdateReportStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateReportStatusParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateReportStatusParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateReportStatus_Call) Return(report repository.Report, err error) *Querier_UpdateReportStatus_Call {
+	_c.Call.Return(report, err)
+	return _c
+}
+
+func (_c *Querier_UpdateReportStatus_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateReportStatusParams) (repository.Report, error)) *Querier_UpdateReportStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTeam provides a mock function for the type Querier
+func (_mock *Querier) UpdateTeam(ctx context.Context, arg repository.UpdateTeamParams) (repository.Team, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTeam")
+	}
+
+	var r0 repository.Team
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateTeamParams) (repository.Team, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateTeamParams) repository.Team); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.Team)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateTeamParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeam'
+type Querier_UpdateTeam_Call struct {
+	*mock.Call
+}
+
+// UpdateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateTeamParams
+func (_e *Querier_Expecter) UpdateTeam(ctx interface{}, arg interface{}) *Querier_UpdateTeam_Call {
+	return &Querier_UpdateTeam_Call{Call: _e.mock.On("UpdateTeam", ctx, arg)}
+}
+
+func (_c *Querier_UpdateTeam_Call) Run(run func(ctx context.Context, arg repository.UpdateTeamParams)) *Querier_UpdateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repository.UpdateTeamParams
+		if args[1] != nil {
+			arg1 = args[1].(repository.UpdateTeamParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Querier_UpdateTeam_Call) Return(team repository.Team, err error) *Querier_UpdateTeam_Call {
+	_c.Call.Return(team, err)
+	return _c
+}
+
+func (_c *Querier_UpdateTeam_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateTeamParams) (repository.Team, error)) *Querier_UpdateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function for the type Querier
+func (_mock *Querier) UpdateUser(ctx context.Context, arg repository.UpdateUserParams) (repository.User, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 repository.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateUserParams) (repository.User, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateUserParams) repository.User); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(repository.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateUserParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Querier_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type Querier_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg repository.UpdateUserParams
+func (_e *Querier_Expecter) UpdateUser(ctx interface{}, arg interface{}) *Querier_UpdateUser_Call {
+	return &Querier_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, arg)}
 }
 
-func (_c *Querier_ListActiveParticipantsByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListActiveParticipantsByGame_Call {
+func (_c *Querier_UpdateUser_Call) Run(run func(ctx context.Context, arg repository.UpdateUserParams)) *Querier_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
+		var arg1 repository.UpdateUserParams
 		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
+			arg1 = args[1].(repository.UpdateUserParams)
 		}
 		run(
 			arg0,
@@ -1363,37 +14989,35 @@ func (_c *Querier_ListActiveParticipantsByGame_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *Querier_ListActiveParticipantsByGame_Call) Return(listActiveParticipantsByGameRows []repository.ListActiveParticipantsByGameRow, err error) *Querier_ListActiveParticipantsByGame_Call {
-	_c.Call.Return(listActiveParticipantsByGameRows, err)
+func (_c *Querier_UpdateUser_Call) Return(user repository.User, err error) *Querier_UpdateUser_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *Querier_ListActiveParticipantsByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListActiveParticipantsByGameRow, error)) *Querier_ListActiveParticipantsByGame_Call {
+func (_c *Querier_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateUserParams) (repository.User, error)) *Querier_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListGamesInRadius provides a mock function for the type Querier
-func (_mock *Querier) ListGamesInRadius(ctx context.Context, arg repository.ListGamesInRadiusParams) ([]repository.ListGamesInRadiusRow, error) {
+// UpdateUserPassword provides a mock function for the type Querier
+func (_mock *Querier) UpdateUserPassword(ctx context.Context, arg repository.UpdateUserPasswordParams) (repository.User, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListGamesInRadius")
+		panic("no return value specified for UpdateUserPassword")
 	}
 
-	var r0 []repository.ListGamesInRadiusRow
+	var r0 repository.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListGamesInRadiusParams) ([]repository.ListGamesInRadiusRow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateUserPasswordParams) (repository.User, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.ListGamesInRadiusParams) []repository.ListGamesInRadiusRow); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateUserPasswordParams) repository.User); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListGamesInRadiusRow)
-		}
+		r0 = ret.Get(0).(repository.User)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.ListGamesInRadiusParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateUserPasswordParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1401,27 +15025,27 @@ func (_mock *Querier) ListGamesInRadius(ctx context.Context, arg repository.List
 	return r0, r1
 }
 
-// Querier_ListGamesInRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGamesInRadius'
-type Querier_ListGamesInRadius_Call struct {
+// Querier_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
+type Querier_UpdateUserPassword_Call struct {
 	*mock.Call
 }
 
-// ListGamesInRadius is a helper method to define mock.On call
+// UpdateUserPassword is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.ListGamesInRadiusParams
-func (_e *Querier_Expecter) ListGamesInRadius(ctx interface{}, arg interface{}) *Querier_ListGamesInRadius_Call {
-	return &Querier_ListGamesInRadius_Call{Call: _e.mock.On("ListGamesInRadius", ctx, arg)}
+//   - arg repository.UpdateUserPasswordParams
+func (_e *Querier_Expecter) UpdateUserPassword(ctx interface{}, arg interface{}) *Querier_UpdateUserPassword_Call {
+	return &Querier_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, arg)}
 }
 
-func (_c *Querier_ListGamesInRadius_Call) Run(run func(ctx context.Context, arg repository.ListGamesInRadiusParams)) *Querier_ListGamesInRadius_Call {
+func (_c *Querier_UpdateUserPassword_Call) Run(run func(ctx context.Context, arg repository.UpdateUserPasswordParams)) *Querier_UpdateUserPassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.ListGamesInRadiusParams
+		var arg1 repository.UpdateUserPasswordParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.ListGamesInRadiusParams)
+			arg1 = args[1].(repository.UpdateUserPasswordParams)
 		}
 		run(
 			arg0,
@@ -1431,65 +15055,54 @@ func (_c *Querier_ListGamesInRadius_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *Querier_ListGamesInRadius_Call) Return(listGamesInRadiusRows []repository.ListGamesInRadiusRow, err error) *Querier_ListGamesInRadius_Call {
-	_c.Call.Return(listGamesInRadiusRows, err)
+func (_c *Querier_UpdateUserPassword_Call) Return(user repository.User, err error) *Querier_UpdateUserPassword_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *Querier_ListGamesInRadius_Call) RunAndReturn(run func(ctx context.Context, arg repository.ListGamesInRadiusParams) ([]repository.ListGamesInRadiusRow, error)) *Querier_ListGamesInRadius_Call {
+func (_c *Querier_UpdateUserPassword_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateUserPasswordParams) (repository.User, error)) *Querier_UpdateUserPassword_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListParticipantsByGame provides a mock function for the type Querier
-func (_mock *Querier) ListParticipantsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.ListParticipantsByGameRow, error) {
-	ret := _mock.Called(ctx, gameID)
+// UpdateWebAuthnCredentialUsage provides a mock function for the type Querier
+func (_mock *Querier) UpdateWebAuthnCredentialUsage(ctx context.Context, arg repository.UpdateWebAuthnCredentialUsageParams) error {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListParticipantsByGame")
+		panic("no return value specified for UpdateWebAuthnCredentialUsage")
 	}
 
-	var r0 []repository.ListParticipantsByGameRow
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.ListParticipantsByGameRow, error)); ok {
-		return returnFunc(ctx, gameID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.ListParticipantsByGameRow); ok {
-		r0 = returnFunc(ctx, gameID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.ListParticipantsByGameRow)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, gameID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateWebAuthnCredentialUsageParams) error); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Querier_ListParticipantsByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParticipantsByGame'
-type Querier_ListParticipantsByGame_Call struct {
+// Querier_UpdateWebAuthnCredentialUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebAuthnCredentialUsage'
+type Querier_UpdateWebAuthnCredentialUsage_Call struct {
 	*mock.Call
 }
 
-// ListParticipantsByGame is a helper method to define mock.On call
+// UpdateWebAuthnCredentialUsage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - gameID pgtype.UUID
-func (_e *Querier_Expecter) ListParticipantsByGame(ctx interface{}, gameID interface{}) *Querier_ListParticipantsByGame_Call {
-	return &Querier_ListParticipantsByGame_Call{Call: _e.mock.On("ListParticipantsByGame", ctx, gameID)}
+//   - arg repository.UpdateWebAuthnCredentialUsageParams
+func (_e *Querier_Expecter) UpdateWebAuthnCredentialUsage(ctx interface{}, arg interface{}) *Querier_UpdateWebAuthnCredentialUsage_Call {
+	return &Querier_UpdateWebAuthnCredentialUsage_Call{Call: _e.mock.On("UpdateWebAuthnCredentialUsage", ctx, arg)}
 }
 
-func (_c *Querier_ListParticipantsByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListParticipantsByGame_Call {
+func (_c *Querier_UpdateWebAuthnCredentialUsage_Call) Run(run func(ctx context.Context, arg repository.UpdateWebAuthnCredentialUsageParams)) *Querier_UpdateWebAuthnCredentialUsage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
+		var arg1 repository.UpdateWebAuthnCredentialUsageParams
 		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
+			arg1 = args[1].(repository.UpdateWebAuthnCredentialUsageParams)
 		}
 		run(
 			arg0,
@@ -1499,65 +15112,63 @@ func (_c *Querier_ListParticipantsByGame_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *Querier_ListParticipantsByGame_Call) Return(listParticipantsByGameRows []repository.ListParticipantsByGameRow, err error) *Querier_ListParticipantsByGame_Call {
-	_c.Call.Return(listParticipantsByGameRows, err)
+func (_c *Querier_UpdateWebAuthnCredentialUsage_Call) Return(err error) *Querier_UpdateWebAuthnCredentialUsage_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Querier_ListParticipantsByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.ListParticipantsByGameRow, error)) *Querier_ListParticipantsByGame_Call {
+func (_c *Querier_UpdateWebAuthnCredentialUsage_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateWebAuthnCredentialUsageParams) error) *Querier_UpdateWebAuthnCredentialUsage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListParticipantsByUser provides a mock function for the type Querier
-func (_mock *Querier) ListParticipantsByUser(ctx context.Context, userID pgtype.UUID) ([]repository.Participant, error) {
-	ret := _mock.Called(ctx, userID)
+// UpsertAttendance provides a mock function for the type Querier
+func (_mock *Querier) UpsertAttendance(ctx context.Context, arg repository.UpsertAttendanceParams) (repository.Attendance, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListParticipantsByUser")
+		panic("no return value specified for UpsertAttendance")
 	}
 
-	var r0 []repository.Participant
+	var r0 repository.Attendance
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.Participant, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertAttendanceParams) (repository.Attendance, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.Participant); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertAttendanceParams) repository.Attendance); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.Participant)
-		}
+		r0 = ret.Get(0).(repository.Attendance)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpsertAttendanceParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_ListParticipantsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParticipantsByUser'
-type Querier_ListParticipantsByUser_Call struct {
+// Querier_UpsertAttendance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertAttendance'
+type Querier_UpsertAttendance_Call struct {
 	*mock.Call
 }
 
-// ListParticipantsByUser is a helper method to define mock.On call
+// UpsertAttendance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID pgtype.UUID
-func (_e *Querier_Expecter) ListParticipantsByUser(ctx interface{}, userID interface{}) *Querier_ListParticipantsByUser_Call {
-	return &Querier_ListParticipantsByUser_Call{Call: _e.mock.On("ListParticipantsByUser", ctx, userID)}
+//   - arg repository.UpsertAttendanceParams
+func (_e *Querier_Expecter) UpsertAttendance(ctx interface{}, arg interface{}) *Querier_UpsertAttendance_Call {
+	return &Querier_UpsertAttendance_Call{Call: _e.mock.On("UpsertAttendance", ctx, arg)}
 }
 
-func (_c *Querier_ListParticipantsByUser_Call) Run(run func(ctx context.Context, userID pgtype.UUID)) *Querier_ListParticipantsByUser_Call {
+func (_c *Querier_UpsertAttendance_Call) Run(run func(ctx context.Context, arg repository.UpsertAttendanceParams)) *Querier_UpsertAttendance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
+		var arg1 repository.UpsertAttendanceParams
 		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
+			arg1 = args[1].(repository.UpsertAttendanceParams)
 		}
 		run(
 			arg0,
@@ -1567,65 +15178,63 @@ func (_c *Querier_ListParticipantsByUser_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *Querier_ListParticipantsByUser_Call) Return(participants []repository.Participant, err error) *Querier_ListParticipantsByUser_Call {
-	_c.Call.Return(participants, err)
+func (_c *Querier_UpsertAttendance_Call) Return(attendance repository.Attendance, err error) *Querier_UpsertAttendance_Call {
+	_c.Call.Return(attendance, err)
 	return _c
 }
 
-func (_c *Querier_ListParticipantsByUser_Call) RunAndReturn(run func(ctx context.Context, userID pgtype.UUID) ([]repository.Participant, error)) *Querier_ListParticipantsByUser_Call {
+func (_c *Querier_UpsertAttendance_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpsertAttendanceParams) (repository.Attendance, error)) *Querier_UpsertAttendance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTeamsByGame provides a mock function for the type Querier
-func (_mock *Querier) ListTeamsByGame(ctx context.Context, gameID pgtype.UUID) ([]repository.Team, error) {
-	ret := _mock.Called(ctx, gameID)
+// UpsertGameMVP provides a mock function for the type Querier
+func (_mock *Querier) UpsertGameMVP(ctx context.Context, arg repository.UpsertGameMVPParams) (repository.GameMvp, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTeamsByGame")
+		panic("no return value specified for UpsertGameMVP")
 	}
 
-	var r0 []repository.Team
+	var r0 repository.GameMvp
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]repository.Team, error)); ok {
-		return returnFunc(ctx, gameID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertGameMVPParams) (repository.GameMvp, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []repository.Team); ok {
-		r0 = returnFunc(ctx, gameID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertGameMVPParams) repository.GameMvp); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.Team)
-		}
+		r0 = ret.Get(0).(repository.GameMvp)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
-		r1 = returnFunc(ctx, gameID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpsertGameMVPParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Querier_ListTeamsByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeamsByGame'
-type Querier_ListTeamsByGame_Call struct {
+// Querier_UpsertGameMVP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertGameMVP'
+type Querier_UpsertGameMVP_Call struct {
 	*mock.Call
 }
 
-// ListTeamsByGame is a helper method to define mock.On call
+// UpsertGameMVP is a helper method to define mock.On call
 //   - ctx context.Context
-//   - gameID pgtype.UUID
-func (_e *Querier_Expecter) ListTeamsByGame(ctx interface{}, gameID interface{}) *Querier_ListTeamsByGame_Call {
-	return &Querier_ListTeamsByGame_Call{Call: _e.mock.On("ListTeamsByGame", ctx, gameID)}
+//   - arg repository.UpsertGameMVPParams
+func (_e *Querier_Expecter) UpsertGameMVP(ctx interface{}, arg interface{}) *Querier_UpsertGameMVP_Call {
+	return &Querier_UpsertGameMVP_Call{Call: _e.mock.On("UpsertGameMVP", ctx, arg)}
 }
 
-func (_c *Querier_ListTeamsByGame_Call) Run(run func(ctx context.Context, gameID pgtype.UUID)) *Querier_ListTeamsByGame_Call {
+func (_c *Querier_UpsertGameMVP_Call) Run(run func(ctx context.Context, arg repository.UpsertGameMVPParams)) *Querier_UpsertGameMVP_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 pgtype.UUID
+		var arg1 repository.UpsertGameMVPParams
 		if args[1] != nil {
-			arg1 = args[1].(pgtype.UUID)
+			arg1 = args[1].(repository.UpsertGameMVPParams)
 		}
 		run(
 			arg0,
@@ -1635,35 +15244,35 @@ func (_c *Querier_ListTeamsByGame_Call) Run(run func(ctx context.Context, gameID
 	return _c
 }
 
-func (_c *Querier_ListTeamsByGame_Call) Return(teams []repository.Team, err error) *Querier_ListTeamsByGame_Call {
-	_c.Call.Return(teams, err)
+func (_c *Querier_UpsertGameMVP_Call) Return(gameMvp repository.GameMvp, err error) *Querier_UpsertGameMVP_Call {
+	_c.Call.Return(gameMvp, err)
 	return _c
 }
 
-func (_c *Querier_ListTeamsByGame_Call) RunAndReturn(run func(ctx context.Context, gameID pgtype.UUID) ([]repository.Team, error)) *Querier_ListTeamsByGame_Call {
+func (_c *Querier_UpsertGameMVP_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpsertGameMVPParams) (repository.GameMvp, error)) *Querier_UpsertGameMVP_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateGame provides a mock function for the type Querier
-func (_mock *Querier) UpdateGame(ctx context.Context, arg repository.UpdateGameParams) (pgtype.UUID, error) {
+// UpsertGameNotificationSettings provides a mock function for the type Querier
+func (_mock *Querier) UpsertGameNotificationSettings(ctx context.Context, arg repository.UpsertGameNotificationSettingsParams) (repository.GameNotificationSetting, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateGame")
+		panic("no return value specified for UpsertGameNotificationSettings")
 	}
 
-	var r0 pgtype.UUID
+	var r0 repository.GameNotificationSetting
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateGameParams) (pgtype.UUID, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertGameNotificationSettingsParams) (repository.GameNotificationSetting, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateGameParams) pgtype.UUID); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertGameNotificationSettingsParams) repository.GameNotificationSetting); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(pgtype.UUID)
+		r0 = ret.Get(0).(repository.GameNotificationSetting)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateGameParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpsertGameNotificationSettingsParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1671,27 +15280,27 @@ func (_mock *Querier) UpdateGame(ctx context.Context, arg repository.UpdateGameP
 	return r0, r1
 }
 
-// Querier_UpdateGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGame'
-type Querier_UpdateGame_Call struct {
+// Querier_UpsertGameNotificationSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertGameNotificationSettings'
+type Querier_UpsertGameNotificationSettings_Call struct {
 	*mock.Call
 }
 
-// UpdateGame is a helper method to define mock.On call
+// UpsertGameNotificationSettings is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateGameParams
-func (_e *Querier_Expecter) UpdateGame(ctx interface{}, arg interface{}) *Querier_UpdateGame_Call {
-	return &Querier_UpdateGame_Call{Call: _e.mock.On("UpdateGame", ctx, arg)}
+//   - arg repository.UpsertGameNotificationSettingsParams
+func (_e *Querier_Expecter) UpsertGameNotificationSettings(ctx interface{}, arg interface{}) *Querier_UpsertGameNotificationSettings_Call {
+	return &Querier_UpsertGameNotificationSettings_Call{Call: _e.mock.On("UpsertGameNotificationSettings", ctx, arg)}
 }
 
-func (_c *Querier_UpdateGame_Call) Run(run func(ctx context.Context, arg repository.UpdateGameParams)) *Querier_UpdateGame_Call {
+func (_c *Querier_UpsertGameNotificationSettings_Call) Run(run func(ctx context.Context, arg repository.UpsertGameNotificationSettingsParams)) *Querier_UpsertGameNotificationSettings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.UpdateGameParams
+		var arg1 repository.UpsertGameNotificationSettingsParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.UpdateGameParams)
+			arg1 = args[1].(repository.UpsertGameNotificationSettingsParams)
 		}
 		run(
 			arg0,
@@ -1701,35 +15310,35 @@ func (_c *Querier_UpdateGame_Call) Run(run func(ctx context.Context, arg reposit
 	return _c
 }
 
-func (_c *Querier_UpdateGame_Call) Return(uUID pgtype.UUID, err error) *Querier_UpdateGame_Call {
-	_c.Call.Return(uUID, err)
+func (_c *Querier_UpsertGameNotificationSettings_Call) Return(gameNotificationSetting repository.GameNotificationSetting, err error) *Querier_UpsertGameNotificationSettings_Call {
+	_c.Call.Return(gameNotificationSetting, err)
 	return _c
 }
 
-func (_c *Querier_UpdateGame_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateGameParams) (pgtype.UUID, error)) *Querier_UpdateGame_Call {
+func (_c *Querier_UpsertGameNotificationSettings_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpsertGameNotificationSettingsParams) (repository.GameNotificationSetting, error)) *Querier_UpsertGameNotificationSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateParticipantPayment provides a mock function for the type Querier
-func (_mock *Querier) UpdateParticipantPayment(ctx context.Context, arg repository.UpdateParticipantPaymentParams) (repository.Participant, error) {
+// UpsertGameReservation provides a mock function for the type Querier
+func (_mock *Querier) UpsertGameReservation(ctx context.Context, arg repository.UpsertGameReservationParams) (repository.GameReservation, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateParticipantPayment")
+		panic("no return value specified for UpsertGameReservation")
 	}
 
-	var r0 repository.Participant
+	var r0 repository.GameReservation
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantPaymentParams) (repository.Participant, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertGameReservationParams) (repository.GameReservation, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantPaymentParams) repository.Participant); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertGameReservationParams) repository.GameReservation); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Participant)
+		r0 = ret.Get(0).(repository.GameReservation)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateParticipantPaymentParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpsertGameReservationParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1737,27 +15346,27 @@ func (_mock *Querier) UpdateParticipantPayment(ctx context.Context, arg reposito
 	return r0, r1
 }
 
-// Querier_UpdateParticipantPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParticipantPayment'
-type Querier_UpdateParticipantPayment_Call struct {
+// Querier_UpsertGameReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertGameReservation'
+type Querier_UpsertGameReservation_Call struct {
 	*mock.Call
 }
 
-// UpdateParticipantPayment is a helper method to define mock.On call
+// UpsertGameReservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateParticipantPaymentParams
-func (_e *Querier_Expecter) UpdateParticipantPayment(ctx interface{}, arg interface{}) *Querier_UpdateParticipantPayment_Call {
-	return &Querier_UpdateParticipantPayment_Call{Call: _e.mock.On("UpdateParticipantPayment", ctx, arg)}
+//   - arg repository.UpsertGameReservationParams
+func (_e *Querier_Expecter) UpsertGameReservation(ctx interface{}, arg interface{}) *Querier_UpsertGameReservation_Call {
+	return &Querier_UpsertGameReservation_Call{Call: _e.mock.On("UpsertGameReservation", ctx, arg)}
 }
 
-func (_c *Querier_UpdateParticipantPayment_Call) Run(run func(ctx context.Context, arg repository.UpdateParticipantPaymentParams)) *Querier_UpdateParticipantPayment_Call {
+func (_c *Querier_UpsertGameReservation_Call) Run(run func(ctx context.Context, arg repository.UpsertGameReservationParams)) *Querier_UpsertGameReservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.UpdateParticipantPaymentParams
+		var arg1 repository.UpsertGameReservationParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.UpdateParticipantPaymentParams)
+			arg1 = args[1].(repository.UpsertGameReservationParams)
 		}
 		run(
 			arg0,
@@ -1767,35 +15376,35 @@ func (_c *Querier_UpdateParticipantPayment_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *Querier_UpdateParticipantPayment_Call) Return(participant repository.Participant, err error) *Querier_UpdateParticipantPayment_Call {
-	_c.Call.Return(participant, err)
+func (_c *Querier_UpsertGameReservation_Call) Return(gameReservation repository.GameReservation, err error) *Querier_UpsertGameReservation_Call {
+	_c.Call.Return(gameReservation, err)
 	return _c
 }
 
-func (_c *Querier_UpdateParticipantPayment_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateParticipantPaymentParams) (repository.Participant, error)) *Querier_UpdateParticipantPayment_Call {
+func (_c *Querier_UpsertGameReservation_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpsertGameReservationParams) (repository.GameReservation, error)) *Querier_UpsertGameReservation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateParticipantStatus provides a mock function for the type Querier
-func (_mock *Querier) UpdateParticipantStatus(ctx context.Context, arg repository.UpdateParticipantStatusParams) (repository.Participant, error) {
+// UpsertNotificationPreferences provides a mock function for the type Querier
+func (_mock *Querier) UpsertNotificationPreferences(ctx context.Context, arg repository.UpsertNotificationPreferencesParams) (repository.UserNotificationPreference, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateParticipantStatus")
+		panic("no return value specified for UpsertNotificationPreferences")
 	}
 
-	var r0 repository.Participant
+	var r0 repository.UserNotificationPreference
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantStatusParams) (repository.Participant, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertNotificationPreferencesParams) (repository.UserNotificationPreference, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantStatusParams) repository.Participant); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertNotificationPreferencesParams) repository.UserNotificationPreference); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Participant)
+		r0 = ret.Get(0).(repository.UserNotificationPreference)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateParticipantStatusParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpsertNotificationPreferencesParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1803,27 +15412,27 @@ func (_mock *Querier) UpdateParticipantStatus(ctx context.Context, arg repositor
 	return r0, r1
 }
 
-// Querier_UpdateParticipantStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParticipantStatus'
-type Querier_UpdateParticipantStatus_Call struct {
+// Querier_UpsertNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertNotificationPreferences'
+type Querier_UpsertNotificationPreferences_Call struct {
 	*mock.Call
 }
 
-// UpdateParticipantStatus is a helper method to define mock.On call
+// UpsertNotificationPreferences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateParticipantStatusParams
-func (_e *Querier_Expecter) UpdateParticipantStatus(ctx interface{}, arg interface{}) *Querier_UpdateParticipantStatus_Call {
-	return &Querier_UpdateParticipantStatus_Call{Call: _e.mock.On("UpdateParticipantStatus", ctx, arg)}
+//   - arg repository.UpsertNotificationPreferencesParams
+func (_e *Querier_Expecter) UpsertNotificationPreferences(ctx interface{}, arg interface{}) *Querier_UpsertNotificationPreferences_Call {
+	return &Querier_UpsertNotificationPreferences_Call{Call: _e.mock.On("UpsertNotificationPreferences", ctx, arg)}
 }
 
-func (_c *Querier_UpdateParticipantStatus_Call) Run(run func(ctx context.Context, arg repository.UpdateParticipantStatusParams)) *Querier_UpdateParticipantStatus_Call {
+func (_c *Querier_UpsertNotificationPreferences_Call) Run(run func(ctx context.Context, arg repository.UpsertNotificationPreferencesParams)) *Querier_UpsertNotificationPreferences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.UpdateParticipantStatusParams
+		var arg1 repository.UpsertNotificationPreferencesParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.UpdateParticipantStatusParams)
+			arg1 = args[1].(repository.UpsertNotificationPreferencesParams)
 		}
 		run(
 			arg0,
@@ -1833,35 +15442,35 @@ func (_c *Querier_UpdateParticipantStatus_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *Querier_UpdateParticipantStatus_Call) Return(participant repository.Participant, err error) *Querier_UpdateParticipantStatus_Call {
-	_c.Call.Return(participant, err)
+func (_c *Querier_UpsertNotificationPreferences_Call) Return(userNotificationPreference repository.UserNotificationPreference, err error) *Querier_UpsertNotificationPreferences_Call {
+	_c.Call.Return(userNotificationPreference, err)
 	return _c
 }
 
-func (_c *Querier_UpdateParticipantStatus_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateParticipantStatusParams) (repository.Participant, error)) *Querier_UpdateParticipantStatus_Call {
+func (_c *Querier_UpsertNotificationPreferences_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpsertNotificationPreferencesParams) (repository.UserNotificationPreference, error)) *Querier_UpsertNotificationPreferences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateParticipantStatusResetJoinedAt provides a mock function for the type Querier
-func (_mock *Querier) UpdateParticipantStatusResetJoinedAt(ctx context.Context, arg repository.UpdateParticipantStatusResetJoinedAtParams) (repository.Participant, error) {
+// UpsertSkillEndorsement provides a mock function for the type Querier
+func (_mock *Querier) UpsertSkillEndorsement(ctx context.Context, arg repository.UpsertSkillEndorsementParams) (repository.SkillEndorsement, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateParticipantStatusResetJoinedAt")
+		panic("no return value specified for UpsertSkillEndorsement")
 	}
 
-	var r0 repository.Participant
+	var r0 repository.SkillEndorsement
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantStatusResetJoinedAtParams) (repository.Participant, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertSkillEndorsementParams) (repository.SkillEndorsement, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantStatusResetJoinedAtParams) repository.Participant); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertSkillEndorsementParams) repository.SkillEndorsement); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Participant)
+		r0 = ret.Get(0).(repository.SkillEndorsement)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateParticipantStatusResetJoinedAtParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpsertSkillEndorsementParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1869,27 +15478,27 @@ func (_mock *Querier) UpdateParticipantStatusResetJoinedAt(ctx context.Context,
 	return r0, r1
 }
 
-// Querier_UpdateParticipantStatusResetJoinedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParticipantStatusResetJoinedAt'
-type Querier_UpdateParticipantStatusResetJoinedAt_Call struct {
+// Querier_UpsertSkillEndorsement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertSkillEndorsement'
+type Querier_UpsertSkillEndorsement_Call struct {
 	*mock.Call
 }
 
-// UpdateParticipantStatusResetJoinedAt is a helper method to define mock.On call
+// UpsertSkillEndorsement is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateParticipantStatusResetJoinedAtParams
-func (_e *Querier_Expecter) UpdateParticipantStatusResetJoinedAt(ctx interface{}, arg interface{}) *Querier_UpdateParticipantStatusResetJoinedAt_Call {
-	return &Querier_UpdateParticipantStatusResetJoinedAt_Call{Call: _e.mock.On("UpdateParticipantStatusResetJoinedAt", ctx, arg)}
+//   - arg repository.UpsertSkillEndorsementParams
+func (_e *Querier_Expecter) UpsertSkillEndorsement(ctx interface{}, arg interface{}) *Querier_UpsertSkillEndorsement_Call {
+	return &Querier_UpsertSkillEndorsement_Call{Call: _e.mock.On("UpsertSkillEndorsement", ctx, arg)}
 }
 
-func (_c *Querier_UpdateParticipantStatusResetJoinedAt_Call) Run(run func(ctx context.Context, arg repository.UpdateParticipantStatusResetJoinedAtParams)) *Querier_UpdateParticipantStatusResetJoinedAt_Call {
+func (_c *Querier_UpsertSkillEndorsement_Call) Run(run func(ctx context.Context, arg repository.UpsertSkillEndorsementParams)) *Querier_UpsertSkillEndorsement_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.UpdateParticipantStatusResetJoinedAtParams
+		var arg1 repository.UpsertSkillEndorsementParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.UpdateParticipantStatusResetJoinedAtParams)
+			arg1 = args[1].(repository.UpsertSkillEndorsementParams)
 		}
 		run(
 			arg0,
@@ -1899,35 +15508,35 @@ func (_c *Querier_UpdateParticipantStatusResetJoinedAt_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *Querier_UpdateParticipantStatusResetJoinedAt_Call) Return(participant repository.Participant, err error) *Querier_UpdateParticipantStatusResetJoinedAt_Call {
-	_c.Call.Return(participant, err)
+func (_c *Querier_UpsertSkillEndorsement_Call) Return(skillEndorsement repository.SkillEndorsement, err error) *Querier_UpsertSkillEndorsement_Call {
+	_c.Call.Return(skillEndorsement, err)
 	return _c
 }
 
-func (_c *Querier_UpdateParticipantStatusResetJoinedAt_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateParticipantStatusResetJoinedAtParams) (repository.Participant, error)) *Querier_UpdateParticipantStatusResetJoinedAt_Call {
+func (_c *Querier_UpsertSkillEndorsement_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpsertSkillEndorsementParams) (repository.SkillEndorsement, error)) *Querier_UpsertSkillEndorsement_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateParticipantTeam provides a mock function for the type Querier
-func (_mock *Querier) UpdateParticipantTeam(ctx context.Context, arg repository.UpdateParticipantTeamParams) (repository.Participant, error) {
+// UpsertUserSettings provides a mock function for the type Querier
+func (_mock *Querier) UpsertUserSettings(ctx context.Context, arg repository.UpsertUserSettingsParams) (repository.UserSetting, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateParticipantTeam")
+		panic("no return value specified for UpsertUserSettings")
 	}
 
-	var r0 repository.Participant
+	var r0 repository.UserSetting
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantTeamParams) (repository.Participant, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertUserSettingsParams) (repository.UserSetting, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateParticipantTeamParams) repository.Participant); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpsertUserSettingsParams) repository.UserSetting); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.Participant)
+		r0 = ret.Get(0).(repository.UserSetting)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateParticipantTeamParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpsertUserSettingsParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1935,27 +15544,27 @@ func (_mock *Querier) UpdateParticipantTeam(ctx context.Context, arg repository.
 	return r0, r1
 }
 
-// Querier_UpdateParticipantTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParticipantTeam'
-type Querier_UpdateParticipantTeam_Call struct {
+// Querier_UpsertUserSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertUserSettings'
+type Querier_UpsertUserSettings_Call struct {
 	*mock.Call
 }
 
-// UpdateParticipantTeam is a helper method to define mock.On call
+// UpsertUserSettings is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateParticipantTeamParams
-func (_e *Querier_Expecter) UpdateParticipantTeam(ctx interface{}, arg interface{}) *Querier_UpdateParticipantTeam_Call {
-	return &Querier_UpdateParticipantTeam_Call{Call: _e.mock.On("UpdateParticipantTeam", ctx, arg)}
+//   - arg repository.UpsertUserSettingsParams
+func (_e *Querier_Expecter) UpsertUserSettings(ctx interface{}, arg interface{}) *Querier_UpsertUserSettings_Call {
+	return &Querier_UpsertUserSettings_Call{Call: _e.mock.On("UpsertUserSettings", ctx, arg)}
 }
 
-func (_c *Querier_UpdateParticipantTeam_Call) Run(run func(ctx context.Context, arg repository.UpdateParticipantTeamParams)) *Querier_UpdateParticipantTeam_Call {
+func (_c *Querier_UpsertUserSettings_Call) Run(run func(ctx context.Context, arg repository.UpsertUserSettingsParams)) *Querier_UpsertUserSettings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.UpdateParticipantTeamParams
+		var arg1 repository.UpsertUserSettingsParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.UpdateParticipantTeamParams)
+			arg1 = args[1].(repository.UpsertUserSettingsParams)
 		}
 		run(
 			arg0,
@@ -1965,35 +15574,35 @@ func (_c *Querier_UpdateParticipantTeam_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *Querier_UpdateParticipantTeam_Call) Return(participant repository.Participant, err error) *Querier_UpdateParticipantTeam_Call {
-	_c.Call.Return(participant, err)
+func (_c *Querier_UpsertUserSettings_Call) Return(userSetting repository.UserSetting, err error) *Querier_UpsertUserSettings_Call {
+	_c.Call.Return(userSetting, err)
 	return _c
 }
 
-func (_c *Querier_UpdateParticipantTeam_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateParticipantTeamParams) (repository.Participant, error)) *Querier_UpdateParticipantTeam_Call {
+func (_c *Querier_UpsertUserSettings_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpsertUserSettingsParams) (repository.UserSetting, error)) *Querier_UpsertUserSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function for the type Querier
-func (_mock *Querier) UpdateUser(ctx context.Context, arg repository.UpdateUserParams) (repository.User, error) {
+// UserHasRole provides a mock function for the type Querier
+func (_mock *Querier) UserHasRole(ctx context.Context, arg repository.UserHasRoleParams) (bool, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
+		panic("no return value specified for UserHasRole")
 	}
 
-	var r0 repository.User
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateUserParams) (repository.User, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UserHasRoleParams) (bool, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UpdateUserParams) repository.User); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repository.UserHasRoleParams) bool); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(repository.User)
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UpdateUserParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repository.UserHasRoleParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2001,27 +15610,27 @@ func (_mock *Querier) UpdateUser(ctx context.Context, arg repository.UpdateUserP
 	return r0, r1
 }
 
-// Querier_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type Querier_UpdateUser_Call struct {
+// Querier_UserHasRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserHasRole'
+type Querier_UserHasRole_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
+// UserHasRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg repository.UpdateUserParams
-func (_e *Querier_Expecter) UpdateUser(ctx interface{}, arg interface{}) *Querier_UpdateUser_Call {
-	return &Querier_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, arg)}
+//   - arg repository.UserHasRoleParams
+func (_e *Querier_Expecter) UserHasRole(ctx interface{}, arg interface{}) *Querier_UserHasRole_Call {
+	return &Querier_UserHasRole_Call{Call: _e.mock.On("UserHasRole", ctx, arg)}
 }
 
-func (_c *Querier_UpdateUser_Call) Run(run func(ctx context.Context, arg repository.UpdateUserParams)) *Querier_UpdateUser_Call {
+func (_c *Querier_UserHasRole_Call) Run(run func(ctx context.Context, arg repository.UserHasRoleParams)) *Querier_UserHasRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 repository.UpdateUserParams
+		var arg1 repository.UserHasRoleParams
 		if args[1] != nil {
-			arg1 = args[1].(repository.UpdateUserParams)
+			arg1 = args[1].(repository.UserHasRoleParams)
 		}
 		run(
 			arg0,
@@ -2031,12 +15640,12 @@ func (_c *Querier_UpdateUser_Call) Run(run func(ctx context.Context, arg reposit
 	return _c
 }
 
-func (_c *Querier_UpdateUser_Call) Return(user repository.User, err error) *Querier_UpdateUser_Call {
-	_c.Call.Return(user, err)
+func (_c *Querier_UserHasRole_Call) Return(b bool, err error) *Querier_UserHasRole_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *Querier_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, arg repository.UpdateUserParams) (repository.User, error)) *Querier_UpdateUser_Call {
+func (_c *Querier_UserHasRole_Call) RunAndReturn(run func(ctx context.Context, arg repository.UserHasRoleParams) (bool, error)) *Querier_UserHasRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -57,6 +57,7 @@ func TestRegister_DuplicateEmail(t *testing.T) {
 		Password:  "password456@",
 		FirstName: "Jane",
 		LastName:  "Smith",
+		Birthdate: "1992-05-17",
 	}
 
 	var resp2 models.AuthResponse
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/gabe-dev-svc/volley/internal/models"
+	"github.com/gabe-dev-svc/volley/internal/repository"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 func TestCreateGame_Success(t *testing.T) {
@@ -356,3 +358,94 @@ func TestListGames_EmptyResult(t *testing.T) {
 		t.Errorf("expected 0 games, got %d", len(listResp.Games))
 	}
 }
+
+// TestListGamesInRadius_BlockedAfterDropping checks that a host's block hides their games from a
+// player who dropped out, while a player still in the game keeps seeing it
+func TestListGamesInRadius_BlockedAfterDropping(t *testing.T) {
+	hostClient := NewTestClient()
+	droppedClient := NewTestClient()
+	confirmedClient := NewTestClient()
+	ctx := context.Background()
+
+	host, err := hostClient.RegisterUser(TestEmail(t), "password123@", "Host", "User")
+	AssertNoError(t, err)
+	defer CleanupUser(ctx, host.User.ID)
+
+	skillLevel := models.SkillLevelAll
+	game, err := hostClient.CreateGame(models.CreateGameRequest{
+		Category:        models.GameCategoryBasketball,
+		StartTime:       time.Now().Add(48 * time.Hour),
+		DurationMinutes: 90,
+		MaxParticipants: 10,
+		Location: models.Location{
+			Name:      "Central Park",
+			Latitude:  floatPtr(40.7829),
+			Longitude: floatPtr(-73.9654),
+		},
+		Pricing: models.Pricing{
+			Type:        models.PricingTypeFree,
+			AmountCents: 0,
+			Currency:    "USD",
+		},
+		SkillLevel: &skillLevel,
+	})
+	AssertNoError(t, err)
+	defer CleanupGame(ctx, game.ID)
+
+	dropped, err := droppedClient.RegisterUser(TestEmail(t), "password123@", "Dropped", "User")
+	AssertNoError(t, err)
+	defer CleanupUser(ctx, dropped.User.ID)
+	confirmed, err := confirmedClient.RegisterUser(TestEmail(t), "password123@", "Confirmed", "User")
+	AssertNoError(t, err)
+	defer CleanupUser(ctx, confirmed.User.ID)
+
+	for _, client := range []*TestClient{droppedClient, confirmedClient} {
+		httpResp, err := client.POST("/v1/games/"+game.ID+"/join", nil, nil)
+		AssertNoError(t, err)
+		AssertStatusCode(t, http.StatusOK, httpResp.StatusCode)
+	}
+	httpResp, err := droppedClient.POST("/v1/games/"+game.ID+"/drop", nil, nil)
+	AssertNoError(t, err)
+	AssertStatusCode(t, http.StatusOK, httpResp.StatusCode)
+
+	var hostUUID, gameUUID pgtype.UUID
+	AssertNoError(t, hostUUID.Scan(host.User.ID))
+	AssertNoError(t, gameUUID.Scan(game.ID))
+	queries := repository.New(testDBPool)
+	listed := func(userID string) bool {
+		t.Helper()
+		var userUUID pgtype.UUID
+		AssertNoError(t, userUUID.Scan(userID))
+		rows, err := queries.ListGamesInRadius(ctx, repository.ListGamesInRadiusParams{
+			UserID:     userUUID,
+			Longitude:  -73.9654,
+			Latitude:   40.7829,
+			Radius:     10000,
+			StartTime:  pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			Categories: []string{string(models.GameCategoryBasketball)},
+			TimeZone:   "UTC",
+			Limit:      100,
+		})
+		AssertNoError(t, err)
+		for _, row := range rows {
+			if row.ID == gameUUID {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, userID := range []string{dropped.User.ID, confirmed.User.ID} {
+		var playerUUID pgtype.UUID
+		AssertNoError(t, playerUUID.Scan(userID))
+		AssertNoError(t, queries.BlockPlayer(ctx, repository.BlockPlayerParams{HostID: hostUUID, PlayerID: playerUUID}))
+	}
+	defer testDBPool.Exec(ctx, "DELETE FROM host_blocked_players WHERE host_id = $1", host.User.ID)
+
+	if listed(dropped.User.ID) {
+		t.Error("expected the blocking host's game to be hidden from the player who dropped")
+	}
+	if !listed(confirmed.User.ID) {
+		t.Error("expected a confirmed player to keep seeing the game after being blocked")
+	}
+}
@@ -105,6 +105,7 @@ func (c *TestClient) RegisterUser(email, password, firstName, lastName string) (
 		Password:  password,
 		FirstName: firstName,
 		LastName:  lastName,
+		Birthdate: "1990-01-01",
 	}
 
 	var resp models.AuthResponse
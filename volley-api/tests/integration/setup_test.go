@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/gabe-dev-svc/volley/internal/api"
+	"github.com/gabe-dev-svc/volley/internal/notifications"
+	"github.com/gabe-dev-svc/volley/internal/places"
 	"github.com/gabe-dev-svc/volley/internal/repository"
 	"github.com/gabe-dev-svc/volley/internal/service"
 	"github.com/gin-gonic/gin"
@@ -79,8 +81,8 @@ func TestMain(m *testing.M) {
 	// Start test API server
 	queries := repository.New(testDBPool)
 	gamesService := service.NewGamesService(queries, testDBPool)
-	userService := service.NewUserService(queries)
-	handler := api.NewHandler(gamesService, userService, "")
+	userService := service.NewUserService(queries, notifications.NewLogSMSSender(), notifications.NewLogEmailSender())
+	handler := api.NewHandler(gamesService, userService, service.NewStatsService(queries), places.NewSandboxClient())
 
 	// Set up router with middleware
 	router := gin.New()